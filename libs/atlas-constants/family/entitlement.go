@@ -0,0 +1,91 @@
+// Package family holds the family entitlement catalog shared by atlas-families,
+// which charges reputation for an entitlement, and atlas-channel and
+// atlas-rates, which carry out its effect.
+package family
+
+import "time"
+
+// EntitlementType is the wire index of an entitlement. The client addresses an
+// entitlement by its position in the privilege list, so the catalog order IS
+// the wire value.
+type EntitlementType byte
+
+const (
+	EntitlementTeleport           EntitlementType = 0
+	EntitlementSummon             EntitlementType = 1
+	EntitlementSelfDrop15         EntitlementType = 2
+	EntitlementSelfExp15          EntitlementType = 3
+	EntitlementBonding            EntitlementType = 4
+	EntitlementSelfDrop2          EntitlementType = 5
+	EntitlementSelfExp2           EntitlementType = 6
+	EntitlementSelfDrop2Extended  EntitlementType = 7
+	EntitlementSelfExp2Extended   EntitlementType = 8
+	EntitlementPartyDrop2Extended EntitlementType = 9
+	EntitlementPartyExp2Extended  EntitlementType = 10
+)
+
+// defaultEntitlementDailyUseLimit is how many times a day each entitlement may
+// be used. Usage counts reset with daily reputation.
+const defaultEntitlementDailyUseLimit = 1
+
+// Target identifies who an entitlement acts upon.
+type Target string
+
+const (
+	// TargetMember moves the user to, or summons, one named family member.
+	TargetMember Target = "MEMBER"
+	// TargetSelf buffs only the user.
+	TargetSelf Target = "SELF"
+	// TargetParty buffs the user and their party members.
+	TargetParty Target = "PARTY"
+	// TargetFamily buffs the user and their online juniors.
+	TargetFamily Target = "FAMILY"
+)
+
+// Entitlement is one reputation-priced family privilege.
+type Entitlement struct {
+	Type        EntitlementType
+	Name        string
+	Description string
+	RepCost     uint32
+	UsageLimit  uint32
+	Target      Target
+	ExpRate     float64
+	DropRate    float64
+	Duration    time.Duration
+}
+
+// IsBuff reports whether the entitlement grants a timed rate bonus rather
+// than moving a character.
+func (e Entitlement) IsBuff() bool {
+	return e.ExpRate > 1.0 || e.DropRate > 1.0
+}
+
+var entitlements = []Entitlement{
+	{Type: EntitlementTeleport, Name: "Family Reunion", Description: "[Target] Me\n[Effect] Teleport directly to the Family member of your choice.", RepCost: 300, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetMember},
+	{Type: EntitlementSummon, Name: "Summon Family", Description: "[Target] 1 Family member\n[Effect] Summon a Family member of choice to the map you're in.", RepCost: 500, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetMember},
+	{Type: EntitlementSelfDrop15, Name: "My Drop Rate 1.5x (15 min)", Description: "[Target] Me\n[Time] 15 min.\n[Effect] Monster drop rate will be increased #c1.5x#.", RepCost: 700, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, DropRate: 1.5, Duration: 15 * time.Minute},
+	{Type: EntitlementSelfExp15, Name: "My EXP 1.5x (15 min)", Description: "[Target] Me\n[Time] 15 min.\n[Effect] EXP earned from hunting will be increased #c1.5x#.", RepCost: 800, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, ExpRate: 1.5, Duration: 15 * time.Minute},
+	{Type: EntitlementBonding, Name: "Family Bonding (30 min)", Description: "[Target] Me and my online Juniors\n[Time] 30 min.\n[Effect] Monster drop rate and EXP earned will be increased #c2x#.", RepCost: 1000, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetFamily, ExpRate: 2.0, DropRate: 2.0, Duration: 30 * time.Minute},
+	{Type: EntitlementSelfDrop2, Name: "My Drop Rate 2x (15 min)", Description: "[Target] Me\n[Time] 15 min.\n[Effect] Monster drop rate will be increased #c2x#.", RepCost: 1200, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, DropRate: 2.0, Duration: 15 * time.Minute},
+	{Type: EntitlementSelfExp2, Name: "My EXP 2x (15 min)", Description: "[Target] Me\n[Time] 15 min.\n[Effect] EXP earned from hunting will be increased #c2x#.", RepCost: 1500, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, ExpRate: 2.0, Duration: 15 * time.Minute},
+	{Type: EntitlementSelfDrop2Extended, Name: "My Drop Rate 2x (30 min)", Description: "[Target] Me\n[Time] 30 min.\n[Effect] Monster drop rate will be increased #c2x#.", RepCost: 2000, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, DropRate: 2.0, Duration: 30 * time.Minute},
+	{Type: EntitlementSelfExp2Extended, Name: "My EXP 2x (30 min)", Description: "[Target] Me\n[Time] 30 min.\n[Effect] EXP earned from hunting will be increased #c2x#.", RepCost: 2500, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetSelf, ExpRate: 2.0, Duration: 30 * time.Minute},
+	{Type: EntitlementPartyDrop2Extended, Name: "My Party Drop Rate 2x (30 min)", Description: "[Target] Party\n[Time] 30 min.\n[Effect] Monster drop rate will be increased #c2x#.", RepCost: 4000, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetParty, DropRate: 2.0, Duration: 30 * time.Minute},
+	{Type: EntitlementPartyExp2Extended, Name: "My Party EXP 2x (30 min)", Description: "[Target] Party\n[Time] 30 min.\n[Effect] EXP earned from hunting will be increased #c2x#.", RepCost: 5000, UsageLimit: defaultEntitlementDailyUseLimit, Target: TargetParty, ExpRate: 2.0, Duration: 30 * time.Minute},
+}
+
+// Entitlements returns the catalog in wire order.
+func Entitlements() []Entitlement {
+	result := make([]Entitlement, len(entitlements))
+	copy(result, entitlements)
+	return result
+}
+
+// GetEntitlement looks an entitlement up by its wire index.
+func GetEntitlement(t EntitlementType) (Entitlement, bool) {
+	if int(t) >= len(entitlements) {
+		return Entitlement{}, false
+	}
+	return entitlements[t], true
+}
@@ -0,0 +1,27 @@
+package family
+
+import "testing"
+
+func TestEntitlementsAreInWireOrder(t *testing.T) {
+	for i, e := range Entitlements() {
+		if int(e.Type) != i {
+			t.Errorf("entitlement [%s] has type [%d] at position [%d]", e.Name, e.Type, i)
+		}
+	}
+}
+
+func TestGetEntitlement(t *testing.T) {
+	e, ok := GetEntitlement(EntitlementSelfExp2)
+	if !ok {
+		t.Fatalf("expected entitlement [%d] to exist", EntitlementSelfExp2)
+	}
+	if !e.IsBuff() || e.ExpRate != 2.0 || e.Target != TargetSelf {
+		t.Errorf("unexpected entitlement %+v", e)
+	}
+	if e, _ := GetEntitlement(EntitlementTeleport); e.IsBuff() {
+		t.Errorf("teleport must not be a buff")
+	}
+	if _, ok := GetEntitlement(EntitlementType(len(Entitlements()))); ok {
+		t.Errorf("expected lookup past the catalog to fail")
+	}
+}
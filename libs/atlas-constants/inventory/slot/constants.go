@@ -26,6 +26,7 @@ var Slots = []Slot{
 	{Type: "cape", Position: -9},
 	{Type: "top", Position: -5},
 	{Type: "pendant", Position: -17},
+	{Type: "pendant2", Position: -59},
 	{Type: "weapon", Position: -11},
 	{Type: "shield", Position: -10},
 	{Type: "gloves", Position: -8},
@@ -100,3 +101,38 @@ func GetSlotByPosition(position Position) (Slot, error) {
 	}
 	return Slot{}, errors.New("unknown position")
 }
+
+// Expansion describes an equip slot that is locked until a character
+// purchases an equip slot expansion for it. Base is the slot whose items may
+// also be worn in the expansion slot. Index is the expansion's ordinal in the
+// client's extended equip slot list.
+type Expansion struct {
+	Slot  Slot
+	Base  Position
+	Index uint16
+}
+
+// Expansions lists every equip slot gated behind an equip slot expansion.
+var Expansions = []Expansion{
+	{Slot: Slot{Type: "pendant2", Position: -59}, Base: -17, Index: 0},
+}
+
+// GetExpansionByPosition returns the expansion gating the given position.
+func GetExpansionByPosition(position Position) (Expansion, bool) {
+	for _, e := range Expansions {
+		if e.Slot.Position == position {
+			return e, true
+		}
+	}
+	return Expansion{}, false
+}
+
+// GetExpansionByType returns the expansion gating the given slot type.
+func GetExpansionByType(slotType Type) (Expansion, bool) {
+	for _, e := range Expansions {
+		if e.Slot.Type == slotType {
+			return e, true
+		}
+	}
+	return Expansion{}, false
+}
@@ -0,0 +1,286 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const AllianceOperationWriter = "AllianceOperation"
+
+// titleCount is the number of rank titles every alliance carries.
+const titleCount = 5
+
+// Info
+
+// packet-audit:fname CWvsContext::OnAllianceResult#Info
+type Info struct {
+	mode       byte
+	allianceId uint32
+	name       string
+	titles     []string
+	guildIds   []uint32
+	capacity   uint32
+	notice     string
+}
+
+func NewInfo(mode byte, allianceId uint32, name string, titles []string, guildIds []uint32, capacity uint32, notice string) Info {
+	return Info{mode: mode, allianceId: allianceId, name: name, titles: titles, guildIds: guildIds, capacity: capacity, notice: notice}
+}
+
+func (m Info) AllianceId() uint32 { return m.allianceId }
+func (m Info) Name() string       { return m.name }
+func (m Info) Titles() []string   { return m.titles }
+func (m Info) GuildIds() []uint32 { return m.guildIds }
+func (m Info) Capacity() uint32   { return m.capacity }
+func (m Info) Notice() string     { return m.notice }
+func (m Info) Operation() string  { return AllianceOperationWriter }
+
+func (m Info) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d], name [%s], guilds [%v], capacity [%d]", m.mode, m.allianceId, m.name, m.guildIds, m.capacity)
+}
+
+func (m Info) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteBool(true)
+		w.WriteInt(m.allianceId)
+		w.WriteAsciiString(m.name)
+		for _, t := range m.titles {
+			w.WriteAsciiString(t)
+		}
+		w.WriteByte(byte(len(m.guildIds)))
+		for _, id := range m.guildIds {
+			w.WriteInt(id)
+		}
+		w.WriteInt(m.capacity)
+		w.WriteAsciiString(m.notice)
+		return w.Bytes()
+	}
+}
+
+func (m *Info) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		_ = r.ReadBool()
+		m.allianceId = r.ReadUint32()
+		m.name = r.ReadAsciiString()
+		m.titles = make([]string, titleCount)
+		for i := range m.titles {
+			m.titles[i] = r.ReadAsciiString()
+		}
+		m.guildIds = make([]uint32, r.ReadByte())
+		for i := range m.guildIds {
+			m.guildIds[i] = r.ReadUint32()
+		}
+		m.capacity = r.ReadUint32()
+		m.notice = r.ReadAsciiString()
+	}
+}
+
+// Invite
+
+// packet-audit:fname CWvsContext::OnAllianceResult#Invite
+type Invite struct {
+	mode        byte
+	allianceId  uint32
+	inviterName string
+}
+
+func NewInvite(mode byte, allianceId uint32, inviterName string) Invite {
+	return Invite{mode: mode, allianceId: allianceId, inviterName: inviterName}
+}
+
+func (m Invite) AllianceId() uint32  { return m.allianceId }
+func (m Invite) InviterName() string { return m.inviterName }
+func (m Invite) Operation() string   { return AllianceOperationWriter }
+
+func (m Invite) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d], inviter [%s]", m.mode, m.allianceId, m.inviterName)
+}
+
+func (m Invite) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteInt(m.allianceId)
+		w.WriteAsciiString(m.inviterName)
+		w.WriteShort(0)
+		return w.Bytes()
+	}
+}
+
+func (m *Invite) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		m.allianceId = r.ReadUint32()
+		m.inviterName = r.ReadAsciiString()
+		_ = r.ReadUint16()
+	}
+}
+
+// TitlesUpdate
+
+// packet-audit:fname CWvsContext::OnAllianceResult#TitlesUpdate
+type TitlesUpdate struct {
+	mode       byte
+	allianceId uint32
+	titles     []string
+}
+
+func NewTitlesUpdate(mode byte, allianceId uint32, titles []string) TitlesUpdate {
+	return TitlesUpdate{mode: mode, allianceId: allianceId, titles: titles}
+}
+
+func (m TitlesUpdate) AllianceId() uint32 { return m.allianceId }
+func (m TitlesUpdate) Titles() []string   { return m.titles }
+func (m TitlesUpdate) Operation() string  { return AllianceOperationWriter }
+
+func (m TitlesUpdate) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d], titles [%v]", m.mode, m.allianceId, m.titles)
+}
+
+func (m TitlesUpdate) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteInt(m.allianceId)
+		for _, t := range m.titles {
+			w.WriteAsciiString(t)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *TitlesUpdate) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		m.allianceId = r.ReadUint32()
+		m.titles = make([]string, titleCount)
+		for i := range m.titles {
+			m.titles[i] = r.ReadAsciiString()
+		}
+	}
+}
+
+// MemberTitleUpdate
+
+// packet-audit:fname CWvsContext::OnAllianceResult#MemberTitleUpdate
+type MemberTitleUpdate struct {
+	mode        byte
+	allianceId  uint32
+	characterId uint32
+	title       uint32
+}
+
+func NewMemberTitleUpdate(mode byte, allianceId uint32, characterId uint32, title byte) MemberTitleUpdate {
+	return MemberTitleUpdate{mode: mode, allianceId: allianceId, characterId: characterId, title: uint32(title)}
+}
+
+func (m MemberTitleUpdate) AllianceId() uint32  { return m.allianceId }
+func (m MemberTitleUpdate) CharacterId() uint32 { return m.characterId }
+func (m MemberTitleUpdate) Title() byte         { return byte(m.title) }
+func (m MemberTitleUpdate) Operation() string   { return AllianceOperationWriter }
+
+func (m MemberTitleUpdate) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d], characterId [%d], title [%d]", m.mode, m.allianceId, m.characterId, m.title)
+}
+
+func (m MemberTitleUpdate) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteInt(m.allianceId)
+		w.WriteInt(m.characterId)
+		w.WriteInt(m.title)
+		return w.Bytes()
+	}
+}
+
+func (m *MemberTitleUpdate) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		m.allianceId = r.ReadUint32()
+		m.characterId = r.ReadUint32()
+		m.title = r.ReadUint32()
+	}
+}
+
+// NoticeUpdate
+
+// packet-audit:fname CWvsContext::OnAllianceResult#NoticeUpdate
+type NoticeUpdate struct {
+	mode       byte
+	allianceId uint32
+	notice     string
+}
+
+func NewNoticeUpdate(mode byte, allianceId uint32, notice string) NoticeUpdate {
+	return NoticeUpdate{mode: mode, allianceId: allianceId, notice: notice}
+}
+
+func (m NoticeUpdate) AllianceId() uint32 { return m.allianceId }
+func (m NoticeUpdate) Notice() string     { return m.notice }
+func (m NoticeUpdate) Operation() string  { return AllianceOperationWriter }
+
+func (m NoticeUpdate) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d], notice [%s]", m.mode, m.allianceId, m.notice)
+}
+
+func (m NoticeUpdate) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteInt(m.allianceId)
+		w.WriteAsciiString(m.notice)
+		return w.Bytes()
+	}
+}
+
+func (m *NoticeUpdate) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		m.allianceId = r.ReadUint32()
+		m.notice = r.ReadAsciiString()
+	}
+}
+
+// Disband
+
+// packet-audit:fname CWvsContext::OnAllianceResult#Disband
+type Disband struct {
+	mode       byte
+	allianceId uint32
+}
+
+func NewDisband(mode byte, allianceId uint32) Disband {
+	return Disband{mode: mode, allianceId: allianceId}
+}
+
+func (m Disband) AllianceId() uint32 { return m.allianceId }
+func (m Disband) Operation() string  { return AllianceOperationWriter }
+
+func (m Disband) String() string {
+	return fmt.Sprintf("mode [%d], allianceId [%d]", m.mode, m.allianceId)
+}
+
+func (m Disband) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.mode)
+		w.WriteInt(m.allianceId)
+		return w.Bytes()
+	}
+}
+
+func (m *Disband) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadByte()
+		m.allianceId = r.ReadUint32()
+	}
+}
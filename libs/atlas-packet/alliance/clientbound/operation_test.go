@@ -0,0 +1,68 @@
+package clientbound
+
+import (
+	"reflect"
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestAllianceInfo(t *testing.T) {
+	titles := []string{"Master", "Jr. Master", "Member", "Member", "Member"}
+	input := NewInfo(0x0C, 3, "Union", titles, []uint32{7, 9}, 3, "Welcome")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := Info{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.AllianceId() != 3 || output.Name() != "Union" || output.Capacity() != 3 || output.Notice() != "Welcome" {
+				t.Errorf("got %v", output)
+			}
+			if !reflect.DeepEqual(output.Titles(), titles) || !reflect.DeepEqual(output.GuildIds(), []uint32{7, 9}) {
+				t.Errorf("got titles %v, guilds %v", output.Titles(), output.GuildIds())
+			}
+		})
+	}
+}
+
+func TestAllianceInvite(t *testing.T) {
+	input := NewInvite(0x03, 3, "Master")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := Invite{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.AllianceId() != 3 || output.InviterName() != "Master" {
+				t.Errorf("got %v/%v", output.AllianceId(), output.InviterName())
+			}
+		})
+	}
+}
+
+func TestAllianceMemberTitleUpdate(t *testing.T) {
+	input := NewMemberTitleUpdate(0x1B, 3, 42, 4)
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := MemberTitleUpdate{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.AllianceId() != 3 || output.CharacterId() != 42 || output.Title() != 4 {
+				t.Errorf("got %v", output)
+			}
+		})
+	}
+}
+
+func TestAllianceNoticeUpdate(t *testing.T) {
+	input := NewNoticeUpdate(0x1C, 3, "Raid at 8")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := NoticeUpdate{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.AllianceId() != 3 || output.Notice() != "Raid at 8" {
+				t.Errorf("got %v/%v", output.AllianceId(), output.Notice())
+			}
+		})
+	}
+}
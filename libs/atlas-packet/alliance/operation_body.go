@@ -0,0 +1,58 @@
+package alliance
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	atlas_packet "github.com/Chronicle20/atlas/libs/atlas-packet"
+	"github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/packet"
+)
+
+// AllianceOperation result-mode keys (CWvsContext::OnAllianceResult), resolved
+// through the writer's "operations" option.
+const (
+	AllianceOperationInvite            = "INVITE"
+	AllianceOperationInfo              = "INFO"
+	AllianceOperationTitlesUpdate      = "TITLES_UPDATE"
+	AllianceOperationMemberTitleUpdate = "MEMBER_TITLE_UPDATE"
+	AllianceOperationNoticeUpdate      = "NOTICE_UPDATE"
+	AllianceOperationDisband           = "DISBAND"
+)
+
+func AllianceInfoBody(allianceId uint32, name string, titles []string, guildIds []uint32, capacity uint32, notice string) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationInfo, func(mode byte) packet.Encoder {
+		return clientbound.NewInfo(mode, allianceId, name, titles, guildIds, capacity, notice)
+	})
+}
+
+func AllianceInviteBody(allianceId uint32, inviterName string) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationInvite, func(mode byte) packet.Encoder {
+		return clientbound.NewInvite(mode, allianceId, inviterName)
+	})
+}
+
+func AllianceTitlesUpdatedBody(allianceId uint32, titles []string) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationTitlesUpdate, func(mode byte) packet.Encoder {
+		return clientbound.NewTitlesUpdate(mode, allianceId, titles)
+	})
+}
+
+func AllianceMemberTitleUpdatedBody(allianceId uint32, characterId uint32, title byte) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationMemberTitleUpdate, func(mode byte) packet.Encoder {
+		return clientbound.NewMemberTitleUpdate(mode, allianceId, characterId, title)
+	})
+}
+
+func AllianceNoticeUpdatedBody(allianceId uint32, notice string) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationNoticeUpdate, func(mode byte) packet.Encoder {
+		return clientbound.NewNoticeUpdate(mode, allianceId, notice)
+	})
+}
+
+func AllianceDisbandBody(allianceId uint32) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return atlas_packet.WithResolvedCode("operations", AllianceOperationDisband, func(mode byte) packet.Encoder {
+		return clientbound.NewDisband(mode, allianceId)
+	})
+}
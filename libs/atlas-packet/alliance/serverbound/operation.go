@@ -0,0 +1,242 @@
+package serverbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	AllianceOperationHandle    = "AllianceOperationHandle"
+	AllianceInviteRejectHandle = "AllianceInviteRejectHandle"
+)
+
+// TitleCount is the number of alliance rank titles the client edits at once.
+const TitleCount = 5
+
+// Operation - CTabGuildAlliance request prefix. The operation byte is mapped
+// to an arm through the handler's "operations" option.
+type Operation struct {
+	op byte
+}
+
+func (m Operation) Op() byte {
+	return m.op
+}
+
+func (m Operation) Operation() string {
+	return AllianceOperationHandle
+}
+
+func (m Operation) String() string {
+	return fmt.Sprintf("op [%d]", m.op)
+}
+
+func (m Operation) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.op)
+		return w.Bytes()
+	}
+}
+
+func (m *Operation) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.op = r.ReadByte()
+	}
+}
+
+// Invite - CTabGuildAlliance::OnInvite
+type Invite struct {
+	guildName string
+}
+
+func (m Invite) GuildName() string { return m.guildName }
+func (m Invite) Operation() string { return "Invite" }
+func (m Invite) String() string    { return fmt.Sprintf("guildName [%s]", m.guildName) }
+
+func (m Invite) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.guildName)
+		return w.Bytes()
+	}
+}
+
+func (m *Invite) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.guildName = r.ReadAsciiString()
+	}
+}
+
+// Join - CFadeWnd::SendCloseMessage, the guild master accepting an alliance
+// invitation.
+type Join struct {
+	allianceId uint32
+}
+
+func (m Join) AllianceId() uint32 { return m.allianceId }
+func (m Join) Operation() string  { return "Join" }
+func (m Join) String() string     { return fmt.Sprintf("allianceId [%d]", m.allianceId) }
+
+func (m Join) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.allianceId)
+		return w.Bytes()
+	}
+}
+
+func (m *Join) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.allianceId = r.ReadUint32()
+	}
+}
+
+// Kick - CTabGuildAlliance::OnKick
+type Kick struct {
+	guildId    uint32
+	allianceId uint32
+}
+
+func (m Kick) GuildId() uint32    { return m.guildId }
+func (m Kick) AllianceId() uint32 { return m.allianceId }
+func (m Kick) Operation() string  { return "Kick" }
+
+func (m Kick) String() string {
+	return fmt.Sprintf("guildId [%d], allianceId [%d]", m.guildId, m.allianceId)
+}
+
+func (m Kick) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.guildId)
+		w.WriteInt(m.allianceId)
+		return w.Bytes()
+	}
+}
+
+func (m *Kick) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.guildId = r.ReadUint32()
+		m.allianceId = r.ReadUint32()
+	}
+}
+
+// SetTitleNames - CWndAllianceGrade::OnSaveGradeName
+type SetTitleNames struct {
+	titles [TitleCount]string
+}
+
+func (m SetTitleNames) Titles() []string  { return m.titles[:] }
+func (m SetTitleNames) Operation() string { return "SetTitleNames" }
+func (m SetTitleNames) String() string    { return fmt.Sprintf("titles [%v]", m.titles) }
+
+func (m SetTitleNames) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		for _, t := range m.titles {
+			w.WriteAsciiString(t)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *SetTitleNames) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		for i := range m.titles {
+			m.titles[i] = r.ReadAsciiString()
+		}
+	}
+}
+
+// SetMemberTitle - CTabGuildAlliance::OnGradeChange. The client only raises
+// or lowers a member by one rank.
+type SetMemberTitle struct {
+	characterId uint32
+	raise       bool
+}
+
+func (m SetMemberTitle) CharacterId() uint32 { return m.characterId }
+func (m SetMemberTitle) Raise() bool         { return m.raise }
+func (m SetMemberTitle) Operation() string   { return "SetMemberTitle" }
+
+func (m SetMemberTitle) String() string {
+	return fmt.Sprintf("characterId [%d], raise [%t]", m.characterId, m.raise)
+}
+
+func (m SetMemberTitle) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.characterId)
+		w.WriteBool(m.raise)
+		return w.Bytes()
+	}
+}
+
+func (m *SetMemberTitle) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.characterId = r.ReadUint32()
+		m.raise = r.ReadBool()
+	}
+}
+
+// SetNotice - CTabGuildAlliance::OnSetNotice
+type SetNotice struct {
+	notice string
+}
+
+func (m SetNotice) Notice() string    { return m.notice }
+func (m SetNotice) Operation() string { return "SetNotice" }
+func (m SetNotice) String() string    { return fmt.Sprintf("notice [%s]", m.notice) }
+
+func (m SetNotice) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.notice)
+		return w.Bytes()
+	}
+}
+
+func (m *SetNotice) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.notice = r.ReadAsciiString()
+	}
+}
+
+// InviteReject - the guild master declining an alliance invitation.
+type InviteReject struct {
+	unk         byte
+	inviterName string
+	guildName   string
+}
+
+func (m InviteReject) InviterName() string { return m.inviterName }
+func (m InviteReject) GuildName() string   { return m.guildName }
+func (m InviteReject) Operation() string   { return AllianceInviteRejectHandle }
+
+func (m InviteReject) String() string {
+	return fmt.Sprintf("inviterName [%s], guildName [%s]", m.inviterName, m.guildName)
+}
+
+func (m InviteReject) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.unk)
+		w.WriteAsciiString(m.inviterName)
+		w.WriteAsciiString(m.guildName)
+		return w.Bytes()
+	}
+}
+
+func (m *InviteReject) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.unk = r.ReadByte()
+		m.inviterName = r.ReadAsciiString()
+		m.guildName = r.ReadAsciiString()
+	}
+}
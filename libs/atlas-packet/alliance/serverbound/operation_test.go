@@ -0,0 +1,63 @@
+package serverbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestKickRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := Kick{guildId: 7, allianceId: 3}
+			output := Kick{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.GuildId() != 7 || output.AllianceId() != 3 {
+				t.Errorf("got %v/%v", output.GuildId(), output.AllianceId())
+			}
+		})
+	}
+}
+
+func TestSetTitleNamesRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SetTitleNames{titles: [TitleCount]string{"Master", "Jr. Master", "A", "B", "C"}}
+			output := SetTitleNames{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.titles != input.titles {
+				t.Errorf("titles: got %v, want %v", output.Titles(), input.Titles())
+			}
+		})
+	}
+}
+
+func TestSetMemberTitleRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SetMemberTitle{characterId: 42, raise: true}
+			output := SetMemberTitle{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.CharacterId() != 42 || !output.Raise() {
+				t.Errorf("got %v/%v", output.CharacterId(), output.Raise())
+			}
+		})
+	}
+}
+
+func TestInviteRejectRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := InviteReject{inviterName: "Master", guildName: "Maple"}
+			output := InviteReject{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.InviterName() != "Master" || output.GuildName() != "Maple" {
+				t.Errorf("got %v/%v", output.InviterName(), output.GuildName())
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const FamilyChartResultWriter = "FamilyChartResult"
+
+// Chart statistic keys understood by the pedigree window.
+const (
+	ChartStatTotalMembers = int32(-1)
+	ChartStatTotalSeniors = int32(0)
+)
+
+type ChartEntry struct {
+	CharacterId   uint32
+	SeniorId      uint32
+	JobId         uint16
+	Level         byte
+	Online        bool
+	Rep           uint32
+	TotalRep      uint32
+	RepToSenior   uint32
+	TodaysRep     uint32
+	ChannelId     int32
+	OnlineMinutes uint32
+	Name          string
+}
+
+type ChartStat struct {
+	Key   int32
+	Value uint32
+}
+
+// packet-audit:fname CWvsContext::OnFamilyChartResult
+type ChartResult struct {
+	viewedId uint32
+	entries  []ChartEntry
+	stats    []ChartStat
+	canAdd   bool
+}
+
+func NewFamilyChartResult(viewedId uint32, entries []ChartEntry, stats []ChartStat, canAdd bool) ChartResult {
+	return ChartResult{viewedId: viewedId, entries: entries, stats: stats, canAdd: canAdd}
+}
+
+func (m ChartResult) ViewedId() uint32      { return m.viewedId }
+func (m ChartResult) Entries() []ChartEntry { return m.entries }
+func (m ChartResult) Stats() []ChartStat    { return m.stats }
+func (m ChartResult) CanAdd() bool          { return m.canAdd }
+func (m ChartResult) Operation() string     { return FamilyChartResultWriter }
+
+func (m ChartResult) String() string {
+	return fmt.Sprintf("chart for [%d] with [%d] entries", m.viewedId, len(m.entries))
+}
+
+func (m ChartResult) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.viewedId)
+		w.WriteInt(uint32(len(m.entries)))
+		for _, e := range m.entries {
+			w.WriteInt(e.CharacterId)
+			w.WriteInt(e.SeniorId)
+			w.WriteShort(e.JobId)
+			w.WriteByte(e.Level)
+			w.WriteBool(e.Online)
+			w.WriteInt(e.Rep)
+			w.WriteInt(e.TotalRep)
+			w.WriteInt(e.RepToSenior)
+			w.WriteInt(e.TodaysRep)
+			w.WriteInt32(e.ChannelId)
+			w.WriteInt(e.OnlineMinutes)
+			w.WriteAsciiString(e.Name)
+		}
+		w.WriteInt(uint32(len(m.stats)))
+		for _, s := range m.stats {
+			w.WriteInt32(s.Key)
+			w.WriteInt(s.Value)
+		}
+		if m.canAdd {
+			w.WriteShort(1)
+		} else {
+			w.WriteShort(0)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *ChartResult) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.viewedId = r.ReadUint32()
+		m.entries = make([]ChartEntry, r.ReadUint32())
+		for i := range m.entries {
+			m.entries[i].CharacterId = r.ReadUint32()
+			m.entries[i].SeniorId = r.ReadUint32()
+			m.entries[i].JobId = r.ReadUint16()
+			m.entries[i].Level = r.ReadByte()
+			m.entries[i].Online = r.ReadBool()
+			m.entries[i].Rep = r.ReadUint32()
+			m.entries[i].TotalRep = r.ReadUint32()
+			m.entries[i].RepToSenior = r.ReadUint32()
+			m.entries[i].TodaysRep = r.ReadUint32()
+			m.entries[i].ChannelId = r.ReadInt32()
+			m.entries[i].OnlineMinutes = r.ReadUint32()
+			m.entries[i].Name = r.ReadAsciiString()
+		}
+		m.stats = make([]ChartStat, r.ReadUint32())
+		for i := range m.stats {
+			m.stats[i].Key = r.ReadInt32()
+			m.stats[i].Value = r.ReadUint32()
+		}
+		m.canAdd = r.ReadUint16() != 0
+	}
+}
@@ -0,0 +1,38 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyChartResult(t *testing.T) {
+	entries := []ChartEntry{
+		{CharacterId: 1, SeniorId: 0, JobId: 112, Level: 120, Online: true, Rep: 300, TotalRep: 1500, RepToSenior: 0, TodaysRep: 20, ChannelId: 2, OnlineMinutes: 45, Name: "Leader"},
+		{CharacterId: 2, SeniorId: 1, JobId: 212, Level: 100, Online: false, Rep: 10, TotalRep: 40, RepToSenior: 40, TodaysRep: 0, ChannelId: -1, Name: "Junior"},
+	}
+	stats := []ChartStat{{Key: ChartStatTotalMembers, Value: 2}, {Key: ChartStatTotalSeniors, Value: 1}}
+	input := NewFamilyChartResult(2, entries, stats, true)
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := ChartResult{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.ViewedId() != 2 {
+				t.Errorf("viewedId: got %v, want 2", output.ViewedId())
+			}
+			if len(output.Entries()) != 2 {
+				t.Fatalf("entries: got %d, want 2", len(output.Entries()))
+			}
+			if output.Entries()[1] != entries[1] {
+				t.Errorf("entry: got %+v, want %+v", output.Entries()[1], entries[1])
+			}
+			if len(output.Stats()) != 2 || output.Stats()[0].Key != ChartStatTotalMembers {
+				t.Errorf("stats: got %+v", output.Stats())
+			}
+			if !output.CanAdd() {
+				t.Errorf("canAdd: got false, want true")
+			}
+		})
+	}
+}
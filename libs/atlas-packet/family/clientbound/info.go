@@ -0,0 +1,88 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const FamilyInfoResultWriter = "FamilyInfoResult"
+
+type EntitlementUse struct {
+	Type  uint32
+	Count uint32
+}
+
+// packet-audit:fname CWvsContext::OnFamilyInfoResult
+type InfoResult struct {
+	rep         uint32
+	totalRep    uint32
+	todaysRep   uint32
+	juniorCount uint16
+	leaderId    uint32
+	familyName  string
+	precept     string
+	uses        []EntitlementUse
+}
+
+func NewFamilyInfoResult(rep uint32, totalRep uint32, todaysRep uint32, juniorCount uint16, leaderId uint32, familyName string, precept string, uses []EntitlementUse) InfoResult {
+	return InfoResult{rep: rep, totalRep: totalRep, todaysRep: todaysRep, juniorCount: juniorCount, leaderId: leaderId, familyName: familyName, precept: precept, uses: uses}
+}
+
+func (m InfoResult) Rep() uint32            { return m.rep }
+func (m InfoResult) TotalRep() uint32       { return m.totalRep }
+func (m InfoResult) TodaysRep() uint32      { return m.todaysRep }
+func (m InfoResult) JuniorCount() uint16    { return m.juniorCount }
+func (m InfoResult) LeaderId() uint32       { return m.leaderId }
+func (m InfoResult) FamilyName() string     { return m.familyName }
+func (m InfoResult) Precept() string        { return m.precept }
+func (m InfoResult) Uses() []EntitlementUse { return m.uses }
+func (m InfoResult) Operation() string      { return FamilyInfoResultWriter }
+
+func (m InfoResult) String() string {
+	return fmt.Sprintf("rep [%d], total rep [%d], juniors [%d], family [%s]", m.rep, m.totalRep, m.juniorCount, m.familyName)
+}
+
+func (m InfoResult) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.rep)
+		w.WriteInt(m.totalRep)
+		w.WriteInt(m.todaysRep)
+		w.WriteShort(m.juniorCount)
+		w.WriteShort(2) // junior capacity
+		w.WriteShort(0)
+		w.WriteInt(m.leaderId)
+		w.WriteAsciiString(m.familyName)
+		w.WriteAsciiString(m.precept)
+		w.WriteInt(uint32(len(m.uses)))
+		for _, u := range m.uses {
+			w.WriteInt(u.Type)
+			w.WriteInt(u.Count)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *InfoResult) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.rep = r.ReadUint32()
+		m.totalRep = r.ReadUint32()
+		m.todaysRep = r.ReadUint32()
+		m.juniorCount = r.ReadUint16()
+		_ = r.ReadUint16() // junior capacity
+		_ = r.ReadUint16()
+		m.leaderId = r.ReadUint32()
+		m.familyName = r.ReadAsciiString()
+		m.precept = r.ReadAsciiString()
+		m.uses = make([]EntitlementUse, r.ReadUint32())
+		for i := range m.uses {
+			m.uses[i].Type = r.ReadUint32()
+			m.uses[i].Count = r.ReadUint32()
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyInfoResult(t *testing.T) {
+	input := NewFamilyInfoResult(500, 2500, 100, 2, 1, "Maple", "Be kind", []EntitlementUse{{Type: 0, Count: 1}, {Type: 3, Count: 1}})
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := InfoResult{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Rep() != 500 || output.TotalRep() != 2500 || output.TodaysRep() != 100 {
+				t.Errorf("rep: got %v/%v/%v", output.Rep(), output.TotalRep(), output.TodaysRep())
+			}
+			if output.JuniorCount() != 2 || output.LeaderId() != 1 {
+				t.Errorf("juniors/leader: got %v/%v", output.JuniorCount(), output.LeaderId())
+			}
+			if output.FamilyName() != "Maple" || output.Precept() != "Be kind" {
+				t.Errorf("names: got %v/%v", output.FamilyName(), output.Precept())
+			}
+			if len(output.Uses()) != 2 || output.Uses()[1].Type != 3 {
+				t.Errorf("uses: got %+v", output.Uses())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyJoinRequestWriter       = "FamilyJoinRequest"
+	FamilyJoinRequestResultWriter = "FamilyJoinRequestResult"
+	FamilyJoinAcceptedWriter      = "FamilyJoinAccepted"
+)
+
+// packet-audit:fname CWvsContext::OnFamilyJoinRequest
+type JoinRequest struct {
+	inviterId   uint32
+	inviterName string
+}
+
+func NewFamilyJoinRequest(inviterId uint32, inviterName string) JoinRequest {
+	return JoinRequest{inviterId: inviterId, inviterName: inviterName}
+}
+
+func (m JoinRequest) InviterId() uint32   { return m.inviterId }
+func (m JoinRequest) InviterName() string { return m.inviterName }
+func (m JoinRequest) Operation() string   { return FamilyJoinRequestWriter }
+
+func (m JoinRequest) String() string {
+	return fmt.Sprintf("inviter [%d], name [%s]", m.inviterId, m.inviterName)
+}
+
+func (m JoinRequest) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.inviterId)
+		w.WriteAsciiString(m.inviterName)
+		return w.Bytes()
+	}
+}
+
+func (m *JoinRequest) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.inviterId = r.ReadUint32()
+		m.inviterName = r.ReadAsciiString()
+	}
+}
+
+// packet-audit:fname CWvsContext::OnFamilyJoinRequestResult
+type JoinRequestResult struct {
+	accepted bool
+	name     string
+}
+
+func NewFamilyJoinRequestResult(accepted bool, name string) JoinRequestResult {
+	return JoinRequestResult{accepted: accepted, name: name}
+}
+
+func (m JoinRequestResult) Accepted() bool    { return m.accepted }
+func (m JoinRequestResult) Name() string      { return m.name }
+func (m JoinRequestResult) Operation() string { return FamilyJoinRequestResultWriter }
+
+func (m JoinRequestResult) String() string {
+	return fmt.Sprintf("accepted [%t], name [%s]", m.accepted, m.name)
+}
+
+func (m JoinRequestResult) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteBool(m.accepted)
+		w.WriteAsciiString(m.name)
+		return w.Bytes()
+	}
+}
+
+func (m *JoinRequestResult) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.accepted = r.ReadBool()
+		m.name = r.ReadAsciiString()
+	}
+}
+
+// packet-audit:fname CWvsContext::OnFamilyJoinAccepted
+type JoinAccepted struct {
+	seniorName string
+}
+
+func NewFamilyJoinAccepted(seniorName string) JoinAccepted {
+	return JoinAccepted{seniorName: seniorName}
+}
+
+func (m JoinAccepted) SeniorName() string { return m.seniorName }
+func (m JoinAccepted) Operation() string  { return FamilyJoinAcceptedWriter }
+
+func (m JoinAccepted) String() string {
+	return fmt.Sprintf("senior [%s]", m.seniorName)
+}
+
+func (m JoinAccepted) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.seniorName)
+		w.WriteInt(0)
+		return w.Bytes()
+	}
+}
+
+func (m *JoinAccepted) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.seniorName = r.ReadAsciiString()
+		_ = r.ReadUint32()
+	}
+}
@@ -0,0 +1,49 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyJoinRequest(t *testing.T) {
+	input := NewFamilyJoinRequest(1001, "Senior")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := JoinRequest{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.InviterId() != 1001 || output.InviterName() != "Senior" {
+				t.Errorf("got %v/%v", output.InviterId(), output.InviterName())
+			}
+		})
+	}
+}
+
+func TestFamilyJoinRequestResult(t *testing.T) {
+	input := NewFamilyJoinRequestResult(true, "Junior")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := JoinRequestResult{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if !output.Accepted() || output.Name() != "Junior" {
+				t.Errorf("got %v/%v", output.Accepted(), output.Name())
+			}
+		})
+	}
+}
+
+func TestFamilyJoinAccepted(t *testing.T) {
+	input := NewFamilyJoinAccepted("Senior")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := JoinAccepted{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.SeniorName() != "Senior" {
+				t.Errorf("seniorName: got %v", output.SeniorName())
+			}
+		})
+	}
+}
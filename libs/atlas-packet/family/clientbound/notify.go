@@ -0,0 +1,119 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyFamousPointIncResultWriter = "FamilyFamousPointIncResult"
+	FamilyNotifyLoginOrLogoutWriter  = "FamilyNotifyLoginOrLogout"
+	FamilySummonRequestWriter        = "FamilySummonRequest"
+)
+
+// packet-audit:fname CWvsContext::OnFamilyFamousPointIncResult
+type FamousPointIncResult struct {
+	amount   uint32
+	fromName string
+}
+
+func NewFamilyFamousPointIncResult(amount uint32, fromName string) FamousPointIncResult {
+	return FamousPointIncResult{amount: amount, fromName: fromName}
+}
+
+func (m FamousPointIncResult) Amount() uint32    { return m.amount }
+func (m FamousPointIncResult) FromName() string  { return m.fromName }
+func (m FamousPointIncResult) Operation() string { return FamilyFamousPointIncResultWriter }
+
+func (m FamousPointIncResult) String() string {
+	return fmt.Sprintf("amount [%d], from [%s]", m.amount, m.fromName)
+}
+
+func (m FamousPointIncResult) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.amount)
+		w.WriteAsciiString(m.fromName)
+		return w.Bytes()
+	}
+}
+
+func (m *FamousPointIncResult) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.amount = r.ReadUint32()
+		m.fromName = r.ReadAsciiString()
+	}
+}
+
+// packet-audit:fname CWvsContext::OnFamilyNotifyLoginOrLogout
+type NotifyLoginOrLogout struct {
+	loggedIn bool
+	name     string
+}
+
+func NewFamilyNotifyLoginOrLogout(loggedIn bool, name string) NotifyLoginOrLogout {
+	return NotifyLoginOrLogout{loggedIn: loggedIn, name: name}
+}
+
+func (m NotifyLoginOrLogout) LoggedIn() bool    { return m.loggedIn }
+func (m NotifyLoginOrLogout) Name() string      { return m.name }
+func (m NotifyLoginOrLogout) Operation() string { return FamilyNotifyLoginOrLogoutWriter }
+
+func (m NotifyLoginOrLogout) String() string {
+	return fmt.Sprintf("logged in [%t], name [%s]", m.loggedIn, m.name)
+}
+
+func (m NotifyLoginOrLogout) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteBool(m.loggedIn)
+		w.WriteAsciiString(m.name)
+		return w.Bytes()
+	}
+}
+
+func (m *NotifyLoginOrLogout) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.loggedIn = r.ReadBool()
+		m.name = r.ReadAsciiString()
+	}
+}
+
+// packet-audit:fname CWvsContext::OnFamilySummonRequest
+type SummonRequest struct {
+	summonerName string
+	familyName   string
+}
+
+func NewFamilySummonRequest(summonerName string, familyName string) SummonRequest {
+	return SummonRequest{summonerName: summonerName, familyName: familyName}
+}
+
+func (m SummonRequest) SummonerName() string { return m.summonerName }
+func (m SummonRequest) FamilyName() string   { return m.familyName }
+func (m SummonRequest) Operation() string    { return FamilySummonRequestWriter }
+
+func (m SummonRequest) String() string {
+	return fmt.Sprintf("summoner [%s], family [%s]", m.summonerName, m.familyName)
+}
+
+func (m SummonRequest) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.summonerName)
+		w.WriteAsciiString(m.familyName)
+		return w.Bytes()
+	}
+}
+
+func (m *SummonRequest) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.summonerName = r.ReadAsciiString()
+		m.familyName = r.ReadAsciiString()
+	}
+}
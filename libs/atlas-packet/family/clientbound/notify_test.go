@@ -0,0 +1,49 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyFamousPointIncResult(t *testing.T) {
+	input := NewFamilyFamousPointIncResult(12, "Junior")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := FamousPointIncResult{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Amount() != 12 || output.FromName() != "Junior" {
+				t.Errorf("got %v/%v", output.Amount(), output.FromName())
+			}
+		})
+	}
+}
+
+func TestFamilyNotifyLoginOrLogout(t *testing.T) {
+	input := NewFamilyNotifyLoginOrLogout(true, "Junior")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := NotifyLoginOrLogout{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if !output.LoggedIn() || output.Name() != "Junior" {
+				t.Errorf("got %v/%v", output.LoggedIn(), output.Name())
+			}
+		})
+	}
+}
+
+func TestFamilySummonRequest(t *testing.T) {
+	input := NewFamilySummonRequest("Senior", "Maple")
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := SummonRequest{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.SummonerName() != "Senior" || output.FamilyName() != "Maple" {
+				t.Errorf("got %v/%v", output.SummonerName(), output.FamilyName())
+			}
+		})
+	}
+}
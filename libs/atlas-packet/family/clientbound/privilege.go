@@ -0,0 +1,126 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyPrivilegeListWriter = "FamilyPrivilegeList"
+	FamilySetPrivilegeWriter  = "FamilySetPrivilege"
+)
+
+type PrivilegeEntry struct {
+	Kind        byte
+	RepCost     uint32
+	UsageLimit  uint32
+	Name        string
+	Description string
+}
+
+// packet-audit:fname CWvsContext::OnFamilyPrivilegeList
+type PrivilegeList struct {
+	privileges []PrivilegeEntry
+}
+
+func NewFamilyPrivilegeList(privileges []PrivilegeEntry) PrivilegeList {
+	return PrivilegeList{privileges: privileges}
+}
+
+func (m PrivilegeList) Privileges() []PrivilegeEntry { return m.privileges }
+func (m PrivilegeList) Operation() string            { return FamilyPrivilegeListWriter }
+
+func (m PrivilegeList) String() string {
+	return fmt.Sprintf("[%d] privileges", len(m.privileges))
+}
+
+func (m PrivilegeList) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(uint32(len(m.privileges)))
+		for _, p := range m.privileges {
+			w.WriteByte(p.Kind)
+			w.WriteInt(p.RepCost)
+			w.WriteInt(p.UsageLimit)
+			w.WriteAsciiString(p.Name)
+			w.WriteAsciiString(p.Description)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *PrivilegeList) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.privileges = make([]PrivilegeEntry, r.ReadUint32())
+		for i := range m.privileges {
+			m.privileges[i].Kind = r.ReadByte()
+			m.privileges[i].RepCost = r.ReadUint32()
+			m.privileges[i].UsageLimit = r.ReadUint32()
+			m.privileges[i].Name = r.ReadAsciiString()
+			m.privileges[i].Description = r.ReadAsciiString()
+		}
+	}
+}
+
+// packet-audit:fname CWvsContext::OnFamilySetPrivilege
+// Privilege types 2 through 4 carry the rate buff the client displays; other
+// types only toggle the privilege icon.
+type SetPrivilege struct {
+	privilegeType byte
+	buffId        uint32
+	expRate       uint32
+	dropRate      uint32
+	duration      uint32
+}
+
+func NewFamilySetPrivilege(privilegeType byte, buffId uint32, expRate uint32, dropRate uint32, duration uint32) SetPrivilege {
+	return SetPrivilege{privilegeType: privilegeType, buffId: buffId, expRate: expRate, dropRate: dropRate, duration: duration}
+}
+
+func (m SetPrivilege) PrivilegeType() byte { return m.privilegeType }
+func (m SetPrivilege) BuffId() uint32      { return m.buffId }
+func (m SetPrivilege) ExpRate() uint32     { return m.expRate }
+func (m SetPrivilege) DropRate() uint32    { return m.dropRate }
+func (m SetPrivilege) Duration() uint32    { return m.duration }
+func (m SetPrivilege) Operation() string   { return FamilySetPrivilegeWriter }
+
+func (m SetPrivilege) String() string {
+	return fmt.Sprintf("type [%d], exp [%d], drop [%d], duration [%d]", m.privilegeType, m.expRate, m.dropRate, m.duration)
+}
+
+func (m SetPrivilege) hasBuff() bool {
+	return m.privilegeType >= 2 && m.privilegeType <= 4
+}
+
+func (m SetPrivilege) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteByte(m.privilegeType)
+		if m.hasBuff() {
+			w.WriteInt(m.buffId)
+			w.WriteInt(m.expRate)
+			w.WriteInt(m.dropRate)
+			w.WriteByte(0)
+			w.WriteInt(m.duration)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *SetPrivilege) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.privilegeType = r.ReadByte()
+		if m.hasBuff() {
+			m.buffId = r.ReadUint32()
+			m.expRate = r.ReadUint32()
+			m.dropRate = r.ReadUint32()
+			_ = r.ReadByte()
+			m.duration = r.ReadUint32()
+		}
+	}
+}
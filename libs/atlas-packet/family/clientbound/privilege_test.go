@@ -0,0 +1,55 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyPrivilegeList(t *testing.T) {
+	input := NewFamilyPrivilegeList([]PrivilegeEntry{
+		{Kind: 1, RepCost: 300, UsageLimit: 1, Name: "Family Reunion", Description: "Teleport to a family member."},
+		{Kind: 2, RepCost: 700, UsageLimit: 1, Name: "My Drop Rate 1.5x (15 min)", Description: "Drop rate increased."},
+	})
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := PrivilegeList{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if len(output.Privileges()) != 2 {
+				t.Fatalf("privileges: got %d, want 2", len(output.Privileges()))
+			}
+			if output.Privileges()[1] != input.Privileges()[1] {
+				t.Errorf("privilege: got %+v, want %+v", output.Privileges()[1], input.Privileges()[1])
+			}
+		})
+	}
+}
+
+func TestFamilySetPrivilegeBuff(t *testing.T) {
+	input := NewFamilySetPrivilege(3, 3, 150, 100, 900)
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := SetPrivilege{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.ExpRate() != 150 || output.Duration() != 900 {
+				t.Errorf("got exp %v, duration %v", output.ExpRate(), output.Duration())
+			}
+		})
+	}
+}
+
+func TestFamilySetPrivilegeNoBuff(t *testing.T) {
+	input := NewFamilySetPrivilege(0, 0, 0, 0, 0)
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := SetPrivilege{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.PrivilegeType() != 0 {
+				t.Errorf("type: got %v, want 0", output.PrivilegeType())
+			}
+		})
+	}
+}
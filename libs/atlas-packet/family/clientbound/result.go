@@ -0,0 +1,47 @@
+package clientbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const FamilyResultWriter = "FamilyResult"
+
+// packet-audit:fname CWvsContext::OnFamilyResult
+type Result struct {
+	mode  uint32
+	mesos uint32
+}
+
+func NewFamilyResult(mode uint32, mesos uint32) Result {
+	return Result{mode: mode, mesos: mesos}
+}
+
+func (m Result) Mode() uint32      { return m.mode }
+func (m Result) Mesos() uint32     { return m.mesos }
+func (m Result) Operation() string { return FamilyResultWriter }
+
+func (m Result) String() string {
+	return fmt.Sprintf("mode [%d], mesos [%d]", m.mode, m.mesos)
+}
+
+func (m Result) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.mode)
+		w.WriteInt(m.mesos)
+		return w.Bytes()
+	}
+}
+
+func (m *Result) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.mode = r.ReadUint32()
+		m.mesos = r.ReadUint32()
+	}
+}
@@ -0,0 +1,21 @@
+package clientbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestFamilyResult(t *testing.T) {
+	input := NewFamilyResult(72, 0)
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			output := Result{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Mode() != 72 {
+				t.Errorf("mode: got %v, want 72", output.Mode())
+			}
+		})
+	}
+}
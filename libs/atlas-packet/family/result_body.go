@@ -0,0 +1,33 @@
+package family
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	atlas_packet "github.com/Chronicle20/atlas/libs/atlas-packet"
+	"github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+)
+
+// Family result codes resolved through the writer's "operations" option.
+const (
+	ResultCharacterNotFound = "CHARACTER_NOT_FOUND"
+	ResultNotSameMap        = "NOT_SAME_MAP"
+	ResultAlreadyHasSenior  = "ALREADY_HAS_SENIOR"
+	ResultJuniorsFull       = "JUNIORS_FULL"
+	ResultLevelGap          = "LEVEL_GAP"
+	ResultTooLowLevel       = "TOO_LOW_LEVEL"
+	ResultInsufficientRep   = "INSUFFICIENT_REP"
+	ResultUsageLimitReached = "USAGE_LIMIT_REACHED"
+	ResultTargetNotInFamily = "TARGET_NOT_IN_FAMILY"
+	ResultNotInFamily       = "NOT_IN_FAMILY"
+)
+
+func FamilyResultBody(code string) func(logrus.FieldLogger, context.Context) func(map[string]interface{}) []byte {
+	return func(l logrus.FieldLogger, ctx context.Context) func(options map[string]interface{}) []byte {
+		return func(options map[string]interface{}) []byte {
+			mode := atlas_packet.ResolveCode(l, options, "operations", code)
+			return clientbound.NewFamilyResult(uint32(mode), 0).Encode(l, ctx)(options)
+		}
+	}
+}
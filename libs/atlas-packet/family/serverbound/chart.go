@@ -0,0 +1,71 @@
+package serverbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyChartRequestHandle = "FamilyChartRequestHandle"
+	FamilyInfoRequestHandle  = "FamilyInfoRequestHandle"
+)
+
+// ChartRequest - CWvsContext::SendFamilyChartRequest
+type ChartRequest struct {
+	name string
+}
+
+func (m ChartRequest) Name() string {
+	return m.name
+}
+
+func (m ChartRequest) Operation() string {
+	return FamilyChartRequestHandle
+}
+
+func (m ChartRequest) String() string {
+	return fmt.Sprintf("name [%s]", m.name)
+}
+
+func (m ChartRequest) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.name)
+		return w.Bytes()
+	}
+}
+
+func (m *ChartRequest) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.name = r.ReadAsciiString()
+	}
+}
+
+// InfoRequest - CWvsContext::SendFamilyInfoRequest
+type InfoRequest struct {
+}
+
+func (m InfoRequest) Operation() string {
+	return FamilyInfoRequestHandle
+}
+
+func (m InfoRequest) String() string {
+	return "family info request"
+}
+
+func (m InfoRequest) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		return w.Bytes()
+	}
+}
+
+func (m *InfoRequest) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+	}
+}
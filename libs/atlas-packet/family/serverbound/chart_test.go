@@ -0,0 +1,32 @@
+package serverbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestChartRequestRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := ChartRequest{name: "Junior"}
+			output := ChartRequest{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Name() != input.Name() {
+				t.Errorf("name: got %v, want %v", output.Name(), input.Name())
+			}
+		})
+	}
+}
+
+func TestInfoRequestRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := InfoRequest{}
+			output := InfoRequest{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+		})
+	}
+}
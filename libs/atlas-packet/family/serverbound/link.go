@@ -0,0 +1,149 @@
+package serverbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyRegisterJuniorHandle   = "FamilyRegisterJuniorHandle"
+	FamilyUnregisterJuniorHandle = "FamilyUnregisterJuniorHandle"
+	FamilyUnregisterParentHandle = "FamilyUnregisterParentHandle"
+	FamilyInviteResultHandle     = "FamilyInviteResultHandle"
+)
+
+// RegisterJunior - CWvsContext::SendRegisterJunior
+type RegisterJunior struct {
+	name string
+}
+
+func (m RegisterJunior) Name() string {
+	return m.name
+}
+
+func (m RegisterJunior) Operation() string {
+	return FamilyRegisterJuniorHandle
+}
+
+func (m RegisterJunior) String() string {
+	return fmt.Sprintf("name [%s]", m.name)
+}
+
+func (m RegisterJunior) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.name)
+		return w.Bytes()
+	}
+}
+
+func (m *RegisterJunior) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.name = r.ReadAsciiString()
+	}
+}
+
+// UnregisterJunior - CWvsContext::SendUnregisterJunior
+type UnregisterJunior struct {
+	juniorId uint32
+}
+
+func (m UnregisterJunior) JuniorId() uint32 {
+	return m.juniorId
+}
+
+func (m UnregisterJunior) Operation() string {
+	return FamilyUnregisterJuniorHandle
+}
+
+func (m UnregisterJunior) String() string {
+	return fmt.Sprintf("juniorId [%d]", m.juniorId)
+}
+
+func (m UnregisterJunior) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.juniorId)
+		return w.Bytes()
+	}
+}
+
+func (m *UnregisterJunior) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.juniorId = r.ReadUint32()
+	}
+}
+
+// UnregisterParent - CWvsContext::SendUnregisterParent
+type UnregisterParent struct {
+}
+
+func (m UnregisterParent) Operation() string {
+	return FamilyUnregisterParentHandle
+}
+
+func (m UnregisterParent) String() string {
+	return "unregister parent"
+}
+
+func (m UnregisterParent) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		return w.Bytes()
+	}
+}
+
+func (m *UnregisterParent) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+	}
+}
+
+// InviteResult - CWvsContext::SendFamilyInviteResult
+type InviteResult struct {
+	inviterId   uint32
+	inviterName string
+	accepted    bool
+}
+
+func (m InviteResult) InviterId() uint32 {
+	return m.inviterId
+}
+
+func (m InviteResult) InviterName() string {
+	return m.inviterName
+}
+
+func (m InviteResult) Accepted() bool {
+	return m.accepted
+}
+
+func (m InviteResult) Operation() string {
+	return FamilyInviteResultHandle
+}
+
+func (m InviteResult) String() string {
+	return fmt.Sprintf("inviterId [%d], inviterName [%s], accepted [%t]", m.inviterId, m.inviterName, m.accepted)
+}
+
+func (m InviteResult) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.inviterId)
+		w.WriteAsciiString(m.inviterName)
+		w.WriteBool(m.accepted)
+		return w.Bytes()
+	}
+}
+
+func (m *InviteResult) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.inviterId = r.ReadUint32()
+		m.inviterName = r.ReadAsciiString()
+		m.accepted = r.ReadBool()
+	}
+}
@@ -0,0 +1,66 @@
+package serverbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestRegisterJuniorRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := RegisterJunior{name: "Junior"}
+			output := RegisterJunior{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Name() != input.Name() {
+				t.Errorf("name: got %v, want %v", output.Name(), input.Name())
+			}
+		})
+	}
+}
+
+func TestUnregisterJuniorRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := UnregisterJunior{juniorId: 4002}
+			output := UnregisterJunior{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.JuniorId() != input.JuniorId() {
+				t.Errorf("juniorId: got %v, want %v", output.JuniorId(), input.JuniorId())
+			}
+		})
+	}
+}
+
+func TestUnregisterParentRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := UnregisterParent{}
+			output := UnregisterParent{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+		})
+	}
+}
+
+func TestInviteResultRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := InviteResult{inviterId: 4001, inviterName: "Senior", accepted: true}
+			output := InviteResult{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.InviterId() != input.InviterId() {
+				t.Errorf("inviterId: got %v, want %v", output.InviterId(), input.InviterId())
+			}
+			if output.InviterName() != input.InviterName() {
+				t.Errorf("inviterName: got %v, want %v", output.InviterName(), input.InviterName())
+			}
+			if output.Accepted() != input.Accepted() {
+				t.Errorf("accepted: got %v, want %v", output.Accepted(), input.Accepted())
+			}
+		})
+	}
+}
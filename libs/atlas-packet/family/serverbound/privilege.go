@@ -0,0 +1,133 @@
+package serverbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const (
+	FamilyUsePrivilegeHandle   = "FamilyUsePrivilegeHandle"
+	FamilySetPreceptHandle     = "FamilySetPreceptHandle"
+	FamilySummonResponseHandle = "FamilySummonResponseHandle"
+)
+
+// UsePrivilege - CWvsContext::SendUseFamilyPrivilege
+// Teleport (0) and summon (1) name the family member they act on.
+type UsePrivilege struct {
+	privilegeType uint32
+	targetName    string
+}
+
+func (m UsePrivilege) PrivilegeType() uint32 {
+	return m.privilegeType
+}
+
+func (m UsePrivilege) TargetName() string {
+	return m.targetName
+}
+
+func (m UsePrivilege) Operation() string {
+	return FamilyUsePrivilegeHandle
+}
+
+func (m UsePrivilege) String() string {
+	return fmt.Sprintf("type [%d], targetName [%s]", m.privilegeType, m.targetName)
+}
+
+func (m UsePrivilege) hasTarget() bool {
+	return m.privilegeType <= 1
+}
+
+func (m UsePrivilege) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.privilegeType)
+		if m.hasTarget() {
+			w.WriteAsciiString(m.targetName)
+		}
+		return w.Bytes()
+	}
+}
+
+func (m *UsePrivilege) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.privilegeType = r.ReadUint32()
+		if m.hasTarget() {
+			m.targetName = r.ReadAsciiString()
+		}
+	}
+}
+
+// SetPrecept - CWvsContext::SendSetFamilyPrecept
+type SetPrecept struct {
+	precept string
+}
+
+func (m SetPrecept) Precept() string {
+	return m.precept
+}
+
+func (m SetPrecept) Operation() string {
+	return FamilySetPreceptHandle
+}
+
+func (m SetPrecept) String() string {
+	return fmt.Sprintf("precept [%s]", m.precept)
+}
+
+func (m SetPrecept) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.precept)
+		return w.Bytes()
+	}
+}
+
+func (m *SetPrecept) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.precept = r.ReadAsciiString()
+	}
+}
+
+// SummonResponse - CWvsContext::OnFamilySummonRequest
+type SummonResponse struct {
+	summonerName string
+	accepted     bool
+}
+
+func (m SummonResponse) SummonerName() string {
+	return m.summonerName
+}
+
+func (m SummonResponse) Accepted() bool {
+	return m.accepted
+}
+
+func (m SummonResponse) Operation() string {
+	return FamilySummonResponseHandle
+}
+
+func (m SummonResponse) String() string {
+	return fmt.Sprintf("summonerName [%s], accepted [%t]", m.summonerName, m.accepted)
+}
+
+func (m SummonResponse) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteAsciiString(m.summonerName)
+		w.WriteBool(m.accepted)
+		return w.Bytes()
+	}
+}
+
+func (m *SummonResponse) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.summonerName = r.ReadAsciiString()
+		m.accepted = r.ReadBool()
+	}
+}
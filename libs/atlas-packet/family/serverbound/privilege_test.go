@@ -0,0 +1,61 @@
+package serverbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestUsePrivilegeRoundTrip(t *testing.T) {
+	cases := []UsePrivilege{
+		{privilegeType: 0, targetName: "Junior"},
+		{privilegeType: 1, targetName: "Senior"},
+		{privilegeType: 3},
+	}
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			for _, input := range cases {
+				output := UsePrivilege{}
+				pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+				if output.PrivilegeType() != input.PrivilegeType() {
+					t.Errorf("type: got %v, want %v", output.PrivilegeType(), input.PrivilegeType())
+				}
+				if output.TargetName() != input.TargetName() {
+					t.Errorf("targetName: got %v, want %v", output.TargetName(), input.TargetName())
+				}
+			}
+		})
+	}
+}
+
+func TestSetPreceptRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SetPrecept{precept: "Be kind"}
+			output := SetPrecept{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.Precept() != input.Precept() {
+				t.Errorf("precept: got %v, want %v", output.Precept(), input.Precept())
+			}
+		})
+	}
+}
+
+func TestSummonResponseRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SummonResponse{summonerName: "Senior", accepted: true}
+			output := SummonResponse{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.SummonerName() != input.SummonerName() {
+				t.Errorf("summonerName: got %v, want %v", output.SummonerName(), input.SummonerName())
+			}
+			if output.Accepted() != input.Accepted() {
+				t.Errorf("accepted: got %v, want %v", output.Accepted(), input.Accepted())
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package serverbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/response"
+)
+
+const PartySearchStartHandle = "PartySearchStartHandle"
+
+// SearchStart is sent by a party leader opening the party-search window. The
+// job flags use one bit per job branch (beginner, warrior, magician, bowman,
+// thief, pirate).
+// packet-audit:fname CWvsContext::SendPartyWanted
+type SearchStart struct {
+	minLevel   uint32
+	maxLevel   uint32
+	maxMembers uint32
+	jobFlags   uint32
+}
+
+func (m SearchStart) MinLevel() uint32 {
+	return m.minLevel
+}
+
+func (m SearchStart) MaxLevel() uint32 {
+	return m.maxLevel
+}
+
+func (m SearchStart) MaxMembers() uint32 {
+	return m.maxMembers
+}
+
+func (m SearchStart) JobFlags() uint32 {
+	return m.jobFlags
+}
+
+func (m SearchStart) Operation() string {
+	return PartySearchStartHandle
+}
+
+func (m SearchStart) String() string {
+	return fmt.Sprintf("minLevel [%d] maxLevel [%d] maxMembers [%d] jobFlags [%d]", m.minLevel, m.maxLevel, m.maxMembers, m.jobFlags)
+}
+
+func (m SearchStart) Encode(l logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	w := response.NewWriter(l)
+	return func(options map[string]interface{}) []byte {
+		w.WriteInt(m.minLevel)
+		w.WriteInt(m.maxLevel)
+		w.WriteInt(m.maxMembers)
+		w.WriteInt(m.jobFlags)
+		return w.Bytes()
+	}
+}
+
+func (m *SearchStart) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+		m.minLevel = r.ReadUint32()
+		m.maxLevel = r.ReadUint32()
+		m.maxMembers = r.ReadUint32()
+		m.jobFlags = r.ReadUint32()
+	}
+}
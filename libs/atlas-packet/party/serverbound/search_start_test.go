@@ -0,0 +1,46 @@
+package serverbound
+
+import (
+	"bytes"
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestSearchStartRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SearchStart{minLevel: 30, maxLevel: 70, maxMembers: 6, jobFlags: 0x3E}
+			output := SearchStart{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+			if output.MinLevel() != input.MinLevel() {
+				t.Errorf("minLevel: got %v, want %v", output.MinLevel(), input.MinLevel())
+			}
+			if output.MaxLevel() != input.MaxLevel() {
+				t.Errorf("maxLevel: got %v, want %v", output.MaxLevel(), input.MaxLevel())
+			}
+			if output.MaxMembers() != input.MaxMembers() {
+				t.Errorf("maxMembers: got %v, want %v", output.MaxMembers(), input.MaxMembers())
+			}
+			if output.JobFlags() != input.JobFlags() {
+				t.Errorf("jobFlags: got %v, want %v", output.JobFlags(), input.JobFlags())
+			}
+		})
+	}
+}
+
+// TestSearchStartBytes pins the four little-endian Encode4 fields in wire order.
+func TestSearchStartBytes(t *testing.T) {
+	ctx := pt.CreateContext("GMS", 83, 1)
+	m := SearchStart{minLevel: 30, maxLevel: 70, maxMembers: 6, jobFlags: 0x3E}
+	want := []byte{
+		0x1E, 0x00, 0x00, 0x00, // minLevel
+		0x46, 0x00, 0x00, 0x00, // maxLevel
+		0x06, 0x00, 0x00, 0x00, // maxMembers
+		0x3E, 0x00, 0x00, 0x00, // jobFlags
+	}
+	if got := m.Encode(nil, ctx)(nil); !bytes.Equal(got, want) {
+		t.Errorf("SearchStart mismatch\n got: % x\nwant: % x", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package serverbound
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+const PartySearchStopHandle = "PartySearchStopHandle"
+
+// SearchStop is sent when the leader cancels party search. It has no payload.
+// packet-audit:fname CWvsContext::SendCancelPartyWanted
+type SearchStop struct{}
+
+func (m SearchStop) Operation() string {
+	return PartySearchStopHandle
+}
+
+func (m SearchStop) String() string {
+	return ""
+}
+
+func (m SearchStop) Encode(_ logrus.FieldLogger, _ context.Context) func(options map[string]interface{}) []byte {
+	return func(options map[string]interface{}) []byte {
+		return []byte{}
+	}
+}
+
+func (m *SearchStop) Decode(_ logrus.FieldLogger, _ context.Context) func(r *request.Reader, options map[string]interface{}) {
+	return func(r *request.Reader, options map[string]interface{}) {
+	}
+}
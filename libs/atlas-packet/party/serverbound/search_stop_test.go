@@ -0,0 +1,18 @@
+package serverbound
+
+import (
+	"testing"
+
+	pt "github.com/Chronicle20/atlas/libs/atlas-packet/test"
+)
+
+func TestSearchStopRoundTrip(t *testing.T) {
+	for _, v := range pt.Variants {
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := pt.CreateContext(v.Region, v.MajorVersion, v.MinorVersion)
+			input := SearchStop{}
+			output := SearchStop{}
+			pt.RoundTrip(t, ctx, input.Encode, output.Decode, nil)
+		})
+	}
+}
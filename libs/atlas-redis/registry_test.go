@@ -422,6 +422,47 @@ func TestTenantRegistry_Update(t *testing.T) {
 	}
 }
 
+func TestTenantRegistry_UpsertWithTTL(t *testing.T) {
+	client, mr := setupTestRedis(t)
+	ctx := context.Background()
+	tn := testTenant()
+
+	type Counter struct {
+		Value int `json:"value"`
+	}
+
+	r := NewTenantRegistry[uint32, Counter](client, "test", func(k uint32) string {
+		return strconv.FormatUint(uint64(k), 10)
+	})
+	inc := func(c Counter) Counter {
+		c.Value++
+		return c
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.UpsertWithTTL(ctx, tn, 1, time.Minute, inc); err != nil {
+				t.Errorf("UpsertWithTTL failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := r.Get(ctx, tn, 1)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got.Value != 20 {
+		t.Fatalf("expected 20 increments from a missing key, got %d", got.Value)
+	}
+	if ttl := mr.TTL(r.entityKey(tn, 1)); ttl <= 0 {
+		t.Fatalf("TTL after UpsertWithTTL is %v, want > 0", ttl)
+	}
+}
+
 func TestTenantRegistry_PutWithTTL(t *testing.T) {
 	client, mr := setupTestRedis(t)
 	ctx := context.Background()
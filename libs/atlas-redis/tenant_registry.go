@@ -190,6 +190,53 @@ func (r *TenantRegistry[K, V]) Update(ctx context.Context, t tenant.Model, key K
 	return result, fmt.Errorf("optimistic lock failed after %d retries", updateMaxRetries)
 }
 
+// UpsertWithTTL atomically applies fn to the value under key and stores the
+// result with ttl. A missing key is not an error: fn receives the zero value,
+// so a record that starts from nothing (a counter, a score) needs no separate
+// create. Like Update, fn may run multiple times (optimistic-lock retry) and
+// must be pure.
+func (r *TenantRegistry[K, V]) UpsertWithTTL(ctx context.Context, t tenant.Model, key K, ttl time.Duration, fn func(V) V) (V, error) {
+	rk := r.entityKey(t, key)
+
+	var result V
+	txFn := func(tx *goredis.Tx) error {
+		var current V
+		data, err := tx.Get(ctx, rk).Bytes()
+		if err != nil && !errors.Is(err, goredis.Nil) {
+			return err
+		}
+		if err == nil {
+			if current, err = r.unmarshal(data); err != nil {
+				return err
+			}
+		}
+
+		result = fn(current)
+		newData, err := r.marshal(result)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+			pipe.Set(ctx, rk, newData, ttl)
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < updateMaxRetries; i++ {
+		err := r.client.Watch(ctx, txFn, rk)
+		if err == nil {
+			return result, nil
+		}
+		if errors.Is(err, goredis.TxFailedErr) {
+			continue
+		}
+		return result, err
+	}
+	return result, fmt.Errorf("optimistic lock failed after %d retries", updateMaxRetries)
+}
+
 func (r *TenantRegistry[K, V]) Exists(ctx context.Context, t tenant.Model, key K) (bool, error) {
 	rk := r.entityKey(t, key)
 	n, err := r.client.Exists(ctx, rk).Result()
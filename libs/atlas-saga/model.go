@@ -45,6 +45,12 @@ const (
 	MegaphoneUse          Type = "megaphone_use"
 	MesoSackUse           Type = "meso_sack_use"
 	PetNameTagUse         Type = "pet_name_tag_use"
+	// NoteAttachment moves note attachments in and out of atlas-notes custody:
+	// a send escrows items and mesos (transfer_to_note / accept_to_note) ahead
+	// of its create_note, and a claim or expiry return releases them again
+	// (withdraw_from_note). It is not NoteSend: that flow consumes a Note item
+	// and its reverse-walk knows nothing about escrow rows.
+	NoteAttachment Type = "note_attachment"
 	// RemoteMerchant is the classification-545 cash item flow: open an NPC's
 	// shop from anywhere, then consume the item — never the other way round
 	// (task-221).
@@ -229,6 +235,10 @@ const (
 	RequestGuildCapacityIncrease Action = "request_guild_capacity_increase"
 	CreateInvite                 Action = "create_invite"
 
+	// Alliance actions
+	CreateAlliance                  Action = "create_alliance"
+	RequestAllianceCapacityIncrease Action = "request_alliance_capacity_increase"
+
 	// Character creation actions
 	CreateCharacter       Action = "create_character"
 	AwaitCharacterCreated Action = "await_character_created"
@@ -262,6 +272,16 @@ const (
 	// Note actions
 	CreateNote Action = "create_note"
 
+	// Note attachment custody. transfer_to_note is a COMPOSITE expanded into
+	// release_from_character + accept_to_note, the transfer_to_trade shape;
+	// withdraw_from_note is a COMPOSITE expanded into release_from_note +
+	// accept_to_character (item) or award_mesos (mesos). accept_to_note and
+	// release_from_note are the atomic custody steps dispatched to atlas-notes.
+	TransferToNote   Action = "transfer_to_note"
+	WithdrawFromNote Action = "withdraw_from_note"
+	AcceptToNote     Action = "accept_to_note"
+	ReleaseFromNote  Action = "release_from_note"
+
 	// Item tag / sealing lock / incubator actions
 	SetAssetOwner   Action = "set_asset_owner"
 	ApplyAssetLock  Action = "apply_asset_lock"
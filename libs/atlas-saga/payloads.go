@@ -543,11 +543,12 @@ type SpawnReactorDropsPayload struct {
 
 // ShowStoragePayload represents the payload required to show the storage UI to a character.
 type ShowStoragePayload struct {
-	CharacterId uint32     `json:"characterId"` // CharacterId to show storage to
-	NpcId       uint32     `json:"npcId"`       // NpcId of the storage keeper
-	WorldId     world.Id   `json:"worldId"`     // WorldId associated with the action
-	ChannelId   channel.Id `json:"channelId"`   // ChannelId associated with the action
-	AccountId   uint32     `json:"accountId"`   // AccountId that owns the storage
+	CharacterId uint32     `json:"characterId"`   // CharacterId to show storage to
+	NpcId       uint32     `json:"npcId"`         // NpcId of the storage keeper
+	WorldId     world.Id   `json:"worldId"`       // WorldId associated with the action
+	ChannelId   channel.Id `json:"channelId"`     // ChannelId associated with the action
+	AccountId   uint32     `json:"accountId"`     // AccountId that owns the storage
+	Tab         byte       `json:"tab,omitempty"` // Storage tab to open (0 = main tab, 255 = account-wide shared tab)
 }
 
 // OpenNpcShopPayload represents the payload required to open an NPC's shop for
@@ -1008,6 +1009,21 @@ type RequestGuildCapacityIncreasePayload struct {
 	ChannelId   channel.Id `json:"channelId"`   // ChannelId associated with the action
 }
 
+// CreateAlliancePayload represents the payload required to found an alliance
+// led by the character's guild.
+type CreateAlliancePayload struct {
+	CharacterId uint32   `json:"characterId"` // CharacterId of the founding guild leader
+	WorldId     world.Id `json:"worldId"`     // WorldId associated with the action
+	Name        string   `json:"name"`        // Name of the new alliance
+}
+
+// RequestAllianceCapacityIncreasePayload represents the payload required to
+// buy an extra guild slot for the character's alliance.
+type RequestAllianceCapacityIncreasePayload struct {
+	CharacterId uint32   `json:"characterId"` // CharacterId of the alliance leader
+	WorldId     world.Id `json:"worldId"`     // WorldId associated with the action
+}
+
 // CreateInvitePayload represents the payload required to create an invitation.
 type CreateInvitePayload struct {
 	InviteType   string   `json:"inviteType"`   // Type of invitation (e.g., "GUILD", "PARTY", "BUDDY")
@@ -1254,6 +1270,75 @@ type CreateNotePayload struct {
 	Flag       byte   `json:"flag"`       // Memo flag/type; 0 = plain note (player sends). Non-zero selects reward/gift render templates client-side.
 }
 
+// TransferToNotePayload is the composite atlas-notes submits for each item a
+// sender attaches to a note. Expansion (expandTransferToNote) turns it into
+// release_from_character + accept_to_note, reading the snapshot from the
+// sender's compartment at expansion time exactly as expandTransferToTrade does.
+//
+// AttachmentId is minted by atlas-notes so the escrow row the accept creates
+// can be bound to the note the saga's trailing create_note produces.
+type TransferToNotePayload struct {
+	TransactionId       uuid.UUID `json:"transactionId"`
+	AttachmentId        uuid.UUID `json:"attachmentId"`
+	CharacterId         uint32    `json:"characterId"` // Sender
+	RecipientId         uint32    `json:"recipientId"`
+	WorldId             world.Id  `json:"worldId"`
+	SourceInventoryType byte      `json:"sourceInventoryType"`
+	AssetId             uint32    `json:"assetId"`
+	Quantity            uint32    `json:"quantity"`
+}
+
+// AcceptToNotePayload (atomic, dispatched to the atlas-notes custody consumer)
+// creates one attachment escrow row. An item attachment carries the snapshot
+// taken at expansion; a meso attachment carries Mesos and a zero snapshot, and
+// is preceded in the saga by the award_mesos that debits the sender.
+type AcceptToNotePayload struct {
+	TransactionId       uuid.UUID     `json:"transactionId"`
+	AttachmentId        uuid.UUID     `json:"attachmentId"`
+	SenderId            uint32        `json:"senderId"`
+	RecipientId         uint32        `json:"recipientId"`
+	WorldId             world.Id      `json:"worldId"`
+	SourceInventoryType byte          `json:"sourceInventoryType"`
+	AssetId             uint32        `json:"assetId"`
+	Snapshot            AssetSnapshot `json:"snapshot"`
+	Mesos               uint32        `json:"mesos"`
+}
+
+// ReleaseFromNotePayload (atomic, dispatched to the atlas-notes custody
+// consumer). Carries only the row id: the row holds what it escrows, and the
+// release is the compare-and-set that lets exactly one claim or return win.
+type ReleaseFromNotePayload struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	AttachmentId  uuid.UUID `json:"attachmentId"`
+}
+
+// WithdrawFromNotePayload is the composite atlas-notes submits to deliver one
+// escrowed attachment — to the recipient on a claim, or back to the sender on
+// expiry. Expansion (expandWithdrawFromNote) turns it into release_from_note
+// followed by accept_to_character for an item or award_mesos for mesos. The
+// snapshot travels on the payload because the escrow row is the only place the
+// item still exists.
+//
+// Minted marks an attachment no character ever held (GM reward mail). Its
+// snapshot is only a template and quantity, so it is delivered through
+// award_asset and atlas-inventory generates the item as it would any reward.
+//
+// Currency is a minted NX reward credited to AccountId's cash-shop wallet
+// through award_currency. Only reward mail carries it; players cannot mail NX.
+type WithdrawFromNotePayload struct {
+	TransactionId uuid.UUID     `json:"transactionId"`
+	AttachmentId  uuid.UUID     `json:"attachmentId"`
+	CharacterId   uint32        `json:"characterId"` // Character receiving the attachment
+	WorldId       world.Id      `json:"worldId"`
+	ChannelId     channel.Id    `json:"channelId"`
+	Snapshot      AssetSnapshot `json:"snapshot"`
+	Mesos         uint32        `json:"mesos"`
+	Minted        bool          `json:"minted"`
+	AccountId     uint32        `json:"accountId,omitempty"`
+	Currency      uint32        `json:"currency,omitempty"`
+	CurrencyType  uint32        `json:"currencyType,omitempty"`
+}
+
 // AssetSnapshot captures one inventory asset at decode time (item megaphone,
 // and every trade-escrow payload). Snapshot DTO shared by saga payloads AND the
 // kafka message structs of channel/world/orchestrator (single source of truth;
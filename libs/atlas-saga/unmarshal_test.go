@@ -1381,3 +1381,90 @@ func TestUnmarshalStartNpcConversationPayload(t *testing.T) {
 		t.Errorf("payload round-trip: %+v", p)
 	}
 }
+
+// TestUnmarshalNoteAttachmentSteps pins the note attachment composites and the
+// atomic custody steps atlas-notes submits.
+func TestUnmarshalNoteAttachmentSteps(t *testing.T) {
+	cases := []struct {
+		name   string
+		raw    string
+		action Action
+		check  func(t *testing.T, p any)
+	}{
+		{
+			name: "transfer_to_note",
+			raw: `{"stepId":"transfer_to_note-1","status":"pending","action":"transfer_to_note",
+				"payload":{"transactionId":"11111111-1111-1111-1111-111111111111","attachmentId":"22222222-2222-2222-2222-222222222222",
+				"characterId":100,"recipientId":200,"worldId":1,"sourceInventoryType":2,"assetId":55,"quantity":3}}`,
+			action: TransferToNote,
+			check: func(t *testing.T, p any) {
+				v, ok := p.(TransferToNotePayload)
+				if !ok {
+					t.Fatalf("expected TransferToNotePayload, got %T", p)
+				}
+				if v.RecipientId != 200 || v.AssetId != 55 || v.Quantity != 3 {
+					t.Errorf("payload = %+v", v)
+				}
+			},
+		},
+		{
+			name: "withdraw_from_note",
+			raw: `{"stepId":"withdraw_from_note-1","status":"pending","action":"withdraw_from_note",
+				"payload":{"transactionId":"11111111-1111-1111-1111-111111111111","attachmentId":"22222222-2222-2222-2222-222222222222",
+				"characterId":200,"worldId":1,"channelId":2,"snapshot":{"templateId":2000000,"quantity":5},"mesos":0}}`,
+			action: WithdrawFromNote,
+			check: func(t *testing.T, p any) {
+				v, ok := p.(WithdrawFromNotePayload)
+				if !ok {
+					t.Fatalf("expected WithdrawFromNotePayload, got %T", p)
+				}
+				if v.CharacterId != 200 || v.Snapshot.TemplateId != 2000000 || v.Snapshot.Quantity != 5 {
+					t.Errorf("payload = %+v", v)
+				}
+			},
+		},
+		{
+			name: "accept_to_note",
+			raw: `{"stepId":"accept_to_note-1","status":"pending","action":"accept_to_note",
+				"payload":{"transactionId":"11111111-1111-1111-1111-111111111111","attachmentId":"22222222-2222-2222-2222-222222222222",
+				"senderId":100,"recipientId":200,"worldId":1,"mesos":5000}}`,
+			action: AcceptToNote,
+			check: func(t *testing.T, p any) {
+				v, ok := p.(AcceptToNotePayload)
+				if !ok {
+					t.Fatalf("expected AcceptToNotePayload, got %T", p)
+				}
+				if v.Mesos != 5000 || v.SenderId != 100 {
+					t.Errorf("payload = %+v", v)
+				}
+			},
+		},
+		{
+			name: "release_from_note",
+			raw: `{"stepId":"release_from_note-1","status":"pending","action":"release_from_note",
+				"payload":{"transactionId":"11111111-1111-1111-1111-111111111111","attachmentId":"22222222-2222-2222-2222-222222222222"}}`,
+			action: ReleaseFromNote,
+			check: func(t *testing.T, p any) {
+				v, ok := p.(ReleaseFromNotePayload)
+				if !ok {
+					t.Fatalf("expected ReleaseFromNotePayload, got %T", p)
+				}
+				if v.AttachmentId.String() != "22222222-2222-2222-2222-222222222222" {
+					t.Errorf("attachmentId: got %s", v.AttachmentId)
+				}
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var step Step[any]
+			if err := json.Unmarshal([]byte(tc.raw), &step); err != nil {
+				t.Fatalf("unmarshal failed: %v", err)
+			}
+			if step.Action != tc.action {
+				t.Fatalf("expected action %s, got %q", tc.action, step.Action)
+			}
+			tc.check(t, step.Payload)
+		})
+	}
+}
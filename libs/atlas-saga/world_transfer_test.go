@@ -117,6 +117,7 @@ func TestWorldTransferActionConstantsAreUnique(t *testing.T) {
 		MtsSettlePurchase, MtsMoveListingToHolding, MtsBidEscrow,
 		RequestGuildName, RequestGuildEmblem, RequestGuildDisband,
 		RequestGuildCapacityIncrease, CreateInvite,
+		CreateAlliance, RequestAllianceCapacityIncrease,
 		CreateCharacter, AwaitCharacterCreated, AwaitInventoryCreated,
 		StartInstanceTransport,
 		SelectGachaponReward, EmitGachaponWin,
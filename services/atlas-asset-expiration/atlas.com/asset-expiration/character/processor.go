@@ -62,6 +62,13 @@ func checkInventory(l logrus.FieldLogger, pp producer.Provider, ctx context.Cont
 	}
 
 	for _, comp := range inv.Compartments {
+		for _, se := range comp.SlotExpansions {
+			if expiration.IsExpired(se.Expiration, now) {
+				l.Infof("Equip slot expansion [%d] is expired for character [%d].", se.Slot, characterId)
+				emitCompartmentExpireSlotExpansionCommand(l, pp, characterId, comp.Type, se.Slot)
+			}
+		}
+
 		assets, err := inventory.NewProcessor(l, ctx).GetAssets(characterId, comp.Id)
 		if err != nil {
 			l.WithError(err).Warnf("Failed to get assets for compartment [%s].", comp.Id)
@@ -193,3 +200,21 @@ func emitCompartmentExpireCommand(l logrus.FieldLogger, pp producer.Provider, ch
 		l.Infof("Emitted compartment expire command for asset [%d] (template [%d]).", assetId, templateId)
 	}
 }
+
+func emitCompartmentExpireSlotExpansionCommand(l logrus.FieldLogger, pp producer.Provider, characterId uint32, inventoryType byte, slot int16) {
+	cmd := asset.CompartmentExpireSlotExpansionCommand{
+		TransactionId: uuid.New(),
+		CharacterId:   characterId,
+		InventoryType: inventoryType,
+		Type:          asset.CommandTypeExpireSlotExpansion,
+		Body: asset.CompartmentExpireSlotExpansionBody{
+			Slot: slot,
+		},
+	}
+	err := pp(asset.EnvCommandTopicCompartment)(producer.SingleMessageProvider(producer.CreateKey(int(characterId)), cmd))
+	if err != nil {
+		l.WithError(err).Errorf("Failed to emit slot expansion expire command for character [%d] slot [%d].", characterId, slot)
+	} else {
+		l.Infof("Emitted slot expansion expire command for character [%d] slot [%d].", characterId, slot)
+	}
+}
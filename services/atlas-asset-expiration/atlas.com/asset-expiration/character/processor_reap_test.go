@@ -195,3 +195,43 @@ func TestCheckAndExpireEmitsNothingForExpiredPet(t *testing.T) {
 		t.Fatalf("expected no expire commands for an expired pet, got %d on topics %v", r.count(), r.topics)
 	}
 }
+
+// TestCheckAndExpireEmitsForExpiredSlotExpansion proves an expired equip slot
+// expansion is reaped while a permanent (zero expiration) one is left alone.
+// The compartment holds no assets, so the expansion is the only emit.
+func TestCheckAndExpireEmitsForExpiredSlotExpansion(t *testing.T) {
+	inventoryDoc := mustMarshal(t, inventory.RestModel{
+		Id:          "1",
+		CharacterId: 42,
+		Compartments: []inventory.CompartmentRestModel{
+			{
+				Id:       compartmentId,
+				Type:     inventory.CompartmentTypeEquip,
+				Capacity: 24,
+				SlotExpansions: []inventory.SlotExpansionRestModel{
+					{Slot: -59, Expiration: past},
+					{Slot: -60},
+				},
+			},
+		},
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if strings.HasSuffix(r.URL.Path, "/inventory") {
+			_, _ = w.Write(inventoryDoc)
+			return
+		}
+		_, _ = w.Write(withPageMeta(t, []byte(`{"data":[]}`), 0))
+	}))
+	t.Cleanup(srv.Close)
+	for _, env := range []string{"INVENTORY_SERVICE_URL", "STORAGE_SERVICE_URL", "CASHSHOP_SERVICE_URL", "DATA_SERVICE_URL"} {
+		t.Setenv(env, srv.URL+"/")
+	}
+
+	r := &recorder{}
+	l, _ := test.NewNullLogger()
+	character.NewProcessor(l, context.Background()).CheckAndExpire(r.provider())(42, 7, 0)
+	if r.count() != 1 || r.topics[0] != "COMMAND_TOPIC_COMPARTMENT" {
+		t.Fatalf("expected one compartment command for the expired expansion, got %d on topics %v", r.count(), r.topics)
+	}
+}
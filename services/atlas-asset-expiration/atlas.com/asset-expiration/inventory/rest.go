@@ -94,10 +94,18 @@ func (r *RestModel) SetReferencedStructs(references map[string]map[string]jsonap
 }
 
 type CompartmentRestModel struct {
-	Id       string           `json:"-"`
-	Type     uint8            `json:"type"`
-	Capacity uint32           `json:"capacity"`
-	Assets   []AssetRestModel `json:"-"`
+	Id             string                   `json:"-"`
+	Type           uint8                    `json:"type"`
+	Capacity       uint32                   `json:"capacity"`
+	SlotExpansions []SlotExpansionRestModel `json:"slotExpansions,omitempty"`
+	Assets         []AssetRestModel         `json:"-"`
+}
+
+// SlotExpansionRestModel is an unlocked equip slot expansion. A zero
+// Expiration is permanent.
+type SlotExpansionRestModel struct {
+	Slot       int16     `json:"slot"`
+	Expiration time.Time `json:"expiration"`
 }
 
 func (r CompartmentRestModel) GetName() string {
@@ -15,7 +15,8 @@ const (
 
 // Command type constant
 const (
-	CommandTypeExpire = "EXPIRE"
+	CommandTypeExpire              = "EXPIRE"
+	CommandTypeExpireSlotExpansion = "EXPIRE_SLOT_EXPANSION"
 )
 
 // StorageExpireCommand is sent to atlas-storage to expire an item
@@ -74,3 +75,18 @@ type CompartmentExpireBody struct {
 	ReplaceItemId  uint32 `json:"replaceItemId"`
 	ReplaceMessage string `json:"replaceMessage"`
 }
+
+// CompartmentExpireSlotExpansionCommand is sent to atlas-inventory to lock an
+// expired equip slot expansion
+type CompartmentExpireSlotExpansionCommand struct {
+	TransactionId uuid.UUID                          `json:"transactionId"`
+	CharacterId   uint32                             `json:"characterId"`
+	InventoryType byte                               `json:"inventoryType"`
+	Type          string                             `json:"type"`
+	Body          CompartmentExpireSlotExpansionBody `json:"body"`
+}
+
+// CompartmentExpireSlotExpansionBody contains the expansion slot to lock
+type CompartmentExpireSlotExpansionBody struct {
+	Slot int16 `json:"slot"`
+}
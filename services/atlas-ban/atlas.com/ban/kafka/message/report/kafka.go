@@ -19,8 +19,9 @@ const (
 	ErrorCodeInternal      = "INTERNAL"
 	ErrorCodeQuotaExceeded = "QUOTA_EXCEEDED"
 
-	KindSue   = "sue"
-	KindClaim = "claim"
+	KindSue       = "sue"
+	KindClaim     = "claim"
+	KindDetection = "detection"
 )
 
 type Command[E any] struct {
@@ -40,10 +40,19 @@ type Kind string
 const (
 	KindSue   Kind = "sue"
 	KindClaim Kind = "claim"
+	// KindDetection is a system-filed report: a channel-side cheat detector
+	// (e.g. damage-range validation) accuses a character on its own
+	// evidence. There is no player reporter, so no quota, no transcript and
+	// no status event back to a client.
+	KindDetection Kind = "detection"
 )
 
+// DetectionReporterName is the reporter name stamped on detection reports;
+// their reporter id is always zero.
+const DetectionReporterName = "SYSTEM"
+
 func (k Kind) Valid() bool {
-	return k == KindSue || k == KindClaim
+	return k == KindSue || k == KindClaim || k == KindDetection
 }
 
 type Status string
@@ -76,6 +76,10 @@ func (p *ProcessorImpl) CreateFromCommandAndEmit(c report2.CreateCommandBody) er
 // never gets a result packet.
 func (p *ProcessorImpl) CreateFromCommand(buf *message.Buffer) func(c report2.CreateCommandBody) error {
 	return func(c report2.CreateCommandBody) error {
+		if Kind(c.Kind) == KindDetection {
+			return p.createDetection(c)
+		}
+
 		fail := func(code string) error {
 			return buf.Put(report2.EnvEventTopicStatus, statusEventProvider(uuid.Nil, Kind(c.Kind), c.WorldId, c.ReporterId, report2.EventStatusError, code))
 		}
@@ -181,6 +185,30 @@ func (p *ProcessorImpl) CreateFromCommand(buf *message.Buffer) func(c report2.Cr
 	}
 }
 
+// createDetection persists a system-filed report. The accused is always
+// identified by id (the detector holds the live session), there is no
+// reporter to resolve or charge quota against, and no status event is
+// buffered: nobody is waiting on a result packet. Failures are logged and
+// swallowed for the same reason.
+func (p *ProcessorImpl) createDetection(c report2.CreateCommandBody) error {
+	accused, err := p.charP.GetById(c.AccusedId)
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to resolve accused [%d] for detection report.", c.AccusedId)
+		return nil
+	}
+	description := c.Description
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		description = truncateRunes(description, MaxDescriptionLength)
+	}
+	m, err := create(p.db.WithContext(p.ctx))(p.t.Id(), KindDetection, 0, DetectionReporterName, accused.Id(), accused.Name(), c.ReasonType, description, nil, nil)
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to persist detection report against [%d].", c.AccusedId)
+		return nil
+	}
+	p.l.Infof("Created [%s] report [%s]: accused [%d/%s] reason [%d].", m.Kind(), m.Id(), m.AccusedId(), m.AccusedName(), m.ReasonType())
+	return nil
+}
+
 func (p *ProcessorImpl) UpdateStatus(reportId uuid.UUID, status Status) (Model, error) {
 	if !status.Valid() {
 		return Model{}, ErrInvalidStatus
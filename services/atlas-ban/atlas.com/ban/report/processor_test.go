@@ -389,6 +389,37 @@ func TestCreateFromCommandSueIgnoresClaimQuota(t *testing.T) {
 	}
 }
 
+// TestCreateFromCommandDetectionIsSystemFiled asserts a detector-filed report
+// needs no reporter, is stamped SYSTEM, and buffers no status event — there is
+// no client waiting on a result packet.
+func TestCreateFromCommandDetectionIsSystemFiled(t *testing.T) {
+	db := setupTestDatabase(t)
+	tm := sampleTenant()
+	p := quotaTestProcessor(t, db, tm)
+
+	buf := message.NewBuffer()
+	err := p.CreateFromCommand(buf)(report2.CreateCommandBody{
+		Kind: report2.KindDetection, AccusedId: 2, Description: "damage range",
+	})
+	if err != nil {
+		t.Fatalf("CreateFromCommand: %v", err)
+	}
+	if evs := buf.GetAll()[report2.EnvEventTopicStatus]; len(evs) != 0 {
+		t.Fatalf("expected no status event, got %d", len(evs))
+	}
+	reports, err := p.GetByTenant()
+	if err != nil {
+		t.Fatalf("GetByTenant: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	m := reports[0]
+	if m.Kind() != KindDetection || m.ReporterId() != 0 || m.ReporterName() != DetectionReporterName || m.AccusedName() != "Accused" {
+		t.Errorf("detection report mismatch: %+v", m)
+	}
+}
+
 func TestCreateFromCommandTruncatesOversizedInputs(t *testing.T) {
 	db := setupTestDatabase(t)
 	tm := sampleTenant()
@@ -4,6 +4,7 @@ import (
 	consumer2 "atlas-buddies/kafka/consumer"
 	cashshop2 "atlas-buddies/kafka/message/cashshop"
 	"atlas-buddies/list"
+	"atlas-buddies/presence"
 	"context"
 
 	"github.com/sirupsen/logrus"
@@ -45,6 +46,9 @@ func handleStatusEventCharacterEnter(db *gorm.DB) message.Handler[cashshop2.Stat
 		if e.Type != cashshop2.EventStatusTypeCharacterEnter {
 			return
 		}
+		if err := presence.NewProcessor(l, ctx).EnterCashShopAndEmit(e.Body.CharacterId, e.WorldId); err != nil {
+			l.WithError(err).Errorf("Unable to record cash shop presence for character [%d].", e.Body.CharacterId)
+		}
 		_ = list.NewProcessor(l, ctx, db).UpdateBuddyShopStatusAndEmit(e.Body.CharacterId, e.WorldId, true)
 	}
 }
@@ -54,6 +58,9 @@ func handleStatusEventCharacterExit(db *gorm.DB) message.Handler[cashshop2.Statu
 		if e.Type != cashshop2.EventStatusTypeCharacterExit {
 			return
 		}
+		if err := presence.NewProcessor(l, ctx).ExitCashShopAndEmit(e.Body.CharacterId, e.WorldId); err != nil {
+			l.WithError(err).Errorf("Unable to record cash shop presence for character [%d].", e.Body.CharacterId)
+		}
 		_ = list.NewProcessor(l, ctx, db).UpdateBuddyShopStatusAndEmit(e.Body.CharacterId, e.WorldId, false)
 	}
 }
@@ -4,6 +4,7 @@ import (
 	consumer2 "atlas-buddies/kafka/consumer"
 	"atlas-buddies/kafka/message/character"
 	"atlas-buddies/list"
+	"atlas-buddies/presence"
 	"context"
 
 	"github.com/sirupsen/logrus"
@@ -47,6 +48,9 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventNameChanged(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventMapChanged))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -79,7 +83,11 @@ func handleStatusEventLogin(db *gorm.DB) func(l logrus.FieldLogger, ctx context.
 		if event.Type != character.StatusEventTypeLogin {
 			return
 		}
-		err := list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, int8(event.Body.ChannelId))
+		err := presence.NewProcessor(l, ctx).LoginAndEmit(event.CharacterId, event.WorldId, event.Body.ChannelId, event.Body.MapId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to record login presence for character [%d].", event.CharacterId)
+		}
+		err = list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, int8(event.Body.ChannelId))
 		if err != nil {
 			l.WithError(err).Errorf("Unable to process login for character [%d].", event.CharacterId)
 		}
@@ -91,7 +99,11 @@ func handleStatusEventLogout(db *gorm.DB) func(l logrus.FieldLogger, ctx context
 		if event.Type != character.StatusEventTypeLogout {
 			return
 		}
-		err := list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, -1)
+		err := presence.NewProcessor(l, ctx).LogoutAndEmit(event.CharacterId, event.WorldId, event.Body.ChannelId, event.Body.MapId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to record logout presence for character [%d].", event.CharacterId)
+		}
+		err = list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, -1)
 		if err != nil {
 			l.WithError(err).Errorf("Unable to process logout for character [%d].", event.CharacterId)
 		}
@@ -106,7 +118,11 @@ func handleStatusEventChannelChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx
 		if event.Body.ChannelId == event.Body.OldChannelId {
 			return
 		}
-		err := list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, int8(event.Body.ChannelId))
+		err := presence.NewProcessor(l, ctx).ChangeChannelAndEmit(event.CharacterId, event.WorldId, event.Body.ChannelId, event.Body.MapId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to record channel presence for character [%d].", event.CharacterId)
+		}
+		err = list.NewProcessor(l, ctx, db).UpdateBuddyChannelAndEmit(event.CharacterId, event.WorldId, int8(event.Body.ChannelId))
 		if err != nil {
 			l.WithError(err).Errorf("Unable to process change channel for character [%d].", event.CharacterId)
 		}
@@ -124,3 +140,13 @@ func handleStatusEventNameChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx co
 		}
 	}
 }
+
+func handleStatusEventMapChanged(l logrus.FieldLogger, ctx context.Context, event character.StatusEvent[character.MapChangedStatusEventBody]) {
+	if event.Type != character.StatusEventTypeMapChanged {
+		return
+	}
+	err := presence.NewProcessor(l, ctx).ChangeMapAndEmit(event.CharacterId, event.WorldId, event.Body.ChannelId, event.Body.TargetMapId)
+	if err != nil {
+		l.WithError(err).Errorf("Unable to record map presence for character [%d].", event.CharacterId)
+	}
+}
@@ -44,6 +44,9 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleIncreaseCapacityCommand(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRenameGroupCommand(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -101,6 +104,18 @@ func handleRestoreBuddyCommand(db *gorm.DB) message.Handler[list2.Command[list2.
 	}
 }
 
+func handleRenameGroupCommand(db *gorm.DB) message.Handler[list2.Command[list2.RenameGroupCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c list2.Command[list2.RenameGroupCommandBody]) {
+		if c.Type != list2.CommandTypeRenameGroup {
+			return
+		}
+		err := list.NewProcessor(l, ctx, db).RenameGroupAndEmit(uint32(c.CharacterId), c.WorldId, c.Body.OldGroup, c.Body.NewGroup)
+		if err != nil {
+			l.WithError(err).Errorf("Error attempting to rename buddy group [%s] for character [%d].", c.Body.OldGroup, c.CharacterId)
+		}
+	}
+}
+
 // handleIncreaseCapacityCommand creates a Kafka message handler for INCREASE_CAPACITY commands.
 // This handler processes requests to increase a character's buddy list capacity.
 //
@@ -16,6 +16,7 @@ const (
 	StatusEventTypeLogout         = "LOGOUT"
 	StatusEventTypeChannelChanged = "CHANNEL_CHANGED"
 	StatusEventTypeNameChanged    = "NAME_CHANGED"
+	StatusEventTypeMapChanged     = "MAP_CHANGED"
 )
 
 type StatusEvent[E any] struct {
@@ -51,6 +52,15 @@ type ChannelChangedStatusEventBody struct {
 	Instance     uuid.UUID  `json:"instance"`
 }
 
+type MapChangedStatusEventBody struct {
+	ChannelId      channel.Id `json:"channelId"`
+	OldMapId       _map.Id    `json:"oldMapId"`
+	OldInstance    uuid.UUID  `json:"oldInstance"`
+	TargetMapId    _map.Id    `json:"targetMapId"`
+	TargetInstance uuid.UUID  `json:"targetInstance"`
+	TargetPortalId uint32     `json:"targetPortalId"`
+}
+
 // StatusEventNameChangedBody mirrors the producer's
 // atlas-character/kafka/message/character/kafka.go StatusEventNameChangedBody
 // field-for-field: OldName/NewName, both `json:"oldName"`/`json:"newName"`.
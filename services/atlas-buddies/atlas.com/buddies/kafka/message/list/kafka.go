@@ -26,6 +26,8 @@ const (
 	// offline) to accept anything. Idempotent — an entry that is already
 	// present is left alone.
 	CommandTypeRestore = "RESTORE"
+	// CommandTypeRenameGroup is the command type for renaming a buddy group
+	CommandTypeRenameGroup = "RENAME_GROUP"
 )
 
 type Command[E any] struct {
@@ -59,6 +61,12 @@ type RestoreBuddyCommandBody struct {
 	CharacterId character.Id `json:"characterId"`
 }
 
+// RenameGroupCommandBody moves every buddy filed under OldGroup to NewGroup.
+type RenameGroupCommandBody struct {
+	OldGroup string `json:"oldGroup"`
+	NewGroup string `json:"newGroup"`
+}
+
 // IncreaseCapacityCommandBody represents the body of an increase capacity command.
 // This command is used to increase a character's buddy list capacity.
 type IncreaseCapacityCommandBody struct {
@@ -94,6 +102,10 @@ const (
 	StatusEventErrorCharacterNotFound = "CHARACTER_NOT_FOUND"
 	// StatusEventErrorInvalidCapacity indicates the new capacity is invalid (not greater than current)
 	StatusEventErrorInvalidCapacity = "INVALID_CAPACITY"
+	// StatusEventErrorInvalidGroupName indicates the new group name is empty, too long or unchanged
+	StatusEventErrorInvalidGroupName = "INVALID_GROUP_NAME"
+	// StatusEventErrorGroupNotFound indicates no buddy is filed under the group being renamed
+	StatusEventErrorGroupNotFound = "GROUP_NOT_FOUND"
 	// StatusEventErrorUnknownError indicates an unexpected error occurred
 	StatusEventErrorUnknownError = "UNKNOWN_ERROR"
 )
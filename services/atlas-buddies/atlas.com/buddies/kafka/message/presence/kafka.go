@@ -0,0 +1,37 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/character"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	// EnvStatusEventTopic defines the environment variable for the character presence status event topic
+	EnvStatusEventTopic = "EVENT_TOPIC_CHARACTER_PRESENCE_STATUS"
+	// StatusEventTypeUpdated is emitted whenever a character's state, channel or map changes
+	StatusEventTypeUpdated = "UPDATED"
+)
+
+type StatusEvent[E any] struct {
+	WorldId     world.Id     `json:"worldId"`
+	CharacterId character.Id `json:"characterId"`
+	Type        string       `json:"type"`
+	Body        E            `json:"body"`
+}
+
+// UpdatedStatusEventBody carries the character's presence before and after
+// the transition, so consumers can react to only the edges they care about
+// (for example ONLINE to OFFLINE) without keeping their own copy.
+type UpdatedStatusEventBody struct {
+	State             string     `json:"state"`
+	ChannelId         channel.Id `json:"channelId"`
+	MapId             _map.Id    `json:"mapId"`
+	PreviousState     string     `json:"previousState"`
+	PreviousChannelId channel.Id `json:"previousChannelId"`
+	PreviousMapId     _map.Id    `json:"previousMapId"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
@@ -0,0 +1,34 @@
+package presence
+
+import (
+	presence2 "atlas-buddies/kafka/message/presence"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/character"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func UpdatedStatusEventProvider(characterId character.Id, worldId world.Id, state string, channelId channel.Id, mapId _map.Id, previousState string, previousChannelId channel.Id, previousMapId _map.Id, updatedAt time.Time) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &presence2.StatusEvent[presence2.UpdatedStatusEventBody]{
+		WorldId:     worldId,
+		CharacterId: characterId,
+		Type:        presence2.StatusEventTypeUpdated,
+		Body: presence2.UpdatedStatusEventBody{
+			State:             state,
+			ChannelId:         channelId,
+			MapId:             mapId,
+			PreviousState:     previousState,
+			PreviousChannelId: previousChannelId,
+			PreviousMapId:     previousMapId,
+			UpdatedAt:         updatedAt,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
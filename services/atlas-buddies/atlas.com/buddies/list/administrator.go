@@ -113,6 +113,18 @@ func updateBuddyShopStatus(db *gorm.DB, characterId uint32, targetId uint32, inS
 	return true, nil
 }
 
+// applyBuddyPresence stamps buddyId's current channel and cash shop status
+// onto the row for buddyId in ownerId's list. Rows are created offline; this
+// brings a freshly added buddy up to date without waiting for their next
+// login or channel change.
+func applyBuddyPresence(db *gorm.DB, ownerId uint32, buddyId uint32, channelId int8, inShop bool) error {
+	if _, err := updateBuddyChannel(db, buddyId, ownerId, channelId); err != nil {
+		return err
+	}
+	_, err := updateBuddyShopStatus(db, buddyId, ownerId, inShop)
+	return err
+}
+
 // buddyNameUpdate reports one owner's buddy-list row that updateBuddyName
 // actually renamed, carrying what the caller needs to emit BUDDY_UPDATED to
 // that owner (list/processor.go:610-645's UpdateBuddyChannel emit pattern).
@@ -164,6 +176,29 @@ func updateBuddyName(db *gorm.DB, targetId uint32, name string) ([]buddyNameUpda
 	return updates, nil
 }
 
+// renameGroup moves every buddy filed under oldGroup in characterId's list to
+// newGroup, returning the renamed rows. No rows means characterId has no such
+// group.
+func renameGroup(db *gorm.DB, characterId uint32, oldGroup string, newGroup string) ([]buddy.Entity, error) {
+	e, err := byCharacterIdEntityProvider(characterId)(db)()
+	if err != nil {
+		return nil, err
+	}
+
+	var renamed []buddy.Entity
+	for _, b := range e.Buddies {
+		if b.Group != oldGroup {
+			continue
+		}
+		b.Group = newGroup
+		if err = db.Save(&b).Error; err != nil {
+			return nil, err
+		}
+		renamed = append(renamed, b)
+	}
+	return renamed, nil
+}
+
 func deleteEntityWithBuddies(db *gorm.DB, characterId uint32) error {
 	var entity Entity
 
@@ -7,6 +7,7 @@ import (
 	"atlas-buddies/kafka/message"
 	list2 "atlas-buddies/kafka/message/list"
 	list3 "atlas-buddies/kafka/producer/list"
+	"atlas-buddies/presence"
 	"context"
 	"errors"
 
@@ -30,6 +31,10 @@ import (
 // custom one.
 const DefaultGroup = "Default Group"
 
+// MaxGroupNameLength matches the limit the channel enforces on the group
+// named when a buddy is added.
+const MaxGroupNameLength = 16
+
 type Processor interface {
 	WithTransaction(*gorm.DB) Processor
 	ByCharacterIdProvider(characterId uint32) model.Provider[Model]
@@ -63,6 +68,10 @@ type Processor interface {
 	// buddy list actually has this row and whose stored name differs.
 	UpdateBuddyNameAndEmit(characterId uint32, worldId world.Id, name string) error
 	UpdateBuddyName(mb *message.Buffer) func(characterId uint32, worldId world.Id, name string) error
+	// RenameGroupAndEmit refiles every buddy in characterId's oldGroup under
+	// newGroup, emitting BUDDY_UPDATED per moved buddy.
+	RenameGroupAndEmit(characterId uint32, worldId world.Id, oldGroup string, newGroup string) error
+	RenameGroup(mb *message.Buffer) func(characterId uint32, worldId world.Id, oldGroup string, newGroup string) error
 	// IncreaseCapacityAndEmit increases buddy list capacity and emits appropriate status events.
 	// This method validates the new capacity and updates the database in a transaction.
 	// On success, emits a CAPACITY_CHANGE event. On failure, emits an ERROR event.
@@ -84,6 +93,7 @@ type ProcessorImpl struct {
 	t   tenant.Model
 	cp  character.Processor
 	ip  invite.Processor
+	pp  presence.Processor
 }
 
 func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
@@ -94,6 +104,7 @@ func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Proces
 		t:   tenant.MustFromContext(ctx),
 		cp:  character.NewProcessor(l, ctx),
 		ip:  invite.NewProcessor(l, ctx),
+		pp:  presence.NewProcessor(l, ctx),
 	}
 }
 
@@ -107,7 +118,20 @@ func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
 		t:   p.t,
 		cp:  p.cp,
 		ip:  p.ip,
+		pp:  p.pp,
+	}
+}
+
+// presenceOf resolves where characterId is right now, for stamping onto a
+// buddy list row. A presence lookup failure reads as offline rather than
+// failing the buddy operation; the next login or channel change corrects it.
+func (p *ProcessorImpl) presenceOf(characterId uint32) presence.Model {
+	pm, err := p.pp.GetByCharacterId(characterId)
+	if err != nil {
+		p.l.WithError(err).Warnf("Unable to retrieve presence for character [%d], treating as offline.", characterId)
+		return presence.Offline(characterId)
 	}
+	return pm
 }
 
 func (p *ProcessorImpl) ByCharacterIdProvider(characterId uint32) model.Provider[Model] {
@@ -249,9 +273,21 @@ func (p *ProcessorImpl) RequestAddBuddy(mb *message.Buffer) func(characterId uin
 					return err
 				}
 
-				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), tc.Name(), -1, group))
-				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(targetId), worldId, character2.Id(characterId), mbe.Name(), mbe.ChannelId(), mbe.Group()))
-				// TODO need to trigger a channel request for target.
+				tp := p.presenceOf(targetId)
+				cp := p.presenceOf(characterId)
+				if err = applyBuddyPresence(tx, characterId, targetId, tp.BuddyChannelId(), tp.InCashShop()); err != nil {
+					p.l.WithError(err).Errorf("Unable to apply character [%d] presence to buddy list for character [%d].", targetId, characterId)
+					_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorUnknownError))
+					return err
+				}
+				if err = applyBuddyPresence(tx, targetId, characterId, cp.BuddyChannelId(), cp.InCashShop()); err != nil {
+					p.l.WithError(err).Errorf("Unable to apply character [%d] presence to buddy list for character [%d].", characterId, targetId)
+					_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorUnknownError))
+					return err
+				}
+
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), tc.Name(), tp.BuddyChannelId(), group))
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(targetId), worldId, character2.Id(characterId), mbe.Name(), cp.BuddyChannelId(), mbe.Group()))
 				return nil
 			}
 
@@ -353,8 +389,15 @@ func (p *ProcessorImpl) RestoreBuddy(mb *message.Buffer) func(characterId uint32
 				return err
 			}
 
+			tp := p.presenceOf(targetId)
+			if err = applyBuddyPresence(tx, characterId, targetId, tp.BuddyChannelId(), tp.InCashShop()); err != nil {
+				p.l.WithError(err).Errorf("Unable to apply character [%d] presence to buddy list for character [%d].", targetId, characterId)
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorUnknownError))
+				return err
+			}
+
 			p.l.Infof("Restored buddy [%d] to character [%d] buddy list.", targetId, characterId)
-			_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), tc.Name(), -1, DefaultGroup))
+			_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), tc.Name(), tp.BuddyChannelId(), DefaultGroup))
 			return nil
 		})
 		if txErr != nil {
@@ -548,8 +591,19 @@ func (p *ProcessorImpl) AcceptInvite(mb *message.Buffer) func(characterId uint32
 				return err
 			}
 
-			_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), oc.Name(), -1, "Default Group"))
-			// TODO need to trigger a channel request for target.
+			tp := p.presenceOf(targetId)
+			cp := p.presenceOf(characterId)
+			if err = applyBuddyPresence(tx, characterId, targetId, tp.BuddyChannelId(), tp.InCashShop()); err != nil {
+				return err
+			}
+			if err = applyBuddyPresence(tx, targetId, characterId, cp.BuddyChannelId(), cp.InCashShop()); err != nil {
+				return err
+			}
+
+			_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(targetId), oc.Name(), tp.BuddyChannelId(), "Default Group"))
+			// The requester's pending entry is now a confirmed buddy; tell
+			// their channel so it stops showing the invite as outstanding.
+			_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyAddedStatusEventProvider(character2.Id(targetId), worldId, character2.Id(characterId), c.Name(), cp.BuddyChannelId(), ob.Group()))
 			return nil
 		})
 		if txErr != nil {
@@ -690,7 +744,7 @@ func (p *ProcessorImpl) UpdateBuddyShopStatus(mb *message.Buffer) func(character
 						continue
 					}
 
-					_ = mb.Put(list2.EnvStatusEventTopic, list3.BuddyUpdatedStatusEventProvider(character2.Id(b.CharacterId), worldId, character2.Id(tbe.CharacterId), tbe.Group, tbe.CharacterName, b.ChannelId, inShop))
+					_ = mb.Put(list2.EnvStatusEventTopic, list3.BuddyUpdatedStatusEventProvider(character2.Id(b.CharacterId), worldId, character2.Id(tbe.CharacterId), tbe.Group, tbe.CharacterName, tbe.ChannelId, inShop))
 				}
 			}
 			return nil
@@ -733,6 +787,63 @@ func (p *ProcessorImpl) UpdateBuddyName(mb *message.Buffer) func(characterId uin
 	}
 }
 
+func (p *ProcessorImpl) RenameGroupAndEmit(characterId uint32, worldId world.Id, oldGroup string, newGroup string) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
+			return p.WithTransaction(tx).RenameGroup(buf)(characterId, worldId, oldGroup, newGroup)
+		})
+	})
+}
+
+func (p *ProcessorImpl) RenameGroup(mb *message.Buffer) func(characterId uint32, worldId world.Id, oldGroup string, newGroup string) error {
+	return func(characterId uint32, worldId world.Id, oldGroup string, newGroup string) error {
+		// innerMb follows RequestAddBuddy: rejections leave on the direct
+		// producer path (D7), renames ride the caller's buffer.
+		innerMb := message.NewBuffer()
+		txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+			if newGroup == "" || len(newGroup) > MaxGroupNameLength || newGroup == oldGroup {
+				p.l.Infof("Character [%d] attempting to rename buddy group [%s] to invalid name [%s].", characterId, oldGroup, newGroup)
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorInvalidGroupName))
+				return errors.New("invalid group name")
+			}
+
+			renamed, err := renameGroup(tx, characterId, oldGroup, newGroup)
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to rename buddy group [%s] for character [%d].", oldGroup, characterId)
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorUnknownError))
+				return err
+			}
+			if len(renamed) == 0 {
+				p.l.Infof("Character [%d] has no buddy group [%s] to rename.", characterId, oldGroup)
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.ErrorStatusEventProvider(character2.Id(characterId), worldId, list2.StatusEventErrorGroupNotFound))
+				return errors.New("group not found")
+			}
+
+			for _, b := range renamed {
+				_ = innerMb.Put(list2.EnvStatusEventTopic, list3.BuddyUpdatedStatusEventProvider(character2.Id(characterId), worldId, character2.Id(b.CharacterId), b.Group, b.CharacterName, b.ChannelId, b.InShop))
+			}
+			return nil
+		})
+		if txErr != nil {
+			_ = message.Emit(producer.ProviderImpl(p.l)(p.ctx))(func(buf *message.Buffer) error {
+				for t, ms := range innerMb.GetAll() {
+					if putErr := buf.Put(t, model.FixedProvider(ms)); putErr != nil {
+						return putErr
+					}
+				}
+				return nil
+			})
+			return nil
+		}
+		for t, ms := range innerMb.GetAll() {
+			if err := mb.Put(t, model.FixedProvider(ms)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // IncreaseCapacityAndEmit increases the buddy list capacity for a character and emits status events.
 // This method handles the complete workflow: validation, database update, and event emission.
 //
@@ -0,0 +1,132 @@
+package list
+
+import (
+	"atlas-buddies/buddy"
+	"atlas-buddies/kafka/message"
+	list2 "atlas-buddies/kafka/message/list"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func seedGroupedList(t *testing.T, db *gorm.DB, te tenant.Model, ownerId uint32, groups map[uint32]string) {
+	t.Helper()
+	if err := db.AutoMigrate(&buddy.Entity{}); err != nil {
+		t.Fatalf("failed to migrate buddy.Entity: %v", err)
+	}
+	e := Entity{TenantId: te.Id(), Id: uuid.New(), CharacterId: ownerId, Capacity: 20}
+	if err := db.Create(&e).Error; err != nil {
+		t.Fatalf("failed to create list entity: %v", err)
+	}
+	for buddyId, group := range groups {
+		if err := db.Create(&buddy.Entity{
+			CharacterId:   buddyId,
+			ListId:        e.Id,
+			TenantId:      te.Id(),
+			Group:         group,
+			CharacterName: "Buddy",
+			ChannelId:     -1,
+		}).Error; err != nil {
+			t.Fatalf("failed to create buddy row: %v", err)
+		}
+	}
+}
+
+func TestRenameGroupMovesEveryBuddyInTheGroup(t *testing.T) {
+	captured, restore := installCapturingProducer()
+	defer restore()
+
+	db := setupProcessorTestDB(t)
+	te := testTenant()
+	ctx := tenant.WithContext(context.Background(), te)
+	seedGroupedList(t, db, te, 600, map[uint32]string{601: "Friends", 602: "Friends", 603: DefaultGroup})
+
+	mb := message.NewBuffer()
+	if err := NewProcessor(testProcessorLogger(), ctx, db).RenameGroup(mb)(600, world.Id(0), "Friends", "Guildies"); err != nil {
+		t.Fatalf("RenameGroup: %v", err)
+	}
+
+	msgs := mb.GetAll()[list2.EnvStatusEventTopic]
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 BUDDY_UPDATED events, got %d", len(msgs))
+	}
+	for _, m := range msgs {
+		var ev list2.StatusEvent[list2.BuddyUpdatedStatusEventBody]
+		if err := json.Unmarshal(m.Value, &ev); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if ev.Type != list2.StatusEventTypeBuddyUpdated || ev.CharacterId != 600 || ev.Body.Group != "Guildies" {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+	}
+	if len(*captured) != 0 {
+		t.Fatalf("expected no direct-path emissions on success, got: %#v", *captured)
+	}
+
+	bl, err := NewProcessor(testProcessorLogger(), ctx, db).GetByCharacterId(600)
+	if err != nil {
+		t.Fatalf("GetByCharacterId: %v", err)
+	}
+	for _, b := range bl.Buddies() {
+		want := "Guildies"
+		if b.CharacterId() == 603 {
+			want = DefaultGroup
+		}
+		if b.Group() != want {
+			t.Errorf("buddy [%d] group = %q, want %q", b.CharacterId(), b.Group(), want)
+		}
+	}
+}
+
+func TestRenameGroupRejections(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldGroup string
+		newGroup string
+		error    string
+	}{
+		{"empty name", "Friends", "", list2.StatusEventErrorInvalidGroupName},
+		{"name too long", "Friends", "ABCDEFGHIJKLMNOPQ", list2.StatusEventErrorInvalidGroupName},
+		{"unchanged name", "Friends", "Friends", list2.StatusEventErrorInvalidGroupName},
+		{"unknown group", "Family", "Kin", list2.StatusEventErrorGroupNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captured, restore := installCapturingProducer()
+			defer restore()
+
+			db := setupProcessorTestDB(t)
+			te := testTenant()
+			ctx := tenant.WithContext(context.Background(), te)
+			seedGroupedList(t, db, te, 700, map[uint32]string{701: "Friends"})
+
+			mb := message.NewBuffer()
+			if err := NewProcessor(testProcessorLogger(), ctx, db).RenameGroup(mb)(700, world.Id(0), tt.oldGroup, tt.newGroup); err != nil {
+				t.Fatalf("RenameGroup is expected to swallow rejections, got: %v", err)
+			}
+			if len(mb.GetAll()) != 0 {
+				t.Fatalf("expected nothing in the caller buffer, got: %#v", mb.GetAll())
+			}
+
+			var direct []byte
+			for _, ms := range *captured {
+				if len(ms) == 1 {
+					direct = ms[0].Value
+				}
+			}
+			var ev list2.StatusEvent[list2.ErrorStatusEventBody]
+			if err := json.Unmarshal(direct, &ev); err != nil {
+				t.Fatalf("expected one direct-path ERROR event: %v", err)
+			}
+			if ev.Body.Error != tt.error {
+				t.Fatalf("error = %s, want %s", ev.Body.Error, tt.error)
+			}
+		})
+	}
+}
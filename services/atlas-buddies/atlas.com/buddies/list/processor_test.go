@@ -1,6 +1,11 @@
 package list
 
 import (
+	"atlas-buddies/buddy"
+	"atlas-buddies/kafka/message"
+	list2 "atlas-buddies/kafka/message/list"
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/uuid"
@@ -8,7 +13,9 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
 
 func setupProcessorTestDB(t *testing.T) *gorm.DB {
@@ -454,3 +461,34 @@ func TestIncreaseCapacityConcurrentIntegration(t *testing.T) {
 		t.Logf("Concurrent test results: err1=%v, err2=%v, final_capacity=%d", err1, err2, finalEntity.Capacity)
 	})
 }
+
+// TestUpdateBuddyShopStatusReachesBuddies covers a character moving from a
+// channel into the cash shop: each buddy listing them is told they are in the
+// shop, still on the channel they left.
+func TestUpdateBuddyShopStatusReachesBuddies(t *testing.T) {
+	db := setupProcessorTestDB(t)
+	te := testTenant()
+	ctx := tenant.WithContext(context.Background(), te)
+	seedGroupedList(t, db, te, 700, map[uint32]string{701: DefaultGroup})
+	seedGroupedList(t, db, te, 701, map[uint32]string{700: DefaultGroup})
+	if err := db.Model(&buddy.Entity{}).Where("character_id = ?", 700).Update("channel_id", 3).Error; err != nil {
+		t.Fatalf("failed to seed buddy channel: %v", err)
+	}
+
+	mb := message.NewBuffer()
+	if err := NewProcessor(testProcessorLogger(), ctx, db).UpdateBuddyShopStatus(mb)(700, world.Id(0), true); err != nil {
+		t.Fatalf("UpdateBuddyShopStatus: %v", err)
+	}
+
+	msgs := mb.GetAll()[list2.EnvStatusEventTopic]
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 BUDDY_UPDATED event, got %d", len(msgs))
+	}
+	var ev list2.StatusEvent[list2.BuddyUpdatedStatusEventBody]
+	if err := json.Unmarshal(msgs[0].Value, &ev); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+	if ev.Type != list2.StatusEventTypeBuddyUpdated || ev.CharacterId != 701 || ev.Body.CharacterId != 700 || ev.Body.ChannelId != 3 || !ev.Body.InShop {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+}
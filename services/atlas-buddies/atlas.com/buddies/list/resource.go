@@ -4,10 +4,12 @@ import (
 	"atlas-buddies/buddy"
 	list2 "atlas-buddies/kafka/message/list"
 	list3 "atlas-buddies/kafka/producer/list"
+	"atlas-buddies/presence"
 	"atlas-buddies/rest"
 	"errors"
 	"net/http"
 	"sort"
+	"strconv"
 
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 
@@ -26,6 +28,7 @@ const (
 	GetBuddyList          = "get_buddy_list"
 	CreateBuddyList       = "create_buddy_list"
 	GetBuddiesInBuddyList = "get_buddies_in_buddy_list"
+	GetBuddyListPresence  = "get_buddy_list_presence"
 )
 
 func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
@@ -36,6 +39,7 @@ func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteIn
 			r.HandleFunc("", registerGet(GetBuddyList, handleGetBuddyList(db))).Methods(http.MethodGet)
 			r.HandleFunc("", rest.RegisterInputHandler[RestModel](l)(si)(CreateBuddyList, handleCreateBuddyList)).Methods(http.MethodPost)
 			r.HandleFunc("/buddies", registerGet(GetBuddiesInBuddyList, handleGetBuddiesInBuddyList(db))).Methods(http.MethodGet)
+			r.HandleFunc("/presence", registerGet(GetBuddyListPresence, handleGetBuddyListPresence(db))).Methods(http.MethodGet)
 		}
 	}
 }
@@ -126,3 +130,61 @@ func handleGetBuddiesInBuddyList(db *gorm.DB) rest.GetHandler {
 		})
 	}
 }
+
+// handleGetBuddyListPresence reports where each confirmed buddy is, for
+// "friends online" style views. Pending invites are omitted; filter[online]
+// set to true drops offline buddies.
+func handleGetBuddyListPresence(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				onlineOnly := false
+				if v := r.URL.Query().Get("filter[online]"); v != "" {
+					var err error
+					onlineOnly, err = strconv.ParseBool(v)
+					if err != nil {
+						server.WriteBadRequest(d.Logger(), w, "invalid filter[online]")
+						return
+					}
+				}
+
+				bl, err := NewProcessor(d.Logger(), d.Context(), db).GetByCharacterId(characterId)
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if err != nil {
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				ids := make([]uint32, 0, len(bl.Buddies()))
+				for _, b := range bl.Buddies() {
+					if !b.Pending() {
+						ids = append(ids, b.CharacterId())
+					}
+				}
+				sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+				pms, err := presence.NewProcessor(d.Logger(), d.Context()).GetByCharacterIds(ids)
+				if err != nil {
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				filters := make([]model.Filter[presence.Model], 0)
+				if onlineOnly {
+					filters = append(filters, presence.Model.Online)
+				}
+
+				res, err := model.SliceMap(presence.Transform)(model.FilteredProvider(model.FixedProvider(pms), filters))()()
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Creating REST model.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				server.MarshalResponse[[]presence.RestModel](d.Logger())(w)(c.ServerInformation())(r.URL.Query())(res)
+			}
+		})
+	}
+}
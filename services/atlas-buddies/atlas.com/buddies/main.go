@@ -7,11 +7,13 @@ import (
 	invite2 "atlas-buddies/kafka/consumer/invite"
 	list2 "atlas-buddies/kafka/consumer/list"
 	"atlas-buddies/list"
+	"atlas-buddies/presence"
 	"context"
 	"os"
 
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	outboxlib "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	atlas "github.com/Chronicle20/atlas/libs/atlas-redis"
 	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
 	service "github.com/Chronicle20/atlas/libs/atlas-service"
 
@@ -49,6 +51,9 @@ func main() {
 	rt := service.Bootstrap(serviceName, service.WithEnvironmentRegistry(serviceName))
 	l := rt.Logger()
 
+	rc := atlas.Connect(l)
+	presence.InitRegistry(rc)
+
 	db := database.Connect(l, database.SetMigrations(list.Migration, buddy.Migration, outboxlib.Migration))
 
 	// Boot the outbox drainer: publishes the transactional outbox to Kafka.
@@ -97,6 +102,7 @@ func main() {
 		SetBasePath(GetServer().GetPrefix()).
 		SetPort(os.Getenv("REST_PORT")).
 		AddRouteInitializer(list.InitResource(GetServer())(db)).
+		AddRouteInitializer(presence.InitResource(GetServer())).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
@@ -0,0 +1,69 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Builder struct {
+	characterId uint32
+	worldId     world.Id
+	state       string
+	channelId   channel.Id
+	mapId       _map.Id
+	updatedAt   time.Time
+}
+
+func NewBuilder(characterId uint32) *Builder {
+	return &Builder{characterId: characterId, state: StateOffline}
+}
+
+func (m Model) Builder() *Builder {
+	return &Builder{
+		characterId: m.characterId,
+		worldId:     m.worldId,
+		state:       m.state,
+		channelId:   m.channelId,
+		mapId:       m.mapId,
+		updatedAt:   m.updatedAt,
+	}
+}
+
+func (b *Builder) SetWorldId(worldId world.Id) *Builder {
+	b.worldId = worldId
+	return b
+}
+
+func (b *Builder) SetState(state string) *Builder {
+	b.state = state
+	return b
+}
+
+func (b *Builder) SetChannelId(channelId channel.Id) *Builder {
+	b.channelId = channelId
+	return b
+}
+
+func (b *Builder) SetMapId(mapId _map.Id) *Builder {
+	b.mapId = mapId
+	return b
+}
+
+func (b *Builder) SetUpdatedAt(updatedAt time.Time) *Builder {
+	b.updatedAt = updatedAt
+	return b
+}
+
+func (b *Builder) Build() Model {
+	return Model{
+		characterId: b.characterId,
+		worldId:     b.worldId,
+		state:       b.state,
+		channelId:   b.channelId,
+		mapId:       b.mapId,
+		updatedAt:   b.updatedAt,
+	}
+}
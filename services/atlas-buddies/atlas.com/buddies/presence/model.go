@@ -0,0 +1,83 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	StateOffline  = "OFFLINE"
+	StateOnline   = "ONLINE"
+	StateCashShop = "CASH_SHOP"
+)
+
+// Model is where a character was last seen. Offline characters keep the
+// world, channel and map they logged out from, and updatedAt doubles as
+// their last-seen time.
+type Model struct {
+	characterId uint32
+	worldId     world.Id
+	state       string
+	channelId   channel.Id
+	mapId       _map.Id
+	updatedAt   time.Time
+}
+
+func (m Model) CharacterId() uint32 {
+	return m.characterId
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) State() string {
+	return m.state
+}
+
+func (m Model) ChannelId() channel.Id {
+	return m.channelId
+}
+
+func (m Model) MapId() _map.Id {
+	return m.mapId
+}
+
+func (m Model) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+func (m Model) Online() bool {
+	return m.state == StateOnline || m.state == StateCashShop
+}
+
+func (m Model) InCashShop() bool {
+	return m.state == StateCashShop
+}
+
+// BuddyChannelId is the channel as buddy list rows store it: -1 while
+// offline.
+func (m Model) BuddyChannelId() int8 {
+	if !m.Online() {
+		return -1
+	}
+	return int8(m.channelId)
+}
+
+// Equal reports whether two sightings describe the same place, ignoring
+// when they were recorded.
+func (m Model) Equal(o Model) bool {
+	return m.characterId == o.characterId &&
+		m.worldId == o.worldId &&
+		m.state == o.state &&
+		m.channelId == o.channelId &&
+		m.mapId == o.mapId
+}
+
+// Offline is the presence of a character with no recorded sighting.
+func Offline(characterId uint32) Model {
+	return Model{characterId: characterId, state: StateOffline}
+}
@@ -0,0 +1,194 @@
+package presence
+
+import (
+	"atlas-buddies/kafka/message"
+	presence2 "atlas-buddies/kafka/message/presence"
+	presence3 "atlas-buddies/kafka/producer/presence"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/character"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type Processor interface {
+	GetByCharacterId(characterId uint32) (Model, error)
+	GetByCharacterIds(characterIds []uint32) ([]Model, error)
+	LoginAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	Login(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	LogoutAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	Logout(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	ChangeChannelAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	ChangeChannel(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	ChangeMapAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	ChangeMap(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error
+	EnterCashShopAndEmit(characterId uint32, worldId world.Id) error
+	EnterCashShop(mb *message.Buffer) func(characterId uint32, worldId world.Id) error
+	ExitCashShopAndEmit(characterId uint32, worldId world.Id) error
+	ExitCashShop(mb *message.Buffer) func(characterId uint32, worldId world.Id) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetByCharacterId(characterId uint32) (Model, error) {
+	return getRegistry().Get(p.ctx, p.t, characterId)
+}
+
+func (p *ProcessorImpl) GetByCharacterIds(characterIds []uint32) ([]Model, error) {
+	results := make([]Model, 0, len(characterIds))
+	for _, id := range characterIds {
+		m, err := p.GetByCharacterId(id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, m)
+	}
+	return results, nil
+}
+
+func (p *ProcessorImpl) emit(f func(mb *message.Buffer) error) error {
+	return message.Emit(producer.ProviderImpl(p.l)(p.ctx))(f)
+}
+
+// transition applies fn to the character's current presence and records the
+// result. An UPDATED event is buffered only when the character actually
+// moved, so a redelivered or out-of-order event that restates the current
+// presence is silent.
+func (p *ProcessorImpl) transition(mb *message.Buffer, characterId uint32, fn func(Model) (Model, bool)) error {
+	previous, err := p.GetByCharacterId(characterId)
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to retrieve presence for character [%d].", characterId)
+		return err
+	}
+	current, ok := fn(previous)
+	if !ok || current.Equal(previous) {
+		return nil
+	}
+	current = current.Builder().SetUpdatedAt(time.Now()).Build()
+	if err = getRegistry().Put(p.ctx, p.t, current); err != nil {
+		p.l.WithError(err).Errorf("Unable to record presence for character [%d].", characterId)
+		return err
+	}
+	p.l.Debugf("Character [%d] presence changed from [%s] to [%s] in channel [%d] map [%d].", characterId, previous.State(), current.State(), current.ChannelId(), current.MapId())
+	return mb.Put(presence2.EnvStatusEventTopic, presence3.UpdatedStatusEventProvider(character.Id(characterId), current.WorldId(), current.State(), current.ChannelId(), current.MapId(), previous.State(), previous.ChannelId(), previous.MapId(), current.UpdatedAt()))
+}
+
+func (p *ProcessorImpl) LoginAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.Login(mb)(characterId, worldId, channelId, mapId)
+	})
+}
+
+func (p *ProcessorImpl) Login(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			return m.Builder().SetWorldId(worldId).SetState(StateOnline).SetChannelId(channelId).SetMapId(mapId).Build(), true
+		})
+	}
+}
+
+func (p *ProcessorImpl) LogoutAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.Logout(mb)(characterId, worldId, channelId, mapId)
+	})
+}
+
+// Logout records where the character was last seen. A logout from a
+// channel other than the recorded one is stale (the character has already
+// logged in elsewhere) and is ignored.
+func (p *ProcessorImpl) Logout(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			if m.State() == StateOnline && (m.WorldId() != worldId || m.ChannelId() != channelId) {
+				return m, false
+			}
+			return m.Builder().SetWorldId(worldId).SetState(StateOffline).SetChannelId(channelId).SetMapId(mapId).Build(), true
+		})
+	}
+}
+
+func (p *ProcessorImpl) ChangeChannelAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.ChangeChannel(mb)(characterId, worldId, channelId, mapId)
+	})
+}
+
+func (p *ProcessorImpl) ChangeChannel(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			return m.Builder().SetWorldId(worldId).SetState(StateOnline).SetChannelId(channelId).SetMapId(mapId).Build(), true
+		})
+	}
+}
+
+func (p *ProcessorImpl) ChangeMapAndEmit(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.ChangeMap(mb)(characterId, worldId, channelId, mapId)
+	})
+}
+
+// ChangeMap follows a character between maps. Map changes never bring a
+// character online; one reported for an offline character is stale.
+func (p *ProcessorImpl) ChangeMap(mb *message.Buffer) func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+	return func(characterId uint32, worldId world.Id, channelId channel.Id, mapId _map.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			if m.State() != StateOnline {
+				return m, false
+			}
+			return m.Builder().SetWorldId(worldId).SetChannelId(channelId).SetMapId(mapId).Build(), true
+		})
+	}
+}
+
+func (p *ProcessorImpl) EnterCashShopAndEmit(characterId uint32, worldId world.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.EnterCashShop(mb)(characterId, worldId)
+	})
+}
+
+// EnterCashShop keeps the channel the character left from; buddy lists
+// show cash shop visitors against that channel.
+func (p *ProcessorImpl) EnterCashShop(mb *message.Buffer) func(characterId uint32, worldId world.Id) error {
+	return func(characterId uint32, worldId world.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			return m.Builder().SetWorldId(worldId).SetState(StateCashShop).Build(), true
+		})
+	}
+}
+
+func (p *ProcessorImpl) ExitCashShopAndEmit(characterId uint32, worldId world.Id) error {
+	return p.emit(func(mb *message.Buffer) error {
+		return p.ExitCashShop(mb)(characterId, worldId)
+	})
+}
+
+func (p *ProcessorImpl) ExitCashShop(mb *message.Buffer) func(characterId uint32, worldId world.Id) error {
+	return func(characterId uint32, worldId world.Id) error {
+		return p.transition(mb, characterId, func(m Model) (Model, bool) {
+			if m.State() != StateCashShop {
+				return m, false
+			}
+			return m.Builder().SetState(StateOnline).Build(), true
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package presence
+
+import (
+	"atlas-buddies/kafka/message"
+	presence2 "atlas-buddies/kafka/message/presence"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func setupPresenceTest(t *testing.T) Processor {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	InitRegistry(goredis.NewClient(&goredis.Options{Addr: mr.Addr()}))
+	te, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	l, _ := test.NewNullLogger()
+	return NewProcessor(l, tenant.WithContext(context.Background(), te))
+}
+
+func updatedEvents(t *testing.T, mb *message.Buffer) []presence2.StatusEvent[presence2.UpdatedStatusEventBody] {
+	t.Helper()
+	var results []presence2.StatusEvent[presence2.UpdatedStatusEventBody]
+	for _, m := range mb.GetAll()[presence2.EnvStatusEventTopic] {
+		var ev presence2.StatusEvent[presence2.UpdatedStatusEventBody]
+		if err := json.Unmarshal(m.Value, &ev); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		results = append(results, ev)
+	}
+	return results
+}
+
+func TestUnknownCharacterIsOffline(t *testing.T) {
+	p := setupPresenceTest(t)
+	m, err := p.GetByCharacterId(1)
+	if err != nil {
+		t.Fatalf("GetByCharacterId: %v", err)
+	}
+	if m.Online() || m.State() != StateOffline || m.BuddyChannelId() != -1 {
+		t.Fatalf("expected offline presence, got %+v", m)
+	}
+}
+
+func TestPresenceFollowsACharacterSession(t *testing.T) {
+	p := setupPresenceTest(t)
+	mb := message.NewBuffer()
+
+	steps := []func() error{
+		func() error { return p.Login(mb)(1, 0, 2, 100000000) },
+		func() error { return p.ChangeMap(mb)(1, 0, 2, 104000000) },
+		func() error { return p.ChangeChannel(mb)(1, 0, 4, 104000000) },
+		func() error { return p.EnterCashShop(mb)(1, 0) },
+		func() error { return p.ExitCashShop(mb)(1, 0) },
+		func() error { return p.Logout(mb)(1, 0, 4, 104000000) },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	evs := updatedEvents(t, mb)
+	want := []struct {
+		state     string
+		previous  string
+		channelId byte
+		mapId     uint32
+	}{
+		{StateOnline, StateOffline, 2, 100000000},
+		{StateOnline, StateOnline, 2, 104000000},
+		{StateOnline, StateOnline, 4, 104000000},
+		{StateCashShop, StateOnline, 4, 104000000},
+		{StateOnline, StateCashShop, 4, 104000000},
+		{StateOffline, StateOnline, 4, 104000000},
+	}
+	if len(evs) != len(want) {
+		t.Fatalf("expected %d UPDATED events, got %d", len(want), len(evs))
+	}
+	for i, w := range want {
+		b := evs[i].Body
+		if b.State != w.state || b.PreviousState != w.previous || byte(b.ChannelId) != w.channelId || uint32(b.MapId) != w.mapId {
+			t.Errorf("event %d = %+v, want %+v", i, b, w)
+		}
+	}
+
+	m, err := p.GetByCharacterId(1)
+	if err != nil {
+		t.Fatalf("GetByCharacterId: %v", err)
+	}
+	if m.Online() || m.ChannelId() != 4 || m.MapId() != 104000000 || m.UpdatedAt().IsZero() {
+		t.Fatalf("expected last seen offline in channel 4 map 104000000, got %+v", m)
+	}
+}
+
+func TestStaleTransitionsAreIgnored(t *testing.T) {
+	p := setupPresenceTest(t)
+	if err := p.Login(message.NewBuffer())(1, 0, 3, 100000000); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	mb := message.NewBuffer()
+	// A logout from the channel the character already left.
+	if err := p.Logout(mb)(1, 0, 1, 100000000); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	// Leaving a cash shop the character never entered.
+	if err := p.ExitCashShop(mb)(1, 0); err != nil {
+		t.Fatalf("ExitCashShop: %v", err)
+	}
+	// A redelivered login restating the current presence.
+	if err := p.Login(mb)(1, 0, 3, 100000000); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	// A map change for a character who is not online.
+	if err := p.ChangeMap(mb)(2, 0, 1, 100000000); err != nil {
+		t.Fatalf("ChangeMap: %v", err)
+	}
+
+	if evs := updatedEvents(t, mb); len(evs) != 0 {
+		t.Fatalf("expected no UPDATED events, got %+v", evs)
+	}
+	m, _ := p.GetByCharacterId(1)
+	if !m.Online() || m.ChannelId() != 3 {
+		t.Fatalf("expected character online in channel 3, got %+v", m)
+	}
+}
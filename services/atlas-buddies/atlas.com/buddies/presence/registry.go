@@ -0,0 +1,81 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	atlas "github.com/Chronicle20/atlas/libs/atlas-redis"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// offlineRetention bounds how long a last-seen record outlives its
+// character's logout. Online records never expire.
+const offlineRetention = 30 * 24 * time.Hour
+
+type entry struct {
+	CharacterId uint32     `json:"characterId"`
+	WorldId     world.Id   `json:"worldId"`
+	State       string     `json:"state"`
+	ChannelId   channel.Id `json:"channelId"`
+	MapId       _map.Id    `json:"mapId"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type Registry struct {
+	entries *atlas.TenantRegistry[uint32, entry]
+}
+
+var registry *Registry
+
+func InitRegistry(client *goredis.Client) {
+	registry = &Registry{
+		entries: atlas.NewTenantRegistry[uint32, entry](client, "buddy-presence", func(characterId uint32) string {
+			return strconv.FormatUint(uint64(characterId), 10)
+		}),
+	}
+}
+
+func getRegistry() *Registry {
+	return registry
+}
+
+// Get returns the recorded presence of characterId, or an offline presence
+// when nothing has been recorded.
+func (r *Registry) Get(ctx context.Context, t tenant.Model, characterId uint32) (Model, error) {
+	e, err := r.entries.Get(ctx, t, characterId)
+	if errors.Is(err, atlas.ErrNotFound) {
+		return Offline(characterId), nil
+	}
+	if err != nil {
+		return Model{}, err
+	}
+	return NewBuilder(e.CharacterId).
+		SetWorldId(e.WorldId).
+		SetState(e.State).
+		SetChannelId(e.ChannelId).
+		SetMapId(e.MapId).
+		SetUpdatedAt(e.UpdatedAt).
+		Build(), nil
+}
+
+func (r *Registry) Put(ctx context.Context, t tenant.Model, m Model) error {
+	e := entry{
+		CharacterId: m.CharacterId(),
+		WorldId:     m.WorldId(),
+		State:       m.State(),
+		ChannelId:   m.ChannelId(),
+		MapId:       m.MapId(),
+		UpdatedAt:   m.UpdatedAt(),
+	}
+	if m.Online() {
+		return r.entries.Put(ctx, t, m.CharacterId(), e)
+	}
+	return r.entries.PutWithTTL(ctx, t, m.CharacterId(), e, offlineRetention)
+}
@@ -0,0 +1,45 @@
+package presence
+
+import (
+	"atlas-buddies/rest"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+)
+
+const (
+	GetPresence = "get_presence"
+)
+
+func InitResource(si jsonapi.ServerInformation) server.RouteInitializer {
+	return func(router *mux.Router, l logrus.FieldLogger) {
+		registerGet := rest.RegisterHandler(l)(si)
+		router.HandleFunc("/characters/{characterId}/presence", registerGet(GetPresence, handleGetPresence)).Methods(http.MethodGet)
+	}
+}
+
+func handleGetPresence(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			pm, err := NewProcessor(d.Logger(), d.Context()).GetByCharacterId(characterId)
+			if err != nil {
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			res, err := model.Map(Transform)(model.FixedProvider(pm))()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(r.URL.Query())(res)
+		}
+	})
+}
@@ -20,6 +20,7 @@ type Model struct {
 	noExpiry  bool
 
 	correlationId string
+	source        Source
 }
 
 func (m Model) SourceId() int32 {
@@ -44,6 +45,18 @@ func (m Model) NoExpiry() bool {
 // an event is.
 func (m Model) CorrelationId() string { return m.correlationId }
 
+// Source is the category of what granted this buff. A buff stored before
+// the category was recorded resolves it from its sourceId and correlation id.
+func (m Model) Source() Source {
+	return ResolveSource(string(m.source), m.sourceId, m.correlationId)
+}
+
+// WithSource returns a copy of the buff carrying the given source category.
+func (m Model) WithSource(source Source) Model {
+	m.source = source
+	return m
+}
+
 func (m Model) Expired() bool {
 	if m.noExpiry {
 		return false
@@ -96,6 +109,7 @@ func (m Model) WithStatAmount(statType string, amount int32) (Model, bool) {
 		expiresAt:     m.expiresAt,
 		noExpiry:      m.noExpiry,
 		correlationId: m.correlationId,
+		source:        m.source,
 	}, true
 }
 
@@ -110,6 +124,7 @@ func (m Model) MarshalJSON() ([]byte, error) {
 		ExpiresAt     time.Time    `json:"expiresAt"`
 		NoExpiry      bool         `json:"noExpiry,omitempty"`
 		CorrelationId string       `json:"correlationId,omitempty"`
+		Source        Source       `json:"source,omitempty"`
 	}{
 		Id:            m.id,
 		SourceId:      m.sourceId,
@@ -120,6 +135,7 @@ func (m Model) MarshalJSON() ([]byte, error) {
 		ExpiresAt:     m.expiresAt,
 		NoExpiry:      m.noExpiry,
 		CorrelationId: m.correlationId,
+		Source:        m.source,
 	})
 }
 
@@ -134,6 +150,7 @@ func (m *Model) UnmarshalJSON(data []byte) error {
 		ExpiresAt     time.Time    `json:"expiresAt"`
 		NoExpiry      bool         `json:"noExpiry,omitempty"`
 		CorrelationId string       `json:"correlationId,omitempty"`
+		Source        Source       `json:"source,omitempty"`
 	}
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
@@ -147,6 +164,7 @@ func (m *Model) UnmarshalJSON(data []byte) error {
 	m.expiresAt = aux.ExpiresAt
 	m.noExpiry = aux.NoExpiry
 	m.correlationId = aux.CorrelationId
+	m.source = aux.Source
 	return nil
 }
 
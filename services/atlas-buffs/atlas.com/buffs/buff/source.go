@@ -0,0 +1,50 @@
+package buff
+
+// Source is the category of what granted a buff. It decides which
+// transitions the buff survives (character.PersistencePolicy); it is not a
+// lookup key, so two buffs of different sources still replace each other
+// when they share a sourceId.
+type Source string
+
+const (
+	SourceSkill Source = "SKILL"
+	SourceItem  Source = "ITEM"
+	SourceEvent Source = "EVENT"
+	// SourceMap is a buff a field imposes on whoever stands in it (mist
+	// diseases). It belongs to the field, not the character.
+	SourceMap Source = "MAP"
+	// SourceEntitlement is a buff bought for a group the character belongs
+	// to. Guild skills are the only entitlements granted through atlas-buffs:
+	// family entitlements are rate bonuses that atlas-rates tracks against
+	// their start time, so they already outlive channel changes and relogs.
+	// A future group entitlement granted here should declare this source.
+	SourceEntitlement Source = "ENTITLEMENT"
+)
+
+// guildSkillJob is the job prefix of the guild skill ids (91000000+) that
+// atlas-guilds uses as the sourceId of the buffs it grants.
+const guildSkillJob = 9100
+
+// ResolveSource returns the declared source when it is a known one, and
+// otherwise infers it from what the granter sent: a correlation id means an
+// event granted it, a negative sourceId is an item, a guild skill id is an
+// entitlement, and anything else is a skill. Producers that predate the
+// source field therefore keep their old bytes and still classify correctly;
+// only a field effect, which is indistinguishable from a skill by id, has to
+// declare itself.
+func ResolveSource(declared string, sourceId int32, correlationId string) Source {
+	switch s := Source(declared); s {
+	case SourceSkill, SourceItem, SourceEvent, SourceMap, SourceEntitlement:
+		return s
+	}
+	if correlationId != "" {
+		return SourceEvent
+	}
+	if sourceId < 0 {
+		return SourceItem
+	}
+	if sourceId/10000 == guildSkillJob {
+		return SourceEntitlement
+	}
+	return SourceSkill
+}
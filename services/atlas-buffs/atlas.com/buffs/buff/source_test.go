@@ -0,0 +1,53 @@
+package buff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSource(t *testing.T) {
+	cases := []struct {
+		name          string
+		declared      string
+		sourceId      int32
+		correlationId string
+		want          Source
+	}{
+		{"skill", "", 2001001, "", SourceSkill},
+		{"item", "", -2022003, "", SourceItem},
+		{"guild skill", "", 91000003, "", SourceEntitlement},
+		{"event", "", 2022179, "occ-1", SourceEvent},
+		{"declared entitlement", "ENTITLEMENT", 2001001, "", SourceEntitlement},
+		{"declared map", "MAP", 2111003, "", SourceMap},
+		{"declared wins over inference", "SKILL", -2022003, "", SourceSkill},
+		{"unknown declared falls back", "WEATHER", -2022003, "", SourceItem},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, ResolveSource(c.declared, c.sourceId, c.correlationId))
+		})
+	}
+}
+
+func TestSource_JSONRoundTrip(t *testing.T) {
+	b, err := NewBuff(int32(2111003), byte(1), 5000, setupTestChanges(), "")
+	assert.NoError(t, err)
+	b = b.WithSource(SourceMap)
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+
+	var got Model
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, SourceMap, got.Source())
+	assert.Equal(t, b.ExpiresAt().UnixMilli(), got.ExpiresAt().UnixMilli())
+}
+
+// A buff stored before the source was recorded resolves it on read.
+func TestSource_UnrecordedIsInferred(t *testing.T) {
+	b, err := NewBuff(int32(-2022003), byte(1), 5000, setupTestChanges(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, SourceItem, b.Source())
+}
@@ -0,0 +1,97 @@
+package character
+
+import (
+	"atlas-buffs/buff"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Transition is a move that takes a character away from the field it was
+// playing on and, usually, back again.
+type Transition string
+
+const (
+	TransitionChannelChange Transition = "CHANNEL_CHANGE"
+	TransitionCashShop      Transition = "CASH_SHOP"
+	// TransitionRelog is a disconnect followed by a login within the grace
+	// window (RelogGrace). A login after the window restores nothing.
+	TransitionRelog Transition = "RELOG"
+)
+
+// Persistence says which transitions a buff survives. A surviving buff keeps
+// its original expiresAt, so it comes back with whatever time it has left; a
+// buff that does not survive is cancelled (EXPIRED) when the transition
+// starts.
+type Persistence struct {
+	ChannelChange bool
+	CashShop      bool
+	Relog         bool
+}
+
+func (p Persistence) Survives(t Transition) bool {
+	switch t {
+	case TransitionChannelChange:
+		return p.ChannelChange
+	case TransitionCashShop:
+		return p.CashShop
+	case TransitionRelog:
+		return p.Relog
+	}
+	return false
+}
+
+// persistencePolicy is the per-source rule table.
+//
+//   - Skills, items and entitlements belong to the character and survive
+//     everything short of the grace window lapsing.
+//   - Event buffs survive a channel change or the cash shop but not a relog:
+//     the event re-grants them at login while it is still running, so
+//     restoring one could only ever resurrect a buff of an event that has
+//     since ended.
+//   - Map effects belong to the field instance the character left, so they
+//     survive nothing.
+var persistencePolicy = map[buff.Source]Persistence{
+	buff.SourceSkill:       {ChannelChange: true, CashShop: true, Relog: true},
+	buff.SourceItem:        {ChannelChange: true, CashShop: true, Relog: true},
+	buff.SourceEntitlement: {ChannelChange: true, CashShop: true, Relog: true},
+	buff.SourceEvent:       {ChannelChange: true, CashShop: true, Relog: false},
+	buff.SourceMap:         {},
+}
+
+// PersistencePolicy returns the rule for a source. An unknown source
+// survives nothing.
+func PersistencePolicy(source buff.Source) Persistence {
+	return persistencePolicy[source]
+}
+
+func survives(t Transition) func(b buff.Model) bool {
+	return func(b buff.Model) bool {
+		return PersistencePolicy(b.Source()).Survives(t)
+	}
+}
+
+const (
+	envRelogGraceSeconds     = "BUFF_RELOG_GRACE_SECONDS"
+	defaultRelogGraceSeconds = 300
+)
+
+var (
+	relogGraceOnce sync.Once
+	relogGrace     time.Duration
+)
+
+// RelogGrace is how long after a logout a login still restores the
+// character's buffs. Read once from BUFF_RELOG_GRACE_SECONDS; a missing or
+// non-positive value falls back to the default.
+func RelogGrace() time.Duration {
+	relogGraceOnce.Do(func() {
+		seconds := defaultRelogGraceSeconds
+		if v, err := strconv.Atoi(os.Getenv(envRelogGraceSeconds)); err == nil && v > 0 {
+			seconds = v
+		}
+		relogGrace = time.Duration(seconds) * time.Second
+	})
+	return relogGrace
+}
@@ -0,0 +1,176 @@
+package character
+
+import (
+	"atlas-buffs/buff"
+	"atlas-buffs/buff/stat"
+	character2 "atlas-buffs/kafka/message/character"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+func applySourced(t *testing.T, ctx context.Context, characterId uint32, sourceId int32, duration int32, source buff.Source) {
+	t.Helper()
+	_, err := GetRegistry().Apply(ctx, world.Id(0), channel.Id(1), characterId, sourceId, 1, duration, []stat.Model{stat.NewStat("WEAPON_ATTACK", 10)}, false, false, "", source)
+	require.NoError(t, err)
+}
+
+func sourceIds(bs map[string]buff.Model) []int32 {
+	out := make([]int32, 0, len(bs))
+	for _, b := range bs {
+		out = append(out, b.SourceId())
+	}
+	return out
+}
+
+func TestPersistencePolicy(t *testing.T) {
+	for _, s := range []buff.Source{buff.SourceSkill, buff.SourceItem, buff.SourceEntitlement} {
+		p := PersistencePolicy(s)
+		assert.True(t, p.Survives(TransitionChannelChange), s)
+		assert.True(t, p.Survives(TransitionCashShop), s)
+		assert.True(t, p.Survives(TransitionRelog), s)
+	}
+
+	ev := PersistencePolicy(buff.SourceEvent)
+	assert.True(t, ev.Survives(TransitionChannelChange))
+	assert.True(t, ev.Survives(TransitionCashShop))
+	assert.False(t, ev.Survives(TransitionRelog), "the event re-grants at login")
+
+	mp := PersistencePolicy(buff.SourceMap)
+	assert.False(t, mp.Survives(TransitionChannelChange))
+	assert.False(t, mp.Survives(TransitionCashShop))
+	assert.False(t, mp.Survives(TransitionRelog))
+
+	assert.Equal(t, Persistence{}, PersistencePolicy(buff.Source("WEATHER")))
+}
+
+func TestProcessor_CancelForTransition_DropsMapEffects(t *testing.T) {
+	processor, _, ctx := setupProcessorTest(t)
+	characterId := uint32(3000)
+	applySourced(t, ctx, characterId, 2001001, 60000, buff.SourceSkill)
+	applySourced(t, ctx, characterId, 2111003, 60000, buff.SourceMap)
+	emitted.Reset()
+
+	require.NoError(t, processor.CancelForTransition(world.Id(0), characterId, TransitionChannelChange))
+
+	m, err := processor.GetById(characterId)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int32{2001001}, sourceIds(m.Buffs()))
+
+	msgs := emitted.Messages(character2.EnvEventStatusTopic)
+	require.Len(t, msgs, 1)
+	var ev character2.StatusEvent[character2.ExpiredStatusEventBody]
+	require.NoError(t, json.Unmarshal(msgs[0].Value, &ev))
+	assert.Equal(t, character2.EventStatusTypeBuffExpired, ev.Type)
+	assert.Equal(t, int32(2111003), ev.Body.SourceId)
+}
+
+func TestProcessor_SuspendThenResume_RestoresRemainingDuration(t *testing.T) {
+	processor, _, ctx := setupProcessorTest(t)
+	characterId := uint32(3001)
+	applySourced(t, ctx, characterId, 2001001, 60000, buff.SourceSkill)
+	applySourced(t, ctx, characterId, -2022003, 60000, buff.SourceItem)
+	applySourced(t, ctx, characterId, 2022179, 60000, buff.SourceEvent)
+	applySourced(t, ctx, characterId, 2111003, 60000, buff.SourceMap)
+	before, err := processor.GetById(characterId)
+	require.NoError(t, err)
+	emitted.Reset()
+
+	require.NoError(t, processor.Suspend(world.Id(0), characterId))
+
+	_, err = processor.GetById(characterId)
+	assert.ErrorIs(t, err, ErrNotFound, "a logged-out character is off the live registry")
+	expired := emitted.Messages(character2.EnvEventStatusTopic)
+	assert.Len(t, expired, 2, "the event and map buffs expire at logout")
+
+	emitted.Reset()
+	require.NoError(t, processor.Resume(world.Id(0), channel.Id(2), characterId))
+
+	m, err := processor.GetById(characterId)
+	require.NoError(t, err)
+	assert.Equal(t, channel.Id(2), m.ChannelId())
+	assert.ElementsMatch(t, []int32{2001001, -2022003}, sourceIds(m.Buffs()))
+	for k, b := range m.Buffs() {
+		assert.True(t, before.Buffs()[k].ExpiresAt().Equal(b.ExpiresAt()), "restored buff keeps its original expiry")
+	}
+
+	applied := emitted.Messages(character2.EnvEventStatusTopic)
+	require.Len(t, applied, 2)
+	for _, msg := range applied {
+		var ev character2.StatusEvent[character2.AppliedStatusEventBody]
+		require.NoError(t, json.Unmarshal(msg.Value, &ev))
+		assert.Equal(t, character2.EventStatusTypeBuffApplied, ev.Type)
+	}
+
+	emitted.Reset()
+	require.NoError(t, processor.Resume(world.Id(0), channel.Id(2), characterId))
+	assert.Empty(t, emitted.Messages(character2.EnvEventStatusTopic), "the snapshot is consumed by the first login")
+}
+
+func TestProcessor_Resume_DropsBuffsThatLapsedWhileAway(t *testing.T) {
+	processor, _, ctx := setupProcessorTest(t)
+	characterId := uint32(3002)
+	applySourced(t, ctx, characterId, 2001001, 60000, buff.SourceSkill)
+	applySourced(t, ctx, characterId, 2001002, 30, buff.SourceSkill)
+
+	require.NoError(t, processor.Suspend(world.Id(0), characterId))
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, processor.Resume(world.Id(0), channel.Id(1), characterId))
+
+	m, err := processor.GetById(characterId)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int32{2001001}, sourceIds(m.Buffs()))
+}
+
+// A buff granted since the login is newer than its snapshotted namesake.
+func TestRegistry_Resume_LiveBuffWins(t *testing.T) {
+	setupTestRegistry(t)
+	ctx := setupTestContext(t, setupTestTenant(t))
+	characterId := uint32(3003)
+	applySourced(t, ctx, characterId, 2001001, 60000, buff.SourceSkill)
+	_, _, err := GetRegistry().Suspend(ctx, characterId, survives(TransitionRelog), time.Minute)
+	require.NoError(t, err)
+
+	applySourced(t, ctx, characterId, 2001001, 120000, buff.SourceSkill)
+	live, err := GetRegistry().Get(ctx, characterId)
+	require.NoError(t, err)
+
+	restored, err := GetRegistry().Resume(ctx, world.Id(0), channel.Id(1), characterId)
+	require.NoError(t, err)
+	assert.Empty(t, restored)
+
+	m, err := GetRegistry().Get(ctx, characterId)
+	require.NoError(t, err)
+	assert.Equal(t, live.Buffs()["2001001"].ExpiresAt(), m.Buffs()["2001001"].ExpiresAt())
+}
+
+func TestRegistry_Resume_AfterGraceRestoresNothing(t *testing.T) {
+	mr := miniredis.RunT(t)
+	InitRegistry(goredis.NewClient(&goredis.Options{Addr: mr.Addr()}))
+	ctx := setupTestContext(t, setupTestTenant(t))
+	characterId := uint32(3004)
+	applySourced(t, ctx, characterId, 2001001, 600000, buff.SourceSkill)
+
+	_, _, err := GetRegistry().Suspend(ctx, characterId, survives(TransitionRelog), time.Minute)
+	require.NoError(t, err)
+	mr.FastForward(time.Minute + time.Second)
+
+	restored, err := GetRegistry().Resume(ctx, world.Id(0), channel.Id(1), characterId)
+	require.NoError(t, err)
+	assert.Empty(t, restored)
+	_, err = GetRegistry().Get(ctx, characterId)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRelogGrace_Default(t *testing.T) {
+	assert.Equal(t, time.Duration(defaultRelogGraceSeconds)*time.Second, RelogGrace())
+}
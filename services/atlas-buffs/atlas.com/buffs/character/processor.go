@@ -1,6 +1,7 @@
 package character
 
 import (
+	"atlas-buffs/buff"
 	"atlas-buffs/buff/stat"
 	extchar "atlas-buffs/external/character"
 	"atlas-buffs/kafka/message"
@@ -21,11 +22,14 @@ import (
 
 type Processor interface {
 	GetById(characterId uint32) (Model, error)
-	Apply(worldId world.Id, channelId channel.Id, characterId uint32, fromId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string) error
+	Apply(worldId world.Id, channelId channel.Id, characterId uint32, fromId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string, source buff.Source) error
 	Cancel(worldId world.Id, characterId uint32, sourceId int32) error
 	CancelAll(worldId world.Id, characterId uint32) error
 	CancelByStatTypes(worldId world.Id, characterId uint32, types []string) error
 	CancelByCorrelation(correlationId string) error
+	CancelForTransition(worldId world.Id, characterId uint32, t Transition) error
+	Suspend(worldId world.Id, characterId uint32) error
+	Resume(worldId world.Id, channelId channel.Id, characterId uint32) error
 	UpdateStatValue(worldId world.Id, channelId channel.Id, characterId uint32, u StatValueUpdate) error
 	ExpireBuffs() error
 	ExpireForCharacter(worldId world.Id, characterId uint32) error
@@ -63,14 +67,14 @@ func (p *ProcessorImpl) GetById(characterId uint32) (Model, error) {
 	return GetRegistry().Get(p.ctx, characterId)
 }
 
-func (p *ProcessorImpl) Apply(worldId world.Id, channelId channel.Id, characterId uint32, fromId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string) error {
+func (p *ProcessorImpl) Apply(worldId world.Id, channelId channel.Id, characterId uint32, fromId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string, source buff.Source) error {
 	if isDiseaseChange(changes) && GetRegistry().HasImmunity(p.ctx, characterId) {
 		p.l.Debugf("Character [%d] is immune to disease, skipping apply.", characterId)
 		return nil
 	}
 
 	err := message.Emit(p.l, p.ctx)(func(buf *message.Buffer) error {
-		applied, err := GetRegistry().Apply(p.ctx, worldId, channelId, characterId, sourceId, level, duration, changes, accumulate, noExpiry, correlationId)
+		applied, err := GetRegistry().Apply(p.ctx, worldId, channelId, characterId, sourceId, level, duration, changes, accumulate, noExpiry, correlationId, source)
 		if err != nil {
 			return err
 		}
@@ -226,6 +230,94 @@ func (p *ProcessorImpl) CancelByCorrelation(correlationId string) error {
 	})
 }
 
+// CancelForTransition cancels every buff the character's persistence policy
+// says does not survive t, emitting one EXPIRED each. Surviving buffs are
+// untouched and keep running on their original expiresAt.
+func (p *ProcessorImpl) CancelForTransition(worldId world.Id, characterId uint32, t Transition) error {
+	cancelled, err := GetRegistry().CancelUnless(p.ctx, characterId, survives(t))
+	if err != nil {
+		return err
+	}
+	return p.announceRemoved(worldId, characterId, cancelled)
+}
+
+// Suspend snapshots a logged-out character's relog-surviving buffs for the
+// grace window and takes the character off the live registry. The
+// snapshotted buffs announce nothing — to the rest of the system they are
+// simply still held — while every other buff announces EXPIRED.
+func (p *ProcessorImpl) Suspend(worldId world.Id, characterId uint32) error {
+	suspended, dropped, err := GetRegistry().Suspend(p.ctx, characterId, survives(TransitionRelog), RelogGrace())
+	if err != nil {
+		return err
+	}
+	if len(suspended) > 0 {
+		p.l.Debugf("Suspended [%d] buff(s) of character [%d] for [%s].", len(suspended), characterId, RelogGrace())
+		sets := make([][]stat.Model, 0, len(suspended))
+		for _, b := range suspended {
+			sets = append(sets, b.Changes())
+		}
+		GetRegistry().ClearPeriodicTicksFor(p.ctx, characterId, sets...)
+	}
+	return p.announceRemoved(worldId, characterId, dropped)
+}
+
+// Resume restores the buffs a character was suspended with when the login
+// falls inside the grace window, emitting one APPLIED each with the buff's
+// original createdAt/expiresAt so the channel renders the remaining time.
+func (p *ProcessorImpl) Resume(worldId world.Id, channelId channel.Id, characterId uint32) error {
+	restored, err := GetRegistry().Resume(p.ctx, worldId, channelId, characterId)
+	if err != nil {
+		return err
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+	p.l.Debugf("Restored [%d] buff(s) of character [%d] on relog.", len(restored), characterId)
+	err = message.Emit(p.l, p.ctx)(func(buf *message.Buffer) error {
+		for _, b := range restored {
+			if err := buf.Put(character2.EnvEventStatusTopic, appliedStatusEventProvider(worldId, characterId, characterId, b.SourceId(), b.Level(), b.Duration(), b.Changes(), b.CreatedAt(), b.ExpiresAt(), b.NoExpiry())); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sets := make([][]stat.Model, 0, len(restored))
+	for _, b := range restored {
+		sets = append(sets, b.Changes())
+	}
+	markBerserkDirtyOnMaxHpChange(p.l, p.ctx, characterId, sets...)
+	return nil
+}
+
+// announceRemoved emits one EXPIRED per buff already removed from storage and
+// clears the periodic ticks and berserk state they fed.
+func (p *ProcessorImpl) announceRemoved(worldId world.Id, characterId uint32, removed []buff.Model) error {
+	if len(removed) == 0 {
+		return nil
+	}
+	err := message.Emit(p.l, p.ctx)(func(buf *message.Buffer) error {
+		for _, b := range removed {
+			if err := buf.Put(character2.EnvEventStatusTopic, expiredStatusEventProvider(worldId, characterId, b.SourceId(), b.Level(), b.Duration(), b.Changes(), b.CreatedAt(), b.ExpiresAt(), b.NoExpiry())); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sets := make([][]stat.Model, 0, len(removed))
+	for _, b := range removed {
+		sets = append(sets, b.Changes())
+	}
+	GetRegistry().ClearPeriodicTicksFor(p.ctx, characterId, sets...)
+	markBerserkDirtyOnMaxHpChange(p.l, p.ctx, characterId, sets...)
+	return nil
+}
+
 // UpdateStatValue applies a stat-value mutation to an existing buff — or, with
 // u.CreateIfMissing, creates the buff — and emits the matching status event: a
 // created buff announces APPLIED (it is a new buff, carrying its own
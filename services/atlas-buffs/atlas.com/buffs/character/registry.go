@@ -23,7 +23,10 @@ var ErrNotFound = errors.New("not found")
 type Registry struct {
 	characters    *atlas.TenantRegistry[uint32, Model]
 	periodicTicks *atlas.TenantRegistry[TickKey, time.Time]
-	tenants       *atlas.Set
+	// relog holds the buffs a logged-out character may get back, for the
+	// grace window only (Suspend / Resume).
+	relog   *atlas.TenantRegistry[uint32, Model]
+	tenants *atlas.Set
 }
 
 var registry *Registry
@@ -36,6 +39,9 @@ func InitRegistry(client *goredis.Client) {
 		periodicTicks: atlas.NewTenantRegistry[TickKey, time.Time](client, "buffs-tick", func(k TickKey) string {
 			return strconv.FormatUint(uint64(k.CharacterId), 10) + ":" + k.StatType
 		}),
+		relog: atlas.NewTenantRegistry[uint32, Model](client, "buffs-relog", func(k uint32) string {
+			return strconv.FormatUint(uint64(k), 10)
+		}),
 		tenants: atlas.NewSet(client, "buffs:_tenants"),
 	}
 }
@@ -67,7 +73,7 @@ func statKey(sourceId int32, statType string) string {
 // (sourceId, statType), each with its own expiry; other stats of the same source
 // are left intact, so the source's buffs accumulate one-at-a-time. Returns one
 // buff per change.
-func (r *Registry) Apply(ctx context.Context, worldId world.Id, channelId channel.Id, characterId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string) ([]buff.Model, error) {
+func (r *Registry) Apply(ctx context.Context, worldId world.Id, channelId channel.Id, characterId uint32, sourceId int32, level byte, duration int32, changes []stat.Model, accumulate bool, noExpiry bool, correlationId string, source buff.Source) ([]buff.Model, error) {
 	t := tenant.MustFromContext(ctx)
 
 	m, err := r.characters.Get(ctx, t, characterId)
@@ -83,10 +89,17 @@ func (r *Registry) Apply(ctx context.Context, worldId world.Id, channelId channe
 	}
 
 	newBuff := func(cs []stat.Model) (buff.Model, error) {
+		var b buff.Model
+		var err error
 		if noExpiry {
-			return buff.NewNoExpiryBuff(sourceId, level, cs, correlationId)
+			b, err = buff.NewNoExpiryBuff(sourceId, level, cs, correlationId)
+		} else {
+			b, err = buff.NewBuff(sourceId, level, duration, cs, correlationId)
 		}
-		return buff.NewBuff(sourceId, level, duration, cs, correlationId)
+		if err != nil {
+			return buff.Model{}, err
+		}
+		return b.WithSource(source), nil
 	}
 
 	var applied []buff.Model
@@ -316,6 +329,140 @@ func (r *Registry) CancelByCorrelation(ctx context.Context, characterId uint32,
 	return cancelled, nil
 }
 
+// CancelUnless removes every buff on one character that keep rejects and
+// returns them (caller emits EXPIRED events). Same read-modify-write shape as
+// CancelByStatTypes, matching on a predicate instead of stat type.
+func (r *Registry) CancelUnless(ctx context.Context, characterId uint32, keep func(buff.Model) bool) ([]buff.Model, error) {
+	t := tenant.MustFromContext(ctx)
+
+	m, err := r.characters.Get(ctx, t, characterId)
+	if errors.Is(err, atlas.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := make([]buff.Model, 0)
+	kept := make(map[string]buff.Model)
+	for id, b := range m.buffs {
+		if keep(b) {
+			kept[id] = b
+		} else {
+			cancelled = append(cancelled, b)
+		}
+	}
+
+	if len(cancelled) == 0 {
+		return nil, nil
+	}
+
+	m.buffs = kept
+	if err := r.characters.Put(ctx, t, characterId, m); err != nil {
+		return nil, err
+	}
+	return cancelled, nil
+}
+
+// Suspend takes a logged-out character off the live registry, so the
+// expiration and periodic sweeps stop touching it. Every buff that has not
+// expired and that keep returns true for is saved in a relog snapshot, under
+// its original key and with its original expiry. The snapshot lives for ttl;
+// a Resume inside that window puts those buffs back on the character with
+// the time they have left. Returns the saved buffs and the dropped ones —
+// rejected by keep or already lapsed — so the caller can emit EXPIRED for
+// the latter. A character with no buffs suspends nothing.
+func (r *Registry) Suspend(ctx context.Context, characterId uint32, keep func(buff.Model) bool, ttl time.Duration) (suspended []buff.Model, dropped []buff.Model, err error) {
+	t := tenant.MustFromContext(ctx)
+
+	m, err := r.characters.Get(ctx, t, characterId)
+	if errors.Is(err, atlas.ErrNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kept := make(map[string]buff.Model)
+	for id, b := range m.buffs {
+		if !b.Expired() && keep(b) {
+			kept[id] = b
+			suspended = append(suspended, b)
+		} else {
+			dropped = append(dropped, b)
+		}
+	}
+
+	if len(kept) > 0 {
+		m.buffs = kept
+		if err := r.relog.PutWithTTL(ctx, t, characterId, m, ttl); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := r.characters.Remove(ctx, t, characterId); err != nil {
+		return nil, nil, err
+	}
+	return suspended, dropped, nil
+}
+
+// Resume restores the buffs a character was suspended with, provided the
+// snapshot is still inside its grace window, and returns them (caller emits
+// APPLIED events). Each buff keeps its original expiresAt, so it comes back
+// with the time it has left; one that lapsed while the character was away is
+// discarded. A buff granted since the login — already on the live record
+// under the same key — is newer and wins. The snapshot is consumed either
+// way, so a second login restores nothing.
+func (r *Registry) Resume(ctx context.Context, worldId world.Id, channelId channel.Id, characterId uint32) ([]buff.Model, error) {
+	t := tenant.MustFromContext(ctx)
+
+	s, err := r.relog.Get(ctx, t, characterId)
+	if errors.Is(err, atlas.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.relog.Remove(ctx, t, characterId); err != nil {
+		return nil, err
+	}
+
+	m, err := r.characters.Get(ctx, t, characterId)
+	if errors.Is(err, atlas.ErrNotFound) {
+		m, err = NewBuilder(worldId, channelId, characterId).Build()
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else {
+		m.channelId = channelId
+	}
+
+	restored := make([]buff.Model, 0)
+	for id, b := range s.buffs {
+		if b.Expired() {
+			continue
+		}
+		if _, ok := m.buffs[id]; ok {
+			continue
+		}
+		m.buffs[id] = b
+		restored = append(restored, b)
+	}
+
+	if len(restored) == 0 {
+		return nil, nil
+	}
+
+	if err := r.characters.Put(ctx, t, characterId, m); err != nil {
+		return nil, err
+	}
+	if tb, err := json.Marshal(&t); err == nil {
+		_ = r.tenants.Add(ctx, string(tb))
+	}
+	return restored, nil
+}
+
 func (r *Registry) HasImmunity(ctx context.Context, characterId uint32) bool {
 	t := tenant.MustFromContext(ctx)
 	m, err := r.characters.Get(ctx, t, characterId)
@@ -0,0 +1,49 @@
+package cashshop
+
+import (
+	"atlas-buffs/character"
+	consumer2 "atlas-buffs/kafka/consumer"
+	cashshop2 "atlas-buffs/kafka/message/cashshop"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("cash_shop_status_event")(cashshop2.EnvEventTopicStatus)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		var t string
+		t, _ = topic.EnvProvider(l)(cashshop2.EnvEventTopicStatus)()
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventCharacterEnter))); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// handleStatusEventCharacterEnter cancels the buffs that do not survive a
+// cash-shop visit. Nothing happens on exit: the surviving buffs never left
+// the live registry, and the return to the channel re-renders them like any
+// other session start.
+func handleStatusEventCharacterEnter(l logrus.FieldLogger, ctx context.Context, e cashshop2.StatusEvent[cashshop2.CharacterMovementBody]) {
+	if e.Type != cashshop2.StatusEventTypeCharacterEnter {
+		return
+	}
+	if err := character.NewProcessor(l, ctx).CancelForTransition(e.WorldId, e.Body.CharacterId, character.TransitionCashShop); err != nil {
+		l.WithError(err).Errorf("Unable to cancel cash-shop-bound buffs for character [%d].", e.Body.CharacterId)
+	}
+}
@@ -0,0 +1,73 @@
+package cashshop
+
+import (
+	"atlas-buffs/buff"
+	buffstat "atlas-buffs/buff/stat"
+	"atlas-buffs/character"
+	cashshop2 "atlas-buffs/kafka/message/cashshop"
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func setup(t *testing.T) context.Context {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	character.InitRegistry(goredis.NewClient(&goredis.Options{Addr: mr.Addr()}))
+	ten, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	assert.NoError(t, err)
+	return tenant.WithContext(context.Background(), ten)
+}
+
+func seed(t *testing.T, ctx context.Context, characterId uint32, sourceId int32, source buff.Source) {
+	t.Helper()
+	_, err := character.GetRegistry().Apply(ctx, world.Id(0), channel.Id(1), characterId, sourceId, byte(1),
+		60000, []buffstat.Model{buffstat.NewStat("WEAPON_ATTACK", 10)}, false, false, "", source)
+	assert.NoError(t, err)
+}
+
+func enter(characterId uint32, eventType string) cashshop2.StatusEvent[cashshop2.CharacterMovementBody] {
+	return cashshop2.StatusEvent[cashshop2.CharacterMovementBody]{
+		WorldId: world.Id(0), CharacterId: characterId, Type: eventType,
+		Body: cashshop2.CharacterMovementBody{CharacterId: characterId, ChannelId: channel.Id(1)},
+	}
+}
+
+func TestHandleCharacterEnterCancelsMapEffects(t *testing.T) {
+	ctx := setup(t)
+	characterId := uint32(4000)
+	seed(t, ctx, characterId, 2001001, buff.SourceSkill)
+	seed(t, ctx, characterId, 2022179, buff.SourceEvent)
+	seed(t, ctx, characterId, 2111003, buff.SourceMap)
+
+	handleStatusEventCharacterEnter(logrus.New(), ctx, enter(characterId, cashshop2.StatusEventTypeCharacterEnter))
+
+	m, err := character.GetRegistry().Get(ctx, characterId)
+	assert.NoError(t, err)
+	var ids []int32
+	for _, b := range m.Buffs() {
+		ids = append(ids, b.SourceId())
+	}
+	assert.ElementsMatch(t, []int32{2001001, 2022179}, ids)
+}
+
+func TestHandleCharacterEnterGuardsType(t *testing.T) {
+	ctx := setup(t)
+	characterId := uint32(4001)
+	seed(t, ctx, characterId, 2111003, buff.SourceMap)
+
+	handleStatusEventCharacterEnter(logrus.New(), ctx, enter(characterId, "CHARACTER_EXIT"))
+
+	m, err := character.GetRegistry().Get(ctx, characterId)
+	assert.NoError(t, err)
+	assert.Len(t, m.Buffs(), 1)
+}
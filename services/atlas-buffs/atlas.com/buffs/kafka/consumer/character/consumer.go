@@ -1,6 +1,7 @@
 package character
 
 import (
+	"atlas-buffs/buff"
 	"atlas-buffs/buff/stat"
 	"atlas-buffs/character"
 	consumer2 "atlas-buffs/kafka/consumer"
@@ -68,7 +69,7 @@ func handleApply(l logrus.FieldLogger, ctx context.Context, c character2.Command
 		statChanges = append(statChanges, stat.NewStat(cs.Type, cs.Amount))
 	}
 
-	if err := character.NewProcessor(l, ctx).Apply(c.WorldId, c.ChannelId, c.CharacterId, c.Body.FromId, c.Body.SourceId, c.Body.Level, c.Body.Duration, statChanges, c.Body.Accumulate, c.Body.NoExpiry, c.Body.CorrelationId); err != nil {
+	if err := character.NewProcessor(l, ctx).Apply(c.WorldId, c.ChannelId, c.CharacterId, c.Body.FromId, c.Body.SourceId, c.Body.Level, c.Body.Duration, statChanges, c.Body.Accumulate, c.Body.NoExpiry, c.Body.CorrelationId, buff.ResolveSource(c.Body.Source, c.Body.SourceId, c.Body.CorrelationId)); err != nil {
 		l.WithError(err).Errorf("Unable to apply buff [%d] to character [%d].", c.Body.SourceId, c.CharacterId)
 	}
 }
@@ -49,15 +49,26 @@ func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handl
 	}
 }
 
+// handleStatusEventLogin restores the buffs suspended at a logout inside the
+// relog grace window before berserk tracking evaluates, so a restored buff
+// is already in place when the login's state is read.
 func handleStatusEventLogin(l logrus.FieldLogger, ctx context.Context, e characterstatus2.StatusEvent[characterstatus2.StatusEventLoginBody]) {
 	if e.Type != characterstatus2.StatusEventTypeLogin {
 		return
 	}
+	if err := character.NewProcessor(l, ctx).Resume(e.WorldId, e.Body.ChannelId, e.CharacterId); err != nil {
+		l.WithError(err).Errorf("Unable to restore buffs for character [%d] at login.", e.CharacterId)
+	}
 	if err := berserk.NewProcessor(l, ctx).TrackOnLogin(e.WorldId, e.Body.ChannelId, e.CharacterId); err != nil {
 		l.WithError(err).Errorf("Unable to evaluate berserk tracking for character [%d] at login.", e.CharacterId)
 	}
 }
 
+// handleStatusEventLogout suspends the character's buffs for the relog grace
+// window: those the persistence policy keeps across a relog are snapshotted,
+// the rest expire. atlas-character only emits LOGOUT once a dropped session
+// has gone unclaimed, so a channel change or cash-shop return never gets
+// here.
 func handleStatusEventLogout(l logrus.FieldLogger, ctx context.Context, e characterstatus2.StatusEvent[characterstatus2.StatusEventLogoutBody]) {
 	if e.Type != characterstatus2.StatusEventTypeLogout {
 		return
@@ -65,6 +76,9 @@ func handleStatusEventLogout(l logrus.FieldLogger, ctx context.Context, e charac
 	if err := berserk.NewProcessor(l, ctx).Untrack(e.CharacterId); err != nil {
 		l.WithError(err).Errorf("Unable to untrack berserk for character [%d] at logout.", e.CharacterId)
 	}
+	if err := character.NewProcessor(l, ctx).Suspend(e.WorldId, e.CharacterId); err != nil {
+		l.WithError(err).Errorf("Unable to suspend buffs for character [%d] at logout.", e.CharacterId)
+	}
 }
 
 func handleStatusEventStatChanged(l logrus.FieldLogger, ctx context.Context, e characterstatus2.StatusEvent[characterstatus2.StatusEventStatChangedBody]) {
@@ -96,6 +110,9 @@ func handleStatusEventMapChanged(l logrus.FieldLogger, ctx context.Context, e ch
 	}
 }
 
+// handleStatusEventChannelChanged refreshes berserk tracking and cancels the
+// buffs that do not survive a channel change (map effects); each concern logs
+// and continues on its own error.
 func handleStatusEventChannelChanged(l logrus.FieldLogger, ctx context.Context, e characterstatus2.StatusEvent[characterstatus2.StatusEventChannelChangedBody]) {
 	if e.Type != characterstatus2.StatusEventTypeChannelChanged {
 		return
@@ -103,4 +120,7 @@ func handleStatusEventChannelChanged(l logrus.FieldLogger, ctx context.Context,
 	if err := berserk.NewProcessor(l, ctx).HandleTransfer(e.WorldId, e.Body.ChannelId, e.CharacterId); err != nil {
 		l.WithError(err).Errorf("Unable to process channel change for berserk tracking of character [%d].", e.CharacterId)
 	}
+	if err := character.NewProcessor(l, ctx).CancelForTransition(e.WorldId, e.CharacterId, character.TransitionChannelChange); err != nil {
+		l.WithError(err).Errorf("Unable to cancel channel-bound buffs for character [%d] on channel change.", e.CharacterId)
+	}
 }
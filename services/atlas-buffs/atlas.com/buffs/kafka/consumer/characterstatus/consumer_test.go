@@ -2,6 +2,7 @@ package characterstatus
 
 import (
 	"atlas-buffs/berserk"
+	"atlas-buffs/buff"
 	buffstat "atlas-buffs/buff/stat"
 	"atlas-buffs/character"
 	"context"
@@ -45,7 +46,7 @@ func tracked(t *testing.T, ctx context.Context, characterId uint32) {
 func seedBeacon(t *testing.T, ctx context.Context, characterId uint32) {
 	t.Helper()
 	_, err := character.GetRegistry().Apply(ctx, world.Id(0), channel.Id(0), characterId, int32(5211006), byte(1),
-		0, []buffstat.Model{buffstat.NewStat(string(charconst.TemporaryStatTypeHomingBeacon), 1000001)}, false, true, "")
+		0, []buffstat.Model{buffstat.NewStat(string(charconst.TemporaryStatTypeHomingBeacon), 1000001)}, false, true, "", "")
 	assert.NoError(t, err)
 }
 
@@ -175,7 +176,7 @@ func TestHandleMapChangedLeavesOtherBuffsForHomingBeacon(t *testing.T) {
 	characterId := uint32(2002)
 	seedBeacon(t, ctx, characterId)
 	_, err := character.GetRegistry().Apply(ctx, world.Id(0), channel.Id(0), characterId, int32(2001001), byte(5),
-		60000, []buffstat.Model{buffstat.NewStat("SPEED", 20)}, false, false, "")
+		60000, []buffstat.Model{buffstat.NewStat("SPEED", 20)}, false, false, "", "")
 	assert.NoError(t, err)
 
 	handleStatusEventMapChanged(l, ctx, characterstatus2.StatusEvent[characterstatus2.StatusEventMapChangedBody]{
@@ -189,3 +190,59 @@ func TestHandleMapChangedLeavesOtherBuffsForHomingBeacon(t *testing.T) {
 		assert.Equal(t, int32(2001001), b.SourceId())
 	}
 }
+
+func seedSourced(t *testing.T, ctx context.Context, characterId uint32, sourceId int32, source buff.Source) {
+	t.Helper()
+	_, err := character.GetRegistry().Apply(ctx, world.Id(0), channel.Id(1), characterId, sourceId, byte(1),
+		60000, []buffstat.Model{buffstat.NewStat("WEAPON_ATTACK", 10)}, false, false, "", source)
+	assert.NoError(t, err)
+}
+
+// A relog inside the grace window gets the character's own buffs back; the
+// map effect stays behind.
+func TestHandleLogoutThenLoginRestoresBuffs(t *testing.T) {
+	ctx := setup(t)
+	l := logrus.New()
+	characterId := uint32(2100)
+	seedSourced(t, ctx, characterId, 2001001, buff.SourceSkill)
+	seedSourced(t, ctx, characterId, 2111003, buff.SourceMap)
+
+	handleStatusEventLogout(l, ctx, characterstatus2.StatusEvent[characterstatus2.StatusEventLogoutBody]{
+		WorldId: world.Id(0), CharacterId: characterId, Type: characterstatus2.StatusEventTypeLogout,
+	})
+	_, err := character.GetRegistry().Get(ctx, characterId)
+	assert.ErrorIs(t, err, character.ErrNotFound)
+
+	handleStatusEventLogin(l, ctx, characterstatus2.StatusEvent[characterstatus2.StatusEventLoginBody]{
+		WorldId: world.Id(0), CharacterId: characterId, Type: characterstatus2.StatusEventTypeLogin,
+		Body: characterstatus2.StatusEventLoginBody{ChannelId: channel.Id(3)},
+	})
+
+	m, err := character.GetRegistry().Get(ctx, characterId)
+	assert.NoError(t, err)
+	assert.Equal(t, channel.Id(3), m.ChannelId())
+	assert.Len(t, m.Buffs(), 1)
+	for _, b := range m.Buffs() {
+		assert.Equal(t, int32(2001001), b.SourceId())
+	}
+}
+
+func TestHandleChannelChangedCancelsMapEffects(t *testing.T) {
+	ctx := setup(t)
+	l := logrus.New()
+	characterId := uint32(2101)
+	seedSourced(t, ctx, characterId, 2001001, buff.SourceSkill)
+	seedSourced(t, ctx, characterId, 2111003, buff.SourceMap)
+
+	handleStatusEventChannelChanged(l, ctx, characterstatus2.StatusEvent[characterstatus2.StatusEventChannelChangedBody]{
+		WorldId: world.Id(0), CharacterId: characterId, Type: characterstatus2.StatusEventTypeChannelChanged,
+		Body: characterstatus2.StatusEventChannelChangedBody{ChannelId: channel.Id(4)},
+	})
+
+	m, err := character.GetRegistry().Get(ctx, characterId)
+	assert.NoError(t, err)
+	assert.Len(t, m.Buffs(), 1)
+	for _, b := range m.Buffs() {
+		assert.Equal(t, int32(2001001), b.SourceId())
+	}
+}
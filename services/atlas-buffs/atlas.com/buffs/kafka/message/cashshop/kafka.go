@@ -0,0 +1,30 @@
+// Package cashshop mirrors the cash-shop movement events this service
+// consumes (source of truth:
+// services/atlas-channel/atlas.com/channel/kafka/message/cashshop/kafka.go).
+// Only CHARACTER_ENTER is consumed; the topic's other types are ignored by
+// the handler's type guard.
+package cashshop
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventTopicStatus           = "EVENT_TOPIC_CASH_SHOP_STATUS"
+	StatusEventTypeCharacterEnter = "CHARACTER_ENTER"
+)
+
+type StatusEvent[E any] struct {
+	WorldId     world.Id `json:"worldId"`
+	CharacterId uint32   `json:"characterId"`
+	Type        string   `json:"type"`
+	Body        E        `json:"body"`
+}
+
+type CharacterMovementBody struct {
+	CharacterId uint32     `json:"characterId"`
+	ChannelId   channel.Id `json:"channelId"`
+	MapId       _map.Id    `json:"mapId"`
+}
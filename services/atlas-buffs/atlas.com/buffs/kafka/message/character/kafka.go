@@ -75,6 +75,11 @@ type ApplyCommandBody struct {
 	// (FR-A12). Opaque to atlas-buffs. Optional — omitting it leaves every
 	// existing producer's bytes unchanged.
 	CorrelationId string `json:"correlationId,omitempty"`
+	// Source is the category of the granter: SKILL, ITEM, EVENT, MAP or
+	// ENTITLEMENT. It decides which transitions (channel change, cash shop,
+	// relog) the buff survives. Optional — when omitted atlas-buffs infers it
+	// from SourceId and CorrelationId; only a field effect must declare MAP.
+	Source string `json:"source,omitempty"`
 }
 
 type StatChange struct {
@@ -3,6 +3,7 @@ package main
 import (
 	"atlas-buffs/berserk"
 	"atlas-buffs/character"
+	cashshop2 "atlas-buffs/kafka/consumer/cashshop"
 	character2 "atlas-buffs/kafka/consumer/character"
 	characterstatus2 "atlas-buffs/kafka/consumer/characterstatus"
 	skillstatus2 "atlas-buffs/kafka/consumer/skillstatus"
@@ -65,6 +66,10 @@ func main() {
 	if err := skillstatus2.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	cashshop2.InitConsumers(l)(cmf)(consumerGroupId)
+	if err := cashshop2.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
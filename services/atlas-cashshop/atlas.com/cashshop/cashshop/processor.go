@@ -7,6 +7,7 @@ import (
 	"atlas-cashshop/character"
 	compartment2 "atlas-cashshop/character/compartment"
 	inventory2 "atlas-cashshop/character/inventory"
+	"atlas-cashshop/configuration"
 	dataPet "atlas-cashshop/data/pet"
 	"atlas-cashshop/kafka/message"
 	"atlas-cashshop/kafka/message/cashshop"
@@ -25,6 +26,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory/slot"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
 	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
@@ -35,6 +37,7 @@ var (
 	ErrInsufficientFunds    = errors.New("insufficient funds")
 	ErrMaxSlots             = errors.New("max slots")
 	ErrAssetAlreadyReserved = errors.New("asset already reserved")
+	ErrNotEquipSlotItem     = errors.New("not an equip slot expansion item")
 )
 
 // errPurchaseRejected is an internal sentinel used to abort the Purchase
@@ -50,6 +53,8 @@ type Processor interface {
 	PurchaseInventoryIncreaseByItemAndEmit(characterId uint32, currency uint32, serialNumber uint32) error
 	PurchaseInventoryIncreaseByTypeAndEmit(characterId uint32, currency uint32, inventoryType inventory.Type) error
 	PurchaseInventoryIncrease(mb *message.Buffer) func(characterId uint32, currency uint32, inventoryType inventory.Type, cost uint32, amount uint32) error
+	PurchaseEquipSlotExpansionByItemAndEmit(characterId uint32, currency uint32, serialNumber uint32) error
+	PurchaseEquipSlotExpansion(mb *message.Buffer) func(characterId uint32, currency uint32, expansion slot.Expansion, cost uint32, days uint32) error
 }
 
 type ProcessorImpl struct {
@@ -319,3 +324,75 @@ func (p *ProcessorImpl) PurchaseInventoryIncrease(mb *message.Buffer) func(chara
 		return nil
 	}
 }
+
+// PurchaseEquipSlotExpansionByItemAndEmit resolves the equip slot a cash item
+// unlocks from tenant configuration. The configured days take precedence over
+// the commodity's period.
+func (p *ProcessorImpl) PurchaseEquipSlotExpansionByItemAndEmit(characterId uint32, currency uint32, serialNumber uint32) error {
+	ci, err := p.comP.GetById(serialNumber)
+	if err != nil {
+		_ = producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvEventTopicStatus)(cashshop2.EquipSlotFailedStatusEventProvider(characterId, "UNKNOWN_ERROR"))
+		return err
+	}
+	esi, ok := configuration.GetEquipSlotItem(p.l, p.ctx, p.t.Id(), ci.ItemId())
+	if !ok {
+		p.l.Warnf("Character [%d] attempted to enable an equip slot with item [%d], which does not unlock one.", characterId, ci.ItemId())
+		_ = producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvEventTopicStatus)(cashshop2.EquipSlotFailedStatusEventProvider(characterId, "UNKNOWN_ERROR"))
+		return ErrNotEquipSlotItem
+	}
+	e, ok := slot.GetExpansionByType(slot.Type(esi.Slot))
+	if !ok {
+		p.l.Errorf("Equip slot item [%d] is configured for slot [%s], which is not an expansion slot.", ci.ItemId(), esi.Slot)
+		_ = producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvEventTopicStatus)(cashshop2.EquipSlotFailedStatusEventProvider(characterId, "UNKNOWN_ERROR"))
+		return ErrNotEquipSlotItem
+	}
+	days := esi.Days
+	if days == 0 {
+		days = ci.Period()
+	}
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
+			return NewProcessor(p.l, p.ctx, tx).PurchaseEquipSlotExpansion(buf)(characterId, currency, e, ci.Price(), days)
+		})
+	})
+}
+
+func (p *ProcessorImpl) PurchaseEquipSlotExpansion(mb *message.Buffer) func(characterId uint32, currency uint32, expansion slot.Expansion, cost uint32, days uint32) error {
+	return func(characterId uint32, currency uint32, expansion slot.Expansion, cost uint32, days uint32) error {
+		p.l.Debugf("Character [%d] attempting to purchase equip slot [%s] for [%d] days using currency [%d]. Cost is [%d].", characterId, expansion.Slot.Type, days, currency, cost)
+		errorCode := "UNKNOWN_ERROR"
+		txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+			c, err := p.chaP.GetById()(characterId)
+			if err != nil {
+				return err
+			}
+
+			w, err := p.walP.WithTransaction(tx).GetByAccountId(c.AccountId())
+			if err != nil {
+				return err
+			}
+			if w.Balance(currency) < cost {
+				errorCode = "NOT_ENOUGH_CASH"
+				return ErrInsufficientFunds
+			}
+			w = w.Purchase(currency, cost)
+
+			_, err = p.walP.WithTransaction(tx).Update(mb)(c.AccountId())(w.Credit())(w.Points())(w.Prepaid())
+			if err != nil {
+				return err
+			}
+			err = p.chaComP.EnableSlotExpansion(mb)(characterId, int16(expansion.Slot.Position), days)
+			if err != nil {
+				return err
+			}
+			return mb.Put(cashshop.EnvEventTopicStatus, cashshop2.EquipSlotEnabledStatusEventProvider(characterId, expansion.Index, uint16(days)))
+		})
+		if txErr != nil {
+			_ = producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvEventTopicStatus)(cashshop2.EquipSlotFailedStatusEventProvider(characterId, errorCode))
+			return txErr
+		}
+
+		p.l.Debugf("Character [%d] purchased equip slot [%s] for [%d] days.", characterId, expansion.Slot.Type, days)
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+package cashshop
+
+import (
+	"atlas-cashshop/kafka/message/cashshop"
+	characterCompartment "atlas-cashshop/kafka/message/character/compartment"
+	"atlas-cashshop/wallet"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	testlog "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	databasetest "github.com/Chronicle20/atlas/libs/atlas-database/databasetest"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+)
+
+// testPendantSlotItemId is a tenant-configured pendant slot expansion that
+// overrides the commodity's 30 day period with 7 days.
+const testPendantSlotItemId = uint32(5550001)
+
+func startEquipSlotConfigurationServer(t *testing.T, tenantId uuid.UUID) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"tenants","id":"%s","attributes":{"cashShop":{"equipSlots":{"items":[{"templateId":%d,"slot":"pendant2","days":7}]}}}}}`, tenantId, testPendantSlotItemId)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("CONFIGURATIONS_SERVICE_URL", srv.URL+"/api/")
+}
+
+func outboxEntriesForTopic(t *testing.T, db *gorm.DB, topic string) []outbox.Entity {
+	t.Helper()
+	var rows []outbox.Entity
+	require.NoError(t, db.Where("topic = ?", topic).Find(&rows).Error)
+	return rows
+}
+
+// TestPurchaseEquipSlotExpansionEnablesSlot proves a purchase debits the
+// wallet, commands atlas-inventory to unlock the configured slot for the
+// configured days, and announces the slot index and days to the channel.
+func TestPurchaseEquipSlotExpansionEnablesSlot(t *testing.T) {
+	db := purchaseTestDatabase(t)
+	tenantId := uuid.New()
+	accountId := uint32(500)
+	characterId := uint32(1000)
+	serialNumber := uint32(9201)
+	price := uint32(3000)
+
+	startEquipSlotConfigurationServer(t, tenantId)
+	startPurchaseCharacterServer(t, characterId, accountId)
+	startPurchaseCommodityServer(t, serialNumber, testPendantSlotItemId, price)
+	seedPurchaseWallet(t, db, tenantId, accountId, price+1000)
+
+	ctx := databasetest.TenantContext(tenantId)
+	l, _ := testlog.NewNullLogger()
+
+	require.NoError(t, NewProcessor(l, ctx, db).PurchaseEquipSlotExpansionByItemAndEmit(characterId, 1, serialNumber))
+
+	var w wallet.Entity
+	require.NoError(t, db.Where("account_id = ?", accountId).First(&w).Error)
+	require.Equal(t, uint32(1000), w.Credit)
+
+	commands := outboxEntriesForTopic(t, db, characterCompartment.EnvCommandTopic)
+	require.Len(t, commands, 1)
+	var cmd characterCompartment.Command[characterCompartment.EnableSlotExpansionCommandBody]
+	require.NoError(t, json.Unmarshal(commands[0].MessageValue, &cmd))
+	require.Equal(t, characterCompartment.CommandEnableSlotExpansion, cmd.Type)
+	require.Equal(t, int16(-59), cmd.Body.Slot)
+	require.Equal(t, uint32(7), cmd.Body.Days, "configured days override the commodity period")
+
+	var enabled *cashshop.StatusEvent[cashshop.EquipSlotEnabledBody]
+	for _, e := range purchaseOutboxEntries(t, db) {
+		var ev cashshop.StatusEvent[cashshop.EquipSlotEnabledBody]
+		require.NoError(t, json.Unmarshal(e.MessageValue, &ev))
+		if ev.Type == cashshop.StatusEventTypeEquipSlotEnabled {
+			enabled = &ev
+		}
+	}
+	require.NotNil(t, enabled)
+	require.Equal(t, uint16(0), enabled.Body.SlotIndex)
+	require.Equal(t, uint16(7), enabled.Body.Days)
+}
+
+// TestPurchaseEquipSlotExpansionInsufficientFunds proves a rejected purchase
+// writes nothing and reports NOT_ENOUGH_CASH on the direct producer path.
+func TestPurchaseEquipSlotExpansionInsufficientFunds(t *testing.T) {
+	db := purchaseTestDatabase(t)
+	tenantId := uuid.New()
+	accountId := uint32(500)
+	characterId := uint32(1000)
+	serialNumber := uint32(9202)
+
+	events := captureDirectPurchaseEvents(t)
+	startEquipSlotConfigurationServer(t, tenantId)
+	startPurchaseCharacterServer(t, characterId, accountId)
+	startPurchaseCommodityServer(t, serialNumber, testPendantSlotItemId, 3000)
+	seedPurchaseWallet(t, db, tenantId, accountId, 1)
+
+	ctx := databasetest.TenantContext(tenantId)
+	l, _ := testlog.NewNullLogger()
+
+	err := NewProcessor(l, ctx, db).PurchaseEquipSlotExpansionByItemAndEmit(characterId, 1, serialNumber)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	require.Len(t, outboxEntriesForTopic(t, db, characterCompartment.EnvCommandTopic), 0)
+
+	var failed []cashshop.StatusEvent[cashshop.EquipSlotFailedBody]
+	for _, m := range events.Messages(testPurchaseStatusTopic) {
+		var ev cashshop.StatusEvent[cashshop.EquipSlotFailedBody]
+		if err := json.Unmarshal(m.Value, &ev); err == nil && ev.Type == cashshop.StatusEventTypeEquipSlotFailed {
+			failed = append(failed, ev)
+		}
+	}
+	require.Len(t, failed, 1)
+	require.Equal(t, "NOT_ENOUGH_CASH", failed[0].Body.Error)
+}
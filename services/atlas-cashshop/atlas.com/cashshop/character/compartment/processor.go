@@ -14,6 +14,7 @@ import (
 
 type Processor interface {
 	IncreaseCapacity(mb *message.Buffer) func(characterId uint32, inventoryType inventory3.Type, amount uint32) error
+	EnableSlotExpansion(mb *message.Buffer) func(characterId uint32, slot int16, days uint32) error
 }
 
 type ProcessorImpl struct {
@@ -38,3 +39,9 @@ func (p *ProcessorImpl) IncreaseCapacity(mb *message.Buffer) func(characterId ui
 		return mb.Put(compartment.EnvCommandTopic, compartment2.IncreaseCapacityCommandProvider(characterId, byte(inventoryType), amount))
 	}
 }
+
+func (p *ProcessorImpl) EnableSlotExpansion(mb *message.Buffer) func(characterId uint32, slot int16, days uint32) error {
+	return func(characterId uint32, slot int16, days uint32) error {
+		return mb.Put(compartment.EnvCommandTopic, compartment2.EnableSlotExpansionCommandProvider(characterId, byte(inventory3.TypeValueEquip), slot, days))
+	}
+}
@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"atlas-cashshop/configuration/tenant"
+	"atlas-cashshop/configuration/tenant/cashshop/equipslots"
 	"context"
 	"sync"
 	"time"
@@ -101,3 +102,28 @@ func couponRateLimitFrom(cfg tenant.RestModel) (uint32, time.Duration) {
 	}
 	return attempts, window
 }
+
+// DefaultPendantSlotExpansionTemplateId is the stock pendant slot expansion
+// cash item. Like the surprise box default, it is only the fallback for a
+// tenant that has not configured its own equip slot items.
+const DefaultPendantSlotExpansionTemplateId = uint32(5550000)
+
+// GetEquipSlotItem returns the equip slot expansion configured for a cash
+// item template, if the template unlocks one.
+func GetEquipSlotItem(l logrus.FieldLogger, ctx context.Context, tenantId uuid.UUID, templateId uint32) (equipslots.ItemRestModel, bool) {
+	cfg, _ := GetTenantConfig(l, ctx, tenantId)
+	return equipSlotItemFrom(cfg, templateId)
+}
+
+func equipSlotItemFrom(cfg tenant.RestModel, templateId uint32) (equipslots.ItemRestModel, bool) {
+	items := cfg.CashShop.EquipSlots.Items
+	if len(items) == 0 {
+		items = []equipslots.ItemRestModel{{TemplateId: DefaultPendantSlotExpansionTemplateId, Slot: "pendant2"}}
+	}
+	for _, i := range items {
+		if i.TemplateId == templateId {
+			return i, true
+		}
+	}
+	return equipslots.ItemRestModel{}, false
+}
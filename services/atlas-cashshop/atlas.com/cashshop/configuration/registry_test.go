@@ -3,6 +3,7 @@ package configuration
 import (
 	"atlas-cashshop/configuration/tenant"
 	"atlas-cashshop/configuration/tenant/cashshop"
+	"atlas-cashshop/configuration/tenant/cashshop/equipslots"
 	"atlas-cashshop/configuration/tenant/cashshop/surprise"
 	"context"
 	"testing"
@@ -76,3 +77,25 @@ func TestGetCouponRateLimitRejectsZero(t *testing.T) {
 		t.Errorf("zero config must fall back to defaults, got %d / %v", attempts, window)
 	}
 }
+
+func TestEquipSlotItemDefaultsToPendantExpansion(t *testing.T) {
+	i, ok := equipSlotItemFrom(tenant.RestModel{}, DefaultPendantSlotExpansionTemplateId)
+	if !ok || i.Slot != "pendant2" || i.Days != 0 {
+		t.Fatalf("item = %+v, ok = %v, want pendant2 deferring to the commodity period", i, ok)
+	}
+	if _, ok = equipSlotItemFrom(tenant.RestModel{}, 5000000); ok {
+		t.Fatalf("unconfigured template should not unlock an equip slot")
+	}
+}
+
+func TestEquipSlotItemUsesConfiguredList(t *testing.T) {
+	cfg := tenant.RestModel{}
+	cfg.CashShop.EquipSlots.Items = []equipslots.ItemRestModel{{TemplateId: 5550001, Slot: "pendant2", Days: 7}}
+	i, ok := equipSlotItemFrom(cfg, 5550001)
+	if !ok || i.Days != 7 {
+		t.Fatalf("item = %+v, ok = %v, want configured 7 day item", i, ok)
+	}
+	if _, ok = equipSlotItemFrom(cfg, DefaultPendantSlotExpansionTemplateId); ok {
+		t.Fatalf("a configured list replaces the default")
+	}
+}
@@ -0,0 +1,15 @@
+package equipslots
+
+type RestModel struct {
+	Items []ItemRestModel `json:"items"`
+}
+
+// ItemRestModel maps an equip slot expansion cash item to the slot it unlocks.
+// Slot is a slot type from the atlas-constants slot table (e.g. "pendant2").
+// Days overrides the commodity's period; zero defers to the commodity, whose
+// own zero period unlocks the slot permanently.
+type ItemRestModel struct {
+	TemplateId uint32 `json:"templateId"`
+	Slot       string `json:"slot"`
+	Days       uint32 `json:"days"`
+}
@@ -3,6 +3,7 @@ package cashshop
 import (
 	"atlas-cashshop/configuration/tenant/cashshop/commodities"
 	"atlas-cashshop/configuration/tenant/cashshop/coupons"
+	"atlas-cashshop/configuration/tenant/cashshop/equipslots"
 	"atlas-cashshop/configuration/tenant/cashshop/surprise"
 )
 
@@ -10,4 +11,5 @@ type RestModel struct {
 	Commodities commodities.RestModel `json:"commodities"`
 	Surprise    surprise.RestModel    `json:"surprise"`
 	Coupons     coupons.RestModel     `json:"coupons"`
+	EquipSlots  equipslots.RestModel  `json:"equipSlots"`
 }
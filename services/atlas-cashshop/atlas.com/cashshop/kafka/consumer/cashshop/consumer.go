@@ -56,6 +56,9 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandRequestCharacterSlotIncreaseByItem(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandRequestEquipSlotEnableByItem(db)))); err != nil {
+				return err
+			}
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandExpire(db)))); err != nil {
 				return err
 			}
@@ -124,6 +127,15 @@ func handleCommandRequestCharacterSlotIncreaseByItem(db *gorm.DB) message.Handle
 	}
 }
 
+func handleCommandRequestEquipSlotEnableByItem(db *gorm.DB) message.Handler[cashshop.Command[cashshop.RequestEquipSlotEnableByItemCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c cashshop.Command[cashshop.RequestEquipSlotEnableByItemCommandBody]) {
+		if c.Type != cashshop.CommandTypeRequestEquipSlotEnableByItem {
+			return
+		}
+		_ = cashshop3.NewProcessor(l, ctx, db).PurchaseEquipSlotExpansionByItemAndEmit(c.CharacterId, c.Body.Currency, c.Body.SerialNumber)
+	}
+}
+
 func handleCommandExpire(db *gorm.DB) message.Handler[cashshop.Command[cashshop.ExpireCommandBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, c cashshop.Command[cashshop.ExpireCommandBody]) {
 		if c.Type != cashshop.CommandTypeExpire {
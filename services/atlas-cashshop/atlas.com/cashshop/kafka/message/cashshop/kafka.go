@@ -14,6 +14,7 @@ const (
 	CommandTypeRequestStorageIncrease             = "REQUEST_STORAGE_INCREASE"
 	CommandTypeRequestStorageIncreaseByItem       = "REQUEST_STORAGE_INCREASE_BY_ITEM"
 	CommandTypeRequestCharacterSlotIncreaseByItem = "REQUEST_CHARACTER_SLOT_INCREASE_BY_ITEM"
+	CommandTypeRequestEquipSlotEnableByItem       = "REQUEST_EQUIP_SLOT_ENABLE_BY_ITEM"
 	CommandTypeExpire                             = "EXPIRE"
 	CommandTypeOpenSurprise                       = "OPEN_SURPRISE"
 	CommandTypeRequestCouponRedemption            = "REQUEST_COUPON_REDEMPTION"
@@ -61,6 +62,11 @@ type RequestCharacterSlotIncreaseByItemCommandBody struct {
 	SerialNumber uint32 `json:"serialNumber"`
 }
 
+type RequestEquipSlotEnableByItemCommandBody struct {
+	Currency     uint32 `json:"currency"`
+	SerialNumber uint32 `json:"serialNumber"`
+}
+
 // OpenSurpriseCommandBody opens one Cash Shop Surprise box. TransactionId is
 // minted by atlas-channel per click and is the idempotency key: a Kafka
 // redelivery replays the same id (and is rejected by the openings ledger)
@@ -94,6 +100,8 @@ const (
 	StatusEventTypeSurpriseFailed             = "SURPRISE_FAILED"
 	StatusEventTypeCouponRedeemed             = "COUPON_REDEEMED"
 	StatusEventTypeCouponFailed               = "COUPON_FAILED"
+	StatusEventTypeEquipSlotEnabled           = "EQUIP_SLOT_ENABLED"
+	StatusEventTypeEquipSlotFailed            = "EQUIP_SLOT_FAILED"
 )
 
 type StatusEvent[E any] struct {
@@ -159,6 +167,21 @@ type CouponFailedBody struct {
 	Error string `json:"error"`
 }
 
+// EquipSlotEnabledBody carries the ENABLE_EQUIP_SLOT_EXT_SUCCESS arm. SlotIndex
+// is the expansion's index in the client's extended equip slot list; Days is
+// zero for a permanent expansion.
+type EquipSlotEnabledBody struct {
+	SlotIndex uint16 `json:"slotIndex"`
+	Days      uint16 `json:"days"`
+}
+
+// EquipSlotFailedBody is a distinct event for the same reason as
+// CouponFailedBody: the failure goes out on the ENABLE_EQUIP_SLOT_EXT_FAILED
+// arm, not the inventory capacity failure the ERROR handler announces.
+type EquipSlotFailedBody struct {
+	Error string `json:"error"`
+}
+
 // ExpireCommandBody contains the data for expiring a cash shop item
 type ExpireCommandBody struct {
 	AccountId      uint32   `json:"accountId"`
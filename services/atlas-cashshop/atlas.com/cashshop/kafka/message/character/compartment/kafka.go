@@ -1,8 +1,9 @@
 package compartment
 
 const (
-	EnvCommandTopic         = "COMMAND_TOPIC_COMPARTMENT"
-	CommandIncreaseCapacity = "INCREASE_CAPACITY"
+	EnvCommandTopic            = "COMMAND_TOPIC_COMPARTMENT"
+	CommandIncreaseCapacity    = "INCREASE_CAPACITY"
+	CommandEnableSlotExpansion = "ENABLE_SLOT_EXPANSION"
 )
 
 type Command[E any] struct {
@@ -15,3 +16,8 @@ type Command[E any] struct {
 type IncreaseCapacityCommandBody struct {
 	Amount uint32 `json:"amount"`
 }
+
+type EnableSlotExpansionCommandBody struct {
+	Slot int16  `json:"slot"`
+	Days uint32 `json:"days"`
+}
@@ -81,3 +81,28 @@ func SurpriseFailedStatusEventProvider(characterId uint32, reason string) model.
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func EquipSlotEnabledStatusEventProvider(characterId uint32, slotIndex uint16, days uint16) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &cashshop.StatusEvent[cashshop.EquipSlotEnabledBody]{
+		CharacterId: characterId,
+		Type:        cashshop.StatusEventTypeEquipSlotEnabled,
+		Body: cashshop.EquipSlotEnabledBody{
+			SlotIndex: slotIndex,
+			Days:      days,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func EquipSlotFailedStatusEventProvider(characterId uint32, error string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &cashshop.StatusEvent[cashshop.EquipSlotFailedBody]{
+		CharacterId: characterId,
+		Type:        cashshop.StatusEventTypeEquipSlotFailed,
+		Body: cashshop.EquipSlotFailedBody{
+			Error: error,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -21,3 +21,17 @@ func IncreaseCapacityCommandProvider(characterId uint32, inventoryType byte, amo
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func EnableSlotExpansionCommandProvider(characterId uint32, inventoryType byte, slot int16, days uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &compartment.Command[compartment.EnableSlotExpansionCommandBody]{
+		CharacterId:   characterId,
+		InventoryType: inventoryType,
+		Type:          compartment.CommandEnableSlotExpansion,
+		Body: compartment.EnableSlotExpansionCommandBody{
+			Slot: slot,
+			Days: days,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
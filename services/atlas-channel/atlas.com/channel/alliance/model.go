@@ -0,0 +1,74 @@
+package alliance
+
+import (
+	"atlas-channel/guild/member"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Model struct {
+	id       uint32
+	worldId  world.Id
+	name     string
+	notice   string
+	capacity uint32
+	leaderId uint32
+	titles   []string
+	guilds   []GuildModel
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) Name() string {
+	return m.name
+}
+
+func (m Model) Notice() string {
+	return m.notice
+}
+
+func (m Model) Capacity() uint32 {
+	return m.capacity
+}
+
+func (m Model) LeaderId() uint32 {
+	return m.leaderId
+}
+
+func (m Model) Titles() []string {
+	return m.titles
+}
+
+func (m Model) Guilds() []GuildModel {
+	return m.guilds
+}
+
+// GuildModel is a member guild as reported by its alliance.
+type GuildModel struct {
+	id       uint32
+	name     string
+	leaderId uint32
+	members  []member.Model
+}
+
+func (m GuildModel) Id() uint32 {
+	return m.id
+}
+
+func (m GuildModel) Name() string {
+	return m.name
+}
+
+func (m GuildModel) LeaderId() uint32 {
+	return m.leaderId
+}
+
+func (m GuildModel) Members() []member.Model {
+	return m.members
+}
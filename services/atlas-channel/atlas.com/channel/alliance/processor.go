@@ -0,0 +1,100 @@
+package alliance
+
+import (
+	"atlas-channel/guild/member"
+	alliance2 "atlas-channel/kafka/message/alliance"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+type Processor interface {
+	GetById(allianceId uint32) (Model, error)
+	GetMemberIds(allianceId uint32, filters []model.Filter[member.Model]) model.Provider[[]uint32]
+	RequestInvite(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) error
+	Leave(worldId world.Id, allianceId uint32, characterId uint32) error
+	Expel(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) error
+	ChangeNotice(worldId world.Id, allianceId uint32, characterId uint32, notice string) error
+	ChangeTitles(worldId world.Id, allianceId uint32, characterId uint32, titles []string) error
+	ChangeMemberTitle(worldId world.Id, allianceId uint32, characterId uint32, targetId uint32, title byte) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	p := &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+	return p
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetById(allianceId uint32) (Model, error) {
+	return requests.Provider[RestModel, Model](p.l, p.ctx)(requestById(p.ctx, allianceId), Extract)()
+}
+
+// GetMemberIds lists the characters of every guild in the alliance that pass
+// all filters.
+func (p *ProcessorImpl) GetMemberIds(allianceId uint32, filters []model.Filter[member.Model]) model.Provider[[]uint32] {
+	a, err := p.GetById(allianceId)
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to retrieve alliance [%d].", allianceId)
+		return model.ErrorProvider[[]uint32](err)
+	}
+	ids := make([]uint32, 0)
+	for _, g := range a.Guilds() {
+		for _, m := range g.Members() {
+			ok := true
+			for _, f := range filters {
+				if !f(m) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				ids = append(ids, m.CharacterId())
+			}
+		}
+	}
+	return model.FixedProvider(ids)
+}
+
+func (p *ProcessorImpl) RequestInvite(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) error {
+	p.l.Debugf("Character [%d] inviting guild [%d] to alliance [%d].", characterId, guildId, allianceId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(RequestInviteProvider(worldId, allianceId, characterId, guildId))
+}
+
+func (p *ProcessorImpl) Leave(worldId world.Id, allianceId uint32, characterId uint32) error {
+	p.l.Debugf("Character [%d] withdrawing their guild from alliance [%d].", characterId, allianceId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(LeaveProvider(worldId, allianceId, characterId))
+}
+
+func (p *ProcessorImpl) Expel(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) error {
+	p.l.Debugf("Character [%d] expelling guild [%d] from alliance [%d].", characterId, guildId, allianceId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(ExpelProvider(worldId, allianceId, characterId, guildId))
+}
+
+func (p *ProcessorImpl) ChangeNotice(worldId world.Id, allianceId uint32, characterId uint32, notice string) error {
+	p.l.Debugf("Character [%d] changing the notice of alliance [%d].", characterId, allianceId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(ChangeNoticeProvider(worldId, allianceId, characterId, notice))
+}
+
+func (p *ProcessorImpl) ChangeTitles(worldId world.Id, allianceId uint32, characterId uint32, titles []string) error {
+	p.l.Debugf("Character [%d] changing the titles of alliance [%d].", characterId, allianceId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(ChangeTitlesProvider(worldId, allianceId, characterId, titles))
+}
+
+func (p *ProcessorImpl) ChangeMemberTitle(worldId world.Id, allianceId uint32, characterId uint32, targetId uint32, title byte) error {
+	p.l.Debugf("Character [%d] changing the alliance [%d] title of [%d] to [%d].", characterId, allianceId, targetId, title)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance2.EnvCommandTopic)(ChangeMemberTitleProvider(worldId, allianceId, characterId, targetId, title))
+}
@@ -0,0 +1,101 @@
+package alliance
+
+import (
+	alliance2 "atlas-channel/kafka/message/alliance"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func RequestInviteProvider(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.RequestInviteBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeRequestInvite,
+		Body: alliance2.RequestInviteBody{
+			GuildId: guildId,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func LeaveProvider(worldId world.Id, allianceId uint32, characterId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.LeaveBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeLeave,
+		Body:          alliance2.LeaveBody{},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func ExpelProvider(worldId world.Id, allianceId uint32, characterId uint32, guildId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.ExpelBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeExpel,
+		Body: alliance2.ExpelBody{
+			GuildId: guildId,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func ChangeNoticeProvider(worldId world.Id, allianceId uint32, characterId uint32, notice string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.ChangeNoticeBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeChangeNotice,
+		Body: alliance2.ChangeNoticeBody{
+			Notice: notice,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func ChangeTitlesProvider(worldId world.Id, allianceId uint32, characterId uint32, titles []string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.ChangeTitlesBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeChangeTitles,
+		Body: alliance2.ChangeTitlesBody{
+			Titles: titles,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func ChangeMemberTitleProvider(worldId world.Id, allianceId uint32, characterId uint32, targetId uint32, title byte) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.Command[alliance2.ChangeMemberTitleBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		AllianceId:    allianceId,
+		Type:          alliance2.CommandTypeChangeMemberTitle,
+		Body: alliance2.ChangeMemberTitleBody{
+			TargetId: targetId,
+			Title:    title,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
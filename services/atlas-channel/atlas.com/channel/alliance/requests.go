@@ -0,0 +1,25 @@
+package alliance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const (
+	Resource = "alliances"
+	ById     = Resource + "/%d"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "ALLIANCES")
+}
+
+func requestById(ctx context.Context, id uint32) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.GetRequest[RestModel](fmt.Sprintf(root+ById, id))
+}
@@ -0,0 +1,74 @@
+package alliance
+
+import (
+	"atlas-channel/guild/member"
+	"strconv"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+type RestModel struct {
+	Id       uint32           `json:"-"`
+	WorldId  world.Id         `json:"worldId"`
+	Name     string           `json:"name"`
+	Notice   string           `json:"notice"`
+	Capacity uint32           `json:"capacity"`
+	LeaderId uint32           `json:"leaderId"`
+	Titles   []string         `json:"titles"`
+	Guilds   []GuildRestModel `json:"guilds"`
+}
+
+type GuildRestModel struct {
+	Id       uint32             `json:"id"`
+	Name     string             `json:"name"`
+	LeaderId uint32             `json:"leaderId"`
+	Members  []member.RestModel `json:"members"`
+}
+
+func (r RestModel) GetName() string {
+	return "alliances"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+func Extract(rm RestModel) (Model, error) {
+	guilds, err := model.SliceMap(ExtractGuild)(model.FixedProvider(rm.Guilds))()()
+	if err != nil {
+		return Model{}, err
+	}
+	return Model{
+		id:       rm.Id,
+		worldId:  rm.WorldId,
+		name:     rm.Name,
+		notice:   rm.Notice,
+		capacity: rm.Capacity,
+		leaderId: rm.LeaderId,
+		titles:   rm.Titles,
+		guilds:   guilds,
+	}, nil
+}
+
+func ExtractGuild(rm GuildRestModel) (GuildModel, error) {
+	members, err := model.SliceMap(member.Extract)(model.FixedProvider(rm.Members))()()
+	if err != nil {
+		return GuildModel{}, err
+	}
+	return GuildModel{
+		id:       rm.Id,
+		name:     rm.Name,
+		leaderId: rm.LeaderId,
+		members:  members,
+	}, nil
+}
@@ -265,7 +265,7 @@ func (p *ProcessorImpl) breakShip(f field.Model, characterId uint32, skillLevel
 			p.l.WithError(err).Errorf("Battleship break: unable to apply cooldown for character [%d].", characterId)
 		}
 	}
-	p.l.Debugf("Battleship broke for character [%d]: dismounted, cooldown [%d]s.", characterId, cooldown)
+	p.l.Debugf("Battleship broke for character [%d]: dismounted, cooldown [%d]ms.", characterId, cooldown)
 }
 
 func (p *ProcessorImpl) Clear(characterId uint32) {
@@ -124,7 +124,7 @@ func setupProcessor(t *testing.T) (Processor, *fakeStore, *breakRecorder, tenant
 		return nil
 	}
 	effectFunc = func(_ logrus.FieldLogger, _ context.Context, _ byte) (effect.Model, error) {
-		return effect.Extract(effect.RestModel{Cooldown: 90})
+		return effect.Extract(effect.RestModel{Cooldown: 90000})
 	}
 	characterLevelFunc = func(_ logrus.FieldLogger, _ context.Context, _ uint32) (byte, error) {
 		return 150, nil
@@ -247,8 +247,8 @@ func TestDrainLazyReinitOverkillBreaks(t *testing.T) {
 	if res.Status != DrainBroke {
 		t.Fatalf("Status = %v, want DrainBroke", res.Status)
 	}
-	if rec.cancels != 1 || len(rec.cooldowns) != 1 || rec.cooldowns[0] != 90 {
-		t.Fatalf("break side effects = cancels %d cooldowns %v, want 1/[90]", rec.cancels, rec.cooldowns)
+	if rec.cancels != 1 || len(rec.cooldowns) != 1 || rec.cooldowns[0] != 90000 {
+		t.Fatalf("break side effects = cancels %d cooldowns %v, want 1/[90000]", rec.cancels, rec.cooldowns)
 	}
 	if _, ok := fs.values[fs.k(tm, "100")]; ok {
 		t.Fatal("ship state must be cleared on break")
@@ -24,6 +24,7 @@ type Processor interface {
 	RequestStorageIncreasePurchase(characterId uint32, isPoints bool, currency uint32) error
 	RequestStorageIncreasePurchaseByItem(characterId uint32, isPoints bool, currency uint32, serialNumber uint32) error
 	RequestCharacterSlotIncreasePurchaseByItem(characterId uint32, isPoints bool, currency uint32, serialNumber uint32) error
+	RequestEquipSlotEnablePurchaseByItem(characterId uint32, isPoints bool, currency uint32, serialNumber uint32) error
 	RequestPurchase(characterId uint32, serialNumber uint32, isPoints bool, currency uint32, zero uint32, transactionId uuid.UUID) error
 	RequestCouponRedemption(characterId uint32, code string) error
 	MoveFromCashInventory(accountId uint32, characterId uint32, serialNumber uint64, inventoryType byte, slot int16) error
@@ -95,6 +96,11 @@ func (p *ProcessorImpl) RequestCharacterSlotIncreasePurchaseByItem(characterId u
 	return producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvCommandTopic)(RequestCharacterSlotIncreaseByItemCommandProvider(characterId, currency, serialNumber))
 }
 
+func (p *ProcessorImpl) RequestEquipSlotEnablePurchaseByItem(characterId uint32, _ bool, currency uint32, serialNumber uint32) error {
+	p.l.Debugf("Character [%d] purchasing equip slot expansion via item [%d] using currency [%d]", characterId, serialNumber, currency)
+	return producer.ProviderImpl(p.l)(p.ctx)(cashshop.EnvCommandTopic)(RequestEquipSlotEnableByItemCommandProvider(characterId, currency, serialNumber))
+}
+
 func (p *ProcessorImpl) RequestPurchase(characterId uint32, serialNumber uint32, isPoints bool, currency uint32, zero uint32, transactionId uuid.UUID) error {
 	currency = resolvePurchaseCurrency(isPoints, currency)
 	p.l.Debugf("Character [%d] purchasing [%d] with currency [%d], zero [%d], transaction [%s]", characterId, serialNumber, currency, zero, transactionId)
@@ -137,6 +137,19 @@ func RequestCharacterSlotIncreaseByItemCommandProvider(characterId uint32, curre
 	return producer.SingleMessageProvider(key, value)
 }
 
+func RequestEquipSlotEnableByItemCommandProvider(characterId uint32, currency uint32, serialNumber uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &cashshop.Command[cashshop.RequestEquipSlotEnableByItemCommandBody]{
+		CharacterId: characterId,
+		Type:        cashshop.CommandTypeRequestEquipSlotEnableByItem,
+		Body: cashshop.RequestEquipSlotEnableByItemCommandBody{
+			Currency:     currency,
+			SerialNumber: serialNumber,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 func OpenSurpriseCommandProvider(characterId uint32, transactionId uuid.UUID, accountId uint32, cashId int64) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(characterId))
 	value := &cashshop.Command[cashshop.OpenSurpriseCommandBody]{
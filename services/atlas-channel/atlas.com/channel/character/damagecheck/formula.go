@@ -0,0 +1,238 @@
+// Package damagecheck bounds client-reported attack damage server-side.
+//
+// The attack packet carries every damage line already rolled by the client,
+// and until this package the channel applied them verbatim — only reflect
+// math was clamped. Here each line is compared against a theoretical range
+// computed from the caster's buff-inclusive effective stats, weapon type,
+// skill effect, and the target's level and defense. Lines above the
+// tolerated ceiling are clamped; grossly impossible entries are rejected
+// outright. Every violation feeds the per-session suspicion score
+// (registry.go) that files detection reports with atlas-ban.
+//
+// The range is deliberately an OVER-estimate. Critical hits, elemental
+// weakness and combo/charge amplifiers are not modelled individually; they
+// are folded into Tolerance. A false clamp costs a legitimate player damage,
+// a missed clamp costs nothing that the suspicion score will not catch on
+// the next hundred hits.
+package damagecheck
+
+import (
+	"math"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
+)
+
+const (
+	// Tolerance scales the theoretical maximum into the clamp ceiling. It
+	// covers Sharp Eyes criticals (up to 2.4x), elemental weakness (1.5x)
+	// and the amplifier buffs effective stats do not fold in (Combo, charge
+	// elements), with headroom for WZ data drift between versions.
+	Tolerance = 4.0
+
+	// RejectFactor scales the clamp ceiling into the rejection ceiling. A
+	// line beyond it is not a lucky roll — the whole entry is discarded.
+	RejectFactor = 2.0
+
+	// LineCap is the client's own per-line damage cap. No unmodified client
+	// can send a line above it, regardless of stats.
+	LineCap = 199999
+
+	// defaultMastery is the weapon mastery floor (percent) before any
+	// mastery passive is learned.
+	defaultMastery = 10
+)
+
+// Input is everything the range formula reads. Stats are buff-inclusive
+// (atlas-effective-stats); MonsterDefense is PDD for physical attacks and
+// MDD for magic ones.
+type Input struct {
+	Magic          bool
+	WeaponType     item.WeaponType
+	Level          byte
+	Strength       uint32
+	Dexterity      uint32
+	Luck           uint32
+	Intelligence   uint32
+	WeaponAttack   uint32
+	MagicAttack    uint32
+	SkillDamage    uint32 // WZ `damage` percent; 0 means 100
+	SpellAttack    int16  // WZ `mad` on attack spells
+	Mastery        int32  // WZ `mastery` percent; 0 means defaultMastery
+	MonsterLevel   uint32
+	MonsterDefense uint32
+}
+
+// Range is the theoretical per-line damage range of one attack. An Exact
+// range is a fixed-damage hit (skill `fixdamage`, monster `fixedDamage`):
+// no roll, no amplifier, so no tolerance either.
+type Range struct {
+	Min   uint32
+	Max   uint32
+	Exact bool
+}
+
+// Fixed returns the exact range of a fixed-damage hit.
+func Fixed(v uint32) Range {
+	return Range{Min: v, Max: v, Exact: true}
+}
+
+// Compute returns the theoretical per-line range for in. ok is false when
+// the inputs cannot bound the attack (no weapon, missing stats, a magic
+// attack without spell attack) — callers must then skip validation rather
+// than clamp against a meaningless zero.
+func Compute(in Input) (Range, bool) {
+	mastery := float64(in.Mastery)
+	if mastery <= 0 {
+		mastery = defaultMastery
+	}
+	mastery = math.Min(mastery, 100) / 100
+
+	var lo, hi float64
+	if in.Magic {
+		if in.MagicAttack == 0 || in.SpellAttack <= 0 {
+			return Range{}, false
+		}
+		m := float64(in.MagicAttack)
+		spell := float64(in.SpellAttack)
+		in2 := float64(in.Intelligence) / 200
+		hi = ((m*m)/1000 + m) / 30
+		lo = ((m*m)/1000 + m*mastery*0.9) / 30
+		hi = (hi + in2) * spell
+		lo = (lo + in2) * spell
+	} else {
+		mult, primary, secondary, ok := weaponFactors(in)
+		if !ok || in.WeaponAttack == 0 {
+			return Range{}, false
+		}
+		watk := float64(in.WeaponAttack) / 100
+		hi = (primary*mult + secondary) * watk
+		lo = (primary*mult*0.9*mastery + secondary) * watk
+		if in.SkillDamage > 0 {
+			hi *= float64(in.SkillDamage) / 100
+			lo *= float64(in.SkillDamage) / 100
+		}
+	}
+
+	// Defense: the classic formula subtracts 50–60% of the monster's
+	// defense; the ceiling uses the lighter reduction, the floor the heavier.
+	hi -= float64(in.MonsterDefense) * 0.5
+	lo -= float64(in.MonsterDefense) * 0.6
+
+	// Level penalty: 1% per level the monster outranks the attacker.
+	if in.MonsterLevel > uint32(in.Level) {
+		penalty := math.Max(0, 1-float64(in.MonsterLevel-uint32(in.Level))/100)
+		hi *= penalty
+		lo *= penalty
+	}
+
+	r := Range{Min: clampLine(lo), Max: clampLine(hi)}
+	if r.Max < 1 {
+		r.Max = 1
+	}
+	if r.Min > r.Max {
+		r.Min = r.Max
+	}
+	return r, true
+}
+
+// Ceiling is the largest line accepted unchanged for r.
+func (r Range) Ceiling() uint32 {
+	if r.Exact {
+		return r.Max
+	}
+	return clampLine(float64(r.Max) * Tolerance)
+}
+
+// weaponFactors returns the weapon multiplier and the primary/secondary
+// stat for a physical attack. Multipliers are the per-type maximum (swing
+// vs. stab) so the ceiling never under-shoots a legitimate swing.
+func weaponFactors(in Input) (mult, primary, secondary float64, ok bool) {
+	str, dex, luk := float64(in.Strength), float64(in.Dexterity), float64(in.Luck)
+	switch in.WeaponType {
+	case item.WeaponTypeOneHandedSword:
+		return 4.0, str, dex, true
+	case item.WeaponTypeOneHandedAxe, item.WeaponTypeOneHandedMace:
+		return 4.4, str, dex, true
+	case item.WeaponTypeTwoHandedSword:
+		return 4.6, str, dex, true
+	case item.WeaponTypeTwoHandedAxe, item.WeaponTypeTwoHandedMace:
+		return 4.8, str, dex, true
+	case item.WeaponTypeSpear, item.WeaponTypePolearm:
+		return 5.0, str, dex, true
+	case item.WeaponTypeKnuckle:
+		return 4.8, str, dex, true
+	case item.WeaponTypeDagger:
+		return 3.6, luk, str + dex, true
+	case item.WeaponTypeClaw:
+		return 3.6, luk, str + dex, true
+	case item.WeaponTypeBow:
+		return 3.4, dex, str, true
+	case item.WeaponTypeCrossbow, item.WeaponTypeGun:
+		return 3.6, dex, str, true
+	case item.WeaponTypeWand, item.WeaponTypeStaff:
+		// Physical swing with a mage weapon.
+		return 1.0, str, dex, true
+	}
+	return 0, 0, 0, false
+}
+
+func clampLine(v float64) uint32 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= LineCap {
+		return LineCap
+	}
+	return uint32(v)
+}
+
+// Verdict classifies one damage entry against its range.
+type Verdict byte
+
+const (
+	VerdictOk Verdict = iota
+	VerdictClamped
+	VerdictRejected
+)
+
+// Result is the outcome of checking one entry's damage lines.
+type Result struct {
+	Verdict Verdict
+	Damages []uint32 // the lines to apply; all zero on VerdictRejected
+	Clamped int      // number of lines lowered to the ceiling
+	Ceiling uint32
+	Highest uint32 // highest line the client reported
+}
+
+// Check bounds damages against r. Lines above the ceiling are lowered to
+// it; a single line above the rejection ceiling (or the client's own line
+// cap) rejects the whole entry. damages is never mutated.
+func Check(damages []uint32, r Range) Result {
+	ceiling := r.Ceiling()
+	reject := uint64(float64(ceiling) * RejectFactor)
+	res := Result{Verdict: VerdictOk, Ceiling: ceiling}
+	out := make([]uint32, len(damages))
+	for i, d := range damages {
+		if d > res.Highest {
+			res.Highest = d
+		}
+		if d > LineCap || uint64(d) > reject {
+			res.Verdict = VerdictRejected
+		}
+		if d > ceiling {
+			out[i] = ceiling
+			res.Clamped++
+			continue
+		}
+		out[i] = d
+	}
+	if res.Verdict == VerdictRejected {
+		res.Damages = make([]uint32, len(damages))
+		return res
+	}
+	if res.Clamped > 0 {
+		res.Verdict = VerdictClamped
+	}
+	res.Damages = out
+	return res
+}
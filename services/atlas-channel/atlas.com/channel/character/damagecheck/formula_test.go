@@ -0,0 +1,122 @@
+package damagecheck
+
+import (
+	"testing"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
+)
+
+func warrior() Input {
+	return Input{
+		WeaponType:   item.WeaponTypeOneHandedSword,
+		Level:        50,
+		Strength:     200,
+		Dexterity:    50,
+		WeaponAttack: 100,
+	}
+}
+
+// TestComputePhysical pins the classic max formula:
+// (STR*4.0 + DEX) * WATK / 100 = (800 + 50) * 1 = 850.
+func TestComputePhysical(t *testing.T) {
+	r, ok := Compute(warrior())
+	if !ok {
+		t.Fatal("expected a bounded range")
+	}
+	if r.Max != 850 {
+		t.Errorf("Max=%d, want 850", r.Max)
+	}
+	if r.Min == 0 || r.Min >= r.Max {
+		t.Errorf("Min=%d, want 0 < Min < Max", r.Min)
+	}
+}
+
+func TestComputeSkillDamageDefenseAndLevel(t *testing.T) {
+	in := warrior()
+	in.SkillDamage = 200
+	r, _ := Compute(in)
+	if r.Max != 1700 {
+		t.Errorf("skill Max=%d, want 1700", r.Max)
+	}
+
+	in.MonsterDefense = 200 // -100 on the ceiling
+	r, _ = Compute(in)
+	if r.Max != 1600 {
+		t.Errorf("defense Max=%d, want 1600", r.Max)
+	}
+
+	in.MonsterLevel = 60 // 10 levels above: x0.9
+	r, _ = Compute(in)
+	if r.Max != 1440 {
+		t.Errorf("level Max=%d, want 1440", r.Max)
+	}
+}
+
+// TestComputeMagic pins ((MATK²/1000 + MATK)/30 + INT/200) * spell:
+// ((90000/1000 + 300)/30 + 400/200) * 20 = (13 + 2) * 20 = 300.
+func TestComputeMagic(t *testing.T) {
+	r, ok := Compute(Input{Magic: true, MagicAttack: 300, Intelligence: 400, SpellAttack: 20})
+	if !ok {
+		t.Fatal("expected a bounded range")
+	}
+	if r.Max != 300 {
+		t.Errorf("Max=%d, want 300", r.Max)
+	}
+}
+
+func TestComputeUnboundedInputs(t *testing.T) {
+	if _, ok := Compute(Input{WeaponType: item.WeaponTypeNone, Strength: 100, WeaponAttack: 10}); ok {
+		t.Error("no weapon must not be bounded")
+	}
+	in := warrior()
+	in.WeaponAttack = 0
+	if _, ok := Compute(in); ok {
+		t.Error("missing stats must not be bounded")
+	}
+	if _, ok := Compute(Input{Magic: true, MagicAttack: 300}); ok {
+		t.Error("magic without spell attack must not be bounded")
+	}
+}
+
+func TestComputeCapsAtLineCap(t *testing.T) {
+	in := warrior()
+	in.Strength = 60000
+	in.WeaponAttack = 1000
+	r, _ := Compute(in)
+	if r.Max != LineCap || r.Ceiling() != LineCap {
+		t.Errorf("Max/Ceiling=%d/%d, want %d", r.Max, r.Ceiling(), LineCap)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	r := Range{Min: 500, Max: 1000} // ceiling 4000, reject above 8000
+
+	res := Check([]uint32{900, 3999}, r)
+	if res.Verdict != VerdictOk || res.Damages[1] != 3999 {
+		t.Errorf("in-range: %+v", res)
+	}
+
+	in := []uint32{900, 5000}
+	res = Check(in, r)
+	if res.Verdict != VerdictClamped || res.Clamped != 1 || res.Damages[1] != 4000 {
+		t.Errorf("clamp: %+v", res)
+	}
+	if in[1] != 5000 {
+		t.Error("Check must not mutate its input")
+	}
+
+	res = Check([]uint32{900, 9000}, r)
+	if res.Verdict != VerdictRejected || res.Damages[0] != 0 || res.Damages[1] != 0 || res.Highest != 9000 {
+		t.Errorf("reject: %+v", res)
+	}
+
+	res = Check([]uint32{10, 11}, Fixed(10))
+	if res.Verdict != VerdictClamped || res.Damages[1] != 10 {
+		t.Errorf("fixed damage gets no tolerance: %+v", res)
+	}
+
+	res = Check([]uint32{LineCap + 1}, Range{Max: LineCap})
+	if res.Verdict != VerdictRejected {
+		t.Errorf("over the client line cap must reject: %+v", res)
+	}
+}
@@ -0,0 +1,105 @@
+package damagecheck
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// Suspicion weights and thresholds. A clamped entry is weak evidence — an
+// unmodelled amplifier stack can produce one — so it weighs little; a
+// rejected entry cannot come from an unmodified client. The score halves
+// every HalfLife, so sporadic clamps from a legitimate player decay away
+// while a damage hacker, who trips the check on nearly every attack, climbs
+// past ReportThreshold within a minute of play.
+const (
+	ClampWeight     = 1.0
+	RejectWeight    = 5.0
+	HalfLife        = 10 * time.Minute
+	ReportThreshold = 25.0
+	// ReportCooldown bounds detection reports to one per character per
+	// window, so a hacker left running does not flood the GM queue.
+	ReportCooldown = time.Hour
+)
+
+type Key struct {
+	Tenant      tenant.Model
+	CharacterId uint32
+}
+
+type entry struct {
+	score      float64
+	updatedAt  time.Time
+	reportedAt time.Time
+}
+
+// Registry holds the per-session suspicion score. In-process state is the
+// whole view: a character's socket session lives on exactly one
+// atlas-channel pod, and the score is scoped to that session — it is
+// dropped on session destroy, never persisted.
+type Registry struct {
+	mutex   sync.Mutex
+	entries map[Key]*entry
+}
+
+var (
+	registry *Registry
+	once     sync.Once
+)
+
+func GetRegistry() *Registry {
+	once.Do(func() {
+		registry = &Registry{entries: make(map[Key]*entry)}
+	})
+	return registry
+}
+
+func decayed(e *entry, now time.Time) float64 {
+	elapsed := now.Sub(e.updatedAt)
+	if elapsed <= 0 {
+		return e.score
+	}
+	return e.score * math.Pow(0.5, float64(elapsed)/float64(HalfLife))
+}
+
+// Record adds weight to the character's score and returns the decayed
+// total. report is true exactly when the total has reached ReportThreshold
+// and no report was filed within ReportCooldown; the report time is stamped
+// before returning, so concurrent attacks cannot both win.
+func (r *Registry) Record(t tenant.Model, characterId uint32, weight float64, now time.Time) (score float64, report bool) {
+	k := Key{Tenant: t, CharacterId: characterId}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	e, ok := r.entries[k]
+	if !ok {
+		e = &entry{}
+		r.entries[k] = e
+	}
+	e.score = decayed(e, now) + weight
+	e.updatedAt = now
+	if e.score >= ReportThreshold && (e.reportedAt.IsZero() || now.Sub(e.reportedAt) >= ReportCooldown) {
+		e.reportedAt = now
+		return e.score, true
+	}
+	return e.score, false
+}
+
+// Score returns the character's decayed score without changing it.
+func (r *Registry) Score(t tenant.Model, characterId uint32, now time.Time) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	e, ok := r.entries[Key{Tenant: t, CharacterId: characterId}]
+	if !ok {
+		return 0
+	}
+	return decayed(e, now)
+}
+
+// ClearCharacter drops the character's score (session destroy).
+func (r *Registry) ClearCharacter(t tenant.Model, characterId uint32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.entries, Key{Tenant: t, CharacterId: characterId})
+}
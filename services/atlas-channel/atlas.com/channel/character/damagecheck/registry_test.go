@@ -0,0 +1,78 @@
+package damagecheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func mkTenant(t *testing.T) tenant.Model {
+	t.Helper()
+	tm, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatalf("tenant.Create: %v", err)
+	}
+	return tm
+}
+
+func TestRecordReportsOnceAtThreshold(t *testing.T) {
+	r := GetRegistry()
+	tm := mkTenant(t)
+	now := time.Unix(1_700_000_000, 0)
+
+	reports := 0
+	for i := 0; i < 10; i++ {
+		if _, report := r.Record(tm, 1001, RejectWeight, now); report {
+			reports++
+		}
+	}
+	if reports != 1 {
+		t.Fatalf("want exactly one report inside the cooldown, got %d", reports)
+	}
+
+	later := now.Add(ReportCooldown)
+	reports = 0
+	for i := 0; i < 10; i++ {
+		if _, report := r.Record(tm, 1001, RejectWeight, later); report {
+			reports++
+		}
+	}
+	if reports != 1 {
+		t.Errorf("want one fresh report once the cooldown has passed, got %d", reports)
+	}
+}
+
+// TestRecordDecays asserts a legitimate player's sporadic clamps fade: the
+// score halves every HalfLife.
+func TestRecordDecays(t *testing.T) {
+	r := GetRegistry()
+	tm := mkTenant(t)
+	now := time.Unix(1_700_000_000, 0)
+
+	r.Record(tm, 1002, 8, now)
+	if got := r.Score(tm, 1002, now.Add(HalfLife)); got < 3.99 || got > 4.01 {
+		t.Errorf("score after one half-life=%f, want 4", got)
+	}
+	if _, report := r.Record(tm, 1002, ClampWeight, now.Add(2*HalfLife)); report {
+		t.Error("a decayed score must not report")
+	}
+}
+
+func TestClearCharacterScopesToSession(t *testing.T) {
+	r := GetRegistry()
+	tm := mkTenant(t)
+	now := time.Unix(1_700_000_000, 0)
+
+	r.Record(tm, 1003, RejectWeight, now)
+	r.Record(tm, 1004, RejectWeight, now)
+	r.ClearCharacter(tm, 1003)
+	if r.Score(tm, 1003, now) != 0 {
+		t.Error("cleared character must read zero")
+	}
+	if r.Score(tm, 1004, now) != RejectWeight {
+		t.Error("clear must not touch other characters")
+	}
+}
@@ -0,0 +1,5 @@
+package messaging
+
+type RestModel struct {
+	OfflineWhisperNotes bool `json:"offlineWhisperNotes"`
+}
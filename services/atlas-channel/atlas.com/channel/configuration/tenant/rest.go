@@ -2,6 +2,7 @@ package tenant
 
 import (
 	"atlas-channel/configuration/tenant/characters"
+	"atlas-channel/configuration/tenant/messaging"
 	"atlas-channel/configuration/tenant/npcs"
 	"atlas-channel/configuration/tenant/socket"
 	"atlas-channel/configuration/tenant/worlds"
@@ -17,6 +18,7 @@ type RestModel struct {
 	Characters   characters.RestModel `json:"characters"`
 	NPCs         []npcs.RestModel     `json:"npcs"`
 	Worlds       []worlds.RestModel   `json:"worlds"`
+	Messaging    messaging.RestModel  `json:"messaging"`
 }
 
 func (r RestModel) GetName() string {
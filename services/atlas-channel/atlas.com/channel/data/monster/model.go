@@ -1,9 +1,12 @@
 package monster
 
 type Model struct {
-	id          uint32
-	boss        bool
-	fixedDamage uint32
+	id            uint32
+	boss          bool
+	fixedDamage   uint32
+	level         uint32
+	weaponDefense uint32
+	magicDefense  uint32
 }
 
 func (m Model) Id() uint32 {
@@ -17,3 +20,15 @@ func (m Model) Boss() bool {
 func (m Model) FixedDamage() uint32 {
 	return m.fixedDamage
 }
+
+func (m Model) Level() uint32 {
+	return m.level
+}
+
+func (m Model) WeaponDefense() uint32 {
+	return m.weaponDefense
+}
+
+func (m Model) MagicDefense() uint32 {
+	return m.magicDefense
+}
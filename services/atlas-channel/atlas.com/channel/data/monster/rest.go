@@ -3,12 +3,15 @@ package monster
 import "strconv"
 
 // RestModel is a projection of atlas-data's monster resource; only the
-// fields the damage pipeline needs are declared, the rest of the
-// attributes payload is ignored on unmarshal.
+// fields the damage pipelines (taken and dealt) need are declared, the
+// rest of the attributes payload is ignored on unmarshal.
 type RestModel struct {
-	Id          uint32 `json:"-"`
-	Boss        bool   `json:"boss"`
-	FixedDamage uint32 `json:"fixed_damage"`
+	Id            uint32 `json:"-"`
+	Boss          bool   `json:"boss"`
+	FixedDamage   uint32 `json:"fixed_damage"`
+	Level         uint32 `json:"level"`
+	WeaponDefense uint32 `json:"weapon_defense"`
+	MagicDefense  uint32 `json:"magic_defense"`
 }
 
 func (r RestModel) GetName() string {
@@ -30,8 +33,11 @@ func (r *RestModel) SetID(strId string) error {
 
 func Extract(rm RestModel) (Model, error) {
 	return Model{
-		id:          rm.Id,
-		boss:        rm.Boss,
-		fixedDamage: rm.FixedDamage,
+		id:            rm.Id,
+		boss:          rm.Boss,
+		fixedDamage:   rm.FixedDamage,
+		level:         rm.Level,
+		weaponDefense: rm.WeaponDefense,
+		magicDefense:  rm.MagicDefense,
 	}, nil
 }
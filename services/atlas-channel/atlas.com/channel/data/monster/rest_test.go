@@ -3,7 +3,7 @@ package monster
 import "testing"
 
 func TestExtract(t *testing.T) {
-	rm := RestModel{Boss: true, FixedDamage: 5}
+	rm := RestModel{Boss: true, FixedDamage: 5, Level: 120, WeaponDefense: 800, MagicDefense: 900}
 	if err := rm.SetID("8510000"); err != nil {
 		t.Fatal(err)
 	}
@@ -20,4 +20,7 @@ func TestExtract(t *testing.T) {
 	if m.FixedDamage() != 5 {
 		t.Errorf("FixedDamage=%d, want 5", m.FixedDamage())
 	}
+	if m.Level() != 120 || m.WeaponDefense() != 800 || m.MagicDefense() != 900 {
+		t.Errorf("Level/WeaponDefense/MagicDefense=%d/%d/%d, want 120/800/900", m.Level(), m.WeaponDefense(), m.MagicDefense())
+	}
 }
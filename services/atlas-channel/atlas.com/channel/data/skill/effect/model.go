@@ -52,6 +52,7 @@ type Model struct {
 	itemCon              uint32
 	itemConNo            uint32
 	damage               uint32
+	mastery              int32
 	attackCount          uint32
 	fixDamage            int32
 	dot                  int32
@@ -200,3 +201,29 @@ func (m Model) AttackCount() uint32 {
 func (m Model) Y() int16 {
 	return m.y
 }
+
+// MagicAttack returns the WZ `mad` attribute. On attack spells it is the
+// spell attack that scales the magic damage formula; on buffs it is a flat
+// magic attack bonus.
+func (m Model) MagicAttack() int16 {
+	return m.magicAttack
+}
+
+// Damage returns the WZ `damage` attribute: the percent multiplier a
+// physical attack skill applies to the base damage range. Zero means the
+// skill carries no multiplier (treated as 100).
+func (m Model) Damage() uint32 {
+	return m.damage
+}
+
+// FixDamage returns the WZ `fixdamage` attribute: a flat per-line damage
+// that bypasses the stat formula entirely. Zero means absent.
+func (m Model) FixDamage() int32 {
+	return m.fixDamage
+}
+
+// Mastery returns the WZ `mastery` attribute as a percent. Mastery passives
+// raise the floor of the damage range; the ceiling is unaffected.
+func (m Model) Mastery() int32 {
+	return m.mastery
+}
@@ -53,6 +53,7 @@ type RestModel struct {
 	ItemConsume       uint32  `json:"itemConsume"`
 	ItemConsumeAmount uint32  `json:"itemConsumeAmount"`
 	Damage            uint32  `json:"damage"`
+	Mastery           int32   `json:"mastery"`
 	AttackCount       uint32  `json:"attackCount"`
 	FixDamage         int32   `json:"fixDamage"`
 	// Dot is the raw per-tick DoT magnitude. DotInterval and DotTime are
@@ -133,6 +134,7 @@ func Extract(rm RestModel) (Model, error) {
 		itemCon:              rm.ItemConsume,
 		itemConNo:            rm.ItemConsumeAmount,
 		damage:               rm.Damage,
+		mastery:              rm.Mastery,
 		attackCount:          rm.AttackCount,
 		fixDamage:            rm.FixDamage,
 		dot:                  rm.Dot,
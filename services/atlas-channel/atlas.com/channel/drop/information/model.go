@@ -0,0 +1,32 @@
+package information
+
+// Model is one entry of a monster's drop table as served by
+// atlas-drop-information.
+type Model struct {
+	itemId          uint32
+	minimumQuantity uint32
+	maximumQuantity uint32
+	questId         uint32
+	chance          uint32
+}
+
+func (m Model) ItemId() uint32 {
+	return m.itemId
+}
+
+func (m Model) MinimumQuantity() uint32 {
+	return m.minimumQuantity
+}
+
+func (m Model) MaximumQuantity() uint32 {
+	return m.maximumQuantity
+}
+
+// QuestId is non-zero for quest-gated drops.
+func (m Model) QuestId() uint32 {
+	return m.questId
+}
+
+func (m Model) Chance() uint32 {
+	return m.chance
+}
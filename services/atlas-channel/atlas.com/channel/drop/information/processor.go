@@ -0,0 +1,40 @@
+package information
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+type Processor interface {
+	ByMonsterIdProvider(monsterId uint32) model.Provider[[]Model]
+	GetByMonsterId(monsterId uint32) ([]Model, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+}
+
+// ByMonsterIdProvider drains every page of a monster template's drop table.
+func (p *ProcessorImpl) ByMonsterIdProvider(monsterId uint32) model.Provider[[]Model] {
+	url, err := monsterDropsUrl(p.ctx, monsterId)
+	if err != nil {
+		return model.ErrorProvider[[]Model](err)
+	}
+	return requests.DrainProvider[RestModel, Model](p.l, p.ctx)(url, 250, Extract, model.Filters[Model]())
+}
+
+func (p *ProcessorImpl) GetByMonsterId(monsterId uint32) ([]Model, error) {
+	return p.ByMonsterIdProvider(monsterId)()
+}
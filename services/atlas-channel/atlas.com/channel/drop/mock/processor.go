@@ -12,6 +12,7 @@ type ProcessorMock struct {
 	ForEachInMapFunc       func(f field.Model, o model.Operator[drop.Model]) error
 	RequestReservationFunc func(f field.Model, dropId uint32, characterId uint32, partyId uint32, characterX int16, characterY int16, petSlot int8) error
 	SpawnMesoFunc          func(f field.Model, mesos uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error
+	SpawnItemFunc          func(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error
 	ConsumeAllFunc         func(f field.Model, dropIds []uint32) error
 }
 
@@ -45,6 +46,13 @@ func (m *ProcessorMock) SpawnMeso(f field.Model, mesos uint32, x int16, y int16,
 	return nil
 }
 
+func (m *ProcessorMock) SpawnItem(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error {
+	if m.SpawnItemFunc != nil {
+		return m.SpawnItemFunc(f, itemId, quantity, x, y, ownerId, dropperId, dropperX, dropperY)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) ConsumeAll(f field.Model, dropIds []uint32) error {
 	if m.ConsumeAllFunc != nil {
 		return m.ConsumeAllFunc(f, dropIds)
@@ -20,6 +20,7 @@ type Processor interface {
 	ForEachInMap(f field.Model, o model.Operator[Model]) error
 	RequestReservation(f field.Model, dropId uint32, characterId uint32, partyId uint32, characterX int16, characterY int16, petSlot int8) error
 	SpawnMeso(f field.Model, mesos uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error
+	SpawnItem(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error
 	ConsumeAll(f field.Model, dropIds []uint32) error
 }
 
@@ -68,6 +69,13 @@ func (p *ProcessorImpl) SpawnMeso(f field.Model, mesos uint32, x int16, y int16,
 // single produce call, carrying the attacker's field in the envelope
 // (task-150 FR-8). atlas-drops removes each drop and emits CONSUMED; the
 // drop consumer then announces the explode animation to the field.
+// SpawnItem emits a non-equipment item drop owned by ownerId (Bandit
+// Steal). Equipment is not spawned here: equips need rolled stats, which
+// only the monster-death drop path generates.
+func (p *ProcessorImpl) SpawnItem(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error {
+	return producer.ProviderImpl(p.l)(p.ctx)(drop2.EnvCommandTopic)(SpawnItemCommandProvider(f, itemId, quantity, x, y, ownerId, dropperId, dropperX, dropperY))
+}
+
 func (p *ProcessorImpl) ConsumeAll(f field.Model, dropIds []uint32) error {
 	if len(dropIds) == 0 {
 		return nil
@@ -85,3 +85,30 @@ func SpawnMesoCommandProvider(f field.Model, mesos uint32, x int16, y int16, own
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+// SpawnItemCommandProvider emits an owner-only item drop: DropType=0
+// (owner priority), PlayerDrop=false so the usual ownership window applies
+// before the drop turns FFA.
+func SpawnItemCommandProvider(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(dropperId))
+	value := &drop2.Command[drop2.SpawnCommandBody]{
+		WorldId:   f.WorldId(),
+		ChannelId: f.ChannelId(),
+		MapId:     f.MapId(),
+		Instance:  f.Instance(),
+		Type:      drop2.CommandTypeSpawn,
+		Body: drop2.SpawnCommandBody{
+			ItemId:     itemId,
+			Quantity:   quantity,
+			DropType:   0,
+			X:          x,
+			Y:          y,
+			OwnerId:    ownerId,
+			DropperId:  dropperId,
+			DropperX:   dropperX,
+			DropperY:   dropperY,
+			PlayerDrop: false,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
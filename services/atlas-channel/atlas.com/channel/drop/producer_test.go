@@ -99,3 +99,26 @@ func TestConsumeAllCommandProvider(t *testing.T) {
 		}
 	}
 }
+
+func TestSpawnItemCommandProvider(t *testing.T) {
+	f := field.NewBuilder(world.Id(0), channel.Id(1), _map.Id(100000000)).Build()
+
+	msgs, err := drop.SpawnItemCommandProvider(f, 2000000, 1, 45, -67, 999, 700001, 40, -67)()
+	if err != nil {
+		t.Fatalf("provider error: %v", err)
+	}
+	var cmd drop2.Command[drop2.SpawnCommandBody]
+	if err := json.Unmarshal(msgs[0].Value, &cmd); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	b := cmd.Body
+	if b.ItemId != 2000000 || b.Quantity != 1 || b.Mesos != 0 {
+		t.Fatalf("item/quantity/mesos = %d/%d/%d; want 2000000/1/0", b.ItemId, b.Quantity, b.Mesos)
+	}
+	if b.OwnerId != 999 || b.DropType != 0 || b.PlayerDrop {
+		t.Fatalf("owner/dropType/playerDrop = %d/%d/%t; want 999/0/false", b.OwnerId, b.DropType, b.PlayerDrop)
+	}
+	if b.DropperId != 700001 || b.DropperX != 40 || b.DropperY != -67 {
+		t.Fatalf("dropper = %d@(%d,%d); want 700001@(40,-67)", b.DropperId, b.DropperX, b.DropperY)
+	}
+}
@@ -0,0 +1,63 @@
+package family
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/presence"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+)
+
+// MaxJuniors is how many juniors a senior may register.
+const MaxJuniors = 2
+
+// ChartResult builds the pedigree chart of viewedId as viewerId sees it.
+// Shared by the chart request handler and the presence consumer, which
+// re-pushes the chart when a relative moves so online state and channel stay
+// current.
+func ChartResult(l logrus.FieldLogger, ctx context.Context, tree Tree, viewerId uint32, viewedId uint32) familycb.ChartResult {
+	ids := []uint32{viewedId}
+	for _, m := range tree {
+		if m.CharacterId() != viewedId {
+			ids = append(ids, m.CharacterId())
+		}
+	}
+
+	cp := character.NewProcessor(l, ctx)
+	pp := presence.NewProcessor(l, ctx)
+	entries := make([]familycb.ChartEntry, 0, len(ids))
+	for _, id := range ids {
+		c, err := cp.GetById()(id)
+		if err != nil {
+			l.WithError(err).Warnf("Unable to retrieve family member [%d] for chart.", id)
+			continue
+		}
+		m, _ := tree.Member(id)
+		e := familycb.ChartEntry{
+			CharacterId: id,
+			SeniorId:    m.SeniorId(),
+			JobId:       uint16(c.JobId()),
+			Level:       c.Level(),
+			Rep:         m.Rep(),
+			TotalRep:    m.Rep(),
+			TodaysRep:   m.DailyRep(),
+			ChannelId:   -1,
+			Name:        c.Name(),
+		}
+		if pm, perr := pp.GetByCharacterId(id); perr == nil && pm.Online() {
+			e.Online = true
+			e.ChannelId = int32(pm.ChannelId())
+		}
+		entries = append(entries, e)
+	}
+
+	stats := []familycb.ChartStat{
+		{Key: familycb.ChartStatTotalMembers, Value: uint32(len(entries))},
+		{Key: familycb.ChartStatTotalSeniors, Value: tree.SeniorCount(viewedId)},
+	}
+	viewed, _ := tree.Member(viewedId)
+	canAdd := viewedId == viewerId && len(viewed.JuniorIds()) < MaxJuniors
+	return familycb.NewFamilyChartResult(viewedId, entries, stats, canAdd)
+}
@@ -0,0 +1,84 @@
+package family
+
+type Model struct {
+	characterId     uint32
+	seniorId        uint32
+	juniorIds       []uint32
+	rep             uint32
+	dailyRep        uint32
+	level           uint16
+	entitlementUses map[byte]uint32
+}
+
+func (m Model) CharacterId() uint32 {
+	return m.characterId
+}
+
+// SeniorId is zero when the member has no senior.
+func (m Model) SeniorId() uint32 {
+	return m.seniorId
+}
+
+func (m Model) HasSenior() bool {
+	return m.seniorId != 0
+}
+
+func (m Model) JuniorIds() []uint32 {
+	return m.juniorIds
+}
+
+func (m Model) Rep() uint32 {
+	return m.rep
+}
+
+func (m Model) DailyRep() uint32 {
+	return m.dailyRep
+}
+
+func (m Model) Level() uint16 {
+	return m.level
+}
+
+func (m Model) EntitlementUses() map[byte]uint32 {
+	return m.entitlementUses
+}
+
+// Tree is the slice of a family visible from one member: the senior chain up
+// to the leader, the member's juniors and their juniors, and siblings.
+type Tree []Model
+
+func (t Tree) Member(characterId uint32) (Model, bool) {
+	for _, m := range t {
+		if m.CharacterId() == characterId {
+			return m, true
+		}
+	}
+	return Model{}, false
+}
+
+// LeaderId walks the senior chain from characterId to the family leader.
+func (t Tree) LeaderId(characterId uint32) uint32 {
+	current := characterId
+	seen := map[uint32]bool{}
+	for !seen[current] {
+		seen[current] = true
+		m, ok := t.Member(current)
+		if !ok || !m.HasSenior() {
+			return current
+		}
+		current = m.SeniorId()
+	}
+	return current
+}
+
+// SeniorCount is how many seniors stand between characterId and the leader,
+// the leader included.
+func (t Tree) SeniorCount(characterId uint32) uint32 {
+	leaderId := t.LeaderId(characterId)
+	count := uint32(0)
+	for current := characterId; current != leaderId; count++ {
+		m, _ := t.Member(current)
+		current = m.SeniorId()
+	}
+	return count
+}
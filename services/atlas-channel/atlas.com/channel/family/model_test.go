@@ -0,0 +1,36 @@
+package family
+
+import "testing"
+
+func member(id uint32, seniorId uint32, juniorIds ...uint32) Model {
+	return Model{characterId: id, seniorId: seniorId, juniorIds: juniorIds}
+}
+
+func TestTreeLeaderAndSeniorCount(t *testing.T) {
+	tree := Tree{
+		member(1, 0, 2),
+		member(2, 1, 3, 4),
+		member(3, 2),
+		member(4, 2),
+	}
+	if got := tree.LeaderId(3); got != 1 {
+		t.Errorf("LeaderId(3) = %d, want 1", got)
+	}
+	if got := tree.SeniorCount(3); got != 2 {
+		t.Errorf("SeniorCount(3) = %d, want 2", got)
+	}
+	if got := tree.SeniorCount(1); got != 0 {
+		t.Errorf("SeniorCount(1) = %d, want 0", got)
+	}
+}
+
+// A senior outside the visible slice ends the walk instead of looping.
+func TestTreeLeaderStopsAtMissingSenior(t *testing.T) {
+	tree := Tree{member(5, 9)}
+	if got := tree.LeaderId(5); got != 9 {
+		t.Errorf("LeaderId(5) = %d, want 9", got)
+	}
+	if got := tree.SeniorCount(5); got != 1 {
+		t.Errorf("SeniorCount(5) = %d, want 1", got)
+	}
+}
@@ -0,0 +1,61 @@
+package family
+
+import (
+	family2 "atlas-channel/kafka/message/family"
+	"context"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+type Processor interface {
+	GetTree(characterId uint32) (Tree, error)
+	BreakLink(worldId world.Id, characterId uint32, reason string) error
+	UseEntitlement(worldId world.Id, characterId uint32, entitlement byte, targetId uint32, beneficiaryIds []uint32) error
+	RefundEntitlement(worldId world.Id, characterId uint32, entitlement byte, reason string) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	p := &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+	return p
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetTree(characterId uint32) (Tree, error) {
+	ms, err := requests.SliceProvider[RestModel, Model](p.l, p.ctx)(requestTree(p.ctx, characterId), Extract, model.Filters[Model]())()
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+func (p *ProcessorImpl) BreakLink(worldId world.Id, characterId uint32, reason string) error {
+	p.l.Debugf("Character [%d] is leaving their senior's family.", characterId)
+	return producer.ProviderImpl(p.l)(p.ctx)(family2.EnvCommandTopic)(BreakLinkCommandProvider(worldId, characterId, reason))
+}
+
+func (p *ProcessorImpl) UseEntitlement(worldId world.Id, characterId uint32, entitlement byte, targetId uint32, beneficiaryIds []uint32) error {
+	p.l.Debugf("Character [%d] is using family entitlement [%d] on [%d].", characterId, entitlement, targetId)
+	return producer.ProviderImpl(p.l)(p.ctx)(family2.EnvCommandTopic)(UseEntitlementCommandProvider(worldId, characterId, entitlement, targetId, beneficiaryIds))
+}
+
+// RefundEntitlement asks atlas-families to return the rep and use of an
+// entitlement this channel was charged for but could not carry out.
+func (p *ProcessorImpl) RefundEntitlement(worldId world.Id, characterId uint32, entitlement byte, reason string) error {
+	p.l.Debugf("Refunding family entitlement [%d] for character [%d]: %s.", entitlement, characterId, reason)
+	return producer.ProviderImpl(p.l)(p.ctx)(family2.EnvCommandTopic)(RefundEntitlementCommandProvider(worldId, characterId, entitlement, reason))
+}
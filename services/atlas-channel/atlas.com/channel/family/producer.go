@@ -0,0 +1,57 @@
+package family
+
+import (
+	family2 "atlas-channel/kafka/message/family"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func BreakLinkCommandProvider(worldId world.Id, characterId uint32, reason string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &family2.Command[family2.BreakLinkCommandBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          family2.CommandTypeBreakLink,
+		Body: family2.BreakLinkCommandBody{
+			Reason: reason,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func UseEntitlementCommandProvider(worldId world.Id, characterId uint32, entitlement byte, targetId uint32, beneficiaryIds []uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &family2.Command[family2.UseEntitlementCommandBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          family2.CommandTypeUseEntitlement,
+		Body: family2.UseEntitlementCommandBody{
+			Entitlement:    entitlement,
+			TargetId:       targetId,
+			BeneficiaryIds: beneficiaryIds,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func RefundEntitlementCommandProvider(worldId world.Id, characterId uint32, entitlement byte, reason string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &family2.Command[family2.RefundEntitlementCommandBody]{
+		TransactionId: uuid.New(),
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          family2.CommandTypeRefundEntitlement,
+		Body: family2.RefundEntitlementCommandBody{
+			Entitlement: entitlement,
+			Reason:      reason,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -0,0 +1,24 @@
+package family
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const (
+	TreeResource = "families/tree/%d"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "FAMILIES")
+}
+
+func requestTree(ctx context.Context, characterId uint32) requests.Request[[]RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[[]RestModel](err)
+	}
+	return requests.GetRequest[[]RestModel](fmt.Sprintf(root+TreeResource, characterId))
+}
@@ -0,0 +1,41 @@
+package family
+
+type RestModel struct {
+	Id              string          `json:"-"`
+	CharacterId     uint32          `json:"characterId"`
+	SeniorId        *uint32         `json:"seniorId,omitempty"`
+	JuniorIds       []uint32        `json:"juniorIds"`
+	Rep             uint32          `json:"rep"`
+	DailyRep        uint32          `json:"dailyRep"`
+	Level           uint16          `json:"level"`
+	EntitlementUses map[byte]uint32 `json:"entitlementUses,omitempty"`
+}
+
+func (r RestModel) GetName() string {
+	return "familyMembers"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+func (r *RestModel) SetID(strId string) error {
+	r.Id = strId
+	return nil
+}
+
+func Extract(rm RestModel) (Model, error) {
+	seniorId := uint32(0)
+	if rm.SeniorId != nil {
+		seniorId = *rm.SeniorId
+	}
+	return Model{
+		characterId:     rm.CharacterId,
+		seniorId:        seniorId,
+		juniorIds:       rm.JuniorIds,
+		rep:             rm.Rep,
+		dailyRep:        rm.DailyRep,
+		level:           rm.Level,
+		entitlementUses: rm.EntitlementUses,
+	}, nil
+}
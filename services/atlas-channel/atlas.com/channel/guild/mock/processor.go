@@ -11,6 +11,7 @@ import (
 type ProcessorMock struct {
 	GetByIdFunc                  func(guildId uint32) (guild.Model, error)
 	GetByMemberIdFunc            func(memberId uint32) (guild.Model, error)
+	GetByNameFunc                func(name string) (guild.Model, error)
 	ByMemberIdProviderFunc       func(memberId uint32) model.Provider[[]guild.Model]
 	GetMemberIdsFunc             func(guildId uint32, filters []model.Filter[member.Model]) model.Provider[[]uint32]
 	RequestCreateFunc            func(f field.Model, characterId uint32, name string) error
@@ -40,6 +41,13 @@ func (m *ProcessorMock) GetByMemberId(memberId uint32) (guild.Model, error) {
 	return guild.Model{}, nil
 }
 
+func (m *ProcessorMock) GetByName(name string) (guild.Model, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(name)
+	}
+	return guild.Model{}, nil
+}
+
 func (m *ProcessorMock) ByMemberIdProvider(memberId uint32) model.Provider[[]guild.Model] {
 	if m.ByMemberIdProviderFunc != nil {
 		return m.ByMemberIdProviderFunc(memberId)
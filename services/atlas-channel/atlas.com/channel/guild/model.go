@@ -20,6 +20,7 @@ type Model struct {
 	logoBackground      uint16
 	logoBackgroundColor byte
 	leaderId            uint32
+	allianceId          uint32
 	members             []member.Model
 	titles              []title.Model
 }
@@ -76,7 +77,7 @@ func (m Model) Points() uint32 {
 }
 
 func (m Model) AllianceId() uint32 {
-	return 0
+	return m.allianceId
 }
 
 func (m Model) LeaderId() uint32 {
@@ -18,6 +18,7 @@ import (
 type Processor interface {
 	GetById(guildId uint32) (Model, error)
 	GetByMemberId(memberId uint32) (Model, error)
+	GetByName(name string) (Model, error)
 	ByMemberIdProvider(memberId uint32) model.Provider[[]Model]
 	GetMemberIds(guildId uint32, filters []model.Filter[member.Model]) model.Provider[[]uint32]
 	RequestCreate(f field.Model, characterId uint32, name string) error
@@ -54,6 +55,16 @@ func (p *ProcessorImpl) GetByMemberId(memberId uint32) (Model, error) {
 	return model.First[Model](p.ByMemberIdProvider(memberId), model.Filters[Model]())
 }
 
+// GetByName resolves a guild by its exact name. The guilds service matches the
+// filter as a substring, so the candidates are narrowed to a case-insensitive
+// match.
+func (p *ProcessorImpl) GetByName(name string) (Model, error) {
+	ms := requests.SliceProvider[RestModel, Model](p.l, p.ctx)(requestByName(p.ctx, name), Extract, model.Filters[Model]())
+	return model.First[Model](ms, model.Filters[Model](func(m Model) bool {
+		return strings.EqualFold(m.Name(), name)
+	}))
+}
+
 func (p *ProcessorImpl) ByMemberIdProvider(memberId uint32) model.Provider[[]Model] {
 	return requests.SliceProvider[RestModel, Model](p.l, p.ctx)(requestByMemberId(p.ctx, memberId), Extract, model.Filters[Model]())
 }
@@ -3,6 +3,7 @@ package guild
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
 )
@@ -11,6 +12,7 @@ const (
 	Resource   = "guilds"
 	ByMemberId = Resource + "?filter[members.id]=%d"
 	ById       = Resource + "/%d"
+	ByName     = Resource + "?filter[name]=%s"
 )
 
 func getBaseRequest(ctx context.Context) (string, error) {
@@ -32,3 +34,11 @@ func requestByMemberId(ctx context.Context, id uint32) requests.Request[[]RestMo
 	}
 	return requests.GetRequest[[]RestModel](fmt.Sprintf(root+ByMemberId, id))
 }
+
+func requestByName(ctx context.Context, name string) requests.Request[[]RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[[]RestModel](err)
+	}
+	return requests.GetRequest[[]RestModel](fmt.Sprintf(root+ByName, url.QueryEscape(name)))
+}
@@ -21,6 +21,7 @@ type RestModel struct {
 	LogoBackground      uint16             `json:"logoBackground"`
 	LogoBackgroundColor byte               `json:"logoBackgroundColor"`
 	LeaderId            uint32             `json:"leaderId"`
+	AllianceId          uint32             `json:"allianceId"`
 	Members             []member.RestModel `json:"members"`
 	Titles              []title.RestModel  `json:"titles"`
 }
@@ -63,6 +64,7 @@ func Extract(rm RestModel) (Model, error) {
 		logoBackground:      rm.LogoBackground,
 		logoBackgroundColor: rm.LogoBackgroundColor,
 		leaderId:            rm.LeaderId,
+		allianceId:          rm.AllianceId,
 		members:             members,
 		titles:              titles,
 	}, nil
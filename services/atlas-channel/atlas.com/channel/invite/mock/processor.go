@@ -7,12 +7,20 @@ import (
 )
 
 type ProcessorMock struct {
+	CreateFunc func(originatorId uint32, worldId world.Id, inviteType string, targetId uint32, referenceId uint32) error
 	AcceptFunc func(actorId uint32, worldId world.Id, inviteType string, referenceId uint32) error
 	RejectFunc func(actorId uint32, worldId world.Id, inviteType string, originatorId uint32) error
 }
 
 var _ invite.Processor = (*ProcessorMock)(nil)
 
+func (m *ProcessorMock) Create(originatorId uint32, worldId world.Id, inviteType string, targetId uint32, referenceId uint32) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(originatorId, worldId, inviteType, targetId, referenceId)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) Accept(actorId uint32, worldId world.Id, inviteType string, referenceId uint32) error {
 	if m.AcceptFunc != nil {
 		return m.AcceptFunc(actorId, worldId, inviteType, referenceId)
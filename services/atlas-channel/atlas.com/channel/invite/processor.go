@@ -12,6 +12,7 @@ import (
 )
 
 type Processor interface {
+	Create(originatorId uint32, worldId world.Id, inviteType string, targetId uint32, referenceId uint32) error
 	Accept(actorId uint32, worldId world.Id, inviteType string, referenceId uint32) error
 	Reject(actorId uint32, worldId world.Id, inviteType string, originatorId uint32) error
 }
@@ -31,6 +32,10 @@ func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
 
 var _ Processor = (*ProcessorImpl)(nil)
 
+func (p *ProcessorImpl) Create(originatorId uint32, worldId world.Id, inviteType string, targetId uint32, referenceId uint32) error {
+	return producer.ProviderImpl(p.l)(p.ctx)(invite2.EnvCommandTopic)(CreateInviteCommandProvider(originatorId, worldId, inviteType, targetId, referenceId))
+}
+
 func (p *ProcessorImpl) Accept(actorId uint32, worldId world.Id, inviteType string, referenceId uint32) error {
 	return producer.ProviderImpl(p.l)(p.ctx)(invite2.EnvCommandTopic)(AcceptInviteCommandProvider(actorId, worldId, inviteType, referenceId))
 }
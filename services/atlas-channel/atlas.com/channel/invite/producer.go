@@ -12,6 +12,21 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
+func CreateInviteCommandProvider(originatorId uint32, worldId world.Id, inviteType string, targetId uint32, referenceId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(originatorId))
+	value := &invite2.Command[invite2.CreateCommandBody]{
+		WorldId:    worldId,
+		InviteType: invite.Type(inviteType),
+		Type:       invite.CommandTypeCreate,
+		Body: invite2.CreateCommandBody{
+			OriginatorId: character.Id(originatorId),
+			TargetId:     character.Id(targetId),
+			ReferenceId:  invite.Id(referenceId),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 func AcceptInviteCommandProvider(actorId uint32, worldId world.Id, inviteType string, referenceId uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(actorId))
 	value := &invite2.Command[invite2.AcceptCommandBody]{
@@ -0,0 +1,287 @@
+package alliance
+
+import (
+	"atlas-channel/alliance"
+	"atlas-channel/guild"
+	consumer2 "atlas-channel/kafka/consumer"
+	alliance2 "atlas-channel/kafka/message/alliance"
+	"atlas-channel/listener"
+	"atlas-channel/server"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	alliancepkt "github.com/Chronicle20/atlas/libs/atlas-packet/alliance"
+	alliancecb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
+	chatcb "github.com/Chronicle20/atlas/libs/atlas-packet/chat/clientbound"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("alliance_status_event")(alliance2.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+	return func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+		return func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+			return func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+				var handles []listener.HandlerHandle
+				register := func(t string, h handler.Handler) error {
+					id, err := rf(t, h)
+					if err != nil {
+						return err
+					}
+					handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+					return nil
+				}
+
+				t, _ := topic.EnvProvider(l)(alliance2.EnvStatusEventTopic)()
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleCreated(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleGuildJoined(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleGuildLeft(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleCapacityUpdated(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleNoticeUpdated(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleTitlesUpdated(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleMemberTitleUpdated(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleDisbanded(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleError(sc, wp)))); err != nil {
+					return nil, err
+				}
+				return handles, nil
+			}
+		}
+	}
+}
+
+func handleCreated(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventCreatedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventCreatedBody]) {
+		if e.Type != alliance2.StatusEventTypeCreated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		announceAllianceInfo(l)(ctx)(sc)(wp)(e.AllianceId)
+	}
+}
+
+func handleGuildJoined(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventGuildJoinedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventGuildJoinedBody]) {
+		if e.Type != alliance2.StatusEventTypeGuildJoined {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		announceAllianceInfo(l)(ctx)(sc)(wp)(e.AllianceId)
+	}
+}
+
+func handleGuildLeft(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventGuildLeftBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventGuildLeftBody]) {
+		if e.Type != alliance2.StatusEventTypeGuildLeft {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		// The departed guild no longer sees the alliance tab.
+		err := session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(guild.NewProcessor(l, ctx).GetMemberIds(e.Body.GuildId, model.Filters(guild.MemberOnline)), announceDisband(l)(ctx)(wp)(e.AllianceId))
+		if err != nil {
+			l.WithError(err).Errorf("Unable to announce to guild [%d] members that they left alliance [%d].", e.Body.GuildId, e.AllianceId)
+		}
+
+		announceAllianceInfo(l)(ctx)(sc)(wp)(e.AllianceId)
+	}
+}
+
+func handleCapacityUpdated(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventCapacityUpdatedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventCapacityUpdatedBody]) {
+		if e.Type != alliance2.StatusEventTypeCapacityUpdated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		announceAllianceInfo(l)(ctx)(sc)(wp)(e.AllianceId)
+	}
+}
+
+func handleNoticeUpdated(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventNoticeUpdatedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventNoticeUpdatedBody]) {
+		if e.Type != alliance2.StatusEventTypeNoticeUpdated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		op := session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceNoticeUpdatedBody(e.AllianceId, e.Body.Notice))
+		err := session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(alliance.NewProcessor(l, ctx).GetMemberIds(e.AllianceId, model.Filters(guild.MemberOnline)), op)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to announce to alliance [%d] members that the notice has changed.", e.AllianceId)
+		}
+	}
+}
+
+func handleTitlesUpdated(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventTitlesUpdatedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventTitlesUpdatedBody]) {
+		if e.Type != alliance2.StatusEventTypeTitlesUpdated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		op := session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceTitlesUpdatedBody(e.AllianceId, e.Body.Titles))
+		err := session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(alliance.NewProcessor(l, ctx).GetMemberIds(e.AllianceId, model.Filters(guild.MemberOnline)), op)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to announce to alliance [%d] members that the titles have changed.", e.AllianceId)
+		}
+	}
+}
+
+func handleMemberTitleUpdated(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventMemberTitleUpdatedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventMemberTitleUpdatedBody]) {
+		if e.Type != alliance2.StatusEventTypeMemberTitleUpdated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		op := session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceMemberTitleUpdatedBody(e.AllianceId, e.Body.CharacterId, e.Body.Title))
+		err := session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(alliance.NewProcessor(l, ctx).GetMemberIds(e.AllianceId, model.Filters(guild.MemberOnline)), op)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to announce to alliance [%d] members that [%d] changed title to [%d].", e.AllianceId, e.Body.CharacterId, e.Body.Title)
+		}
+	}
+}
+
+func handleDisbanded(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventDisbandedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventDisbandedBody]) {
+		if e.Type != alliance2.StatusEventTypeDisbanded {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		// The alliance is gone by now, so its former members are reached through their guilds.
+		for _, guildId := range e.Body.GuildIds {
+			err := session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(guild.NewProcessor(l, ctx).GetMemberIds(guildId, model.Filters(guild.MemberOnline)), announceDisband(l)(ctx)(wp)(e.AllianceId))
+			if err != nil {
+				l.WithError(err).Errorf("Unable to announce to guild [%d] members that alliance [%d] has disbanded.", guildId, e.AllianceId)
+			}
+		}
+	}
+}
+
+func handleError(sc server.Model, wp writer.Producer) message.Handler[alliance2.StatusEvent[alliance2.StatusEventErrorBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventErrorBody]) {
+		if e.Type != alliance2.StatusEventTypeError {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		msg, ok := errorMessages[e.Body.Error]
+		if !ok {
+			l.Debugf("Alliance error [%s] for character [%d] has no client message.", e.Body.Error, e.Body.ActorId)
+			return
+		}
+		_ = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.Body.ActorId, session.Announce(l)(ctx)(wp)(chatcb.WorldMessageWriter)(writer.WorldMessagePopUpBody(msg)))
+	}
+}
+
+var errorMessages = map[string]string{
+	alliance2.ErrorNameInUse:         "The alliance name is already in use.",
+	alliance2.ErrorInvalidName:       "The alliance name is not available.",
+	alliance2.ErrorNotGuildLeader:    "Only a guild master may do that.",
+	alliance2.ErrorAlreadyInAlliance: "That guild is already part of an alliance.",
+	alliance2.ErrorNotEnoughMeso:     "You do not have enough mesos.",
+	alliance2.ErrorNotAuthorized:     "You are not allowed to do that.",
+	alliance2.ErrorAllianceFull:      "The alliance is full.",
+	alliance2.ErrorCapacityMaxed:     "The alliance cannot grow any larger.",
+	alliance2.ErrorMasterGuild:       "The alliance master's guild cannot leave the alliance.",
+	alliance2.ErrorNotInAlliance:     "That guild is not part of the alliance.",
+	alliance2.ErrorInvalidTitle:      "That title cannot be assigned.",
+}
+
+// announceAllianceInfo refreshes the alliance tab of every online member.
+func announceAllianceInfo(l logrus.FieldLogger) func(ctx context.Context) func(sc server.Model) func(wp writer.Producer) func(allianceId uint32) {
+	return func(ctx context.Context) func(sc server.Model) func(wp writer.Producer) func(allianceId uint32) {
+		return func(sc server.Model) func(wp writer.Producer) func(allianceId uint32) {
+			return func(wp writer.Producer) func(allianceId uint32) {
+				return func(allianceId uint32) {
+					a, err := alliance.NewProcessor(l, ctx).GetById(allianceId)
+					if err != nil {
+						l.WithError(err).Errorf("Unable to retrieve alliance [%d] to announce.", allianceId)
+						return
+					}
+					guildIds := make([]uint32, 0, len(a.Guilds()))
+					for _, g := range a.Guilds() {
+						guildIds = append(guildIds, g.Id())
+					}
+					op := session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceInfoBody(a.Id(), a.Name(), a.Titles(), guildIds, a.Capacity(), a.Notice()))
+					err = session.NewProcessor(l, ctx).ForEachByCharacterId(sc.Channel())(alliance.NewProcessor(l, ctx).GetMemberIds(allianceId, model.Filters(guild.MemberOnline)), op)
+					if err != nil {
+						l.WithError(err).Errorf("Unable to announce alliance [%d] information to its members.", allianceId)
+					}
+				}
+			}
+		}
+	}
+}
+
+func announceDisband(l logrus.FieldLogger) func(ctx context.Context) func(wp writer.Producer) func(allianceId uint32) model.Operator[session.Model] {
+	return func(ctx context.Context) func(wp writer.Producer) func(allianceId uint32) model.Operator[session.Model] {
+		return func(wp writer.Producer) func(allianceId uint32) model.Operator[session.Model] {
+			return func(allianceId uint32) model.Operator[session.Model] {
+				return session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceDisbandBody(allianceId))
+			}
+		}
+	}
+}
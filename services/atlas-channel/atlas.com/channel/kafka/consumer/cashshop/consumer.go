@@ -101,6 +101,16 @@ func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Pro
 					return nil, err
 				}
 				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventEquipSlotEnabled(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventEquipSlotFailed(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
 				return handles, nil
 			}
 		}
@@ -287,6 +297,55 @@ func handleStatusEventCouponFailed(sc server.Model, wp writer.Producer) message.
 	}
 }
 
+func handleStatusEventEquipSlotEnabled(sc server.Model, wp writer.Producer) message.Handler[cashshop2.StatusEvent[cashshop2.EquipSlotEnabledBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e cashshop2.StatusEvent[cashshop2.EquipSlotEnabledBody]) {
+		if e.Type != cashshop2.StatusEventTypeEquipSlotEnabled {
+			return
+		}
+
+		t := tenant.MustFromContext(ctx)
+		if !t.Is(sc.Tenant()) {
+			return
+		}
+
+		_ = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.CharacterId, func(s session.Model) error {
+			err := session.Announce(l)(ctx)(wp)(cashpkt.CashShopOperationWriter)(cashpkt.CashShopEnableEquipSlotExtSuccessBody(e.Body.SlotIndex, e.Body.Days))(s)
+			if err != nil {
+				return err
+			}
+			w, err := wallet.NewProcessor(l, ctx).GetByAccountId(s.AccountId())
+			if err != nil {
+				l.WithError(err).Errorf("Unable to retrieve cash shop wallet for character [%d].", s.CharacterId())
+				w = wallet.Model{}
+			}
+			err = session.Announce(l)(ctx)(wp)(cashpkt.CashQueryResultWriter)(cashpkt.NewCashQueryResult(w.Credit(), w.Points(), w.Prepaid()).Encode)(s)
+			if err != nil {
+				l.WithError(err).Errorf("Unable to announce cash shop wallet to character [%d].", s.CharacterId())
+				return err
+			}
+			return nil
+		})
+	}
+}
+
+// handleStatusEventEquipSlotFailed announces on the ENABLE_EQUIP_SLOT_EXT_FAILED
+// arm, for the same reason COUPON_FAILED is not folded into ERROR.
+func handleStatusEventEquipSlotFailed(sc server.Model, wp writer.Producer) message.Handler[cashshop2.StatusEvent[cashshop2.EquipSlotFailedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e cashshop2.StatusEvent[cashshop2.EquipSlotFailedBody]) {
+		if e.Type != cashshop2.StatusEventTypeEquipSlotFailed {
+			return
+		}
+
+		t := tenant.MustFromContext(ctx)
+		if !t.Is(sc.Tenant()) {
+			return
+		}
+
+		op := session.Announce(l)(ctx)(wp)(cashpkt.CashShopOperationWriter)(cashpkt.CashShopEnableEquipSlotExtFailedBody(e.Body.Error))
+		_ = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.CharacterId, op)
+	}
+}
+
 func handleStatusEventError(sc server.Model, wp writer.Producer) message.Handler[cashshop2.StatusEvent[cashshop2.ErrorEventBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, e cashshop2.StatusEvent[cashshop2.ErrorEventBody]) {
 		if e.Type != cashshop2.StatusEventTypeError {
@@ -0,0 +1,275 @@
+package family
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	"atlas-channel/invite"
+	consumer2 "atlas-channel/kafka/consumer"
+	family2 "atlas-channel/kafka/message/family"
+	"atlas-channel/listener"
+	"atlas-channel/portal"
+	"atlas-channel/server"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	invite2 "github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("family_status_event")(family2.EnvEventTopicStatus)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+			rf(consumer2.NewConfig(l)("family_reputation_event")(family2.EnvEventTopicRep)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+			rf(consumer2.NewConfig(l)("family_error_event")(family2.EnvEventTopicErrors)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+	return func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+		return func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+			return func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+				var t string
+				var handles []listener.HandlerHandle
+				register := func(t string, h handler.Handler) error {
+					id, err := rf(t, h)
+					if err != nil {
+						return err
+					}
+					handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+					return nil
+				}
+
+				t, _ = topic.EnvProvider(l)(family2.EnvEventTopicStatus)()
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleLinkCreatedEvent(sc, wp)))); err != nil {
+					return nil, err
+				}
+				t, _ = topic.EnvProvider(l)(family2.EnvEventTopicRep)()
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleRepGainedEvent(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleEntitlementUsedEvent(sc, wp)))); err != nil {
+					return nil, err
+				}
+				t, _ = topic.EnvProvider(l)(family2.EnvEventTopicErrors)()
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleRepErrorEvent(sc, wp)))); err != nil {
+					return nil, err
+				}
+				if err := register(t, message.AdaptHandler(message.PersistentConfig(handleLinkErrorEvent(sc, wp)))); err != nil {
+					return nil, err
+				}
+				return handles, nil
+			}
+		}
+	}
+}
+
+// handleLinkCreatedEvent tells the senior their invite was accepted and the
+// junior who they now answer to.
+func handleLinkCreatedEvent(sc server.Model, wp writer.Producer) message.Handler[family2.Event[family2.LinkCreatedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e family2.Event[family2.LinkCreatedEventBody]) {
+		if e.Type != family2.EventTypeLinkCreated {
+			return
+		}
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		cp := character.NewProcessor(l, ctx)
+		senior, err := cp.GetById()(e.Body.SeniorId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to retrieve senior [%d] of new family link.", e.Body.SeniorId)
+			return
+		}
+		junior, err := cp.GetById()(e.Body.JuniorId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to retrieve junior [%d] of new family link.", e.Body.JuniorId)
+			return
+		}
+
+		sp := session.NewProcessor(l, ctx)
+		_ = sp.IfPresentByCharacterId(sc.Channel())(e.Body.SeniorId, session.Announce(l)(ctx)(wp)(familycb.FamilyJoinRequestResultWriter)(familycb.NewFamilyJoinRequestResult(true, junior.Name()).Encode))
+		_ = sp.IfPresentByCharacterId(sc.Channel())(e.Body.JuniorId, session.Announce(l)(ctx)(wp)(familycb.FamilyJoinAcceptedWriter)(familycb.NewFamilyJoinAccepted(senior.Name()).Encode))
+	}
+}
+
+func handleRepGainedEvent(sc server.Model, wp writer.Producer) message.Handler[family2.Event[family2.RepGainedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e family2.Event[family2.RepGainedEventBody]) {
+		if e.Type != family2.EventTypeRepGained {
+			return
+		}
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		_ = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.CharacterId, func(s session.Model) error {
+			fromName := ""
+			if e.Body.FromId != 0 {
+				if c, err := character.NewProcessor(l, ctx).GetById()(e.Body.FromId); err == nil {
+					fromName = c.Name()
+				}
+			}
+			return session.Announce(l)(ctx)(wp)(familycb.FamilyFamousPointIncResultWriter)(familycb.NewFamilyFamousPointIncResult(e.Body.RepGained, fromName).Encode)(s)
+		})
+	}
+}
+
+// handleEntitlementUsedEvent carries out a paid entitlement. Teleport and
+// summon act on characters in this channel; rate buffs are applied by
+// atlas-rates and only displayed here. The rep is already spent by the time
+// this runs, so a teleport or summon that cannot be carried out is refunded.
+func handleEntitlementUsedEvent(sc server.Model, wp writer.Producer) message.Handler[family2.Event[family2.EntitlementUsedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e family2.Event[family2.EntitlementUsedEventBody]) {
+		if e.Type != family2.EventTypeEntitlementUsed {
+			return
+		}
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		ent, ok := familyconst.GetEntitlement(familyconst.EntitlementType(e.Body.Entitlement))
+		if !ok {
+			return
+		}
+
+		sp := session.NewProcessor(l, ctx)
+		refund := func(reason string) {
+			if err := refundEntitlementFunc(l, ctx, e.WorldId, e.CharacterId, e.Body.Entitlement, reason); err != nil {
+				l.WithError(err).Errorf("Unable to refund family entitlement [%d] for character [%d].", e.Body.Entitlement, e.CharacterId)
+			}
+		}
+		switch ent.Type {
+		case familyconst.EntitlementTeleport:
+			_ = sp.IfPresentByCharacterId(sc.Channel())(e.CharacterId, func(s session.Model) error {
+				ts, err := sp.GetByCharacterId(sc.Channel())(e.Body.TargetId)
+				if err != nil {
+					l.WithError(err).Warnf("Family teleport target [%d] is not in the channel of character [%d].", e.Body.TargetId, e.CharacterId)
+					refund(refundReasonTargetUnreachable)
+					return err
+				}
+				if err = warpFunc(l, ctx, s.Field(), s.CharacterId(), ts.MapId()); err != nil {
+					l.WithError(err).Errorf("Unable to warp character [%d] to family member [%d].", e.CharacterId, e.Body.TargetId)
+					refund(refundReasonWarpFailed)
+					return err
+				}
+				return nil
+			})
+		case familyconst.EntitlementSummon:
+			_ = sp.IfPresentByCharacterId(sc.Channel())(e.CharacterId, func(s session.Model) error {
+				err := invite.NewProcessor(l, ctx).Create(s.CharacterId(), s.WorldId(), string(invite2.TypeFamilySummon), e.Body.TargetId, s.CharacterId())
+				if err != nil {
+					l.WithError(err).Errorf("Unable to invite family member [%d] to character [%d].", e.Body.TargetId, e.CharacterId)
+					refund(refundReasonInviteFailed)
+				}
+				return err
+			})
+		default:
+			if !ent.IsBuff() {
+				return
+			}
+			body := familycb.NewFamilySetPrivilege(privilegeBuffType(ent), uint32(ent.Type), uint32(ent.ExpRate*100), uint32(ent.DropRate*100), uint32(ent.Duration.Minutes())).Encode
+			for _, id := range e.Body.BeneficiaryIds {
+				_ = sp.IfPresentByCharacterId(sc.Channel())(id, session.Announce(l)(ctx)(wp)(familycb.FamilySetPrivilegeWriter)(body))
+			}
+		}
+	}
+}
+
+const (
+	refundReasonTargetUnreachable = "TARGET_UNREACHABLE"
+	refundReasonWarpFailed        = "WARP_FAILED"
+	refundReasonInviteFailed      = "INVITE_FAILED"
+)
+
+// refundEntitlementFunc and warpFunc are package seams so tests can observe
+// refunds without a Kafka producer.
+var refundEntitlementFunc = func(l logrus.FieldLogger, ctx context.Context, worldId world.Id, characterId uint32, entitlement byte, reason string) error {
+	return family.NewProcessor(l, ctx).RefundEntitlement(worldId, characterId, entitlement, reason)
+}
+
+var warpFunc = func(l logrus.FieldLogger, ctx context.Context, f field.Model, characterId uint32, mapId _map.Id) error {
+	return portal.NewProcessor(l, ctx).Warp(f, characterId, mapId)
+}
+
+// privilegeBuffType is the client's buff icon for a rate entitlement: 2 for
+// drop rate, 3 for EXP, 4 for both.
+func privilegeBuffType(e familyconst.Entitlement) byte {
+	switch {
+	case e.ExpRate > 1.0 && e.DropRate > 1.0:
+		return 4
+	case e.ExpRate > 1.0:
+		return 3
+	default:
+		return 2
+	}
+}
+
+func handleRepErrorEvent(sc server.Model, wp writer.Producer) message.Handler[family2.Event[family2.RepErrorEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e family2.Event[family2.RepErrorEventBody]) {
+		if e.Type != family2.EventTypeRepError {
+			return
+		}
+		announceError(l, ctx, sc, wp, e.WorldId, e.CharacterId, e.Body.ErrorCode)
+	}
+}
+
+func handleLinkErrorEvent(sc server.Model, wp writer.Producer) message.Handler[family2.Event[family2.LinkErrorEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e family2.Event[family2.LinkErrorEventBody]) {
+		if e.Type != family2.EventTypeLinkError {
+			return
+		}
+		announceError(l, ctx, sc, wp, e.WorldId, e.Body.SeniorId, e.Body.ErrorCode)
+	}
+}
+
+func announceError(l logrus.FieldLogger, ctx context.Context, sc server.Model, wp writer.Producer, worldId world.Id, characterId uint32, errorCode string) {
+	if !sc.IsWorld(tenant.MustFromContext(ctx), worldId) {
+		return
+	}
+	code, ok := resultCode(errorCode)
+	if !ok {
+		l.Debugf("Family error [%s] for character [%d] has no client message.", errorCode, characterId)
+		return
+	}
+	_ = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(characterId, session.Announce(l)(ctx)(wp)(familycb.FamilyResultWriter)(familypkt.FamilyResultBody(code)))
+}
+
+// resultCode maps an atlas-families error code to the family result the
+// client shows for it.
+func resultCode(errorCode string) (string, bool) {
+	switch errorCode {
+	case "SENIOR_NOT_FOUND", "JUNIOR_NOT_FOUND", "SELF_REFERENCE":
+		return familypkt.ResultCharacterNotFound, true
+	case "NOT_ON_SAME_MAP":
+		return familypkt.ResultNotSameMap, true
+	case "JUNIOR_ALREADY_LINKED":
+		return familypkt.ResultAlreadyHasSenior, true
+	case "TOO_MANY_JUNIORS":
+		return familypkt.ResultJuniorsFull, true
+	case "LEVEL_DIFFERENCE_TOO_LARGE":
+		return familypkt.ResultLevelGap, true
+	case "INSUFFICIENT_REP":
+		return familypkt.ResultInsufficientRep, true
+	case "ENTITLEMENT_LIMIT_REACHED":
+		return familypkt.ResultUsageLimitReached, true
+	case "TARGET_NOT_IN_FAMILY":
+		return familypkt.ResultTargetNotInFamily, true
+	}
+	return "", false
+}
@@ -0,0 +1,163 @@
+package family
+
+import (
+	family2 "atlas-channel/kafka/message/family"
+	"atlas-channel/server"
+	"atlas-channel/session"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	testlog "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func TestResultCode(t *testing.T) {
+	cases := map[string]string{
+		"JUNIOR_NOT_FOUND":           familypkt.ResultCharacterNotFound,
+		"NOT_ON_SAME_MAP":            familypkt.ResultNotSameMap,
+		"JUNIOR_ALREADY_LINKED":      familypkt.ResultAlreadyHasSenior,
+		"TOO_MANY_JUNIORS":           familypkt.ResultJuniorsFull,
+		"LEVEL_DIFFERENCE_TOO_LARGE": familypkt.ResultLevelGap,
+		"INSUFFICIENT_REP":           familypkt.ResultInsufficientRep,
+		"ENTITLEMENT_LIMIT_REACHED":  familypkt.ResultUsageLimitReached,
+		"TARGET_NOT_IN_FAMILY":       familypkt.ResultTargetNotInFamily,
+	}
+	for errorCode, want := range cases {
+		got, ok := resultCode(errorCode)
+		if !ok || got != want {
+			t.Errorf("resultCode(%s) = %s, %t; want %s", errorCode, got, ok, want)
+		}
+	}
+	if _, ok := resultCode("RESET_FAILED"); ok {
+		t.Errorf("internal errors must not reach the client")
+	}
+}
+
+func TestPrivilegeBuffType(t *testing.T) {
+	cases := map[familyconst.EntitlementType]byte{
+		familyconst.EntitlementSelfDrop15:         2,
+		familyconst.EntitlementSelfExp2:           3,
+		familyconst.EntitlementBonding:            4,
+		familyconst.EntitlementPartyExp2Extended:  3,
+		familyconst.EntitlementPartyDrop2Extended: 2,
+	}
+	for et, want := range cases {
+		e, _ := familyconst.GetEntitlement(et)
+		if got := privilegeBuffType(e); got != want {
+			t.Errorf("privilegeBuffType(%d) = %d, want %d", et, got, want)
+		}
+	}
+}
+
+type refundCall struct {
+	characterId uint32
+	entitlement byte
+	reason      string
+}
+
+// stubEntitlementSeams records refunds and warps instead of producing them.
+func stubEntitlementSeams(t *testing.T) (refunds *[]refundCall, warps *int) {
+	t.Helper()
+	refunds = &[]refundCall{}
+	warps = new(int)
+	origRefund, origWarp := refundEntitlementFunc, warpFunc
+	refundEntitlementFunc = func(_ logrus.FieldLogger, _ context.Context, _ world.Id, characterId uint32, entitlement byte, reason string) error {
+		*refunds = append(*refunds, refundCall{characterId, entitlement, reason})
+		return nil
+	}
+	warpFunc = func(_ logrus.FieldLogger, _ context.Context, _ field.Model, _ uint32, _ _map.Id) error {
+		*warps++
+		return nil
+	}
+	t.Cleanup(func() { refundEntitlementFunc, warpFunc = origRefund, origWarp })
+	return refunds, warps
+}
+
+// openSession registers a session for characterId on ch and drains whatever
+// the server writes to it.
+func openSession(t *testing.T, ctx context.Context, ch channel.Model, characterId uint32) {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	go func() { _, _ = io.Copy(io.Discard, clientConn) }()
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	})
+	l, _ := testlog.NewNullLogger()
+	sessionId := uuid.New()
+	session.NewProcessor(l, ctx).Create(ch, 0)(sessionId, serverConn)
+	session.NewProcessor(l, ctx).SetCharacterId(sessionId, characterId)
+}
+
+func teleportEvent(characterId uint32, targetId uint32) family2.Event[family2.EntitlementUsedEventBody] {
+	return family2.Event[family2.EntitlementUsedEventBody]{
+		WorldId:     0,
+		CharacterId: characterId,
+		Type:        family2.EventTypeEntitlementUsed,
+		Body: family2.EntitlementUsedEventBody{
+			Entitlement: byte(familyconst.EntitlementTeleport),
+			TargetId:    targetId,
+		},
+	}
+}
+
+func TestHandleEntitlementUsed_TeleportRefundedWhenTargetIsElsewhere(t *testing.T) {
+	tm, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatalf("tenant: %v", err)
+	}
+	ctx := tenant.WithContext(context.Background(), tm)
+	defer session.ClearRegistryForTenant(tm.Id())
+	l, _ := testlog.NewNullLogger()
+
+	ch := channel.NewModel(0, 0)
+	sc := server.NewProcessor(l, context.Background()).Register(tm, ch, "127.0.0.1", 8484)
+	openSession(t, ctx, ch, 1)
+
+	refunds, warps := stubEntitlementSeams(t)
+	handleEntitlementUsedEvent(sc, nil)(l, ctx, teleportEvent(1, 2))
+
+	if *warps != 0 {
+		t.Fatalf("warps = %d, want 0", *warps)
+	}
+	want := []refundCall{{1, byte(familyconst.EntitlementTeleport), refundReasonTargetUnreachable}}
+	if len(*refunds) != 1 || (*refunds)[0] != want[0] {
+		t.Fatalf("refunds = %+v, want %+v", *refunds, want)
+	}
+}
+
+func TestHandleEntitlementUsed_TeleportNotRefundedWhenWarped(t *testing.T) {
+	tm, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatalf("tenant: %v", err)
+	}
+	ctx := tenant.WithContext(context.Background(), tm)
+	defer session.ClearRegistryForTenant(tm.Id())
+	l, _ := testlog.NewNullLogger()
+
+	ch := channel.NewModel(0, 0)
+	sc := server.NewProcessor(l, context.Background()).Register(tm, ch, "127.0.0.1", 8484)
+	openSession(t, ctx, ch, 1)
+	openSession(t, ctx, ch, 2)
+
+	refunds, warps := stubEntitlementSeams(t)
+	handleEntitlementUsedEvent(sc, nil)(l, ctx, teleportEvent(1, 2))
+
+	if *warps != 1 {
+		t.Fatalf("warps = %d, want 1", *warps)
+	}
+	if len(*refunds) != 0 {
+		t.Fatalf("refunds = %+v, want none", *refunds)
+	}
+}
@@ -2,9 +2,11 @@ package invite
 
 import (
 	"atlas-channel/character"
+	"atlas-channel/family"
 	consumer2 "atlas-channel/kafka/consumer"
 	invite2 "atlas-channel/kafka/message/invite"
 	"atlas-channel/listener"
+	"atlas-channel/portal"
 	"atlas-channel/server"
 	"atlas-channel/session"
 	"atlas-channel/socket/writer"
@@ -19,7 +21,11 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	alliancepkt "github.com/Chronicle20/atlas/libs/atlas-packet/alliance"
+	alliancecb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
 	buddypkt "github.com/Chronicle20/atlas/libs/atlas-packet/buddy"
+	chatcb "github.com/Chronicle20/atlas/libs/atlas-packet/chat/clientbound"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
 	guildpkt "github.com/Chronicle20/atlas/libs/atlas-packet/guild"
 	guildcb "github.com/Chronicle20/atlas/libs/atlas-packet/guild/clientbound"
 	messengerpkt "github.com/Chronicle20/atlas/libs/atlas-packet/messenger"
@@ -53,6 +59,11 @@ func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Pro
 					return nil, err
 				}
 				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleAcceptedStatusEvent(sc))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
 				return handles, nil
 			}
 		}
@@ -84,6 +95,12 @@ func handleCreatedStatusEvent(sc server.Model, wp writer.Producer) message.Handl
 			eventHandler = handleGuildCreatedStatusEvent(l)(ctx)(wp)(uint32(e.ReferenceId), rc.Name())
 		} else if e.InviteType == invite.TypeMessenger {
 			eventHandler = handleMessengerCreatedStatusEvent(l)(ctx)(wp)(uint32(e.ReferenceId), rc.Name())
+		} else if e.InviteType == invite.TypeFamily {
+			eventHandler = handleFamilyCreatedStatusEvent(l)(ctx)(wp)(rc.Id(), rc.Name())
+		} else if e.InviteType == invite.TypeAlliance {
+			eventHandler = session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceInviteBody(uint32(e.ReferenceId), rc.Name()))
+		} else if e.InviteType == invite.TypeFamilySummon {
+			eventHandler = handleFamilySummonCreatedStatusEvent(l)(ctx)(wp)(rc.Name(), familyName(l, ctx, rc.Id()))
 		}
 
 		if eventHandler != nil {
@@ -132,6 +149,64 @@ func handleMessengerCreatedStatusEvent(l logrus.FieldLogger) func(ctx context.Co
 	}
 }
 
+func handleFamilyCreatedStatusEvent(l logrus.FieldLogger) func(ctx context.Context) func(wp writer.Producer) func(originatorId uint32, originatorName string) model.Operator[session.Model] {
+	return func(ctx context.Context) func(wp writer.Producer) func(originatorId uint32, originatorName string) model.Operator[session.Model] {
+		return func(wp writer.Producer) func(originatorId uint32, originatorName string) model.Operator[session.Model] {
+			return func(originatorId uint32, originatorName string) model.Operator[session.Model] {
+				return session.Announce(l)(ctx)(wp)(familycb.FamilyJoinRequestWriter)(familycb.NewFamilyJoinRequest(originatorId, originatorName).Encode)
+			}
+		}
+	}
+}
+
+func handleFamilySummonCreatedStatusEvent(l logrus.FieldLogger) func(ctx context.Context) func(wp writer.Producer) func(originatorName string, familyName string) model.Operator[session.Model] {
+	return func(ctx context.Context) func(wp writer.Producer) func(originatorName string, familyName string) model.Operator[session.Model] {
+		return func(wp writer.Producer) func(originatorName string, familyName string) model.Operator[session.Model] {
+			return func(originatorName string, familyName string) model.Operator[session.Model] {
+				return session.Announce(l)(ctx)(wp)(familycb.FamilySummonRequestWriter)(familycb.NewFamilySummonRequest(originatorName, familyName).Encode)
+			}
+		}
+	}
+}
+
+// familyName is the name of the leader of characterId's family.
+func familyName(l logrus.FieldLogger, ctx context.Context, characterId uint32) string {
+	tree, err := family.NewProcessor(l, ctx).GetTree(characterId)
+	if err != nil {
+		return ""
+	}
+	leader, err := character.NewProcessor(l, ctx).GetById()(tree.LeaderId(characterId))
+	if err != nil {
+		return ""
+	}
+	return leader.Name()
+}
+
+// handleAcceptedStatusEvent completes a family summon by moving the summoned
+// member to the summoner's map. Every other accepted invite is finished by the
+// service owning it.
+func handleAcceptedStatusEvent(sc server.Model) message.Handler[invite2.StatusEvent[invite2.AcceptedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e invite2.StatusEvent[invite2.AcceptedEventBody]) {
+		if e.Type != invite.StatusTypeAccepted || e.InviteType != invite.TypeFamilySummon {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		sp := session.NewProcessor(l, ctx)
+		_ = sp.IfPresentByCharacterId(sc.Channel())(uint32(e.Body.TargetId), func(s session.Model) error {
+			ss, err := sp.GetByCharacterId(sc.Channel())(uint32(e.Body.OriginatorId))
+			if err != nil {
+				l.WithError(err).Warnf("Family summoner [%d] left channel before [%d] arrived.", e.Body.OriginatorId, s.CharacterId())
+				return err
+			}
+			return portal.NewProcessor(l, ctx).Warp(s.Field(), s.CharacterId(), ss.MapId())
+		})
+	}
+}
+
 func handleRejectedStatusEvent(sc server.Model, wp writer.Producer) message.Handler[invite2.StatusEvent[invite2.RejectedEventBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, e invite2.StatusEvent[invite2.RejectedEventBody]) {
 		if e.Type != invite.StatusTypeRejected {
@@ -157,6 +232,10 @@ func handleRejectedStatusEvent(sc server.Model, wp writer.Producer) message.Hand
 			eventHandler = handleGuildRejectedStatusEvent(l)(ctx)(wp)(rc.Name())
 		} else if e.InviteType == invite.TypeMessenger {
 			eventHandler = handleMessengerRejectedStatusEvent(l)(ctx)(wp)(rc.Name())
+		} else if e.InviteType == invite.TypeFamily {
+			eventHandler = session.Announce(l)(ctx)(wp)(familycb.FamilyJoinRequestResultWriter)(familycb.NewFamilyJoinRequestResult(false, rc.Name()).Encode)
+		} else if e.InviteType == invite.TypeAlliance {
+			eventHandler = session.Announce(l)(ctx)(wp)(chatcb.WorldMessageWriter)(writer.WorldMessagePinkTextBody("", "", "The master of the guild that you offered an invitation has declined."))
 		}
 
 		if eventHandler != nil {
@@ -7,6 +7,7 @@ import (
 	"atlas-channel/listener"
 	_map "atlas-channel/map"
 	message2 "atlas-channel/message"
+	"atlas-channel/messenger"
 	"atlas-channel/pet"
 	"atlas-channel/server"
 	"atlas-channel/session"
@@ -241,7 +242,7 @@ func handleMessengerChat(sc server.Model, wp writer.Producer) message.Handler[me
 			return
 		}
 
-		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+		if !messenger.InScope(sc, ctx) {
 			return
 		}
 
@@ -312,6 +312,38 @@ func TestHandleWhisperChat_SameChannel_DeliversBothOnce(t *testing.T) {
 	expectNoPacket(t, recipReads, "recipient (same channel, second packet)")
 }
 
+// TestHandleWhisperChat_CashSceneRecipient_Delivers asserts a recipient in
+// the cash shop or MTS still receives the whisper: the session stays
+// registered with its channel across the cash-scene transition, so the
+// bound handler finds it like any other.
+func TestHandleWhisperChat_CashSceneRecipient_Delivers(t *testing.T) {
+	for _, scene := range []byte{session.CashSceneCashShop, session.CashSceneMts} {
+		t.Run(fmt.Sprintf("scene %d", scene), func(t *testing.T) {
+			tm := newTestTenant(t)
+			ctx := tenant.WithContext(context.Background(), tm)
+			defer session.ClearRegistryForTenant(tm.Id())
+
+			srv := characterServer(t, map[uint32]string{senderCharId: senderName})
+			t.Setenv("CHARACTERS_SERVICE_URL", srv.URL+"/")
+
+			recipCh := channel.NewModel(worldId, recipChannelId)
+			recipReads, cleanup := pipedSession(t, ctx, recipCh, recipientCharId)
+			defer cleanup()
+			sp := session.NewProcessor(nullLogger(), ctx)
+			rs, err := sp.GetByCharacterId(recipCh)(recipientCharId)
+			if err != nil {
+				t.Fatalf("recipient session: %v", err)
+			}
+			sp.SetCashScene(rs.SessionId(), scene)
+
+			sc := newServerModel(worldId, recipChannelId, tm)
+			handleWhisperChat(sc, fakeWriterProducer())(nullLogger(), ctx, whisperEvent())
+
+			expectPacket(t, recipReads, "recipient (cash scene)")
+		})
+	}
+}
+
 // The following regression guards cover the sibling bug reported in
 // docs/tasks/fix-whisper-cross-channel-delivery/bug-cross-channel-chat-siblings.md:
 // handleMultiChat, handleMessengerChat, and handlePinkChat carried the same
@@ -23,7 +23,6 @@ import (
 	messengerpkt "github.com/Chronicle20/atlas/libs/atlas-packet/messenger"
 	messengercb "github.com/Chronicle20/atlas/libs/atlas-packet/messenger/clientbound"
 	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
-	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
 
 func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
@@ -51,6 +50,16 @@ func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Pro
 					return nil, err
 				}
 				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleDisconnected(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleRejoined(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
 				return handles, nil
 			}
 		}
@@ -63,7 +72,7 @@ func handleLeft(sc server.Model, wp writer.Producer) message.Handler[messenger2.
 			return
 		}
 
-		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+		if !messenger.InScope(sc, ctx) {
 			return
 		}
 
@@ -113,7 +122,7 @@ func handleJoin(sc server.Model, wp writer.Producer) message.Handler[messenger2.
 			return
 		}
 
-		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+		if !messenger.InScope(sc, ctx) {
 			return
 		}
 
@@ -180,3 +189,125 @@ func handleJoin(sc server.Model, wp writer.Producer) message.Handler[messenger2.
 		})
 	}
 }
+
+func handleDisconnected(sc server.Model, wp writer.Producer) message.Handler[messenger2.StatusEvent[messenger2.DisconnectedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e messenger2.StatusEvent[messenger2.DisconnectedEventBody]) {
+		if e.Type != messenger2.EventMessengerStatusTypeDisconnected {
+			return
+		}
+
+		if !messenger.InScope(sc, ctx) {
+			return
+		}
+
+		p, err := messenger.NewProcessor(l, ctx).GetById(e.MessengerId)
+		if err != nil {
+			l.WithError(err).Errorf("Received disconnected event for messenger [%d] which does not exist.", e.MessengerId)
+			return
+		}
+
+		// The seat is held, but the avatar is cleared for the remaining members until they rejoin.
+		routine.Go(l, ctx, func(_ context.Context) {
+			for _, m := range p.Members() {
+				if m.Id() == e.ActorId {
+					continue
+				}
+				err := session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(m.Id(), messengerLeft(l)(ctx)(wp)(e.Body.Slot))
+				if err != nil {
+					l.WithError(err).Errorf("Unable to announce character [%d] has disconnected from messenger [%d].", e.ActorId, p.Id())
+				}
+			}
+		})
+	}
+}
+
+func handleRejoined(sc server.Model, wp writer.Producer) message.Handler[messenger2.StatusEvent[messenger2.RejoinedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e messenger2.StatusEvent[messenger2.RejoinedEventBody]) {
+		if e.Type != messenger2.EventMessengerStatusTypeRejoined {
+			return
+		}
+
+		if !messenger.InScope(sc, ctx) {
+			return
+		}
+
+		mp := messenger.NewProcessor(l, ctx)
+		p, err := mp.GetById(e.MessengerId)
+		if err != nil {
+			l.WithError(err).Errorf("Received rejoined event for messenger [%d] which does not exist.", e.MessengerId)
+			return
+		}
+		mm, err := p.FindMember(e.ActorId)
+		if err != nil {
+			l.WithError(err).Errorf("Received rejoined event for character [%d] not in messenger [%d].", e.ActorId, e.MessengerId)
+			return
+		}
+
+		cp := character.NewProcessor(l, ctx)
+		tc, err := cp.GetById(cp.InventoryDecorator, cp.PetAssetEnrichmentDecorator)(e.ActorId)
+		if err != nil {
+			l.WithError(err).Errorf("Received rejoined event for character [%d] which does not exist.", e.ActorId)
+			return
+		}
+
+		// Remaining members see the avatar restored on its new channel.
+		routine.Go(l, ctx, func(_ context.Context) {
+			for _, m := range p.Members() {
+				if m.Id() == e.ActorId || !m.Online() {
+					continue
+				}
+				ava := socketmodel.NewFromCharacter(tc, true)
+				bp := session.Announce(l)(ctx)(wp)(messengercb.MessengerOperationWriter)(messengerpkt.MessengerOperationAddBody(e.Body.Slot, ava, tc.Name(), byte(mm.ChannelId())))
+				err := session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(m.Id(), bp)
+				if err != nil {
+					l.WithError(err).Errorf("Unable to announce character [%d] has rejoined messenger [%d].", tc.Id(), p.Id())
+				}
+			}
+		})
+		// A plain channel change keeps the member's own room open; only a member returning from a logout reopens it.
+		if !e.Body.Reconnected {
+			return
+		}
+		// The rejoining member reopens the room, sees who is connected and gets the transcript replayed.
+		routine.Go(l, ctx, func(_ context.Context) {
+			err := session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.ActorId, func(s session.Model) error {
+				err := session.Announce(l)(ctx)(wp)(messengercb.MessengerOperationWriter)(messengerpkt.MessengerOperationJoinBody(e.Body.Slot))(s)
+				if err != nil {
+					return err
+				}
+
+				for _, m := range p.Members() {
+					if m.Id() == e.ActorId || !m.Online() {
+						continue
+					}
+					mc, err := cp.GetById(cp.InventoryDecorator, cp.PetAssetEnrichmentDecorator)(m.Id())
+					if err != nil {
+						continue
+					}
+					mAva := socketmodel.NewFromCharacter(mc, true)
+					err = session.Announce(l)(ctx)(wp)(messengercb.MessengerOperationWriter)(messengerpkt.MessengerOperationAddBody(m.Slot(), mAva, mc.Name(), byte(m.ChannelId())))(s)
+					if err != nil {
+						l.WithError(err).Errorf("Unable to show member [%d] of messenger [%d] to [%d].", m.Id(), p.Id(), tc.Id())
+					}
+				}
+
+				ls, err := mp.GetTranscript(p.Id())
+				if err != nil {
+					l.WithError(err).Warnf("Unable to retrieve transcript for messenger [%d].", p.Id())
+					return nil
+				}
+				for _, line := range ls {
+					err = session.Announce(l)(ctx)(wp)(messengercb.MessengerOperationWriter)(messengerpkt.MessengerOperationChatBody(line.Message()))(s)
+					if err != nil {
+						l.WithError(err).Errorf("Unable to replay messenger [%d] transcript to [%d].", p.Id(), tc.Id())
+						break
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				l.WithError(err).Errorf("Unable to announce character [%d] has rejoined messenger [%d].", tc.Id(), p.Id())
+			}
+		})
+	}
+}
@@ -108,6 +108,11 @@ func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Pro
 					return nil, err
 				}
 				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventResisted(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
 				id, err = rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventNextSkillDecided(sc, wp))))
 				if err != nil {
 					return nil, err
@@ -206,6 +211,7 @@ func handleStatusEventDestroyed(sc server.Model, wp writer.Producer) message.Han
 		monster.GetNextSkillInbox().Evict(t, e.UniqueId)
 		monster.GetStatusMirror().OnMonsterGone(t, e.UniqueId)
 		monster.GetLiveMirror().Remove(t, e.UniqueId)
+		monster.GetStolenRegistry().OnMonsterGone(t, e.UniqueId)
 	}
 }
 
@@ -304,6 +310,7 @@ func handleStatusEventKilled(sc server.Model, wp writer.Producer) message.Handle
 		}
 		monster.GetStatusMirror().OnMonsterGone(tenant.MustFromContext(ctx), e.UniqueId)
 		monster.GetLiveMirror().Remove(tenant.MustFromContext(ctx), e.UniqueId)
+		monster.GetStolenRegistry().OnMonsterGone(tenant.MustFromContext(ctx), e.UniqueId)
 	}
 }
 
@@ -655,6 +662,32 @@ func handleDamageReflected(sc server.Model) message.Handler[monster2.StatusEvent
 	}
 }
 
+// handleStatusEventResisted shows the attacker that a monster shrugged off
+// their attack or status (elemental immunity/resistance, boss immunity).
+// Pre-Big-Bang clients have no dedicated immune indicator for player
+// attacks, so a zero-damage MonsterDamage is sent to the attacker alone; the
+// client renders it as a MISS over the monster.
+func handleStatusEventResisted(sc server.Model, wp writer.Producer) message.Handler[monster2.StatusEvent[monster2.StatusEventResistedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e monster2.StatusEvent[monster2.StatusEventResistedBody]) {
+		if e.Type != monster2.EventStatusResisted {
+			return
+		}
+		if !sc.Is(tenant.MustFromContext(ctx), e.WorldId, e.ChannelId) {
+			return
+		}
+
+		l.Debugf("Monster [%d] resisted character [%d] skill [%d]: reason [%s] element [%s] outcome [%s].", e.UniqueId, e.Body.SourceCharacterId, e.Body.SourceSkillId, e.Body.Reason, e.Body.Element, e.Body.Outcome)
+		m, err := monster.NewProcessor(l, ctx).GetById(e.UniqueId)
+		if err != nil {
+			return
+		}
+		sf := session.Announce(l)(ctx)(wp)(monsterpkt.MonsterDamageWriter)(monsterpkt.NewMonsterDamage(m.UniqueId(), monsterpkt.MonsterDamageTypeUnk3, 0, m.Hp(), m.MaxHp()).Encode)
+		if err = session.NewProcessor(l, ctx).IfPresentByCharacterId(sc.Channel())(e.Body.SourceCharacterId, sf); err != nil {
+			l.WithError(err).Errorf("Unable to show monster [%d] resist to character [%d].", e.UniqueId, e.Body.SourceCharacterId)
+		}
+	}
+}
+
 func handleStatusEventNextSkillDecided(sc server.Model, _ writer.Producer) message.Handler[monster2.StatusEvent[monster2.StatusEventNextSkillDecidedBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, e monster2.StatusEvent[monster2.StatusEventNextSkillDecidedBody]) {
 		if e.Type != monster2.EventStatusNextSkillDecided {
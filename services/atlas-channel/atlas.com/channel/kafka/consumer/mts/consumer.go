@@ -492,18 +492,19 @@ func handleListingSold(sc server.Model, wp writer.Producer) message.Handler[mtsm
 			// Refresh the POSTER's My Page -> Offers: the want-ad they posted was
 			// consumed by the accept, so it drops off that panel.
 			announceOwnWantAds(l, ctx, sc, wp, e.Body.WorldId, e.Body.BuyerId)
-		case mtsmsg.ResultKindAuctionSettle:
-			// An auction settled at expiry to its winner. The SuccessBidInfoResult arm
-			// is sent to BOTH parties: the buyer (winner) with soldFlag=bought and the
-			// seller with soldFlag=sold, each carrying the sold item id, the winning
-			// price, and the contract timestamp.
+		case mtsmsg.ResultKindAuctionSettle, mtsmsg.ResultKindBuyOrder:
+			// An auction settled at expiry to its winner, or a standing buy order
+			// filled against a new listing. Neither party is waiting on a buy
+			// confirmation, so the SuccessBidInfoResult arm is sent to BOTH parties: the
+			// buyer with soldFlag=bought and the seller with soldFlag=sold, each
+			// carrying the sold item id, the price, and the contract timestamp.
 			contractDate := packetmodel.MsTimeBytes(time.Now())
 			announceTo(l, ctx, sc, wp, e.Body.BuyerId, fieldpkt.MtsOperationSuccessBidInfoResultBody(mtsSoldFlagBought, e.Body.ItemId, e.Body.Price, contractDate))
 			if e.Body.SellerId != 0 {
 				announceTo(l, ctx, sc, wp, e.Body.SellerId, fieldpkt.MtsOperationSuccessBidInfoResultBody(mtsSoldFlagSold, e.Body.ItemId, e.Body.Price, contractDate))
 			}
-			// Refresh the seller's Not-Yet-Sold (the sold auction leaves it) and the
-			// buyer's Transfer Inventory (the won item now sits there, ready to take home).
+			// Refresh the seller's Not-Yet-Sold (the sold listing leaves it) and the
+			// buyer's Transfer Inventory (the item now sits there, ready to take home).
 			if e.Body.SellerId != 0 {
 				announceUserSaleList(l, ctx, sc, wp, e.Body.WorldId, e.Body.SellerId)
 			}
@@ -0,0 +1,121 @@
+package presence
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	consumer2 "atlas-channel/kafka/consumer"
+	presence2 "atlas-channel/kafka/message/presence"
+	"atlas-channel/listener"
+	"atlas-channel/server"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("character_presence_status_event")(presence2.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+	return func(sc server.Model) func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+		return func(wp writer.Producer) func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+			return func(rf func(topic string, handler handler.Handler) (string, error)) ([]listener.HandlerHandle, error) {
+				var t string
+				var handles []listener.HandlerHandle
+				t, _ = topic.EnvProvider(l)(presence2.EnvStatusEventTopic)()
+				id, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventUpdated(sc, wp))))
+				if err != nil {
+					return nil, err
+				}
+				handles = append(handles, listener.HandlerHandle{Topic: t, Id: id})
+				return handles, nil
+			}
+		}
+	}
+}
+
+// handleStatusEventUpdated keeps the family views of relatives connected to
+// this channel current, wherever the moving relative is connected. Login and
+// logout edges are also announced; every transition, including channel, map
+// and cash shop moves, re-pushes the pedigree chart with the new state.
+func handleStatusEventUpdated(sc server.Model, wp writer.Producer) message.Handler[presence2.StatusEvent[presence2.UpdatedStatusEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e presence2.StatusEvent[presence2.UpdatedStatusEventBody]) {
+		if e.Type != presence2.StatusEventTypeUpdated {
+			return
+		}
+
+		if !sc.IsWorld(tenant.MustFromContext(ctx), e.WorldId) {
+			return
+		}
+
+		tree, err := familyTreeFunc(l, ctx, e.CharacterId)
+		if err != nil || len(tree) == 0 {
+			return
+		}
+
+		loggedIn := e.Body.PreviousState == presence2.StateOffline && e.Body.State != presence2.StateOffline
+		loggedOut := e.Body.PreviousState != presence2.StateOffline && e.Body.State == presence2.StateOffline
+		name := ""
+		if loggedIn || loggedOut {
+			c, err := character.NewProcessor(l, ctx).GetById()(e.CharacterId)
+			if err != nil {
+				l.WithError(err).Errorf("Unable to retrieve character [%d] to announce family presence.", e.CharacterId)
+				return
+			}
+			name = c.Name()
+		}
+
+		sp := session.NewProcessor(l, ctx)
+		for _, m := range tree {
+			if m.CharacterId() == e.CharacterId {
+				continue
+			}
+			err = sp.IfPresentByCharacterId(sc.Channel())(m.CharacterId(), func(s session.Model) error {
+				return announceFamilyPresenceFunc(l, ctx, wp, s, loggedIn || loggedOut, loggedIn, name)
+			})
+			if err != nil {
+				l.WithError(err).Errorf("Unable to announce character [%d] presence to family member [%d].", e.CharacterId, m.CharacterId())
+			}
+		}
+	}
+}
+
+// familyTreeFunc and announceFamilyPresenceFunc are package seams so tests
+// can observe which relatives a presence change reaches without
+// atlas-families or a socket writer.
+var familyTreeFunc = func(l logrus.FieldLogger, ctx context.Context, characterId uint32) (family.Tree, error) {
+	return family.NewProcessor(l, ctx).GetTree(characterId)
+}
+
+var announceFamilyPresenceFunc = announceFamilyPresence
+
+// announceFamilyPresence tells the recipient a relative logged in or out,
+// when that is what happened, and refreshes their pedigree chart.
+func announceFamilyPresence(l logrus.FieldLogger, ctx context.Context, wp writer.Producer, s session.Model, edge bool, loggedIn bool, name string) error {
+	if edge {
+		err := session.Announce(l)(ctx)(wp)(familycb.FamilyNotifyLoginOrLogoutWriter)(familycb.NewFamilyNotifyLoginOrLogout(loggedIn, name).Encode)(s)
+		if err != nil {
+			return err
+		}
+	}
+	tree, err := familyTreeFunc(l, ctx, s.CharacterId())
+	if err != nil {
+		return err
+	}
+	return session.Announce(l)(ctx)(wp)(familycb.FamilyChartResultWriter)(family.ChartResult(l, ctx, tree, s.CharacterId(), s.CharacterId()).Encode)(s)
+}
@@ -0,0 +1,108 @@
+package presence
+
+import (
+	"atlas-channel/family"
+	presence2 "atlas-channel/kafka/message/presence"
+	"atlas-channel/server"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	testlog "github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// openSession registers a session for characterId on ch and drains whatever
+// the server writes to it.
+func openSession(t *testing.T, ctx context.Context, ch channel.Model, characterId uint32) {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	go func() { _, _ = io.Copy(io.Discard, clientConn) }()
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	})
+	l, _ := testlog.NewNullLogger()
+	sessionId := uuid.New()
+	session.NewProcessor(l, ctx).Create(ch, 0)(sessionId, serverConn)
+	session.NewProcessor(l, ctx).SetCharacterId(sessionId, characterId)
+}
+
+type familyAnnouncement struct {
+	characterId uint32
+	edge        bool
+}
+
+// stubFamilySeams serves tree for every lookup and records announcements
+// instead of writing them.
+func stubFamilySeams(t *testing.T, tree family.Tree) *[]familyAnnouncement {
+	t.Helper()
+	announced := &[]familyAnnouncement{}
+	origTree, origAnnounce := familyTreeFunc, announceFamilyPresenceFunc
+	familyTreeFunc = func(_ logrus.FieldLogger, _ context.Context, _ uint32) (family.Tree, error) {
+		return tree, nil
+	}
+	announceFamilyPresenceFunc = func(_ logrus.FieldLogger, _ context.Context, _ writer.Producer, s session.Model, edge bool, _ bool, _ string) error {
+		*announced = append(*announced, familyAnnouncement{s.CharacterId(), edge})
+		return nil
+	}
+	t.Cleanup(func() { familyTreeFunc, announceFamilyPresenceFunc = origTree, origAnnounce })
+	return announced
+}
+
+func familyTree(t *testing.T, ids ...uint32) family.Tree {
+	t.Helper()
+	tree := make(family.Tree, 0, len(ids))
+	for _, id := range ids {
+		m, err := family.Extract(family.RestModel{CharacterId: id})
+		if err != nil {
+			t.Fatalf("extract: %v", err)
+		}
+		tree = append(tree, m)
+	}
+	return tree
+}
+
+func TestHandleStatusEventUpdated_ChannelToCashShopReachesFamily(t *testing.T) {
+	tm, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatalf("tenant: %v", err)
+	}
+	ctx := tenant.WithContext(context.Background(), tm)
+	defer session.ClearRegistryForTenant(tm.Id())
+	l, _ := testlog.NewNullLogger()
+
+	ch := channel.NewModel(0, 0)
+	sc := server.NewProcessor(l, context.Background()).Register(tm, ch, "127.0.0.1", 8484)
+	openSession(t, ctx, ch, 1)
+	openSession(t, ctx, ch, 2)
+	openSession(t, ctx, ch, 3)
+
+	// Character 3 is connected here but is not family; relative 4 is connected elsewhere.
+	announced := stubFamilySeams(t, familyTree(t, 1, 2, 4))
+
+	handleStatusEventUpdated(sc, nil)(l, ctx, presence2.StatusEvent[presence2.UpdatedStatusEventBody]{
+		WorldId:     0,
+		CharacterId: 1,
+		Type:        presence2.StatusEventTypeUpdated,
+		Body: presence2.UpdatedStatusEventBody{
+			State:             presence2.StateCashShop,
+			ChannelId:         0,
+			PreviousState:     presence2.StateOnline,
+			PreviousChannelId: 0,
+		},
+	})
+
+	got := *announced
+	want := []familyAnnouncement{{2, false}}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("announced = %+v, want %+v", got, want)
+	}
+}
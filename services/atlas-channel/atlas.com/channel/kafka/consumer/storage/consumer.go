@@ -164,7 +164,7 @@ func handleArrangedEvent(sc server.Model, wp writer.Producer) message.Handler[st
 
 		// Fetch the updated storage data and resend to client
 		err := session.NewProcessor(l, ctx).IfPresentByAccountId(sc.Channel())(e.AccountId, func(s session.Model) error {
-			storageData, err := storage.NewProcessor(l, ctx).GetStorageData(e.AccountId, e.WorldId)
+			storageData, err := storage.NewProcessor(l, ctx).GetStorageTabData(e.AccountId, e.WorldId, e.Body.Tab)
 			if err != nil {
 				return err
 			}
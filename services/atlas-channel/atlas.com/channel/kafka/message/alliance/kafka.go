@@ -0,0 +1,123 @@
+package alliance
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic              = "COMMAND_TOPIC_GUILD_ALLIANCE"
+	CommandTypeRequestInvite     = "REQUEST_INVITE"
+	CommandTypeLeave             = "LEAVE"
+	CommandTypeExpel             = "EXPEL"
+	CommandTypeChangeNotice      = "CHANGE_NOTICE"
+	CommandTypeChangeTitles      = "CHANGE_TITLES"
+	CommandTypeChangeMemberTitle = "CHANGE_MEMBER_TITLE"
+)
+
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	CharacterId   uint32    `json:"characterId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type RequestInviteBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type LeaveBody struct{}
+
+type ExpelBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type ChangeNoticeBody struct {
+	Notice string `json:"notice"`
+}
+
+type ChangeTitlesBody struct {
+	Titles []string `json:"titles"`
+}
+
+type ChangeMemberTitleBody struct {
+	TargetId uint32 `json:"targetId"`
+	Title    byte   `json:"title"`
+}
+
+const (
+	EnvStatusEventTopic               = "EVENT_TOPIC_GUILD_ALLIANCE_STATUS"
+	StatusEventTypeCreated            = "CREATED"
+	StatusEventTypeDisbanded          = "DISBANDED"
+	StatusEventTypeGuildJoined        = "GUILD_JOINED"
+	StatusEventTypeGuildLeft          = "GUILD_LEFT"
+	StatusEventTypeNoticeUpdated      = "NOTICE_UPDATED"
+	StatusEventTypeTitlesUpdated      = "TITLES_UPDATED"
+	StatusEventTypeMemberTitleUpdated = "MEMBER_TITLE_UPDATED"
+	StatusEventTypeCapacityUpdated    = "CAPACITY_UPDATED"
+	StatusEventTypeError              = "ERROR"
+
+	ErrorNameInUse         = "ALLIANCE_NAME_IN_USE"
+	ErrorInvalidName       = "ALLIANCE_NAME_INVALID"
+	ErrorNotGuildLeader    = "NOT_GUILD_LEADER"
+	ErrorAlreadyInAlliance = "ALREADY_IN_ALLIANCE"
+	ErrorNotEnoughMeso     = "NOT_ENOUGH_MESO"
+	ErrorNotAuthorized     = "NOT_AUTHORIZED"
+	ErrorAllianceFull      = "ALLIANCE_FULL"
+	ErrorCapacityMaxed     = "CAPACITY_MAXED"
+	ErrorMasterGuild       = "MASTER_GUILD_CANNOT_LEAVE"
+	ErrorNotInAlliance     = "NOT_IN_ALLIANCE"
+	ErrorInvalidTitle      = "INVALID_TITLE"
+)
+
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type StatusEventCreatedBody struct {
+	Name     string `json:"name"`
+	GuildId  uint32 `json:"guildId"`
+	LeaderId uint32 `json:"leaderId"`
+}
+
+type StatusEventDisbandedBody struct {
+	GuildIds []uint32 `json:"guildIds"`
+}
+
+type StatusEventGuildJoinedBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type StatusEventGuildLeftBody struct {
+	GuildId uint32 `json:"guildId"`
+	Force   bool   `json:"force"`
+}
+
+type StatusEventNoticeUpdatedBody struct {
+	Notice string `json:"notice"`
+}
+
+type StatusEventTitlesUpdatedBody struct {
+	Titles []string `json:"titles"`
+}
+
+type StatusEventMemberTitleUpdatedBody struct {
+	CharacterId uint32 `json:"characterId"`
+	Title       byte   `json:"title"`
+}
+
+type StatusEventCapacityUpdatedBody struct {
+	Capacity uint32 `json:"capacity"`
+}
+
+type StatusEventErrorBody struct {
+	ActorId uint32 `json:"actorId"`
+	Error   string `json:"error"`
+}
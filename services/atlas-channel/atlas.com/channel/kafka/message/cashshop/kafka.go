@@ -16,6 +16,7 @@ const (
 	CommandTypeRequestStorageIncrease             = "REQUEST_STORAGE_INCREASE"
 	CommandTypeRequestStorageIncreaseByItem       = "REQUEST_STORAGE_INCREASE_BY_ITEM"
 	CommandTypeRequestCharacterSlotIncreaseByItem = "REQUEST_CHARACTER_SLOT_INCREASE_BY_ITEM"
+	CommandTypeRequestEquipSlotEnableByItem       = "REQUEST_EQUIP_SLOT_ENABLE_BY_ITEM"
 	CommandTypeMoveFromCashInventory              = "MOVE_FROM_CASH_INVENTORY"
 	CommandTypeOpenSurprise                       = "OPEN_SURPRISE"
 	CommandTypeRequestCouponRedemption            = "REQUEST_COUPON_REDEMPTION"
@@ -63,6 +64,11 @@ type RequestCharacterSlotIncreaseByItemCommandBody struct {
 	SerialNumber uint32 `json:"serialNumber"`
 }
 
+type RequestEquipSlotEnableByItemCommandBody struct {
+	Currency     uint32 `json:"currency"`
+	SerialNumber uint32 `json:"serialNumber"`
+}
+
 type MoveFromCashInventoryCommandBody struct {
 	SerialNumber  uint64 `json:"serialNumber"`
 	InventoryType byte   `json:"inventoryType"`
@@ -105,6 +111,8 @@ const (
 	StatusEventTypeSurpriseFailed             = "SURPRISE_FAILED"
 	StatusEventTypeCouponRedeemed             = "COUPON_REDEEMED"
 	StatusEventTypeCouponFailed               = "COUPON_FAILED"
+	StatusEventTypeEquipSlotEnabled           = "EQUIP_SLOT_ENABLED"
+	StatusEventTypeEquipSlotFailed            = "EQUIP_SLOT_FAILED"
 )
 
 // TODO multiple services have different impl of this
@@ -199,3 +207,16 @@ type CouponRedeemedBody struct {
 type CouponFailedBody struct {
 	Error string `json:"error"`
 }
+
+// EquipSlotEnabledBody carries the ENABLE_EQUIP_SLOT_EXT_SUCCESS arm's slot
+// index and days; zero days is a permanent expansion.
+type EquipSlotEnabledBody struct {
+	SlotIndex uint16 `json:"slotIndex"`
+	Days      uint16 `json:"days"`
+}
+
+// EquipSlotFailedBody is distinct from ErrorEventBody for the same reason as
+// CouponFailedBody: it is announced on the ENABLE_EQUIP_SLOT_EXT_FAILED arm.
+type EquipSlotFailedBody struct {
+	Error string `json:"error"`
+}
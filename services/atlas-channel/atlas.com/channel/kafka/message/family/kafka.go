@@ -0,0 +1,102 @@
+package family
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic     = "COMMAND_TOPIC_FAMILY"
+	EnvEventTopicStatus = "EVENT_TOPIC_FAMILY_STATUS"
+	EnvEventTopicErrors = "EVENT_TOPIC_FAMILY_ERRORS"
+	EnvEventTopicRep    = "EVENT_TOPIC_FAMILY_REPUTATION"
+
+	CommandTypeBreakLink         = "BREAK_LINK"
+	CommandTypeUseEntitlement    = "USE_ENTITLEMENT"
+	CommandTypeRefundEntitlement = "REFUND_ENTITLEMENT"
+
+	EventTypeLinkCreated     = "LINK_CREATED"
+	EventTypeLinkBroken      = "LINK_BROKEN"
+	EventTypeRepGained       = "REP_GAINED"
+	EventTypeEntitlementUsed = "ENTITLEMENT_USED"
+	EventTypeRepError        = "REP_ERROR"
+	EventTypeLinkError       = "LINK_ERROR"
+)
+
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	CharacterId   uint32    `json:"characterId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type BreakLinkCommandBody struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type UseEntitlementCommandBody struct {
+	Entitlement    byte     `json:"entitlement"`
+	TargetId       uint32   `json:"targetId,omitempty"`
+	BeneficiaryIds []uint32 `json:"beneficiaryIds,omitempty"`
+}
+
+type RefundEntitlementCommandBody struct {
+	Entitlement byte   `json:"entitlement"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+type Event[E any] struct {
+	WorldId     world.Id `json:"worldId"`
+	CharacterId uint32   `json:"characterId"`
+	Type        string   `json:"type"`
+	Body        E        `json:"body"`
+}
+
+type LinkCreatedEventBody struct {
+	SeniorId  uint32    `json:"seniorId"`
+	JuniorId  uint32    `json:"juniorId"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type LinkBrokenEventBody struct {
+	SeniorId  uint32    `json:"seniorId"`
+	JuniorId  uint32    `json:"juniorId"`
+	Reason    string    `json:"reason"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type RepGainedEventBody struct {
+	RepGained uint32    `json:"repGained"`
+	DailyRep  uint32    `json:"dailyRep"`
+	Source    string    `json:"source"`
+	FromId    uint32    `json:"fromId,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type EntitlementUsedEventBody struct {
+	Entitlement    byte      `json:"entitlement"`
+	RepCost        uint32    `json:"repCost"`
+	Rep            uint32    `json:"rep"`
+	TargetId       uint32    `json:"targetId,omitempty"`
+	BeneficiaryIds []uint32  `json:"beneficiaryIds,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+type RepErrorEventBody struct {
+	ErrorCode    string    `json:"errorCode"`
+	ErrorMessage string    `json:"errorMessage"`
+	Amount       uint32    `json:"amount"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+type LinkErrorEventBody struct {
+	SeniorId     uint32    `json:"seniorId"`
+	JuniorId     uint32    `json:"juniorId"`
+	ErrorCode    string    `json:"errorCode"`
+	ErrorMessage string    `json:"errorMessage"`
+	Timestamp    time.Time `json:"timestamp"`
+}
@@ -19,6 +19,12 @@ type Command[E any] struct {
 	Body       E                  `json:"body"`
 }
 
+type CreateCommandBody struct {
+	OriginatorId character.Id `json:"originatorId"`
+	TargetId     character.Id `json:"targetId"`
+	ReferenceId  invite.Id    `json:"referenceId"`
+}
+
 type AcceptCommandBody struct {
 	TargetId    character.Id `json:"targetId"`
 	ReferenceId invite.Id    `json:"referenceId"`
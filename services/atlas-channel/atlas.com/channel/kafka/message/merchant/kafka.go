@@ -117,6 +117,7 @@ type CommandPurchaseBundleBody struct {
 	ShopId       string `json:"shopId"`
 	ListingIndex uint16 `json:"listingIndex"`
 	BundleCount  uint16 `json:"bundleCount"`
+	BuyerName    string `json:"buyerName"`
 }
 
 type CommandWithdrawMesoBody struct {
@@ -28,11 +28,13 @@ type RequestInviteBody struct {
 }
 
 const (
-	EnvEventStatusTopic             = "EVENT_TOPIC_MESSENGER_STATUS"
-	EventMessengerStatusTypeCreated = "CREATED"
-	EventMessengerStatusTypeJoined  = "JOINED"
-	EventMessengerStatusTypeLeft    = "LEFT"
-	EventMessengerStatusTypeError   = "ERROR"
+	EnvEventStatusTopic                  = "EVENT_TOPIC_MESSENGER_STATUS"
+	EventMessengerStatusTypeCreated      = "CREATED"
+	EventMessengerStatusTypeJoined       = "JOINED"
+	EventMessengerStatusTypeLeft         = "LEFT"
+	EventMessengerStatusTypeDisconnected = "DISCONNECTED"
+	EventMessengerStatusTypeRejoined     = "REJOINED"
+	EventMessengerStatusTypeError        = "ERROR"
 )
 
 type StatusEvent[E any] struct {
@@ -53,6 +55,15 @@ type LeftEventBody struct {
 	Slot byte `json:"slot"`
 }
 
+type DisconnectedEventBody struct {
+	Slot byte `json:"slot"`
+}
+
+type RejoinedEventBody struct {
+	Slot        byte `json:"slot"`
+	Reconnected bool `json:"reconnected"`
+}
+
 type ErrorEventBody struct {
 	Type          string `json:"type"`
 	CharacterName string `json:"characterName"`
@@ -20,6 +20,7 @@ const (
 	CommandTypeKill           = "KILL"
 	CommandTypeClearAggro     = "CLEAR_AGGRO"
 	CommandTypeForceControl   = "FORCE_CONTROL"
+	CommandTypeWeakenElement  = "WEAKEN_ELEMENT"
 )
 
 type DamageFriendlyCommandBody struct {
@@ -37,10 +38,16 @@ type Command[E any] struct {
 	Body      E          `json:"body"`
 }
 
+// DamageCommandBody carries one attack's damage lines. Element is the attack
+// skill's element ("" for physical) and ElementalReset the attacker's
+// Elemental Reset percentage; atlas-monsters uses both to apply temporary
+// elemental weaknesses and immunities the client does not know about.
 type DamageCommandBody struct {
-	CharacterId uint32   `json:"characterId"`
-	Damages     []uint32 `json:"damages"`
-	AttackType  byte     `json:"attackType"`
+	CharacterId    uint32   `json:"characterId"`
+	Damages        []uint32 `json:"damages"`
+	AttackType     byte     `json:"attackType"`
+	Element        string   `json:"element,omitempty"`
+	ElementalReset uint32   `json:"elementalReset,omitempty"`
 }
 
 type ApplyStatusCommandBody struct {
@@ -123,6 +130,16 @@ type ForceControlCommandBody struct {
 	CharacterId uint32 `json:"characterId"`
 }
 
+// WeakenElementCommandBody makes a monster temporarily WEAK to Element for
+// Duration milliseconds (Fire Demon / Ice Demon). Duration shares its name and
+// uint32 type with ApplyStatusCommandBody.Duration on the shared topic.
+// Mirrors atlas-monsters' weakenElementCommandBody — edit both together.
+type WeakenElementCommandBody struct {
+	CharacterId uint32 `json:"characterId"`
+	Element     string `json:"element,omitempty"`
+	Duration    uint32 `json:"duration"`
+}
+
 const (
 	EnvEventTopicStatus = "EVENT_TOPIC_MONSTER_STATUS"
 
@@ -136,6 +153,7 @@ const (
 	EventStatusEffectExpired    = "STATUS_EXPIRED"
 	EventStatusEffectCancelled  = "STATUS_CANCELLED"
 	EventStatusDamageReflected  = "DAMAGE_REFLECTED"
+	EventStatusResisted         = "RESISTED"
 	EventStatusAggroChanged     = "AGGRO_CHANGED"
 	EventStatusNextSkillDecided = "NEXT_SKILL_DECIDED"
 	EventStatusMpChanged        = "MP_CHANGED"
@@ -257,6 +275,17 @@ type StatusEventDamageReflectedBody struct {
 	ReflectType   string `json:"reflectType"`
 }
 
+// StatusEventResistedBody reports an attack or status a monster shrugged off.
+// Reason is "ELEMENT" or "BOSS"; Outcome is "IMMUNE" or "RESIST".
+type StatusEventResistedBody struct {
+	SourceCharacterId uint32   `json:"sourceCharacterId"`
+	SourceSkillId     uint32   `json:"sourceSkillId"`
+	Reason            string   `json:"reason"`
+	Element           string   `json:"element,omitempty"`
+	Outcome           string   `json:"outcome"`
+	ResistedStatuses  []string `json:"resistedStatuses,omitempty"`
+}
+
 type StatusEventAggroChangedBody struct {
 	ControllerCharacterId uint32 `json:"controllerCharacterId"`
 	ControllerHasAggro    bool   `json:"controllerHasAggro"`
@@ -21,6 +21,24 @@ func TestDamageCommandBody_EncodeNewShape(t *testing.T) {
 	}
 }
 
+func TestDamageCommandBody_EncodesElement(t *testing.T) {
+	body := DamageCommandBody{
+		CharacterId:    42,
+		Damages:        []uint32{100},
+		AttackType:     2,
+		Element:        "FIRE",
+		ElementalReset: 40,
+	}
+	out, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	want := `{"characterId":42,"damages":[100],"attackType":2,"element":"FIRE","elementalReset":40}`
+	if string(out) != want {
+		t.Fatalf("got %s, want %s", out, want)
+	}
+}
+
 func TestDamageCommandBody_DecodeRoundTrip(t *testing.T) {
 	in := DamageCommandBody{
 		CharacterId: 7,
@@ -36,7 +36,8 @@ const (
 // ResultKind* discriminate which client result mode a buy/settle should route to
 // on its LISTING_SOLD / BUY_FAILED status event. The channel sets it from the ITC
 // arm the buyer used (BUY/BUY_AUCTION_IMM -> item, BUY_ZZIM -> zzim, BUY_WISH ->
-// wish); atlas-mts's auction settle sets auction_settle. It round-trips command ->
+// wish); atlas-mts's auction settle sets auction_settle and a buy-order fill sets
+// buy_order. It round-trips command ->
 // status event so handleListingSold/handleBuyFailed pick the matching
 // CITC::OnNormalItemResult arm. Must match atlas-mts's ResultKind* byte-for-byte.
 const (
@@ -44,6 +45,7 @@ const (
 	ResultKindZzim          = "zzim"
 	ResultKindWish          = "wish"
 	ResultKindAuctionSettle = "auction_settle"
+	ResultKindBuyOrder      = "buy_order"
 )
 
 // Command is the generic high-level MTS command envelope. TransactionId keys the
@@ -10,6 +10,9 @@ const (
 	CommandPartyLeave         = "LEAVE"
 	CommandPartyChangeLeader  = "CHANGE_LEADER"
 	CommandPartyRequestInvite = "REQUEST_INVITE"
+
+	CommandPartyPublishListing  = "PUBLISH_LISTING"
+	CommandPartyWithdrawListing = "WITHDRAW_LISTING"
 )
 
 type Command[E any] struct {
@@ -37,6 +40,17 @@ type RequestInviteBody struct {
 	CharacterId uint32 `json:"characterId"`
 }
 
+type PublishListingBody struct {
+	MinLevel    byte   `json:"minLevel"`
+	MaxLevel    byte   `json:"maxLevel"`
+	JobMask     uint32 `json:"jobMask"`
+	MaxMembers  byte   `json:"maxMembers"`
+	TargetMapId uint32 `json:"targetMapId"`
+	Description string `json:"description"`
+}
+
+type WithdrawListingBody struct{}
+
 const (
 	EnvEventStatusTopic              = "EVENT_TOPIC_PARTY_STATUS"
 	EventPartyStatusTypeCreated      = "CREATED"
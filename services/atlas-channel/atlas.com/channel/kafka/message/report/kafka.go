@@ -21,6 +21,9 @@ const (
 
 	KindSue   = "sue"
 	KindClaim = "claim"
+	// KindDetection is a system-filed report from a channel cheat detector.
+	// atlas-ban emits no StatusEvent for it.
+	KindDetection = "detection"
 )
 
 type Command[E any] struct {
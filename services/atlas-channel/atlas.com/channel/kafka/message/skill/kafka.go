@@ -26,6 +26,8 @@ type Command[E any] struct {
 	Body          E         `json:"body"`
 }
 
+// SetCooldownBody starts a cooldown of Cooldown milliseconds, the unit
+// effect.Cooldown() carries.
 type SetCooldownBody struct {
 	SkillId  uint32 `json:"skillId"`
 	Cooldown uint32 `json:"cooldown"`
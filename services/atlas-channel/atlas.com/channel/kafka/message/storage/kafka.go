@@ -29,6 +29,7 @@ type ShowStorageCommand struct {
 	CharacterId   uint32     `json:"characterId"`
 	NpcId         uint32     `json:"npcId"`
 	AccountId     uint32     `json:"accountId"`
+	Tab           byte       `json:"tab,omitempty"`
 	Type          string     `json:"type"`
 }
 
@@ -48,7 +49,9 @@ type Command[E any] struct {
 }
 
 // ArrangeCommandBody contains data for the ARRANGE command
-type ArrangeCommandBody struct{}
+type ArrangeCommandBody struct {
+	Tab byte `json:"tab,omitempty"`
+}
 
 // UpdateMesosCommandBody contains data for the UPDATE_MESOS command
 type UpdateMesosCommandBody struct {
@@ -95,7 +98,9 @@ type MesosUpdatedEventBody struct {
 }
 
 // ArrangedEventBody contains the data for an arranged event
-type ArrangedEventBody struct{}
+type ArrangedEventBody struct {
+	Tab byte `json:"tab,omitempty"`
+}
 
 // ErrorEventBody contains the data for an error event
 type ErrorEventBody struct {
@@ -134,6 +139,7 @@ type ProjectionCreatedEventBody struct {
 	WorldId     world.Id   `json:"worldId"`
 	ChannelId   channel.Id `json:"channelId"`
 	NpcId       uint32     `json:"npcId"`
+	Tab         byte       `json:"tab,omitempty"`
 }
 
 // ProjectionDestroyedEventBody contains the data for a projection destroyed event
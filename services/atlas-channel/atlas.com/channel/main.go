@@ -7,6 +7,7 @@ import (
 	"atlas-channel/character/combo"
 	"atlas-channel/configuration/projection"
 	account2 "atlas-channel/kafka/consumer/account"
+	allianceConsumer "atlas-channel/kafka/consumer/alliance"
 	"atlas-channel/kafka/consumer/asset"
 	"atlas-channel/kafka/consumer/buddylist"
 	"atlas-channel/kafka/consumer/buff"
@@ -25,6 +26,7 @@ import (
 	eventConsumer "atlas-channel/kafka/consumer/event"
 	"atlas-channel/kafka/consumer/expression"
 	"atlas-channel/kafka/consumer/fame"
+	familyConsumer "atlas-channel/kafka/consumer/family"
 	"atlas-channel/kafka/consumer/gachapon"
 	"atlas-channel/kafka/consumer/guild"
 	"atlas-channel/kafka/consumer/guild/thread"
@@ -52,6 +54,7 @@ import (
 	"atlas-channel/kafka/consumer/party_quest"
 	"atlas-channel/kafka/consumer/pendingchange"
 	"atlas-channel/kafka/consumer/pet"
+	presenceConsumer "atlas-channel/kafka/consumer/presence"
 	"atlas-channel/kafka/consumer/quest"
 	"atlas-channel/kafka/consumer/reactor"
 	reportstatus "atlas-channel/kafka/consumer/report"
@@ -86,6 +89,8 @@ import (
 
 	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
 
+	alliancecb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
+	alliancesb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/serverbound"
 	buddy2 "github.com/Chronicle20/atlas/libs/atlas-packet/buddy"
 	cashcb "github.com/Chronicle20/atlas/libs/atlas-packet/cash/clientbound"
 	cashsb "github.com/Chronicle20/atlas/libs/atlas-packet/cash/serverbound"
@@ -105,6 +110,8 @@ import (
 	dropsb "github.com/Chronicle20/atlas/libs/atlas-packet/drop/serverbound"
 	famecb "github.com/Chronicle20/atlas/libs/atlas-packet/fame/clientbound"
 	famesb "github.com/Chronicle20/atlas/libs/atlas-packet/fame/serverbound"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
 	fieldcb "github.com/Chronicle20/atlas/libs/atlas-packet/field/clientbound"
 	fieldsb "github.com/Chronicle20/atlas/libs/atlas-packet/field/serverbound"
 	guildcb "github.com/Chronicle20/atlas/libs/atlas-packet/guild/clientbound"
@@ -239,6 +246,9 @@ func main() {
 	pendingchange.InitConsumers(l)(cmf)(consumerGroupId)
 	session2.InitConsumers(l)(cmf)(consumerGroupId)
 	fame.InitConsumers(l)(cmf)(consumerGroupId)
+	familyConsumer.InitConsumers(l)(cmf)(consumerGroupId)
+	allianceConsumer.InitConsumers(l)(cmf)(consumerGroupId)
+	presenceConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	thread.InitConsumers(l)(cmf)(consumerGroupId)
 	chair.InitConsumers(l)(cmf)(consumerGroupId)
 	drop.InitConsumers(l)(cmf)(consumerGroupId)
@@ -297,6 +307,7 @@ func main() {
 		account.GetRegistry().EvictTenant(tid)
 		monsterDomain.GetStatusMirror().EvictTenant(tid)
 		monsterDomain.GetLiveMirror().EvictTenant(tid)
+		monsterDomain.GetStolenRegistry().EvictTenant(tid)
 		monsterinfo.EvictTenant(tid)
 		if inbox := monsterDomain.GetNextSkillInbox(); inbox != nil {
 			inbox.EvictTenant(tid)
@@ -520,6 +531,15 @@ func buildListener(
 		if err := register(fame.InitHandlers(fl)(sc)(wp)(rh)); err != nil {
 			return handles, err
 		}
+		if err := register(familyConsumer.InitHandlers(fl)(sc)(wp)(rh)); err != nil {
+			return handles, err
+		}
+		if err := register(allianceConsumer.InitHandlers(fl)(sc)(wp)(rh)); err != nil {
+			return handles, err
+		}
+		if err := register(presenceConsumer.InitHandlers(fl)(sc)(wp)(rh)); err != nil {
+			return handles, err
+		}
 		if err := register(thread.InitHandlers(fl)(sc)(wp)(rh)); err != nil {
 			return handles, err
 		}
@@ -750,7 +770,19 @@ func produceWriters() []string {
 		guildcb.GuildOperationWriter,
 		guildcb.GuildEmblemChangedWriter,
 		guildcb.GuildNameChangedWriter,
+		alliancecb.AllianceOperationWriter,
 		famecb.FameResponseWriter,
+		familycb.FamilyChartResultWriter,
+		familycb.FamilyInfoResultWriter,
+		familycb.FamilyResultWriter,
+		familycb.FamilyJoinRequestWriter,
+		familycb.FamilyJoinRequestResultWriter,
+		familycb.FamilyJoinAcceptedWriter,
+		familycb.FamilyPrivilegeListWriter,
+		familycb.FamilyFamousPointIncResultWriter,
+		familycb.FamilyNotifyLoginOrLogoutWriter,
+		familycb.FamilySetPrivilegeWriter,
+		familycb.FamilySummonRequestWriter,
 		charcb.CharacterStatusMessageWriter,
 		guildcb.GuildBBSWriter,
 		charcb.CharacterShowChairWriter,
@@ -950,6 +982,8 @@ func produceHandlers() map[string]handler.MessageHandler {
 	handlerMap[invsb.CharacterInventoryMoveHandle] = handler.CharacterInventoryMoveHandleFunc
 	handlerMap[partysb.PartyOperationHandle] = handler.PartyOperationHandleFunc
 	handlerMap[partysb.PartyInviteRejectHandle] = handler.PartyInviteRejectHandleFunc
+	handlerMap[partysb.PartySearchStartHandle] = handler.PartySearchStartHandleFunc
+	handlerMap[partysb.PartySearchStopHandle] = handler.PartySearchStopHandleFunc
 	handlerMap[chatSB.CharacterChatMultiHandle] = handler.CharacterChatMultiHandleFunc
 	handlerMap[charsb.CharacterKeyMapChangeHandle] = handler.CharacterKeyMapChangeHandleFunc
 	handlerMap[buddy2.BuddyOperationHandle] = handler.BuddyOperationHandleFunc
@@ -959,7 +993,18 @@ func produceHandlers() map[string]handler.MessageHandler {
 	handlerMap[npcsb.NPCContinueConversationHandle] = handler.NPCContinueConversationHandleFunc
 	handlerMap[guildsb.GuildOperationHandle] = handler.GuildOperationHandleFunc
 	handlerMap[guildsb.GuildInviteRejectHandle] = handler.GuildInviteRejectHandleFunc
+	handlerMap[alliancesb.AllianceOperationHandle] = handler.AllianceOperationHandleFunc
+	handlerMap[alliancesb.AllianceInviteRejectHandle] = handler.AllianceInviteRejectHandleFunc
 	handlerMap[famesb.FameChangeHandle] = handler.FameChangeHandleFunc
+	handlerMap[familysb.FamilyChartRequestHandle] = handler.FamilyChartRequestHandleFunc
+	handlerMap[familysb.FamilyInfoRequestHandle] = handler.FamilyInfoRequestHandleFunc
+	handlerMap[familysb.FamilyRegisterJuniorHandle] = handler.FamilyRegisterJuniorHandleFunc
+	handlerMap[familysb.FamilyUnregisterJuniorHandle] = handler.FamilyUnregisterJuniorHandleFunc
+	handlerMap[familysb.FamilyUnregisterParentHandle] = handler.FamilyUnregisterParentHandleFunc
+	handlerMap[familysb.FamilyInviteResultHandle] = handler.FamilyInviteResultHandleFunc
+	handlerMap[familysb.FamilyUsePrivilegeHandle] = handler.FamilyUsePrivilegeHandleFunc
+	handlerMap[familysb.FamilySetPreceptHandle] = handler.FamilySetPreceptHandleFunc
+	handlerMap[familysb.FamilySummonResponseHandle] = handler.FamilySummonResponseHandleFunc
 	handlerMap[charsb.CharacterDistributeApHandle] = handler.CharacterDistributeApHandleFunc
 	handlerMap[charsb.CharacterAutoDistributeApHandle] = handler.CharacterAutoDistributeApHandleFunc
 	handlerMap[guildsb.GuildBBSHandle] = handler.GuildBBSHandleFunc
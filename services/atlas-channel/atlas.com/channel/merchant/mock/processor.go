@@ -33,7 +33,7 @@ type ProcessorMock struct {
 	OrganizeListingsFunc     func(characterId uint32, shopId uuid.UUID) error
 	AddListingFunc           func(characterId uint32, shopId uuid.UUID, inventoryType byte, slot int16, quantity uint16, bundleSize uint16, pricePerBundle uint32) error
 	RemoveListingFunc        func(characterId uint32, shopId uuid.UUID, listingIndex uint16) error
-	PurchaseBundleFunc       func(characterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error
+	PurchaseBundleFunc       func(characterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error
 	SearchListingsFunc       func(worldId world.Id, itemId uint32, descending bool) ([]merchant.SearchListing, error)
 	GetTopSearchesFunc       func(worldId world.Id) ([]merchant.TopSearch, error)
 	RecordItemSearchFunc     func(f field.Model, characterId uint32, itemId uint32) error
@@ -195,9 +195,9 @@ func (m *ProcessorMock) RemoveListing(characterId uint32, shopId uuid.UUID, list
 	return nil
 }
 
-func (m *ProcessorMock) PurchaseBundle(characterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error {
+func (m *ProcessorMock) PurchaseBundle(characterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error {
 	if m.PurchaseBundleFunc != nil {
-		return m.PurchaseBundleFunc(characterId, shopId, listingIndex, bundleCount)
+		return m.PurchaseBundleFunc(characterId, buyerName, shopId, listingIndex, bundleCount)
 	}
 	return nil
 }
@@ -40,7 +40,7 @@ type Processor interface {
 	OrganizeListings(characterId uint32, shopId uuid.UUID) error
 	AddListing(characterId uint32, shopId uuid.UUID, inventoryType byte, slot int16, quantity uint16, bundleSize uint16, pricePerBundle uint32) error
 	RemoveListing(characterId uint32, shopId uuid.UUID, listingIndex uint16) error
-	PurchaseBundle(characterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error
+	PurchaseBundle(characterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error
 	SearchListings(worldId world.Id, itemId uint32, descending bool) ([]SearchListing, error)
 	GetTopSearches(worldId world.Id) ([]TopSearch, error)
 	RecordItemSearch(f field.Model, characterId uint32, itemId uint32) error
@@ -186,8 +186,8 @@ func (p *ProcessorImpl) RemoveListing(characterId uint32, shopId uuid.UUID, list
 	return producer.ProviderImpl(p.l)(p.ctx)(merchant2.EnvCommandTopic)(RemoveListingCommandProvider(characterId, shopId, listingIndex))
 }
 
-func (p *ProcessorImpl) PurchaseBundle(characterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error {
-	return producer.ProviderImpl(p.l)(p.ctx)(merchant2.EnvCommandTopic)(PurchaseBundleCommandProvider(characterId, shopId, listingIndex, bundleCount))
+func (p *ProcessorImpl) PurchaseBundle(characterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) error {
+	return producer.ProviderImpl(p.l)(p.ctx)(merchant2.EnvCommandTopic)(PurchaseBundleCommandProvider(characterId, buyerName, shopId, listingIndex, bundleCount))
 }
 
 func (p *ProcessorImpl) SearchListings(worldId world.Id, itemId uint32, descending bool) ([]SearchListing, error) {
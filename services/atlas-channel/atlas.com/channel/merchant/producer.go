@@ -212,7 +212,7 @@ func SendMessageCommandProvider(characterId uint32, shopId uuid.UUID, content st
 	return producer.SingleMessageProvider(key, value)
 }
 
-func PurchaseBundleCommandProvider(characterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) model.Provider[[]kafka.Message] {
+func PurchaseBundleCommandProvider(characterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(characterId))
 	value := &merchant2.Command[merchant2.CommandPurchaseBundleBody]{
 		CharacterId: characterId,
@@ -221,6 +221,7 @@ func PurchaseBundleCommandProvider(characterId uint32, shopId uuid.UUID, listing
 			ShopId:       shopId.String(),
 			ListingIndex: listingIndex,
 			BundleCount:  bundleCount,
+			BuyerName:    buyerName,
 		},
 	}
 	return producer.SingleMessageProvider(key, value)
@@ -14,6 +14,7 @@ type ProcessorMock struct {
 	ByIdProviderFunc       func(messengerId uint32) model.Provider[messenger.Model]
 	GetByMemberIdFunc      func(memberId uint32) (messenger.Model, error)
 	ByMemberIdProviderFunc func(memberId uint32) model.Provider[messenger.Model]
+	GetTranscriptFunc      func(messengerId uint32) ([]messenger.TranscriptLineModel, error)
 }
 
 var _ messenger.Processor = (*ProcessorMock)(nil)
@@ -66,3 +67,10 @@ func (m *ProcessorMock) ByMemberIdProvider(memberId uint32) model.Provider[messe
 	}
 	return model.FixedProvider(messenger.Model{})
 }
+
+func (m *ProcessorMock) GetTranscript(messengerId uint32) ([]messenger.TranscriptLineModel, error) {
+	if m.GetTranscriptFunc != nil {
+		return m.GetTranscriptFunc(messengerId)
+	}
+	return nil, nil
+}
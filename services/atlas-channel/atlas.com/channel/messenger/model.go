@@ -57,3 +57,21 @@ func (m MemberModel) ChannelId() channel.Id {
 func (m MemberModel) Slot() byte {
 	return m.slot
 }
+
+type TranscriptLineModel struct {
+	sequence    uint32
+	characterId uint32
+	message     string
+}
+
+func (m TranscriptLineModel) Sequence() uint32 {
+	return m.sequence
+}
+
+func (m TranscriptLineModel) CharacterId() uint32 {
+	return m.characterId
+}
+
+func (m TranscriptLineModel) Message() string {
+	return m.message
+}
@@ -20,6 +20,7 @@ type Processor interface {
 	ByIdProvider(messengerId uint32) model.Provider[Model]
 	GetByMemberId(memberId uint32) (Model, error)
 	ByMemberIdProvider(memberId uint32) model.Provider[Model]
+	GetTranscript(messengerId uint32) ([]TranscriptLineModel, error)
 }
 
 type ProcessorImpl struct {
@@ -68,3 +69,7 @@ func (p *ProcessorImpl) ByMemberIdProvider(memberId uint32) model.Provider[Model
 	rp := requests.SliceProvider[RestModel, Model](p.l, p.ctx)(requestByMemberId(p.ctx, memberId), Extract, model.Filters[Model]())
 	return model.FirstProvider(rp, model.Filters[Model]())
 }
+
+func (p *ProcessorImpl) GetTranscript(messengerId uint32) ([]TranscriptLineModel, error) {
+	return requests.SliceProvider[TranscriptLineRestModel, TranscriptLineModel](p.l, p.ctx)(requestTranscript(p.ctx, messengerId), ExtractTranscriptLine, model.Filters[TranscriptLineModel]())()
+}
@@ -12,6 +12,7 @@ const (
 	ByMemberId      = Resource + "?filter[members.id]=%d"
 	ById            = Resource + "/%d"
 	MembersResource = ById + "/members"
+	ByIdTranscript  = ById + "/transcript"
 )
 
 func getBaseRequest(ctx context.Context) (string, error) {
@@ -33,3 +34,11 @@ func requestByMemberId(ctx context.Context, id uint32) requests.Request[[]RestMo
 	}
 	return requests.GetRequest[[]RestModel](fmt.Sprintf(root+ByMemberId, id))
 }
+
+func requestTranscript(ctx context.Context, id uint32) requests.Request[[]TranscriptLineRestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[[]TranscriptLineRestModel](err)
+	}
+	return requests.GetRequest[[]TranscriptLineRestModel](fmt.Sprintf(root+ByIdTranscript, id))
+}
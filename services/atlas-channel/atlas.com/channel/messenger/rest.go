@@ -156,3 +156,31 @@ func (r *MemberRestModel) SetID(idStr string) error {
 	r.Id = uint32(id)
 	return nil
 }
+
+type TranscriptLineRestModel struct {
+	Id          string `json:"-"`
+	Sequence    uint32 `json:"sequence"`
+	CharacterId uint32 `json:"characterId"`
+	Message     string `json:"message"`
+}
+
+func (r TranscriptLineRestModel) GetName() string {
+	return "transcript-lines"
+}
+
+func (r TranscriptLineRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *TranscriptLineRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func ExtractTranscriptLine(rm TranscriptLineRestModel) (TranscriptLineModel, error) {
+	return TranscriptLineModel{
+		sequence:    rm.Sequence,
+		characterId: rm.CharacterId,
+		message:     rm.Message,
+	}, nil
+}
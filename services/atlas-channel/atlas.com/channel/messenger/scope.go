@@ -0,0 +1,15 @@
+package messenger
+
+import (
+	"atlas-channel/server"
+	"context"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// InScope reports whether a messenger event should be delivered by this
+// channel server. Messenger rooms span worlds within a tenant, so only the
+// tenant is compared.
+func InScope(sc server.Model, ctx context.Context) bool {
+	return sc.IsTenant(tenant.MustFromContext(ctx))
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"atlas-channel/monster"
+	"time"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
@@ -14,7 +15,8 @@ type ProcessorMock struct {
 	GetInMapFunc               func(f field.Model) ([]monster.Model, error)
 	InMapRectModelProviderFunc func(f field.Model, x1, y1, x2, y2 int16, limit uint32) model.Provider[[]monster.Model]
 	GetInMapRectFunc           func(f field.Model, x1, y1, x2, y2 int16, limit uint32) ([]monster.Model, error)
-	DamageFunc                 func(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) error
+	DamageFunc                 func(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) error
+	WeakenElementFunc          func(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) error
 	EmitDamageReflectedFunc    func(f field.Model, uniqueId uint32, templateId uint32, characterId uint32, reflectDamage uint32, reflectType string) error
 	UseSkillFunc               func(f field.Model, monsterId uint32, characterId uint32, skillId byte, skillLevel byte) error
 	UseBasicAttackFunc         func(f field.Model, monsterId uint32, attackPos uint8) error
@@ -71,9 +73,9 @@ func (m *ProcessorMock) GetInMapRect(f field.Model, x1, y1, x2, y2 int16, limit
 	return nil, nil
 }
 
-func (m *ProcessorMock) Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) error {
+func (m *ProcessorMock) Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) error {
 	if m.DamageFunc != nil {
-		return m.DamageFunc(f, monsterId, characterId, damages, attackType)
+		return m.DamageFunc(f, monsterId, characterId, damages, attackType, element, elementalReset)
 	}
 	return nil
 }
@@ -147,3 +149,10 @@ func (m *ProcessorMock) ForceControl(f field.Model, monsterId uint32, characterI
 	}
 	return nil
 }
+
+func (m *ProcessorMock) WeakenElement(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) error {
+	if m.WeakenElementFunc != nil {
+		return m.WeakenElementFunc(f, monsterId, characterId, element, duration)
+	}
+	return nil
+}
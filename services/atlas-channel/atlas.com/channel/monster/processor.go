@@ -3,6 +3,7 @@ package monster
 import (
 	monster2 "atlas-channel/kafka/message/monster"
 	"context"
+	"time"
 
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 
@@ -21,7 +22,8 @@ type Processor interface {
 	GetInMap(f field.Model) ([]Model, error)
 	InMapRectModelProvider(f field.Model, x1, y1, x2, y2 int16, limit uint32) model.Provider[[]Model]
 	GetInMapRect(f field.Model, x1, y1, x2, y2 int16, limit uint32) ([]Model, error)
-	Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) error
+	Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) error
+	WeakenElement(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) error
 	EmitDamageReflected(f field.Model, uniqueId uint32, templateId uint32, characterId uint32, reflectDamage uint32, reflectType string) error
 	UseSkill(f field.Model, monsterId uint32, characterId uint32, skillId byte, skillLevel byte) error
 	UseBasicAttack(f field.Model, monsterId uint32, attackPos uint8) error
@@ -91,9 +93,16 @@ func (p *ProcessorImpl) GetInMapRect(f field.Model, x1, y1, x2, y2 int16, limit
 	return p.InMapRectModelProvider(f, x1, y1, x2, y2, limit)()
 }
 
-func (p *ProcessorImpl) Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) error {
-	p.l.Debugf("Applying damage to monster [%d]. Character [%d]. Lines [%d].", monsterId, characterId, len(damages))
-	return producer.ProviderImpl(p.l)(p.ctx)(monster2.EnvCommandTopic)(DamageCommandProvider(f, monsterId, characterId, damages, attackType))
+func (p *ProcessorImpl) Damage(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) error {
+	p.l.Debugf("Applying damage to monster [%d]. Character [%d]. Lines [%d]. Element [%s].", monsterId, characterId, len(damages), element)
+	return producer.ProviderImpl(p.l)(p.ctx)(monster2.EnvCommandTopic)(DamageCommandProvider(f, monsterId, characterId, damages, attackType, element, elementalReset))
+}
+
+// WeakenElement asks atlas-monsters to make the monster temporarily WEAK to
+// element (Fire Demon / Ice Demon).
+func (p *ProcessorImpl) WeakenElement(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) error {
+	p.l.Debugf("Weakening monster [%d] to element [%s] for [%s]. Character [%d].", monsterId, element, duration, characterId)
+	return producer.ProviderImpl(p.l)(p.ctx)(monster2.EnvCommandTopic)(WeakenElementCommandProvider(f, monsterId, characterId, element, duration))
 }
 
 // EmitDamageReflected publishes a DAMAGE_REFLECTED status event so the
@@ -2,6 +2,7 @@ package monster
 
 import (
 	monster2 "atlas-channel/kafka/message/monster"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 
@@ -126,7 +127,7 @@ func DamageReflectedStatusEventProvider(f field.Model, uniqueId uint32, monsterI
 	return producer.SingleMessageProvider(key, value)
 }
 
-func DamageCommandProvider(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) model.Provider[[]kafka.Message] {
+func DamageCommandProvider(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(monsterId))
 	value := &monster2.Command[monster2.DamageCommandBody]{
 		WorldId:   f.WorldId(),
@@ -136,9 +137,11 @@ func DamageCommandProvider(f field.Model, monsterId uint32, characterId uint32,
 		MonsterId: monsterId,
 		Type:      monster2.CommandTypeDamage,
 		Body: monster2.DamageCommandBody{
-			CharacterId: characterId,
-			Damages:     damages,
-			AttackType:  attackType,
+			CharacterId:    characterId,
+			Damages:        damages,
+			AttackType:     attackType,
+			Element:        element,
+			ElementalReset: elementalReset,
 		},
 	}
 	return producer.SingleMessageProvider(key, value)
@@ -222,3 +225,23 @@ func ForceControlCommandProvider(f field.Model, monsterId uint32, characterId ui
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+// WeakenElementCommandProvider builds the WEAKEN_ELEMENT command making a
+// monster WEAK to element for duration (Fire Demon / Ice Demon).
+func WeakenElementCommandProvider(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(monsterId))
+	value := &monster2.Command[monster2.WeakenElementCommandBody]{
+		WorldId:   f.WorldId(),
+		ChannelId: f.ChannelId(),
+		MapId:     f.MapId(),
+		Instance:  f.Instance(),
+		MonsterId: monsterId,
+		Type:      monster2.CommandTypeWeakenElement,
+		Body: monster2.WeakenElementCommandBody{
+			CharacterId: characterId,
+			Element:     element,
+			Duration:    uint32(duration.Milliseconds()),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
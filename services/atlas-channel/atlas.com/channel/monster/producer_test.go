@@ -3,6 +3,7 @@ package monster
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	monster2 "atlas-channel/kafka/message/monster"
 
@@ -16,7 +17,7 @@ import (
 
 func TestDamageCommandProvider_EncodesDamagesSlice(t *testing.T) {
 	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(100000000)).SetInstance(uuid.Nil).Build()
-	provider := DamageCommandProvider(f, 12345, 67, []uint32{40, 80, 120}, 1)
+	provider := DamageCommandProvider(f, 12345, 67, []uint32{40, 80, 120}, 1, "ICE", 40)
 
 	msgs, err := provider()
 	if err != nil {
@@ -45,6 +46,30 @@ func TestDamageCommandProvider_EncodesDamagesSlice(t *testing.T) {
 	if cmd.Body.AttackType != 1 {
 		t.Fatalf("Body.AttackType = %d, want 1", cmd.Body.AttackType)
 	}
+	if cmd.Body.Element != "ICE" || cmd.Body.ElementalReset != 40 {
+		t.Fatalf("Body.Element/ElementalReset = %s/%d, want ICE/40", cmd.Body.Element, cmd.Body.ElementalReset)
+	}
+}
+
+func TestWeakenElementCommandProvider(t *testing.T) {
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).SetInstance(uuid.Nil).Build()
+	msgs, err := WeakenElementCommandProvider(f, 5001, 67, "FIRE", 30*time.Second)()
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("messages = %d, want 1", len(msgs))
+	}
+	var cmd monster2.Command[monster2.WeakenElementCommandBody]
+	if err := json.Unmarshal(msgs[0].Value, &cmd); err != nil {
+		t.Fatalf("unmarshal command: %v", err)
+	}
+	if cmd.Type != monster2.CommandTypeWeakenElement {
+		t.Fatalf("Type = %s, want %s", cmd.Type, monster2.CommandTypeWeakenElement)
+	}
+	if cmd.Body.CharacterId != 67 || cmd.Body.Element != "FIRE" || cmd.Body.Duration != 30000 {
+		t.Fatalf("Body = %+v, want {67 FIRE 30000}", cmd.Body)
+	}
 }
 
 func TestUseBasicAttackCommandProvider(t *testing.T) {
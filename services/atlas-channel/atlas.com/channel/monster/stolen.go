@@ -0,0 +1,74 @@
+package monster
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// stolenMaxEntryAge bounds how long a steal mark outlives a monster whose
+// KILLED/DESTROYED event this pod never saw. Far beyond any monster's
+// lifetime in a fight; pruned lazily on the next mark.
+const stolenMaxEntryAge = time.Hour
+
+// StolenRegistry records which monsters have already been stolen from
+// (Bandit Steal): a monster yields at most one stolen item over its life.
+// Per-pod and tenant-scoped like the live mirror — a monster lives in one
+// field, and every attack on it arrives at the channel serving that field.
+type StolenRegistry struct {
+	mu        sync.Mutex
+	perTenant map[uuid.UUID]map[uint32]time.Time
+}
+
+var (
+	stolenRegistryOnce sync.Once
+	stolenRegistry     *StolenRegistry
+)
+
+func GetStolenRegistry() *StolenRegistry {
+	stolenRegistryOnce.Do(func() {
+		stolenRegistry = &StolenRegistry{perTenant: map[uuid.UUID]map[uint32]time.Time{}}
+	})
+	return stolenRegistry
+}
+
+// MarkStolen marks the monster stolen from and reports whether this call
+// claimed it. False means an earlier steal already succeeded.
+func (r *StolenRegistry) MarkStolen(t tenant.Model, uniqueId uint32, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tenantMap, ok := r.perTenant[t.Id()]
+	if !ok {
+		tenantMap = map[uint32]time.Time{}
+		r.perTenant[t.Id()] = tenantMap
+	}
+	if at, ok := tenantMap[uniqueId]; ok && now.Sub(at) <= stolenMaxEntryAge {
+		return false
+	}
+	for id, at := range tenantMap {
+		if now.Sub(at) > stolenMaxEntryAge {
+			delete(tenantMap, id)
+		}
+	}
+	tenantMap[uniqueId] = now
+	return true
+}
+
+// OnMonsterGone drops the monster's mark (DESTROYED/KILLED).
+func (r *StolenRegistry) OnMonsterGone(t tenant.Model, uniqueId uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if tenantMap, ok := r.perTenant[t.Id()]; ok {
+		delete(tenantMap, uniqueId)
+	}
+}
+
+// EvictTenant drops every mark for the tenant.
+func (r *StolenRegistry) EvictTenant(tid uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.perTenant, tid)
+}
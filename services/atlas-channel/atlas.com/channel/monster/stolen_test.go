@@ -0,0 +1,46 @@
+package monster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newTestStolenRegistry() *StolenRegistry {
+	return &StolenRegistry{perTenant: map[uuid.UUID]map[uint32]time.Time{}}
+}
+
+func TestStolenRegistry_OncePerMonster(t *testing.T) {
+	r := newTestStolenRegistry()
+	tm := newTestTenant(t)
+	now := time.Now()
+
+	if !r.MarkStolen(tm, 7, now) {
+		t.Fatal("first steal must claim the monster")
+	}
+	if r.MarkStolen(tm, 7, now.Add(time.Second)) {
+		t.Fatal("second steal from the same monster must be refused")
+	}
+	if !r.MarkStolen(tm, 8, now) {
+		t.Fatal("a different monster is unaffected")
+	}
+	if !r.MarkStolen(newTestTenant(t), 7, now) {
+		t.Fatal("marks are tenant-scoped")
+	}
+}
+
+func TestStolenRegistry_GoneAndStaleRelease(t *testing.T) {
+	r := newTestStolenRegistry()
+	tm := newTestTenant(t)
+	now := time.Now()
+
+	r.MarkStolen(tm, 7, now)
+	r.OnMonsterGone(tm, 7)
+	if !r.MarkStolen(tm, 7, now) {
+		t.Fatal("a recycled unique id must be stealable again after the monster is gone")
+	}
+	if !r.MarkStolen(tm, 7, now.Add(stolenMaxEntryAge+time.Second)) {
+		t.Fatal("a stale mark must not block forever")
+	}
+}
@@ -21,6 +21,7 @@ type Processor interface {
 	ByIdProvider(noteId uint32) model.Provider[Model]
 	GetById(noteId uint32) (Model, error)
 	DiscardNotes(ch channel.Model, characterId uint32, noteIds []uint32) error
+	Create(ch channel.Model, characterId uint32, senderId uint32, message string) error
 }
 
 // ProcessorImpl implements the Processor interface
@@ -70,3 +71,10 @@ func (p *ProcessorImpl) DiscardNotes(ch channel.Model, characterId uint32, noteI
 	p.l.Debugf("Character [%d] attempting to discard [%d] notes.", characterId, len(noteIds))
 	return producer.ProviderImpl(p.l)(p.ctx)(note2.EnvCommandTopic)(DiscardCommandProvider(ch, characterId, noteIds))
 }
+
+// Create stores a plain note for characterId. Flag 0 renders as sender and
+// message only (see buildNoteSendSaga).
+func (p *ProcessorImpl) Create(ch channel.Model, characterId uint32, senderId uint32, message string) error {
+	p.l.Debugf("Character [%d] storing a note for character [%d].", senderId, characterId)
+	return producer.ProviderImpl(p.l)(p.ctx)(note2.EnvCommandTopic)(CreateCommandProvider(ch, characterId, senderId, message, 0))
+}
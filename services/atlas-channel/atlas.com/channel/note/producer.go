@@ -10,6 +10,22 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
+func CreateCommandProvider(ch channel.Model, characterId uint32, senderId uint32, message string, flag byte) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &note.Command[note.CommandCreateBody]{
+		WorldId:     ch.WorldId(),
+		ChannelId:   ch.Id(),
+		CharacterId: characterId,
+		Type:        note.CommandTypeCreate,
+		Body: note.CommandCreateBody{
+			SenderId: senderId,
+			Message:  message,
+			Flag:     flag,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 func DiscardCommandProvider(ch channel.Model, characterId uint32, noteIds []uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(characterId))
 	value := &note.Command[note.CommandDiscardBody]{
@@ -3,6 +3,7 @@ package mock
 import (
 	"atlas-channel/party"
 
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
@@ -12,6 +13,8 @@ type ProcessorMock struct {
 	ExpelFunc              func(partyId uint32, characterId uint32, targetCharacterId uint32) error
 	ChangeLeaderFunc       func(partyId uint32, characterId uint32, targetCharacterId uint32) error
 	RequestInviteFunc      func(characterId uint32, targetCharacterId uint32) error
+	PublishListingFunc     func(characterId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id) error
+	WithdrawListingFunc    func(characterId uint32) error
 	GetByIdFunc            func(partyId uint32) (party.Model, error)
 	ByIdProviderFunc       func(partyId uint32) model.Provider[party.Model]
 	GetByMemberIdFunc      func(memberId uint32) (party.Model, error)
@@ -55,6 +58,20 @@ func (m *ProcessorMock) RequestInvite(characterId uint32, targetCharacterId uint
 	return nil
 }
 
+func (m *ProcessorMock) PublishListing(characterId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id) error {
+	if m.PublishListingFunc != nil {
+		return m.PublishListingFunc(characterId, minLevel, maxLevel, jobMask, maxMembers, targetMapId)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) WithdrawListing(characterId uint32) error {
+	if m.WithdrawListingFunc != nil {
+		return m.WithdrawListingFunc(characterId)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) GetById(partyId uint32) (party.Model, error) {
 	if m.GetByIdFunc != nil {
 		return m.GetByIdFunc(partyId)
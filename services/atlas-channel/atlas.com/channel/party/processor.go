@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
 )
@@ -19,6 +20,8 @@ type Processor interface {
 	Expel(partyId uint32, characterId uint32, targetCharacterId uint32) error
 	ChangeLeader(partyId uint32, characterId uint32, targetCharacterId uint32) error
 	RequestInvite(characterId uint32, targetCharacterId uint32) error
+	PublishListing(characterId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id) error
+	WithdrawListing(characterId uint32) error
 	GetById(partyId uint32) (Model, error)
 	ByIdProvider(partyId uint32) model.Provider[Model]
 	GetByMemberId(memberId uint32) (Model, error)
@@ -65,6 +68,16 @@ func (p *ProcessorImpl) RequestInvite(characterId uint32, targetCharacterId uint
 	return producer.ProviderImpl(p.l)(p.ctx)(party2.EnvCommandTopic)(RequestInviteCommandProvider(characterId, targetCharacterId))
 }
 
+func (p *ProcessorImpl) PublishListing(characterId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id) error {
+	p.l.Debugf("Character [%d] attempting to list their party for levels [%d-%d].", characterId, minLevel, maxLevel)
+	return producer.ProviderImpl(p.l)(p.ctx)(party2.EnvCommandTopic)(PublishListingCommandProvider(characterId, minLevel, maxLevel, jobMask, maxMembers, targetMapId))
+}
+
+func (p *ProcessorImpl) WithdrawListing(characterId uint32) error {
+	p.l.Debugf("Character [%d] attempting to withdraw their party listing.", characterId)
+	return producer.ProviderImpl(p.l)(p.ctx)(party2.EnvCommandTopic)(WithdrawListingCommandProvider(characterId))
+}
+
 func (p *ProcessorImpl) GetById(partyId uint32) (Model, error) {
 	return p.ByIdProvider(partyId)()
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/segmentio/kafka-go"
 
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
@@ -57,3 +58,29 @@ func RequestInviteCommandProvider(actorId uint32, characterId uint32) model.Prov
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func PublishListingCommandProvider(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(actorId))
+	value := &party2.Command[party2.PublishListingBody]{
+		ActorId: actorId,
+		Type:    party2.CommandPartyPublishListing,
+		Body: party2.PublishListingBody{
+			MinLevel:    minLevel,
+			MaxLevel:    maxLevel,
+			JobMask:     jobMask,
+			MaxMembers:  maxMembers,
+			TargetMapId: uint32(targetMapId),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func WithdrawListingCommandProvider(actorId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(actorId))
+	value := &party2.Command[party2.WithdrawListingBody]{
+		ActorId: actorId,
+		Type:    party2.CommandPartyWithdrawListing,
+		Body:    party2.WithdrawListingBody{},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
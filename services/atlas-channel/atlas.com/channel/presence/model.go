@@ -0,0 +1,40 @@
+package presence
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Model struct {
+	characterId uint32
+	worldId     world.Id
+	state       string
+	online      bool
+	channelId   channel.Id
+	mapId       _map.Id
+}
+
+func (m Model) CharacterId() uint32 {
+	return m.characterId
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) State() string {
+	return m.state
+}
+
+func (m Model) Online() bool {
+	return m.online
+}
+
+func (m Model) ChannelId() channel.Id {
+	return m.channelId
+}
+
+func (m Model) MapId() _map.Id {
+	return m.mapId
+}
@@ -0,0 +1,34 @@
+package presence
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+// Processor reads character presence tracked by atlas-buddies. Presence
+// spans every channel, unlike the session registry, which only knows the
+// characters connected to this one.
+type Processor interface {
+	GetByCharacterId(characterId uint32) (Model, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetByCharacterId(characterId uint32) (Model, error) {
+	return requests.Provider[RestModel, Model](p.l, p.ctx)(requestByCharacterId(p.ctx, characterId), Extract)()
+}
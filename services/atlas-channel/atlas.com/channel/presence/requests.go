@@ -0,0 +1,24 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const (
+	Resource = "characters/%d/presence"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "BUDDIES")
+}
+
+func requestByCharacterId(ctx context.Context, characterId uint32) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.GetRequest[RestModel](fmt.Sprintf(root+Resource, characterId))
+}
@@ -0,0 +1,46 @@
+package presence
+
+import (
+	"strconv"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type RestModel struct {
+	CharacterId uint32     `json:"-"`
+	WorldId     world.Id   `json:"worldId"`
+	State       string     `json:"state"`
+	Online      bool       `json:"online"`
+	ChannelId   channel.Id `json:"channelId"`
+	MapId       _map.Id    `json:"mapId"`
+}
+
+func (r RestModel) GetName() string {
+	return "presences"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.CharacterId))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.CharacterId = uint32(id)
+	return nil
+}
+
+func Extract(rm RestModel) (Model, error) {
+	return Model{
+		characterId: rm.CharacterId,
+		worldId:     rm.WorldId,
+		state:       rm.State,
+		online:      rm.Online,
+		channelId:   rm.ChannelId,
+		mapId:       rm.MapId,
+	}, nil
+}
@@ -32,6 +32,9 @@ type Processor interface {
 	Sue(reporterId uint32, worldId world.Id, channelId channel.Id, accusedId uint32, subCommand string, flag byte, reason string) error
 	// Claim submits a CUIClaim report window submission.
 	Claim(reporterId uint32, worldId world.Id, channelId channel.Id, targetName string, reasonType byte, description string, chatClaim bool, chatLog string) error
+	// Detect files a system report against accusedId on a cheat detector's
+	// own evidence. There is no reporter and no result packet.
+	Detect(worldId world.Id, channelId channel.Id, accusedId uint32, description string) error
 }
 
 // ProcessorImpl implements the Processor interface
@@ -55,3 +58,8 @@ func (p *ProcessorImpl) Claim(reporterId uint32, worldId world.Id, channelId cha
 	p.l.Debugf("Character [%d] claims against [%s] type [%d] chatClaim [%t].", reporterId, targetName, reasonType, chatClaim)
 	return producer.ProviderImpl(p.l)(p.ctx)(report2.EnvCommandTopic)(claimCommandProvider(reporterId, worldId, channelId, targetName, reasonType, description, chatClaim, chatLog))
 }
+
+func (p *ProcessorImpl) Detect(worldId world.Id, channelId channel.Id, accusedId uint32, description string) error {
+	p.l.Debugf("Filing detection report against character [%d]: %s", accusedId, description)
+	return producer.ProviderImpl(p.l)(p.ctx)(report2.EnvCommandTopic)(detectCommandProvider(worldId, channelId, accusedId, description))
+}
@@ -54,3 +54,20 @@ func claimCommandProvider(reporterId uint32, worldId world.Id, channelId channel
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+// detectCommandProvider builds the CREATE command for a system-filed
+// detection report. Keyed by the accused: there is no reporter.
+func detectCommandProvider(worldId world.Id, channelId channel.Id, accusedId uint32, description string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(accusedId))
+	value := &report2.Command[report2.CreateCommandBody]{
+		Type: report2.CommandTypeCreate,
+		Body: report2.CreateCommandBody{
+			Kind:        report2.KindDetection,
+			WorldId:     worldId,
+			ChannelId:   channelId,
+			AccusedId:   accusedId,
+			Description: description,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
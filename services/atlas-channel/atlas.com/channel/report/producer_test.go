@@ -53,3 +53,18 @@ func TestClaimCommandProvider(t *testing.T) {
 		t.Errorf("body mismatch: %+v", b)
 	}
 }
+
+func TestDetectCommandProvider(t *testing.T) {
+	msgs, err := detectCommandProvider(0, 2, 12345, "damage range")()
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	var cmd report2.Command[report2.CreateCommandBody]
+	if err := json.Unmarshal(msgs[0].Value, &cmd); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	b := cmd.Body
+	if b.Kind != report2.KindDetection || b.ReporterId != 0 || b.AccusedId != 12345 || b.ChannelId != 2 || b.Description != "damage range" {
+		t.Errorf("body mismatch: %+v", b)
+	}
+}
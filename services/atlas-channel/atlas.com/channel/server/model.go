@@ -58,8 +58,7 @@ func (m Model) Is(t tenant.Model, worldId world.Id, channelId channel.Id) bool {
 }
 
 func (m Model) IsWorld(t tenant.Model, worldId world.Id) bool {
-	is := t.Is(m.Tenant())
-	if !is {
+	if !m.IsTenant(t) {
 		return false
 	}
 	if worldId != m.Channel().WorldId() {
@@ -68,6 +67,12 @@ func (m Model) IsWorld(t tenant.Model, worldId world.Id) bool {
 	return true
 }
 
+// IsTenant reports whether the channel serves the given tenant, regardless of
+// world. Used for tenant-wide features such as cross-world messenger rooms.
+func (m Model) IsTenant(t tenant.Model) bool {
+	return t.Is(m.Tenant())
+}
+
 func (m Model) Map(mapId _map.Id) _map.Model {
 	return _map.NewModel(m.WorldId())(m.ChannelId())(mapId)
 }
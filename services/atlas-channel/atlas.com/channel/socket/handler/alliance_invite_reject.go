@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/invite"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	invite2 "github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	alliancesb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+func AllianceInviteRejectHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := alliancesb.InviteReject{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		cs, err := character.NewProcessor(l, ctx).GetByName(p.InviterName())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to locate character by name [%s]. Invite will be stuck", p.InviterName())
+			return
+		}
+
+		err = invite.NewProcessor(l, ctx).Reject(s.CharacterId(), s.WorldId(), string(invite2.TypeAlliance), cs.Id())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to issue invite rejection command for character [%d].", s.CharacterId())
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"atlas-channel/alliance"
+	"atlas-channel/guild"
+	"atlas-channel/invite"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	invite2 "github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	alliancepkt "github.com/Chronicle20/atlas/libs/atlas-packet/alliance"
+	alliancecb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
+	alliancesb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+const (
+	AllianceOperationLoad           = "LOAD"
+	AllianceOperationWithdraw       = "WITHDRAW"
+	AllianceOperationInvite         = "INVITE"
+	AllianceOperationJoin           = "JOIN"
+	AllianceOperationKick           = "KICK"
+	AllianceOperationSetTitleNames  = "SET_TITLE_NAMES"
+	AllianceOperationSetMemberTitle = "SET_MEMBER_TITLE"
+	AllianceOperationSetNotice      = "SET_NOTICE"
+)
+
+func AllianceOperationHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := alliancesb.Operation{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+		op := p.Op()
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationJoin) {
+			sp := &alliancesb.Join{}
+			sp.Decode(l, ctx)(r, readerOptions)
+			err := invite.NewProcessor(l, ctx).Accept(s.CharacterId(), s.WorldId(), string(invite2.TypeAlliance), sp.AllianceId())
+			if err != nil {
+				l.WithError(err).Errorf("Unable to issue invite acceptance command for character [%d].", s.CharacterId())
+			}
+			return
+		}
+
+		g, _ := guild.NewProcessor(l, ctx).GetByMemberId(s.CharacterId())
+		if g.AllianceId() == 0 {
+			l.Errorf("Character [%d] issued alliance operation [%d] while not in an alliance.", s.CharacterId(), op)
+			return
+		}
+		ap := alliance.NewProcessor(l, ctx)
+
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationLoad) {
+			a, err := ap.GetById(g.AllianceId())
+			if err != nil {
+				l.WithError(err).Errorf("Unable to retrieve alliance [%d] for character [%d].", g.AllianceId(), s.CharacterId())
+				return
+			}
+			guildIds := make([]uint32, 0, len(a.Guilds()))
+			for _, ag := range a.Guilds() {
+				guildIds = append(guildIds, ag.Id())
+			}
+			_ = session.Announce(l)(ctx)(wp)(alliancecb.AllianceOperationWriter)(alliancepkt.AllianceInfoBody(a.Id(), a.Name(), a.Titles(), guildIds, a.Capacity(), a.Notice()))(s)
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationWithdraw) {
+			if !g.IsLeader(s.CharacterId()) {
+				l.Errorf("Character [%d] attempting to withdraw guild [%d] from an alliance when they are not the guild leader.", s.CharacterId(), g.Id())
+				_ = session.NewProcessor(l, ctx).Destroy(s)
+				return
+			}
+			_ = ap.Leave(s.WorldId(), g.AllianceId(), s.CharacterId())
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationInvite) {
+			sp := &alliancesb.Invite{}
+			sp.Decode(l, ctx)(r, readerOptions)
+			tg, err := guild.NewProcessor(l, ctx).GetByName(sp.GuildName())
+			if err != nil || tg.WorldId() != s.WorldId() {
+				l.Errorf("Unable to locate guild [%s] to invite to alliance [%d].", sp.GuildName(), g.AllianceId())
+				return
+			}
+			_ = ap.RequestInvite(s.WorldId(), g.AllianceId(), s.CharacterId(), tg.Id())
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationKick) {
+			sp := &alliancesb.Kick{}
+			sp.Decode(l, ctx)(r, readerOptions)
+			if sp.AllianceId() != g.AllianceId() {
+				l.Errorf("Character [%d] attempting to expel guild [%d] from alliance [%d] they are not in.", s.CharacterId(), sp.GuildId(), sp.AllianceId())
+				_ = session.NewProcessor(l, ctx).Destroy(s)
+				return
+			}
+			_ = ap.Expel(s.WorldId(), g.AllianceId(), s.CharacterId(), sp.GuildId())
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationSetTitleNames) {
+			sp := &alliancesb.SetTitleNames{}
+			sp.Decode(l, ctx)(r, readerOptions)
+			_ = ap.ChangeTitles(s.WorldId(), g.AllianceId(), s.CharacterId(), sp.Titles())
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationSetMemberTitle) {
+			sp := &alliancesb.SetMemberTitle{}
+			sp.Decode(l, ctx)(r, readerOptions)
+
+			tg, err := guild.NewProcessor(l, ctx).GetByMemberId(sp.CharacterId())
+			if err != nil || tg.AllianceId() != g.AllianceId() {
+				l.Errorf("Character [%d] attempting to change the alliance title of [%d] outside their alliance.", s.CharacterId(), sp.CharacterId())
+				return
+			}
+			var current byte
+			for _, m := range tg.Members() {
+				if m.CharacterId() == sp.CharacterId() {
+					current = m.AllianceTitle()
+				}
+			}
+			// Titles rank from 1 (master) downwards, so a raise lowers the number.
+			newTitle := current + 1
+			if sp.Raise() {
+				newTitle = current - 1
+			}
+			if newTitle <= 1 || newTitle > 5 {
+				l.Errorf("Character [%d] attempting to change [%d] to an alliance title [%d] outside of bounds.", s.CharacterId(), sp.CharacterId(), newTitle)
+				return
+			}
+			_ = ap.ChangeMemberTitle(s.WorldId(), g.AllianceId(), s.CharacterId(), sp.CharacterId(), newTitle)
+			return
+		}
+		if isGuildOperation(l)(readerOptions, op, AllianceOperationSetNotice) {
+			sp := &alliancesb.SetNotice{}
+			sp.Decode(l, ctx)(r, readerOptions)
+			if len(sp.Notice()) > 100 {
+				l.Errorf("Character [%d] setting an alliance notice longer than possible.", s.CharacterId())
+				_ = session.NewProcessor(l, ctx).Destroy(s)
+				return
+			}
+			_ = ap.ChangeNotice(s.WorldId(), g.AllianceId(), s.CharacterId(), sp.Notice())
+			return
+		}
+		l.Warnf("Character [%d] issued unhandled alliance operation with operation [%d].", s.CharacterId(), op)
+	}
+}
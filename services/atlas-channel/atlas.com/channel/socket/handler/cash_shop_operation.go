@@ -127,8 +127,10 @@ func CashShopOperationHandleFunc(l logrus.FieldLogger, ctx context.Context, wp w
 		if isCashShopOperation(l)(readerOptions, op, CashShopOperationEnableEquipSlot) {
 			sp := &cashsb.ShopOperationEnableEquipSlot{}
 			sp.Decode(l, ctx)(r, readerOptions)
-			pt := cashshop.GetPointType(sp.PointType())
-			l.Infof("Character [%d] enabling equip slot? via item [%d] using [%s].", s.CharacterId(), sp.SerialNumber(), pt)
+			err = cashshop.NewProcessor(l, ctx).RequestEquipSlotEnablePurchaseByItem(s.CharacterId(), sp.PointType(), sp.Currency(), sp.SerialNumber())
+			if err != nil {
+				l.WithError(err).Errorf("Unable to request equip slot expansion purchase for character [%d].", s.CharacterId())
+			}
 			return
 		}
 		if isCashShopOperation(l)(readerOptions, op, CashShopOperationMoveFromCashInventory) {
@@ -4,14 +4,19 @@ import (
 	"atlas-channel/battleship"
 	"atlas-channel/character"
 	"atlas-channel/character/buff"
+	"atlas-channel/character/damagecheck"
 	"atlas-channel/character/skill"
+	"atlas-channel/consumable"
+	monsterdata "atlas-channel/data/monster"
 	skill2 "atlas-channel/data/skill"
 	"atlas-channel/data/skill/effect"
 	"atlas-channel/data/skill/effect/statup"
 	"atlas-channel/drop"
+	"atlas-channel/drop/information"
 	"atlas-channel/effective_stats"
 	_map "atlas-channel/map"
 	"atlas-channel/monster"
+	"atlas-channel/report"
 	"atlas-channel/session"
 	"atlas-channel/skill/handler"
 	"atlas-channel/socket/writer"
@@ -19,12 +24,15 @@ import (
 	"errors"
 	"math"
 	"math/rand"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/constants"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory/slot"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
 	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/point"
@@ -69,6 +77,24 @@ func snapshotVenomDamagePerTick(luck, magicAttack int, coef float64) int32 {
 	return int32(math.Round(coef * float64(luck) * float64(magicAttack)))
 }
 
+// skillMonsterStatuses is the APPLY_STATUS payload for a skill's monster
+// statuses. Magnitudes come from the skill data as-is, except VENOM, whose
+// per-tick damage depends on the caster's stats and is snapshotted here;
+// atlas-monsters owns everything else about the status (stacking, ticks,
+// exclusions, immunity, level validation).
+func skillMonsterStatuses(se effect.Model, loadEffectiveStats func() effective_stats.RestModel) map[string]int32 {
+	ms := make(map[string]int32, len(se.MonsterStatus()))
+	for k, v := range se.MonsterStatus() {
+		ms[k] = int32(v)
+	}
+	if _, isVenom := ms["VENOM"]; isVenom {
+		stats := loadEffectiveStats()
+		coef := 0.1 + rand.Float64()*0.1
+		ms["VENOM"] = snapshotVenomDamagePerTick(int(stats.Luck), int(stats.MagicAttack), coef)
+	}
+	return ms
+}
+
 // attackKindFromAttackType maps a packet AttackType to the reflect kind the
 // monster's reflect would have to match for the attack to be reflected.
 // Returns the empty string for attack types that cannot be reflected
@@ -83,21 +109,6 @@ func attackKindFromAttackType(at packetmodel.AttackType) string {
 	return ""
 }
 
-// isDrainSkill reports whether id is one of the four attack-side
-// drain-family skills that heal the attacker from damage dealt
-// (Assassin Drain, Marauder/Thunder Breaker Energy Drain, Night
-// Walker Vampire). Aran Combo Drain is buff-driven and excluded.
-func isDrainSkill(id skill3.Id) bool {
-	switch id {
-	case skill3.AssassinDrainId,
-		skill3.MarauderEnergyDrainId,
-		skill3.ThunderBreakerStage3EnergyDrainId,
-		skill3.NightWalkerStage2VampireId:
-		return true
-	}
-	return false
-}
-
 // damageInfoEntryDeps groups the per-attack closures and lookups that
 // processDamageInfoEntry needs. Wrapping them keeps the helper signature
 // readable and lets tests construct fakes with a single struct.
@@ -142,16 +153,7 @@ func processDamageInfoEntry(
 		if len(se.MonsterStatus()) == 0 {
 			return
 		}
-		ms := make(map[string]int32)
-		for k, v := range se.MonsterStatus() {
-			ms[k] = int32(v)
-		}
-		if _, isVenom := ms["VENOM"]; isVenom {
-			stats := deps.loadEffectiveStats()
-			coef := 0.1 + rand.Float64()*0.1
-			ms["VENOM"] = snapshotVenomDamagePerTick(int(stats.Luck), int(stats.MagicAttack), coef)
-		}
-		_ = deps.applyStatus(f, di.MonsterId(), casterId, uint32(ai.SkillId()), skillLevel, ms, uint32(se.Duration()))
+		_ = deps.applyStatus(f, di.MonsterId(), casterId, uint32(ai.SkillId()), skillLevel, skillMonsterStatuses(se, deps.loadEffectiveStats), uint32(se.Duration()))
 		return
 	}
 
@@ -189,16 +191,7 @@ func processDamageInfoEntry(
 
 	// Apply monster status effects from skill (e.g., freeze, poison, stun).
 	if len(se.MonsterStatus()) > 0 {
-		ms := make(map[string]int32)
-		for k, v := range se.MonsterStatus() {
-			ms[k] = int32(v)
-		}
-		if _, isVenom := ms["VENOM"]; isVenom {
-			stats := deps.loadEffectiveStats()
-			coef := 0.1 + rand.Float64()*0.1
-			ms["VENOM"] = snapshotVenomDamagePerTick(int(stats.Luck), int(stats.MagicAttack), coef)
-		}
-		_ = deps.applyStatus(f, di.MonsterId(), casterId, uint32(ai.SkillId()), skillLevel, ms, uint32(se.Duration()))
+		_ = deps.applyStatus(f, di.MonsterId(), casterId, uint32(ai.SkillId()), skillLevel, skillMonsterStatuses(se, deps.loadEffectiveStats), uint32(se.Duration()))
 	}
 
 	if deps.onDamageApplied != nil {
@@ -829,6 +822,7 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 					var sk skill.Model
 					var se effect.Model
 					var explodedMesoDropIds []uint32
+					registered := false
 
 					if ai.SkillId() > 0 {
 						// Process skill
@@ -871,6 +865,25 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 							return nil
 						}
 
+						// A skill with a use-skill Handler has its cooldown
+						// started by the buff-side packet that precedes this
+						// attack, so only the cast gate there applies.
+						if attackIdOk {
+							_, registered = handler.Lookup(attackId)
+						}
+
+						// Server-side cooldown gate, same soft-rejection
+						// posture: the attack would otherwise land and the
+						// post-attack hook restart the cooldown.
+						if !registered && skillOnCooldown(sk.CooldownExpiresAt(), time.Now()) {
+							l.WithFields(logrus.Fields{
+								"character_id":        s.CharacterId(),
+								"skill_id":            ai.SkillId(),
+								"cooldown_expires_at": sk.CooldownExpiresAt(),
+							}).Debug("skill_attack_rejected_on_cooldown")
+							return nil
+						}
+
 						se, err = skill2.NewProcessor(l, ctx).GetEffect(ai.SkillId(), sk.Level())
 						if err != nil {
 							return err
@@ -904,10 +917,6 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 						// CharacterUseSkill packet. Without this gate,
 						// dual-packet skills like Heal would
 						// double-deduct MP.
-						registered := false
-						if attackIdOk {
-							_, registered = handler.Lookup(attackId)
-						}
 						if !registered {
 							if se.HPConsume() > 0 {
 								_ = cp.ChangeHP(s.Field(), s.CharacterId(), -int16(se.HPConsume()))
@@ -968,10 +977,60 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 						s.CharacterId(),
 					)
 
+					// Attack-side skill effects (character_attack_effect*.go):
+					// resolved once per attack from the cast skill, its WZ
+					// effect fields and the caster's active buffs.
+					bp := buff.NewProcessor(l, ctx)
+					sp := skill.NewProcessor(l, ctx)
+					effects := resolveAttackEffects(attackEffectContext{
+						l:                  l,
+						f:                  s.Field(),
+						characterId:        s.CharacterId(),
+						c:                  c,
+						ai:                 ai,
+						skillId:            ai.SkillId(),
+						skillLevel:         sk.Level(),
+						e:                  se,
+						registeredUseSkill: registered,
+						deps: attackEffectDeps{
+							getBuffs:           loadBuffs,
+							getEffect:          skill2.NewProcessor(l, ctx).GetEffect,
+							resolveSkill:       set.Skill.Resolve,
+							getMonster:         mp.GetById,
+							loadEffectiveStats: loadEffectiveStats,
+							changeHP:           cp.ChangeHP,
+							applyStatus:        mp.ApplyStatus,
+							killMonster:        mp.Kill,
+							cancelBuff:         bp.Cancel,
+							applyCooldown: func(f field.Model, skillId skill3.Id, cooldown uint32, characterId uint32) error {
+								return sp.ApplyCooldown(f, skillId, cooldown)(characterId)
+							},
+							consumeItem: func(f field.Model, characterId uint32, itemId uint32, source int16, quantity int16) error {
+								return consumable.NewProcessor(l, ctx).RequestItemConsume(f, charconst.Id(characterId), item.Id(itemId), slot.Position(source), quantity, 0)
+							},
+							monsterDrops: information.NewProcessor(l, ctx).GetByMonsterId,
+							spawnItem:    dp.SpawnItem,
+							markStolen: func(monsterId uint32) bool {
+								return monster.GetStolenRegistry().MarkStolen(t, monsterId, time.Now())
+							},
+							weakenElement: mp.WeakenElement,
+							roll:          rand.Float64,
+						},
+					}, attackId, attackIdOk)
+					if !effects.preDamage() {
+						return nil
+					}
+
+					// The attack's element and the caster's Elemental Reset
+					// ride on every damage command of the attack.
+					element, elementalReset := resolveAttackElement(l, skill2.NewProcessor(l, ctx).GetById, loadBuffs, s.CharacterId(), ai.SkillId())
+
 					deps := damageInfoEntryDeps{
-						getReflect:         mirror.GetReflect,
-						getMonster:         mp.GetById,
-						applyDamage:        mp.Damage,
+						getReflect: mirror.GetReflect,
+						getMonster: mp.GetById,
+						applyDamage: func(f field.Model, monsterId, characterId uint32, damages []uint32, attackType byte) error {
+							return mp.Damage(f, monsterId, characterId, damages, attackType, element, elementalReset)
+						},
 						emitReflectDamage:  mp.EmitDamageReflected,
 						applyStatus:        mp.ApplyStatus,
 						loadEffectiveStats: loadEffectiveStats,
@@ -983,9 +1042,6 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 							if ai.AttackType() == packetmodel.AttackTypeMagic && ai.SkillId() > 0 {
 								mpEaterTryProc(l, ctx, mp, c, di.MonsterId(), s.Field(), s.CharacterId())
 							}
-							if ai.SkillId() > 0 && isDrainSkill(skill3.Id(ai.SkillId())) {
-								drainTryHeal(l, mp.GetById, cp.ChangeHP, loadEffectiveStats, se.X(), ai.SkillId(), di.MonsterId(), totalDamage, s.Field(), s.CharacterId())
-							}
 							if ppState.enabled {
 								pickPocketTryProc(l, mp.GetById, dp.SpawnMeso, ppState, di, s.Field(), s.CharacterId())
 							}
@@ -999,8 +1055,40 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 									roll:       func() int { return rand.Intn(100) + 1 },
 								}, se, di.MonsterId(), s.Field(), s.CharacterId(), ai.SkillId())
 							}
+							effects.perTarget(di, totalDamage)
 						},
 					}
+
+					// Damage-range validation runs before any entry is
+					// applied, so the monster damage command and the
+					// broadcast below both carry the corrected lines.
+					if !damageCheckExempted(attackId, attackIdOk) {
+						weaponType := item.WeaponTypeNone
+						if w, ok := equippedWeapon(c); ok {
+							weaponType = item.GetWeaponType(item.Id(w.TemplateId()))
+						}
+						validateAttackDamage(l, &ai, s.CharacterId(), c.Level(), weaponType, se, damageCheckDeps{
+							monsterTemplate: func(uniqueId uint32) (uint32, error) {
+								if e, ok := monster.GetLiveMirror().Lookup(t, uniqueId); ok {
+									return e.MonsterId, nil
+								}
+								m, mErr := mp.GetById(uniqueId)
+								if mErr != nil {
+									return 0, mErr
+								}
+								return m.MonsterId(), nil
+							},
+							monsterData:        monsterdata.NewProcessor(l, ctx).GetById,
+							loadEffectiveStats: loadEffectiveStats,
+							recordSuspicion: func(weight float64) (float64, bool) {
+								return damagecheck.GetRegistry().Record(t, s.CharacterId(), weight, time.Now())
+							},
+							fileReport: func(description string) error {
+								return report.NewProcessor(l, ctx).Detect(s.WorldId(), s.ChannelId(), s.CharacterId(), description)
+							},
+						})
+					}
+
 					for _, di := range ai.DamageInfo() {
 						processDamageInfoEntry(
 							l, di, ai, se, uint32(sk.Level()),
@@ -1060,8 +1148,6 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 						}
 					}
 
-					// TODO apply cooldown
-					// TODO cancel dark sight / wind walk
 					// Combo orb gain/consume: melee only (close-range attacks,
 					// Cosmic CloseRangeDamageHandler parity). Fire-and-forget
 					// beside the projectile emit — failures never abort the
@@ -1094,24 +1180,11 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 						energyChargeTryUpdate(l, set.Skill, c, ai, energyChargeProductionDeps(l, ctx, s.Field(), s.CharacterId()))
 					}
 
-					// Dragon Knight Sacrifice trades the caster's HP for the hit:
-					// firstDamageLine × X / 100, clamped to leave at least 1 HP
-					// (Cosmic parity — Sacrifice can never kill the caster). This
-					// damage-proportional cost is separate from the generic
-					// HPConsume/MPConsume cast cost above, which continues to apply.
-					// Fire-and-forget like the projectile emit: failures are
-					// logged and never abort the attack pipeline.
-					if skill3.Id(ai.SkillId()) == skill3.DragonKnightSacrificeId {
-						firstLine := sacrificeFirstDamageLine(ai)
-						cost := sacrificeHpCost(firstLine, se.X(), c.Hp())
-						if cost > 0 {
-							l.Debugf("Sacrifice self-HP cost: caster=[%d] skill=[%d] firstLine=[%d] x=[%d] cost=[%d].",
-								s.CharacterId(), ai.SkillId(), firstLine, se.X(), cost)
-							if herr := cp.ChangeHP(s.Field(), s.CharacterId(), -int16(cost)); herr != nil {
-								l.WithError(herr).Errorf("Sacrifice: CHANGE_HP emit failed for caster [%d] skill [%d].", s.CharacterId(), ai.SkillId())
-							}
-						}
-					}
+					// Post-attack skill effects: cooldown, concealment break,
+					// itemCon consumption, Sacrifice's HP cost, and whatever
+					// else registered a postAttack hook. Fire-and-forget like
+					// the projectile emit.
+					effects.postAttack()
 
 					// Per-skill attack-cast dispatcher (Poison Mist, ...). This is
 					// the ATTACK-packet twin of the UseSkill dispatcher at
@@ -1132,12 +1205,7 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 						attackCastTryApply(l, ctx, wp, s.Field(), s.CharacterId(), attackId, skill3.Id(ai.SkillId()), sk.Level(), se, attackCastOrigin(ai))
 					}
 
-					// TODO apply attack effect (heal, mp consumption, dispel, cure all, combo reset, etc)
-					// TODO apply Bandit Steal
-					// TODO Fire Demon ice weaken
-					// TODO Ice Demon fire weaken
 					if ai.AttackType() == packetmodel.AttackTypeRanged && ai.SkillId() > 0 {
-						bp := buff.NewProcessor(l, ctx)
 						beaconTryApply(l, ai, sk.Level(), s.Field(), s.CharacterId(), beaconApplyDeps{
 							monsterExists: func(monsterId uint32) bool {
 								_, gErr := mp.GetById(monsterId)
@@ -1152,17 +1220,7 @@ func processAttack(l logrus.FieldLogger) func(ctx context.Context) func(wp write
 							},
 						})
 					}
-					// TODO Flame Thrower
-					// TODO Snow Charge
-					// TODO Hamstring
-					// TODO Slow
-					// TODO Blind
-					// TODO Paladin / White Knight charges
 					comboDrainTryProc(l, loadBuffs, cp.ChangeHP, s.Field(), s.CharacterId(), ai)
-					// TODO Three Snails consumption
-					// TODO Heavens Hammer
-					// TODO ComboTempest
-					// TODO BodyPressure
 
 					return nil
 				}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"atlas-channel/data/skill/effect"
+	"atlas-channel/effective_stats"
 	"atlas-channel/monster"
 	"errors"
 	"io"
@@ -317,6 +319,39 @@ func TestSnapshotVenomDamagePerTick_Mid(t *testing.T) {
 	}
 }
 
+// TestSkillMonsterStatuses_PassesSkillDataThrough pins that a skill without
+// VENOM forwards its WZ magnitudes untouched and never pays for the
+// effective-stats lookup.
+func TestSkillMonsterStatuses_PassesSkillDataThrough(t *testing.T) {
+	se, err := effect.Extract(effect.RestModel{MonsterStatus: map[string]uint32{"FREEZE": 1, "SPEED": 40}})
+	if err != nil {
+		t.Fatalf("effect.Extract: %v", err)
+	}
+	got := skillMonsterStatuses(se, func() effective_stats.RestModel {
+		t.Fatal("effective stats loaded for a skill without VENOM")
+		return effective_stats.RestModel{}
+	})
+	if len(got) != 2 || got["FREEZE"] != 1 || got["SPEED"] != 40 {
+		t.Fatalf("skillMonsterStatuses() = %v, want FREEZE:1 SPEED:40", got)
+	}
+}
+
+// TestSkillMonsterStatuses_SnapshotsVenom pins that VENOM's magnitude is
+// replaced by the caster-stat snapshot, inside the [0.1, 0.2) coefficient
+// band for Luck=120, MAtk=200.
+func TestSkillMonsterStatuses_SnapshotsVenom(t *testing.T) {
+	se, err := effect.Extract(effect.RestModel{MonsterStatus: map[string]uint32{"VENOM": 1}})
+	if err != nil {
+		t.Fatalf("effect.Extract: %v", err)
+	}
+	got := skillMonsterStatuses(se, func() effective_stats.RestModel {
+		return effective_stats.RestModel{Luck: 120, MagicAttack: 200}
+	})
+	if v := got["VENOM"]; v < 2400 || v > 4800 {
+		t.Fatalf("VENOM = %d, want within [2400, 4800]", v)
+	}
+}
+
 // TestAttackKindFromAttackType maps each AttackType to the reflect kind
 // the handler will look up in the StatusMirror.
 func TestAttackKindFromAttackType(t *testing.T) {
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"atlas-channel/character/damagecheck"
+	monsterdata "atlas-channel/data/monster"
+	"atlas-channel/data/skill/effect"
+	"atlas-channel/effective_stats"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+// damageSource names what an exempt attack skill's lines scale with in
+// place of the caster's stats.
+type damageSource string
+
+const (
+	damageSourceMesos damageSource = "mesos" // mesos dropped or consumed
+	damageSourceHp    damageSource = "hp"    // the caster's current or spent HP
+	damageSourceKill  damageSource = "kill"  // a fixed or target-HP kill shot
+	damageSourceHeal  damageSource = "heal"  // the heal amount, against undead
+	damageSourceCombo damageSource = "combo" // a per-use combo or charge counter
+)
+
+// damageCheckExempt maps each attack skill whose lines the damage check
+// leaves unvalidated to the source its damage scales with. It was derived
+// by walking every attack skill and keeping the ones whose client-side
+// damage is not the stat formula times the WZ `damage` percent:
+//
+//   - skills whose WZ effect carries `fixdamage` are NOT listed; they are
+//     bounded exactly by effect.FixDamage() instead;
+//   - combo finishers are listed because their multiplier grows with the
+//     orbs or combo count consumed, which effective stats never see and
+//     which can outgrow Tolerance on its own;
+//   - venom-family damage is not listed because it ticks server-side in
+//     atlas-monsters and never arrives as an attack line.
+//
+// A new attack skill with a non-stat damage source belongs here; the test
+// pins that every entry would otherwise be clamped by damagecheck.Compute.
+var damageCheckExempt = map[skill3.Identity]damageSource{
+	skill3.ChiefBanditMesoExplosion: damageSourceMesos,
+	skill3.HermitShadowMeso:         damageSourceMesos,
+	skill3.DragonKnightDragonRoar:   damageSourceHp,
+	skill3.DragonKnightSacrifice:    damageSourceHp,
+	skill3.PaladinHeavensHammer:     damageSourceKill,
+	skill3.GmSuperDragonRoar:        damageSourceKill,
+	skill3.SuperGmDragonRoar:        damageSourceKill,
+	skill3.ClericHeal:               damageSourceHeal,
+	skill3.CrusaderPanicSword:       damageSourceCombo,
+	skill3.CrusaderPanicAxe:         damageSourceCombo,
+	skill3.CrusaderComaSword:        damageSourceCombo,
+	skill3.CrusaderComaAxe:          damageSourceCombo,
+	skill3.ShadowerAssassinate:      damageSourceCombo,
+	skill3.AranStage2ComboSmash:     damageSourceCombo,
+	skill3.AranStage3ComboFenrir:    damageSourceCombo,
+	skill3.AranStage4ComboTempest:   damageSourceCombo,
+}
+
+func damageCheckExempted(attackId skill3.Identity, attackIdOk bool) bool {
+	if !attackIdOk {
+		return false
+	}
+	_, ok := damageCheckExempt[attackId]
+	return ok
+}
+
+// damageCheckDeps isolates the lookups and side effects of damage-range
+// validation so tests can drive every verdict without REST or Kafka.
+type damageCheckDeps struct {
+	// monsterTemplate resolves a spawned monster's unique id to its
+	// template id.
+	monsterTemplate func(uniqueId uint32) (uint32, error)
+	// monsterData fetches the template's level/defense from atlas-data.
+	monsterData        func(templateId uint32) (monsterdata.Model, error)
+	loadEffectiveStats func() effective_stats.RestModel
+	// recordSuspicion adds weight to the session's suspicion score and
+	// reports whether a detection report is now due.
+	recordSuspicion func(weight float64) (float64, bool)
+	fileReport      func(description string) error
+}
+
+// validateAttackDamage bounds every damage line of ai against the
+// theoretical range for this caster, skill and target BEFORE any damage is
+// applied or broadcast. Offending entries are rewritten in place through
+// DamageInfo.SetDamages, so the monster damage command and the broadcast
+// to other players both carry the corrected lines. Lookup failures fail
+// open: an entry that cannot be bounded is applied as reported.
+func validateAttackDamage(
+	l logrus.FieldLogger,
+	ai *packetmodel.AttackInfo,
+	characterId uint32,
+	level byte,
+	weaponType item.WeaponType,
+	se effect.Model,
+	deps damageCheckDeps,
+) {
+	var base damagecheck.Input
+	baseLoaded := false
+	templates := make(map[uint32]monsterdata.Model)
+
+	entries := ai.DamageInfo()
+	for i := range entries {
+		damages := entries[i].Damages()
+		if len(damages) == 0 {
+			continue
+		}
+
+		var r damagecheck.Range
+		if se.FixDamage() > 0 {
+			r = damagecheck.Fixed(uint32(se.FixDamage()))
+		} else {
+			if !baseLoaded {
+				base = damageCheckInput(ai, level, weaponType, se, deps.loadEffectiveStats())
+				baseLoaded = true
+			}
+			in := base
+			if md, ok := damageCheckMonster(l, entries[i].MonsterId(), templates, deps); ok {
+				if md.FixedDamage() > 0 {
+					r = damagecheck.Fixed(md.FixedDamage())
+				}
+				in.MonsterLevel = md.Level()
+				in.MonsterDefense = md.WeaponDefense()
+				if in.Magic {
+					in.MonsterDefense = md.MagicDefense()
+				}
+			}
+			if !r.Exact {
+				var ok bool
+				if r, ok = damagecheck.Compute(in); !ok {
+					continue
+				}
+			}
+		}
+
+		res := damagecheck.Check(damages, r)
+		if res.Verdict == damagecheck.VerdictOk {
+			continue
+		}
+		entries[i].SetDamages(res.Damages)
+
+		weight := damagecheck.ClampWeight
+		event := "damage_range_clamped"
+		if res.Verdict == damagecheck.VerdictRejected {
+			weight = damagecheck.RejectWeight
+			event = "damage_range_rejected"
+		}
+		score, report := deps.recordSuspicion(weight)
+		l.WithFields(logrus.Fields{
+			"character_id": characterId,
+			"skill_id":     ai.SkillId(),
+			"monster_id":   entries[i].MonsterId(),
+			"highest":      res.Highest,
+			"ceiling":      res.Ceiling,
+			"clamped":      res.Clamped,
+			"suspicion":    score,
+		}).Warn(event)
+
+		if report {
+			desc := fmt.Sprintf("Damage range violation: skill [%d] reported line [%d] against a ceiling of [%d]; session suspicion score [%.1f].", ai.SkillId(), res.Highest, res.Ceiling, score)
+			if err := deps.fileReport(desc); err != nil {
+				l.WithError(err).Errorf("Unable to file damage detection report for character [%d].", characterId)
+			}
+		}
+	}
+}
+
+// damageCheckInput builds the caster half of the range input, shared by
+// every entry of one attack.
+func damageCheckInput(ai *packetmodel.AttackInfo, level byte, weaponType item.WeaponType, se effect.Model, stats effective_stats.RestModel) damagecheck.Input {
+	return damagecheck.Input{
+		Magic:        ai.AttackType() == packetmodel.AttackTypeMagic,
+		WeaponType:   weaponType,
+		Level:        level,
+		Strength:     stats.Strength,
+		Dexterity:    stats.Dexterity,
+		Luck:         stats.Luck,
+		Intelligence: stats.Intelligence,
+		WeaponAttack: stats.WeaponAttack,
+		MagicAttack:  stats.MagicAttack,
+		SkillDamage:  se.Damage(),
+		SpellAttack:  se.MagicAttack(),
+		Mastery:      se.Mastery(),
+	}
+}
+
+// damageCheckMonster resolves the target's template data, memoised per
+// template for the duration of one attack.
+func damageCheckMonster(l logrus.FieldLogger, uniqueId uint32, templates map[uint32]monsterdata.Model, deps damageCheckDeps) (monsterdata.Model, bool) {
+	templateId, err := deps.monsterTemplate(uniqueId)
+	if err != nil {
+		l.WithError(err).Debugf("Unable to resolve monster [%d] for damage validation; bounding without target data.", uniqueId)
+		return monsterdata.Model{}, false
+	}
+	if md, ok := templates[templateId]; ok {
+		return md, true
+	}
+	md, err := deps.monsterData(templateId)
+	if err != nil {
+		l.WithError(err).Debugf("Unable to fetch monster template [%d] for damage validation; bounding without target data.", templateId)
+		return monsterdata.Model{}, false
+	}
+	templates[templateId] = md
+	return md, true
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"atlas-channel/character/damagecheck"
+	monsterdata "atlas-channel/data/monster"
+	"atlas-channel/data/skill/effect"
+	"atlas-channel/effective_stats"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+type damageCheckRecorder struct {
+	weights []float64
+	reports []string
+	report  bool
+}
+
+// damageCheckTestDeps bounds a 1H sword warrior at a 850 max line against a
+// level-1, zero-defense monster: ceiling 3400, rejection above 6800.
+func damageCheckTestDeps(rec *damageCheckRecorder, md monsterdata.RestModel) damageCheckDeps {
+	return damageCheckDeps{
+		monsterTemplate: func(uniqueId uint32) (uint32, error) { return 100100, nil },
+		monsterData: func(templateId uint32) (monsterdata.Model, error) {
+			return monsterdata.Extract(md)
+		},
+		loadEffectiveStats: func() effective_stats.RestModel {
+			return effective_stats.RestModel{Strength: 200, Dexterity: 50, WeaponAttack: 100}
+		},
+		recordSuspicion: func(weight float64) (float64, bool) {
+			rec.weights = append(rec.weights, weight)
+			return float64(len(rec.weights)), rec.report
+		},
+		fileReport: func(description string) error {
+			rec.reports = append(rec.reports, description)
+			return nil
+		},
+	}
+}
+
+func TestValidateAttackDamageVerdicts(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	rec := &damageCheckRecorder{}
+	ai := attackWithDamages(packetmodel.AttackTypeMelee,
+		[]uint32{800, 3000},  // in range
+		[]uint32{800, 5000},  // clamped
+		[]uint32{800, 90000}, // rejected
+		[]uint32{},           // no lines
+	)
+
+	validateAttackDamage(l, &ai, 1, 50, item.WeaponTypeOneHandedSword, effect.Model{}, damageCheckTestDeps(rec, monsterdata.RestModel{Level: 1}))
+
+	di := ai.DamageInfo()
+	if got := di[0].Damages(); got[0] != 800 || got[1] != 3000 {
+		t.Errorf("in-range entry changed: %v", got)
+	}
+	if got := di[1].Damages(); got[0] != 800 || got[1] != 3400 {
+		t.Errorf("clamped entry: %v, want [800 3400]", got)
+	}
+	if got := di[2].Damages(); got[0] != 0 || got[1] != 0 {
+		t.Errorf("rejected entry: %v, want zeroed", got)
+	}
+	if len(rec.weights) != 2 || rec.weights[0] != damagecheck.ClampWeight || rec.weights[1] != damagecheck.RejectWeight {
+		t.Errorf("suspicion weights: %v", rec.weights)
+	}
+	if len(rec.reports) != 0 {
+		t.Errorf("no report expected below threshold, got %v", rec.reports)
+	}
+}
+
+func TestValidateAttackDamageFilesReportWhenDue(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	rec := &damageCheckRecorder{report: true}
+	ai := attackWithDamages(packetmodel.AttackTypeMelee, []uint32{90000})
+
+	validateAttackDamage(l, &ai, 1, 50, item.WeaponTypeOneHandedSword, effect.Model{}, damageCheckTestDeps(rec, monsterdata.RestModel{Level: 1}))
+
+	if len(rec.reports) != 1 {
+		t.Fatalf("want one detection report, got %d", len(rec.reports))
+	}
+}
+
+// TestValidateAttackDamageMonsterFixedDamage asserts a fixed-damage monster
+// admits exactly its fixed value, with no tolerance.
+func TestValidateAttackDamageMonsterFixedDamage(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	rec := &damageCheckRecorder{}
+	ai := attackWithDamages(packetmodel.AttackTypeMelee, []uint32{1, 2})
+
+	validateAttackDamage(l, &ai, 1, 50, item.WeaponTypeOneHandedSword, effect.Model{}, damageCheckTestDeps(rec, monsterdata.RestModel{FixedDamage: 1}))
+
+	if got := ai.DamageInfo()[0].Damages(); got[0] != 1 || got[1] != 1 {
+		t.Errorf("fixed-damage entry: %v, want [1 1]", got)
+	}
+}
+
+// TestValidateAttackDamageFailsOpen asserts an entry that cannot be bounded
+// (no effective stats) is applied as reported, never clamped to zero.
+func TestValidateAttackDamageFailsOpen(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	rec := &damageCheckRecorder{}
+	deps := damageCheckTestDeps(rec, monsterdata.RestModel{})
+	deps.loadEffectiveStats = func() effective_stats.RestModel { return effective_stats.RestModel{} }
+	deps.monsterTemplate = func(uint32) (uint32, error) { return 0, errors.New("down") }
+	ai := attackWithDamages(packetmodel.AttackTypeMelee, []uint32{90000})
+
+	validateAttackDamage(l, &ai, 1, 50, item.WeaponTypeOneHandedSword, effect.Model{}, deps)
+
+	if got := ai.DamageInfo()[0].Damages(); got[0] != 90000 {
+		t.Errorf("unbounded entry changed: %v", got)
+	}
+	if len(rec.weights) != 0 {
+		t.Errorf("unbounded entry must not add suspicion: %v", rec.weights)
+	}
+}
+
+func TestDamageCheckExempted(t *testing.T) {
+	if !damageCheckExempted(skill3.ChiefBanditMesoExplosion, true) {
+		t.Error("Meso Explosion must be exempt")
+	}
+	if damageCheckExempted(skill3.ChiefBanditMesoExplosion, false) {
+		t.Error("an unresolved id must not be exempt")
+	}
+	if damageCheckExempted(skill3.PaladinHeavensHammer-1, true) {
+		t.Error("a neighbouring skill must not be exempt")
+	}
+}
+
+// TestDamageCheckExemptSkillsOutgrowCompute runs every attack skill with a
+// non-stat damage source through damagecheck.Compute for the reference
+// caster and asserts a line the mechanic legitimately produces would be
+// clamped — the reason for the exemption — and that the skill is exempt.
+// The table must cover damageCheckExempt exactly, so an entry cannot be
+// added or dropped without its justification.
+func TestDamageCheckExemptSkillsOutgrowCompute(t *testing.T) {
+	cases := []struct {
+		id     skill3.Identity
+		source damageSource
+		line   uint32 // a legitimate line for the reference caster
+	}{
+		{skill3.ChiefBanditMesoExplosion, damageSourceMesos, 30000},
+		{skill3.HermitShadowMeso, damageSourceMesos, 20000},
+		{skill3.DragonKnightDragonRoar, damageSourceHp, 15000},
+		{skill3.DragonKnightSacrifice, damageSourceHp, 15000},
+		{skill3.PaladinHeavensHammer, damageSourceKill, damagecheck.LineCap},
+		{skill3.GmSuperDragonRoar, damageSourceKill, damagecheck.LineCap},
+		{skill3.SuperGmDragonRoar, damageSourceKill, damagecheck.LineCap},
+		{skill3.ClericHeal, damageSourceHeal, 8000},
+		{skill3.CrusaderPanicSword, damageSourceCombo, 12000},
+		{skill3.CrusaderPanicAxe, damageSourceCombo, 12000},
+		{skill3.CrusaderComaSword, damageSourceCombo, 12000},
+		{skill3.CrusaderComaAxe, damageSourceCombo, 12000},
+		{skill3.ShadowerAssassinate, damageSourceCombo, 12000},
+		{skill3.AranStage2ComboSmash, damageSourceCombo, 12000},
+		{skill3.AranStage3ComboFenrir, damageSourceCombo, 12000},
+		{skill3.AranStage4ComboTempest, damageSourceCombo, 12000},
+	}
+	if len(cases) != len(damageCheckExempt) {
+		t.Fatalf("table covers %d skills, damageCheckExempt has %d", len(cases), len(damageCheckExempt))
+	}
+
+	stats := damageCheckTestDeps(&damageCheckRecorder{}, monsterdata.RestModel{}).loadEffectiveStats()
+	for _, c := range cases {
+		if got, ok := damageCheckExempt[c.id]; !ok || got != c.source {
+			t.Errorf("skill %d: exempt source = %q (listed %v), want %q", c.id, got, ok, c.source)
+			continue
+		}
+		if !damageCheckExempted(c.id, true) {
+			t.Errorf("skill %d is listed but not exempted", c.id)
+		}
+		ai := attackWithDamages(packetmodel.AttackTypeMelee, []uint32{c.line})
+		in := damageCheckInput(&ai, 50, item.WeaponTypeOneHandedSword, effect.Model{}, stats)
+		in.MonsterLevel = 1
+		r, ok := damagecheck.Compute(in)
+		if !ok {
+			t.Fatalf("reference caster cannot be bounded")
+		}
+		if res := damagecheck.Check([]uint32{c.line}, r); res.Verdict == damagecheck.VerdictOk {
+			t.Errorf("skill %d: line %d passes Compute (ceiling %d); the exemption is unnecessary", c.id, c.line, r.Ceiling())
+		}
+	}
+}
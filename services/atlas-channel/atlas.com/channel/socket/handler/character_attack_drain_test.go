@@ -21,24 +21,26 @@ import (
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
 
-func TestIsDrainSkill(t *testing.T) {
+// TestDrainRegisteredAsCastEffect pins the four attack-side drain skills
+// to the drain heal. Aran Combo Drain is buff-driven (COMBO_DRAIN stat)
+// and must not be.
+func TestDrainRegisteredAsCastEffect(t *testing.T) {
 	tests := []struct {
 		name string
-		id   skill3.Id
+		id   skill3.Identity
 		want bool
 	}{
-		{"assassin drain", skill3.AssassinDrainId, true},
-		{"marauder energy drain", skill3.MarauderEnergyDrainId, true},
-		{"thunder breaker energy drain", skill3.ThunderBreakerStage3EnergyDrainId, true},
-		{"night walker vampire", skill3.NightWalkerStage2VampireId, true},
-		{"aran combo drain is NOT attack-side drain", skill3.AranStage2ComboDrainId, false},
-		{"zero id", skill3.Id(0), false},
-		{"adjacent id", skill3.AssassinDrainId + 1, false},
+		{"assassin drain", skill3.AssassinDrain, true},
+		{"marauder energy drain", skill3.MarauderEnergyDrain, true},
+		{"thunder breaker energy drain", skill3.ThunderBreakerStage3EnergyDrain, true},
+		{"night walker vampire", skill3.NightWalkerStage2Vampire, true},
+		{"aran combo drain is NOT attack-side drain", skill3.AranStage2ComboDrain, false},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			if got := isDrainSkill(tc.id); got != tc.want {
-				t.Errorf("isDrainSkill(%d) = %v, want %v", tc.id, got, tc.want)
+			e, ok := castEffects[tc.id]
+			if got := ok && e.perTarget != nil; got != tc.want {
+				t.Errorf("drain cast effect registered for [%d] = %v, want %v", tc.id, got, tc.want)
 			}
 		})
 	}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/character/buff"
+	"atlas-channel/data/skill/effect"
+	"atlas-channel/drop/information"
+	"atlas-channel/effective_stats"
+	"atlas-channel/monster"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+// attackEffect is one attack-side skill mechanic, hooked into up to three
+// phases of processAttack. Every hook is optional.
+//
+//   - preDamage runs after the skill's ownership and cost gates, before any
+//     damage line is validated or applied. Returning false rejects the
+//     attack softly: no damage, no broadcast, no later hook, and the session
+//     is kept (the same posture as the battleship and Energy Blast gates).
+//   - perTarget runs once per damaged, non-reflected monster, after damage
+//     and status apply, with the entry's summed damage.
+//   - postAttack runs once after the broadcast and the projectile emit.
+//
+// perTarget and postAttack are fire-and-forget: the attack is already
+// complete, so a hook logs and swallows its own failures.
+type attackEffect struct {
+	preDamage  func(ac attackEffectContext) bool
+	perTarget  func(ac attackEffectContext, di packetmodel.DamageInfo, totalDamage uint32)
+	postAttack func(ac attackEffectContext)
+}
+
+// attackEffectContext is what a hook sees: the attack, the caster, and the
+// skill the effect was registered for. For a cast effect that skill is the
+// attack's own; for a buff effect it is the active buff's source, so
+// skillId/skillLevel/e describe the buff (Hamstring's prop, x and y), not
+// the attack that carried it.
+type attackEffectContext struct {
+	l           logrus.FieldLogger
+	f           field.Model
+	characterId uint32
+	c           character.Model
+	ai          packetmodel.AttackInfo
+	skillId     uint32
+	skillLevel  byte
+	e           effect.Model
+	// registeredUseSkill: the attack's skill has a use-skill Handler, which
+	// owns its cast costs and cooldown on the buff-side packet.
+	registeredUseSkill bool
+	deps               attackEffectDeps
+}
+
+// attackEffectDeps isolates every lookup and emit attack effects perform,
+// so tests drive each hook without REST or Kafka.
+type attackEffectDeps struct {
+	getBuffs           func(characterId uint32) ([]buff.Model, error)
+	getEffect          func(skillId uint32, level byte) (effect.Model, error)
+	resolveSkill       func(id skill3.Id) (skill3.Identity, bool)
+	getMonster         func(monsterId uint32) (monster.Model, error)
+	loadEffectiveStats func() effective_stats.RestModel
+	changeHP           func(f field.Model, characterId uint32, amount int16) error
+	applyStatus        func(f field.Model, monsterId, characterId, skillId, skillLevel uint32, statuses map[string]int32, duration uint32) error
+	killMonster        func(f field.Model, monsterId uint32, characterId uint32) error
+	cancelBuff         func(f field.Model, characterId uint32, sourceId int32) error
+	applyCooldown      func(f field.Model, skillId skill3.Id, cooldown uint32, characterId uint32) error
+	consumeItem        func(f field.Model, characterId uint32, itemId uint32, slot int16, quantity int16) error
+	monsterDrops       func(templateId uint32) ([]information.Model, error)
+	spawnItem          func(f field.Model, itemId uint32, quantity uint32, x int16, y int16, ownerId uint32, dropperId uint32, dropperX int16, dropperY int16) error
+	// markStolen claims a monster for Bandit Steal; false means it was
+	// already stolen from.
+	markStolen func(monsterId uint32) bool
+	// weakenElement makes a monster temporarily weak to an element (Fire
+	// Demon / Ice Demon).
+	weakenElement func(f field.Model, monsterId uint32, characterId uint32, element string, duration time.Duration) error
+	// roll draws a uniform [0,1) proc roll.
+	roll func() float64
+}
+
+// castEffects and buffEffects are keyed on skill3.Identity for the same
+// version-blind reason as the skill/handler registries. A cast effect fires
+// when the attack's own skill resolves to the identity; a buff effect fires
+// on every attack made while a buff sourced from the identity is active.
+var (
+	castEffects = map[skill3.Identity]attackEffect{}
+	buffEffects = map[skill3.Identity]attackEffect{}
+	// fieldEffects fire on every attack and decide for themselves from the
+	// attack skill's effect data (cooldown, itemCon) or the caster's buffs.
+	fieldEffects []attackEffect
+)
+
+// registerCastEffect installs e for attacks made with any of ids. Called
+// from init() in the character_attack_effect_*.go files.
+func registerCastEffect(e attackEffect, ids ...skill3.Identity) {
+	for _, id := range ids {
+		castEffects[id] = e
+	}
+}
+
+// registerBuffEffect installs e for attacks made while a buff sourced from
+// any of ids is active.
+func registerBuffEffect(e attackEffect, ids ...skill3.Identity) {
+	for _, id := range ids {
+		buffEffects[id] = e
+	}
+}
+
+func registerFieldEffect(e attackEffect) {
+	fieldEffects = append(fieldEffects, e)
+}
+
+type boundAttackEffect struct {
+	effect attackEffect
+	ctx    attackEffectContext
+}
+
+// attackEffectSet is the resolved effect list of one attack, in firing
+// order: data-driven field effects, the cast effect, then buff effects.
+type attackEffectSet []boundAttackEffect
+
+// resolveAttackEffects binds every effect that applies to this attack.
+// base carries the attack's own skill (skillId 0 for a basic attack). Buff
+// effects read the caster's buffs through deps.getBuffs (the per-attack
+// cached loader) and the buff source's effect at the buff's level; a failed
+// lookup drops that one effect and never the attack.
+func resolveAttackEffects(base attackEffectContext, attackId skill3.Identity, attackIdOk bool) attackEffectSet {
+	var set attackEffectSet
+	for _, e := range fieldEffects {
+		set = append(set, boundAttackEffect{effect: e, ctx: base})
+	}
+	if attackIdOk && base.skillId > 0 {
+		if e, ok := castEffects[attackId]; ok {
+			set = append(set, boundAttackEffect{effect: e, ctx: base})
+		}
+	}
+	if len(buffEffects) == 0 || base.deps.getBuffs == nil {
+		return set
+	}
+	buffs, _ := base.deps.getBuffs(base.characterId)
+	for _, b := range buffs {
+		if b.Expired() {
+			continue
+		}
+		id, ok := base.deps.resolveSkill(skill3.Id(b.SourceId()))
+		if !ok {
+			continue
+		}
+		e, ok := buffEffects[id]
+		if !ok {
+			continue
+		}
+		be, err := base.deps.getEffect(uint32(b.SourceId()), b.Level())
+		if err != nil {
+			base.l.WithError(err).Warnf("Unable to load effect of buff [%d] level [%d] for character [%d] attack; skipping its attack effect.", b.SourceId(), b.Level(), base.characterId)
+			continue
+		}
+		ctx := base
+		ctx.skillId = uint32(b.SourceId())
+		ctx.skillLevel = b.Level()
+		ctx.e = be
+		set = append(set, boundAttackEffect{effect: e, ctx: ctx})
+	}
+	return set
+}
+
+// preDamage runs every preDamage hook and reports whether the attack may
+// proceed. The first rejection stops the chain.
+func (s attackEffectSet) preDamage() bool {
+	for _, b := range s {
+		if b.effect.preDamage != nil && !b.effect.preDamage(b.ctx) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s attackEffectSet) perTarget(di packetmodel.DamageInfo, totalDamage uint32) {
+	for _, b := range s {
+		if b.effect.perTarget != nil {
+			b.effect.perTarget(b.ctx, di, totalDamage)
+		}
+	}
+}
+
+func (s attackEffectSet) postAttack() {
+	for _, b := range s {
+		if b.effect.postAttack != nil {
+			b.effect.postAttack(b.ctx)
+		}
+	}
+}
+
+// statusProc applies statuses to one monster on a prop roll of the bound
+// skill's effect, for durationMs. Shared by the buff-driven debuffs.
+func statusProc(ac attackEffectContext, monsterId uint32, statuses map[string]int32, durationMs uint32) {
+	if !shouldProc(ac.e.Prop(), ac.deps.roll()) {
+		return
+	}
+	if err := ac.deps.applyStatus(ac.f, monsterId, ac.characterId, ac.skillId, uint32(ac.skillLevel), statuses, durationMs); err != nil {
+		ac.l.WithError(err).Errorf("Unable to apply attack effect [%d] statuses to monster [%d] for character [%d].", ac.skillId, monsterId, ac.characterId)
+	}
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+// Buff effects: debuffs an active buff lays on whatever the caster hits.
+// The bound effect is the buff's own (prop, x, y at the buff's level).
+// Boss and elemental immunity are enforced by atlas-monsters.
+func init() {
+	registerBuffEffect(attackEffect{perTarget: hamstringPerTarget}, skill3.BowmasterHamstring)
+	registerBuffEffect(attackEffect{perTarget: blindPerTarget}, skill3.MarksmanBlind)
+	registerBuffEffect(attackEffect{perTarget: iceChargePerTarget},
+		skill3.WhiteKnightIceChargeSword,
+		skill3.WhiteKnightBlizzardChargeBluntWeapon,
+	)
+	registerBuffEffect(attackEffect{perTarget: snowChargePerTarget}, skill3.AranStage3SnowCharge)
+}
+
+// hamstringPerTarget slows monsters hit by a ranged attack: SPEED x for
+// y seconds.
+func hamstringPerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if ac.ai.AttackType() != packetmodel.AttackTypeRanged {
+		return
+	}
+	statusProc(ac, di.MonsterId(), map[string]int32{monster2.StatusSpeed: int32(ac.e.X())}, uint32(ac.e.Y())*1000)
+}
+
+// blindPerTarget lowers the accuracy of monsters hit by a ranged attack:
+// ACC x for y seconds.
+func blindPerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if ac.ai.AttackType() != packetmodel.AttackTypeRanged {
+		return
+	}
+	statusProc(ac, di.MonsterId(), map[string]int32{monster2.StatusAccuracy: int32(ac.e.X())}, uint32(ac.e.Y())*1000)
+}
+
+// iceChargePerTarget freezes monsters hit by a charged melee attack for
+// y × 2 seconds. The charge carries no prop; every hit freezes.
+func iceChargePerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if ac.ai.AttackType() != packetmodel.AttackTypeMelee {
+		return
+	}
+	if err := ac.deps.applyStatus(ac.f, di.MonsterId(), ac.characterId, ac.skillId, uint32(ac.skillLevel), map[string]int32{monster2.StatusFreeze: 1}, uint32(ac.e.Y())*2000); err != nil {
+		ac.l.WithError(err).Errorf("Ice charge: FREEZE emit failed for monster [%d] caster [%d].", di.MonsterId(), ac.characterId)
+	}
+}
+
+// snowChargePerTarget slows monsters hit by a charged melee attack: SPEED
+// x for y seconds.
+func snowChargePerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if ac.ai.AttackType() != packetmodel.AttackTypeMelee {
+		return
+	}
+	if err := ac.deps.applyStatus(ac.f, di.MonsterId(), ac.characterId, ac.skillId, uint32(ac.skillLevel), map[string]int32{monster2.StatusSpeed: int32(ac.e.X())}, uint32(ac.e.Y())*1000); err != nil {
+		ac.l.WithError(err).Errorf("Snow Charge: SPEED emit failed for monster [%d] caster [%d].", di.MonsterId(), ac.characterId)
+	}
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
+	inventoryconst "github.com/Chronicle20/atlas/libs/atlas-constants/inventory"
+	itemconst "github.com/Chronicle20/atlas/libs/atlas-constants/item"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+)
+
+// Data-driven effects: these fire for every attack and key on the attack
+// skill's effect fields (cooltime, itemCon) or on the caster's buffs rather
+// than on a skill id.
+func init() {
+	registerFieldEffect(attackEffect{postAttack: cooldownPostAttack})
+	registerFieldEffect(attackEffect{preDamage: itemConsumePreDamage, postAttack: itemConsumePostAttack})
+	registerFieldEffect(attackEffect{postAttack: concealmentBreakPostAttack})
+}
+
+// cooldownPostAttack starts the attack skill's WZ cooltime. Skills with a
+// use-skill Handler are skipped: their cooldown is applied on the buff-side
+// packet, and applying it here too would double-fire. Battleship is exempt
+// for the same reason as on the cast path — its cooldown starts only when
+// the ship breaks.
+func cooldownPostAttack(ac attackEffectContext) {
+	if ac.skillId == 0 || ac.registeredUseSkill {
+		return
+	}
+	cooldown := ac.e.Cooldown()
+	if cooldown == 0 || skill3.IsBattleshipMountSkill(skill3.Id(ac.skillId)) {
+		return
+	}
+	if err := ac.deps.applyCooldown(ac.f, skill3.Id(ac.skillId), cooldown, ac.characterId); err != nil {
+		ac.l.WithError(err).Errorf("Unable to apply cooldown [%d] of skill [%d] for character [%d].", cooldown, ac.skillId, ac.characterId)
+	}
+}
+
+// itemConsumeSlot locates the single inventory slot holding the attack
+// skill's itemCon × itemConNo (Three Snails' shells). ok is false when the
+// skill consumes nothing; found is false when no slot holds enough.
+func itemConsumeSlot(ac attackEffectContext) (itemId uint32, slot int16, amount int16, ok bool, found bool) {
+	itemId = ac.e.ItemConsume()
+	if ac.skillId == 0 || itemId == 0 || ac.registeredUseSkill {
+		return 0, 0, 0, false, false
+	}
+	invType, typeOk := inventoryconst.TypeFromItemId(itemconst.Id(itemId))
+	if !typeOk {
+		return 0, 0, 0, false, false
+	}
+	amount = int16(ac.e.ItemConsumeAmount())
+	if amount < 1 {
+		// Absent itemConNo means one item, as on the cast path.
+		amount = 1
+	}
+	a, found := ac.c.Inventory().CompartmentByType(invType).FindFirstByItemIdWithQuantity(itemId, amount)
+	if !found {
+		return itemId, 0, amount, true, false
+	}
+	return itemId, a.Slot(), amount, true, true
+}
+
+// itemConsumePreDamage rejects an item-consuming attack the caster cannot
+// pay for. The client never sends one; a modified client that does gets no
+// damage rather than free shells.
+func itemConsumePreDamage(ac attackEffectContext) bool {
+	itemId, _, amount, ok, found := itemConsumeSlot(ac)
+	if !ok || found {
+		return true
+	}
+	ac.l.Warnf("Character [%d] attacked with skill [%d] requiring [%d]x item [%d] but no single slot holds enough; attack rejected.", ac.characterId, ac.skillId, amount, itemId)
+	return false
+}
+
+func itemConsumePostAttack(ac attackEffectContext) {
+	itemId, slot, amount, ok, found := itemConsumeSlot(ac)
+	if !ok || !found {
+		return
+	}
+	if err := ac.deps.consumeItem(ac.f, ac.characterId, itemId, slot, amount); err != nil {
+		ac.l.WithError(err).Errorf("Unable to consume [%d]x item [%d] for character [%d] skill [%d].", amount, itemId, ac.characterId, ac.skillId)
+	}
+}
+
+// concealmentBreakPostAttack ends Dark Sight and Wind Walk once the caster
+// actually hits something. SuperGM Hide also carries DARK_SIGHT but is not
+// broken by attacking, so buffs are matched on source, not stat alone.
+func concealmentBreakPostAttack(ac attackEffectContext) {
+	hit := false
+	for _, di := range ac.ai.DamageInfo() {
+		if len(di.Damages()) > 0 {
+			hit = true
+			break
+		}
+	}
+	if !hit {
+		return
+	}
+	buffs, _ := ac.deps.getBuffs(ac.characterId)
+	for _, b := range buffs {
+		if b.Expired() {
+			continue
+		}
+		if id, ok := ac.deps.resolveSkill(skill3.Id(b.SourceId())); ok && id == skill3.SuperGmHide {
+			continue
+		}
+		concealed := false
+		for _, ch := range b.Changes() {
+			if ch.Type() == string(charconst.TemporaryStatTypeDarkSight) || ch.Type() == string(charconst.TemporaryStatTypeWindWalk) {
+				concealed = true
+				break
+			}
+		}
+		if !concealed {
+			continue
+		}
+		if err := ac.deps.cancelBuff(ac.f, ac.characterId, b.SourceId()); err != nil {
+			ac.l.WithError(err).Errorf("Unable to cancel concealment buff [%d] for attacking character [%d].", b.SourceId(), ac.characterId)
+		}
+	}
+}
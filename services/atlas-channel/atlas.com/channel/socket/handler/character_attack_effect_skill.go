@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"time"
+
+	inventoryconst "github.com/Chronicle20/atlas/libs/atlas-constants/inventory"
+	itemconst "github.com/Chronicle20/atlas/libs/atlas-constants/item"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+// Cast effects: mechanics of one attack skill, keyed on its identity.
+func init() {
+	registerCastEffect(attackEffect{postAttack: sacrificePostAttack}, skill3.DragonKnightSacrifice)
+	registerCastEffect(attackEffect{perTarget: drainPerTarget},
+		skill3.AssassinDrain,
+		skill3.MarauderEnergyDrain,
+		skill3.ThunderBreakerStage3EnergyDrain,
+		skill3.NightWalkerStage2Vampire,
+	)
+	registerCastEffect(attackEffect{perTarget: heavensHammerPerTarget}, skill3.PaladinHeavensHammer)
+	registerCastEffect(attackEffect{perTarget: stealPerTarget}, skill3.BanditSteal)
+	registerCastEffect(attackEffect{perTarget: weakenElementPerTarget(attackElementIce)}, skill3.FirePoisonArchMagicianFireDemon)
+	registerCastEffect(attackEffect{perTarget: weakenElementPerTarget(attackElementFire)}, skill3.IceLightningArchMagicianIceDemon)
+}
+
+// weakenElementPerTarget makes every monster hit weak to element for x
+// seconds: Fire Demon leaves its targets weak to ice, Ice Demon to fire.
+// atlas-monsters ignores a monster already weak to the element.
+func weakenElementPerTarget(element string) func(ac attackEffectContext, di packetmodel.DamageInfo, totalDamage uint32) {
+	return func(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+		if ac.e.X() <= 0 {
+			return
+		}
+		duration := time.Duration(ac.e.X()) * time.Second
+		if err := ac.deps.weakenElement(ac.f, di.MonsterId(), ac.characterId, element, duration); err != nil {
+			ac.l.WithError(err).Errorf("Skill [%d]: WEAKEN_ELEMENT emit failed for monster [%d] caster [%d].", ac.skillId, di.MonsterId(), ac.characterId)
+		}
+	}
+}
+
+// sacrificePostAttack charges Dragon Knight Sacrifice's self-HP cost:
+// firstDamageLine × x / 100, never killing the caster. It is separate from
+// the generic HPConsume cast cost, which still applies.
+func sacrificePostAttack(ac attackEffectContext) {
+	firstLine := sacrificeFirstDamageLine(ac.ai)
+	cost := sacrificeHpCost(firstLine, ac.e.X(), ac.c.Hp())
+	if cost == 0 {
+		return
+	}
+	ac.l.Debugf("Sacrifice self-HP cost: caster=[%d] skill=[%d] firstLine=[%d] x=[%d] cost=[%d].",
+		ac.characterId, ac.skillId, firstLine, ac.e.X(), cost)
+	if err := ac.deps.changeHP(ac.f, ac.characterId, -int16(cost)); err != nil {
+		ac.l.WithError(err).Errorf("Sacrifice: CHANGE_HP emit failed for caster [%d] skill [%d].", ac.characterId, ac.skillId)
+	}
+}
+
+// drainPerTarget heals the caster for a share of the damage dealt to each
+// monster (Drain, Energy Drain, Vampire).
+func drainPerTarget(ac attackEffectContext, di packetmodel.DamageInfo, totalDamage uint32) {
+	drainTryHeal(ac.l, ac.deps.getMonster, ac.deps.changeHP, ac.deps.loadEffectiveStats, ac.e.X(), ac.skillId, di.MonsterId(), totalDamage, ac.f, ac.characterId)
+}
+
+// heavensHammerPerTarget kills every monster the hammer lands on. Bosses
+// keep the damage line the client reported: atlas-monsters refuses a KILL
+// on a boss (fail-closed), exactly as for Mortal Blow.
+func heavensHammerPerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if err := ac.deps.killMonster(ac.f, di.MonsterId(), ac.characterId); err != nil {
+		ac.l.WithError(err).Errorf("Heaven's Hammer: KILL emit failed for monster [%d] caster [%d].", di.MonsterId(), ac.characterId)
+	}
+}
+
+// stealPerTarget rolls Bandit Steal's prop against each monster hit and,
+// on success, drops one item from the monster's drop table at its feet,
+// owned by the thief. A monster yields at most one stolen item over its
+// life. Quest drops and equipment are never stolen: the former are gated
+// on quest state, the latter would need the stat roll only the kill path
+// performs.
+func stealPerTarget(ac attackEffectContext, di packetmodel.DamageInfo, _ uint32) {
+	if !shouldProc(ac.e.Prop(), ac.deps.roll()) {
+		return
+	}
+	mon, err := ac.deps.getMonster(di.MonsterId())
+	if err != nil {
+		ac.l.WithError(err).Debugf("Steal: monster [%d] snapshot fetch failed.", di.MonsterId())
+		return
+	}
+	drops, err := ac.deps.monsterDrops(mon.MonsterId())
+	if err != nil {
+		ac.l.WithError(err).Debugf("Steal: drop table of monster template [%d] unavailable.", mon.MonsterId())
+		return
+	}
+	var pool []uint32
+	for _, d := range drops {
+		if d.ItemId() == 0 || d.QuestId() != 0 {
+			continue
+		}
+		if t, ok := inventoryconst.TypeFromItemId(itemconst.Id(d.ItemId())); !ok || t == inventoryconst.TypeValueEquip {
+			continue
+		}
+		pool = append(pool, d.ItemId())
+	}
+	if len(pool) == 0 {
+		return
+	}
+	if !ac.deps.markStolen(di.MonsterId()) {
+		return
+	}
+	// The pick draws from the same roll seam as the proc, so tests can pin it.
+	pick := int(ac.deps.roll() * float64(len(pool)))
+	if pick >= len(pool) {
+		pick = len(pool) - 1
+	}
+	itemId := pool[pick]
+	ac.l.Debugf("Steal: caster=[%d] monster=[%d] item=[%d].", ac.characterId, di.MonsterId(), itemId)
+	if err := ac.deps.spawnItem(ac.f, itemId, 1, mon.X(), mon.Y(), ac.characterId, di.MonsterId(), mon.X(), mon.Y()); err != nil {
+		ac.l.WithError(err).Errorf("Steal: SPAWN emit failed for item [%d] from monster [%d] caster [%d].", itemId, di.MonsterId(), ac.characterId)
+	}
+}
@@ -0,0 +1,341 @@
+package handler
+
+import (
+	"atlas-channel/character/buff"
+	"atlas-channel/character/buff/stat"
+	"atlas-channel/data/skill/effect"
+	"atlas-channel/drop/information"
+	"atlas-channel/monster"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+
+	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+	skill3 "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+	packetmodel "github.com/Chronicle20/atlas/libs/atlas-packet/model"
+)
+
+type attackEffectEmissions struct {
+	cooldowns []uint32
+	cancels   []int32
+	statuses  []map[string]int32
+	durations []uint32
+	kills     []uint32
+	spawned   []uint32
+	hp        []int16
+	weakened  []string
+}
+
+func buffWith(sourceId int32, level byte, statType charconst.TemporaryStatType) buff.Model {
+	return buff.NewBuff(sourceId, level, 3600, []stat.Model{stat.NewStat(string(statType), 1)}, time.Now(), time.Now().Add(time.Hour), false)
+}
+
+func attackEffectTestContext(t *testing.T, ai packetmodel.AttackInfo, e effect.Model, buffs []buff.Model, buffEffect effect.Model, em *attackEffectEmissions) attackEffectContext {
+	t.Helper()
+	l, _ := test.NewNullLogger()
+	mob, err := monster.NewModelBuilder(42, damageTestField(), 100100).SetHp(500).SetMaxHp(1000).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return attackEffectContext{
+		l:           l,
+		f:           damageTestField(),
+		characterId: 7,
+		c:           testCharacter(t, 0, 100, 0, nil),
+		ai:          ai,
+		skillId:     ai.SkillId(),
+		skillLevel:  10,
+		e:           e,
+		deps: attackEffectDeps{
+			getBuffs:     func(uint32) ([]buff.Model, error) { return buffs, nil },
+			getEffect:    func(uint32, byte) (effect.Model, error) { return buffEffect, nil },
+			resolveSkill: func(id skill3.Id) (skill3.Identity, bool) { return skill3.Identity(id), true },
+			getMonster:   func(uint32) (monster.Model, error) { return mob, nil },
+			changeHP: func(_ field.Model, _ uint32, amount int16) error {
+				em.hp = append(em.hp, amount)
+				return nil
+			},
+			applyStatus: func(_ field.Model, _ uint32, _ uint32, _ uint32, _ uint32, statuses map[string]int32, duration uint32) error {
+				em.statuses = append(em.statuses, statuses)
+				em.durations = append(em.durations, duration)
+				return nil
+			},
+			killMonster: func(_ field.Model, monsterId uint32, _ uint32) error {
+				em.kills = append(em.kills, monsterId)
+				return nil
+			},
+			cancelBuff: func(_ field.Model, _ uint32, sourceId int32) error {
+				em.cancels = append(em.cancels, sourceId)
+				return nil
+			},
+			applyCooldown: func(_ field.Model, _ skill3.Id, cooldown uint32, _ uint32) error {
+				em.cooldowns = append(em.cooldowns, cooldown)
+				return nil
+			},
+			consumeItem: func(field.Model, uint32, uint32, int16, int16) error { return nil },
+			monsterDrops: func(uint32) ([]information.Model, error) {
+				return nil, errors.New("no drop table")
+			},
+			spawnItem: func(_ field.Model, itemId uint32, _ uint32, _ int16, _ int16, _ uint32, _ uint32, _ int16, _ int16) error {
+				em.spawned = append(em.spawned, itemId)
+				return nil
+			},
+			markStolen: func(uint32) bool { return true },
+			weakenElement: func(_ field.Model, _ uint32, _ uint32, element string, duration time.Duration) error {
+				em.weakened = append(em.weakened, element)
+				em.durations = append(em.durations, uint32(duration.Milliseconds()))
+				return nil
+			},
+			roll: func() float64 { return 0 },
+		},
+	}
+}
+
+func hitAttack(attackType packetmodel.AttackType, skillId uint32, damages ...uint32) packetmodel.AttackInfo {
+	return *packetmodel.NewAttackInfo(attackType).
+		SetSkillId(skillId).
+		AddDamageInfo(*packetmodel.NewDamageInfo(byte(len(damages))).SetMonsterId(42).SetDamages(damages))
+}
+
+func mustEffect(t *testing.T, rm effect.RestModel) effect.Model {
+	t.Helper()
+	e, err := effect.Extract(rm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestResolveAttackEffectsBindsCastAndBuffEffects(t *testing.T) {
+	em := &attackEffectEmissions{}
+	hamstring := mustEffect(t, effect.RestModel{Prop: 1, X: 40, Y: 5})
+	buffs := []buff.Model{
+		buffWith(int32(skill3.BowmasterHamstringId), 20, charconst.TemporaryStatTypeHamstring),
+		buffWith(2001002, 20, charconst.TemporaryStatTypeMagicGuard),
+	}
+	ai := hitAttack(packetmodel.AttackTypeRanged, uint32(skill3.PaladinHeavensHammerId), 100)
+	set := resolveAttackEffects(attackEffectTestContext(t, ai, effect.Model{}, buffs, hamstring, em), skill3.PaladinHeavensHammer, true)
+
+	if len(set) != len(fieldEffects)+2 {
+		t.Fatalf("bound %d effects, want field effects + Heaven's Hammer + Hamstring", len(set))
+	}
+	last := set[len(set)-1].ctx
+	if last.skillId != uint32(skill3.BowmasterHamstringId) || last.skillLevel != 20 || last.e.X() != 40 {
+		t.Fatalf("buff effect bound to skill [%d] level [%d] x [%d]; want the Hamstring buff's own effect", last.skillId, last.skillLevel, last.e.X())
+	}
+}
+
+func TestAttackEffectPreDamageRejectionStopsChain(t *testing.T) {
+	calls := 0
+	set := attackEffectSet{
+		{effect: attackEffect{preDamage: func(attackEffectContext) bool { calls++; return false }}},
+		{effect: attackEffect{preDamage: func(attackEffectContext) bool { calls++; return true }}},
+	}
+	if set.preDamage() {
+		t.Fatal("a rejecting hook must reject the attack")
+	}
+	if calls != 1 {
+		t.Fatalf("calls=%d, want the chain to stop at the first rejection", calls)
+	}
+}
+
+func TestCooldownPostAttack(t *testing.T) {
+	e := mustEffect(t, effect.RestModel{Cooldown: 30})
+
+	em := &attackEffectEmissions{}
+	cooldownPostAttack(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 1121008, 10), e, nil, effect.Model{}, em))
+	if len(em.cooldowns) != 1 || em.cooldowns[0] != 30 {
+		t.Fatalf("cooldowns=%v, want [30]", em.cooldowns)
+	}
+
+	em = &attackEffectEmissions{}
+	ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 1121008, 10), e, nil, effect.Model{}, em)
+	ac.registeredUseSkill = true
+	cooldownPostAttack(ac)
+	if len(em.cooldowns) != 0 {
+		t.Fatalf("cooldowns=%v; a use-skill Handler owns the cooldown", em.cooldowns)
+	}
+}
+
+func TestItemConsumePreDamageRejectsWithoutItem(t *testing.T) {
+	em := &attackEffectEmissions{}
+	shells := mustEffect(t, effect.RestModel{ItemConsume: 4000019, ItemConsumeAmount: 1})
+	ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeRanged, uint32(skill3.BeginnerThreeSnailsId), 10), shells, nil, effect.Model{}, em)
+	if itemConsumePreDamage(ac) {
+		t.Fatal("Three Snails without shells must be rejected")
+	}
+	ac.e = effect.Model{}
+	if !itemConsumePreDamage(ac) {
+		t.Fatal("a skill without itemCon must pass")
+	}
+}
+
+func TestConcealmentBreakPostAttack(t *testing.T) {
+	buffs := []buff.Model{
+		buffWith(int32(skill3.RogueDarkSightId), 20, charconst.TemporaryStatTypeDarkSight),
+		buffWith(int32(skill3.SuperGmHideId), 1, charconst.TemporaryStatTypeDarkSight),
+		buffWith(int32(skill3.WindArcherStage2WindWalkId), 20, charconst.TemporaryStatTypeWindWalk),
+	}
+
+	em := &attackEffectEmissions{}
+	concealmentBreakPostAttack(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 0, 10), effect.Model{}, buffs, effect.Model{}, em))
+	if len(em.cancels) != 2 || em.cancels[0] != int32(skill3.RogueDarkSightId) || em.cancels[1] != int32(skill3.WindArcherStage2WindWalkId) {
+		t.Fatalf("cancels=%v, want Dark Sight and Wind Walk but never GM Hide", em.cancels)
+	}
+
+	em = &attackEffectEmissions{}
+	concealmentBreakPostAttack(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 0), effect.Model{}, buffs, effect.Model{}, em))
+	if len(em.cancels) != 0 {
+		t.Fatalf("cancels=%v; a whiff does not break concealment", em.cancels)
+	}
+}
+
+func TestHamstringAndBlindRangedOnly(t *testing.T) {
+	hamstring := mustEffect(t, effect.RestModel{Prop: 0.5, X: 40, Y: 5})
+	di := *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10})
+
+	em := &attackEffectEmissions{}
+	ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeRanged, 0, 10), hamstring, nil, effect.Model{}, em)
+	hamstringPerTarget(ac, di, 10)
+	blindPerTarget(ac, di, 10)
+	if len(em.statuses) != 2 || em.statuses[0][monster2.StatusSpeed] != 40 || em.statuses[1][monster2.StatusAccuracy] != 40 || em.durations[0] != 5000 {
+		t.Fatalf("statuses=%v durations=%v, want SPEED 40 and ACC 40 for 5s", em.statuses, em.durations)
+	}
+
+	em = &attackEffectEmissions{}
+	ac = attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeRanged, 0, 10), hamstring, nil, effect.Model{}, em)
+	ac.deps.roll = func() float64 { return 0.9 }
+	hamstringPerTarget(ac, di, 10)
+	if len(em.statuses) != 0 {
+		t.Fatal("a roll above prop must not slow")
+	}
+
+	ac = attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 0, 10), hamstring, nil, effect.Model{}, em)
+	hamstringPerTarget(ac, di, 10)
+	if len(em.statuses) != 0 {
+		t.Fatal("Hamstring applies to ranged attacks only")
+	}
+}
+
+func TestIceChargeFreezesOnMelee(t *testing.T) {
+	em := &attackEffectEmissions{}
+	charge := mustEffect(t, effect.RestModel{Y: 3})
+	di := *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10})
+	iceChargePerTarget(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, 0, 10), charge, nil, effect.Model{}, em), di, 10)
+	if len(em.statuses) != 1 || em.statuses[0][monster2.StatusFreeze] != 1 || em.durations[0] != 6000 {
+		t.Fatalf("statuses=%v durations=%v, want FREEZE for y*2s", em.statuses, em.durations)
+	}
+}
+
+func TestStealDropsOneNonQuestNonEquipItemOnce(t *testing.T) {
+	em := &attackEffectEmissions{}
+	steal := mustEffect(t, effect.RestModel{Prop: 1})
+	ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, uint32(skill3.BanditStealId), 10), steal, nil, effect.Model{}, em)
+	drops := func(rms ...information.RestModel) []information.Model {
+		var out []information.Model
+		for _, rm := range rms {
+			m, _ := information.Extract(rm)
+			out = append(out, m)
+		}
+		return out
+	}
+	ac.deps.monsterDrops = func(uint32) ([]information.Model, error) {
+		return drops(
+			information.RestModel{ItemId: 1302000},              // equip
+			information.RestModel{ItemId: 4031013, QuestId: 1},  // quest
+			information.RestModel{ItemId: 2000000, Chance: 100}, // stealable
+		), nil
+	}
+	claimed := false
+	ac.deps.markStolen = func(uint32) bool {
+		if claimed {
+			return false
+		}
+		claimed = true
+		return true
+	}
+	di := *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10})
+
+	stealPerTarget(ac, di, 10)
+	stealPerTarget(ac, di, 10)
+	if len(em.spawned) != 1 || em.spawned[0] != 2000000 {
+		t.Fatalf("spawned=%v, want exactly one steal of 2000000", em.spawned)
+	}
+}
+
+// TestStealPicksItemFromRoll pins which stealable item is taken: the second
+// roll (after the proc roll) indexes the eligible pool.
+func TestStealPicksItemFromRoll(t *testing.T) {
+	cases := []struct {
+		pick float64
+		want uint32
+	}{
+		{0, 2000000},
+		{0.4, 2000001},
+		{0.99, 2000002},
+	}
+	for _, c := range cases {
+		em := &attackEffectEmissions{}
+		steal := mustEffect(t, effect.RestModel{Prop: 1})
+		ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, uint32(skill3.BanditStealId), 10), steal, nil, effect.Model{}, em)
+		ac.deps.monsterDrops = func(uint32) ([]information.Model, error) {
+			var out []information.Model
+			for _, id := range []uint32{2000000, 1302000, 2000001, 2000002} {
+				m, _ := information.Extract(information.RestModel{ItemId: id, Chance: 100})
+				out = append(out, m)
+			}
+			return out, nil
+		}
+		rolls := []float64{0, c.pick}
+		ac.deps.roll = func() float64 {
+			r := rolls[0]
+			rolls = rolls[1:]
+			return r
+		}
+
+		stealPerTarget(ac, *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10}), 10)
+		if len(em.spawned) != 1 || em.spawned[0] != c.want {
+			t.Errorf("pick roll %.2f: spawned=%v, want [%d]", c.pick, em.spawned, c.want)
+		}
+	}
+}
+
+func TestHeavensHammerKillsEachTarget(t *testing.T) {
+	em := &attackEffectEmissions{}
+	ac := attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMagic, uint32(skill3.PaladinHeavensHammerId), 10), effect.Model{}, nil, effect.Model{}, em)
+	heavensHammerPerTarget(ac, *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10}), 10)
+	if len(em.kills) != 1 || em.kills[0] != 42 {
+		t.Fatalf("kills=%v, want [42]", em.kills)
+	}
+}
+
+func TestFireAndIceDemonWeakenTheOppositeElement(t *testing.T) {
+	em := &attackEffectEmissions{}
+	demon := mustEffect(t, effect.RestModel{X: 30})
+	di := *packetmodel.NewDamageInfo(1).SetMonsterId(42).SetDamages([]uint32{10})
+
+	fire := castEffects[skill3.FirePoisonArchMagicianFireDemon]
+	fire.perTarget(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMagic, uint32(skill3.FirePoisonArchMagicianFireDemonId), 10), demon, nil, effect.Model{}, em), di, 10)
+	ice := castEffects[skill3.IceLightningArchMagicianIceDemon]
+	ice.perTarget(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMagic, uint32(skill3.IceLightningArchMagicianIceDemonId), 10), demon, nil, effect.Model{}, em), di, 10)
+
+	if len(em.weakened) != 2 || em.weakened[0] != attackElementIce || em.weakened[1] != attackElementFire {
+		t.Fatalf("weakened=%v, want [ICE FIRE]", em.weakened)
+	}
+	if em.durations[0] != 30000 || em.durations[1] != 30000 {
+		t.Fatalf("durations=%v, want x seconds", em.durations)
+	}
+}
+
+func TestSacrificePostAttackChargesHp(t *testing.T) {
+	em := &attackEffectEmissions{}
+	e := mustEffect(t, effect.RestModel{X: 20})
+	sacrificePostAttack(attackEffectTestContext(t, hitAttack(packetmodel.AttackTypeMelee, uint32(skill3.DragonKnightSacrificeId), 1000), e, nil, effect.Model{}, em))
+	if len(em.hp) != 1 || em.hp[0] != -200 {
+		t.Fatalf("hp=%v, want [-200]", em.hp)
+	}
+}
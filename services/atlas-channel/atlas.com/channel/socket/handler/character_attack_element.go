@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"atlas-channel/character/buff"
+	skill2 "atlas-channel/data/skill"
+
+	"github.com/sirupsen/logrus"
+
+	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
+)
+
+// Attack elements, in the spelling atlas-data emits for skill elements and
+// monster resistances ("LIGHTING" is its spelling).
+const (
+	attackElementFire      = "FIRE"
+	attackElementIce       = "ICE"
+	attackElementLightning = "LIGHTING"
+	attackElementPoison    = "POISON"
+	attackElementHoly      = "HOLY"
+)
+
+// resolveAttackElement returns the element of an attack skill and the
+// caster's Elemental Reset percentage, both carried on the monster DAMAGE
+// command. Basic attacks and physical skills have no element; the reset is
+// only looked up for elemental attacks. Lookup failures degrade to a
+// non-elemental attack — atlas-monsters then leaves the lines as the client
+// reported them.
+func resolveAttackElement(
+	l logrus.FieldLogger,
+	getSkill func(skillId uint32) (skill2.Model, error),
+	getBuffs func(characterId uint32) ([]buff.Model, error),
+	characterId uint32,
+	skillId uint32,
+) (string, uint32) {
+	if skillId == 0 {
+		return "", 0
+	}
+	s, err := getSkill(skillId)
+	if err != nil {
+		l.WithError(err).Debugf("Unable to resolve element of skill [%d]; treating attack as physical.", skillId)
+		return "", 0
+	}
+	switch s.Element() {
+	case attackElementFire, attackElementIce, attackElementLightning, attackElementPoison, attackElementHoly:
+	default:
+		return "", 0
+	}
+	buffs, err := getBuffs(characterId)
+	if err != nil {
+		return s.Element(), 0
+	}
+	reset, ok := buffStatAmount(buffs, charconst.TemporaryStatTypeElementalReset)
+	if !ok || reset <= 0 {
+		return s.Element(), 0
+	}
+	return s.Element(), uint32(reset)
+}
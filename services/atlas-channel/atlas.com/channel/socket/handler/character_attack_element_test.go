@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"atlas-channel/character/buff"
+	"atlas-channel/character/buff/stat"
+	skill2 "atlas-channel/data/skill"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+
+	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
+)
+
+func TestResolveAttackElement(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	skillWith := func(element string) func(uint32) (skill2.Model, error) {
+		return func(uint32) (skill2.Model, error) { return skill2.Extract(skill2.RestModel{Element: element}) }
+	}
+	reset := []buff.Model{buff.NewBuff(2121000, 10, 3600, []stat.Model{stat.NewStat(string(charconst.TemporaryStatTypeElementalReset), 40)}, time.Now(), time.Now().Add(time.Hour), false)}
+	buffs := func(bs []buff.Model) func(uint32) ([]buff.Model, error) {
+		return func(uint32) ([]buff.Model, error) { return bs, nil }
+	}
+
+	if e, r := resolveAttackElement(l, skillWith(attackElementFire), buffs(reset), 7, 0); e != "" || r != 0 {
+		t.Fatalf("basic attack = %q/%d, want no element", e, r)
+	}
+	if e, r := resolveAttackElement(l, skillWith("NEUTRAL"), buffs(reset), 7, 1001004); e != "" || r != 0 {
+		t.Fatalf("physical skill = %q/%d, want no element", e, r)
+	}
+	if e, r := resolveAttackElement(l, skillWith(attackElementFire), buffs(reset), 7, 2121003); e != attackElementFire || r != 40 {
+		t.Fatalf("fire skill with Elemental Reset = %q/%d, want FIRE/40", e, r)
+	}
+	if e, r := resolveAttackElement(l, skillWith(attackElementIce), buffs(nil), 7, 2221003); e != attackElementIce || r != 0 {
+		t.Fatalf("ice skill = %q/%d, want ICE/0", e, r)
+	}
+	failing := func(uint32) (skill2.Model, error) { return skill2.Model{}, errors.New("no data") }
+	if e, _ := resolveAttackElement(l, failing, buffs(reset), 7, 2121003); e != "" {
+		t.Fatalf("lookup failure = %q, want physical", e)
+	}
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"atlas-channel/alliance"
+	"atlas-channel/guild"
 	"atlas-channel/message"
 	"atlas-channel/session"
 	"atlas-channel/socket/writer"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	chat "github.com/Chronicle20/atlas/libs/atlas-packet/chat/serverbound"
 	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
 )
@@ -31,7 +34,19 @@ func CharacterChatMultiHandleFunc(l logrus.FieldLogger, ctx context.Context, _ w
 			return
 		}
 		if p.ChatType() == 3 {
-			_ = mp.AllianceChat(s.Field(), s.CharacterId(), p.ChatText(), p.Recipients())
+			// Alliance recipients span several guilds, so they are resolved
+			// here rather than trusted from the client.
+			g, err := guild.NewProcessor(l, ctx).GetByMemberId(s.CharacterId())
+			if err != nil || g.AllianceId() == 0 {
+				l.Debugf("Character [%d] attempted alliance chat outside of an alliance.", s.CharacterId())
+				return
+			}
+			recipients, err := alliance.NewProcessor(l, ctx).GetMemberIds(g.AllianceId(), model.Filters(guild.MemberOnline, guild.NotMember(s.CharacterId())))()
+			if err != nil {
+				l.WithError(err).Errorf("Unable to resolve alliance [%d] chat recipients.", g.AllianceId())
+				return
+			}
+			_ = mp.AllianceChat(s.Field(), s.CharacterId(), p.ChatText(), recipients)
 			return
 		}
 	}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"atlas-channel/character"
+	"atlas-channel/configuration"
 	"atlas-channel/maps/location"
 	"atlas-channel/message"
+	"atlas-channel/note"
 	"atlas-channel/session"
 	"atlas-channel/socket/writer"
 	"context"
@@ -18,6 +20,7 @@ import (
 	chat "github.com/Chronicle20/atlas/libs/atlas-packet/chat/serverbound"
 	fieldcb "github.com/Chronicle20/atlas/libs/atlas-packet/field/clientbound"
 	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
 
 func CharacterChatWhisperHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
@@ -53,6 +56,25 @@ var produceWhisperChatFunc = func(l logrus.FieldLogger, ctx context.Context, f f
 	return message.NewProcessor(l, ctx).WhisperChat(f, actorId, msg, recipientName)
 }
 
+// offlineWhisperNotesFunc reports whether the tenant stores whispers to
+// unreachable targets as notes. A tenant without configuration keeps the
+// legacy behaviour of reporting the whisper undeliverable.
+var offlineWhisperNotesFunc = func(l logrus.FieldLogger, ctx context.Context) bool {
+	t := tenant.MustFromContext(ctx)
+	tc, err := configuration.GetTenantConfig(t.Id())
+	if err != nil {
+		l.WithError(err).Debugf("Unable to read tenant configuration, offline whisper notes disabled.")
+		return false
+	}
+	return tc.Messaging.OfflineWhisperNotes
+}
+
+// storeWhisperNoteFunc is the seam over the atlas-notes CREATE command used
+// when a whisper falls back to a note.
+var storeWhisperNoteFunc = func(l logrus.FieldLogger, ctx context.Context, f fieldconst.Model, actorId uint32, recipientId uint32, msg string) error {
+	return note.NewProcessor(l, ctx).Create(f.Channel(), recipientId, actorId, msg)
+}
+
 var findLocalSessionFunc = func(l logrus.FieldLogger, ctx context.Context, ch channel.Model, characterId uint32) (session.Model, error) {
 	return session.NewProcessor(l, ctx).GetByCharacterId(ch)(characterId)
 }
@@ -166,11 +188,14 @@ func findDecision(l logrus.FieldLogger, ctx context.Context, s session.Model, ta
 }
 
 // whisperOutcome is the result of whisperDecision: whether the target can
-// receive a chat whisper right now, the rule name that matched (for logs),
-// and — only for the infrastructure-error, fail-open branch — the underlying
-// error.
+// receive a chat whisper right now, whether an undeliverable whisper may
+// instead be stored as a note for the resolved recipientId, the rule name
+// that matched (for logs), and — only for the infrastructure-error,
+// fail-open branch — the underlying error.
 type whisperOutcome struct {
 	deliverable bool
+	storable    bool
+	recipientId uint32
 	branch      string
 	err         error
 }
@@ -186,22 +211,28 @@ func whisperDecision(l logrus.FieldLogger, ctx context.Context, targetName strin
 		return whisperOutcome{deliverable: false, branch: "unresolved"}
 	}
 
+	// The chat event itself is world-scoped, so a logged-in target on any
+	// channel is reached by whichever channel handler holds its session. That
+	// includes the cash shop and MTS: the session stays registered with its
+	// channel across the cash-scene transition, and IN_CASH_SHOP covers both.
+	// Targets that exist but are not logged in are storable: the tenant may
+	// opt into keeping the whisper as a note.
 	loc, err := findCharacterLocationFunc(l, ctx, tc.Id())
 	if err != nil {
 		if errors.Is(err, location.ErrNotFound) {
-			return whisperOutcome{deliverable: false, branch: "never-logged-in"}
+			return whisperOutcome{deliverable: false, storable: true, recipientId: tc.Id(), branch: "never-logged-in"}
 		}
 		// Infrastructure failure: fail open.
-		return whisperOutcome{deliverable: true, branch: "lookup-failed", err: err}
+		return whisperOutcome{deliverable: true, recipientId: tc.Id(), branch: "lookup-failed", err: err}
 	}
 
 	switch loc.State() {
 	case characterconst.PresenceStateInField:
-		return whisperOutcome{deliverable: true, branch: "in-field"}
+		return whisperOutcome{deliverable: true, recipientId: tc.Id(), branch: "in-field"}
 	case characterconst.PresenceStateInCashShop:
-		return whisperOutcome{deliverable: false, branch: "cash-shop"}
+		return whisperOutcome{deliverable: true, recipientId: tc.Id(), branch: "cash-shop"}
 	default:
-		return whisperOutcome{deliverable: false, branch: "offline"}
+		return whisperOutcome{deliverable: false, storable: true, recipientId: tc.Id(), branch: "offline"}
 	}
 }
 
@@ -210,7 +241,9 @@ func whisperDecision(l logrus.FieldLogger, ctx context.Context, targetName strin
 // announces WhisperSendResult(false) without producing the chat command, or
 // produces the chat command (its own success:true round-trips back through
 // the Kafka consumer's handleWhisperChat, not from here) and announces
-// WhisperSendResult(false) only if production itself fails.
+// WhisperSendResult(false) only if production itself fails. An undeliverable
+// but storable whisper is kept as a note when the tenant enables offline
+// whisper notes, and answers WhisperSendResult(true).
 func produceWhisperChatResult(l logrus.FieldLogger) func(ctx context.Context) func(wp writer.Producer) func(msg string, targetName string) model.Operator[session.Model] {
 	return func(ctx context.Context) func(wp writer.Producer) func(msg string, targetName string) model.Operator[session.Model] {
 		return func(wp writer.Producer) func(msg string, targetName string) model.Operator[session.Model] {
@@ -235,6 +268,14 @@ func produceWhisperChatResult(l logrus.FieldLogger) func(ctx context.Context) fu
 					announceFailure := session.Announce(l)(ctx)(wp)(fieldcb.WhisperWriter)(fieldcb.NewWhisperSendResult(0x0A, targetName, false).Encode)
 
 					if !o.deliverable {
+						if o.storable && offlineWhisperNotesFunc(l, ctx) {
+							if err := storeWhisperNoteFunc(l, ctx, s.Field(), s.CharacterId(), o.recipientId, msg); err != nil {
+								entry.WithError(err).Error("whisper note could not be stored")
+								return announceFailure(s)
+							}
+							entry.Debug("whisper stored as note")
+							return session.Announce(l)(ctx)(wp)(fieldcb.WhisperWriter)(fieldcb.NewWhisperSendResult(0x0A, targetName, true).Encode)(s)
+						}
 						return announceFailure(s)
 					}
 
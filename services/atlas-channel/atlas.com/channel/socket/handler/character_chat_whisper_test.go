@@ -56,8 +56,12 @@ type findEnv struct {
 	loc       location.Model
 	locErr    error
 	locCalls  int
-	tenantId  uuid.UUID
-	sessionId uuid.UUID
+	// offline whisper notes
+	notesEnabled bool
+	storedFor    []uint32
+	storeErr     error
+	tenantId     uuid.UUID
+	sessionId    uuid.UUID
 }
 
 func newFindEnv(t *testing.T) *findEnv {
@@ -127,6 +131,19 @@ func newFindEnv(t *testing.T) *findEnv {
 	}
 	t.Cleanup(func() { findCharacterLocationFunc = origLoc })
 
+	origNotes := offlineWhisperNotesFunc
+	offlineWhisperNotesFunc = func(_ logrus.FieldLogger, _ context.Context) bool {
+		return env.notesEnabled
+	}
+	t.Cleanup(func() { offlineWhisperNotesFunc = origNotes })
+
+	origStore := storeWhisperNoteFunc
+	storeWhisperNoteFunc = func(_ logrus.FieldLogger, _ context.Context, _ field.Model, _ uint32, recipientId uint32, _ string) error {
+		env.storedFor = append(env.storedFor, recipientId)
+		return env.storeErr
+	}
+	t.Cleanup(func() { storeWhisperNoteFunc = origStore })
+
 	return env
 }
 
@@ -553,8 +570,8 @@ func TestWhisperChat_Decision(t *testing.T) {
 				e.locErr = nil
 			},
 			wantBranch:    "cash-shop",
-			wantAnnounced: true,
-			wantProduced:  false,
+			wantAnnounced: false,
+			wantProduced:  true,
 		},
 		{
 			name: "in field",
@@ -642,6 +659,92 @@ func TestWhisperChat_ProduceFailure(t *testing.T) {
 	}
 }
 
+// TestWhisperChat_OfflineNote covers the tenant opt-in that keeps a whisper
+// to an unreachable target as a note: stored targets answer
+// WhisperSendResult(true) without producing the chat command, and anything
+// else keeps the WhisperSendResult(false) answer.
+func TestWhisperChat_OfflineNote(t *testing.T) {
+	cases := []struct {
+		name        string
+		setup       func(*findEnv)
+		wantStored  bool
+		wantSuccess bool
+	}{
+		{
+			name: "offline target stored",
+			setup: func(e *findEnv) {
+				e.loc, e.locErr = locationFixture(characterconst.PresenceStateOffline, findRemoteChannel), nil
+			},
+			wantStored:  true,
+			wantSuccess: true,
+		},
+		{
+			name:        "never logged in target stored",
+			setup:       func(e *findEnv) { e.locErr = location.ErrNotFound },
+			wantStored:  true,
+			wantSuccess: true,
+		},
+		{
+			name:        "unresolvable name not stored",
+			setup:       func(e *findEnv) { e.targetErr = errors.New("no such character") },
+			wantStored:  false,
+			wantSuccess: false,
+		},
+		{
+			name: "store failure answers failure",
+			setup: func(e *findEnv) {
+				e.loc, e.locErr = locationFixture(characterconst.PresenceStateOffline, findRemoteChannel), nil
+				e.storeErr = errors.New("kafka unavailable")
+			},
+			wantStored:  true,
+			wantSuccess: false,
+		},
+		{
+			name: "disabled tenant not stored",
+			setup: func(e *findEnv) {
+				e.loc, e.locErr = locationFixture(characterconst.PresenceStateOffline, findRemoteChannel), nil
+				e.notesEnabled = false
+			},
+			wantStored:  false,
+			wantSuccess: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newFindEnv(t)
+			env.notesEnabled = true
+			c.setup(env)
+
+			var produced bool
+			origProduce := produceWhisperChatFunc
+			produceWhisperChatFunc = func(_ logrus.FieldLogger, _ context.Context, _ field.Model, _ uint32, _ string, _ string) error {
+				produced = true
+				return nil
+			}
+			t.Cleanup(func() { produceWhisperChatFunc = origProduce })
+
+			b := env.dispatchWhisperChat("hi", "Bob")
+			if produced {
+				t.Errorf("produced the chat command for an unreachable target")
+			}
+			if stored := len(env.storedFor) > 0; stored != c.wantStored {
+				t.Errorf("stored = %v, want %v", stored, c.wantStored)
+			}
+			if c.wantStored && env.storedFor[0] != findTargetId {
+				t.Errorf("stored for %d, want %d", env.storedFor[0], findTargetId)
+			}
+			if b == nil {
+				t.Fatalf("no packet announced, want WhisperSendResult")
+			}
+			name, success := decodeWhisperSendResult(t, env.ctx, env.l, b)
+			if name != "Bob" || success != c.wantSuccess {
+				t.Errorf("name=%q success=%v, want Bob/%v", name, success, c.wantSuccess)
+			}
+		})
+	}
+}
+
 // locationFixture builds a location.Model in the given state on the given
 // channel, at map 100000000.
 func locationFixture(state characterconst.PresenceState, ch channel.Id) location.Model {
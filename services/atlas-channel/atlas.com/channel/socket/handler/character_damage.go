@@ -9,12 +9,14 @@ import (
 	monsterdata "atlas-channel/data/monster"
 	dataskill "atlas-channel/data/skill"
 	"atlas-channel/data/skill/effect"
+	buff2 "atlas-channel/kafka/message/buff"
 	_map "atlas-channel/map"
 	"atlas-channel/monster"
 	"atlas-channel/session"
 	"atlas-channel/socket/writer"
 	"context"
 	"math"
+	"math/rand"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -22,6 +24,7 @@ import (
 	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
 	skillconst "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
 	atlaspacket "github.com/Chronicle20/atlas/libs/atlas-packet"
 	charpkt "github.com/Chronicle20/atlas/libs/atlas-packet/character/clientbound"
@@ -46,6 +49,15 @@ type damageMitigationDeps struct {
 	inProtectiveMist   func(f field.Model, characterId uint32, x, y int16) bool
 	getChakra          func(characterId uint32) (chakra.Entry, bool)
 	clearChakra        func(characterId uint32) bool
+	// Divine Shield charge bookkeeping: SET the remaining charge count, or
+	// cancel the buff when the last charge is spent.
+	updateBuffStat func(f field.Model, characterId uint32, u buff.StatValueUpdate) error
+	cancelBuff     func(f field.Model, characterId uint32, sourceId int32) error
+	// applyMonsterStatus carries Body Pressure's neutralise to the mob.
+	applyMonsterStatus func(f field.Model, monsterId uint32, characterId uint32, skillId uint32, skillLevel uint32, statuses map[string]int32, duration uint32) error
+	// roll draws the uniform [0,1) proc roll for prop-gated stages; nil
+	// means math/rand.
+	roll func() float64
 }
 
 func CharacterDamageHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
@@ -88,8 +100,9 @@ func CharacterDamageHandleFunc(l logrus.FieldLogger, ctx context.Context, wp wri
 		t := tenant.MustFromContext(ctx)
 		cp := character.NewProcessor(l, ctx)
 		mp := monster.NewProcessor(l, ctx)
+		bp := buff.NewProcessor(l, ctx)
 		deps := damageMitigationDeps{
-			getBuffs:           buff.NewProcessor(l, ctx).GetByCharacterId,
+			getBuffs:           bp.GetByCharacterId,
 			getSkills:          skill2.NewProcessor(l, ctx).GetByCharacterId,
 			getEffect:          dataskill.NewProcessor(l, ctx).GetEffect,
 			getMonster:         mp.GetById,
@@ -97,14 +110,21 @@ func CharacterDamageHandleFunc(l logrus.FieldLogger, ctx context.Context, wp wri
 			changeHP:           cp.ChangeHP,
 			changeMP:           cp.ChangeMP,
 			requestChangeMeso:  cp.RequestChangeMeso,
-			damageMonster:      mp.Damage,
-			inProtectiveMist:   newSmokeCheck(l, ctx, t),
+			// Reflected damage carries no element.
+			damageMonster: func(f field.Model, monsterId uint32, characterId uint32, damages []uint32, attackType byte) error {
+				return mp.Damage(f, monsterId, characterId, damages, attackType, "", 0)
+			},
+			inProtectiveMist: newSmokeCheck(l, ctx, t),
 			getChakra: func(characterId uint32) (chakra.Entry, bool) {
 				return chakra.GetRegistry().Get(t, characterId, time.Now())
 			},
 			clearChakra: func(characterId uint32) bool {
 				return chakra.GetRegistry().Clear(t, characterId)
 			},
+			updateBuffStat:     bp.UpdateStatValue,
+			cancelBuff:         bp.Cancel,
+			applyMonsterStatus: mp.ApplyStatus,
+			roll:               rand.Float64,
 		}
 		processDamageTaken(l, t, s.Field(), p, c, deps)
 	}
@@ -139,6 +159,14 @@ type buffAmounts struct {
 	comboBarrier        int32
 	magicShield         int32
 	guard               bool
+	// Divine Shield: remaining charges and the owning buff.
+	blessingArmor         int32
+	blessingArmorSourceId int32
+	// Body Pressure: presence and the owning buff, whose effect carries
+	// prop and the neutralise duration.
+	bodyPressure         bool
+	bodyPressureSourceId int32
+	bodyPressureLevel    byte
 }
 
 func extractBuffAmounts(buffs []buff.Model) buffAmounts {
@@ -167,6 +195,13 @@ func extractBuffAmounts(buffs []buff.Model) buffAmounts {
 				a.magicShield = ch.Amount()
 			case string(charconst.TemporaryStatTypeGuard):
 				a.guard = true
+			case string(charconst.TemporaryStatTypeBlessingArmor):
+				a.blessingArmor = ch.Amount()
+				a.blessingArmorSourceId = b.SourceId()
+			case string(charconst.TemporaryStatTypeBodyPressure):
+				a.bodyPressure = true
+				a.bodyPressureSourceId = b.SourceId()
+				a.bodyPressureLevel = b.Level()
 			}
 		}
 	}
@@ -305,9 +340,32 @@ func processDamageTaken(
 		}
 	}
 
-	// Mob data is only needed when a reflect will actually be computed.
+	// Body Pressure only acts on a body touch; its prop and neutralise
+	// duration come from the owning buff's effect.
+	var bodyPressure bool
+	var bodyPressureEffect effect.Model
+	if a.bodyPressure && p.AttackIdx() == packetmodel.DamageTypePhysical {
+		eff, effErr := deps.getEffect(uint32(a.bodyPressureSourceId), a.bodyPressureLevel)
+		if effErr != nil {
+			l.WithError(effErr).Warnf("Unable to load Body Pressure effect [%d] level [%d] for character [%d]; skipping neutralise.", a.bodyPressureSourceId, a.bodyPressureLevel, characterId)
+		} else {
+			bodyPressure = true
+			bodyPressureEffect = eff
+		}
+	}
+	var bodyPressureRoll float64
+	if bodyPressure {
+		if deps.roll != nil {
+			bodyPressureRoll = deps.roll()
+		} else {
+			bodyPressureRoll = rand.Float64()
+		}
+	}
+
+	// Mob data is only needed when a reflect or a neutralise will actually
+	// be computed.
 	var mob mobInfo
-	if (powerGuardSignal && a.powerGuard > 0) || manaReflectSignal {
+	if (powerGuardSignal && a.powerGuard > 0) || manaReflectSignal || bodyPressure {
 		live, mErr := deps.getMonster(p.MonsterId())
 		if mErr != nil {
 			l.WithError(mErr).Debugf("Reflect target mob [%d] not found for character [%d]; dropping reflect, keeping mitigation.", p.MonsterId(), characterId)
@@ -349,14 +407,18 @@ func processDamageTaken(
 		pgCapDivisor:               pgCapDivisor,
 		pgFixedDamageOverride:      (t.Region() == "GMS" && t.MajorVersion() >= 95) || t.Region() == "JMS",
 		chakraPct:                  chakraPct,
+		blessingArmorCharges:       a.blessingArmor,
+		bodyPressure:               bodyPressure,
+		bodyPressureProp:           bodyPressureEffect.Prop(),
+		bodyPressureRoll:           bodyPressureRoll,
 	}
 
 	result := computeMitigation(in, mob)
-	l.Debugf("Character [%d] damage [%d] mitigated to hp [%d] mp [%d] meso [%d] reflect [%d] (achilles [%d], comboBarrier [%d], magicShield [%d], magicGuard [%d], mesoGuard [%d], powerGuard [%d], chakra [%d]).",
+	l.Debugf("Character [%d] damage [%d] mitigated to hp [%d] mp [%d] meso [%d] reflect [%d] (achilles [%d], comboBarrier [%d], magicShield [%d], magicGuard [%d], mesoGuard [%d], powerGuard [%d], chakra [%d], divineShield [%t], neutralise [%t]).",
 		characterId, raw, result.hpLoss, result.mpLoss, result.mesoCost, result.reflect.amount,
 		result.breakdown.achillesReduce, result.breakdown.comboBarrierReduce, result.breakdown.magicShieldReduce,
 		result.breakdown.magicGuardAbsorbed, result.breakdown.mesoGuarded, result.breakdown.powerGuardReflect,
-		result.breakdown.chakraAmplified)
+		result.breakdown.chakraAmplified, result.shieldConsumed, result.neutralise)
 
 	_ = deps.changeHP(f, characterId, -clampInt16(result.hpLoss))
 	if result.mpLoss > 0 {
@@ -368,6 +430,15 @@ func processDamageTaken(
 	if result.reflect.amount > 0 {
 		_ = deps.damageMonster(f, p.MonsterId(), characterId, []uint32{result.reflect.amount}, result.reflect.attackType)
 	}
+	if result.shieldConsumed {
+		spendDivineShieldCharge(l, f, characterId, a, deps)
+	}
+	if result.neutralise && deps.applyMonsterStatus != nil {
+		statuses := map[string]int32{string(monster2.TemporaryStatTypeBodyPressure): 1}
+		if err := deps.applyMonsterStatus(f, p.MonsterId(), characterId, uint32(a.bodyPressureSourceId), uint32(a.bodyPressureLevel), statuses, uint32(bodyPressureEffect.Duration())); err != nil {
+			l.WithError(err).Errorf("Unable to neutralise monster [%d] for character [%d] Body Pressure.", p.MonsterId(), characterId)
+		}
+	}
 
 	// A hit cancels the pending heal (PRD FR-5.2). Ordering is deliberate:
 	// the factor is applied and the damage lands FIRST, so the interrupting
@@ -381,6 +452,34 @@ func processDamageTaken(
 	}
 }
 
+// spendDivineShieldCharge spends one Divine Shield charge: the BLESSING_ARMOR
+// amount is SET one lower, and the buff is cancelled outright when the last
+// charge goes (atlas-buffs rejects a SET below 1). Failures are logged; the
+// hit itself was already absorbed.
+func spendDivineShieldCharge(l logrus.FieldLogger, f field.Model, characterId uint32, a buffAmounts, deps damageMitigationDeps) {
+	if a.blessingArmor <= 1 {
+		if deps.cancelBuff == nil {
+			return
+		}
+		if err := deps.cancelBuff(f, characterId, a.blessingArmorSourceId); err != nil {
+			l.WithError(err).Errorf("Unable to cancel spent Divine Shield [%d] for character [%d].", a.blessingArmorSourceId, characterId)
+		}
+		return
+	}
+	if deps.updateBuffStat == nil {
+		return
+	}
+	err := deps.updateBuffStat(f, characterId, buff.StatValueUpdate{
+		SourceId:  a.blessingArmorSourceId,
+		StatType:  string(charconst.TemporaryStatTypeBlessingArmor),
+		Operation: buff2.StatOperationSet,
+		Amount:    a.blessingArmor - 1,
+	})
+	if err != nil {
+		l.WithError(err).Errorf("Unable to spend a Divine Shield charge for character [%d].", characterId)
+	}
+}
+
 // shouldAnnounceGauge is the call-site gate isolated as a pure predicate so
 // it is directly unit-testable: the full handler can't be driven end-to-end
 // in this package's tests (the earlier, pre-existing, unseamed
@@ -49,6 +49,17 @@ type mitigationInput struct {
 	achillesPermille int32 // Achilles or Aran High Defense x, job-selected
 	manaReflectPct   int32
 
+	// blessingArmorCharges is the remaining charge count of an active
+	// Divine Shield (BLESSING_ARMOR amount; 0 = absent).
+	blessingArmorCharges int32
+
+	// bodyPressure: active BODY_PRESSURE buff. The proc is rolled by the
+	// caller (bodyPressureRoll in [0,1)) so the math stays pure;
+	// bodyPressureProp is the source effect's prop.
+	bodyPressure     bool
+	bodyPressureProp float64
+	bodyPressureRoll float64
+
 	// chakraPct is the WZ `x` of the caster's active Chakra recovery window
 	// (0 = no window). CUserLocal::SetDamaged rewrites the raw damage by
 	// this factor before every other term reads it (design §3.3), so it is
@@ -113,6 +124,11 @@ type mitigationResult struct {
 	mesoCost  int32
 	reflect   reflectIntent // amount 0 = none
 	breakdown mitigationBreakdown
+	// shieldConsumed: the hit was absorbed by Divine Shield and one charge
+	// must be spent.
+	shieldConsumed bool
+	// neutralise: Body Pressure procced against the touching mob.
+	neutralise bool
 }
 
 // clampDamage bounds the client-supplied damage per FR-10.1. The -1 block
@@ -139,128 +155,243 @@ func clampInt16(v int32) int16 {
 	return int16(v)
 }
 
+// mitigationState is threaded through the stage pipeline for one hit.
+// raw is the post-Chakra damage every stage reads; each stage records its
+// own share in breakdown, and hpLoss is raw minus the recorded shares.
+type mitigationState struct {
+	raw               int32
+	magicGuardPortion int32 // pre-MP-cap Magic Guard share, read by Magic Shield
+	mpLoss            int32
+	mesoCost          int32
+	reflect           reflectIntent
+	breakdown         mitigationBreakdown
+	// blocked ends the pipeline: the hit is fully absorbed and no later
+	// stage (reduction, cost or reflect) runs.
+	blocked        bool
+	shieldConsumed bool
+	neutralise     bool
+}
+
+// mitigationStage is one defensive mechanic. apply reads the validated
+// input and mutates the running state; a stage whose buff or passive is
+// absent must leave the state untouched.
+type mitigationStage struct {
+	name  string
+	apply func(in mitigationInput, mob mobInfo, st *mitigationState)
+}
+
+// mitigationStages is the registered pipeline, in client evaluation order
+// (CUserLocal::SetDamaged / CalcDamage). A new defensive buff or passive
+// registers here: add its amount to mitigationInput (resolved and
+// cross-checked by processDamageTaken), then a stage at the position the
+// client evaluates it. Order matters — Combo Barrier reads Achilles' share,
+// Magic Shield reads Magic Guard's, Mana Reflection overrides Power
+// Guard's reflect.
+var mitigationStages = []mitigationStage{
+	{name: "chakra", apply: chakraStage},
+	{name: "divineShield", apply: divineShieldStage},
+	{name: "achilles", apply: achillesStage},
+	{name: "comboBarrier", apply: comboBarrierStage},
+	{name: "magicGuard", apply: magicGuardStage},
+	{name: "magicShield", apply: magicShieldStage},
+	{name: "mesoGuard", apply: mesoGuardStage},
+	{name: "powerGuard", apply: powerGuardStage},
+	{name: "manaReflection", apply: manaReflectionStage},
+	{name: "bodyPressure", apply: bodyPressureStage},
+}
+
 // computeMitigation is the server mirror of the client's damage-taken
 // math (design task-157 §6, IDA-verified v83/v87/v95/jms185). Integer
-// arithmetic follows the decompiled formulas exactly.
+// arithmetic follows the decompiled formulas exactly; each mechanic lives
+// in its own stage (mitigationStages).
 func computeMitigation(in mitigationInput, mob mobInfo) mitigationResult {
 	var r mitigationResult
-	raw := in.rawDamage
-	if raw <= 0 {
+	if in.rawDamage <= 0 {
 		return r
 	}
 
-	if in.chakraPct > 0 {
-		raw = raw * in.chakraPct / 100
-		// The client's floor is `<= 1 -> 1`, deliberately not `< 1`, and it
-		// applies to the multiplied value rather than the original.
-		if raw <= 1 {
-			raw = 1
+	st := mitigationState{raw: in.rawDamage}
+	for _, stage := range mitigationStages {
+		stage.apply(in, mob, &st)
+		if st.blocked {
+			break
 		}
-		r.breakdown.chakraAmplified = raw
 	}
 
-	var achillesReduce int32
-	if in.achillesPermille > 0 {
-		achillesReduce = raw * (1000 - in.achillesPermille) / 1000
+	r.breakdown = st.breakdown
+	r.shieldConsumed = st.shieldConsumed
+	if st.blocked {
+		return r
 	}
 
-	var comboBarrierReduce int32
-	if in.comboBarrierPermille > 0 {
-		comboBarrierReduce = (raw - achillesReduce) * (1000 - in.comboBarrierPermille) / 1000
+	b := st.breakdown
+	hpLoss := st.raw - b.achillesReduce - b.comboBarrierReduce - b.magicShieldReduce - b.magicGuardAbsorbed - b.mesoGuarded - b.powerGuardReflect
+	if hpLoss < 0 {
+		hpLoss = 0
 	}
+	r.hpLoss = hpLoss
+	r.mpLoss = st.mpLoss
+	r.mesoCost = st.mesoCost
+	r.reflect = st.reflect
+	r.neutralise = st.neutralise
+	return r
+}
 
-	var magicGuardPortion, mpLoss, absorbed int32
-	if in.magicGuardPct > 0 {
-		magicGuardPortion = raw * in.magicGuardPct / 100
-		mpLoss = magicGuardPortion
-		if mpLoss > int32(in.currentMP) {
-			mpLoss = int32(in.currentMP)
-		}
-		absorbed = mpLoss
-		if in.infinity {
-			absorbed = magicGuardPortion
-			mpLoss = 0
-		}
+// chakraStage rewrites the raw damage by the Chakra recovery window's
+// factor before every other term reads it.
+func chakraStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.chakraPct <= 0 {
+		return
+	}
+	st.raw = st.raw * in.chakraPct / 100
+	// The client's floor is `<= 1 -> 1`, deliberately not `< 1`, and it
+	// applies to the multiplied value rather than the original.
+	if st.raw <= 1 {
+		st.raw = 1
 	}
+	st.breakdown.chakraAmplified = st.raw
+}
 
-	var magicShieldReduce int32
-	if in.magicShieldPct > 0 {
-		base := raw
-		if in.magicShieldOnReducedDamage {
-			base = raw - magicGuardPortion
-		}
-		magicShieldReduce = base * in.magicShieldPct / 100
-	}
-
-	var mesoGuarded, mesoCost int32
-	if in.mesoGuardPct > 0 && in.mobSourced {
-		mesoGuarded = raw / 2
-		cost := int64(in.mesoGuardPct) * int64(mesoGuarded) / 100
-		if cost > int64(in.meso) {
-			// Partial guard: scale the guarded share down to what the
-			// meso balance affords (CalcDamage::GetMesoGuardReduce).
-			mesoGuarded = int32(int64(100) * int64(in.meso) / int64(in.mesoGuardPct))
-			cost = int64(in.mesoGuardPct) * int64(mesoGuarded) / 100
-		}
-		mesoCost = int32(cost)
-	}
-
-	var pgReflect int32
-	if in.powerGuardSignal && in.powerGuardPct > 0 && in.mobSourced {
-		if mob.present && mob.alive {
-			pgReflect = in.powerGuardPct * raw / 100
-			divisor := in.pgCapDivisor
-			if divisor <= 0 {
-				divisor = 10
-			}
-			reflectCap := int32(mob.maxHp / uint32(divisor))
-			if pgReflect > reflectCap {
-				pgReflect = reflectCap
-			}
-			if mob.boss {
-				pgReflect /= 2
-			}
-			if pgReflect > 0 && mob.fixedDamage > 0 {
-				fixed := int32(mob.fixedDamage)
-				if in.pgFixedDamageOverride || fixed < pgReflect {
-					pgReflect = fixed
-				}
-			}
-		}
+// divineShieldStage: an active Divine Shield (BLESSING_ARMOR) absorbs a
+// mob-sourced hit whole and spends one charge. Nothing else runs for the
+// hit — there is no damage left to reduce, guard or reflect.
+func divineShieldStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.blessingArmorCharges <= 0 || !in.mobSourced {
+		return
 	}
+	st.blocked = true
+	st.shieldConsumed = true
+}
 
-	hpLoss := raw - achillesReduce - comboBarrierReduce - magicShieldReduce - absorbed - mesoGuarded - pgReflect
-	if hpLoss < 0 {
-		hpLoss = 0
+// achillesStage: Achilles / Aran High Defense flat reduction (x permille
+// of damage kept).
+func achillesStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.achillesPermille <= 0 {
+		return
 	}
+	st.breakdown.achillesReduce = st.raw * (1000 - in.achillesPermille) / 1000
+}
 
-	r.hpLoss = hpLoss
-	r.mpLoss = mpLoss
-	r.mesoCost = mesoCost
-	r.breakdown = mitigationBreakdown{
-		chakraAmplified:    r.breakdown.chakraAmplified,
-		achillesReduce:     achillesReduce,
-		comboBarrierReduce: comboBarrierReduce,
-		magicShieldReduce:  magicShieldReduce,
-		magicGuardAbsorbed: absorbed,
-		mesoGuarded:        mesoGuarded,
-		powerGuardReflect:  pgReflect,
+// comboBarrierStage reduces what Achilles left over.
+func comboBarrierStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.comboBarrierPermille <= 0 {
+		return
 	}
-	if pgReflect > 0 {
-		r.reflect = reflectIntent{amount: uint32(pgReflect), attackType: reflectAttackTypePhysical}
+	st.breakdown.comboBarrierReduce = (st.raw - st.breakdown.achillesReduce) * (1000 - in.comboBarrierPermille) / 1000
+}
+
+// magicGuardStage splits x% of the hit onto MP, spilling the shortfall
+// back to HP; Infinity absorbs the share without spending MP.
+func magicGuardStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.magicGuardPct <= 0 {
+		return
 	}
+	st.magicGuardPortion = st.raw * in.magicGuardPct / 100
+	mpLoss := st.magicGuardPortion
+	if mpLoss > int32(in.currentMP) {
+		mpLoss = int32(in.currentMP)
+	}
+	absorbed := mpLoss
+	if in.infinity {
+		absorbed = st.magicGuardPortion
+		mpLoss = 0
+	}
+	st.mpLoss = mpLoss
+	st.breakdown.magicGuardAbsorbed = absorbed
+}
 
-	if in.manaReflectSignal && in.manaReflectPct > 0 && in.mobSourced && mob.present && mob.alive {
-		mr := raw * in.manaReflectPct / 100
-		mrCap := int32(mob.maxHp / 20)
-		if mr > mrCap {
-			mr = mrCap
-		}
-		if mr > 0 {
-			// Mana Reflection does not reduce the caster's own damage.
-			r.reflect = reflectIntent{amount: uint32(mr), attackType: reflectAttackTypeMagic}
+// magicShieldStage reduces by x% of the raw damage, or of the damage net of
+// the Magic Guard share on v87+.
+func magicShieldStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.magicShieldPct <= 0 {
+		return
+	}
+	base := st.raw
+	if in.magicShieldOnReducedDamage {
+		base = st.raw - st.magicGuardPortion
+	}
+	st.breakdown.magicShieldReduce = base * in.magicShieldPct / 100
+}
+
+// mesoGuardStage guards half a mob-sourced hit at a meso cost of x% of the
+// guarded amount.
+func mesoGuardStage(in mitigationInput, _ mobInfo, st *mitigationState) {
+	if in.mesoGuardPct <= 0 || !in.mobSourced {
+		return
+	}
+	guarded := st.raw / 2
+	cost := int64(in.mesoGuardPct) * int64(guarded) / 100
+	if cost > int64(in.meso) {
+		// Partial guard: scale the guarded share down to what the
+		// meso balance affords (CalcDamage::GetMesoGuardReduce).
+		guarded = int32(int64(100) * int64(in.meso) / int64(in.mesoGuardPct))
+		cost = int64(in.mesoGuardPct) * int64(guarded) / 100
+	}
+	st.breakdown.mesoGuarded = guarded
+	st.mesoCost = int32(cost)
+}
+
+// powerGuardStage reflects x% of a validated mob-sourced hit back to a live
+// attacker, capped by the attacker's MaxHP, halved on bosses and bounded by
+// a template fixedDamage. The reflected share is not taken as HP.
+func powerGuardStage(in mitigationInput, mob mobInfo, st *mitigationState) {
+	if !in.powerGuardSignal || in.powerGuardPct <= 0 || !in.mobSourced {
+		return
+	}
+	if !mob.present || !mob.alive {
+		return
+	}
+	pgReflect := in.powerGuardPct * st.raw / 100
+	divisor := in.pgCapDivisor
+	if divisor <= 0 {
+		divisor = 10
+	}
+	reflectCap := int32(mob.maxHp / uint32(divisor))
+	if pgReflect > reflectCap {
+		pgReflect = reflectCap
+	}
+	if mob.boss {
+		pgReflect /= 2
+	}
+	if pgReflect > 0 && mob.fixedDamage > 0 {
+		fixed := int32(mob.fixedDamage)
+		if in.pgFixedDamageOverride || fixed < pgReflect {
+			pgReflect = fixed
 		}
 	}
+	st.breakdown.powerGuardReflect = pgReflect
+	if pgReflect > 0 {
+		st.reflect = reflectIntent{amount: uint32(pgReflect), attackType: reflectAttackTypePhysical}
+	}
+}
 
-	return r
+// manaReflectionStage reflects x% of a validated mob skill hit as magic
+// damage, capped at MaxHP/20. It does not reduce the caster's own damage.
+func manaReflectionStage(in mitigationInput, mob mobInfo, st *mitigationState) {
+	if !in.manaReflectSignal || in.manaReflectPct <= 0 || !in.mobSourced || !mob.present || !mob.alive {
+		return
+	}
+	mr := st.raw * in.manaReflectPct / 100
+	mrCap := int32(mob.maxHp / 20)
+	if mr > mrCap {
+		mr = mrCap
+	}
+	if mr > 0 {
+		st.reflect = reflectIntent{amount: uint32(mr), attackType: reflectAttackTypeMagic}
+	}
+}
+
+// bodyPressureStage: a live non-boss mob that body-touches a character
+// under Body Pressure is neutralised on a prop roll. The hit itself is
+// taken in full.
+func bodyPressureStage(in mitigationInput, mob mobInfo, st *mitigationState) {
+	if !in.bodyPressure || in.attackIdx != packetmodel.DamageTypePhysical {
+		return
+	}
+	if !mob.present || !mob.alive || mob.boss {
+		return
+	}
+	if shouldProc(in.bodyPressureProp, in.bodyPressureRoll) {
+		st.neutralise = true
+	}
 }
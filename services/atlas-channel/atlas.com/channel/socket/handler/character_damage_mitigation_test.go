@@ -230,6 +230,43 @@ func TestComputeMitigationPassivesAndBarriers(t *testing.T) {
 	})
 }
 
+func TestComputeMitigationDivineShield(t *testing.T) {
+	in := mitigationInput{attackIdx: packetmodel.DamageTypePhysical, rawDamage: 1000, mobSourced: true, blessingArmorCharges: 2, mesoGuardPct: 50, meso: 1000000, powerGuardPct: 30, pgCapDivisor: 10}
+	r := computeMitigation(in, mobUp(100000))
+	if !r.shieldConsumed || r.hpLoss != 0 || r.mesoCost != 0 || r.reflect.amount != 0 {
+		t.Fatalf("shield should absorb the whole hit before later stages: %+v", r)
+	}
+
+	in.mobSourced = false
+	in.attackIdx = packetmodel.DamageTypeObstacle
+	r = computeMitigation(in, mobInfo{})
+	if r.shieldConsumed || r.hpLoss == 0 {
+		t.Fatalf("obstacle damage must not spend a charge: %+v", r)
+	}
+}
+
+func TestComputeMitigationBodyPressure(t *testing.T) {
+	base := mitigationInput{attackIdx: packetmodel.DamageTypePhysical, rawDamage: 500, mobSourced: true, bodyPressure: true, bodyPressureProp: 0.5, bodyPressureRoll: 0.2, pgCapDivisor: 10}
+	if r := computeMitigation(base, mobUp(1000)); !r.neutralise || r.hpLoss != 500 {
+		t.Fatalf("touch under prop should neutralise without reducing damage: %+v", r)
+	}
+	miss := base
+	miss.bodyPressureRoll = 0.9
+	if r := computeMitigation(miss, mobUp(1000)); r.neutralise {
+		t.Fatal("roll above prop must not neutralise")
+	}
+	skillHit := base
+	skillHit.attackIdx = packetmodel.DamageTypeMagic
+	if r := computeMitigation(skillHit, mobUp(1000)); r.neutralise {
+		t.Fatal("mob skill damage is not a touch")
+	}
+	boss := mobUp(1000)
+	boss.boss = true
+	if r := computeMitigation(base, boss); r.neutralise {
+		t.Fatal("bosses are immune to Body Pressure")
+	}
+}
+
 func TestClampDamage(t *testing.T) {
 	if v, adj := clampDamage(500); v != 500 || adj {
 		t.Fatalf("got %d/%t", v, adj)
@@ -45,6 +45,9 @@ type emissions struct {
 	meso               []int32
 	reflects           []uint32
 	reflectAttackTypes []byte
+	buffSets           []buff.StatValueUpdate
+	buffCancels        []int32
+	monsterStatuses    []map[string]int32
 }
 
 func fakeDeps(em *emissions, buffs []buff.Model, skills []skill2.Model, eff effect.Model, mob monster.Model, tmpl monsterdata.Model) damageMitigationDeps {
@@ -73,6 +76,19 @@ func fakeDeps(em *emissions, buffs []buff.Model, skills []skill2.Model, eff effe
 			}
 			return nil
 		},
+		updateBuffStat: func(_ field.Model, _ uint32, u buff.StatValueUpdate) error {
+			em.buffSets = append(em.buffSets, u)
+			return nil
+		},
+		cancelBuff: func(_ field.Model, _ uint32, sourceId int32) error {
+			em.buffCancels = append(em.buffCancels, sourceId)
+			return nil
+		},
+		applyMonsterStatus: func(_ field.Model, _ uint32, _ uint32, _ uint32, _ uint32, statuses map[string]int32, _ uint32) error {
+			em.monsterStatuses = append(em.monsterStatuses, statuses)
+			return nil
+		},
+		roll: func() float64 { return 0 },
 	}
 }
 
@@ -418,6 +434,77 @@ func TestProcessDamageTakenManaReflectionEmitsReflect(t *testing.T) {
 	}
 }
 
+func TestProcessDamageTakenDivineShieldSpendsCharge(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	tm := testTenantModel(t, "GMS", 83)
+	em := &emissions{}
+	buffs := []buff.Model{activeBuff(charconst.TemporaryStatTypeBlessingArmor, 3)}
+	deps := fakeDeps(em, buffs, nil, effect.Model{}, monster.Model{}, monsterdata.Model{})
+
+	p := damagePacket(t, tm, packetmodel.DamageTypePhysical, 1000, false)
+	c := testCharacter(t, job.Id(122), 100, 0, nil)
+	processDamageTaken(l, tm, damageTestField(), p, c, deps)
+
+	if len(em.hp) != 1 || em.hp[0] != 0 {
+		t.Fatalf("hp=%v, want [0]", em.hp)
+	}
+	if len(em.buffSets) != 1 || em.buffSets[0].Amount != 2 || em.buffSets[0].StatType != string(charconst.TemporaryStatTypeBlessingArmor) {
+		t.Fatalf("buffSets=%+v, want one BLESSING_ARMOR SET to 2", em.buffSets)
+	}
+	if len(em.buffCancels) != 0 {
+		t.Fatalf("buffCancels=%v, want none", em.buffCancels)
+	}
+}
+
+func TestProcessDamageTakenDivineShieldLastChargeCancels(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	tm := testTenantModel(t, "GMS", 83)
+	em := &emissions{}
+	buffs := []buff.Model{activeBuff(charconst.TemporaryStatTypeBlessingArmor, 1)}
+	deps := fakeDeps(em, buffs, nil, effect.Model{}, monster.Model{}, monsterdata.Model{})
+
+	p := damagePacket(t, tm, packetmodel.DamageTypePhysical, 1000, false)
+	c := testCharacter(t, job.Id(122), 100, 0, nil)
+	processDamageTaken(l, tm, damageTestField(), p, c, deps)
+
+	if len(em.buffSets) != 0 || len(em.buffCancels) != 1 || em.buffCancels[0] != 2001002 {
+		t.Fatalf("sets=%+v cancels=%v, want a single cancel of the shield buff", em.buffSets, em.buffCancels)
+	}
+}
+
+func TestProcessDamageTakenBodyPressureNeutralisesToucher(t *testing.T) {
+	l, _ := test.NewNullLogger()
+	tm := testTenantModel(t, "GMS", 83)
+	em := &emissions{}
+	buffs := []buff.Model{activeBuff(charconst.TemporaryStatTypeBodyPressure, 130)}
+	mob, err := monster.NewModelBuilder(42, damageTestField(), 200100).SetHp(50000).SetMaxHp(100000).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eff, err := effect.Extract(effect.RestModel{Prop: 0.5, Duration: 3000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deps := fakeDeps(em, buffs, nil, eff, mob, monsterdata.Model{})
+
+	c := testCharacter(t, job.Id(2110), 100, 0, nil)
+	processDamageTaken(l, tm, damageTestField(), damagePacket(t, tm, packetmodel.DamageTypePhysical, 500, false), c, deps)
+	if len(em.monsterStatuses) != 1 || em.monsterStatuses[0]["BODY_PRESSURE"] != 1 {
+		t.Fatalf("statuses=%v, want one BODY_PRESSURE neutralise", em.monsterStatuses)
+	}
+	if len(em.hp) != 1 || em.hp[0] != -500 {
+		t.Fatalf("hp=%v, want [-500]; Body Pressure does not reduce the hit", em.hp)
+	}
+
+	// Mob skill damage is not a touch.
+	em2 := &emissions{}
+	deps = fakeDeps(em2, buffs, nil, eff, mob, monsterdata.Model{})
+	processDamageTaken(l, tm, damageTestField(), damagePacket(t, tm, packetmodel.DamageTypeMagic, 500, false), c, deps)
+	if len(em2.monsterStatuses) != 0 {
+		t.Fatalf("statuses=%v, want none for a mob skill hit", em2.monsterStatuses)
+	}
+}
+
 // TestDamageAppliesChakraFactorAndInterrupts pins PRD FR-4.5 / FR-5.2: the
 // interrupting hit itself takes the Chakra factor, and the window is closed
 // afterwards so the pending heal cannot fire.
@@ -400,7 +400,13 @@ func CharacterInteractionHandleFunc(l logrus.FieldLogger, ctx context.Context, w
 				l.WithError(err).Errorf("Unable to get visiting shop for character [%d].", s.CharacterId())
 				return
 			}
-			_ = mp.PurchaseBundle(s.CharacterId(), visiting.Id(), uint16(sp.Index()), uint16(sp.Quantity()))
+			// The buyer's name rides along so the shop's sale report can
+			// say who bought what while the owner was away.
+			buyerName := ""
+			if bc, berr := character.NewProcessor(l, ctx).GetById()(s.CharacterId()); berr == nil {
+				buyerName = bc.Name()
+			}
+			_ = mp.PurchaseBundle(s.CharacterId(), buyerName, visiting.Id(), uint16(sp.Index()), uint16(sp.Quantity()))
 			return
 		}
 		if isCharacterInteraction(l)(readerOptions, mode, CharacterInteractionModePersonalStoreRemoveItem) {
@@ -490,7 +496,13 @@ func CharacterInteractionHandleFunc(l logrus.FieldLogger, ctx context.Context, w
 				l.WithError(err).Errorf("Unable to get visiting shop for character [%d].", s.CharacterId())
 				return
 			}
-			_ = mp.PurchaseBundle(s.CharacterId(), visiting.Id(), uint16(sp.Index()), uint16(sp.Quantity()))
+			// The buyer's name rides along so the shop's sale report can
+			// say who bought what while the owner was away.
+			buyerName := ""
+			if bc, berr := character.NewProcessor(l, ctx).GetById()(s.CharacterId()); berr == nil {
+				buyerName = bc.Name()
+			}
+			_ = mp.PurchaseBundle(s.CharacterId(), buyerName, visiting.Id(), uint16(sp.Index()), uint16(sp.Quantity()))
 			return
 		}
 		if isCharacterInteraction(l)(readerOptions, mode, CharacterInteractionModeMerchantRemoveItem) {
@@ -77,12 +77,12 @@ func CharacterUseSkillHandleFunc(l logrus.FieldLogger, ctx context.Context, wp w
 			return
 		}
 
-		// Battleship post-break cooldown is enforced server-side: the client
-		// greys the icon, but a packet-editing client must not remount a
-		// broken ship (FR-2.4). Zero extra round-trips — CooldownExpiresAt is
-		// decorated onto the already-loaded skill model.
-		if battleshipCastBlocked(sui.SkillId(), sm.CooldownExpiresAt(), time.Now()) {
-			l.Debugf("Character [%d] attempting to cast battleship while on post-break cooldown (expires [%s]).", s.CharacterId(), sm.CooldownExpiresAt())
+		// Cooldowns are enforced server-side: the client greys the icon, but a
+		// packet-editing client must not recast early (this also covers the
+		// battleship post-break cooldown, FR-2.4). Zero extra round-trips —
+		// CooldownExpiresAt is decorated onto the already-loaded skill model.
+		if skillOnCooldown(sm.CooldownExpiresAt(), time.Now()) {
+			l.Debugf("Character [%d] attempting to cast skill [%d] while on cooldown (expires [%s]).", s.CharacterId(), sui.SkillId(), sm.CooldownExpiresAt())
 			err = enableActions(l)(ctx)(wp)(s)
 			if err != nil {
 				l.WithError(err).Errorf("Unable to write [%s] for character [%d].", statpkt.StatChangedWriter, s.CharacterId())
@@ -217,11 +217,16 @@ func skillLevelOf(skills []skill2.Model, id skill.Id) byte {
 	return 0
 }
 
-// battleshipCastBlocked reports whether a 5221006 cast must be rejected
-// because the post-break cooldown is still running (FR-2.4). Scoped to
-// battleship: a generic cast-time cooldown gate is out of scope here.
-func battleshipCastBlocked(skillId uint32, cooldownExpiresAt time.Time, now time.Time) bool {
-	return skill.Id(skillId) == skill.CorsairBattleshipId && now.Before(cooldownExpiresAt)
+// cooldownCastTolerance absorbs the client running ahead of the server: the
+// client starts its timer at the cast, atlas-skills only once SET_COOLDOWN
+// lands, and the remaining time sent on login truncates to whole seconds.
+const cooldownCastTolerance = time.Second
+
+// skillOnCooldown reports whether a cast or skill attack must be rejected
+// because the skill's cooldown is still running. Shared by the use-skill and
+// attack paths.
+func skillOnCooldown(cooldownExpiresAt time.Time, now time.Time) bool {
+	return now.Add(cooldownCastTolerance).Before(cooldownExpiresAt)
 }
 
 // chakraUseBlocked reports whether a Chakra USE_SKILL must be rejected.
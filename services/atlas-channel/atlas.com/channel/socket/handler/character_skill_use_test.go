@@ -5,25 +5,23 @@ import (
 	"time"
 )
 
-func TestBattleshipCastBlocked(t *testing.T) {
+func TestSkillOnCooldown(t *testing.T) {
 	now := time.Now()
-	future := now.Add(30 * time.Second)
-	past := now.Add(-30 * time.Second)
 	tests := []struct {
 		name              string
-		skillId           uint32
 		cooldownExpiresAt time.Time
 		expected          bool
 	}{
-		{"battleship on cooldown blocked (FR-2.4)", 5221006, future, true},
-		{"battleship cooldown expired allowed", 5221006, past, false},
-		{"battleship never cooled allowed", 5221006, time.Time{}, false},
-		{"other skill on cooldown not blocked here", 2311001, future, false},
+		{"on cooldown blocked", now.Add(30 * time.Second), true},
+		{"cooldown expired allowed", now.Add(-30 * time.Second), false},
+		{"never cooled allowed", time.Time{}, false},
+		{"within tolerance allowed", now.Add(cooldownCastTolerance / 2), false},
+		{"just past tolerance blocked", now.Add(cooldownCastTolerance + time.Millisecond), true},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			if got := battleshipCastBlocked(tc.skillId, tc.cooldownExpiresAt, now); got != tc.expected {
-				t.Errorf("battleshipCastBlocked(%d, %v) = %v, want %v", tc.skillId, tc.cooldownExpiresAt, got, tc.expected)
+			if got := skillOnCooldown(tc.cooldownExpiresAt, now); got != tc.expected {
+				t.Errorf("skillOnCooldown(%v) = %v, want %v", tc.cooldownExpiresAt, got, tc.expected)
 			}
 		})
 	}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+func FamilyChartRequestHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.ChartRequest{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		viewedId := s.CharacterId()
+		if p.Name() != "" {
+			c, err := character.NewProcessor(l, ctx).GetByName(p.Name())
+			if err != nil || c.WorldId() != s.WorldId() {
+				announceFamilyResult(l, ctx, wp, s, familypkt.ResultCharacterNotFound)
+				return
+			}
+			viewedId = c.Id()
+		}
+
+		err := session.Announce(l)(ctx)(wp)(familycb.FamilyChartResultWriter)(family.ChartResult(l, ctx, familyTreeOf(l, ctx, viewedId), s.CharacterId(), viewedId).Encode)(s)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to write family chart to character [%d].", s.CharacterId())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+)
+
+func announceFamilyResult(l logrus.FieldLogger, ctx context.Context, wp writer.Producer, s session.Model, code string) {
+	err := session.Announce(l)(ctx)(wp)(familycb.FamilyResultWriter)(familypkt.FamilyResultBody(code))(s)
+	if err != nil {
+		l.WithError(err).Errorf("Unable to announce family result [%s] to character [%d].", code, s.CharacterId())
+	}
+}
+
+// familyTreeOf returns the family visible from characterId. A character who
+// has never joined a family is a family of one.
+func familyTreeOf(l logrus.FieldLogger, ctx context.Context, characterId uint32) family.Tree {
+	tree, err := family.NewProcessor(l, ctx).GetTree(characterId)
+	if err != nil || len(tree) == 0 {
+		l.WithError(err).Debugf("Character [%d] has no family.", characterId)
+		return family.Tree{}
+	}
+	return tree
+}
+
+func familyInfoResult(l logrus.FieldLogger, ctx context.Context, characterId uint32) familycb.InfoResult {
+	tree := familyTreeOf(l, ctx, characterId)
+	m, _ := tree.Member(characterId)
+
+	leaderId := tree.LeaderId(characterId)
+	familyName := ""
+	if len(tree) > 0 {
+		if leader, err := character.NewProcessor(l, ctx).GetById()(leaderId); err == nil {
+			familyName = leader.Name()
+		}
+	}
+
+	uses := make([]familycb.EntitlementUse, 0, len(m.EntitlementUses()))
+	for t, c := range m.EntitlementUses() {
+		uses = append(uses, familycb.EntitlementUse{Type: uint32(t), Count: c})
+	}
+	sort.Slice(uses, func(i, j int) bool { return uses[i].Type < uses[j].Type })
+
+	return familycb.NewFamilyInfoResult(m.Rep(), m.Rep(), m.DailyRep(), uint16(len(m.JuniorIds())), leaderId, familyName, "", uses)
+}
+
+func familyPrivilegeList() familycb.PrivilegeList {
+	es := familyconst.Entitlements()
+	ps := make([]familycb.PrivilegeEntry, 0, len(es))
+	for _, e := range es {
+		kind := byte(2)
+		if e.Target == familyconst.TargetMember {
+			kind = 1
+		}
+		ps = append(ps, familycb.PrivilegeEntry{Kind: kind, RepCost: e.RepCost, UsageLimit: e.UsageLimit, Name: e.Name, Description: e.Description})
+	}
+	return familycb.NewFamilyPrivilegeList(ps)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+// FamilyInfoRequestHandleFunc answers the family window. The privilege list
+// goes first so the window's entitlement tab is populated when it opens.
+func FamilyInfoRequestHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.InfoRequest{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		err := session.Announce(l)(ctx)(wp)(familycb.FamilyPrivilegeListWriter)(familyPrivilegeList().Encode)(s)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to write family privilege list to character [%d].", s.CharacterId())
+		}
+		err = session.Announce(l)(ctx)(wp)(familycb.FamilyInfoResultWriter)(familyInfoResult(l, ctx, s.CharacterId()).Encode)(s)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to write family info to character [%d].", s.CharacterId())
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/invite"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	invite2 "github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+func FamilyInviteResultHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.InviteResult{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		var err error
+		if p.Accepted() {
+			err = invite.NewProcessor(l, ctx).Accept(s.CharacterId(), s.WorldId(), string(invite2.TypeFamily), p.InviterId())
+		} else {
+			err = invite.NewProcessor(l, ctx).Reject(s.CharacterId(), s.WorldId(), string(invite2.TypeFamily), p.InviterId())
+		}
+		if err != nil {
+			l.WithError(err).Errorf("Unable to answer family invite from [%d] for character [%d].", p.InviterId(), s.CharacterId())
+		}
+	}
+}
+
+func FamilySummonResponseHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.SummonResponse{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		summoner, err := character.NewProcessor(l, ctx).GetByName(p.SummonerName())
+		if err != nil {
+			l.WithError(err).Warnf("Unable to locate family summoner [%s] for character [%d].", p.SummonerName(), s.CharacterId())
+			return
+		}
+		summonerId := summoner.Id()
+		if p.Accepted() {
+			err = invite.NewProcessor(l, ctx).Accept(s.CharacterId(), s.WorldId(), string(invite2.TypeFamilySummon), summonerId)
+		} else {
+			err = invite.NewProcessor(l, ctx).Reject(s.CharacterId(), s.WorldId(), string(invite2.TypeFamilySummon), summonerId)
+		}
+		if err != nil {
+			l.WithError(err).Errorf("Unable to answer family summon from [%d] for character [%d].", summonerId, s.CharacterId())
+		}
+	}
+}
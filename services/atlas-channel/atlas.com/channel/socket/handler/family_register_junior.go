@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	"atlas-channel/invite"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	invite2 "github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+// FamilyRegisterJuniorHandleFunc invites a character in the same map to become
+// the requester's junior. atlas-families links the pair once the invite is
+// accepted and re-validates the level gap at that point.
+func FamilyRegisterJuniorHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.RegisterJunior{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		tc, err := character.NewProcessor(l, ctx).GetByName(p.Name())
+		if err != nil || tc.WorldId() != s.WorldId() || tc.Id() == s.CharacterId() {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultCharacterNotFound)
+			return
+		}
+		ts, err := session.NewProcessor(l, ctx).GetByCharacterId(s.Field().Channel())(tc.Id())
+		if err != nil || ts.Field().Id() != s.Field().Id() {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultNotSameMap)
+			return
+		}
+
+		senior, _ := familyTreeOf(l, ctx, s.CharacterId()).Member(s.CharacterId())
+		if len(senior.JuniorIds()) >= family.MaxJuniors {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultJuniorsFull)
+			return
+		}
+		junior, _ := familyTreeOf(l, ctx, tc.Id()).Member(tc.Id())
+		if junior.HasSenior() {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultAlreadyHasSenior)
+			return
+		}
+
+		err = invite.NewProcessor(l, ctx).Create(s.CharacterId(), s.WorldId(), string(invite2.TypeFamily), tc.Id(), s.CharacterId())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to invite character [%d] to join the family of [%d].", tc.Id(), s.CharacterId())
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"atlas-channel/family"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+func FamilyUnregisterJuniorHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.UnregisterJunior{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		junior, ok := familyTreeOf(l, ctx, s.CharacterId()).Member(p.JuniorId())
+		if !ok || junior.SeniorId() != s.CharacterId() {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultNotInFamily)
+			return
+		}
+		err := family.NewProcessor(l, ctx).BreakLink(s.WorldId(), p.JuniorId(), "Senior removed junior")
+		if err != nil {
+			l.WithError(err).Errorf("Unable to remove junior [%d] from the family of [%d].", p.JuniorId(), s.CharacterId())
+		}
+	}
+}
+
+func FamilyUnregisterParentHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.UnregisterParent{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		member, _ := familyTreeOf(l, ctx, s.CharacterId()).Member(s.CharacterId())
+		if !member.HasSenior() {
+			announceFamilyResult(l, ctx, wp, s, familypkt.ResultNotInFamily)
+			return
+		}
+		err := family.NewProcessor(l, ctx).BreakLink(s.WorldId(), s.CharacterId(), "Junior left senior")
+		if err != nil {
+			l.WithError(err).Errorf("Unable to remove character [%d] from their senior's family.", s.CharacterId())
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"atlas-channel/character"
+	"atlas-channel/family"
+	"atlas-channel/party"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	familypkt "github.com/Chronicle20/atlas/libs/atlas-packet/family"
+	familysb "github.com/Chronicle20/atlas/libs/atlas-packet/family/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+// FamilyUsePrivilegeHandleFunc asks atlas-families to charge reputation for an
+// entitlement. The channel resolves who the entitlement reaches; the effect is
+// carried out once the ENTITLEMENT_USED event confirms the charge.
+func FamilyUsePrivilegeHandleFunc(l logrus.FieldLogger, ctx context.Context, wp writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.UsePrivilege{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		e, ok := familyconst.GetEntitlement(familyconst.EntitlementType(p.PrivilegeType()))
+		if !ok || p.PrivilegeType() > 0xFF {
+			l.Warnf("Character [%d] requested unknown family privilege [%d].", s.CharacterId(), p.PrivilegeType())
+			return
+		}
+
+		targetId := uint32(0)
+		beneficiaryIds := []uint32{s.CharacterId()}
+		switch e.Target {
+		case familyconst.TargetMember:
+			tc, err := character.NewProcessor(l, ctx).GetByName(p.TargetName())
+			if err != nil || tc.Id() == s.CharacterId() {
+				announceFamilyResult(l, ctx, wp, s, familypkt.ResultCharacterNotFound)
+				return
+			}
+			if _, err = session.NewProcessor(l, ctx).GetByCharacterId(s.Field().Channel())(tc.Id()); err != nil {
+				announceFamilyResult(l, ctx, wp, s, familypkt.ResultCharacterNotFound)
+				return
+			}
+			targetId = tc.Id()
+			beneficiaryIds = nil
+		case familyconst.TargetParty:
+			if pm, err := party.NewProcessor(l, ctx).GetByMemberId(s.CharacterId()); err == nil {
+				beneficiaryIds = beneficiaryIds[:0]
+				for _, m := range pm.Members() {
+					beneficiaryIds = append(beneficiaryIds, m.Id())
+				}
+			}
+		case familyconst.TargetFamily:
+			me, _ := familyTreeOf(l, ctx, s.CharacterId()).Member(s.CharacterId())
+			sp := session.NewProcessor(l, ctx)
+			for _, id := range me.JuniorIds() {
+				if _, err := sp.GetByCharacterId(s.Field().Channel())(id); err == nil {
+					beneficiaryIds = append(beneficiaryIds, id)
+				}
+			}
+		}
+
+		err := family.NewProcessor(l, ctx).UseEntitlement(s.WorldId(), s.CharacterId(), byte(e.Type), targetId, beneficiaryIds)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to request family entitlement [%d] for character [%d].", e.Type, s.CharacterId())
+		}
+	}
+}
+
+// FamilySetPreceptHandleFunc acknowledges the leader's precept. Precepts are
+// not persisted by atlas-families, so the request is logged and dropped.
+func FamilySetPreceptHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := familysb.SetPrecept{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+		l.Debugf("Character [%d] attempted to set a family precept, which is not supported.", s.CharacterId())
+	}
+}
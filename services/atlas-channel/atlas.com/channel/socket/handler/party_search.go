@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"atlas-channel/party"
+	"atlas-channel/session"
+	"atlas-channel/socket/writer"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	partysb "github.com/Chronicle20/atlas/libs/atlas-packet/party/serverbound"
+	"github.com/Chronicle20/atlas/libs/atlas-socket/request"
+)
+
+// PartySearchStartHandleFunc lists the leader's party on the party-search
+// board, targeting the map the leader is standing in.
+func PartySearchStartHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := partysb.SearchStart{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		err := party.NewProcessor(l, ctx).PublishListing(s.CharacterId(), clampByte(p.MinLevel()), clampByte(p.MaxLevel()), p.JobFlags(), clampByte(p.MaxMembers()), s.MapId())
+		if err != nil {
+			l.WithError(err).Errorf("Character [%d] unable to start party search.", s.CharacterId())
+		}
+	}
+}
+
+func PartySearchStopHandleFunc(l logrus.FieldLogger, ctx context.Context, _ writer.Producer) func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+	return func(s session.Model, r *request.Reader, readerOptions map[string]interface{}) {
+		p := partysb.SearchStop{}
+		p.Decode(l, ctx)(r, readerOptions)
+		l.Debugf("[%s] read [%s]", p.Operation(), p.String())
+
+		err := party.NewProcessor(l, ctx).WithdrawListing(s.CharacterId())
+		if err != nil {
+			l.WithError(err).Errorf("Character [%d] unable to stop party search.", s.CharacterId())
+		}
+	}
+}
+
+func clampByte(v uint32) byte {
+	if v > 0xFF {
+		return 0xFF
+	}
+	return byte(v)
+}
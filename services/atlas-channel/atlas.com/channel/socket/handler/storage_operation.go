@@ -234,7 +234,14 @@ func handleStoreAsset(l logrus.FieldLogger, ctx context.Context, s session.Model
 func handleArrangeAsset(l logrus.FieldLogger, ctx context.Context, s session.Model) {
 	l.Debugf("Character [%d] would like to arrange their storage.", s.CharacterId())
 
-	err := storage.NewProcessor(l, ctx).Arrange(s.WorldId(), s.AccountId())
+	// Arrange the tab the character currently has open, defaulting to the main tab
+	sp := storage.NewProcessor(l, ctx)
+	var tab byte
+	if pd, err := sp.GetProjectionData(s.CharacterId()); err == nil {
+		tab = pd.Tab
+	}
+
+	err := sp.Arrange(s.WorldId(), s.AccountId(), tab)
 	if err != nil {
 		l.WithError(err).Errorf("Unable to arrange storage for account [%d].", s.AccountId())
 	}
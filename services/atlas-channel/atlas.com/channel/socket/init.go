@@ -3,6 +3,7 @@ package socket
 import (
 	"atlas-channel/channel"
 	"atlas-channel/character/chakra"
+	"atlas-channel/character/damagecheck"
 	"atlas-channel/character/statreset"
 	"atlas-channel/remotemerchant"
 	"atlas-channel/server"
@@ -126,6 +127,9 @@ func CreateSocketService(l logrus.FieldLogger, ctx context.Context, wg socket.Wa
 						// entry per character ever seen by this pod
 						// (PRD FR-5.5, FR-2.2).
 						chakra.GetRegistry().Clear(t, s.CharacterId())
+						// The suspicion score is session-scoped by design
+						// (damage-range validation); drop it with the session.
+						damagecheck.GetRegistry().ClearCharacter(t, s.CharacterId())
 						// Channel change and disconnect both destroy the
 						// session; without this the pending-unlock map
 						// leaks one entry per character ever seen by this
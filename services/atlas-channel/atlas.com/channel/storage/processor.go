@@ -18,8 +18,9 @@ const DefaultStorageCapacity byte = 4
 
 type Processor interface {
 	GetStorageData(accountId uint32, worldId world.Id) (StorageData, error)
+	GetStorageTabData(accountId uint32, worldId world.Id, tab byte) (StorageData, error)
 	GetProjectionData(characterId uint32) (ProjectionData, error)
-	Arrange(worldId world.Id, accountId uint32) error
+	Arrange(worldId world.Id, accountId uint32, tab byte) error
 	DepositMesos(worldId world.Id, accountId uint32, mesos uint32) error
 	WithdrawMesos(worldId world.Id, accountId uint32, mesos uint32) error
 	CloseStorage(characterId uint32) error
@@ -33,6 +34,7 @@ type ProjectionData struct {
 	Capacity     byte
 	Mesos        uint32
 	NpcId        uint32
+	Tab          byte
 	Compartments map[string][]asset.Model
 }
 
@@ -54,12 +56,17 @@ type StorageData struct {
 	Assets   []asset.Model
 }
 
-// GetStorageData fetches storage metadata and assets for an account
+// GetStorageData fetches storage metadata and assets for an account's main tab
 func (p *ProcessorImpl) GetStorageData(accountId uint32, worldId world.Id) (StorageData, error) {
+	return p.GetStorageTabData(accountId, worldId, 0)
+}
+
+// GetStorageTabData fetches storage metadata and assets for a specific storage tab
+func (p *ProcessorImpl) GetStorageTabData(accountId uint32, worldId world.Id, tab byte) (StorageData, error) {
 	// Fetch storage with assets included
-	storageModel, err := requestStorageByAccountAndWorld(p.ctx, accountId, worldId)(p.l, p.ctx)
+	storageModel, err := requestStorageByAccountAndWorld(p.ctx, accountId, worldId, tab)(p.l, p.ctx)
 	if err != nil {
-		p.l.WithError(err).Debugf("Unable to get storage for account %d world %d, returning empty storage.", accountId, worldId)
+		p.l.WithError(err).Debugf("Unable to get storage tab %d for account %d world %d, returning empty storage.", tab, accountId, worldId)
 		// Storage might not exist yet - return empty storage
 		return StorageData{
 			Capacity: DefaultStorageCapacity,
@@ -112,6 +119,7 @@ func (p *ProcessorImpl) GetProjectionData(characterId uint32) (ProjectionData, e
 		Capacity:     byte(projModel.Capacity),
 		Mesos:        projModel.Mesos,
 		NpcId:        projModel.NpcId,
+		Tab:          projModel.Tab,
 		Compartments: compartments,
 	}, nil
 }
@@ -164,10 +172,10 @@ func transformAsset(a AssetRestModel) asset.Model {
 		MustBuild()
 }
 
-// Arrange sends an ARRANGE command to the storage service to merge and sort items
-func (p *ProcessorImpl) Arrange(worldId world.Id, accountId uint32) error {
-	p.l.Debugf("Sending ARRANGE command for storage account [%d] world [%d].", accountId, worldId)
-	return producer.ProviderImpl(p.l)(p.ctx)(storage.EnvCommandTopic)(ArrangeCommandProvider(worldId, accountId, uuid.New()))
+// Arrange sends an ARRANGE command to the storage service to merge and sort items in a storage tab
+func (p *ProcessorImpl) Arrange(worldId world.Id, accountId uint32, tab byte) error {
+	p.l.Debugf("Sending ARRANGE command for storage account [%d] world [%d] tab [%d].", accountId, worldId, tab)
+	return producer.ProviderImpl(p.l)(p.ctx)(storage.EnvCommandTopic)(ArrangeCommandProvider(worldId, accountId, tab, uuid.New()))
 }
 
 // DepositMesos sends an UPDATE_MESOS command to add mesos to storage
@@ -12,14 +12,14 @@ import (
 )
 
 // ArrangeCommandProvider creates an ARRANGE command for the storage service
-func ArrangeCommandProvider(worldId world.Id, accountId uint32, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+func ArrangeCommandProvider(worldId world.Id, accountId uint32, tab byte, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(accountId))
 	value := &storage.Command[storage.ArrangeCommandBody]{
 		TransactionId: transactionId,
 		WorldId:       worldId,
 		AccountId:     accountId,
 		Type:          storage.CommandTypeArrange,
-		Body:          storage.ArrangeCommandBody{},
+		Body:          storage.ArrangeCommandBody{Tab: tab},
 	}
 	return producer.SingleMessageProvider(key, value)
 }
@@ -9,7 +9,7 @@ import (
 )
 
 const (
-	storageResource         = "storage/accounts/%d?worldId=%d"
+	storageResource         = "storage/accounts/%d?worldId=%d&tab=%d"
 	storageAssetsResource   = "storage/accounts/%d/assets?worldId=%d"
 	projectionResource      = "storage/projections/%d"
 	projectionAssetResource = "storage/projections/%d/compartments/%d/assets/%d"
@@ -19,12 +19,12 @@ func getBaseRequest(ctx context.Context) (string, error) {
 	return requests.RootUrlFor(ctx, "STORAGE")
 }
 
-func requestStorageByAccountAndWorld(ctx context.Context, accountId uint32, worldId world.Id) requests.Request[StorageRestModel] {
+func requestStorageByAccountAndWorld(ctx context.Context, accountId uint32, worldId world.Id, tab byte) requests.Request[StorageRestModel] {
 	root, err := getBaseRequest(ctx)
 	if err != nil {
 		return requests.ErrorRequest[StorageRestModel](err)
 	}
-	return requests.GetRequest[StorageRestModel](fmt.Sprintf(root+storageResource, accountId, worldId))
+	return requests.GetRequest[StorageRestModel](fmt.Sprintf(root+storageResource, accountId, worldId, tab))
 }
 
 func requestProjectionByCharacterId(ctx context.Context, characterId uint32) requests.Request[ProjectionRestModel] {
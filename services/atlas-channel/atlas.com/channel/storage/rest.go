@@ -153,6 +153,7 @@ type ProjectionRestModel struct {
 	Capacity     uint32                     `json:"capacity"`
 	Mesos        uint32                     `json:"mesos"`
 	NpcId        uint32                     `json:"npcId"`
+	Tab          byte                       `json:"tab"`
 	Compartments map[string]json.RawMessage `json:"compartments"`
 }
 
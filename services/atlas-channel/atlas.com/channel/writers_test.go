@@ -3,6 +3,8 @@ package main
 import (
 	"testing"
 
+	alliancecb "github.com/Chronicle20/atlas/libs/atlas-packet/alliance/clientbound"
+	familycb "github.com/Chronicle20/atlas/libs/atlas-packet/family/clientbound"
 	fieldcb "github.com/Chronicle20/atlas/libs/atlas-packet/field/clientbound"
 )
 
@@ -29,3 +31,43 @@ func TestProduceWriters_RegistersMtsWriters(t *testing.T) {
 		}
 	}
 }
+
+// TestProduceWriters_RegistersFamilyWriters pins every family writer a handler
+// or consumer announces, for the same reason as the MTS writers above.
+func TestProduceWriters_RegistersFamilyWriters(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, w := range produceWriters() {
+		registered[w] = true
+	}
+
+	for _, name := range []string{
+		familycb.FamilyChartResultWriter,
+		familycb.FamilyInfoResultWriter,
+		familycb.FamilyResultWriter,
+		familycb.FamilyJoinRequestWriter,
+		familycb.FamilyJoinRequestResultWriter,
+		familycb.FamilyJoinAcceptedWriter,
+		familycb.FamilyPrivilegeListWriter,
+		familycb.FamilyFamousPointIncResultWriter,
+		familycb.FamilyNotifyLoginOrLogoutWriter,
+		familycb.FamilySetPrivilegeWriter,
+		familycb.FamilySummonRequestWriter,
+	} {
+		if !registered[name] {
+			t.Errorf("produceWriters() must register writer [%s] or Announce fails with 'writer not found'", name)
+		}
+	}
+}
+
+// TestProduceWriters_RegistersAllianceWriters pins the alliance writer shared
+// by the alliance handler and the alliance and invite consumers.
+func TestProduceWriters_RegistersAllianceWriters(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, w := range produceWriters() {
+		registered[w] = true
+	}
+
+	if !registered[alliancecb.AllianceOperationWriter] {
+		t.Errorf("produceWriters() must register writer [%s] or Announce fails with 'writer not found'", alliancecb.AllianceOperationWriter)
+	}
+}
@@ -0,0 +1,82 @@
+package character
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// Filter narrows a character listing for bulk targeting (GM mail campaigns).
+// Every field is optional; a zero Filter lists every character in the tenant.
+//
+// AfterId is a keyset cursor: only characters with a greater id are listed.
+// Listings are ordered by id, so a caller walking a changing population pages
+// with AfterId rather than page[number] and never skips or repeats a row.
+//
+// ActiveFrom/ActiveTo select characters with a session overlapping the window,
+// read from session_history.
+type Filter struct {
+	WorldId    *world.Id
+	MinLevel   byte
+	MaxLevel   byte
+	ActiveFrom *time.Time
+	ActiveTo   *time.Time
+	AfterId    uint32
+}
+
+// Empty reports whether the filter constrains nothing.
+func (f Filter) Empty() bool {
+	return f.WorldId == nil && f.MinLevel == 0 && f.MaxLevel == 0 && f.ActiveFrom == nil && f.ActiveTo == nil && f.AfterId == 0
+}
+
+// ParseFilter reads the targeting filter from query parameters: worldId,
+// minLevel, maxLevel, activeFrom, activeTo (RFC3339) and afterId.
+func ParseFilter(q url.Values) (Filter, error) {
+	var f Filter
+	if v := q.Get("worldId"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return Filter{}, err
+		}
+		w := world.Id(id)
+		f.WorldId = &w
+	}
+	if v := q.Get("minLevel"); v != "" {
+		lvl, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return Filter{}, err
+		}
+		f.MinLevel = byte(lvl)
+	}
+	if v := q.Get("maxLevel"); v != "" {
+		lvl, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return Filter{}, err
+		}
+		f.MaxLevel = byte(lvl)
+	}
+	if v := q.Get("activeFrom"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return Filter{}, err
+		}
+		f.ActiveFrom = &t
+	}
+	if v := q.Get("activeTo"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return Filter{}, err
+		}
+		f.ActiveTo = &t
+	}
+	if v := q.Get("afterId"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return Filter{}, err
+		}
+		f.AfterId = uint32(id)
+	}
+	return f, nil
+}
@@ -96,6 +96,7 @@ type Processor interface {
 	GetForName(decorators ...model.Decorator[Model]) func(name string) ([]Model, error)
 	GetForNameProvider(page model.Page, decorators ...model.Decorator[Model]) func(name string) model.Provider[model.Paged[Model]]
 	AllProvider(page model.Page, decorators ...model.Decorator[Model]) model.Provider[model.Paged[Model]]
+	FilteredProvider(page model.Page, f Filter, decorators ...model.Decorator[Model]) model.Provider[model.Paged[Model]]
 	SkillModelDecorator(m Model) Model
 	IsValidName(name string) (bool, error)
 	CheckNameValidity(name string, worldId world.Id, scope NameScope) (NameValidityResult, error)
@@ -274,6 +275,13 @@ func (p *ProcessorImpl) AllProvider(page model.Page, decorators ...model.Decorat
 	return model.MapPaged(model.Decorate[Model](decorators))(mp)(model.ParallelMap())
 }
 
+// FilteredProvider lists one page of the characters matching f.
+func (p *ProcessorImpl) FilteredProvider(page model.Page, f Filter, decorators ...model.Decorator[Model]) model.Provider[model.Paged[Model]] {
+	ep := getFilteredPaged(p.t.Id(), f, page)(p.db.WithContext(p.ctx))
+	mp := model.MapPaged(modelFromEntity)(ep)(model.ParallelMap())
+	return model.MapPaged(model.Decorate[Model](decorators))(mp)(model.ParallelMap())
+}
+
 func (p *ProcessorImpl) SkillModelDecorator(m Model) Model {
 	ms, err := p.sp.GetByCharacterId(m.Id())
 	if err != nil {
@@ -3,6 +3,7 @@ package character
 import (
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
@@ -44,6 +45,41 @@ func getAll(page model.Page) database.EntityProvider[model.Paged[entity]] {
 	}
 }
 
+// getFilteredPaged lists the characters matching f. The session window is a
+// raw subquery, which the tenant callback does not reach, so it carries the
+// tenant id itself.
+func getFilteredPaged(tenantId uuid.UUID, f Filter, page model.Page) database.EntityProvider[model.Paged[entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[entity]] {
+		q := db
+		if f.WorldId != nil {
+			q = q.Where("world = ?", *f.WorldId)
+		}
+		if f.MinLevel > 0 {
+			q = q.Where("level >= ?", f.MinLevel)
+		}
+		if f.MaxLevel > 0 {
+			q = q.Where("level <= ?", f.MaxLevel)
+		}
+		if f.AfterId > 0 {
+			q = q.Where("id > ?", f.AfterId)
+		}
+		if f.ActiveFrom != nil || f.ActiveTo != nil {
+			sub := "SELECT character_id FROM session_history WHERE tenant_id = ?"
+			args := []interface{}{tenantId}
+			if f.ActiveTo != nil {
+				sub += " AND login_time <= ?"
+				args = append(args, *f.ActiveTo)
+			}
+			if f.ActiveFrom != nil {
+				sub += " AND (logout_time IS NULL OR logout_time >= ?)"
+				args = append(args, *f.ActiveFrom)
+			}
+			q = q.Where("id IN ("+sub+")", args...)
+		}
+		return database.PagedQuery[entity](q, page)
+	}
+}
+
 func getForAccountInWorldPaged(accountId uint32, worldId world.Id, page model.Page) database.EntityProvider[model.Paged[entity]] {
 	return func(db *gorm.DB) model.Provider[model.Paged[entity]] {
 		return database.PagedQuery[entity](db.Where("account_id = ? AND world = ?", accountId, worldId), page)
@@ -55,7 +55,19 @@ func handleGetCharacters(d *rest.HandlerDependency, c *rest.HandlerContext) http
 			return
 		}
 
-		paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).AllProvider(page, decoratorsFromInclude(r, d, c)...)()
+		f, err := ParseFilter(r.URL.Query())
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, err.Error())
+			return
+		}
+
+		cp := NewProcessor(d.Logger(), d.Context(), d.DB())
+		var paged model.Paged[Model]
+		if f.Empty() {
+			paged, err = cp.AllProvider(page, decoratorsFromInclude(r, d, c)...)()
+		} else {
+			paged, err = cp.FilteredProvider(page, f, decoratorsFromInclude(r, d, c)...)()
+		}
 		if err != nil {
 			d.Logger().WithError(err).Errorf("Unable to get characters.")
 			server.WriteErrorResponse(d.Logger())(w)(err)
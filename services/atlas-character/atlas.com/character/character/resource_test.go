@@ -1,11 +1,13 @@
 package character
 
 import (
+	"atlas-character/session/history"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
@@ -278,3 +280,55 @@ func TestGetCharacterDoesNotServeZeroValueOn200(t *testing.T) {
 		}
 	})
 }
+
+// TestGetCharactersFiltersForTargeting drives the bulk-targeting filters on
+// GET /characters: world, level range, session window and the afterId cursor.
+func TestGetCharactersFiltersForTargeting(t *testing.T) {
+	setupResourceTestRegistry(t)
+
+	db := databasetest.NewInMemoryTenantDB(t, Migration, history.Migration)
+	tenantId := uuid.New()
+	seed := func(id uint32, worldId world.Id, level byte) {
+		require.NoError(t, db.Create(&entity{ID: id, TenantId: tenantId, AccountId: 100 + id, World: worldId, Name: fmt.Sprintf("hero%d", id), Level: level}).Error)
+	}
+	seed(1, 0, 10)
+	seed(2, 0, 50)
+	seed(3, 1, 50)
+	seed(4, 0, 120)
+
+	windowStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	out := windowStart.Add(2 * time.Hour)
+	require.NoError(t, db.Exec("INSERT INTO session_history (tenant_id, character_id, world_id, channel_id, login_time, logout_time) VALUES (?, ?, 0, 0, ?, ?)", tenantId, 2, windowStart.Add(time.Hour), out).Error)
+	require.NoError(t, db.Exec("INSERT INTO session_history (tenant_id, character_id, world_id, channel_id, login_time, logout_time) VALUES (?, ?, 0, 0, ?, ?)", tenantId, 4, windowStart.Add(-48*time.Hour), windowStart.Add(-47*time.Hour)).Error)
+
+	srv := httptest.NewServer(setupCharacterResourceRouter(db))
+	defer srv.Close()
+
+	ids := func(t *testing.T, query string) []string {
+		t.Helper()
+		req := resourceRequestWithTenant(http.MethodGet, srv.URL+"/characters?"+query, tenantId)
+		resp, err := (&http.Client{}).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var doc jsonapi.Document
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+		var out []string
+		for _, d := range doc.Data.DataArray {
+			out = append(out, d.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"1", "2", "4"}, ids(t, "worldId=0"))
+	assert.Equal(t, []string{"2", "3"}, ids(t, "minLevel=30&maxLevel=100"))
+	assert.Equal(t, []string{"2"}, ids(t, "activeFrom=2026-01-01T00:00:00Z&activeTo=2026-01-02T00:00:00Z"))
+	assert.Equal(t, []string{"3", "4"}, ids(t, "afterId=2"))
+
+	req := resourceRequestWithTenant(http.MethodGet, srv.URL+"/characters?minLevel=abc", tenantId)
+	resp, err := (&http.Client{}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
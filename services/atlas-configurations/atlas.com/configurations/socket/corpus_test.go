@@ -60,7 +60,7 @@ func TestValidate_AcceptsEverySeedTemplate(t *testing.T) {
 			}
 		}
 	}
-	if total != 3317 {
-		t.Errorf("corpus size = %d entries, want 3317 (3052 before task-206, plus task-206's 10 CashShopCouponCodeHandle bindings — every template but gms_12 — plus task-207's 7 CashItemGachaponHandle handlers and 6 CashItemGachaponResult writers — plus task-210's 16 template bindings (CharacterUseDeathItemHandle handler and CharacterShowUpgradeTombEffect writer in 8 templates) and 2 v92 writers (CharacterEffect and CharacterEffectForeign) — plus task-212's 15 catch bindings — plus task-211's 30 kite writer bindings (SpawnKite, SpawnKiteError and DestroyKite on every template but gms_12) — plus task-213's 1 gms_92 CharacterSkillPrepareHandle binding, the only template that lacked it — plus task-217's 12 Aran combo bindings (AranComboCounterHandle handler and ShowCombo writer on gms_83/84/87/92/95 and jms_185) — plus task-218's 3 CharacterKeyMapChangeHandle bindings on gms_87/gms_92/jms_185, the three templates that lacked it and where keybinds therefore never saved — plus task-221's 7 npc-shop bindings (NPCShopHandle handler on gms_87/92/95, plus the NPCShop and NPCShopOperation writers on gms_48 and gms_92, the two templates that lacked them) — plus task-226's 6 skill-macro bindings (CharacterSkillMacroHandle handler on gms_61, gms_87, gms_92, gms_95 and jms_185 — gms_61 included because task-226 corrected SKILL_MACRO x gms_v61 off n-a, having located CMacroSysMan::FlushToSvr at 0x59746c sending opcode 101 — plus the CharacterSkillMacro writer on gms_92) — plus task-224's 10 PetNameChanged writer bindings, one on every template but gms_12, carrying the CPet::OnNameChanged broadcast for the pet name tag) — plus task-230's 17 scripted-item bindings (ScriptedItemHandle on the 8 templates whose client carries SCRIPTED_ITEM — every template but gms_12, gms_48 and gms_61, the three where CWvsContext::SendScriptRunItemRequest does not exist — plus NpcItemUseHandle on the 9 templates carrying NPC_ITEM_USE_REQUEST, every template but gms_12 and gms_48, gms_61 included because task-230 located CWvsContext::SendSelectNpcItemUseRequest at 0x83778d there sending opcode 0x066) — plus task-228's 5 WaterOfLifeHandle handler bindings on gms_83/84/87/92/95, the five templates whose client sends the WATER_OF_LIFE opcode; the other six are n-a — plus the same task's 6 PetDestroyItemHandle bindings on gms_83/84/87/92/95 and jms_185, the six templates whose client sends DESTROY_PET_ITEM_REQUEST for a dried-up noRevive pet — plus task-227's 67 cash-shop name-change/world-transfer bindings: CashShopCheckNameChangePossibleHandle handler and CashShopCheckNameChange writer on every template but gms_12 and jms_185 (9 each); CashShopCheckTransferWorldPossibleHandle handler and CashShopCheckTransferWorldPossibleResult writer on every template but gms_12 (10 each); CashShopCancelNameChangeResult and CashShopCancelTransferWorldResult writers on every template but gms_12/gms_48 (8 each); CancelNameChangeByOther writer on every template but gms_12/gms_48/gms_61 (7); CashShopCheckNameChangePossibleResult writer on gms_79/83/84/87/92/95 (6) — plus this task's 9 CashShopCheckNameChangeHandle bindings, one on each GMS template (gms_48 at 0x11, the other eight at 0x15): the channel-scoped half of CHECK_CHAR_NAME, whose opcode the client uses for BOTH CLogin::SendCheckDuplicateIDPacket and CCashShop::SendCheckDuplicateIDPacket, so the two bindings coexist at one opcode with disjoint services. jms_185 is excluded — it has no name-change feature at all — plus task-229's 12 item-use bindings: CharacterItemUseSummonBagHandle and CharacterItemUseTownScrollHandle on gms_87/92/95 and jms_185, the four templates that lacked them (8); plus gms_48's new CharacterItemUseHandle at 0x38, where the pre-existing 0x41 entry was rebound from CharacterItemUseHandle to CharacterItemUseTownScrollHandle against CWvsContext::SendPortalScrollUseRequest, so gms_48 is a net +1; plus gms_92's CharacterItemUseHandle, CharacterItemUseScrollHandle and PetFoodHandle (3), the ordinary item-use hole that made potions and scrolls inert on that column — plus task-225's 24 dragon bindings (the DragonMoveHandle handler plus the DragonSpawn, DragonMove and DragonRemove writers on gms_83/84/87/92/95 and jms_185, the six templates whose client has a CDragon))", total)
+	if total != 3446 {
+		t.Errorf("corpus size = %d entries, want 3446 (3052 before task-206, plus task-206's 10 CashShopCouponCodeHandle bindings — every template but gms_12 — plus task-207's 7 CashItemGachaponHandle handlers and 6 CashItemGachaponResult writers — plus task-210's 16 template bindings (CharacterUseDeathItemHandle handler and CharacterShowUpgradeTombEffect writer in 8 templates) and 2 v92 writers (CharacterEffect and CharacterEffectForeign) — plus task-212's 15 catch bindings — plus task-211's 30 kite writer bindings (SpawnKite, SpawnKiteError and DestroyKite on every template but gms_12) — plus task-213's 1 gms_92 CharacterSkillPrepareHandle binding, the only template that lacked it — plus task-217's 12 Aran combo bindings (AranComboCounterHandle handler and ShowCombo writer on gms_83/84/87/92/95 and jms_185) — plus task-218's 3 CharacterKeyMapChangeHandle bindings on gms_87/gms_92/jms_185, the three templates that lacked it and where keybinds therefore never saved — plus task-221's 7 npc-shop bindings (NPCShopHandle handler on gms_87/92/95, plus the NPCShop and NPCShopOperation writers on gms_48 and gms_92, the two templates that lacked them) — plus task-226's 6 skill-macro bindings (CharacterSkillMacroHandle handler on gms_61, gms_87, gms_92, gms_95 and jms_185 — gms_61 included because task-226 corrected SKILL_MACRO x gms_v61 off n-a, having located CMacroSysMan::FlushToSvr at 0x59746c sending opcode 101 — plus the CharacterSkillMacro writer on gms_92) — plus task-224's 10 PetNameChanged writer bindings, one on every template but gms_12, carrying the CPet::OnNameChanged broadcast for the pet name tag) — plus task-230's 17 scripted-item bindings (ScriptedItemHandle on the 8 templates whose client carries SCRIPTED_ITEM — every template but gms_12, gms_48 and gms_61, the three where CWvsContext::SendScriptRunItemRequest does not exist — plus NpcItemUseHandle on the 9 templates carrying NPC_ITEM_USE_REQUEST, every template but gms_12 and gms_48, gms_61 included because task-230 located CWvsContext::SendSelectNpcItemUseRequest at 0x83778d there sending opcode 0x066) — plus task-228's 5 WaterOfLifeHandle handler bindings on gms_83/84/87/92/95, the five templates whose client sends the WATER_OF_LIFE opcode; the other six are n-a — plus the same task's 6 PetDestroyItemHandle bindings on gms_83/84/87/92/95 and jms_185, the six templates whose client sends DESTROY_PET_ITEM_REQUEST for a dried-up noRevive pet — plus task-227's 67 cash-shop name-change/world-transfer bindings: CashShopCheckNameChangePossibleHandle handler and CashShopCheckNameChange writer on every template but gms_12 and jms_185 (9 each); CashShopCheckTransferWorldPossibleHandle handler and CashShopCheckTransferWorldPossibleResult writer on every template but gms_12 (10 each); CashShopCancelNameChangeResult and CashShopCancelTransferWorldResult writers on every template but gms_12/gms_48 (8 each); CancelNameChangeByOther writer on every template but gms_12/gms_48/gms_61 (7); CashShopCheckNameChangePossibleResult writer on gms_79/83/84/87/92/95 (6) — plus this task's 9 CashShopCheckNameChangeHandle bindings, one on each GMS template (gms_48 at 0x11, the other eight at 0x15): the channel-scoped half of CHECK_CHAR_NAME, whose opcode the client uses for BOTH CLogin::SendCheckDuplicateIDPacket and CCashShop::SendCheckDuplicateIDPacket, so the two bindings coexist at one opcode with disjoint services. jms_185 is excluded — it has no name-change feature at all — plus task-229's 12 item-use bindings: CharacterItemUseSummonBagHandle and CharacterItemUseTownScrollHandle on gms_87/92/95 and jms_185, the four templates that lacked them (8); plus gms_48's new CharacterItemUseHandle at 0x38, where the pre-existing 0x41 entry was rebound from CharacterItemUseHandle to CharacterItemUseTownScrollHandle against CWvsContext::SendPortalScrollUseRequest, so gms_48 is a net +1; plus gms_92's CharacterItemUseHandle, CharacterItemUseScrollHandle and PetFoodHandle (3), the ordinary item-use hole that made potions and scrolls inert on that column — plus task-225's 24 dragon bindings (the DragonMoveHandle handler plus the DragonSpawn, DragonMove and DragonRemove writers on gms_83/84/87/92/95 and jms_185, the six templates whose client has a CDragon) — plus user-031's 100 family bindings (the FamilyChartRequest, FamilyInfoRequest, FamilyInviteResult, FamilyRegisterJunior, FamilySetPrecept, FamilySummonResponse, FamilyUnregisterJunior, FamilyUnregisterParent and FamilyUsePrivilege handlers and the FamilyChartResult, FamilyFamousPointIncResult, FamilyInfoResult, FamilyJoinAccepted, FamilyJoinRequest, FamilyJoinRequestResult, FamilyNotifyLoginOrLogout, FamilyPrivilegeList, FamilyResult, FamilySetPrivilege and FamilySummonRequest writers on gms_83/87/92/95 and jms_185) — plus user-033's 12 party-search bindings (PartySearchStartHandle and PartySearchStopHandle on gms_83/84/87/92/95 and jms_185) — plus user-032's 17 alliance bindings (two AllianceOperationHandle opcodes and the AllianceOperation writer on gms_83/87/92/95 and jms_185, plus AllianceInviteRejectHandle on gms_83/87))", total)
 	}
 }
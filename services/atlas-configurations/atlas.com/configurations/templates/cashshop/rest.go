@@ -2,10 +2,12 @@ package cashshop
 
 import (
 	"atlas-configurations/templates/cashshop/commodities"
+	"atlas-configurations/templates/cashshop/equipslots"
 	"atlas-configurations/templates/cashshop/surprise"
 )
 
 type RestModel struct {
 	Commodities commodities.RestModel `json:"commodities"`
 	Surprise    surprise.RestModel    `json:"surprise"`
+	EquipSlots  equipslots.RestModel  `json:"equipSlots"`
 }
@@ -0,0 +1,16 @@
+package equipslots
+
+// RestModel maps equip slot expansion cash items to the equip slot each one
+// unlocks. An empty list leaves atlas-cashshop on its stock pendant slot
+// expansion item.
+type RestModel struct {
+	Items []ItemRestModel `json:"items,omitempty"`
+}
+
+// ItemRestModel names the slot type (e.g. "pendant2") unlocked by TemplateId.
+// Days overrides the commodity's period; zero defers to the commodity.
+type ItemRestModel struct {
+	TemplateId uint32 `json:"templateId"`
+	Slot       string `json:"slot"`
+	Days       uint32 `json:"days"`
+}
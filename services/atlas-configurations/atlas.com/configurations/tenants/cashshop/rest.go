@@ -2,10 +2,12 @@ package cashshop
 
 import (
 	"atlas-configurations/tenants/cashshop/commodities"
+	"atlas-configurations/tenants/cashshop/equipslots"
 	"atlas-configurations/tenants/cashshop/surprise"
 )
 
 type RestModel struct {
 	Commodities commodities.RestModel `json:"commodities"`
 	Surprise    surprise.RestModel    `json:"surprise"`
+	EquipSlots  equipslots.RestModel  `json:"equipSlots"`
 }
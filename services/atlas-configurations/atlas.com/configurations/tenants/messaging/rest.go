@@ -0,0 +1,8 @@
+package messaging
+
+// RestModel holds the tenant's chat and messaging options.
+type RestModel struct {
+	// OfflineWhisperNotes stores a whisper as a note when the recipient is
+	// offline, instead of reporting it undeliverable.
+	OfflineWhisperNotes bool `json:"offlineWhisperNotes"`
+}
@@ -65,8 +65,9 @@ const (
 	// uint16), which is narrower than the maxInt32 transport bound below and
 	// so is safe to use directly.
 	maxUint16 = math.MaxUint16
-	// getEffect multiplies a non-negative `time` by 1000 to convert wz
-	// seconds to milliseconds; bound it so that product still fits int32.
+	// getEffect multiplies a non-negative `time` and `cooltime` by 1000 to
+	// convert wz seconds to milliseconds; bound them so that product still
+	// fits int32.
 	maxTimeSeconds = math.MaxInt32 / 1000
 )
 
@@ -89,7 +90,7 @@ var commonKeys = []commonKeyDef{
 	{name: "mpCon", kind: commonExpr, min: 0, max: maxUint16},
 	{name: "prop", kind: commonExpr, min: minInt32, max: maxInt32},
 	{name: "mobCount", kind: commonExpr, min: 0, max: maxInt32},
-	{name: "cooltime", kind: commonExpr, min: 0, max: maxInt32},
+	{name: "cooltime", kind: commonExpr, min: 0, max: maxTimeSeconds},
 	{name: "morph", kind: commonExpr, min: 0, max: maxInt32},
 	{name: "pad", kind: commonExpr, min: minInt16, max: maxInt16},
 	{name: "pdd", kind: commonExpr, min: minInt16, max: maxInt16},
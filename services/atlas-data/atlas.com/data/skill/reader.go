@@ -195,7 +195,6 @@ func getEffect(t tenant.Model, skillId skill.Id, overTime bool, node xml.Node) e
 		SetCureAbnormalStatuses(getAbnormalStatuses(node)).
 		SetNuffSkill(uint32(node.GetIntegerWithDefault("nuffSkill", 0))).
 		SetMobCount(uint32(node.GetIntegerWithDefault("mobCount", 1))).
-		SetCooldown(uint32(node.GetIntegerWithDefault("cooltime", 0))).
 		SetMorphId(uint32(node.GetIntegerWithDefault("morph", 0))).
 		SetGhost(uint32(node.GetIntegerWithDefault("ghost", 0))).
 		SetFatigue(uint32(node.GetIntegerWithDefault("incFatigue", 0))).
@@ -208,6 +207,9 @@ func getEffect(t tenant.Model, skillId skill.Id, overTime bool, node xml.Node) e
 	// Why ms: the wz `time` attribute is in seconds; convert here so
 	// downstream consumers (atlas-buffs, atlas-monsters) interpret
 	// effect.Duration() uniformly as time.Millisecond. See task-054.
+	// `cooltime` is WZ seconds as well, converted here for the same reason:
+	// atlas-skills and atlas-channel read effect.Cooldown() as milliseconds.
+	e.SetCooldown(uint32(node.GetIntegerWithDefault("cooltime", 0)) * 1000)
 	if e.Duration() > -1 {
 		e.SetDuration(e.Duration() * 1000)
 		e.SetOverTime(true)
@@ -2833,8 +2833,8 @@ func TestReader(t *testing.T) {
 	if ef.X != 4 {
 		t.Fatalf("rm.Effects[0].X = %d, want 4", ef.X)
 	}
-	if ef.Cooldown != 120 {
-		t.Fatalf("rm.Effects[0].Cooldown = %d, want 120", ef.Cooldown)
+	if ef.Cooldown != 120000 {
+		t.Fatalf("rm.Effects[0].Cooldown = %d, want 120000", ef.Cooldown)
 	}
 	ef = rm.Effects[1]
 	if ef.MPConsume != 10 {
@@ -2846,8 +2846,8 @@ func TestReader(t *testing.T) {
 	if ef.X != 8 {
 		t.Fatalf("rm.Effects[1].X = %d, want 8", ef.X)
 	}
-	if ef.Cooldown != 120 {
-		t.Fatalf("rm.Effects[1].Cooldown = %d, want 120", ef.Cooldown)
+	if ef.Cooldown != 120000 {
+		t.Fatalf("rm.Effects[1].Cooldown = %d, want 120000", ef.Cooldown)
 	}
 	ef = rm.Effects[2]
 	if ef.MPConsume != 15 {
@@ -2859,8 +2859,8 @@ func TestReader(t *testing.T) {
 	if ef.X != 12 {
 		t.Fatalf("rm.Effects[2].X = %d, want 12", ef.X)
 	}
-	if ef.Cooldown != 120 {
-		t.Fatalf("rm.Effects[2].Cooldown = %d, want 120", ef.Cooldown)
+	if ef.Cooldown != 120000 {
+		t.Fatalf("rm.Effects[2].Cooldown = %d, want 120000", ef.Cooldown)
 	}
 
 	if rm, ok = rmm[strconv.Itoa(1002)]; !ok {
@@ -2879,8 +2879,8 @@ func TestReader(t *testing.T) {
 	if ef.Speed != 10 {
 		t.Fatalf("rm.Effects[0].Speed = %d, want 10", ef.Speed)
 	}
-	if ef.Cooldown != 60 {
-		t.Fatalf("rm.Effects[0].Cooldown = %d, want 60", ef.Cooldown)
+	if ef.Cooldown != 60000 {
+		t.Fatalf("rm.Effects[0].Cooldown = %d, want 60000", ef.Cooldown)
 	}
 	ef = rm.Effects[1]
 	if ef.MPConsume != 7 {
@@ -2892,8 +2892,8 @@ func TestReader(t *testing.T) {
 	if ef.Speed != 15 {
 		t.Fatalf("rm.Effects[1].Speed = %d, want 15", ef.Speed)
 	}
-	if ef.Cooldown != 60 {
-		t.Fatalf("rm.Effects[1].Cooldown = %d, want 60", ef.Cooldown)
+	if ef.Cooldown != 60000 {
+		t.Fatalf("rm.Effects[1].Cooldown = %d, want 60000", ef.Cooldown)
 	}
 	ef = rm.Effects[2]
 	if ef.MPConsume != 10 {
@@ -2905,8 +2905,8 @@ func TestReader(t *testing.T) {
 	if ef.Speed != 20 {
 		t.Fatalf("rm.Effects[2].Speed = %d, want 20", ef.Speed)
 	}
-	if ef.Cooldown != 60 {
-		t.Fatalf("rm.Effects[2].Cooldown = %d, want 60", ef.Cooldown)
+	if ef.Cooldown != 60000 {
+		t.Fatalf("rm.Effects[2].Cooldown = %d, want 60000", ef.Cooldown)
 	}
 }
 
@@ -1,9 +1,18 @@
 package character
 
 type Model struct {
-	id    uint32
-	name  string
-	level byte
+	id        uint32
+	accountId uint32
+	name      string
+	level     byte
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) AccountId() uint32 {
+	return m.accountId
 }
 
 func (m Model) Name() string {
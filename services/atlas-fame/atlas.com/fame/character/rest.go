@@ -7,9 +7,10 @@ import (
 )
 
 type RestModel struct {
-	Id    uint32 `json:"-"`
-	Name  string `json:"name"`
-	Level byte   `json:"level"`
+	Id        uint32 `json:"-"`
+	AccountId uint32 `json:"accountId"`
+	Name      string `json:"name"`
+	Level     byte   `json:"level"`
 }
 
 func (r RestModel) GetName() string {
@@ -67,8 +68,9 @@ func (r *RestModel) SetReferencedStructs(_ map[string]map[string]jsonapi.Data) e
 
 func Extract(rm RestModel) (Model, error) {
 	return Model{
-		id:    rm.Id,
-		name:  rm.Name,
-		level: rm.Level,
+		id:        rm.Id,
+		accountId: rm.AccountId,
+		name:      rm.Name,
+		level:     rm.Level,
 	}, nil
 }
@@ -0,0 +1,76 @@
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+// The classic rules, applied when a tenant has no fame configuration or
+// leaves a field at zero.
+const (
+	DefaultDailyLimit         = 1
+	DefaultTargetCooldownDays = 30
+	DefaultMinimumLevel       = 15
+)
+
+const byTenant = "tenants/%s/configurations/fame-configs"
+
+// Limits are the rules a fame change is checked against.
+type Limits struct {
+	DailyLimit         int
+	TargetCooldownDays int
+	MinimumLevel       byte
+}
+
+// DefaultLimits returns the classic rules.
+func DefaultLimits() Limits {
+	return Limits{
+		DailyLimit:         DefaultDailyLimit,
+		TargetCooldownDays: DefaultTargetCooldownDays,
+		MinimumLevel:       DefaultMinimumLevel,
+	}
+}
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "TENANTS")
+}
+
+func requestByTenantId(ctx context.Context, tenantId uuid.UUID) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.GetRequest[RestModel](fmt.Sprintf(root+byTenant, tenantId))
+}
+
+// GetLimits resolves the tenant's fame limits. Missing config (404) is the
+// expected unconfigured state; any other error is logged. Both fall back to
+// the defaults, as does any field left at zero.
+func GetLimits(l logrus.FieldLogger, ctx context.Context) func(tenantId uuid.UUID) Limits {
+	return func(tenantId uuid.UUID) Limits {
+		result := DefaultLimits()
+		rm, err := requestByTenantId(ctx, tenantId)(l, ctx)
+		if err != nil {
+			if !errors.Is(err, requests.ErrNotFound) {
+				l.WithError(err).Warnf("Unable to read fame configuration for tenant [%s]; using default limits.", tenantId)
+			}
+			return result
+		}
+		if rm.DailyLimit > 0 {
+			result.DailyLimit = rm.DailyLimit
+		}
+		if rm.TargetCooldownDays > 0 {
+			result.TargetCooldownDays = rm.TargetCooldownDays
+		}
+		if rm.MinimumLevel > 0 && rm.MinimumLevel <= 255 {
+			result.MinimumLevel = byte(rm.MinimumLevel)
+		}
+		return result
+	}
+}
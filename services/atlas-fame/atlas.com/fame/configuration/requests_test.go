@@ -0,0 +1,69 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func testCtx(t *testing.T) context.Context {
+	t.Helper()
+	tm, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatalf("tenant: %v", err)
+	}
+	return tenant.WithContext(context.Background(), tm)
+}
+
+func serve(t *testing.T, tenantId uuid.UUID, status int, body string) {
+	t.Helper()
+	wantPath := "/tenants/" + tenantId.String() + "/configurations/fame-configs"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Errorf("unexpected path %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("TENANTS_SERVICE_URL", srv.URL+"/")
+}
+
+func TestGetLimitsConfigured(t *testing.T) {
+	tenantId := uuid.New()
+	serve(t, tenantId, http.StatusOK, `{"data":{"type":"fame-configs","id":"`+tenantId.String()+`","attributes":{"dailyLimit":3,"targetCooldownDays":7,"minimumLevel":10}}}`)
+
+	got := GetLimits(logrus.New(), testCtx(t))(tenantId)
+	want := Limits{DailyLimit: 3, TargetCooldownDays: 7, MinimumLevel: 10}
+	if got != want {
+		t.Fatalf("limits = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetLimitsDefaultsOn404(t *testing.T) {
+	tenantId := uuid.New()
+	serve(t, tenantId, http.StatusNotFound, ``)
+
+	got := GetLimits(logrus.New(), testCtx(t))(tenantId)
+	if got != DefaultLimits() {
+		t.Fatalf("limits = %+v, want defaults %+v", got, DefaultLimits())
+	}
+}
+
+func TestGetLimitsDefaultsZeroFields(t *testing.T) {
+	tenantId := uuid.New()
+	serve(t, tenantId, http.StatusOK, `{"data":{"type":"fame-configs","id":"x","attributes":{"dailyLimit":2,"targetCooldownDays":0,"minimumLevel":0}}}`)
+
+	got := GetLimits(logrus.New(), testCtx(t))(tenantId)
+	want := Limits{DailyLimit: 2, TargetCooldownDays: DefaultTargetCooldownDays, MinimumLevel: DefaultMinimumLevel}
+	if got != want {
+		t.Fatalf("limits = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,23 @@
+package configuration
+
+// RestModel is the fame configuration resource served by atlas-tenants at
+// /tenants/{tenantId}/configurations/fame-configs.
+type RestModel struct {
+	Id                 string `json:"-"`
+	DailyLimit         int    `json:"dailyLimit"`
+	TargetCooldownDays int    `json:"targetCooldownDays"`
+	MinimumLevel       int    `json:"minimumLevel"`
+}
+
+func (r RestModel) GetName() string {
+	return "fame-configs"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+func (r *RestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
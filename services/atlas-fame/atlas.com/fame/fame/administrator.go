@@ -7,18 +7,20 @@ import (
 	"gorm.io/gorm"
 )
 
-func create(db *gorm.DB, tenantId uuid.UUID, characterId uint32, targetId uint32, amount int8) (Model, error) {
+func create(db *gorm.DB, tenantId uuid.UUID, characterId uint32, giverAccountId uint32, targetId uint32, targetAccountId uint32, amount int8) (Model, error) {
 	_, err := NewBuilder(tenantId, characterId, targetId, amount).Build()
 	if err != nil {
 		return Model{}, err
 	}
 
 	e := &Entity{
-		TenantId:    tenantId,
-		CharacterId: characterId,
-		TargetId:    targetId,
-		Amount:      amount,
-		CreatedAt:   time.Now(),
+		TenantId:        tenantId,
+		CharacterId:     characterId,
+		TargetId:        targetId,
+		GiverAccountId:  giverAccountId,
+		TargetAccountId: targetAccountId,
+		Amount:          amount,
+		CreatedAt:       time.Now(),
 	}
 
 	err = db.Create(e).Error
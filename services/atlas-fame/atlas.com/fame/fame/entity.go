@@ -12,12 +12,14 @@ func Migration(db *gorm.DB) error {
 }
 
 type Entity struct {
-	TenantId    uuid.UUID `gorm:"not null"`
-	Id          uuid.UUID `gorm:"type:uuid;primaryKey"`
-	CharacterId uint32    `gorm:"not null"`
-	TargetId    uint32    `gorm:"not null"`
-	Amount      int8      `gorm:"not null"`
-	CreatedAt   time.Time `gorm:"not null"`
+	TenantId        uuid.UUID `gorm:"not null"`
+	Id              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CharacterId     uint32    `gorm:"not null"`
+	TargetId        uint32    `gorm:"not null"`
+	GiverAccountId  uint32    `gorm:"not null;default:0"`
+	TargetAccountId uint32    `gorm:"not null;default:0"`
+	Amount          int8      `gorm:"not null"`
+	CreatedAt       time.Time `gorm:"not null"`
 }
 
 func (e *Entity) BeforeCreate(_ *gorm.DB) error {
@@ -33,11 +35,13 @@ func (e Entity) TableName() string {
 
 func Make(e Entity) (Model, error) {
 	return Model{
-		tenantId:    e.TenantId,
-		id:          e.Id,
-		characterId: e.CharacterId,
-		targetId:    e.TargetId,
-		amount:      e.Amount,
-		createdAt:   e.CreatedAt,
+		tenantId:        e.TenantId,
+		id:              e.Id,
+		characterId:     e.CharacterId,
+		targetId:        e.TargetId,
+		giverAccountId:  e.GiverAccountId,
+		targetAccountId: e.TargetAccountId,
+		amount:          e.Amount,
+		createdAt:       e.CreatedAt,
 	}, nil
 }
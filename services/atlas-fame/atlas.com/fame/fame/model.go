@@ -7,12 +7,14 @@ import (
 )
 
 type Model struct {
-	tenantId    uuid.UUID
-	id          uuid.UUID
-	characterId uint32
-	targetId    uint32
-	amount      int8
-	createdAt   time.Time
+	tenantId        uuid.UUID
+	id              uuid.UUID
+	characterId     uint32
+	targetId        uint32
+	giverAccountId  uint32
+	targetAccountId uint32
+	amount          int8
+	createdAt       time.Time
 }
 
 func (m Model) TenantId() uuid.UUID {
@@ -31,6 +33,17 @@ func (m Model) TargetId() uint32 {
 	return m.targetId
 }
 
+// GiverAccountId is the account of the character who gave the fame. Logs
+// written before accounts were recorded carry 0.
+func (m Model) GiverAccountId() uint32 {
+	return m.giverAccountId
+}
+
+// TargetAccountId is the account of the character who received the fame.
+func (m Model) TargetAccountId() uint32 {
+	return m.targetAccountId
+}
+
 func (m Model) Amount() int8 {
 	return m.amount
 }
@@ -38,3 +51,29 @@ func (m Model) Amount() int8 {
 func (m Model) CreatedAt() time.Time {
 	return m.createdAt
 }
+
+// Direction narrows a character's fame history to one side of the log.
+type Direction string
+
+const (
+	DirectionAll      Direction = ""
+	DirectionGiven    Direction = "given"
+	DirectionReceived Direction = "received"
+)
+
+// AccountPair totals the fame one account's characters gave another
+// account's characters. Many changes between the same two accounts, or any
+// between characters of one account, point at alts famming each other.
+type AccountPair struct {
+	GiverAccountId  uint32
+	TargetAccountId uint32
+	Count           int64
+	Givers          int64
+	Targets         int64
+	Net             int64
+}
+
+// SameAccount reports whether the fame stayed within one account.
+func (p AccountPair) SameAccount() bool {
+	return p.GiverAccountId == p.TargetAccountId
+}
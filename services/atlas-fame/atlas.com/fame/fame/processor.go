@@ -2,6 +2,7 @@ package fame
 
 import (
 	"atlas-fame/character"
+	"atlas-fame/configuration"
 	"atlas-fame/kafka/message"
 	messageFame "atlas-fame/kafka/message/fame"
 	"context"
@@ -39,7 +40,12 @@ type Processor interface {
 	// ByCharacterIdLastMonthProvider returns a provider for fame logs for a character in the last month
 	ByCharacterIdLastMonthProvider(characterId uint32) model.Provider[[]Model]
 
-	// RequestChange requests a fame change
+	// HistoryProvider pages a character's fame log, newest first
+	HistoryProvider(characterId uint32, direction Direction, page model.Page) model.Provider[model.Paged[Model]]
+	// AccountPairsProvider totals fame exchanged between accounts since a time, for spotting alts famming each other
+	AccountPairsProvider(since time.Time, minCount int64) model.Provider[[]AccountPair]
+
+	// RequestChange requests a fame change, checked against the tenant's fame limits
 	RequestChange(mb *message.Buffer) func(transactionId uuid.UUID) func(field field.Model) func(characterId uint32) func(targetId uint32) func(amount int8) error
 	// RequestChangeAndEmit requests a fame change and emits a message
 	RequestChangeAndEmit(transactionId uuid.UUID, field field.Model, characterId uint32, targetId uint32, amount int8) error
@@ -49,25 +55,27 @@ type Processor interface {
 }
 
 type ProcessorImpl struct {
-	l   logrus.FieldLogger
-	ctx context.Context
-	db  *gorm.DB
-	t   tenant.Model
+	l      logrus.FieldLogger
+	ctx    context.Context
+	db     *gorm.DB
+	t      tenant.Model
+	limits func(tenantId uuid.UUID) configuration.Limits
 }
 
 func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
 	return &ProcessorImpl{
-		l:   l,
-		ctx: ctx,
-		db:  db,
-		t:   tenant.MustFromContext(ctx),
+		l:      l,
+		ctx:    ctx,
+		db:     db,
+		t:      tenant.MustFromContext(ctx),
+		limits: configuration.GetLimits(l, ctx),
 	}
 }
 
 var _ Processor = (*ProcessorImpl)(nil)
 
 func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
-	return &ProcessorImpl{l: p.l, ctx: p.ctx, db: tx, t: p.t}
+	return &ProcessorImpl{l: p.l, ctx: p.ctx, db: tx, t: p.t, limits: p.limits}
 }
 
 func (p *ProcessorImpl) ByCharacterIdLastMonthProvider(characterId uint32) model.Provider[[]Model] {
@@ -78,6 +86,14 @@ func (p *ProcessorImpl) GetByCharacterIdLastMonth(characterId uint32) ([]Model,
 	return p.ByCharacterIdLastMonthProvider(characterId)()
 }
 
+func (p *ProcessorImpl) HistoryProvider(characterId uint32, direction Direction, page model.Page) model.Provider[model.Paged[Model]] {
+	return model.MapPaged(Make)(historyEntityProvider(characterId, direction, page)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) AccountPairsProvider(since time.Time, minCount int64) model.Provider[[]AccountPair] {
+	return accountPairsProvider(since, minCount)(p.db.WithContext(p.ctx))
+}
+
 func (p *ProcessorImpl) RequestChange(mb *message.Buffer) func(transactionId uuid.UUID) func(field field.Model) func(characterId uint32) func(targetId uint32) func(amount int8) error {
 	return func(transactionId uuid.UUID) func(field field.Model) func(characterId uint32) func(targetId uint32) func(amount int8) error {
 		return func(field field.Model) func(characterId uint32) func(targetId uint32) func(amount int8) error {
@@ -99,7 +115,7 @@ func (p *ProcessorImpl) RequestChange(mb *message.Buffer) func(transactionId uui
 								return errFameChangeRejected
 							}
 
-							_, err = characterProcessor.GetById(targetId)
+							target, err := characterProcessor.GetById(targetId)
 							if err != nil {
 								rejectEmit = func() error {
 									return producer.ProviderImpl(p.l)(p.ctx)(messageFame.EnvEventTopicFameStatus)(errorEventStatusProvider(transactionId, field.Channel(), characterId, messageFame.StatusEventErrorInvalidName))
@@ -107,43 +123,50 @@ func (p *ProcessorImpl) RequestChange(mb *message.Buffer) func(transactionId uui
 								return errFameChangeRejected
 							}
 
-							if c.Level() < 15 {
+							limits := p.limits(p.t.Id())
+							if c.Level() < limits.MinimumLevel {
 								rejectEmit = func() error {
 									return producer.ProviderImpl(p.l)(p.ctx)(messageFame.EnvEventTopicFameStatus)(errorEventStatusProvider(transactionId, field.Channel(), characterId, messageFame.StatusEventErrorTypeNotMinimumLevel))
 								}
 								return errFameChangeRejected
 							}
 
-							fls, err := p.GetByCharacterIdLastMonth(characterId)
+							now := time.Now()
+							startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+							cooldownStart := now.AddDate(0, 0, -limits.TargetCooldownDays)
+							since := startOfDay
+							if cooldownStart.Before(since) {
+								since = cooldownStart
+							}
+							fls, err := model.SliceMap(Make)(byCharacterIdSinceEntityProvider(characterId, since)(tx))(model.ParallelMap())()
 							if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 								return err
 							}
 
-							famedToday := false
-							famedTargetLastMonth := false
-							now := time.Now()
+							givenToday := 0
+							famedTargetInCooldown := false
 							for _, fl := range fls {
-								if fl.TargetId() == targetId {
-									famedTargetLastMonth = true
+								if fl.TargetId() == targetId && !fl.CreatedAt().Before(cooldownStart) {
+									famedTargetInCooldown = true
 								}
-								if fl.CreatedAt().Year() == now.Year() && fl.CreatedAt().YearDay() == now.YearDay() {
-									famedToday = true
+								if !fl.CreatedAt().Before(startOfDay) {
+									givenToday++
 								}
 							}
-							if famedToday {
+							if givenToday >= limits.DailyLimit {
 								rejectEmit = func() error {
 									return producer.ProviderImpl(p.l)(p.ctx)(messageFame.EnvEventTopicFameStatus)(errorEventStatusProvider(transactionId, field.Channel(), characterId, messageFame.StatusEventErrorTypeNotToday))
 								}
 								return errFameChangeRejected
 							}
-							if famedTargetLastMonth {
+							if famedTargetInCooldown {
 								rejectEmit = func() error {
 									return producer.ProviderImpl(p.l)(p.ctx)(messageFame.EnvEventTopicFameStatus)(errorEventStatusProvider(transactionId, field.Channel(), characterId, messageFame.StatusEventErrorTypeNotThisMonth))
 								}
 								return errFameChangeRejected
 							}
 
-							_, err = create(tx, p.t.Id(), characterId, targetId, amount)
+							_, err = create(tx, p.t.Id(), characterId, c.AccountId(), targetId, target.AccountId(), amount)
 							if err != nil {
 								rejectEmit = func() error {
 									return producer.ProviderImpl(p.l)(p.ctx)(messageFame.EnvEventTopicFameStatus)(errorEventStatusProvider(transactionId, field.Channel(), characterId, messageFame.StatusEventErrorTypeUnexpected))
@@ -1,12 +1,14 @@
 package fame
 
 import (
+	"atlas-fame/configuration"
 	messageCharacter "atlas-fame/kafka/message/character"
 	messageFame "atlas-fame/kafka/message/fame"
 	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -28,6 +30,7 @@ import (
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	kafkaproducer "github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer/producertest"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
@@ -397,3 +400,123 @@ func TestProcessor_RequestChangeAndEmit_SuccessEnqueuesOutbox(t *testing.T) {
 		assert.Equal(t, messageCharacter.EnvCommandTopic, entries[0].Topic)
 	}
 }
+
+// characterServer serves every character at level 20 on account id/10, so
+// characters 1000..1009 share account 100.
+func characterServer(t *testing.T) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+		id := parts[len(parts)-1]
+		n, _ := strconv.Atoi(id)
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data":{"type":"characters","id":"` + id + `","attributes":{"name":"Test","level":20,"accountId":` + strconv.Itoa(n/10) + `}}}`))
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("CHARACTERS_SERVICE_URL", srv.URL+"/api/")
+}
+
+func TestProcessor_RequestChangeAndEmit_AppliesConfiguredLimits(t *testing.T) {
+	characterServer(t)
+	captured, restore := installCapturingProducer()
+	defer restore()
+
+	ten := setupTestTenant(t)
+	ctx := setupTestContext(t, ten)
+	l := setupTestLogger(t)
+	db := setupProcessorTestDatabase(t)
+	if err := outbox.Migration(db); err != nil {
+		t.Fatalf("Failed to migrate outbox table: %v", err)
+	}
+	// Famed 2000 ten days ago: outside a 7 day cooldown.
+	createTestEntity(db.WithContext(ctx), ten.Id(), 1000, 2000, 1, time.Now().AddDate(0, 0, -10))
+
+	p := NewProcessor(l, ctx, db)
+	p.(*ProcessorImpl).limits = func(_ uuid.UUID) configuration.Limits {
+		return configuration.Limits{DailyLimit: 2, TargetCooldownDays: 7, MinimumLevel: 10}
+	}
+	f := field.NewBuilder(world.Id(1), channel.Id(0), _map.Id(100000000)).Build()
+
+	assert.NoError(t, p.RequestChangeAndEmit(uuid.New(), f, 1000, 2000, 1))
+	assert.NoError(t, p.RequestChangeAndEmit(uuid.New(), f, 1000, 3000, 1))
+	_, ok := (*captured)[messageFame.EnvEventTopicFameStatus]
+	assert.False(t, ok, "changes within the limits must not be rejected")
+
+	// A third change today exceeds the daily limit of two.
+	assert.NoError(t, p.RequestChangeAndEmit(uuid.New(), f, 1000, 4000, 1))
+	assert.Len(t, (*captured)[messageFame.EnvEventTopicFameStatus], 1)
+
+	paged, err := p.HistoryProvider(1000, DirectionGiven, model.Page{Number: 1, Size: 10})()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, paged.Total)
+	if assert.Len(t, paged.Items, 3) {
+		assert.Equal(t, uint32(3000), paged.Items[0].TargetId())
+		assert.Equal(t, uint32(100), paged.Items[0].GiverAccountId())
+		assert.Equal(t, uint32(300), paged.Items[0].TargetAccountId())
+	}
+}
+
+func TestProcessor_HistoryProvider_FiltersByDirection(t *testing.T) {
+	ten := setupTestTenant(t)
+	ctx := setupTestContext(t, ten)
+	l := setupTestLogger(t)
+	db := setupProcessorTestDatabase(t)
+	now := time.Now()
+	createTestEntity(db.WithContext(ctx), ten.Id(), 1000, 2000, 1, now.Add(-3*time.Hour))
+	createTestEntity(db.WithContext(ctx), ten.Id(), 3000, 1000, -1, now.Add(-2*time.Hour))
+	createTestEntity(db.WithContext(ctx), ten.Id(), 4000, 1000, 1, now.Add(-1*time.Hour))
+
+	p := NewProcessor(l, ctx, db)
+	page := model.Page{Number: 1, Size: 2}
+
+	all, err := p.HistoryProvider(1000, DirectionAll, page)()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, all.Total)
+	if assert.Len(t, all.Items, 2) {
+		assert.Equal(t, uint32(4000), all.Items[0].CharacterId())
+		assert.Equal(t, uint32(3000), all.Items[1].CharacterId())
+	}
+
+	received, err := p.HistoryProvider(1000, DirectionReceived, page)()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, received.Total)
+
+	given, err := p.HistoryProvider(1000, DirectionGiven, page)()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, given.Total)
+}
+
+func TestProcessor_AccountPairsProvider(t *testing.T) {
+	ten := setupTestTenant(t)
+	ctx := setupTestContext(t, ten)
+	l := setupTestLogger(t)
+	db := setupProcessorTestDatabase(t)
+	now := time.Now()
+	create := func(giver, giverAccount, target, targetAccount uint32, at time.Time) {
+		e := Entity{TenantId: ten.Id(), Id: uuid.New(), CharacterId: giver, GiverAccountId: giverAccount, TargetId: target, TargetAccountId: targetAccount, Amount: 1, CreatedAt: at}
+		assert.NoError(t, db.WithContext(ctx).Create(&e).Error)
+	}
+	// Account 7's three alts each famed account 9's main.
+	create(1, 7, 100, 9, now.AddDate(0, 0, -1))
+	create(2, 7, 100, 9, now.AddDate(0, 0, -2))
+	create(3, 7, 100, 9, now.AddDate(0, 0, -3))
+	// Outside the window.
+	create(4, 7, 100, 9, now.AddDate(0, 0, -40))
+	// A one-off between unrelated accounts.
+	create(5, 8, 101, 10, now.AddDate(0, 0, -1))
+	// Legacy rows without accounts are ignored.
+	create(6, 0, 102, 0, now.AddDate(0, 0, -1))
+
+	ps, err := NewProcessor(l, ctx, db).AccountPairsProvider(now.AddDate(0, 0, -30), 2)()
+	assert.NoError(t, err)
+	if assert.Len(t, ps, 1) {
+		assert.Equal(t, uint32(7), ps[0].GiverAccountId)
+		assert.Equal(t, uint32(9), ps[0].TargetAccountId)
+		assert.EqualValues(t, 3, ps[0].Count)
+		assert.EqualValues(t, 3, ps[0].Givers)
+		assert.EqualValues(t, 1, ps[0].Targets)
+		assert.EqualValues(t, 3, ps[0].Net)
+		assert.False(t, ps[0].SameAccount())
+	}
+}
@@ -20,3 +20,51 @@ func byCharacterIdLastMonthEntityProvider(characterId uint32) database.EntityPro
 		return model.FixedProvider[[]Entity](result)
 	}
 }
+
+// byCharacterIdSinceEntityProvider returns the fame a character gave at or
+// after since.
+func byCharacterIdSinceEntityProvider(characterId uint32, since time.Time) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var result []Entity
+		err := db.Where("character_id = ? AND created_at >= ?", characterId, since).Find(&result).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider[[]Entity](result)
+	}
+}
+
+// historyEntityProvider pages a character's fame log, newest first.
+func historyEntityProvider(characterId uint32, direction Direction, page model.Page) database.EntityProvider[model.Paged[Entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[Entity]] {
+		switch direction {
+		case DirectionGiven:
+			db = db.Where("character_id = ?", characterId)
+		case DirectionReceived:
+			db = db.Where("target_id = ?", characterId)
+		default:
+			db = db.Where("character_id = ? OR target_id = ?", characterId, characterId)
+		}
+		return database.PagedQuery[Entity](db.Order("created_at DESC"), page)
+	}
+}
+
+// accountPairsProvider totals fame given from one account to another at or
+// after since, keeping pairs with at least minCount changes. Logs written
+// before accounts were recorded are left out.
+func accountPairsProvider(since time.Time, minCount int64) database.EntityProvider[[]AccountPair] {
+	return func(db *gorm.DB) model.Provider[[]AccountPair] {
+		var result []AccountPair
+		err := db.Model(&Entity{}).
+			Select("giver_account_id, target_account_id, COUNT(*) AS count, COUNT(DISTINCT character_id) AS givers, COUNT(DISTINCT target_id) AS targets, SUM(amount) AS net").
+			Where("created_at >= ? AND giver_account_id <> 0 AND target_account_id <> 0", since).
+			Group("giver_account_id, target_account_id").
+			Having("COUNT(*) >= ?", minCount).
+			Order("count DESC, giver_account_id, target_account_id").
+			Scan(&result).Error
+		if err != nil {
+			return model.ErrorProvider[[]AccountPair](err)
+		}
+		return model.FixedProvider[[]AccountPair](result)
+	}
+}
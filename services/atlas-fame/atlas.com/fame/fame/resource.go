@@ -0,0 +1,121 @@
+package fame
+
+import (
+	"atlas-fame/rest"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
+)
+
+const (
+	// defaultPairWindow is how far back GET /fame/account-pairs looks when no
+	// filter[since] is given.
+	defaultPairWindow = 30 * 24 * time.Hour
+	// defaultPairMinCount is the fewest changes between two accounts that
+	// GET /fame/account-pairs reports when no filter[minCount] is given.
+	defaultPairMinCount = 5
+)
+
+func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerHandler := rest.RegisterHandler(l)(db)(si)
+
+			// A character's fame history
+			router.HandleFunc("/characters/{characterId}/fame", registerHandler("get_character_fame", GetHistoryHandler)).Methods(http.MethodGet)
+
+			// Fame totals between accounts, for spotting alts famming each other
+			router.HandleFunc("/fame/account-pairs", registerHandler("get_fame_account_pairs", GetAccountPairsHandler)).Methods(http.MethodGet)
+		}
+	}
+}
+
+// GetHistoryHandler handles GET /api/characters/{characterId}/fame. An
+// optional filter[direction] of given or received narrows it to one side.
+func GetHistoryHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			direction := Direction(r.URL.Query().Get("filter[direction]"))
+			if direction != DirectionAll && direction != DirectionGiven && direction != DirectionReceived {
+				server.WriteBadRequest(d.Logger(), w, "invalid filter[direction]")
+				return
+			}
+			page, err := paginate.ParseParams(r.URL.Query(), paginate.DefaultPageSize, paginate.MaxPageSize)
+			if err != nil {
+				server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+				return
+			}
+
+			paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).HistoryProvider(characterId, direction, page)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Unable to locate fame history for character [%d].", characterId)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			rm, err := model.SliceMap(Transform)(model.FixedProvider(paged.Items))(model.ParallelMap())()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			query := r.URL.Query()
+			queryParams := jsonapi.ParseQueryFields(&query)
+			server.MarshalPaginatedResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm, paginate.EnvelopeFor(paged), r)
+		}
+	})
+}
+
+// GetAccountPairsHandler handles GET /api/fame/account-pairs. filter[since]
+// (RFC 3339) and filter[minCount] bound the window and the fewest changes a
+// pair needs to be listed.
+func GetAccountPairsHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		since := time.Now().Add(-defaultPairWindow)
+		if v := r.URL.Query().Get("filter[since]"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				server.WriteBadRequest(d.Logger(), w, "invalid filter[since]")
+				return
+			}
+			since = t
+		}
+		minCount := int64(defaultPairMinCount)
+		if v := r.URL.Query().Get("filter[minCount]"); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n < 1 {
+				server.WriteBadRequest(d.Logger(), w, "invalid filter[minCount]")
+				return
+			}
+			minCount = n
+		}
+
+		ps, err := NewProcessor(d.Logger(), d.Context(), d.DB()).AccountPairsProvider(since, minCount)()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to total fame between accounts.")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		rm, err := model.SliceMap(TransformAccountPair)(model.FixedProvider(ps))(model.ParallelMap())()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		query := r.URL.Query()
+		queryParams := jsonapi.ParseQueryFields(&query)
+		server.MarshalResponse[[]AccountPairRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+	}
+}
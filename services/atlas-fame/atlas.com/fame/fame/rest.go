@@ -0,0 +1,86 @@
+package fame
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestModel is one fame log entry: CharacterId gave Amount fame to TargetId.
+type RestModel struct {
+	Id              uuid.UUID `json:"-"`
+	CharacterId     uint32    `json:"characterId"`
+	TargetId        uint32    `json:"targetId"`
+	GiverAccountId  uint32    `json:"giverAccountId"`
+	TargetAccountId uint32    `json:"targetAccountId"`
+	Amount          int8      `json:"amount"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+func (r RestModel) GetName() string {
+	return "fame-logs"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id.String()
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := uuid.Parse(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = id
+	return nil
+}
+
+func Transform(m Model) (RestModel, error) {
+	return RestModel{
+		Id:              m.Id(),
+		CharacterId:     m.CharacterId(),
+		TargetId:        m.TargetId(),
+		GiverAccountId:  m.GiverAccountId(),
+		TargetAccountId: m.TargetAccountId(),
+		Amount:          m.Amount(),
+		CreatedAt:       m.CreatedAt(),
+	}, nil
+}
+
+// AccountPairRestModel is the fame one account's characters gave another's.
+type AccountPairRestModel struct {
+	Id               string `json:"-"`
+	GiverAccountId   uint32 `json:"giverAccountId"`
+	TargetAccountId  uint32 `json:"targetAccountId"`
+	Count            int64  `json:"count"`
+	GiverCharacters  int64  `json:"giverCharacters"`
+	TargetCharacters int64  `json:"targetCharacters"`
+	Net              int64  `json:"net"`
+	SameAccount      bool   `json:"sameAccount"`
+}
+
+func (r AccountPairRestModel) GetName() string {
+	return "fame-account-pairs"
+}
+
+func (r AccountPairRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *AccountPairRestModel) SetID(strId string) error {
+	r.Id = strId
+	return nil
+}
+
+func TransformAccountPair(p AccountPair) (AccountPairRestModel, error) {
+	return AccountPairRestModel{
+		Id:               fmt.Sprintf("%d-%d", p.GiverAccountId, p.TargetAccountId),
+		GiverAccountId:   p.GiverAccountId,
+		TargetAccountId:  p.TargetAccountId,
+		Count:            p.Count,
+		GiverCharacters:  p.Givers,
+		TargetCharacters: p.Targets,
+		Net:              p.Net,
+		SameAccount:      p.SameAccount(),
+	}, nil
+}
@@ -22,6 +22,26 @@ const serviceName = "atlas-fame"
 
 var consumerGroupId = consumergroup.Resolve("Fame Service")
 
+type Server struct {
+	baseUrl string
+	prefix  string
+}
+
+func (s Server) GetBaseURL() string {
+	return s.baseUrl
+}
+
+func (s Server) GetPrefix() string {
+	return s.prefix
+}
+
+func GetServer() Server {
+	return Server{
+		baseUrl: "",
+		prefix:  "/api/",
+	}
+}
+
 func main() {
 	rt := service.Bootstrap(serviceName, service.WithEnvironmentRegistry(serviceName))
 	l := rt.Logger()
@@ -55,8 +75,9 @@ func main() {
 	server.New(l).
 		WithContext(rt.Context()).
 		WithWaitGroup(rt.WaitGroup()).
-		SetBasePath("/api/").
+		SetBasePath(GetServer().GetPrefix()).
 		SetPort(os.Getenv("REST_PORT")).
+		AddRouteInitializer(fame.InitializeRoutes(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
@@ -8,7 +8,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
@@ -58,19 +60,20 @@ func CreateMember(db *gorm.DB, log logrus.FieldLogger) func(characterId uint32,
 	}
 }
 
-// BatchResetDailyRep resets daily reputation for all members
+// BatchResetDailyRep resets daily reputation and entitlement usage for all members
 func BatchResetDailyRep(db *gorm.DB, log logrus.FieldLogger) model.Provider[BatchResetResult] {
 	return func() (BatchResetResult, error) {
 		log.Info("Performing batch daily reputation reset")
 
 		resetTime := time.Now()
 
-		// Reset daily rep for all members
+		// Reset daily rep and entitlement usage for all members
 		result := db.Model(&Entity{}).
-			Where("daily_rep > 0").
+			Where("daily_rep > 0 OR entitlement_uses IS NOT NULL").
 			Updates(map[string]interface{}{
-				"daily_rep":  0,
-				"updated_at": time.Now(),
+				"daily_rep":        0,
+				"entitlement_uses": gorm.Expr("NULL"),
+				"updated_at":       time.Now(),
 			})
 
 		if result.Error != nil {
@@ -85,6 +88,177 @@ func BatchResetDailyRep(db *gorm.DB, log logrus.FieldLogger) model.Provider[Batc
 	}
 }
 
+// SpendRepOnEntitlement deducts cost from the member's reputation and records
+// one use of entitlementType, in one transaction. The deduction is a single
+// conditional UPDATE, so two concurrent spends cannot both pass the balance
+// check: a member short of cost reputation matches no row and gets
+// ErrInsufficientRep. The UPDATE also locks the row, so the usage count read
+// after it is current; a member already at limit gets
+// ErrEntitlementLimitReached and the deduction rolls back.
+func SpendRepOnEntitlement(db *gorm.DB, log logrus.FieldLogger) func(characterId uint32, entitlementType familyconst.EntitlementType, cost uint32, limit uint32) model.Provider[Entity] {
+	return func(characterId uint32, entitlementType familyconst.EntitlementType, cost uint32, limit uint32) model.Provider[Entity] {
+		return func() (Entity, error) {
+			log.WithFields(logrus.Fields{
+				"characterId": characterId,
+				"entitlement": entitlementType,
+				"cost":        cost,
+			}).Debug("Spending family member reputation on entitlement")
+
+			var result Entity
+			err := database.ExecuteTransaction(db, func(tx *gorm.DB) error {
+				now := time.Now()
+				spent := tx.Model(&Entity{}).
+					Where("character_id = ? AND rep >= ?", characterId, cost).
+					Updates(map[string]interface{}{
+						"rep":        gorm.Expr("rep - ?", cost),
+						"updated_at": now,
+					})
+				if spent.Error != nil {
+					return spent.Error
+				}
+				if spent.RowsAffected == 0 {
+					return ErrInsufficientRep
+				}
+
+				e, err := GetByCharacterIdProvider(characterId)(tx)()
+				if err != nil {
+					return err
+				}
+				uses := e.EntitlementUses
+				if uses == nil {
+					uses = make(map[byte]uint32)
+				}
+				if limit > 0 && uses[byte(entitlementType)] >= limit {
+					return ErrEntitlementLimitReached
+				}
+				uses[byte(entitlementType)]++
+				if err := tx.Model(&e).Select("EntitlementUses").Updates(Entity{EntitlementUses: uses}).Error; err != nil {
+					return err
+				}
+				e.EntitlementUses = uses
+				result = e
+				return nil
+			})
+			if err != nil {
+				return Entity{}, err
+			}
+			return result, nil
+		}
+	}
+}
+
+// RefundEntitlement returns cost reputation to the member and takes back one
+// recorded use of entitlementType, in one transaction. The credit is a single
+// UPDATE that also locks the row; a member with no use of the entitlement
+// recorded today has nothing to refund and gets ErrNoEntitlementUse, which
+// rolls the credit back, so a redelivered refund cannot mint reputation.
+func RefundEntitlement(db *gorm.DB, log logrus.FieldLogger) func(characterId uint32, entitlementType familyconst.EntitlementType, cost uint32) model.Provider[Entity] {
+	return func(characterId uint32, entitlementType familyconst.EntitlementType, cost uint32) model.Provider[Entity] {
+		return func() (Entity, error) {
+			log.WithFields(logrus.Fields{
+				"characterId": characterId,
+				"entitlement": entitlementType,
+				"cost":        cost,
+			}).Debug("Refunding family member entitlement")
+
+			var result Entity
+			err := database.ExecuteTransaction(db, func(tx *gorm.DB) error {
+				credited := tx.Model(&Entity{}).
+					Where("character_id = ?", characterId).
+					Updates(map[string]interface{}{
+						"rep":        gorm.Expr("rep + ?", cost),
+						"updated_at": time.Now(),
+					})
+				if credited.Error != nil {
+					return credited.Error
+				}
+				if credited.RowsAffected == 0 {
+					return ErrMemberNotFound
+				}
+
+				e, err := GetByCharacterIdProvider(characterId)(tx)()
+				if err != nil {
+					return err
+				}
+				uses := e.EntitlementUses
+				if uses[byte(entitlementType)] == 0 {
+					return ErrNoEntitlementUse
+				}
+				uses[byte(entitlementType)]--
+				if uses[byte(entitlementType)] == 0 {
+					delete(uses, byte(entitlementType))
+				}
+				if len(uses) == 0 {
+					if err := tx.Model(&e).Update("entitlement_uses", gorm.Expr("NULL")).Error; err != nil {
+						return err
+					}
+					e.EntitlementUses = nil
+				} else {
+					if err := tx.Model(&e).Select("EntitlementUses").Updates(Entity{EntitlementUses: uses}).Error; err != nil {
+						return err
+					}
+					e.EntitlementUses = uses
+				}
+				result = e
+				return nil
+			})
+			if err != nil {
+				return Entity{}, err
+			}
+			return result, nil
+		}
+	}
+}
+
+// AwardRep credits amount reputation to the member and counts it against
+// the day's earnings, in one conditional UPDATE that only touches rep and
+// daily_rep. It matches only while the member's daily_rep still leaves room
+// for amount under dailyCap; a member without that room gets
+// ErrRepCapExceeded and nothing is credited. Because the row is never
+// rewritten whole, an award cannot undo a concurrent spend or refund.
+func AwardRep(db *gorm.DB, log logrus.FieldLogger) func(characterId uint32, amount uint32, dailyCap uint32) model.Provider[Entity] {
+	return func(characterId uint32, amount uint32, dailyCap uint32) model.Provider[Entity] {
+		return func() (Entity, error) {
+			log.WithFields(logrus.Fields{
+				"characterId": characterId,
+				"amount":      amount,
+			}).Debug("Awarding family member reputation")
+
+			if amount > dailyCap {
+				return Entity{}, ErrRepCapExceeded
+			}
+
+			var result Entity
+			err := database.ExecuteTransaction(db, func(tx *gorm.DB) error {
+				awarded := tx.Model(&Entity{}).
+					Where("character_id = ? AND daily_rep <= ?", characterId, dailyCap-amount).
+					Updates(map[string]interface{}{
+						"rep":        gorm.Expr("rep + ?", amount),
+						"daily_rep":  gorm.Expr("daily_rep + ?", amount),
+						"updated_at": time.Now(),
+					})
+				if awarded.Error != nil {
+					return awarded.Error
+				}
+				if awarded.RowsAffected == 0 {
+					return ErrRepCapExceeded
+				}
+
+				e, err := GetByCharacterIdProvider(characterId)(tx)()
+				if err != nil {
+					return err
+				}
+				result = e
+				return nil
+			})
+			if err != nil {
+				return Entity{}, err
+			}
+			return result, nil
+		}
+	}
+}
+
 // SaveMember saves a family member to the database (create or update)
 func SaveMember(db *gorm.DB, log logrus.FieldLogger) func(member FamilyMember) model.Provider[Entity] {
 	return func(member FamilyMember) model.Provider[Entity] {
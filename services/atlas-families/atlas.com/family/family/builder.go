@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
@@ -94,6 +95,23 @@ func (b *Builder) ResetDailyRep() *Builder {
 	return b
 }
 
+func (b *Builder) SetEntitlementUses(uses map[familyconst.EntitlementType]uint32) *Builder {
+	// Copy to avoid shared references
+	b.entitlementUses = make(map[familyconst.EntitlementType]uint32, len(uses))
+	for t, c := range uses {
+		b.entitlementUses[t] = c
+	}
+	return b
+}
+
+func (b *Builder) RecordEntitlementUse(t familyconst.EntitlementType) *Builder {
+	if b.entitlementUses == nil {
+		b.entitlementUses = make(map[familyconst.EntitlementType]uint32)
+	}
+	b.entitlementUses[t]++
+	return b
+}
+
 func (b *Builder) SetLevel(level uint16) *Builder {
 	b.level = level
 	return b
@@ -151,17 +169,23 @@ func (b *Builder) Build() (FamilyMember, error) {
 	juniorIds := make([]uint32, len(b.juniorIds))
 	copy(juniorIds, b.juniorIds)
 
+	entitlementUses := make(map[familyconst.EntitlementType]uint32, len(b.entitlementUses))
+	for t, c := range b.entitlementUses {
+		entitlementUses[t] = c
+	}
+
 	return FamilyMember{
-		id:          b.id,
-		characterId: b.characterId,
-		tenantId:    b.tenantId,
-		seniorId:    b.seniorId,
-		juniorIds:   juniorIds,
-		rep:         b.rep,
-		dailyRep:    b.dailyRep,
-		level:       b.level,
-		world:       b.world,
-		createdAt:   b.createdAt,
-		updatedAt:   b.updatedAt,
+		id:              b.id,
+		characterId:     b.characterId,
+		tenantId:        b.tenantId,
+		seniorId:        b.seniorId,
+		juniorIds:       juniorIds,
+		rep:             b.rep,
+		dailyRep:        b.dailyRep,
+		level:           b.level,
+		world:           b.world,
+		entitlementUses: entitlementUses,
+		createdAt:       b.createdAt,
+		updatedAt:       b.updatedAt,
 	}, nil
 }
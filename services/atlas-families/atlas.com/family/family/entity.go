@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
@@ -20,8 +21,11 @@ type Entity struct {
 	DailyRep    uint32    `gorm:"default:0" json:"dailyRep"`
 	Level       uint16    `gorm:"not null" json:"level"`
 	World       byte      `gorm:"not null" json:"world"`
-	CreatedAt   time.Time `gorm:"not null" json:"createdAt"`
-	UpdatedAt   time.Time `gorm:"not null" json:"updatedAt"`
+	// EntitlementUses is keyed by entitlement type. A member with no uses
+	// today persists SQL NULL, which the daily reset relies on.
+	EntitlementUses map[byte]uint32 `gorm:"serializer:json" json:"entitlementUses"`
+	CreatedAt       time.Time       `gorm:"not null" json:"createdAt"`
+	UpdatedAt       time.Time       `gorm:"not null" json:"updatedAt"`
 }
 
 // TableName specifies the table name for the Entity
@@ -154,18 +158,24 @@ func Make(entity Entity) (FamilyMember, error) {
 	juniorIds := make([]uint32, len(entity.JuniorIds))
 	copy(juniorIds, entity.JuniorIds)
 
+	entitlementUses := make(map[familyconst.EntitlementType]uint32, len(entity.EntitlementUses))
+	for t, c := range entity.EntitlementUses {
+		entitlementUses[familyconst.EntitlementType(t)] = c
+	}
+
 	return FamilyMember{
-		id:          entity.ID,
-		characterId: entity.CharacterId,
-		tenantId:    entity.TenantId,
-		seniorId:    entity.SeniorId,
-		juniorIds:   juniorIds,
-		rep:         entity.Rep,
-		dailyRep:    entity.DailyRep,
-		level:       entity.Level,
-		world:       world.Id(entity.World),
-		createdAt:   entity.CreatedAt,
-		updatedAt:   entity.UpdatedAt,
+		id:              entity.ID,
+		characterId:     entity.CharacterId,
+		tenantId:        entity.TenantId,
+		seniorId:        entity.SeniorId,
+		juniorIds:       juniorIds,
+		rep:             entity.Rep,
+		dailyRep:        entity.DailyRep,
+		level:           entity.Level,
+		world:           world.Id(entity.World),
+		entitlementUses: entitlementUses,
+		createdAt:       entity.CreatedAt,
+		updatedAt:       entity.UpdatedAt,
 	}, nil
 }
 
@@ -175,17 +185,26 @@ func ToEntity(fm FamilyMember) Entity {
 	juniorIds := make([]uint32, len(fm.juniorIds))
 	copy(juniorIds, fm.juniorIds)
 
+	var entitlementUses map[byte]uint32
+	if len(fm.entitlementUses) > 0 {
+		entitlementUses = make(map[byte]uint32, len(fm.entitlementUses))
+		for t, c := range fm.entitlementUses {
+			entitlementUses[byte(t)] = c
+		}
+	}
+
 	return Entity{
-		ID:          fm.id,
-		CharacterId: fm.characterId,
-		TenantId:    fm.tenantId,
-		SeniorId:    fm.seniorId,
-		JuniorIds:   juniorIds,
-		Rep:         fm.rep,
-		DailyRep:    fm.dailyRep,
-		Level:       fm.level,
-		World:       byte(fm.world),
-		CreatedAt:   fm.createdAt,
-		UpdatedAt:   fm.updatedAt,
+		ID:              fm.id,
+		CharacterId:     fm.characterId,
+		TenantId:        fm.tenantId,
+		SeniorId:        fm.seniorId,
+		JuniorIds:       juniorIds,
+		Rep:             fm.rep,
+		DailyRep:        fm.dailyRep,
+		Level:           fm.level,
+		World:           byte(fm.world),
+		EntitlementUses: entitlementUses,
+		CreatedAt:       fm.createdAt,
+		UpdatedAt:       fm.updatedAt,
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
@@ -20,8 +21,11 @@ type FamilyMember struct {
 	dailyRep    uint32
 	level       uint16
 	world       world.Id
-	createdAt   time.Time
-	updatedAt   time.Time
+	// entitlementUses counts today's uses of each entitlement. It resets
+	// with daily reputation.
+	entitlementUses map[familyconst.EntitlementType]uint32
+	createdAt       time.Time
+	updatedAt       time.Time
 }
 
 // Accessor methods for FamilyMember
@@ -64,6 +68,20 @@ func (fm FamilyMember) World() world.Id {
 	return fm.world
 }
 
+func (fm FamilyMember) EntitlementUses() map[familyconst.EntitlementType]uint32 {
+	// Return a copy to maintain immutability
+	result := make(map[familyconst.EntitlementType]uint32, len(fm.entitlementUses))
+	for t, c := range fm.entitlementUses {
+		result[t] = c
+	}
+	return result
+}
+
+// EntitlementUseCount returns how many times the entitlement was used today
+func (fm FamilyMember) EntitlementUseCount(t familyconst.EntitlementType) uint32 {
+	return fm.entitlementUses[t]
+}
+
 func (fm FamilyMember) CreatedAt() time.Time {
 	return fm.createdAt
 }
@@ -120,43 +138,51 @@ func (fm FamilyMember) IsSameWorld(world world.Id) bool {
 
 // IsRepCapReached returns true if daily rep limit is reached
 func (fm FamilyMember) IsRepCapReached() bool {
-	return fm.dailyRep >= 5000
+	return fm.dailyRep >= dailyRepCap
 }
 
 // CanReceiveRep returns true if the member can receive more rep today
 func (fm FamilyMember) CanReceiveRep(amount uint32) bool {
-	return fm.dailyRep+amount <= 5000
+	return fm.dailyRep+amount <= dailyRepCap
+}
+
+// CanUseEntitlement returns true if the member has not exhausted the
+// entitlement's daily usage limit
+func (fm FamilyMember) CanUseEntitlement(e familyconst.Entitlement) bool {
+	return e.UsageLimit == 0 || fm.entitlementUses[e.Type] < e.UsageLimit
 }
 
 // Builder forward declaration - implementation in builder.go
 type Builder struct {
-	id          uint32
-	characterId uint32
-	tenantId    uuid.UUID
-	seniorId    *uint32
-	juniorIds   []uint32
-	rep         uint32
-	dailyRep    uint32
-	level       uint16
-	world       world.Id
-	createdAt   time.Time
-	updatedAt   time.Time
+	id              uint32
+	characterId     uint32
+	tenantId        uuid.UUID
+	seniorId        *uint32
+	juniorIds       []uint32
+	rep             uint32
+	dailyRep        uint32
+	level           uint16
+	world           world.Id
+	entitlementUses map[familyconst.EntitlementType]uint32
+	createdAt       time.Time
+	updatedAt       time.Time
 }
 
 // Builder returns a new builder for modification
 func (fm FamilyMember) Builder() *Builder {
 	return &Builder{
-		id:          fm.id,
-		characterId: fm.characterId,
-		tenantId:    fm.tenantId,
-		seniorId:    fm.seniorId,
-		juniorIds:   append([]uint32{}, fm.juniorIds...),
-		rep:         fm.rep,
-		dailyRep:    fm.dailyRep,
-		level:       fm.level,
-		world:       fm.world,
-		createdAt:   fm.createdAt,
-		updatedAt:   fm.updatedAt,
+		id:              fm.id,
+		characterId:     fm.characterId,
+		tenantId:        fm.tenantId,
+		seniorId:        fm.seniorId,
+		juniorIds:       append([]uint32{}, fm.juniorIds...),
+		rep:             fm.rep,
+		dailyRep:        fm.dailyRep,
+		level:           fm.level,
+		world:           fm.world,
+		entitlementUses: fm.EntitlementUses(),
+		createdAt:       fm.createdAt,
+		updatedAt:       fm.updatedAt,
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
@@ -28,6 +29,10 @@ type Processor interface {
 	AwardRep(buf *message.Buffer) func(characterId uint32, amount uint32, source string) model.Provider[FamilyMember]
 	DeductRep(buf *message.Buffer) func(characterId uint32, amount uint32, reason string) model.Provider[FamilyMember]
 	ResetDailyRep(buf *message.Buffer) model.Provider[BatchResetResult]
+	UseEntitlement(buf *message.Buffer) func(characterId uint32, entitlementType familyconst.EntitlementType, targetId uint32, beneficiaryIds []uint32) model.Provider[FamilyMember]
+	RefundEntitlement(buf *message.Buffer) func(characterId uint32, entitlementType familyconst.EntitlementType, reason string) model.Provider[FamilyMember]
+	AwardSeniorRep(buf *message.Buffer) func(juniorId uint32, amount uint32, source string) model.Provider[FamilyMember]
+	UpdateLevel(characterId uint32, level uint16) model.Provider[FamilyMember]
 
 	// AndEmit variants for Kafka message emission
 	AddJuniorAndEmit(transactionId uuid.UUID, worldId world.Id, seniorId uint32, seniorLevel uint16, juniorId uint32, juniorLevel uint16) model.Provider[FamilyMember]
@@ -35,6 +40,9 @@ type Processor interface {
 	BreakLinkAndEmit(transactionId uuid.UUID, characterId uint32, reason string) model.Provider[[]FamilyMember]
 	AwardRepAndEmit(transactionId uuid.UUID, characterId uint32, amount uint32, source string) model.Provider[FamilyMember]
 	DeductRepAndEmit(transactionId uuid.UUID, characterId uint32, amount uint32, reason string) model.Provider[FamilyMember]
+	UseEntitlementAndEmit(transactionId uuid.UUID, characterId uint32, entitlementType familyconst.EntitlementType, targetId uint32, beneficiaryIds []uint32) model.Provider[FamilyMember]
+	RefundEntitlementAndEmit(transactionId uuid.UUID, characterId uint32, entitlementType familyconst.EntitlementType, reason string) model.Provider[FamilyMember]
+	AwardSeniorRepAndEmit(transactionId uuid.UUID, juniorId uint32, amount uint32, source string) model.Provider[FamilyMember]
 
 	GetFamilyTree(characterId uint32) ([]FamilyMember, error)
 	GetByCharacterId(characterId uint32) (FamilyMember, error)
@@ -103,19 +111,17 @@ func (p *ProcessorImpl) AddJunior(buf *message.Buffer) func(worldId world.Id, se
 				return FamilyMember{}, ErrSelfReference
 			}
 
-			// Get senior member
-			seniorModel, err := p.GetByCharacterId(seniorId)
-			if err != nil {
-				if errors.Is(err, ErrMemberNotFound) {
-					t := tenant.MustFromContext(p.ctx)
-					seniorModel, err = model.Map(Make)(CreateMember(p.db.WithContext(p.ctx), p.log)(seniorId, t.Id(), seniorLevel, worldId))()
-					if buf != nil {
-						if putErr := buf.Put(familymsg.EnvEventTopicErrors, LinkErrorEventProvider(0, seniorId, seniorId, juniorId, "SENIOR_NOT_FOUND", ErrSeniorNotFound.Error())); putErr != nil {
-							p.log.WithError(putErr).Error("Failed to add link error event to buffer")
-						}
+			// Get senior member, registering a character new to the family system
+			seniorModel, err := p.getOrCreateMember(worldId, seniorId, seniorLevel)
+			if errors.Is(err, ErrMemberNotFound) {
+				if buf != nil {
+					if putErr := buf.Put(familymsg.EnvEventTopicErrors, LinkErrorEventProvider(worldId, seniorId, seniorId, juniorId, "SENIOR_NOT_FOUND", ErrSeniorNotFound.Error())); putErr != nil {
+						p.log.WithError(putErr).Error("Failed to add link error event to buffer")
 					}
-					return FamilyMember{}, ErrSeniorNotFound
 				}
+				return FamilyMember{}, ErrSeniorNotFound
+			}
+			if err != nil {
 				return FamilyMember{}, err
 			}
 
@@ -129,17 +135,17 @@ func (p *ProcessorImpl) AddJunior(buf *message.Buffer) func(worldId world.Id, se
 				return FamilyMember{}, ErrSeniorHasTooManyJuniors
 			}
 
-			// Get junior member
-			juniorModel, err := p.GetByCharacterId(juniorId)
-			if err != nil {
-				if errors.Is(err, ErrMemberNotFound) {
-					if buf != nil {
-						if putErr := buf.Put(familymsg.EnvEventTopicErrors, LinkErrorEventProvider(seniorModel.World(), seniorId, seniorId, juniorId, "JUNIOR_NOT_FOUND", ErrJuniorNotFound.Error())); putErr != nil {
-							p.log.WithError(putErr).Error("Failed to add link error event to buffer")
-						}
+			// Get junior member, registering a character new to the family system
+			juniorModel, err := p.getOrCreateMember(worldId, juniorId, juniorLevel)
+			if errors.Is(err, ErrMemberNotFound) {
+				if buf != nil {
+					if putErr := buf.Put(familymsg.EnvEventTopicErrors, LinkErrorEventProvider(seniorModel.World(), seniorId, seniorId, juniorId, "JUNIOR_NOT_FOUND", ErrJuniorNotFound.Error())); putErr != nil {
+						p.log.WithError(putErr).Error("Failed to add link error event to buffer")
 					}
-					return FamilyMember{}, ErrJuniorNotFound
 				}
+				return FamilyMember{}, ErrJuniorNotFound
+			}
+			if err != nil {
 				return FamilyMember{}, err
 			}
 
@@ -230,6 +236,21 @@ func (p *ProcessorImpl) AddJunior(buf *message.Buffer) func(worldId world.Id, se
 	}
 }
 
+// getOrCreateMember returns the family member for a character, creating it
+// when the character has never been part of a family. Only a missing row is
+// reported as ErrMemberNotFound; any other database error is returned as is.
+func (p *ProcessorImpl) getOrCreateMember(worldId world.Id, characterId uint32, level uint16) (FamilyMember, error) {
+	m, err := p.GetByCharacterId(characterId)
+	if err == nil {
+		return m, nil
+	}
+	if !errors.Is(err, ErrMemberNotFound) {
+		return FamilyMember{}, err
+	}
+	t := tenant.MustFromContext(p.ctx)
+	return model.Map(Make)(CreateMember(p.db.WithContext(p.ctx), p.log)(characterId, t.Id(), level, worldId))()
+}
+
 // RemoveMember removes a member from the family and handles cascade operations
 func (p *ProcessorImpl) RemoveMember(_ *message.Buffer) func(characterId uint32, reason string) model.Provider[[]FamilyMember] {
 	return func(characterId uint32, reason string) model.Provider[[]FamilyMember] {
@@ -464,7 +485,7 @@ func (p *ProcessorImpl) AwardRep(buf *message.Buffer) func(characterId uint32, a
 
 			// Add success event to buffer if provided
 			if buf != nil {
-				if putErr := buf.Put(familymsg.EnvEventTopicRep, RepGainedEventProvider(updatedMember.World(), characterId, amount, updatedMember.DailyRep(), source)); putErr != nil {
+				if putErr := buf.Put(familymsg.EnvEventTopicRep, RepGainedEventProvider(updatedMember.World(), characterId, amount, updatedMember.DailyRep(), source, 0)); putErr != nil {
 					p.log.WithError(putErr).Error("Failed to add rep gained event to buffer")
 				}
 			}
@@ -609,5 +630,5 @@ func (p *ProcessorImpl) GetFamilyTree(characterId uint32) ([]FamilyMember, error
 }
 
 func (p *ProcessorImpl) GetByCharacterId(characterId uint32) (FamilyMember, error) {
-	return model.Map(Make)(GetByIdProvider(characterId)(p.db.WithContext(p.ctx)))()
+	return model.Map(Make)(GetByCharacterIdProvider(characterId)(p.db.WithContext(p.ctx)))()
 }
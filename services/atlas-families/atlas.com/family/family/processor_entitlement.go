@@ -0,0 +1,275 @@
+package family
+
+import (
+	"atlas-family/kafka/message"
+	"errors"
+
+	familymsg "atlas-family/kafka/message/family"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// dailyRepCap is the most reputation a member may earn in a day
+const dailyRepCap = uint32(5000)
+
+// Reputation a senior earns from a junior's activity
+const (
+	RepSourceJuniorKill    = "JUNIOR_KILL"
+	RepSourceJuniorLevelUp = "JUNIOR_LEVEL_UP"
+
+	// JuniorKillRep is earned for each monster a junior kills. A junior
+	// levelling up earns its senior the junior's new level in reputation.
+	JuniorKillRep = uint32(1)
+)
+
+var (
+	ErrUnknownEntitlement       = errors.New("unknown family entitlement")
+	ErrEntitlementLimitReached  = errors.New("family entitlement daily usage limit reached")
+	ErrEntitlementTargetMissing = errors.New("family entitlement target is not in the family")
+	ErrNoSenior                 = errors.New("member has no senior")
+	ErrNoEntitlementUse         = errors.New("member has no use of the entitlement to refund")
+)
+
+// UseEntitlement spends a member's reputation on a family entitlement. The
+// effect itself (teleport, summon, rate buff) is carried out by the services
+// consuming the ENTITLEMENT_USED event.
+func (p *ProcessorImpl) UseEntitlement(buf *message.Buffer) func(characterId uint32, entitlementType familyconst.EntitlementType, targetId uint32, beneficiaryIds []uint32) model.Provider[FamilyMember] {
+	return func(characterId uint32, entitlementType familyconst.EntitlementType, targetId uint32, beneficiaryIds []uint32) model.Provider[FamilyMember] {
+		return func() (FamilyMember, error) {
+			p.log.WithFields(logrus.Fields{
+				"characterId": characterId,
+				"entitlement": entitlementType,
+				"targetId":    targetId,
+			}).Info("Using family entitlement")
+
+			memberModel, err := p.GetByCharacterId(characterId)
+			if err != nil {
+				return FamilyMember{}, err
+			}
+
+			fail := func(code string, cause error, amount uint32) (FamilyMember, error) {
+				if buf != nil {
+					if putErr := buf.Put(familymsg.EnvEventTopicErrors, RepErrorEventProvider(memberModel.World(), characterId, code, cause.Error(), amount)); putErr != nil {
+						p.log.WithError(putErr).Error("Failed to add rep error event to buffer")
+					}
+				}
+				return FamilyMember{}, cause
+			}
+
+			e, ok := familyconst.GetEntitlement(entitlementType)
+			if !ok {
+				return fail("UNKNOWN_ENTITLEMENT", ErrUnknownEntitlement, 0)
+			}
+			if memberModel.Rep() < e.RepCost {
+				return fail("INSUFFICIENT_REP", ErrInsufficientRep, e.RepCost)
+			}
+			if !memberModel.CanUseEntitlement(e) {
+				return fail("ENTITLEMENT_LIMIT_REACHED", ErrEntitlementLimitReached, e.RepCost)
+			}
+			if e.Target == familyconst.TargetMember {
+				inFamily, err := p.isRelated(characterId, targetId)
+				if err != nil {
+					return FamilyMember{}, err
+				}
+				if !inFamily {
+					return fail("TARGET_NOT_IN_FAMILY", ErrEntitlementTargetMissing, e.RepCost)
+				}
+			}
+
+			// The checks above reject the common cases with the member's
+			// snapshot; the spend re-checks both against the row itself, so a
+			// concurrent use cannot double-spend the reputation.
+			updatedMember, err := model.Map(Make)(SpendRepOnEntitlement(p.db.WithContext(p.ctx), p.log)(characterId, e.Type, e.RepCost, e.UsageLimit))()
+			if errors.Is(err, ErrInsufficientRep) {
+				return fail("INSUFFICIENT_REP", ErrInsufficientRep, e.RepCost)
+			}
+			if errors.Is(err, ErrEntitlementLimitReached) {
+				return fail("ENTITLEMENT_LIMIT_REACHED", ErrEntitlementLimitReached, e.RepCost)
+			}
+			if err != nil {
+				return FamilyMember{}, err
+			}
+
+			if buf != nil {
+				if putErr := buf.Put(familymsg.EnvEventTopicRep, EntitlementUsedEventProvider(updatedMember.World(), characterId, byte(e.Type), e.RepCost, updatedMember.Rep(), targetId, beneficiaryIds)); putErr != nil {
+					p.log.WithError(putErr).Error("Failed to add entitlement used event to buffer")
+				}
+			}
+
+			return updatedMember, nil
+		}
+	}
+}
+
+// RefundEntitlement gives back the reputation and daily use an entitlement
+// cost when its effect could not be carried out, e.g. a teleport whose target
+// left the channel before the warp.
+func (p *ProcessorImpl) RefundEntitlement(buf *message.Buffer) func(characterId uint32, entitlementType familyconst.EntitlementType, reason string) model.Provider[FamilyMember] {
+	return func(characterId uint32, entitlementType familyconst.EntitlementType, reason string) model.Provider[FamilyMember] {
+		return func() (FamilyMember, error) {
+			p.log.WithFields(logrus.Fields{
+				"characterId": characterId,
+				"entitlement": entitlementType,
+				"reason":      reason,
+			}).Info("Refunding family entitlement")
+
+			e, ok := familyconst.GetEntitlement(entitlementType)
+			if !ok {
+				return FamilyMember{}, ErrUnknownEntitlement
+			}
+			refunded, err := model.Map(Make)(RefundEntitlement(p.db.WithContext(p.ctx), p.log)(characterId, e.Type, e.RepCost))()
+			if err != nil {
+				return FamilyMember{}, err
+			}
+
+			if buf != nil {
+				if putErr := buf.Put(familymsg.EnvEventTopicRep, EntitlementRefundedEventProvider(refunded.World(), characterId, byte(e.Type), e.RepCost, refunded.Rep(), reason)); putErr != nil {
+					p.log.WithError(putErr).Error("Failed to add entitlement refunded event to buffer")
+				}
+			}
+			return refunded, nil
+		}
+	}
+}
+
+// isRelated reports whether targetId belongs to characterId's family tree
+func (p *ProcessorImpl) isRelated(characterId uint32, targetId uint32) (bool, error) {
+	if targetId == 0 || targetId == characterId {
+		return false, nil
+	}
+	tree, err := p.GetFamilyTree(characterId)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range tree {
+		if m.CharacterId() == targetId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AwardSeniorRep credits a junior's senior with reputation earned by the
+// junior's activity. The award is trimmed to what remains of the senior's
+// daily cap. A capped senior earns nothing, and no error event is emitted
+// because the junior did nothing wrong.
+func (p *ProcessorImpl) AwardSeniorRep(buf *message.Buffer) func(juniorId uint32, amount uint32, source string) model.Provider[FamilyMember] {
+	return func(juniorId uint32, amount uint32, source string) model.Provider[FamilyMember] {
+		return func() (FamilyMember, error) {
+			juniorModel, err := p.GetByCharacterId(juniorId)
+			if err != nil {
+				return FamilyMember{}, err
+			}
+			if !juniorModel.HasSenior() {
+				return FamilyMember{}, ErrNoSenior
+			}
+
+			seniorId := *juniorModel.SeniorId()
+			for {
+				seniorModel, err := p.GetByCharacterId(seniorId)
+				if err != nil {
+					return FamilyMember{}, err
+				}
+				if seniorModel.IsRepCapReached() {
+					return FamilyMember{}, ErrRepCapExceeded
+				}
+				granted := amount
+				if remaining := dailyRepCap - seniorModel.DailyRep(); granted > remaining {
+					granted = remaining
+				}
+				if granted == 0 {
+					return seniorModel, nil
+				}
+
+				p.log.WithFields(logrus.Fields{
+					"seniorId": seniorId,
+					"juniorId": juniorId,
+					"amount":   granted,
+					"source":   source,
+				}).Debug("Awarding senior reputation for junior activity")
+
+				// The award re-checks the cap against the row; if a concurrent
+				// award took the headroom this snapshot saw, trim again from a
+				// fresh read.
+				updatedSenior, err := model.Map(Make)(AwardRep(p.db.WithContext(p.ctx), p.log)(seniorId, granted, dailyRepCap))()
+				if errors.Is(err, ErrRepCapExceeded) {
+					continue
+				}
+				if err != nil {
+					return FamilyMember{}, err
+				}
+
+				if buf != nil {
+					if putErr := buf.Put(familymsg.EnvEventTopicRep, RepGainedEventProvider(updatedSenior.World(), seniorId, granted, updatedSenior.DailyRep(), source, juniorId)); putErr != nil {
+						p.log.WithError(putErr).Error("Failed to add rep gained event to buffer")
+					}
+				}
+
+				return updatedSenior, nil
+			}
+		}
+	}
+}
+
+// UpdateLevel records a member's new level, keeping link level checks current
+func (p *ProcessorImpl) UpdateLevel(characterId uint32, level uint16) model.Provider[FamilyMember] {
+	return func() (FamilyMember, error) {
+		memberModel, err := p.GetByCharacterId(characterId)
+		if err != nil {
+			return FamilyMember{}, err
+		}
+		if memberModel.Level() == level {
+			return memberModel, nil
+		}
+
+		updatedMember, err := memberModel.Builder().
+			SetLevel(level).
+			Touch().
+			Build()
+		if err != nil {
+			return FamilyMember{}, err
+		}
+
+		if _, err := SaveMember(p.db.WithContext(p.ctx), p.log)(updatedMember)(); err != nil {
+			return FamilyMember{}, err
+		}
+		return updatedMember, nil
+	}
+}
+
+// UseEntitlementAndEmit spends reputation on an entitlement and emits appropriate events
+func (p *ProcessorImpl) UseEntitlementAndEmit(_ uuid.UUID, characterId uint32, entitlementType familyconst.EntitlementType, targetId uint32, beneficiaryIds []uint32) model.Provider[FamilyMember] {
+	return func() (FamilyMember, error) {
+		return message.EmitWithResult[FamilyMember, struct{}](p.producer)(func(buf *message.Buffer) func(struct{}) (FamilyMember, error) {
+			return func(struct{}) (FamilyMember, error) {
+				return p.UseEntitlement(buf)(characterId, entitlementType, targetId, beneficiaryIds)()
+			}
+		})(struct{}{})
+	}
+}
+
+// RefundEntitlementAndEmit refunds an entitlement and emits appropriate events
+func (p *ProcessorImpl) RefundEntitlementAndEmit(_ uuid.UUID, characterId uint32, entitlementType familyconst.EntitlementType, reason string) model.Provider[FamilyMember] {
+	return func() (FamilyMember, error) {
+		return message.EmitWithResult[FamilyMember, struct{}](p.producer)(func(buf *message.Buffer) func(struct{}) (FamilyMember, error) {
+			return func(struct{}) (FamilyMember, error) {
+				return p.RefundEntitlement(buf)(characterId, entitlementType, reason)()
+			}
+		})(struct{}{})
+	}
+}
+
+// AwardSeniorRepAndEmit credits a senior for junior activity and emits appropriate events
+func (p *ProcessorImpl) AwardSeniorRepAndEmit(_ uuid.UUID, juniorId uint32, amount uint32, source string) model.Provider[FamilyMember] {
+	return func() (FamilyMember, error) {
+		return message.EmitWithResult[FamilyMember, struct{}](p.producer)(func(buf *message.Buffer) func(struct{}) (FamilyMember, error) {
+			return func(struct{}) (FamilyMember, error) {
+				return p.AwardSeniorRep(buf)(juniorId, amount, source)()
+			}
+		})(struct{}{})
+	}
+}
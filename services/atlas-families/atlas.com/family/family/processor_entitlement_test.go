@@ -0,0 +1,199 @@
+package family
+
+import (
+	"atlas-family/kafka/message"
+	"encoding/json"
+	"testing"
+
+	familymsg "atlas-family/kafka/message/family"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-database/databasetest"
+)
+
+func entitlementFixture(t *testing.T) (*gorm.DB, *ProcessorImpl, uuid.UUID) {
+	t.Helper()
+	db := databasetest.NewInMemoryTenantDB(t, Migration)
+	tid := uuid.New()
+	ctx := databasetest.TenantContext(tid)
+	l, _ := test.NewNullLogger()
+	return db, NewProcessor(l, ctx, db).(*ProcessorImpl), tid
+}
+
+func eventTypes(buf *message.Buffer, topic string) []string {
+	var result []string
+	for _, m := range buf.GetAll()[topic] {
+		var e familymsg.Event[json.RawMessage]
+		if err := json.Unmarshal(m.Value, &e); err == nil {
+			result = append(result, e.Type)
+		}
+	}
+	return result
+}
+
+// A senior with enough reputation pays for an entitlement once per day; the
+// second use the same day is refused without charging.
+func TestUseEntitlement_ChargesRepAndEnforcesDailyLimit(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2001, TenantId: tid, Level: 100, World: 0, Rep: 2000}).Error)
+
+	buf := message.NewBuffer()
+	m, err := p.UseEntitlement(buf)(2001, familyconst.EntitlementSelfDrop15, 0, nil)()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1300), m.Rep())
+	require.Equal(t, uint32(1), m.EntitlementUseCount(familyconst.EntitlementSelfDrop15))
+	require.Equal(t, []string{familymsg.EventTypeEntitlementUsed}, eventTypes(buf, familymsg.EnvEventTopicRep))
+
+	buf = message.NewBuffer()
+	_, err = p.UseEntitlement(buf)(2001, familyconst.EntitlementSelfDrop15, 0, nil)()
+	require.ErrorIs(t, err, ErrEntitlementLimitReached)
+	require.Equal(t, []string{familymsg.EventTypeRepError}, eventTypes(buf, familymsg.EnvEventTopicErrors))
+
+	reloaded, err := p.GetByCharacterId(2001)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1300), reloaded.Rep())
+}
+
+func TestUseEntitlement_RejectsInsufficientRep(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2002, TenantId: tid, Level: 100, World: 0, Rep: 100}).Error)
+
+	_, err := p.UseEntitlement(nil)(2002, familyconst.EntitlementTeleport, 0, nil)()
+	require.ErrorIs(t, err, ErrInsufficientRep)
+}
+
+// Teleport and summon only reach characters in the user's family tree.
+func TestUseEntitlement_MemberTargetMustBeInFamily(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	seniorId := uint32(2003)
+	require.NoError(t, db.Create(&Entity{CharacterId: seniorId, TenantId: tid, Level: 100, World: 0, Rep: 2000, JuniorIds: []uint32{2004}}).Error)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2004, TenantId: tid, Level: 90, World: 0, SeniorId: &seniorId}).Error)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2005, TenantId: tid, Level: 90, World: 0}).Error)
+
+	_, err := p.UseEntitlement(nil)(seniorId, familyconst.EntitlementSummon, 2005, nil)()
+	require.ErrorIs(t, err, ErrEntitlementTargetMissing)
+
+	m, err := p.UseEntitlement(nil)(seniorId, familyconst.EntitlementSummon, 2004, nil)()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1500), m.Rep())
+}
+
+// Junior activity credits the senior, trimmed to the senior's daily cap.
+func TestAwardSeniorRep_ClampsToDailyCap(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	seniorId := uint32(2006)
+	require.NoError(t, db.Create(&Entity{CharacterId: seniorId, TenantId: tid, Level: 100, World: 0, DailyRep: dailyRepCap - 10, JuniorIds: []uint32{2007}}).Error)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2007, TenantId: tid, Level: 90, World: 0, SeniorId: &seniorId}).Error)
+
+	buf := message.NewBuffer()
+	m, err := p.AwardSeniorRep(buf)(2007, 50, RepSourceJuniorLevelUp)()
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), m.Rep())
+	require.True(t, m.IsRepCapReached())
+	require.Equal(t, []string{familymsg.EventTypeRepGained}, eventTypes(buf, familymsg.EnvEventTopicRep))
+
+	_, err = p.AwardSeniorRep(nil)(2007, JuniorKillRep, RepSourceJuniorKill)()
+	require.ErrorIs(t, err, ErrRepCapExceeded)
+
+	_, err = p.AwardSeniorRep(nil)(seniorId, JuniorKillRep, RepSourceJuniorKill)()
+	require.ErrorIs(t, err, ErrNoSenior)
+}
+
+// Characters new to the family system are registered when they first link.
+func TestAddJunior_CreatesMissingMembers(t *testing.T) {
+	_, p, _ := entitlementFixture(t)
+
+	senior, err := p.AddJunior(nil)(world.Id(0), 2008, 100, 2009, 90)()
+	require.NoError(t, err)
+	require.True(t, senior.HasJunior(2009))
+
+	junior, err := p.GetByCharacterId(2009)
+	require.NoError(t, err)
+	require.NotNil(t, junior.SeniorId())
+	require.Equal(t, uint32(2008), *junior.SeniorId())
+}
+
+// The spend checks the balance and usage on the row itself, so a use decided
+// on a stale snapshot cannot take reputation the member no longer has, and a
+// use past the daily limit leaves the reputation untouched.
+func TestSpendRepOnEntitlement_ChecksTheRow(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2010, TenantId: tid, Level: 100, World: 0, Rep: 1000}).Error)
+	spend := SpendRepOnEntitlement(db.WithContext(p.ctx), p.log)
+
+	e, err := spend(2010, familyconst.EntitlementSelfDrop15, 700, 2)()
+	require.NoError(t, err)
+	require.Equal(t, uint32(300), e.Rep)
+	require.Equal(t, uint32(1), e.EntitlementUses[byte(familyconst.EntitlementSelfDrop15)])
+
+	_, err = spend(2010, familyconst.EntitlementSelfDrop15, 700, 2)()
+	require.ErrorIs(t, err, ErrInsufficientRep)
+
+	_, err = spend(2010, familyconst.EntitlementSelfDrop15, 100, 1)()
+	require.ErrorIs(t, err, ErrEntitlementLimitReached)
+
+	reloaded, err := p.GetByCharacterId(2010)
+	require.NoError(t, err)
+	require.Equal(t, uint32(300), reloaded.Rep(), "a refused spend charges nothing")
+	require.Equal(t, uint32(1), reloaded.EntitlementUseCount(familyconst.EntitlementSelfDrop15))
+}
+
+// A teleport or summon the channel could not carry out is refunded once:
+// the reputation and the day's use come back, and a repeated refund is
+// refused without crediting anything.
+func TestRefundEntitlement_ReturnsRepAndUseOnce(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	seniorId := uint32(2020)
+	require.NoError(t, db.Create(&Entity{CharacterId: seniorId, TenantId: tid, Level: 100, World: 0, Rep: 2000, JuniorIds: []uint32{2021}}).Error)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2021, TenantId: tid, Level: 90, World: 0, SeniorId: &seniorId}).Error)
+
+	used, err := p.UseEntitlement(nil)(seniorId, familyconst.EntitlementTeleport, 2021, nil)()
+	require.NoError(t, err)
+	require.Less(t, used.Rep(), uint32(2000))
+
+	buf := message.NewBuffer()
+	refunded, err := p.RefundEntitlement(buf)(seniorId, familyconst.EntitlementTeleport, "TARGET_UNREACHABLE")()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2000), refunded.Rep())
+	require.Zero(t, refunded.EntitlementUseCount(familyconst.EntitlementTeleport))
+	require.Equal(t, []string{familymsg.EventTypeEntitlementRefunded}, eventTypes(buf, familymsg.EnvEventTopicRep))
+
+	_, err = p.RefundEntitlement(nil)(seniorId, familyconst.EntitlementTeleport, "TARGET_UNREACHABLE")()
+	require.ErrorIs(t, err, ErrNoEntitlementUse)
+
+	reloaded, err := p.GetByCharacterId(seniorId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2000), reloaded.Rep(), "a repeated refund credits nothing")
+}
+
+// An award only adds to rep and daily_rep, so it cannot undo a spend or
+// refund made since the award's snapshot, and it re-checks the daily cap
+// against the row rather than trusting the caller's headroom.
+func TestAwardRep_UpdatesOnlyReputation(t *testing.T) {
+	db, p, tid := entitlementFixture(t)
+	require.NoError(t, db.Create(&Entity{CharacterId: 2030, TenantId: tid, Level: 100, World: 0, Rep: 1000, DailyRep: dailyRepCap - 20}).Error)
+	award := AwardRep(db.WithContext(p.ctx), p.log)
+
+	_, err := SpendRepOnEntitlement(db.WithContext(p.ctx), p.log)(2030, familyconst.EntitlementSelfDrop15, 700, 1)()
+	require.NoError(t, err)
+
+	e, err := award(2030, 15, dailyRepCap)()
+	require.NoError(t, err)
+	require.Equal(t, uint32(315), e.Rep)
+	require.Equal(t, dailyRepCap-5, e.DailyRep)
+	require.Equal(t, uint32(1), e.EntitlementUses[byte(familyconst.EntitlementSelfDrop15)])
+
+	_, err = award(2030, 10, dailyRepCap)()
+	require.ErrorIs(t, err, ErrRepCapExceeded)
+
+	reloaded, err := p.GetByCharacterId(2030)
+	require.NoError(t, err)
+	require.Equal(t, uint32(315), reloaded.Rep(), "an award past the cap credits nothing")
+	require.Equal(t, dailyRepCap-5, reloaded.DailyRep())
+}
@@ -54,3 +54,18 @@ func TestAddJunior_RollsBackSeniorSaveWhenJuniorSaveFails(t *testing.T) {
 	require.Empty(t, senior.JuniorIds, "senior's junior-list update must roll back")
 	require.Nil(t, junior.SeniorId, "junior must remain unlinked")
 }
+
+// A database failure while registering a new member is a real failure, not a
+// missing senior, and must reach the caller unchanged.
+func TestAddJunior_DatabaseFailureIsNotSeniorNotFound(t *testing.T) {
+	db := databasetest.NewInMemoryTenantDB(t, Migration)
+	ctx := databasetest.TenantContext(uuid.New())
+
+	failNthWriteTo(t, db, "family_members", 1)
+
+	l, _ := test.NewNullLogger()
+	p := NewProcessor(l, ctx, db).(*ProcessorImpl)
+	_, err := p.AddJunior(nil)(world.Id(0), 1003, 100, 1004, 100)()
+	require.ErrorContains(t, err, "injected failure")
+	require.NotErrorIs(t, err, ErrSeniorNotFound)
+}
@@ -26,9 +26,23 @@ func LinkBrokenEventProvider(worldId world.Id, characterId uint32, seniorId uint
 }
 
 // RepGainedEventProvider creates a Kafka message provider for reputation gained events
-func RepGainedEventProvider(worldId world.Id, characterId uint32, repGained uint32, dailyRep uint32, source string) model.Provider[[]kafka.Message] {
+func RepGainedEventProvider(worldId world.Id, characterId uint32, repGained uint32, dailyRep uint32, source string, fromId uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(characterId))
-	value := family.NewRepGainedEvent(worldId, characterId, repGained, dailyRep, source)
+	value := family.NewRepGainedEvent(worldId, characterId, repGained, dailyRep, source, fromId)
+	return producer.SingleMessageProvider(key, value)
+}
+
+// EntitlementUsedEventProvider creates a Kafka message provider for entitlement used events
+func EntitlementUsedEventProvider(worldId world.Id, characterId uint32, entitlement byte, repCost uint32, rep uint32, targetId uint32, beneficiaryIds []uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := family.NewEntitlementUsedEvent(worldId, characterId, entitlement, repCost, rep, targetId, beneficiaryIds)
+	return producer.SingleMessageProvider(key, value)
+}
+
+// EntitlementRefundedEventProvider creates a Kafka message provider for entitlement refunded events
+func EntitlementRefundedEventProvider(worldId world.Id, characterId uint32, entitlement byte, repRefunded uint32, rep uint32, reason string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := family.NewEntitlementRefundedEvent(worldId, characterId, entitlement, repRefunded, rep, reason)
 	return producer.SingleMessageProvider(key, value)
 }
 
@@ -62,23 +62,24 @@ func GetFamilyTreeProvider(characterId uint32) database.EntityProvider[[]Entity]
 		familyMembers = append(familyMembers, member)
 
 		// Get all family members related to this character
-		// This includes: senior, juniors, and siblings (other juniors of the same senior)
+		// This includes: seniors up to the leader, juniors and their juniors, and siblings
+		// (other juniors of the same senior)
 		relatedIds := make(map[uint32]bool)
 		relatedIds[characterId] = true
 
-		// Add senior if exists
-		if member.SeniorId != nil {
-			seniorId := *member.SeniorId
-			if !relatedIds[seniorId] {
-				senior, err := GetByCharacterIdProvider(seniorId)(db)()
-				if err == nil {
-					familyMembers = append(familyMembers, senior)
-					relatedIds[seniorId] = true
-				}
+		// Add seniors up the chain to the family leader
+		next := member.SeniorId
+		for next != nil && !relatedIds[*next] {
+			senior, err := GetByCharacterIdProvider(*next)(db)()
+			if err != nil {
+				break
 			}
+			familyMembers = append(familyMembers, senior)
+			relatedIds[senior.CharacterId] = true
+			next = senior.SeniorId
 		}
 
-		// Add juniors if exist
+		// Add juniors and their juniors
 		if len(member.JuniorIds) > 0 {
 			juniors, err := GetBySeniorIdProvider(characterId)(db)()
 			if err == nil {
@@ -88,6 +89,21 @@ func GetFamilyTreeProvider(characterId uint32) database.EntityProvider[[]Entity]
 						relatedIds[junior.CharacterId] = true
 					}
 				}
+				for _, junior := range juniors {
+					if len(junior.JuniorIds) == 0 {
+						continue
+					}
+					subJuniors, err := GetBySeniorIdProvider(junior.CharacterId)(db)()
+					if err != nil {
+						continue
+					}
+					for _, subJunior := range subJuniors {
+						if !relatedIds[subJunior.CharacterId] {
+							familyMembers = append(familyMembers, subJunior)
+							relatedIds[subJunior.CharacterId] = true
+						}
+					}
+				}
 			}
 		}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
@@ -21,8 +22,10 @@ type RestFamilyMember struct {
 	DailyRep    uint32   `json:"dailyRep"`
 	Level       uint16   `json:"level"`
 	World       world.Id `json:"world"`
-	CreatedAt   string   `json:"createdAt"`
-	UpdatedAt   string   `json:"updatedAt"`
+	// EntitlementUses counts today's uses, keyed by entitlement type
+	EntitlementUses map[byte]uint32 `json:"entitlementUses,omitempty"`
+	CreatedAt       string          `json:"createdAt"`
+	UpdatedAt       string          `json:"updatedAt"`
 }
 
 // GetID returns the ID for JSON:API compatibility
@@ -41,19 +44,28 @@ func Transform(fm FamilyMember) (RestFamilyMember, error) {
 	juniorIds := make([]uint32, len(fm.JuniorIds()))
 	copy(juniorIds, fm.JuniorIds())
 
+	var uses map[byte]uint32
+	if len(fm.EntitlementUses()) > 0 {
+		uses = make(map[byte]uint32, len(fm.EntitlementUses()))
+		for t, c := range fm.EntitlementUses() {
+			uses[byte(t)] = c
+		}
+	}
+
 	return RestFamilyMember{
-		ID:          strconv.FormatUint(uint64(fm.Id()), 10),
-		Type:        "familyMembers",
-		CharacterId: fm.CharacterId(),
-		TenantId:    fm.TenantId().String(),
-		SeniorId:    fm.SeniorId(),
-		JuniorIds:   juniorIds,
-		Rep:         fm.Rep(),
-		DailyRep:    fm.DailyRep(),
-		Level:       fm.Level(),
-		World:       fm.World(),
-		CreatedAt:   fm.CreatedAt().Format(time.RFC3339),
-		UpdatedAt:   fm.UpdatedAt().Format(time.RFC3339),
+		ID:              strconv.FormatUint(uint64(fm.Id()), 10),
+		Type:            "familyMembers",
+		CharacterId:     fm.CharacterId(),
+		TenantId:        fm.TenantId().String(),
+		SeniorId:        fm.SeniorId(),
+		JuniorIds:       juniorIds,
+		Rep:             fm.Rep(),
+		DailyRep:        fm.DailyRep(),
+		Level:           fm.Level(),
+		World:           fm.World(),
+		EntitlementUses: uses,
+		CreatedAt:       fm.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:       fm.UpdatedAt().Format(time.RFC3339),
 	}, nil
 }
 
@@ -86,11 +98,17 @@ func Extract(r RestFamilyMember) (FamilyMember, error) {
 	juniorIds := make([]uint32, len(r.JuniorIds))
 	copy(juniorIds, r.JuniorIds)
 
+	uses := make(map[familyconst.EntitlementType]uint32, len(r.EntitlementUses))
+	for t, c := range r.EntitlementUses {
+		uses[familyconst.EntitlementType(t)] = c
+	}
+
 	// Use the builder pattern to create the domain model
 	builder := NewBuilder(r.CharacterId, tenantId, r.Level, r.World).
 		SetId(uint32(id)).
 		SetRep(r.Rep).
 		SetDailyRep(r.DailyRep).
+		SetEntitlementUses(uses).
 		SetCreatedAt(createdAt).
 		SetUpdatedAt(updatedAt)
 
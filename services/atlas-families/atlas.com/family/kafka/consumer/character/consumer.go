@@ -33,6 +33,9 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventDeleted(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventLevelChanged(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -51,3 +54,24 @@ func handleStatusEventDeleted(db *gorm.DB) func(l logrus.FieldLogger, ctx contex
 		}
 	}
 }
+
+// handleStatusEventLevelChanged keeps a family member's level current and
+// credits their senior with reputation for the level up.
+func handleStatusEventLevelChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventLevelChangedBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventLevelChangedBody]) {
+		if e.Type != character2.EventCharacterStatusTypeLevelChanged {
+			return
+		}
+
+		p := family.NewProcessor(l, ctx, db)
+		if _, err := p.UpdateLevel(e.CharacterId, uint16(e.Body.Current))(); err != nil {
+			l.WithError(err).Debugf("Unable to update family level of character [%d] (may not be a member).", e.CharacterId)
+			return
+		}
+
+		_, err := p.AwardSeniorRepAndEmit(uuid.New(), e.CharacterId, uint32(e.Body.Current), family.RepSourceJuniorLevelUp)()
+		if err != nil {
+			l.WithError(err).Debugf("No family reputation awarded for level up of character [%d].", e.CharacterId)
+		}
+	}
+}
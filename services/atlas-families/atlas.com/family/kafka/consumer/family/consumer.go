@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
@@ -44,6 +45,12 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleDeductRepCommand(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleUseEntitlementCommand(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRefundEntitlementCommand(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -65,23 +72,8 @@ func handleAddJuniorCommand(db *gorm.DB) func(logrus.FieldLogger, context.Contex
 			return
 		}
 
-		fp := family.NewProcessor(l, ctx, db)
-
-		// Ensure both senior and junior exist as family members
-		_, err := fp.GetByCharacterId(cmd.CharacterId)
-		if err != nil {
-			l.WithError(err).Error("Failed to ensure senior member exists")
-			return
-		}
-
-		_, err = fp.GetByCharacterId(cmd.Body.JuniorId)
-		if err != nil {
-			l.WithError(err).Error("Failed to ensure junior member exists")
-			return
-		}
-
-		// Process the add junior operation
-		_, err = fp.AddJuniorAndEmit(cmd.TransactionId, cmd.WorldId, cmd.CharacterId, cmd.Body.SeniorLevel, cmd.Body.JuniorId, cmd.Body.JuniorLevel)()
+		// Process the add junior operation; members new to the family system are created
+		_, err := family.NewProcessor(l, ctx, db).AddJuniorAndEmit(cmd.TransactionId, cmd.WorldId, cmd.CharacterId, cmd.Body.SeniorLevel, cmd.Body.JuniorId, cmd.Body.JuniorLevel)()
 		if err != nil {
 			l.WithError(err).Error("Failed to process add junior command")
 			return
@@ -201,3 +193,51 @@ func handleDeductRepCommand(db *gorm.DB) func(logrus.FieldLogger, context.Contex
 		l.Info("Successfully processed deduct reputation command")
 	}
 }
+
+// handleUseEntitlementCommand handles use entitlement commands
+func handleUseEntitlementCommand(db *gorm.DB) func(logrus.FieldLogger, context.Context, familymsg.Command[familymsg.UseEntitlementCommandBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, cmd familymsg.Command[familymsg.UseEntitlementCommandBody]) {
+		if cmd.Type != familymsg.CommandTypeUseEntitlement {
+			return
+		}
+
+		l.WithFields(logrus.Fields{
+			"transactionId": cmd.TransactionId,
+			"characterId":   cmd.CharacterId,
+			"entitlement":   cmd.Body.Entitlement,
+			"targetId":      cmd.Body.TargetId,
+		}).Info("Processing use entitlement command")
+
+		_, err := family.NewProcessor(l, ctx, db).UseEntitlementAndEmit(cmd.TransactionId, cmd.CharacterId, familyconst.EntitlementType(cmd.Body.Entitlement), cmd.Body.TargetId, cmd.Body.BeneficiaryIds)()
+		if err != nil {
+			l.WithError(err).Error("Failed to process use entitlement command")
+			return
+		}
+
+		l.Info("Successfully processed use entitlement command")
+	}
+}
+
+// handleRefundEntitlementCommand handles refund entitlement commands
+func handleRefundEntitlementCommand(db *gorm.DB) func(logrus.FieldLogger, context.Context, familymsg.Command[familymsg.RefundEntitlementCommandBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, cmd familymsg.Command[familymsg.RefundEntitlementCommandBody]) {
+		if cmd.Type != familymsg.CommandTypeRefundEntitlement {
+			return
+		}
+
+		l.WithFields(logrus.Fields{
+			"transactionId": cmd.TransactionId,
+			"characterId":   cmd.CharacterId,
+			"entitlement":   cmd.Body.Entitlement,
+			"reason":        cmd.Body.Reason,
+		}).Info("Processing refund entitlement command")
+
+		_, err := family.NewProcessor(l, ctx, db).RefundEntitlementAndEmit(cmd.TransactionId, cmd.CharacterId, familyconst.EntitlementType(cmd.Body.Entitlement), cmd.Body.Reason)()
+		if err != nil {
+			l.WithError(err).Error("Failed to process refund entitlement command")
+			return
+		}
+
+		l.Info("Successfully processed refund entitlement command")
+	}
+}
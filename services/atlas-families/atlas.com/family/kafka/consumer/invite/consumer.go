@@ -0,0 +1,74 @@
+package invite
+
+import (
+	"atlas-family/character"
+	"atlas-family/family"
+	consumer2 "atlas-family/kafka/consumer"
+	invite2 "atlas-family/kafka/message/invite"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/invite"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("invite_status_event")(invite2.EnvEventStatusTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(invite2.EnvEventStatusTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAcceptedStatusEvent(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleAcceptedStatusEvent links the junior who accepted a family invite to
+// the senior who sent it.
+func handleAcceptedStatusEvent(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, e invite2.StatusEvent[invite2.AcceptedEventBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e invite2.StatusEvent[invite2.AcceptedEventBody]) {
+		if e.Type != invite.StatusTypeAccepted {
+			return
+		}
+
+		if e.InviteType != invite.TypeFamily {
+			return
+		}
+
+		seniorId := uint32(e.Body.OriginatorId)
+		juniorId := uint32(e.Body.TargetId)
+		cp := character.NewProcessor(l, ctx)
+		senior, err := cp.GetById(seniorId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to retrieve senior [%d] for family invite.", seniorId)
+			return
+		}
+		junior, err := cp.GetById(juniorId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to retrieve junior [%d] for family invite.", juniorId)
+			return
+		}
+
+		_, err = family.NewProcessor(l, ctx, db).AddJuniorAndEmit(uuid.New(), e.WorldId, seniorId, uint16(senior.Level()), juniorId, uint16(junior.Level()))()
+		if err != nil {
+			l.WithError(err).Errorf("Unable to link junior [%d] to senior [%d].", juniorId, seniorId)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package monster
+
+import (
+	"atlas-family/family"
+	consumer2 "atlas-family/kafka/consumer"
+	"atlas-family/kafka/message/monster"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("monster_status")(monster.EnvEventTopicMonsterStatus)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(monster.EnvEventTopicMonsterStatus)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleMonsterKilledEvent(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleMonsterKilledEvent credits the killer's senior with reputation.
+func handleMonsterKilledEvent(db *gorm.DB) message.Handler[monster.StatusEvent[monster.StatusEventKilledBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e monster.StatusEvent[monster.StatusEventKilledBody]) {
+		if e.Type != monster.EventMonsterStatusKilled {
+			return
+		}
+
+		if e.Body.ActorId == 0 {
+			return
+		}
+
+		_, err := family.NewProcessor(l, ctx, db).AwardSeniorRepAndEmit(uuid.New(), e.Body.ActorId, family.JuniorKillRep, family.RepSourceJuniorKill)()
+		if err != nil {
+			// Most killers are not juniors in a family, or their senior is capped for the day.
+			l.WithError(err).Debugf("No family reputation awarded for kill by character [%d].", e.Body.ActorId)
+		}
+	}
+}
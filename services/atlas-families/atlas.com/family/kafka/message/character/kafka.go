@@ -3,12 +3,14 @@ package character
 import (
 	"github.com/google/uuid"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
 const (
-	EnvEventTopicCharacterStatus    = "EVENT_TOPIC_CHARACTER_STATUS"
-	EventCharacterStatusTypeDeleted = "DELETED"
+	EnvEventTopicCharacterStatus         = "EVENT_TOPIC_CHARACTER_STATUS"
+	EventCharacterStatusTypeDeleted      = "DELETED"
+	EventCharacterStatusTypeLevelChanged = "LEVEL_CHANGED"
 )
 
 type StatusEvent[E any] struct {
@@ -20,3 +22,9 @@ type StatusEvent[E any] struct {
 }
 
 type StatusEventDeletedBody struct{}
+
+type StatusEventLevelChangedBody struct {
+	ChannelId channel.Id `json:"channelId"`
+	Amount    byte       `json:"amount"`
+	Current   byte       `json:"current"`
+}
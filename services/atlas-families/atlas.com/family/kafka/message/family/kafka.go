@@ -58,6 +58,20 @@ type AwardRepCommandBody struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// UseEntitlementCommandBody represents the body for spending reputation on an entitlement
+type UseEntitlementCommandBody struct {
+	Entitlement    byte     `json:"entitlement"`
+	TargetId       uint32   `json:"targetId,omitempty"`
+	BeneficiaryIds []uint32 `json:"beneficiaryIds,omitempty"`
+}
+
+// RefundEntitlementCommandBody represents the body for refunding an entitlement
+// whose effect could not be carried out
+type RefundEntitlementCommandBody struct {
+	Entitlement byte   `json:"entitlement"`
+	Reason      string `json:"reason,omitempty"`
+}
+
 // RegisterKillActivityCommandBody represents the body for registering kill activity
 type RegisterKillActivityCommandBody struct {
 	KillCount uint32    `json:"killCount"`
@@ -100,6 +114,7 @@ type RepGainedEventBody struct {
 	RepGained uint32    `json:"repGained"`
 	DailyRep  uint32    `json:"dailyRep"`
 	Source    string    `json:"source"`
+	FromId    uint32    `json:"fromId,omitempty"`
 	Timestamp time.Time `json:"timestamp"`
 }
 
@@ -139,6 +154,25 @@ type BuffRedeemedEventBody struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// EntitlementUsedEventBody represents the body for entitlement used events
+type EntitlementUsedEventBody struct {
+	Entitlement    byte      `json:"entitlement"`
+	RepCost        uint32    `json:"repCost"`
+	Rep            uint32    `json:"rep"`
+	TargetId       uint32    `json:"targetId,omitempty"`
+	BeneficiaryIds []uint32  `json:"beneficiaryIds,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// EntitlementRefundedEventBody represents the body for entitlement refunded events
+type EntitlementRefundedEventBody struct {
+	Entitlement byte      `json:"entitlement"`
+	RepRefunded uint32    `json:"repRefunded"`
+	Rep         uint32    `json:"rep"`
+	Reason      string    `json:"reason,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
 // TeleportUsedEventBody represents the body for teleport used events
 type TeleportUsedEventBody struct {
 	TargetId  uint32    `json:"targetId"`
@@ -177,25 +211,31 @@ const (
 
 // Command Type Constants
 const (
-	CommandTypeAddJunior    = "ADD_JUNIOR"
-	CommandTypeRemoveMember = "REMOVE_MEMBER"
-	CommandTypeBreakLink    = "BREAK_LINK"
-	CommandTypeAwardRep     = "AWARD_REP"
-	CommandTypeDeductRep    = "DEDUCT_REP"
+	CommandTypeAddJunior      = "ADD_JUNIOR"
+	CommandTypeRemoveMember   = "REMOVE_MEMBER"
+	CommandTypeBreakLink      = "BREAK_LINK"
+	CommandTypeAwardRep       = "AWARD_REP"
+	CommandTypeDeductRep      = "DEDUCT_REP"
+	CommandTypeUseEntitlement = "USE_ENTITLEMENT"
+	// CommandTypeRefundEntitlement returns an entitlement's cost and use when
+	// the consumer of ENTITLEMENT_USED could not carry out its effect.
+	CommandTypeRefundEntitlement = "REFUND_ENTITLEMENT"
 )
 
 // Event Type Constants
 const (
-	EventTypeLinkCreated   = "LINK_CREATED"
-	EventTypeLinkBroken    = "LINK_BROKEN"
-	EventTypeTreeDissolved = "TREE_DISSOLVED"
-	EventTypeRepGained     = "REP_GAINED"
-	EventTypeRepRedeemed   = "REP_REDEEMED"
-	EventTypeRepPenalized  = "REP_PENALIZED"
-	EventTypeRepCapped     = "REP_CAPPED"
-	EventTypeRepReset      = "REP_RESET"
-	EventTypeRepError      = "REP_ERROR"
-	EventTypeLinkError     = "LINK_ERROR"
+	EventTypeLinkCreated         = "LINK_CREATED"
+	EventTypeLinkBroken          = "LINK_BROKEN"
+	EventTypeTreeDissolved       = "TREE_DISSOLVED"
+	EventTypeRepGained           = "REP_GAINED"
+	EventTypeRepRedeemed         = "REP_REDEEMED"
+	EventTypeRepPenalized        = "REP_PENALIZED"
+	EventTypeRepCapped           = "REP_CAPPED"
+	EventTypeRepReset            = "REP_RESET"
+	EventTypeEntitlementUsed     = "ENTITLEMENT_USED"
+	EventTypeEntitlementRefunded = "ENTITLEMENT_REFUNDED"
+	EventTypeRepError            = "REP_ERROR"
+	EventTypeLinkError           = "LINK_ERROR"
 )
 
 // Helper functions for creating typed commands and events
@@ -286,7 +326,7 @@ func NewLinkBrokenEvent(worldId world.Id, characterId uint32, seniorId uint32, j
 }
 
 // NewRepGainedEvent creates a new RepGained event
-func NewRepGainedEvent(worldId world.Id, characterId uint32, repGained uint32, dailyRep uint32, source string) Event[RepGainedEventBody] {
+func NewRepGainedEvent(worldId world.Id, characterId uint32, repGained uint32, dailyRep uint32, source string, fromId uint32) Event[RepGainedEventBody] {
 	return Event[RepGainedEventBody]{
 		WorldId:     worldId,
 		CharacterId: characterId,
@@ -295,11 +335,45 @@ func NewRepGainedEvent(worldId world.Id, characterId uint32, repGained uint32, d
 			RepGained: repGained,
 			DailyRep:  dailyRep,
 			Source:    source,
+			FromId:    fromId,
 			Timestamp: time.Now(),
 		},
 	}
 }
 
+// NewEntitlementUsedEvent creates a new EntitlementUsed event
+func NewEntitlementUsedEvent(worldId world.Id, characterId uint32, entitlement byte, repCost uint32, rep uint32, targetId uint32, beneficiaryIds []uint32) Event[EntitlementUsedEventBody] {
+	return Event[EntitlementUsedEventBody]{
+		WorldId:     worldId,
+		CharacterId: characterId,
+		Type:        EventTypeEntitlementUsed,
+		Body: EntitlementUsedEventBody{
+			Entitlement:    entitlement,
+			RepCost:        repCost,
+			Rep:            rep,
+			TargetId:       targetId,
+			BeneficiaryIds: beneficiaryIds,
+			Timestamp:      time.Now(),
+		},
+	}
+}
+
+// NewEntitlementRefundedEvent creates a new EntitlementRefunded event
+func NewEntitlementRefundedEvent(worldId world.Id, characterId uint32, entitlement byte, repRefunded uint32, rep uint32, reason string) Event[EntitlementRefundedEventBody] {
+	return Event[EntitlementRefundedEventBody]{
+		WorldId:     worldId,
+		CharacterId: characterId,
+		Type:        EventTypeEntitlementRefunded,
+		Body: EntitlementRefundedEventBody{
+			Entitlement: entitlement,
+			RepRefunded: repRefunded,
+			Rep:         rep,
+			Reason:      reason,
+			Timestamp:   time.Now(),
+		},
+	}
+}
+
 // NewRepRedeemedEvent creates a new RepRedeemed event
 func NewRepRedeemedEvent(worldId world.Id, characterId uint32, repRedeemed uint32, reason string) Event[RepRedeemedEventBody] {
 	return Event[RepRedeemedEventBody]{
@@ -4,6 +4,8 @@ import (
 	"atlas-family/family"
 	"atlas-family/kafka/consumer/character"
 	family2 "atlas-family/kafka/consumer/family"
+	"atlas-family/kafka/consumer/invite"
+	"atlas-family/kafka/consumer/monster"
 	"atlas-family/scheduler"
 	"os"
 
@@ -68,6 +70,14 @@ func main() {
 	if err := character.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	invite.InitConsumers(l)(cmf)(consumerGroupId)
+	if err := invite.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+	monster.InitConsumers(l)(cmf)(consumerGroupId)
+	if err := monster.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -0,0 +1,56 @@
+package alliance
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+func create(db *gorm.DB, tenantId uuid.UUID, worldId world.Id, leaderId uint32, name string) (Model, error) {
+	e := &Entity{
+		TenantId: tenantId,
+		WorldId:  byte(worldId),
+		Name:     name,
+		LeaderId: leaderId,
+		Capacity: DefaultCapacity,
+		Title1:   DefaultTitles[0],
+		Title2:   DefaultTitles[1],
+		Title3:   DefaultTitles[2],
+		Title4:   DefaultTitles[3],
+		Title5:   DefaultTitles[4],
+	}
+	err := db.Create(e).Error
+	if err != nil {
+		return Model{}, err
+	}
+	return Make(*e)
+}
+
+func updateNotice(db *gorm.DB, allianceId uint32, notice string) error {
+	return db.Model(&Entity{}).
+		Where("id = ?", allianceId).
+		Update("notice", notice).Error
+}
+
+func updateTitles(db *gorm.DB, allianceId uint32, titles []string) error {
+	return db.Model(&Entity{}).
+		Where("id = ?", allianceId).
+		Updates(map[string]interface{}{
+			"title1": titles[0],
+			"title2": titles[1],
+			"title3": titles[2],
+			"title4": titles[3],
+			"title5": titles[4],
+		}).Error
+}
+
+func updateCapacity(db *gorm.DB, allianceId uint32, capacity uint32) error {
+	return db.Model(&Entity{}).
+		Where("id = ?", allianceId).
+		Update("capacity", capacity).Error
+}
+
+func deleteAlliance(db *gorm.DB, allianceId uint32) error {
+	return db.Where("id = ?", allianceId).Delete(&Entity{}).Error
+}
@@ -0,0 +1,46 @@
+package alliance
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
+
+// Entity is an alliance row. Member guilds are not stored here; a guild
+// joins an alliance by carrying its id in guilds.alliance_id.
+type Entity struct {
+	TenantId uuid.UUID `gorm:"not null"`
+	Id       uint32    `gorm:"primaryKey;autoIncrement;not null"`
+	WorldId  byte      `gorm:"not null"`
+	Name     string    `gorm:"not null"`
+	Notice   string    `gorm:"not null"`
+	Capacity uint32    `gorm:"not null;default:2"`
+	LeaderId uint32    `gorm:"not null"`
+	Title1   string    `gorm:"not null"`
+	Title2   string    `gorm:"not null"`
+	Title3   string    `gorm:"not null"`
+	Title4   string    `gorm:"not null"`
+	Title5   string    `gorm:"not null"`
+}
+
+func (e Entity) TableName() string {
+	return "alliances"
+}
+
+func Make(e Entity) (Model, error) {
+	return Model{
+		tenantId: e.TenantId,
+		id:       e.Id,
+		worldId:  world.Id(e.WorldId),
+		name:     e.Name,
+		notice:   e.Notice,
+		capacity: e.Capacity,
+		leaderId: e.LeaderId,
+		titles:   []string{e.Title1, e.Title2, e.Title3, e.Title4, e.Title5},
+	}, nil
+}
@@ -0,0 +1,99 @@
+package alliance
+
+import (
+	"atlas-guilds/guild"
+	"atlas-guilds/guild/member"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Model struct {
+	tenantId uuid.UUID
+	id       uint32
+	worldId  world.Id
+	name     string
+	notice   string
+	capacity uint32
+	leaderId uint32
+	titles   []string
+	guilds   []guild.Model
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) Name() string {
+	return m.name
+}
+
+func (m Model) Notice() string {
+	return m.notice
+}
+
+func (m Model) Capacity() uint32 {
+	return m.capacity
+}
+
+func (m Model) LeaderId() uint32 {
+	return m.leaderId
+}
+
+func (m Model) Titles() []string {
+	return m.titles
+}
+
+func (m Model) Guilds() []guild.Model {
+	return m.guilds
+}
+
+func (m Model) GuildIds() []uint32 {
+	ids := make([]uint32, 0, len(m.guilds))
+	for _, g := range m.guilds {
+		ids = append(ids, g.Id())
+	}
+	return ids
+}
+
+func (m Model) Full() bool {
+	return uint32(len(m.guilds)) >= m.capacity
+}
+
+// LeaderGuildId is the guild the alliance master leads. Zero if the master's
+// guild is no longer part of the alliance.
+func (m Model) LeaderGuildId() uint32 {
+	for _, g := range m.guilds {
+		if g.LeaderId() == m.leaderId {
+			return g.Id()
+		}
+	}
+	return 0
+}
+
+// MemberTitle resolves characterId's alliance title across every member
+// guild. The second return is false when the character is not part of the
+// alliance.
+func (m Model) MemberTitle(characterId uint32) (byte, bool) {
+	if characterId == m.leaderId {
+		return member.AllianceTitleMaster, true
+	}
+	for _, g := range m.guilds {
+		for _, gm := range g.Members() {
+			if gm.CharacterId() == characterId {
+				return gm.AllianceTitle(), true
+			}
+		}
+	}
+	return member.AllianceTitleNone, false
+}
+
+func (m Model) setGuilds(guilds []guild.Model) Model {
+	m.guilds = guilds
+	return m
+}
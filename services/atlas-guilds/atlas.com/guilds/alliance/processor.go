@@ -0,0 +1,657 @@
+package alliance
+
+import (
+	"atlas-guilds/character"
+	"atlas-guilds/guild"
+	"atlas-guilds/guild/member"
+	"atlas-guilds/invite"
+	"atlas-guilds/kafka/message"
+	alliance2 "atlas-guilds/kafka/message/alliance"
+	character2 "atlas-guilds/kafka/message/character"
+	"atlas-guilds/purchase"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	// CreateCost and CapacityIncreaseCost are charged by the alliance NPC.
+	// The character's mesos are checked up front and debited through
+	// atlas-character in the same outbox batch as the alliance change. The
+	// purchase is recorded until the debit settles; one atlas-character
+	// refuses is undone by RevertPurchase.
+	CreateCost           = uint32(2000000)
+	CapacityIncreaseCost = uint32(1000000)
+	MesoActorType        = "ALLIANCE"
+
+	DefaultCapacity = uint32(2)
+	MaxCapacity     = uint32(5)
+
+	NameMinLength = 4
+	NameMaxLength = 12
+	TitleCount    = 5
+
+	ErrorNameInUse         = "ALLIANCE_NAME_IN_USE"
+	ErrorInvalidName       = "ALLIANCE_NAME_INVALID"
+	ErrorNotGuildLeader    = "NOT_GUILD_LEADER"
+	ErrorAlreadyInAlliance = "ALREADY_IN_ALLIANCE"
+	ErrorNotEnoughMeso     = "NOT_ENOUGH_MESO"
+	ErrorNotAuthorized     = "NOT_AUTHORIZED"
+	ErrorAllianceFull      = "ALLIANCE_FULL"
+	ErrorCapacityMaxed     = "CAPACITY_MAXED"
+	ErrorMasterGuild       = "MASTER_GUILD_CANNOT_LEAVE"
+	ErrorNotInAlliance     = "NOT_IN_ALLIANCE"
+	ErrorInvalidTitle      = "INVALID_TITLE"
+)
+
+var DefaultTitles = []string{"Master", "Jr. Master", "Member", "Member", "Member"}
+
+type Processor interface {
+	WithTransaction(tx *gorm.DB) Processor
+	ByIdProvider(allianceId uint32) model.Provider[Model]
+	GetById(allianceId uint32) (Model, error)
+	ByNameProvider(worldId world.Id, name string) model.Provider[Model]
+	GetByName(worldId world.Id, name string) (Model, error)
+
+	Create(mb *message.Buffer) func(worldId world.Id) func(characterId uint32) func(name string) func(transactionId uuid.UUID) error
+	CreateAndEmit(worldId world.Id, characterId uint32, name string, transactionId uuid.UUID) error
+	Disband(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error
+	DisbandAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error
+	RequestInvite(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(guildId uint32) error
+	RequestInviteAndEmit(allianceId uint32, characterId uint32, guildId uint32) error
+	Join(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error
+	JoinAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error
+	Leave(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error
+	LeaveAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error
+	Expel(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(guildId uint32) func(transactionId uuid.UUID) error
+	ExpelAndEmit(allianceId uint32, characterId uint32, guildId uint32, transactionId uuid.UUID) error
+	ChangeNotice(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(notice string) func(transactionId uuid.UUID) error
+	ChangeNoticeAndEmit(allianceId uint32, characterId uint32, notice string, transactionId uuid.UUID) error
+	ChangeTitles(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(titles []string) func(transactionId uuid.UUID) error
+	ChangeTitlesAndEmit(allianceId uint32, characterId uint32, titles []string, transactionId uuid.UUID) error
+	ChangeMemberTitle(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(targetId uint32) func(title byte) func(transactionId uuid.UUID) error
+	ChangeMemberTitleAndEmit(allianceId uint32, characterId uint32, targetId uint32, title byte, transactionId uuid.UUID) error
+	RequestCapacityIncrease(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error
+	RequestCapacityIncreaseAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error
+	// RevertPurchase undoes an alliance creation or capacity increase whose
+	// meso debit atlas-character refused.
+	RevertPurchase(mb *message.Buffer) func(pu purchase.Model) error
+	RevertPurchaseAndEmit(pu purchase.Model) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	db  *gorm.DB
+	t   tenant.Model
+	cp  character.Processor
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		db:  db,
+		t:   tenant.MustFromContext(ctx),
+		cp:  character.NewProcessor(l, ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   p.l,
+		ctx: p.ctx,
+		db:  tx,
+		t:   p.t,
+		cp:  p.cp,
+	}
+}
+
+func (p *ProcessorImpl) guildProcessor() guild.Processor {
+	return guild.NewProcessor(p.l, p.ctx, p.db)
+}
+
+func (p *ProcessorImpl) purchaseProcessor() purchase.Processor {
+	return purchase.NewProcessor(p.l, p.ctx, p.db)
+}
+
+func (p *ProcessorImpl) memberProcessor() member.Processor {
+	return member.NewProcessor(p.l, p.ctx, p.db)
+}
+
+func (p *ProcessorImpl) decorateGuilds(m Model) (Model, error) {
+	gs, err := p.guildProcessor().GetByAllianceId(m.Id())
+	if err != nil {
+		return Model{}, err
+	}
+	return m.setGuilds(gs), nil
+}
+
+func (p *ProcessorImpl) ByIdProvider(allianceId uint32) model.Provider[Model] {
+	return model.Map(p.decorateGuilds)(model.Map(Make)(getById(allianceId)(p.db.WithContext(p.ctx))))
+}
+
+func (p *ProcessorImpl) GetById(allianceId uint32) (Model, error) {
+	return p.ByIdProvider(allianceId)()
+}
+
+func (p *ProcessorImpl) ByNameProvider(worldId world.Id, name string) model.Provider[Model] {
+	ep := model.SliceMap[Entity, Model](Make)(getForName(worldId, name)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+	return model.Map(p.decorateGuilds)(model.FirstProvider(ep, model.Filters[Model]()))
+}
+
+func (p *ProcessorImpl) GetByName(worldId world.Id, name string) (Model, error) {
+	return p.ByNameProvider(worldId, name)()
+}
+
+// inTransaction runs f inside a transaction whose buffered messages are
+// written to the outbox on commit.
+func (p *ProcessorImpl) inTransaction(f func(p Processor, mb *message.Buffer) error) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return f(p.WithTransaction(tx), mb)
+		})
+	})
+}
+
+// reject records a business-rule failure. The error event is buffered and
+// nil is returned so the surrounding transaction still commits and the
+// actor hears about it.
+func (p *ProcessorImpl) reject(mb *message.Buffer, worldId world.Id, allianceId uint32, actorId uint32, reason string, transactionId uuid.UUID) error {
+	p.l.Debugf("Alliance [%d] request from character [%d] rejected: [%s].", allianceId, actorId, reason)
+	return mb.Put(alliance2.EnvStatusEventTopic, statusEventErrorProvider(worldId, allianceId, actorId, reason, transactionId))
+}
+
+func validName(name string) bool {
+	n := len(strings.TrimSpace(name))
+	return n == len(name) && n >= NameMinLength && n <= NameMaxLength
+}
+
+// joinGuild places g in the alliance with every member at the ordinary member
+// title, and g's leader at leaderTitle.
+func (p *ProcessorImpl) joinGuild(allianceId uint32, g guild.Model, leaderTitle byte) error {
+	err := p.guildProcessor().SetAlliance(g.Id(), allianceId)
+	if err != nil {
+		return err
+	}
+	err = p.memberProcessor().UpdateGuildAllianceTitle(g.Id(), member.AllianceTitleMember)
+	if err != nil {
+		return err
+	}
+	return p.memberProcessor().UpdateAllianceTitle(g.LeaderId(), leaderTitle)
+}
+
+func (p *ProcessorImpl) removeGuild(guildId uint32) error {
+	err := p.guildProcessor().SetAlliance(guildId, 0)
+	if err != nil {
+		return err
+	}
+	return p.memberProcessor().UpdateGuildAllianceTitle(guildId, member.AllianceTitleNone)
+}
+
+func (p *ProcessorImpl) Create(mb *message.Buffer) func(worldId world.Id) func(characterId uint32) func(name string) func(transactionId uuid.UUID) error {
+	return func(worldId world.Id) func(characterId uint32) func(name string) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(name string) func(transactionId uuid.UUID) error {
+			return func(name string) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					p.l.Debugf("Character [%d] attempting to create alliance [%s].", characterId, name)
+					if !validName(name) {
+						return p.reject(mb, worldId, 0, characterId, ErrorInvalidName, transactionId)
+					}
+					if a, _ := p.GetByName(worldId, name); a.Id() != 0 {
+						return p.reject(mb, worldId, 0, characterId, ErrorNameInUse, transactionId)
+					}
+
+					g, err := p.guildProcessor().GetByMemberId(characterId)
+					if err != nil || g.LeaderId() != characterId {
+						return p.reject(mb, worldId, 0, characterId, ErrorNotGuildLeader, transactionId)
+					}
+					if g.AllianceId() != 0 {
+						return p.reject(mb, worldId, 0, characterId, ErrorAlreadyInAlliance, transactionId)
+					}
+
+					c, err := p.cp.GetById(characterId)
+					if err != nil {
+						p.l.WithError(err).Errorf("Unable to retrieve character [%d] creating alliance.", characterId)
+						return err
+					}
+					if c.Meso() < CreateCost {
+						return p.reject(mb, worldId, 0, characterId, ErrorNotEnoughMeso, transactionId)
+					}
+
+					a, err := create(p.db.WithContext(p.ctx), p.t.Id(), worldId, characterId, name)
+					if err != nil {
+						p.l.WithError(err).Errorf("Unable to create alliance [%s].", name)
+						return err
+					}
+					err = p.joinGuild(a.Id(), g, member.AllianceTitleMaster)
+					if err != nil {
+						p.l.WithError(err).Errorf("Unable to add guild [%d] to new alliance [%d].", g.Id(), a.Id())
+						return err
+					}
+
+					p.l.Infof("Character [%d] created alliance [%d] [%s] from guild [%d].", characterId, a.Id(), name, g.Id())
+					err = mb.Put(character2.EnvCommandTopic, changeMesoCommandProvider(transactionId, worldId, characterId, a.Id(), -int32(CreateCost)))
+					if err != nil {
+						return err
+					}
+					_, err = p.purchaseProcessor().Record(transactionId, characterId, -int32(CreateCost), purchase.KindAllianceCreate, a.Id(), 0)
+					if err != nil {
+						return err
+					}
+					return mb.Put(alliance2.EnvStatusEventTopic, statusEventCreatedProvider(worldId, a.Id(), name, g.Id(), characterId, transactionId))
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) CreateAndEmit(worldId world.Id, characterId uint32, name string, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.Create(mb)(worldId)(characterId)(name)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) Disband(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(transactionId uuid.UUID) error {
+			return func(transactionId uuid.UUID) error {
+				p.l.Debugf("Character [%d] attempting to disband alliance [%d].", characterId, allianceId)
+				a, err := p.GetById(allianceId)
+				if err != nil {
+					return err
+				}
+				if a.LeaderId() != characterId {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+				}
+
+				p.l.Infof("Alliance [%d] disbanded by character [%d].", a.Id(), characterId)
+				return p.dissolve(mb, a, transactionId)
+			}
+		}
+	}
+}
+
+// dissolve releases every guild in the alliance and deletes it.
+func (p *ProcessorImpl) dissolve(mb *message.Buffer, a Model, transactionId uuid.UUID) error {
+	for _, gid := range a.GuildIds() {
+		err := p.removeGuild(gid)
+		if err != nil {
+			return err
+		}
+	}
+	err := deleteAlliance(p.db.WithContext(p.ctx), a.Id())
+	if err != nil {
+		return err
+	}
+	return mb.Put(alliance2.EnvStatusEventTopic, statusEventDisbandedProvider(a.WorldId(), a.Id(), a.GuildIds(), transactionId))
+}
+
+func (p *ProcessorImpl) DisbandAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.Disband(mb)(allianceId)(characterId)(transactionId)
+	})
+}
+
+// RequestInvite asks the leader of guildId to bring their guild into the
+// alliance. The invitation itself is owned by atlas-invites; acceptance comes
+// back through Join.
+func (p *ProcessorImpl) RequestInvite(_ *message.Buffer) func(allianceId uint32) func(characterId uint32) func(guildId uint32) error {
+	return func(allianceId uint32) func(characterId uint32) func(guildId uint32) error {
+		return func(characterId uint32) func(guildId uint32) error {
+			return func(guildId uint32) error {
+				p.l.Debugf("Character [%d] requesting that guild [%d] be invited to alliance [%d].", characterId, guildId, allianceId)
+				a, err := p.GetById(allianceId)
+				if err != nil {
+					return err
+				}
+				if a.LeaderId() != characterId {
+					return errors.New("must be alliance master")
+				}
+				if a.Full() {
+					return errors.New("alliance full")
+				}
+				g, err := p.guildProcessor().GetById(guildId)
+				if err != nil {
+					return err
+				}
+				if g.WorldId() != a.WorldId() || g.AllianceId() != 0 {
+					return errors.New("guild cannot join alliance")
+				}
+				return invite.NewProcessor(p.l, p.ctx).CreateAlliance(characterId, a.WorldId(), a.Id(), g.LeaderId())
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) RequestInviteAndEmit(allianceId uint32, characterId uint32, guildId uint32) error {
+	return message.Emit(producer.ProviderImpl(p.l)(p.ctx))(func(mb *message.Buffer) error {
+		return p.RequestInvite(mb)(allianceId)(characterId)(guildId)
+	})
+}
+
+// Join brings the guild led by characterId into the alliance, after that
+// leader accepted an alliance invitation.
+func (p *ProcessorImpl) Join(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(transactionId uuid.UUID) error {
+			return func(transactionId uuid.UUID) error {
+				a, err := p.GetById(allianceId)
+				if err != nil {
+					return err
+				}
+				g, err := p.guildProcessor().GetByMemberId(characterId)
+				if err != nil || g.LeaderId() != characterId {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotGuildLeader, transactionId)
+				}
+				if g.AllianceId() != 0 {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorAlreadyInAlliance, transactionId)
+				}
+				if a.Full() {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorAllianceFull, transactionId)
+				}
+
+				err = p.joinGuild(a.Id(), g, member.AllianceTitleJrMaster)
+				if err != nil {
+					return err
+				}
+				p.l.Infof("Guild [%d] joined alliance [%d].", g.Id(), a.Id())
+				return mb.Put(alliance2.EnvStatusEventTopic, statusEventGuildJoinedProvider(a.WorldId(), a.Id(), g.Id(), transactionId))
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) JoinAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.Join(mb)(allianceId)(characterId)(transactionId)
+	})
+}
+
+// Leave removes the guild led by characterId. The master's guild cannot
+// leave; the master disbands instead.
+func (p *ProcessorImpl) Leave(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(transactionId uuid.UUID) error {
+			return func(transactionId uuid.UUID) error {
+				a, err := p.GetById(allianceId)
+				if err != nil {
+					return err
+				}
+				g, err := p.guildProcessor().GetByMemberId(characterId)
+				if err != nil || g.LeaderId() != characterId {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotGuildLeader, transactionId)
+				}
+				if g.AllianceId() != a.Id() {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotInAlliance, transactionId)
+				}
+				if a.LeaderId() == characterId {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorMasterGuild, transactionId)
+				}
+
+				err = p.removeGuild(g.Id())
+				if err != nil {
+					return err
+				}
+				p.l.Infof("Guild [%d] left alliance [%d].", g.Id(), a.Id())
+				return mb.Put(alliance2.EnvStatusEventTopic, statusEventGuildLeftProvider(a.WorldId(), a.Id(), g.Id(), false, transactionId))
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) LeaveAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.Leave(mb)(allianceId)(characterId)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) Expel(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(guildId uint32) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(guildId uint32) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(guildId uint32) func(transactionId uuid.UUID) error {
+			return func(guildId uint32) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					a, err := p.GetById(allianceId)
+					if err != nil {
+						return err
+					}
+					if a.LeaderId() != characterId {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+					}
+					if guildId == a.LeaderGuildId() {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorMasterGuild, transactionId)
+					}
+					g, err := p.guildProcessor().GetById(guildId)
+					if err != nil || g.AllianceId() != a.Id() {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotInAlliance, transactionId)
+					}
+
+					err = p.removeGuild(g.Id())
+					if err != nil {
+						return err
+					}
+					p.l.Infof("Guild [%d] expelled from alliance [%d] by character [%d].", g.Id(), a.Id(), characterId)
+					return mb.Put(alliance2.EnvStatusEventTopic, statusEventGuildLeftProvider(a.WorldId(), a.Id(), g.Id(), true, transactionId))
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) ExpelAndEmit(allianceId uint32, characterId uint32, guildId uint32, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.Expel(mb)(allianceId)(characterId)(guildId)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) ChangeNotice(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(notice string) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(notice string) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(notice string) func(transactionId uuid.UUID) error {
+			return func(notice string) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					a, err := p.GetById(allianceId)
+					if err != nil {
+						return err
+					}
+					if t, ok := a.MemberTitle(characterId); !ok || t > member.AllianceTitleJrMaster {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+					}
+					err = updateNotice(p.db.WithContext(p.ctx), a.Id(), notice)
+					if err != nil {
+						return err
+					}
+					return mb.Put(alliance2.EnvStatusEventTopic, statusEventNoticeUpdatedProvider(a.WorldId(), a.Id(), notice, transactionId))
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) ChangeNoticeAndEmit(allianceId uint32, characterId uint32, notice string, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.ChangeNotice(mb)(allianceId)(characterId)(notice)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) ChangeTitles(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(titles []string) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(titles []string) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(titles []string) func(transactionId uuid.UUID) error {
+			return func(titles []string) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					a, err := p.GetById(allianceId)
+					if err != nil {
+						return err
+					}
+					if a.LeaderId() != characterId {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+					}
+					if len(titles) != TitleCount {
+						return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorInvalidTitle, transactionId)
+					}
+					err = updateTitles(p.db.WithContext(p.ctx), a.Id(), titles)
+					if err != nil {
+						return err
+					}
+					return mb.Put(alliance2.EnvStatusEventTopic, statusEventTitlesUpdatedProvider(a.WorldId(), a.Id(), titles, transactionId))
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) ChangeTitlesAndEmit(allianceId uint32, characterId uint32, titles []string, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.ChangeTitles(mb)(allianceId)(characterId)(titles)(transactionId)
+	})
+}
+
+// ChangeMemberTitle ranks targetId within the alliance. Only the master may
+// rank members, and the master title itself cannot be granted this way.
+func (p *ProcessorImpl) ChangeMemberTitle(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(targetId uint32) func(title byte) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(targetId uint32) func(title byte) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(targetId uint32) func(title byte) func(transactionId uuid.UUID) error {
+			return func(targetId uint32) func(title byte) func(transactionId uuid.UUID) error {
+				return func(title byte) func(transactionId uuid.UUID) error {
+					return func(transactionId uuid.UUID) error {
+						a, err := p.GetById(allianceId)
+						if err != nil {
+							return err
+						}
+						if a.LeaderId() != characterId || targetId == a.LeaderId() {
+							return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+						}
+						if _, ok := a.MemberTitle(targetId); !ok {
+							return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotInAlliance, transactionId)
+						}
+						if title < member.AllianceTitleJrMaster || title > member.AllianceTitleLowest {
+							return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorInvalidTitle, transactionId)
+						}
+						err = p.memberProcessor().UpdateAllianceTitle(targetId, title)
+						if err != nil {
+							return err
+						}
+						return mb.Put(alliance2.EnvStatusEventTopic, statusEventMemberTitleUpdatedProvider(a.WorldId(), a.Id(), targetId, title, transactionId))
+					}
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) ChangeMemberTitleAndEmit(allianceId uint32, characterId uint32, targetId uint32, title byte, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.ChangeMemberTitle(mb)(allianceId)(characterId)(targetId)(title)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) RequestCapacityIncrease(mb *message.Buffer) func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+	return func(allianceId uint32) func(characterId uint32) func(transactionId uuid.UUID) error {
+		return func(characterId uint32) func(transactionId uuid.UUID) error {
+			return func(transactionId uuid.UUID) error {
+				if allianceId == 0 {
+					// The alliance NPC does not know the alliance, so resolve it from the actor's guild.
+					g, _ := p.guildProcessor().GetByMemberId(characterId)
+					if g.AllianceId() == 0 {
+						return p.reject(mb, g.WorldId(), 0, characterId, ErrorNotInAlliance, transactionId)
+					}
+					allianceId = g.AllianceId()
+				}
+				a, err := p.GetById(allianceId)
+				if err != nil {
+					return err
+				}
+				if a.LeaderId() != characterId {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotAuthorized, transactionId)
+				}
+				if a.Capacity() >= MaxCapacity {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorCapacityMaxed, transactionId)
+				}
+				c, err := p.cp.GetById(characterId)
+				if err != nil {
+					return err
+				}
+				if c.Meso() < CapacityIncreaseCost {
+					return p.reject(mb, a.WorldId(), a.Id(), characterId, ErrorNotEnoughMeso, transactionId)
+				}
+
+				capacity := a.Capacity() + 1
+				err = updateCapacity(p.db.WithContext(p.ctx), a.Id(), capacity)
+				if err != nil {
+					return err
+				}
+				p.l.Infof("Alliance [%d] capacity increased to [%d].", a.Id(), capacity)
+				err = mb.Put(character2.EnvCommandTopic, changeMesoCommandProvider(transactionId, a.WorldId(), characterId, a.Id(), -int32(CapacityIncreaseCost)))
+				if err != nil {
+					return err
+				}
+				_, err = p.purchaseProcessor().Record(transactionId, characterId, -int32(CapacityIncreaseCost), purchase.KindAllianceCapacity, a.Id(), 0)
+				if err != nil {
+					return err
+				}
+				return mb.Put(alliance2.EnvStatusEventTopic, statusEventCapacityUpdatedProvider(a.WorldId(), a.Id(), capacity, transactionId))
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) RequestCapacityIncreaseAndEmit(allianceId uint32, characterId uint32, transactionId uuid.UUID) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.RequestCapacityIncrease(mb)(allianceId)(characterId)(transactionId)
+	})
+}
+
+func (p *ProcessorImpl) RevertPurchase(mb *message.Buffer) func(pu purchase.Model) error {
+	return func(pu purchase.Model) error {
+		settled, err := p.purchaseProcessor().Settle(pu.Id())
+		if err != nil || !settled {
+			return err
+		}
+
+		a, err := p.GetById(pu.TargetId())
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.l.Debugf("Alliance [%d] already gone; nothing to revert for transaction [%s].", pu.TargetId(), pu.TransactionId())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch pu.Kind() {
+		case purchase.KindAllianceCreate:
+			p.l.Warnf("Dissolving alliance [%d]: character [%d] could not pay for its creation.", a.Id(), pu.CharacterId())
+			return p.dissolve(mb, a, pu.TransactionId())
+		case purchase.KindAllianceCapacity:
+			if a.Capacity() <= DefaultCapacity {
+				return nil
+			}
+			capacity := a.Capacity() - 1
+			err = updateCapacity(p.db.WithContext(p.ctx), a.Id(), capacity)
+			if err != nil {
+				return err
+			}
+			p.l.Warnf("Alliance [%d] capacity reverted to [%d]: character [%d] could not pay for the increase.", a.Id(), capacity, pu.CharacterId())
+			return mb.Put(alliance2.EnvStatusEventTopic, statusEventCapacityUpdatedProvider(a.WorldId(), a.Id(), capacity, pu.TransactionId()))
+		}
+		return nil
+	}
+}
+
+func (p *ProcessorImpl) RevertPurchaseAndEmit(pu purchase.Model) error {
+	return p.inTransaction(func(p Processor, mb *message.Buffer) error {
+		return p.RevertPurchase(mb)(pu)
+	})
+}
@@ -0,0 +1,320 @@
+package alliance
+
+import (
+	"atlas-guilds/character"
+	"atlas-guilds/character/mock"
+	"atlas-guilds/guild"
+	character3 "atlas-guilds/guild/character"
+	"atlas-guilds/guild/member"
+	"atlas-guilds/kafka/message"
+	alliance2 "atlas-guilds/kafka/message/alliance"
+	character2 "atlas-guilds/kafka/message/character"
+	"atlas-guilds/purchase"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func setupTestLogger(t *testing.T) logrus.FieldLogger {
+	t.Helper()
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func setupTestContext(t *testing.T) (tenant.Model, context.Context) {
+	t.Helper()
+	ten, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	require.NoError(t, err)
+	return ten, tenant.WithContext(context.Background(), ten)
+}
+
+func setupTestDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+uuid.New().String()+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	database.RegisterTenantCallbacks(setupTestLogger(t), db)
+
+	require.NoError(t, Migration(db))
+	require.NoError(t, guild.Migration(db))
+	require.NoError(t, member.Migration(db))
+	require.NoError(t, character3.Migration(db))
+	require.NoError(t, purchase.Migration(db))
+	// Use raw SQL for title table to avoid PostgreSQL-specific uuid_generate_v4()
+	require.NoError(t, db.Exec(`CREATE TABLE IF NOT EXISTS titles (
+		tenant_id TEXT NOT NULL,
+		id TEXT,
+		guild_id INTEGER,
+		name TEXT,
+		"index" INTEGER
+	)`).Error)
+	return db
+}
+
+// seedGuild creates a guild led by leaderId with the given extra members.
+func seedGuild(t *testing.T, db *gorm.DB, ten tenant.Model, name string, leaderId uint32, memberIds ...uint32) uint32 {
+	t.Helper()
+	g := guild.Entity{TenantId: ten.Id(), Name: name, LeaderId: leaderId, Capacity: 30}
+	require.NoError(t, db.Create(&g).Error)
+	for _, id := range append([]uint32{leaderId}, memberIds...) {
+		require.NoError(t, db.Create(&member.Entity{TenantId: ten.Id(), GuildId: g.Id, CharacterId: id, Name: name, Level: 50}).Error)
+		require.NoError(t, db.Create(&character3.Entity{TenantId: ten.Id(), CharacterId: id, GuildId: g.Id}).Error)
+	}
+	return g.Id
+}
+
+func testProcessor(t *testing.T, ctx context.Context, db *gorm.DB, meso uint32) *ProcessorImpl {
+	t.Helper()
+	ten := tenant.MustFromContext(ctx)
+	return &ProcessorImpl{
+		l:   setupTestLogger(t),
+		ctx: ctx,
+		db:  db,
+		t:   ten,
+		cp: &mock.ProcessorMock{GetByIdFunc: func(characterId uint32) (character.Model, error) {
+			return character.Extract(character.RestModel{Id: characterId, Meso: meso})
+		}},
+	}
+}
+
+func eventTypes(t *testing.T, mb *message.Buffer) []string {
+	t.Helper()
+	var result []string
+	for _, m := range mb.GetAll()[alliance2.EnvStatusEventTopic] {
+		var e alliance2.StatusEvent[json.RawMessage]
+		require.NoError(t, json.Unmarshal(m.Value, &e))
+		result = append(result, e.Type)
+	}
+	return result
+}
+
+func memberAllianceTitle(t *testing.T, db *gorm.DB, characterId uint32) byte {
+	t.Helper()
+	var e member.Entity
+	require.NoError(t, db.Where("character_id = ?", characterId).First(&e).Error)
+	return e.AllianceTitle
+}
+
+func createAlliance(t *testing.T, p *ProcessorImpl, characterId uint32) Model {
+	t.Helper()
+	mb := message.NewBuffer()
+	require.NoError(t, p.Create(mb)(0)(characterId)("Alliance")(uuid.New()))
+	require.Equal(t, []string{alliance2.StatusEventTypeCreated}, eventTypes(t, mb))
+	a, err := p.GetByName(0, "Alliance")
+	require.NoError(t, err)
+	return a
+}
+
+func TestCreate(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	gid := seedGuild(t, db, ten, "GuildA", 100, 101)
+	p := testProcessor(t, ctx, db, CreateCost)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.Create(mb)(0)(100)("Alliance")(uuid.New()))
+	assert.Len(t, mb.GetAll()[character2.EnvCommandTopic], 1)
+	assert.Equal(t, []string{alliance2.StatusEventTypeCreated}, eventTypes(t, mb))
+
+	a, err := p.GetByName(0, "Alliance")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), a.LeaderId())
+	assert.Equal(t, DefaultCapacity, a.Capacity())
+	assert.Equal(t, DefaultTitles, a.Titles())
+	assert.Equal(t, []uint32{gid}, a.GuildIds())
+	assert.Equal(t, gid, a.LeaderGuildId())
+	assert.Equal(t, member.AllianceTitleMaster, memberAllianceTitle(t, db, 100))
+	assert.Equal(t, member.AllianceTitleMember, memberAllianceTitle(t, db, 101))
+}
+
+func TestCreateRejections(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    uint32
+		alliance string
+		meso     uint32
+	}{
+		{name: "invalid name", actor: 100, alliance: "Abc", meso: CreateCost},
+		{name: "not guild leader", actor: 101, alliance: "Alliance", meso: CreateCost},
+		{name: "not enough meso", actor: 100, alliance: "Alliance", meso: CreateCost - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ten, ctx := setupTestContext(t)
+			db := setupTestDatabase(t)
+			seedGuild(t, db, ten, "GuildA", 100, 101)
+			p := testProcessor(t, ctx, db, tt.meso)
+
+			mb := message.NewBuffer()
+			require.NoError(t, p.Create(mb)(0)(tt.actor)(tt.alliance)(uuid.New()))
+			assert.Equal(t, []string{alliance2.StatusEventTypeError}, eventTypes(t, mb))
+			assert.Empty(t, mb.GetAll()[character2.EnvCommandTopic])
+
+			var n int64
+			require.NoError(t, db.Model(&Entity{}).Count(&n).Error)
+			assert.Zero(t, n)
+		})
+	}
+}
+
+func TestJoinAndLeave(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	seedGuild(t, db, ten, "GuildA", 100)
+	gb := seedGuild(t, db, ten, "GuildB", 200, 201)
+	seedGuild(t, db, ten, "GuildC", 300)
+	p := testProcessor(t, ctx, db, CreateCost)
+	a := createAlliance(t, p, 100)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.Join(mb)(a.Id())(200)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeGuildJoined}, eventTypes(t, mb))
+	assert.Equal(t, member.AllianceTitleJrMaster, memberAllianceTitle(t, db, 200))
+	assert.Equal(t, member.AllianceTitleMember, memberAllianceTitle(t, db, 201))
+
+	// Default capacity is two guilds.
+	mb = message.NewBuffer()
+	require.NoError(t, p.Join(mb)(a.Id())(300)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeError}, eventTypes(t, mb))
+
+	// The master's guild cannot leave.
+	mb = message.NewBuffer()
+	require.NoError(t, p.Leave(mb)(a.Id())(100)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeError}, eventTypes(t, mb))
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.Leave(mb)(a.Id())(200)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeGuildLeft}, eventTypes(t, mb))
+	assert.Equal(t, member.AllianceTitleNone, memberAllianceTitle(t, db, 200))
+
+	g, err := guild.NewProcessor(p.l, ctx, db).GetById(gb)
+	require.NoError(t, err)
+	assert.Zero(t, g.AllianceId())
+}
+
+func TestChangeMemberTitle(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	seedGuild(t, db, ten, "GuildA", 100, 101)
+	p := testProcessor(t, ctx, db, CreateCost)
+	a := createAlliance(t, p, 100)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.ChangeMemberTitle(mb)(a.Id())(100)(101)(member.AllianceTitleJrMaster)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeMemberTitleUpdated}, eventTypes(t, mb))
+	assert.Equal(t, member.AllianceTitleJrMaster, memberAllianceTitle(t, db, 101))
+
+	// Only the master ranks members, and the master title is never granted.
+	for _, c := range []struct {
+		actor  uint32
+		target uint32
+		title  byte
+	}{
+		{actor: 101, target: 100, title: member.AllianceTitleLowest},
+		{actor: 100, target: 101, title: member.AllianceTitleMaster},
+		{actor: 100, target: 999, title: member.AllianceTitleMember},
+	} {
+		mb = message.NewBuffer()
+		require.NoError(t, p.ChangeMemberTitle(mb)(a.Id())(c.actor)(c.target)(c.title)(uuid.New()))
+		assert.Equal(t, []string{alliance2.StatusEventTypeError}, eventTypes(t, mb))
+	}
+	assert.Equal(t, member.AllianceTitleMaster, memberAllianceTitle(t, db, 100))
+}
+
+func TestGuildCannotDisbandWhileInAlliance(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	gid := seedGuild(t, db, ten, "GuildA", 100)
+	p := testProcessor(t, ctx, db, CreateCost)
+	a := createAlliance(t, p, 100)
+
+	mb := message.NewBuffer()
+	err := guild.NewProcessor(p.l, ctx, db).RequestDisband(mb)(100)(uuid.New())
+	assert.Error(t, err)
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.Disband(mb)(a.Id())(100)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeDisbanded}, eventTypes(t, mb))
+	g, err := guild.NewProcessor(p.l, ctx, db).GetById(gid)
+	require.NoError(t, err)
+	assert.Zero(t, g.AllianceId())
+}
+
+func TestRequestCapacityIncreaseWithoutAllianceId(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	seedGuild(t, db, ten, "GuildA", 100)
+	seedGuild(t, db, ten, "GuildB", 200)
+	p := testProcessor(t, ctx, db, CreateCost)
+	a := createAlliance(t, p, 100)
+
+	// The NPC leaves the alliance to be resolved from the actor's guild.
+	mb := message.NewBuffer()
+	require.NoError(t, p.RequestCapacityIncrease(mb)(0)(100)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeCapacityUpdated}, eventTypes(t, mb))
+	assert.Len(t, mb.GetAll()[character2.EnvCommandTopic], 1)
+	u, err := p.GetById(a.Id())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCapacity+1, u.Capacity())
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.RequestCapacityIncrease(mb)(0)(200)(uuid.New()))
+	assert.Equal(t, []string{alliance2.StatusEventTypeError}, eventTypes(t, mb))
+	assert.Empty(t, mb.GetAll()[character2.EnvCommandTopic])
+}
+
+// A purchase whose meso debit atlas-character refuses is undone: the
+// alliance is dissolved, a capacity increase is taken back, and a repeated
+// refusal changes nothing further.
+func TestRevertPurchase(t *testing.T) {
+	ten, ctx := setupTestContext(t)
+	db := setupTestDatabase(t)
+	gid := seedGuild(t, db, ten, "GuildA", 100)
+	p := testProcessor(t, ctx, db, CreateCost)
+	pp := purchase.NewProcessor(setupTestLogger(t), ctx, db)
+
+	createId := uuid.New()
+	require.NoError(t, p.Create(message.NewBuffer())(0)(100)("Alliance")(createId))
+	a, err := p.GetByName(0, "Alliance")
+	require.NoError(t, err)
+
+	capacityId := uuid.New()
+	require.NoError(t, p.RequestCapacityIncrease(message.NewBuffer())(a.Id())(100)(capacityId))
+	raised, err := pp.GetPending(capacityId, 100, -int32(CapacityIncreaseCost))
+	require.NoError(t, err)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.RevertPurchase(mb)(raised))
+	assert.Equal(t, []string{alliance2.StatusEventTypeCapacityUpdated}, eventTypes(t, mb))
+	u, err := p.GetById(a.Id())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCapacity, u.Capacity())
+
+	created, err := pp.GetPending(createId, 100, -int32(CreateCost))
+	require.NoError(t, err)
+	mb = message.NewBuffer()
+	require.NoError(t, p.RevertPurchase(mb)(created))
+	assert.Equal(t, []string{alliance2.StatusEventTypeDisbanded}, eventTypes(t, mb))
+	_, err = p.GetById(a.Id())
+	assert.Error(t, err)
+	g, err := guild.NewProcessor(setupTestLogger(t), ctx, db).GetById(gid)
+	require.NoError(t, err)
+	assert.Zero(t, g.AllianceId())
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.RevertPurchase(mb)(created))
+	assert.Empty(t, eventTypes(t, mb))
+}
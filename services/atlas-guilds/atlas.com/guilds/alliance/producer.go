@@ -0,0 +1,160 @@
+package alliance
+
+import (
+	alliance2 "atlas-guilds/kafka/message/alliance"
+	character2 "atlas-guilds/kafka/message/character"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func statusEventCreatedProvider(worldId world.Id, allianceId uint32, name string, guildId uint32, leaderId uint32, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventCreatedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeCreated,
+		Body: alliance2.StatusEventCreatedBody{
+			Name:     name,
+			GuildId:  guildId,
+			LeaderId: leaderId,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventDisbandedProvider(worldId world.Id, allianceId uint32, guildIds []uint32, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventDisbandedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeDisbanded,
+		Body: alliance2.StatusEventDisbandedBody{
+			GuildIds: guildIds,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventGuildJoinedProvider(worldId world.Id, allianceId uint32, guildId uint32, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventGuildJoinedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeGuildJoined,
+		Body: alliance2.StatusEventGuildJoinedBody{
+			GuildId: guildId,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventGuildLeftProvider(worldId world.Id, allianceId uint32, guildId uint32, force bool, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventGuildLeftBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeGuildLeft,
+		Body: alliance2.StatusEventGuildLeftBody{
+			GuildId: guildId,
+			Force:   force,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventNoticeUpdatedProvider(worldId world.Id, allianceId uint32, notice string, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventNoticeUpdatedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeNoticeUpdated,
+		Body: alliance2.StatusEventNoticeUpdatedBody{
+			Notice: notice,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventTitlesUpdatedProvider(worldId world.Id, allianceId uint32, titles []string, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventTitlesUpdatedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeTitlesUpdated,
+		Body: alliance2.StatusEventTitlesUpdatedBody{
+			Titles: titles,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventMemberTitleUpdatedProvider(worldId world.Id, allianceId uint32, characterId uint32, title byte, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventMemberTitleUpdatedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeMemberTitleUpdated,
+		Body: alliance2.StatusEventMemberTitleUpdatedBody{
+			CharacterId: characterId,
+			Title:       title,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventCapacityUpdatedProvider(worldId world.Id, allianceId uint32, capacity uint32, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(allianceId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventCapacityUpdatedBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeCapacityUpdated,
+		Body: alliance2.StatusEventCapacityUpdatedBody{
+			Capacity: capacity,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventErrorProvider(worldId world.Id, allianceId uint32, actorId uint32, error string, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(actorId))
+	value := &alliance2.StatusEvent[alliance2.StatusEventErrorBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		AllianceId:    allianceId,
+		Type:          alliance2.StatusEventTypeError,
+		Body: alliance2.StatusEventErrorBody{
+			ActorId: actorId,
+			Error:   error,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func changeMesoCommandProvider(transactionId uuid.UUID, worldId world.Id, characterId uint32, allianceId uint32, amount int32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &character2.Command[character2.RequestChangeMesoBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          character2.CommandRequestChangeMeso,
+		Body: character2.RequestChangeMesoBody{
+			ActorId:   allianceId,
+			ActorType: MesoActorType,
+			Amount:    amount,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -0,0 +1,49 @@
+package alliance
+
+import (
+	"atlas-guilds/rest"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+)
+
+func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerGet := rest.RegisterHandler(l)(si)
+			r := router.PathPrefix("/alliances").Subrouter()
+			r.HandleFunc("/{allianceId}", registerGet("get_alliance", handleGetAlliance(db))).Methods(http.MethodGet)
+		}
+	}
+}
+
+func handleGetAlliance(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseAllianceId(d.Logger(), func(allianceId uint32) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				a, err := NewProcessor(d.Logger(), d.Context(), db).GetById(allianceId)
+				if err != nil {
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				res, err := model.Map(Transform)(model.FixedProvider(a))()
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Creating REST model.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res)
+			}
+		})
+	}
+}
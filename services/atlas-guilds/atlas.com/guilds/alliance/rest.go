@@ -0,0 +1,79 @@
+package alliance
+
+import (
+	"atlas-guilds/guild"
+	"atlas-guilds/guild/member"
+	"strconv"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+type RestModel struct {
+	Id       uint32           `json:"-"`
+	WorldId  world.Id         `json:"worldId"`
+	Name     string           `json:"name"`
+	Notice   string           `json:"notice"`
+	Capacity uint32           `json:"capacity"`
+	LeaderId uint32           `json:"leaderId"`
+	Titles   []string         `json:"titles"`
+	Guilds   []GuildRestModel `json:"guilds"`
+}
+
+// GuildRestModel is a member guild as seen from its alliance. Unlike
+// guild.RestModel it is nested, so it carries its own id.
+type GuildRestModel struct {
+	Id       uint32             `json:"id"`
+	Name     string             `json:"name"`
+	LeaderId uint32             `json:"leaderId"`
+	Members  []member.RestModel `json:"members"`
+}
+
+func (r RestModel) GetName() string {
+	return "alliances"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+func Transform(m Model) (RestModel, error) {
+	guilds, err := model.SliceMap(TransformGuild)(model.FixedProvider(m.Guilds()))()()
+	if err != nil {
+		return RestModel{}, err
+	}
+
+	return RestModel{
+		Id:       m.id,
+		WorldId:  m.worldId,
+		Name:     m.name,
+		Notice:   m.notice,
+		Capacity: m.capacity,
+		LeaderId: m.leaderId,
+		Titles:   m.titles,
+		Guilds:   guilds,
+	}, nil
+}
+
+func TransformGuild(g guild.Model) (GuildRestModel, error) {
+	members, err := model.SliceMap(member.Transform)(model.FixedProvider(g.Members()))()()
+	if err != nil {
+		return GuildRestModel{}, err
+	}
+
+	return GuildRestModel{
+		Id:       g.Id(),
+		Name:     g.Name(),
+		LeaderId: g.LeaderId(),
+		Members:  members,
+	}, nil
+}
@@ -6,6 +6,7 @@ type Model struct {
 	level byte
 	jobId uint16
 	gm    int
+	meso  uint32
 }
 
 func (m Model) Name() string {
@@ -20,6 +21,10 @@ func (m Model) JobId() uint16 {
 	return m.jobId
 }
 
+func (m Model) Meso() uint32 {
+	return m.meso
+}
+
 func (m Model) Gm() bool {
 	return m.gm >= 1
 }
@@ -12,6 +12,7 @@ type RestModel struct {
 	Level byte   `json:"level"`
 	JobId uint16 `json:"jobId"`
 	Gm    int    `json:"gm"`
+	Meso  uint32 `json:"meso"`
 }
 
 func (r *RestModel) GetName() string {
@@ -74,5 +75,6 @@ func Extract(rm RestModel) (Model, error) {
 		level: rm.Level,
 		jobId: rm.JobId,
 		gm:    rm.Gm,
+		meso:  rm.Meso,
 	}, nil
 }
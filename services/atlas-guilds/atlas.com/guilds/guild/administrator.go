@@ -1,6 +1,8 @@
 package guild
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -66,6 +68,49 @@ func updateCapacity(db *gorm.DB, guildId uint32) (Model, error) {
 	return Make(ge)
 }
 
+// errInsufficientPoints is returned by spendPoints when the guild cannot cover
+// the cost at the moment of the update.
+var errInsufficientPoints = errors.New("insufficient guild points")
+
+func addPoints(db *gorm.DB, guildId uint32, amount uint32) (Model, error) {
+	err := db.Model(&Entity{}).
+		Where("id = ?", guildId).
+		Update("points", gorm.Expr("points + ?", amount)).Error
+	if err != nil {
+		return Model{}, err
+	}
+	ge, err := getById(guildId)(db)()
+	if err != nil {
+		return Model{}, err
+	}
+	return Make(ge)
+}
+
+// spendPoints debits the guild's available points in a single conditional
+// update, so two concurrent spends cannot both pass a stale balance check.
+func spendPoints(db *gorm.DB, guildId uint32, amount uint32) (Model, error) {
+	res := db.Model(&Entity{}).
+		Where("id = ? AND points - spent_points >= ?", guildId, amount).
+		Update("spent_points", gorm.Expr("spent_points + ?", amount))
+	if res.Error != nil {
+		return Model{}, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return Model{}, errInsufficientPoints
+	}
+	ge, err := getById(guildId)(db)()
+	if err != nil {
+		return Model{}, err
+	}
+	return Make(ge)
+}
+
+func updateAllianceId(db *gorm.DB, guildId uint32, allianceId uint32) error {
+	return db.Model(&Entity{}).
+		Where("id = ?", guildId).
+		Update("alliance_id", allianceId).Error
+}
+
 func deleteGuild(db *gorm.DB, guildId uint32) error {
 	return db.Where("id = ?", guildId).Delete(&Entity{}).Error
 }
@@ -18,12 +18,14 @@ type Builder struct {
 	name                *string
 	notice              *string
 	points              *uint32
+	spentPoints         *uint32
 	capacity            *uint32
 	logo                *uint16
 	logoColor           *byte
 	logoBackground      *uint16
 	logoBackgroundColor *byte
 	leaderId            *uint32
+	allianceId          *uint32
 	members             []member.Model
 	titles              []title.Model
 }
@@ -53,6 +55,12 @@ func (b *Builder) SetPoints(points uint32) *Builder {
 	return b
 }
 
+// SetSpentPoints sets the guild points already spent on guild skills
+func (b *Builder) SetSpentPoints(spentPoints uint32) *Builder {
+	b.spentPoints = &spentPoints
+	return b
+}
+
 // SetCapacity sets the guild member capacity
 func (b *Builder) SetCapacity(capacity uint32) *Builder {
 	b.capacity = &capacity
@@ -83,6 +91,12 @@ func (b *Builder) SetLogoBackgroundColor(logoBackgroundColor byte) *Builder {
 	return b
 }
 
+// SetAllianceId sets the alliance the guild belongs to
+func (b *Builder) SetAllianceId(allianceId uint32) *Builder {
+	b.allianceId = &allianceId
+	return b
+}
+
 // SetMembers sets the guild members
 func (b *Builder) SetMembers(members []member.Model) *Builder {
 	b.members = make([]member.Model, len(members))
@@ -141,6 +155,14 @@ func (b *Builder) Build() (Model, error) {
 		points = *b.points
 	}
 
+	spentPoints := uint32(0)
+	if b.spentPoints != nil {
+		spentPoints = *b.spentPoints
+	}
+	if spentPoints > points {
+		return Model{}, errors.New("spent points cannot exceed points")
+	}
+
 	logo := uint16(0)
 	if b.logo != nil {
 		logo = *b.logo
@@ -161,6 +183,11 @@ func (b *Builder) Build() (Model, error) {
 		logoBackgroundColor = *b.logoBackgroundColor
 	}
 
+	allianceId := uint32(0)
+	if b.allianceId != nil {
+		allianceId = *b.allianceId
+	}
+
 	return Model{
 		tenantId:            *b.tenantId,
 		id:                  *b.id,
@@ -168,12 +195,14 @@ func (b *Builder) Build() (Model, error) {
 		name:                *b.name,
 		notice:              notice,
 		points:              points,
+		spentPoints:         spentPoints,
 		capacity:            capacity,
 		logo:                logo,
 		logoColor:           logoColor,
 		logoBackground:      logoBackground,
 		logoBackgroundColor: logoBackgroundColor,
 		leaderId:            *b.leaderId,
+		allianceId:          allianceId,
 		members:             b.members,
 		titles:              b.titles,
 	}, nil
@@ -188,12 +217,14 @@ func (m Model) Builder() *Builder {
 	name := m.name
 	notice := m.notice
 	points := m.points
+	spentPoints := m.spentPoints
 	capacity := m.capacity
 	logo := m.logo
 	logoColor := m.logoColor
 	logoBackground := m.logoBackground
 	logoBackgroundColor := m.logoBackgroundColor
 	leaderId := m.leaderId
+	allianceId := m.allianceId
 
 	return &Builder{
 		tenantId:            &tenantId,
@@ -202,12 +233,14 @@ func (m Model) Builder() *Builder {
 		name:                &name,
 		notice:              &notice,
 		points:              &points,
+		spentPoints:         &spentPoints,
 		capacity:            &capacity,
 		logo:                &logo,
 		logoColor:           &logoColor,
 		logoBackground:      &logoBackground,
 		logoBackgroundColor: &logoBackgroundColor,
 		leaderId:            &leaderId,
+		allianceId:          &allianceId,
 		members:             append([]member.Model{}, m.members...),
 		titles:              append([]title.Model{}, m.titles...),
 	}
@@ -21,6 +21,7 @@ type Entity struct {
 	Name                string          `gorm:"not null"`
 	Notice              string          `gorm:"not null"`
 	Points              uint32          `gorm:"not null"`
+	SpentPoints         uint32          `gorm:"not null;default:0"`
 	Capacity            uint32          `gorm:"not null;default=30"`
 	Logo                uint16          `gorm:"not null;default=0"`
 	LogoColor           byte            `gorm:"not null;default=0"`
@@ -62,12 +63,14 @@ func Make(e Entity) (Model, error) {
 		name:                e.Name,
 		notice:              e.Notice,
 		points:              e.Points,
+		spentPoints:         e.SpentPoints,
 		capacity:            e.Capacity,
 		logo:                e.Logo,
 		logoColor:           e.LogoColor,
 		logoBackground:      e.LogoBackground,
 		logoBackgroundColor: e.LogoBackgroundColor,
 		leaderId:            e.LeaderId,
+		allianceId:          e.AllianceId,
 		members:             members,
 		titles:              titles,
 	}, nil
@@ -0,0 +1,59 @@
+package guild
+
+// Guild point (GP) sources. Reasons travel on the POINTS_UPDATED status event
+// so consumers can tell a boss clear from a scripted contribution.
+const (
+	PointsReasonPartyQuest   = "PARTY_QUEST"
+	PointsReasonBoss         = "BOSS"
+	PointsReasonContribution = "CONTRIBUTION"
+
+	// PartyQuestPoints is awarded once per guild represented in a completed
+	// party quest.
+	PartyQuestPoints = uint32(50)
+	// BossPoints is awarded once per guild that dealt damage to a slain boss.
+	BossPoints = uint32(100)
+)
+
+// levelThresholds holds the lifetime GP required to reach each guild level.
+// Index 0 is level 1, which every guild starts at.
+var levelThresholds = []uint32{
+	0,
+	1000,
+	3000,
+	7500,
+	15000,
+	30000,
+	50000,
+	80000,
+	120000,
+	175000,
+}
+
+// MaxLevel is the highest guild level GP can reach.
+func MaxLevel() byte {
+	return byte(len(levelThresholds))
+}
+
+// LevelForPoints resolves the guild level a lifetime GP total has reached.
+func LevelForPoints(points uint32) byte {
+	level := byte(0)
+	for _, t := range levelThresholds {
+		if points < t {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+// PointsForLevel is the lifetime GP needed to reach level. Levels outside
+// 1..MaxLevel resolve to 0 and the final threshold respectively.
+func PointsForLevel(level byte) uint32 {
+	if level <= 1 {
+		return 0
+	}
+	if level > MaxLevel() {
+		level = MaxLevel()
+	}
+	return levelThresholds[level-1]
+}
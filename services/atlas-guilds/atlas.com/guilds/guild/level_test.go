@@ -0,0 +1,39 @@
+package guild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevelForPoints(t *testing.T) {
+	tests := []struct {
+		points uint32
+		level  byte
+	}{
+		{0, 1},
+		{999, 1},
+		{1000, 2},
+		{2999, 2},
+		{3000, 3},
+		{175000, 10},
+		{4000000000, 10},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.level, LevelForPoints(tt.points), "points %d", tt.points)
+	}
+}
+
+func TestPointsForLevelRoundTrips(t *testing.T) {
+	assert.Equal(t, uint32(0), PointsForLevel(0))
+	for level := byte(1); level <= MaxLevel(); level++ {
+		assert.Equal(t, level, LevelForPoints(PointsForLevel(level)))
+	}
+	assert.Equal(t, PointsForLevel(MaxLevel()), PointsForLevel(MaxLevel()+1))
+}
+
+func TestAvailablePoints(t *testing.T) {
+	m := Model{points: 500, spentPoints: 200}
+	assert.Equal(t, uint32(300), m.AvailablePoints())
+	assert.Equal(t, byte(1), m.Level())
+}
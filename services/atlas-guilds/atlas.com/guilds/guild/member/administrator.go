@@ -3,6 +3,8 @@ package member
 import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 )
 
 func create(db *gorm.DB, tenantId uuid.UUID, guildId uint32, characterId uint32, name string, jobId uint16, level byte, title byte) (Model, error) {
@@ -23,10 +25,16 @@ func create(db *gorm.DB, tenantId uuid.UUID, guildId uint32, characterId uint32,
 	return Make(*e)
 }
 
-func updateStatus(db *gorm.DB, characterId uint32, online bool) error {
+func updateStatus(db *gorm.DB, characterId uint32, online bool, channelId channel.Id, inCashShop bool) error {
+	return db.Model(&Entity{}).
+		Where("character_id = ?", characterId).
+		Updates(map[string]interface{}{"online": online, "channel_id": byte(channelId), "in_cash_shop": inCashShop}).Error
+}
+
+func addContribution(db *gorm.DB, characterId uint32, amount uint32) error {
 	return db.Model(&Entity{}).
 		Where("character_id = ?", characterId).
-		Update("online", online).Error
+		Update("contribution", gorm.Expr("contribution + ?", amount)).Error
 }
 
 func updateTitle(db *gorm.DB, characterId uint32, title byte) error {
@@ -35,6 +43,18 @@ func updateTitle(db *gorm.DB, characterId uint32, title byte) error {
 		Update("title", title).Error
 }
 
+func updateAllianceTitle(db *gorm.DB, characterId uint32, title byte) error {
+	return db.Model(&Entity{}).
+		Where("character_id = ?", characterId).
+		Update("alliance_title", title).Error
+}
+
+func updateGuildAllianceTitle(db *gorm.DB, guildId uint32, title byte) error {
+	return db.Model(&Entity{}).
+		Where("guild_id = ?", guildId).
+		Update("alliance_title", title).Error
+}
+
 // updateName is tenant-scoped: unlike updateStatus/updateTitle above, it
 // filters on tenant_id in addition to character_id. See task-227 Phase F
 // decision log — the omission in the siblings is pre-existing and out of
@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 )
 
 // Builder provides fluent construction of member models
@@ -16,7 +18,10 @@ type Builder struct {
 	level         *byte
 	title         *byte
 	online        *bool
+	channelId     *channel.Id
+	inCashShop    *bool
 	allianceTitle *byte
+	contribution  *uint32
 }
 
 // NewBuilder creates a new builder with required parameters
@@ -53,12 +58,30 @@ func (b *Builder) SetOnline(online bool) *Builder {
 	return b
 }
 
+// SetChannelId sets the channel the member was last seen on
+func (b *Builder) SetChannelId(channelId channel.Id) *Builder {
+	b.channelId = &channelId
+	return b
+}
+
+// SetInCashShop sets whether the member is in the cash shop
+func (b *Builder) SetInCashShop(inCashShop bool) *Builder {
+	b.inCashShop = &inCashShop
+	return b
+}
+
 // SetAllianceTitle sets the member's alliance title
 func (b *Builder) SetAllianceTitle(allianceTitle byte) *Builder {
 	b.allianceTitle = &allianceTitle
 	return b
 }
 
+// SetContribution sets the guild points the member has earned for the guild
+func (b *Builder) SetContribution(contribution uint32) *Builder {
+	b.contribution = &contribution
+	return b
+}
+
 // Build validates invariants and constructs the final immutable model
 func (b *Builder) Build() (Model, error) {
 	if b.tenantId == nil {
@@ -101,11 +124,26 @@ func (b *Builder) Build() (Model, error) {
 		online = *b.online
 	}
 
+	channelId := channel.Id(0)
+	if b.channelId != nil {
+		channelId = *b.channelId
+	}
+
+	inCashShop := false
+	if b.inCashShop != nil {
+		inCashShop = *b.inCashShop
+	}
+
 	allianceTitle := byte(0)
 	if b.allianceTitle != nil {
 		allianceTitle = *b.allianceTitle
 	}
 
+	contribution := uint32(0)
+	if b.contribution != nil {
+		contribution = *b.contribution
+	}
+
 	return Model{
 		tenantId:      *b.tenantId,
 		guildId:       *b.guildId,
@@ -115,7 +153,10 @@ func (b *Builder) Build() (Model, error) {
 		level:         level,
 		title:         title,
 		online:        online,
+		channelId:     channelId,
+		inCashShop:    inCashShop,
 		allianceTitle: allianceTitle,
+		contribution:  contribution,
 	}, nil
 }
 
@@ -130,7 +171,10 @@ func (m Model) Builder() *Builder {
 	level := m.level
 	title := m.title
 	online := m.online
+	channelId := m.channelId
+	inCashShop := m.inCashShop
 	allianceTitle := m.allianceTitle
+	contribution := m.contribution
 
 	return &Builder{
 		tenantId:      &tenantId,
@@ -141,6 +185,9 @@ func (m Model) Builder() *Builder {
 		level:         &level,
 		title:         &title,
 		online:        &online,
+		channelId:     &channelId,
+		inCashShop:    &inCashShop,
 		allianceTitle: &allianceTitle,
+		contribution:  &contribution,
 	}
 }
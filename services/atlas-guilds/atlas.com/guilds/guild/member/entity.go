@@ -3,6 +3,8 @@ package member
 import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 )
 
 func Migration(db *gorm.DB) error {
@@ -18,7 +20,10 @@ type Entity struct {
 	Level         byte      `gorm:"not null"`
 	Title         byte      `gorm:"not null;default=5"`
 	Online        bool      `gorm:"not null;default=false"`
+	ChannelId     byte      `gorm:"not null;default:0"`
+	InCashShop    bool      `gorm:"not null;default:false"`
 	AllianceTitle byte      `gorm:"not null;default=5"`
+	Contribution  uint32    `gorm:"not null;default:0"`
 }
 
 func (e Entity) TableName() string {
@@ -35,6 +40,9 @@ func Make(e Entity) (Model, error) {
 		level:         e.Level,
 		title:         e.Title,
 		online:        e.Online,
+		channelId:     channel.Id(e.ChannelId),
+		inCashShop:    e.InCashShop,
 		allianceTitle: e.AllianceTitle,
+		contribution:  e.Contribution,
 	}, nil
 }
@@ -1,6 +1,22 @@
 package member
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+)
+
+// Alliance titles a member can hold. Titles 3 through 5 are all ordinary
+// member ranks whose display names the alliance master configures. A member
+// of a guild outside any alliance keeps the column default, which the client
+// reads as the lowest rank.
+const (
+	AllianceTitleMaster   = byte(1)
+	AllianceTitleJrMaster = byte(2)
+	AllianceTitleMember   = byte(3)
+	AllianceTitleLowest   = byte(5)
+	AllianceTitleNone     = AllianceTitleLowest
+)
 
 type Model struct {
 	tenantId      uuid.UUID
@@ -11,9 +27,40 @@ type Model struct {
 	level         byte
 	title         byte
 	online        bool
+	channelId     channel.Id
+	inCashShop    bool
 	allianceTitle byte
+	contribution  uint32
 }
 
 func (m Model) CharacterId() uint32 {
 	return m.characterId
 }
+
+func (m Model) Title() byte {
+	return m.title
+}
+
+func (m Model) AllianceTitle() byte {
+	return m.allianceTitle
+}
+
+func (m Model) Online() bool {
+	return m.online
+}
+
+// ChannelId is the channel the member was last seen on. A member in the cash
+// shop keeps the channel they entered it from.
+func (m Model) ChannelId() channel.Id {
+	return m.channelId
+}
+
+func (m Model) InCashShop() bool {
+	return m.inCashShop
+}
+
+// Contribution is the lifetime guild points (GP) this member has earned for
+// the guild.
+func (m Model) Contribution() uint32 {
+	return m.contribution
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
@@ -14,9 +15,12 @@ import (
 type Processor interface {
 	AddMember(guildId uint32, characterId uint32, name string, jobId uint16, level byte, title byte) (Model, error)
 	RemoveMember(guildId uint32, characterId uint32) error
-	UpdateStatus(characterId uint32, online bool) error
+	UpdateStatus(characterId uint32, online bool, channelId channel.Id, inCashShop bool) error
 	UpdateTitle(characterId uint32, title byte) error
+	UpdateAllianceTitle(characterId uint32, title byte) error
+	UpdateGuildAllianceTitle(guildId uint32, title byte) error
 	UpdateName(characterId uint32, name string) error
+	AddContribution(characterId uint32, amount uint32) error
 }
 
 type ProcessorImpl struct {
@@ -72,14 +76,26 @@ func (p *ProcessorImpl) RemoveMember(guildId uint32, characterId uint32) error {
 	})
 }
 
-func (p *ProcessorImpl) UpdateStatus(characterId uint32, online bool) error {
-	return updateStatus(p.db.WithContext(p.ctx), characterId, online)
+func (p *ProcessorImpl) UpdateStatus(characterId uint32, online bool, channelId channel.Id, inCashShop bool) error {
+	return updateStatus(p.db.WithContext(p.ctx), characterId, online, channelId, inCashShop)
 }
 
 func (p *ProcessorImpl) UpdateTitle(characterId uint32, title byte) error {
 	return updateTitle(p.db.WithContext(p.ctx), characterId, title)
 }
 
+func (p *ProcessorImpl) UpdateAllianceTitle(characterId uint32, title byte) error {
+	return updateAllianceTitle(p.db.WithContext(p.ctx), characterId, title)
+}
+
+func (p *ProcessorImpl) UpdateGuildAllianceTitle(guildId uint32, title byte) error {
+	return updateGuildAllianceTitle(p.db.WithContext(p.ctx), guildId, title)
+}
+
 func (p *ProcessorImpl) UpdateName(characterId uint32, name string) error {
 	return updateName(p.db.WithContext(p.ctx), p.t.Id(), characterId, name)
 }
+
+func (p *ProcessorImpl) AddContribution(characterId uint32, amount uint32) error {
+	return addContribution(p.db.WithContext(p.ctx), characterId, amount)
+}
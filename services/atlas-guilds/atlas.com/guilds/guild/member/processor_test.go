@@ -14,6 +14,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
@@ -148,7 +149,7 @@ func TestProcessor_UpdateStatus_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// Update status to online
-	err = p.UpdateStatus(100, true)
+	err = p.UpdateStatus(100, true, channel.Id(2), false)
 
 	require.NoError(t, err)
 
@@ -157,9 +158,10 @@ func TestProcessor_UpdateStatus_Success(t *testing.T) {
 	err = db.Where("tenant_id = ? AND character_id = ?", ten.Id(), 100).First(&entity).Error
 	require.NoError(t, err)
 	assert.True(t, entity.Online)
+	assert.Equal(t, byte(2), entity.ChannelId)
 
 	// Update status to offline
-	err = p.UpdateStatus(100, false)
+	err = p.UpdateStatus(100, false, channel.Id(2), false)
 	require.NoError(t, err)
 
 	err = db.Where("tenant_id = ? AND character_id = ?", ten.Id(), 100).First(&entity).Error
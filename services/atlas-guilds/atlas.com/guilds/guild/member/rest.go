@@ -7,7 +7,10 @@ type RestModel struct {
 	Level         byte   `json:"level"`
 	Title         byte   `json:"title"`
 	Online        bool   `json:"online"`
+	ChannelId     byte   `json:"channelId"`
+	InCashShop    bool   `json:"inCashShop"`
 	AllianceTitle byte   `json:"allianceTitle"`
+	Contribution  uint32 `json:"contribution"`
 }
 
 func Transform(m Model) (RestModel, error) {
@@ -18,6 +21,9 @@ func Transform(m Model) (RestModel, error) {
 		Level:         m.level,
 		Title:         m.title,
 		Online:        m.online,
+		ChannelId:     byte(m.channelId),
+		InCashShop:    m.inCashShop,
 		AllianceTitle: m.allianceTitle,
+		Contribution:  m.contribution,
 	}, nil
 }
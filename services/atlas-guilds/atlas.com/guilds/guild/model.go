@@ -16,12 +16,14 @@ type Model struct {
 	name                string
 	notice              string
 	points              uint32
+	spentPoints         uint32
 	capacity            uint32
 	logo                uint16
 	logoColor           byte
 	logoBackground      uint16
 	logoBackgroundColor byte
 	leaderId            uint32
+	allianceId          uint32
 	members             []member.Model
 	titles              []title.Model
 }
@@ -49,3 +51,34 @@ func (m Model) Capacity() uint32 {
 func (m Model) LeaderId() uint32 {
 	return m.leaderId
 }
+
+func (m Model) Name() string {
+	return m.name
+}
+
+func (m Model) AllianceId() uint32 {
+	return m.allianceId
+}
+
+// Points is the guild's lifetime guild point (GP) total. It only ever grows,
+// so it drives both the guild level and the ranking.
+func (m Model) Points() uint32 {
+	return m.points
+}
+
+// SpentPoints is the portion of Points already paid out for guild skills.
+func (m Model) SpentPoints() uint32 {
+	return m.spentPoints
+}
+
+// AvailablePoints is the GP balance the master may still spend.
+func (m Model) AvailablePoints() uint32 {
+	if m.spentPoints > m.points {
+		return 0
+	}
+	return m.points - m.spentPoints
+}
+
+func (m Model) Level() byte {
+	return LevelForPoints(m.points)
+}
@@ -5,14 +5,19 @@ import (
 	"atlas-guilds/coordinator"
 	character2 "atlas-guilds/guild/character"
 	"atlas-guilds/guild/member"
+	"atlas-guilds/guild/skill"
 	"atlas-guilds/guild/title"
 	"atlas-guilds/invite"
 	"atlas-guilds/kafka/message"
+	"atlas-guilds/kafka/message/buff"
+	charactermsg "atlas-guilds/kafka/message/character"
 	guild2 "atlas-guilds/kafka/message/guild"
 	"atlas-guilds/party"
+	"atlas-guilds/purchase"
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 
@@ -20,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
@@ -34,6 +40,19 @@ const (
 	ErrorCannotAsAdmin = "ADMIN_CANNOT_MAKE_A_GUILD"
 
 	MemberThreshold = 2
+
+	// Guild skill activation failures, reported to the acting master.
+	ErrorNotGuildMaster       = "NOT_GUILD_MASTER"
+	ErrorUnknownSkill         = "UNKNOWN_GUILD_SKILL"
+	ErrorSkillLocked          = "GUILD_SKILL_LOCKED"
+	ErrorSkillAlreadyActive   = "GUILD_SKILL_ALREADY_ACTIVE"
+	ErrorInvalidSkillPayment  = "INVALID_GUILD_SKILL_PAYMENT"
+	ErrorNotEnoughGuildPoints = "NOT_ENOUGH_GUILD_POINTS"
+	ErrorNotEnoughMeso        = "NOT_ENOUGH_MESO"
+
+	// MesoActorType tags the master's meso debit when a guild skill is paid
+	// in mesos.
+	MesoActorType = "GUILD"
 )
 
 type Processor interface {
@@ -46,6 +65,12 @@ type Processor interface {
 	GetById(guildId uint32) (Model, error)
 	GetByName(worldId world.Id, name string) (Model, error)
 	GetByMemberId(memberId uint32) (Model, error)
+	ByAllianceIdProvider(allianceId uint32) model.Provider[[]Model]
+	GetByAllianceId(allianceId uint32) ([]Model, error)
+	// SetAlliance records guildId as belonging to allianceId (0 detaches it).
+	// It only moves the guild; member alliance titles are the alliance
+	// processor's concern.
+	SetAlliance(guildId uint32, allianceId uint32) error
 
 	RequestCreate(mb *message.Buffer) func(characterId uint32) func(field field.Model) func(name string) func(transactionId uuid.UUID) error
 	RequestCreateAndEmit(characterId uint32, field field.Model, name string, transactionId uuid.UUID) error
@@ -55,8 +80,11 @@ type Processor interface {
 	CreationAgreementResponseAndEmit(characterId uint32, agreed bool, transactionId uuid.UUID) error
 	ChangeEmblem(mb *message.Buffer) func(guildId uint32) func(characterId uint32) func(logo uint16) func(logoColor byte) func(logoBackground uint16) func(logoBackgroundColor byte) func(transactionId uuid.UUID) error
 	ChangeEmblemAndEmit(guildId uint32, characterId uint32, logo uint16, logoColor byte, logoBackground uint16, logoBackgroundColor byte, transactionId uuid.UUID) error
-	UpdateMemberOnline(mb *message.Buffer) func(characterId uint32) func(online bool) func(transactionId uuid.UUID) error
-	UpdateMemberOnlineAndEmit(characterId uint32, online bool, transactionId uuid.UUID) error
+	// UpdateMemberPresence records where a member is connected. Active guild
+	// skills are re-applied only when the member comes online, not when they
+	// move between channels or through the cash shop.
+	UpdateMemberPresence(mb *message.Buffer) func(characterId uint32) func(online bool) func(channelId channel.Id) func(inCashShop bool) func(transactionId uuid.UUID) error
+	UpdateMemberPresenceAndEmit(characterId uint32, online bool, channelId channel.Id, inCashShop bool, transactionId uuid.UUID) error
 	ChangeNotice(mb *message.Buffer) func(guildId uint32) func(characterId uint32) func(notice string) func(transactionId uuid.UUID) error
 	ChangeNoticeAndEmit(guildId uint32, characterId uint32, notice string, transactionId uuid.UUID) error
 	Leave(mb *message.Buffer) func(guildId uint32) func(characterId uint32) func(force bool) func(transactionId uuid.UUID) error
@@ -80,6 +108,23 @@ type Processor interface {
 	RequestDisbandAndEmit(characterId uint32, transactionId uuid.UUID) error
 	RequestCapacityIncrease(mb *message.Buffer) func(characterId uint32) func(transactionId uuid.UUID) error
 	RequestCapacityIncreaseAndEmit(characterId uint32, transactionId uuid.UUID) error
+	// RankingProvider pages guilds by lifetime guild points, highest first.
+	// A nil worldId ranks all worlds together.
+	RankingProvider(worldId *world.Id, page model.Page) model.Provider[model.Paged[Model]]
+	// AwardPoints credits amount guild points once to every distinct guild the
+	// characters belong to, and amount to each of those characters'
+	// contribution. Characters outside a guild are skipped.
+	AwardPoints(mb *message.Buffer) func(characterIds []uint32) func(amount uint32) func(reason string) func(transactionId uuid.UUID) error
+	AwardPointsAndEmit(characterIds []uint32, amount uint32, reason string, transactionId uuid.UUID) error
+	// ActivateSkill starts a catalog guild skill on behalf of the guild
+	// master, paid from the guild's points or the master's mesos, and buffs
+	// every online member.
+	ActivateSkill(mb *message.Buffer) func(characterId uint32) func(skillId uint32) func(payment string) func(transactionId uuid.UUID) error
+	ActivateSkillAndEmit(characterId uint32, skillId uint32, payment string, transactionId uuid.UUID) error
+	// RevertSkillPurchase ends a meso-paid guild skill whose debit
+	// atlas-character refused and withdraws its buff from online members.
+	RevertSkillPurchase(mb *message.Buffer) func(pu purchase.Model) error
+	RevertSkillPurchaseAndEmit(pu purchase.Model) error
 }
 
 type ProcessorImpl struct {
@@ -87,6 +132,7 @@ type ProcessorImpl struct {
 	ctx context.Context
 	db  *gorm.DB
 	t   tenant.Model
+	cp  character.Processor
 }
 
 func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
@@ -95,6 +141,7 @@ func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Proces
 		ctx: ctx,
 		db:  db,
 		t:   tenant.MustFromContext(ctx),
+		cp:  character.NewProcessor(l, ctx),
 	}
 }
 
@@ -106,6 +153,7 @@ func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
 		ctx: p.ctx,
 		db:  tx,
 		t:   p.t,
+		cp:  p.cp,
 	}
 }
 
@@ -182,6 +230,19 @@ func (p *ProcessorImpl) GetByMemberId(memberId uint32) (Model, error) {
 	return g, nil
 }
 
+func (p *ProcessorImpl) ByAllianceIdProvider(allianceId uint32) model.Provider[[]Model] {
+	return model.SliceMap[Entity, Model](Make)(getByAllianceId(allianceId)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) GetByAllianceId(allianceId uint32) ([]Model, error) {
+	return p.ByAllianceIdProvider(allianceId)()
+}
+
+func (p *ProcessorImpl) SetAlliance(guildId uint32, allianceId uint32) error {
+	p.l.Debugf("Setting guild [%d] alliance to [%d].", guildId, allianceId)
+	return updateAllianceId(p.db.WithContext(p.ctx), guildId, allianceId)
+}
+
 func (p *ProcessorImpl) RequestCreate(mb *message.Buffer) func(characterId uint32) func(field field.Model) func(name string) func(transactionId uuid.UUID) error {
 	return func(characterId uint32) func(field field.Model) func(name string) func(transactionId uuid.UUID) error {
 		return func(field field.Model) func(name string) func(transactionId uuid.UUID) error {
@@ -434,32 +495,45 @@ func (p *ProcessorImpl) ChangeEmblemAndEmit(guildId uint32, characterId uint32,
 	})
 }
 
-func (p *ProcessorImpl) UpdateMemberOnline(mb *message.Buffer) func(characterId uint32) func(online bool) func(transactionId uuid.UUID) error {
-	return func(characterId uint32) func(online bool) func(transactionId uuid.UUID) error {
-		return func(online bool) func(transactionId uuid.UUID) error {
-			return func(transactionId uuid.UUID) error {
-				return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
-					g, err := p.WithTransaction(tx).GetByMemberId(characterId)
-					if err != nil {
-						return nil
-					}
-					p.l.Debugf("Updating guild [%d] member [%d] status to online [%t]", g.Id(), characterId, online)
-					err = member.NewProcessor(p.l, p.ctx, tx).UpdateStatus(characterId, online)
-					if err != nil {
-						return err
+func (p *ProcessorImpl) UpdateMemberPresence(mb *message.Buffer) func(characterId uint32) func(online bool) func(channelId channel.Id) func(inCashShop bool) func(transactionId uuid.UUID) error {
+	return func(characterId uint32) func(online bool) func(channelId channel.Id) func(inCashShop bool) func(transactionId uuid.UUID) error {
+		return func(online bool) func(channelId channel.Id) func(inCashShop bool) func(transactionId uuid.UUID) error {
+			return func(channelId channel.Id) func(inCashShop bool) func(transactionId uuid.UUID) error {
+				return func(inCashShop bool) func(transactionId uuid.UUID) error {
+					return func(transactionId uuid.UUID) error {
+						return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+							g, err := p.WithTransaction(tx).GetByMemberId(characterId)
+							if err != nil {
+								return nil
+							}
+							wasOnline := false
+							for _, m := range g.Members() {
+								if m.CharacterId() == characterId {
+									wasOnline = m.Online()
+								}
+							}
+							p.l.Debugf("Updating guild [%d] member [%d] status to online [%t] channel [%d] cash shop [%t].", g.Id(), characterId, online, channelId, inCashShop)
+							err = member.NewProcessor(p.l, p.ctx, tx).UpdateStatus(characterId, online, channelId, inCashShop)
+							if err != nil {
+								return err
+							}
+							_ = mb.Put(guild2.EnvStatusEventTopic, statusEventMemberStatusUpdatedProvider(g.WorldId(), g.Id(), characterId, online, channelId, inCashShop, transactionId))
+							if online && !wasOnline {
+								p.applyActiveSkills(mb, tx, g, characterId)
+							}
+							return nil
+						})
 					}
-					_ = mb.Put(guild2.EnvStatusEventTopic, statusEventMemberStatusUpdatedProvider(g.WorldId(), g.Id(), characterId, online, transactionId))
-					return nil
-				})
+				}
 			}
 		}
 	}
 }
 
-func (p *ProcessorImpl) UpdateMemberOnlineAndEmit(characterId uint32, online bool, transactionId uuid.UUID) error {
+func (p *ProcessorImpl) UpdateMemberPresenceAndEmit(characterId uint32, online bool, channelId channel.Id, inCashShop bool, transactionId uuid.UUID) error {
 	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
-			return p.WithTransaction(tx).UpdateMemberOnline(mb)(characterId)(online)(transactionId)
+			return p.WithTransaction(tx).UpdateMemberPresence(mb)(characterId)(online)(channelId)(inCashShop)(transactionId)
 		})
 	})
 }
@@ -568,7 +642,16 @@ func (p *ProcessorImpl) Join(mb *message.Buffer) func(guildId uint32) func(chara
 					return err
 				}
 
-				_ = mb.Put(guild2.EnvStatusEventTopic, statusEventMemberJoinedProvider(g.WorldId(), g.Id(), characterId, c.Name(), c.JobId(), c.Level(), 5, 5, true, transactionId))
+				allianceTitle := member.AllianceTitleNone
+				if g.AllianceId() != 0 {
+					allianceTitle = member.AllianceTitleMember
+					err = member.NewProcessor(p.l, p.ctx, p.db).UpdateAllianceTitle(characterId, allianceTitle)
+					if err != nil {
+						return err
+					}
+				}
+
+				_ = mb.Put(guild2.EnvStatusEventTopic, statusEventMemberJoinedProvider(g.WorldId(), g.Id(), characterId, c.Name(), c.JobId(), c.Level(), 5, allianceTitle, true, transactionId))
 				return nil
 			}
 		}
@@ -714,6 +797,9 @@ func (p *ProcessorImpl) RequestDisband(mb *message.Buffer) func(characterId uint
 				if g.LeaderId() != characterId {
 					return errors.New("must be leader")
 				}
+				if g.AllianceId() != 0 {
+					return errors.New("must leave alliance before disbanding")
+				}
 
 				members := make([]uint32, 0)
 				for _, gm := range g.Members() {
@@ -721,6 +807,7 @@ func (p *ProcessorImpl) RequestDisband(mb *message.Buffer) func(characterId uint
 					_ = member.NewProcessor(p.l, p.ctx, tx).RemoveMember(g.Id(), gm.CharacterId())
 				}
 				_ = title.NewProcessor(p.l, p.ctx, tx).Clear(g.Id())
+				_ = skill.NewProcessor(p.l, p.ctx, tx).Clear(g.Id())
 				_ = deleteGuild(tx, g.Id())
 
 				_ = mb.Put(guild2.EnvStatusEventTopic, statusEventDisbandedProvider(g.WorldId(), g.Id(), members, transactionId))
@@ -767,3 +854,230 @@ func (p *ProcessorImpl) RequestCapacityIncreaseAndEmit(characterId uint32, trans
 		})
 	})
 }
+
+func (p *ProcessorImpl) RankingProvider(worldId *world.Id, page model.Page) model.Provider[model.Paged[Model]] {
+	ep := getRanked(worldId, page)(p.db.WithContext(p.ctx))
+	return model.MapPaged(Make)(ep)(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) AwardPoints(mb *message.Buffer) func(characterIds []uint32) func(amount uint32) func(reason string) func(transactionId uuid.UUID) error {
+	return func(characterIds []uint32) func(amount uint32) func(reason string) func(transactionId uuid.UUID) error {
+		return func(amount uint32) func(reason string) func(transactionId uuid.UUID) error {
+			return func(reason string) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					if amount == 0 {
+						return nil
+					}
+
+					guildIds := make([]uint32, 0)
+					earners := make(map[uint32][]uint32)
+					seen := make(map[uint32]bool)
+					for _, characterId := range characterIds {
+						if seen[characterId] {
+							continue
+						}
+						seen[characterId] = true
+						c, err := character2.NewProcessor(p.l, p.ctx, p.db).GetById(characterId)
+						if err != nil || c.GuildId() == 0 {
+							continue
+						}
+						if _, ok := earners[c.GuildId()]; !ok {
+							guildIds = append(guildIds, c.GuildId())
+						}
+						earners[c.GuildId()] = append(earners[c.GuildId()], characterId)
+					}
+
+					for _, guildId := range guildIds {
+						g, err := addPoints(p.db.WithContext(p.ctx), guildId, amount)
+						if err != nil {
+							p.l.WithError(err).Errorf("Unable to award [%d] points to guild [%d].", amount, guildId)
+							return err
+						}
+						for _, characterId := range earners[guildId] {
+							err = member.NewProcessor(p.l, p.ctx, p.db).AddContribution(characterId, amount)
+							if err != nil {
+								p.l.WithError(err).Errorf("Unable to record guild [%d] contribution for character [%d].", guildId, characterId)
+								return err
+							}
+						}
+						levelUp := LevelForPoints(g.Points()-amount) < g.Level()
+						p.l.Debugf("Guild [%d] awarded [%d] points for [%s]. Now at [%d] points, level [%d].", guildId, amount, reason, g.Points(), g.Level())
+						_ = mb.Put(guild2.EnvStatusEventTopic, statusEventPointsUpdatedProvider(g, earners[guildId], amount, reason, levelUp, transactionId))
+					}
+					return nil
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) AwardPointsAndEmit(characterIds []uint32, amount uint32, reason string, transactionId uuid.UUID) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return p.WithTransaction(tx).AwardPoints(mb)(characterIds)(amount)(reason)(transactionId)
+		})
+	})
+}
+
+func (p *ProcessorImpl) ActivateSkill(mb *message.Buffer) func(characterId uint32) func(skillId uint32) func(payment string) func(transactionId uuid.UUID) error {
+	return func(characterId uint32) func(skillId uint32) func(payment string) func(transactionId uuid.UUID) error {
+		return func(skillId uint32) func(payment string) func(transactionId uuid.UUID) error {
+			return func(payment string) func(transactionId uuid.UUID) error {
+				return func(transactionId uuid.UUID) error {
+					g, err := p.GetByMemberId(characterId)
+					if err != nil {
+						return err
+					}
+					reject := func(reason string) error {
+						_ = mb.Put(guild2.EnvStatusEventTopic, statusEventErrorProvider(g.WorldId(), characterId, reason, transactionId))
+						return errors.New(strings.ToLower(reason))
+					}
+
+					if g.LeaderId() != characterId {
+						return reject(ErrorNotGuildMaster)
+					}
+					d, ok := skill.GetDefinition(skillId)
+					if !ok {
+						return reject(ErrorUnknownSkill)
+					}
+					if g.Level() < d.RequiredLevel() {
+						return reject(ErrorSkillLocked)
+					}
+
+					now := time.Now()
+					sp := skill.NewProcessor(p.l, p.ctx, p.db)
+					active, err := sp.GetActive(g.Id(), now)
+					if err != nil {
+						return err
+					}
+					for _, a := range active {
+						if a.SkillId() == skillId {
+							return reject(ErrorSkillAlreadyActive)
+						}
+					}
+
+					switch payment {
+					case guild2.SkillPaymentPoints:
+						if g.AvailablePoints() < d.PointCost() {
+							return reject(ErrorNotEnoughGuildPoints)
+						}
+						spent, err := spendPoints(p.db.WithContext(p.ctx), g.Id(), d.PointCost())
+						if errors.Is(err, errInsufficientPoints) {
+							return reject(ErrorNotEnoughGuildPoints)
+						}
+						if err != nil {
+							return err
+						}
+						g = spent
+					case guild2.SkillPaymentMeso:
+						c, err := p.cp.GetById(characterId)
+						if err != nil {
+							p.l.WithError(err).Errorf("Unable to retrieve character [%d] paying for guild skill [%d].", characterId, skillId)
+							return err
+						}
+						if c.Meso() < d.MesoCost() {
+							return reject(ErrorNotEnoughMeso)
+						}
+						err = mb.Put(charactermsg.EnvCommandTopic, changeMesoCommandProvider(transactionId, g.WorldId(), characterId, g.Id(), -int32(d.MesoCost())))
+						if err != nil {
+							return err
+						}
+						// The mesos are checked on a snapshot; if atlas-character
+						// refuses the debit, RevertSkillPurchase ends the skill.
+						_, err = purchase.NewProcessor(p.l, p.ctx, p.db).Record(transactionId, characterId, -int32(d.MesoCost()), purchase.KindGuildSkill, g.Id(), skillId)
+						if err != nil {
+							return err
+						}
+					default:
+						return reject(ErrorInvalidSkillPayment)
+					}
+
+					a, err := sp.Activate(g.Id(), skillId, characterId, now, d.Duration())
+					if err != nil {
+						p.l.WithError(err).Errorf("Unable to activate guild [%d] skill [%d].", g.Id(), skillId)
+						return err
+					}
+					p.l.Debugf("Character [%d] activated guild [%d] skill [%d] until [%s].", characterId, g.Id(), skillId, a.ExpiresAt())
+
+					for _, m := range g.Members() {
+						if m.Online() {
+							err = mb.Put(buff.EnvCommandTopic, applySkillBuffCommandProvider(g.WorldId(), m.CharacterId(), d, a.Remaining(now)))
+							if err != nil {
+								return err
+							}
+						}
+					}
+					return mb.Put(guild2.EnvStatusEventTopic, statusEventSkillActivatedProvider(g, characterId, skillId, payment, a.ExpiresAt(), transactionId))
+				}
+			}
+		}
+	}
+}
+
+func (p *ProcessorImpl) ActivateSkillAndEmit(characterId uint32, skillId uint32, payment string, transactionId uuid.UUID) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return p.WithTransaction(tx).ActivateSkill(mb)(characterId)(skillId)(payment)(transactionId)
+		})
+	})
+}
+
+func (p *ProcessorImpl) RevertSkillPurchase(mb *message.Buffer) func(pu purchase.Model) error {
+	return func(pu purchase.Model) error {
+		settled, err := purchase.NewProcessor(p.l, p.ctx, p.db).Settle(pu.Id())
+		if err != nil || !settled {
+			return err
+		}
+
+		g, err := p.GetById(pu.TargetId())
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			p.l.Debugf("Guild [%d] already gone; nothing to revert for transaction [%s].", pu.TargetId(), pu.TransactionId())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = skill.NewProcessor(p.l, p.ctx, p.db).Deactivate(g.Id(), pu.SkillId())
+		if err != nil {
+			return err
+		}
+		p.l.Warnf("Guild [%d] skill [%d] ended: character [%d] could not pay for it.", g.Id(), pu.SkillId(), pu.CharacterId())
+
+		for _, m := range g.Members() {
+			if m.Online() {
+				err = mb.Put(buff.EnvCommandTopic, cancelSkillBuffCommandProvider(g.WorldId(), m.CharacterId(), pu.SkillId()))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func (p *ProcessorImpl) RevertSkillPurchaseAndEmit(pu purchase.Model) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return p.WithTransaction(tx).RevertSkillPurchase(mb)(pu)
+		})
+	})
+}
+
+// applyActiveSkills re-grants a member who just came online every guild skill
+// still running, for whatever time the activation has left.
+func (p *ProcessorImpl) applyActiveSkills(mb *message.Buffer, db *gorm.DB, g Model, characterId uint32) {
+	now := time.Now()
+	active, err := skill.NewProcessor(p.l, p.ctx, db).GetActive(g.Id(), now)
+	if err != nil {
+		p.l.WithError(err).Warnf("Unable to retrieve active skills for guild [%d].", g.Id())
+		return
+	}
+	for _, a := range active {
+		d, ok := skill.GetDefinition(a.SkillId())
+		if !ok {
+			continue
+		}
+		_ = mb.Put(buff.EnvCommandTopic, applySkillBuffCommandProvider(g.WorldId(), characterId, d, a.Remaining(now)))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
 )
@@ -95,7 +96,7 @@ func TestUpdateMemberOnlineAndEmit_CommitEnqueuesOutboxRow(t *testing.T) {
 	require.NoError(t, db.Create(&c).Error)
 
 	p := NewProcessor(l, ctx, db)
-	err := p.UpdateMemberOnlineAndEmit(100, true, uuid.New())
+	err := p.UpdateMemberPresenceAndEmit(100, true, channel.Id(1), false, uuid.New())
 	require.NoError(t, err)
 
 	require.Equal(t, int64(1), outboxRowCount(t, db))
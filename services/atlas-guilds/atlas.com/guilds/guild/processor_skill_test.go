@@ -0,0 +1,315 @@
+package guild
+
+import (
+	"atlas-guilds/character"
+	"atlas-guilds/character/mock"
+	character2 "atlas-guilds/guild/character"
+	"atlas-guilds/guild/member"
+	"atlas-guilds/guild/skill"
+	"atlas-guilds/kafka/message"
+	"atlas-guilds/kafka/message/buff"
+	charactermsg "atlas-guilds/kafka/message/character"
+	guild2 "atlas-guilds/kafka/message/guild"
+	"atlas-guilds/purchase"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type seededMember struct {
+	id     uint32
+	online bool
+}
+
+// seedPointsGuild creates a guild led by the first member, holding points
+// lifetime GP of which spent are already spent.
+func seedPointsGuild(t *testing.T, db *gorm.DB, ten tenant.Model, name string, points uint32, spent uint32, members ...seededMember) uint32 {
+	t.Helper()
+	g := Entity{TenantId: ten.Id(), Name: name, LeaderId: members[0].id, Capacity: 30, Points: points, SpentPoints: spent}
+	require.NoError(t, db.Create(&g).Error)
+	for _, m := range members {
+		require.NoError(t, db.Create(&member.Entity{TenantId: ten.Id(), GuildId: g.Id, CharacterId: m.id, Name: name, Level: 50, Online: m.online}).Error)
+		require.NoError(t, db.Create(&character2.Entity{TenantId: ten.Id(), CharacterId: m.id, GuildId: g.Id}).Error)
+	}
+	return g.Id
+}
+
+func skillTestProcessor(t *testing.T, ten tenant.Model, db *gorm.DB, meso uint32) *ProcessorImpl {
+	t.Helper()
+	return &ProcessorImpl{
+		l:   setupTestLogger(t),
+		ctx: setupTestContext(t, ten),
+		db:  db,
+		t:   ten,
+		cp: &mock.ProcessorMock{GetByIdFunc: func(characterId uint32) (character.Model, error) {
+			return character.Extract(character.RestModel{Id: characterId, Meso: meso})
+		}},
+	}
+}
+
+func guildEvents(t *testing.T, mb *message.Buffer) []guild2.StatusEvent[json.RawMessage] {
+	t.Helper()
+	var result []guild2.StatusEvent[json.RawMessage]
+	for _, m := range mb.GetAll()[guild2.EnvStatusEventTopic] {
+		var e guild2.StatusEvent[json.RawMessage]
+		require.NoError(t, json.Unmarshal(m.Value, &e))
+		result = append(result, e)
+	}
+	return result
+}
+
+func buffTargets(t *testing.T, mb *message.Buffer) []uint32 {
+	t.Helper()
+	var result []uint32
+	for _, m := range mb.GetAll()[buff.EnvCommandTopic] {
+		var c buff.Command[buff.ApplyCommandBody]
+		require.NoError(t, json.Unmarshal(m.Value, &c))
+		result = append(result, c.CharacterId)
+	}
+	return result
+}
+
+func errorOf(t *testing.T, mb *message.Buffer) string {
+	t.Helper()
+	es := guildEvents(t, mb)
+	require.Len(t, es, 1)
+	require.Equal(t, guild2.StatusEventTypeError, es[0].Type)
+	var body guild2.StatusEventErrorBody
+	require.NoError(t, json.Unmarshal(es[0].Body, &body))
+	return body.Error
+}
+
+func TestAwardPointsCreditsEachGuildOnce(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	a := seedPointsGuild(t, db, ten, "GuildA", 990, 0, seededMember{id: 100}, seededMember{id: 101})
+	b := seedPointsGuild(t, db, ten, "GuildB", 0, 0, seededMember{id: 200})
+	p := skillTestProcessor(t, ten, db, 0)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.AwardPoints(mb)([]uint32{100, 101, 101, 200, 300})(BossPoints)(PointsReasonBoss)(uuid.New()))
+
+	ga, err := p.GetById(a)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(990+BossPoints), ga.Points())
+	assert.Equal(t, byte(2), ga.Level())
+	for _, m := range ga.Members() {
+		assert.Equal(t, BossPoints, m.Contribution())
+	}
+	gb, err := p.GetById(b)
+	require.NoError(t, err)
+	assert.Equal(t, BossPoints, gb.Points())
+
+	es := guildEvents(t, mb)
+	require.Len(t, es, 2)
+	var body guild2.StatusEventPointsUpdatedBody
+	require.NoError(t, json.Unmarshal(es[0].Body, &body))
+	assert.Equal(t, guild2.StatusEventTypePointsUpdated, es[0].Type)
+	assert.Equal(t, a, es[0].GuildId)
+	assert.Equal(t, []uint32{100, 101}, body.CharacterIds)
+	assert.True(t, body.LevelUp)
+	require.NoError(t, json.Unmarshal(es[1].Body, &body))
+	assert.False(t, body.LevelUp)
+}
+
+func TestActivateSkillWithPoints(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	gid := seedPointsGuild(t, db, ten, "GuildA", PointsForLevel(2), 0, seededMember{id: 100, online: true}, seededMember{id: 101, online: true}, seededMember{id: 102})
+	p := skillTestProcessor(t, ten, db, 0)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.ActivateSkill(mb)(100)(skill.MightId)(guild2.SkillPaymentPoints)(uuid.New()))
+
+	g, err := p.GetById(gid)
+	require.NoError(t, err)
+	d, _ := skill.GetDefinition(skill.MightId)
+	assert.Equal(t, d.PointCost(), g.SpentPoints())
+	assert.ElementsMatch(t, []uint32{100, 101}, buffTargets(t, mb))
+	assert.Empty(t, mb.GetAll()[charactermsg.EnvCommandTopic])
+
+	es := guildEvents(t, mb)
+	require.Len(t, es, 1)
+	assert.Equal(t, guild2.StatusEventTypeSkillActivated, es[0].Type)
+
+	mb = message.NewBuffer()
+	assert.Error(t, p.ActivateSkill(mb)(100)(skill.MightId)(guild2.SkillPaymentPoints)(uuid.New()))
+	assert.Equal(t, ErrorSkillAlreadyActive, errorOf(t, mb))
+}
+
+// A spend that was checked against a stale balance must not overdraw the
+// guild; the debit itself re-checks the balance.
+func TestSpendPointsCannotOverdraw(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	gid := seedPointsGuild(t, db, ten, "GuildA", 100, 0, seededMember{id: 100})
+
+	g, err := spendPoints(db, gid, 60)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(60), g.SpentPoints())
+
+	_, err = spendPoints(db, gid, 60)
+	assert.ErrorIs(t, err, errInsufficientPoints)
+
+	g, err = addPoints(db, gid, 20)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(120), g.Points())
+	assert.Equal(t, uint32(60), g.SpentPoints())
+}
+
+func TestActivateSkillWithMeso(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	d, _ := skill.GetDefinition(skill.MightId)
+	gid := seedPointsGuild(t, db, ten, "GuildA", PointsForLevel(2), 0, seededMember{id: 100, online: true})
+	p := skillTestProcessor(t, ten, db, d.MesoCost())
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.ActivateSkill(mb)(100)(skill.MightId)(guild2.SkillPaymentMeso)(uuid.New()))
+
+	g, err := p.GetById(gid)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), g.SpentPoints())
+	require.Len(t, mb.GetAll()[charactermsg.EnvCommandTopic], 1)
+	var c charactermsg.Command[charactermsg.RequestChangeMesoBody]
+	require.NoError(t, json.Unmarshal(mb.GetAll()[charactermsg.EnvCommandTopic][0].Value, &c))
+	assert.Equal(t, -int32(d.MesoCost()), c.Body.Amount)
+	assert.Equal(t, MesoActorType, c.Body.ActorType)
+}
+
+// A meso-paid skill whose debit atlas-character refuses is ended and its buff
+// withdrawn from online members; a repeated refusal does nothing more.
+func TestRevertSkillPurchase(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	d, _ := skill.GetDefinition(skill.MightId)
+	gid := seedPointsGuild(t, db, ten, "GuildA", PointsForLevel(2), 0, seededMember{id: 100, online: true}, seededMember{id: 101})
+	p := skillTestProcessor(t, ten, db, d.MesoCost())
+
+	transactionId := uuid.New()
+	require.NoError(t, p.ActivateSkill(message.NewBuffer())(100)(skill.MightId)(guild2.SkillPaymentMeso)(transactionId))
+	pu, err := purchase.NewProcessor(p.l, p.ctx, db).GetPending(transactionId, 100, -int32(d.MesoCost()))
+	require.NoError(t, err)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.RevertSkillPurchase(mb)(pu))
+	require.Len(t, mb.GetAll()[buff.EnvCommandTopic], 1)
+	var c buff.Command[buff.CancelCommandBody]
+	require.NoError(t, json.Unmarshal(mb.GetAll()[buff.EnvCommandTopic][0].Value, &c))
+	assert.Equal(t, buff.CommandTypeCancel, c.Type)
+	assert.Equal(t, uint32(100), c.CharacterId)
+	assert.Equal(t, int32(skill.MightId), c.Body.SourceId)
+
+	active, err := skill.NewProcessor(p.l, p.ctx, db).GetActive(gid, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.RevertSkillPurchase(mb)(pu))
+	assert.Empty(t, mb.GetAll()[buff.EnvCommandTopic])
+}
+
+func TestActivateSkillRejections(t *testing.T) {
+	d, _ := skill.GetDefinition(skill.MightId)
+	tests := []struct {
+		name     string
+		actor    uint32
+		skillId  uint32
+		payment  string
+		points   uint32
+		spent    uint32
+		meso     uint32
+		expected string
+	}{
+		{name: "not master", actor: 101, skillId: skill.MightId, payment: guild2.SkillPaymentPoints, points: PointsForLevel(2), expected: ErrorNotGuildMaster},
+		{name: "unknown skill", actor: 100, skillId: 1, payment: guild2.SkillPaymentPoints, points: PointsForLevel(2), expected: ErrorUnknownSkill},
+		{name: "locked", actor: 100, skillId: skill.FortuneId, payment: guild2.SkillPaymentPoints, points: PointsForLevel(2), expected: ErrorSkillLocked},
+		{name: "not enough points", actor: 100, skillId: skill.MightId, payment: guild2.SkillPaymentPoints, points: PointsForLevel(2), spent: PointsForLevel(2) - d.PointCost() + 1, expected: ErrorNotEnoughGuildPoints},
+		{name: "not enough meso", actor: 100, skillId: skill.MightId, payment: guild2.SkillPaymentMeso, points: PointsForLevel(2), meso: d.MesoCost() - 1, expected: ErrorNotEnoughMeso},
+		{name: "invalid payment", actor: 100, skillId: skill.MightId, payment: "ITEM", points: PointsForLevel(2), expected: ErrorInvalidSkillPayment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ten := setupTestTenant(t)
+			db := setupTestDatabase(t)
+			gid := seedPointsGuild(t, db, ten, "GuildA", tt.points, tt.spent, seededMember{id: 100, online: true}, seededMember{id: 101, online: true})
+			p := skillTestProcessor(t, ten, db, tt.meso)
+
+			mb := message.NewBuffer()
+			assert.Error(t, p.ActivateSkill(mb)(tt.actor)(tt.skillId)(tt.payment)(uuid.New()))
+			assert.Equal(t, tt.expected, errorOf(t, mb))
+			assert.Empty(t, buffTargets(t, mb))
+			assert.Empty(t, mb.GetAll()[charactermsg.EnvCommandTopic])
+
+			g, err := p.GetById(gid)
+			require.NoError(t, err)
+			assert.Equal(t, tt.spent, g.SpentPoints())
+		})
+	}
+}
+
+func TestMemberLoginReceivesActiveSkills(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	gid := seedPointsGuild(t, db, ten, "GuildA", PointsForLevel(3), 0, seededMember{id: 100, online: true}, seededMember{id: 101})
+	p := skillTestProcessor(t, ten, db, 0)
+
+	sp := skill.NewProcessor(p.l, p.ctx, db)
+	_, err := sp.Activate(gid, skill.MightId, 100, time.Now(), 10*time.Minute)
+	require.NoError(t, err)
+	_, err = sp.Activate(gid, skill.FortitudeId, 100, time.Now().Add(-time.Hour), 10*time.Minute)
+	require.NoError(t, err)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.UpdateMemberPresence(mb)(101)(true)(channel.Id(1))(false)(uuid.New()))
+
+	require.Len(t, mb.GetAll()[buff.EnvCommandTopic], 1)
+	var c buff.Command[buff.ApplyCommandBody]
+	require.NoError(t, json.Unmarshal(mb.GetAll()[buff.EnvCommandTopic][0].Value, &c))
+	assert.Equal(t, uint32(101), c.CharacterId)
+	assert.Equal(t, int32(skill.MightId), c.Body.SourceId)
+	assert.LessOrEqual(t, c.Body.Duration, int32((10 * time.Minute).Milliseconds()))
+	assert.Greater(t, c.Body.Duration, int32(0))
+
+	mb = message.NewBuffer()
+	require.NoError(t, p.UpdateMemberPresence(mb)(101)(false)(channel.Id(1))(false)(uuid.New()))
+	assert.Empty(t, mb.GetAll()[buff.EnvCommandTopic])
+}
+
+func TestMemberPresenceChannelToCashShop(t *testing.T) {
+	ten := setupTestTenant(t)
+	db := setupTestDatabase(t)
+	gid := seedPointsGuild(t, db, ten, "GuildA", PointsForLevel(3), 0, seededMember{id: 100, online: true}, seededMember{id: 101, online: true})
+	p := skillTestProcessor(t, ten, db, 0)
+
+	_, err := skill.NewProcessor(p.l, p.ctx, db).Activate(gid, skill.MightId, 100, time.Now(), 10*time.Minute)
+	require.NoError(t, err)
+
+	mb := message.NewBuffer()
+	require.NoError(t, p.UpdateMemberPresence(mb)(101)(true)(channel.Id(2))(true)(uuid.New()))
+
+	events := guildEvents(t, mb)
+	require.Len(t, events, 1)
+	assert.Equal(t, guild2.StatusEventTypeMemberStatusUpdated, events[0].Type)
+	assert.Equal(t, gid, events[0].GuildId)
+	var body guild2.StatusEventMemberStatusUpdatedBody
+	require.NoError(t, json.Unmarshal(events[0].Body, &body))
+	assert.Equal(t, guild2.StatusEventMemberStatusUpdatedBody{CharacterId: 101, Online: true, ChannelId: 2, InCashShop: true}, body)
+
+	// Entering the cash shop is not a login; the running skill is not re-applied.
+	assert.Empty(t, mb.GetAll()[buff.EnvCommandTopic])
+
+	var m member.Entity
+	require.NoError(t, db.Where("character_id = ?", 101).First(&m).Error)
+	assert.True(t, m.Online)
+	assert.Equal(t, byte(2), m.ChannelId)
+	assert.True(t, m.InCashShop)
+}
@@ -3,6 +3,8 @@ package guild
 import (
 	"atlas-guilds/guild/character"
 	"atlas-guilds/guild/member"
+	"atlas-guilds/guild/skill"
+	"atlas-guilds/purchase"
 	"context"
 	"testing"
 
@@ -60,6 +62,12 @@ func setupTestDatabase(t *testing.T) *gorm.DB {
 	if err = member.Migration(db); err != nil {
 		t.Fatalf("Failed to migrate member: %v", err)
 	}
+	if err = skill.Migration(db); err != nil {
+		t.Fatalf("Failed to migrate skill: %v", err)
+	}
+	if err = purchase.Migration(db); err != nil {
+		t.Fatalf("Failed to migrate purchase: %v", err)
+	}
 	// Use raw SQL for title table to avoid PostgreSQL-specific uuid_generate_v4()
 	if err = db.Exec(`CREATE TABLE IF NOT EXISTS titles (
 		tenant_id TEXT NOT NULL,
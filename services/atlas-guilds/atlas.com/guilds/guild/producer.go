@@ -1,11 +1,16 @@
 package guild
 
 import (
+	"atlas-guilds/guild/skill"
+	"atlas-guilds/kafka/message/buff"
+	character2 "atlas-guilds/kafka/message/character"
 	guild2 "atlas-guilds/kafka/message/guild"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
@@ -69,7 +74,7 @@ func statusEventEmblemUpdatedProvider(worldId world.Id, guildId uint32, logo uin
 	return producer.SingleMessageProvider(key, value)
 }
 
-func statusEventMemberStatusUpdatedProvider(worldId world.Id, guildId uint32, characterId uint32, online bool, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+func statusEventMemberStatusUpdatedProvider(worldId world.Id, guildId uint32, characterId uint32, online bool, channelId channel.Id, inCashShop bool, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(guildId))
 	value := &guild2.StatusEvent[guild2.StatusEventMemberStatusUpdatedBody]{
 		WorldId:       worldId,
@@ -79,6 +84,8 @@ func statusEventMemberStatusUpdatedProvider(worldId world.Id, guildId uint32, ch
 		Body: guild2.StatusEventMemberStatusUpdatedBody{
 			CharacterId: characterId,
 			Online:      online,
+			ChannelId:   channelId,
+			InCashShop:  inCashShop,
 		},
 	}
 	return producer.SingleMessageProvider(key, value)
@@ -194,3 +201,95 @@ func statusEventErrorProvider(worldId world.Id, characterId uint32, error string
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func statusEventPointsUpdatedProvider(g Model, characterIds []uint32, amount uint32, reason string, levelUp bool, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(g.Id()))
+	value := &guild2.StatusEvent[guild2.StatusEventPointsUpdatedBody]{
+		WorldId:       g.WorldId(),
+		GuildId:       g.Id(),
+		Type:          guild2.StatusEventTypePointsUpdated,
+		TransactionId: transactionId,
+		Body: guild2.StatusEventPointsUpdatedBody{
+			CharacterIds:    characterIds,
+			Amount:          amount,
+			Reason:          reason,
+			Points:          g.Points(),
+			AvailablePoints: g.AvailablePoints(),
+			Level:           g.Level(),
+			LevelUp:         levelUp,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func statusEventSkillActivatedProvider(g Model, actorId uint32, skillId uint32, payment string, expiresAt time.Time, transactionId uuid.UUID) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(g.Id()))
+	value := &guild2.StatusEvent[guild2.StatusEventSkillActivatedBody]{
+		WorldId:       g.WorldId(),
+		GuildId:       g.Id(),
+		Type:          guild2.StatusEventTypeSkillActivated,
+		TransactionId: transactionId,
+		Body: guild2.StatusEventSkillActivatedBody{
+			ActorId:         actorId,
+			SkillId:         skillId,
+			Payment:         payment,
+			ExpiresAt:       expiresAt,
+			AvailablePoints: g.AvailablePoints(),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func changeMesoCommandProvider(transactionId uuid.UUID, worldId world.Id, characterId uint32, guildId uint32, amount int32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &character2.Command[character2.RequestChangeMesoBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          character2.CommandRequestChangeMeso,
+		Body: character2.RequestChangeMesoBody{
+			ActorId:   guildId,
+			ActorType: MesoActorType,
+			Amount:    amount,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+// applySkillBuffCommandProvider grants one member the buff of a running guild
+// skill for whatever remains of it. The channel is left zero: atlas-channel
+// scopes buff announcements by world and session presence, and guild members
+// are spread across channels.
+func applySkillBuffCommandProvider(worldId world.Id, characterId uint32, d skill.Definition, remaining time.Duration) model.Provider[[]kafka.Message] {
+	changes := make([]buff.StatChange, 0, len(d.Changes()))
+	for _, c := range d.Changes() {
+		changes = append(changes, buff.StatChange{Type: string(c.Type()), Amount: c.Amount()})
+	}
+	key := producer.CreateKey(int(characterId))
+	value := &buff.Command[buff.ApplyCommandBody]{
+		WorldId:     worldId,
+		CharacterId: characterId,
+		Type:        buff.CommandTypeApply,
+		Body: buff.ApplyCommandBody{
+			SourceId: int32(d.Id()),
+			Level:    1,
+			Duration: int32(remaining.Milliseconds()),
+			Changes:  changes,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+// cancelSkillBuffCommandProvider withdraws a guild skill's buff from one member.
+func cancelSkillBuffCommandProvider(worldId world.Id, characterId uint32, skillId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &buff.Command[buff.CancelCommandBody]{
+		WorldId:     worldId,
+		CharacterId: characterId,
+		Type:        buff.CommandTypeCancel,
+		Body: buff.CancelCommandBody{
+			SourceId: int32(skillId),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
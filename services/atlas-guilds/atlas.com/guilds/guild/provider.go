@@ -35,6 +35,19 @@ func getByNameLike(name string, page model.Page) database.EntityProvider[model.P
 	}
 }
 
+// getRanked pages guilds by lifetime points, highest first. PagedQuery's
+// trailing primary-key order breaks ties in favour of the older guild, so the
+// ranking is stable across pages. A nil worldId ranks every world together.
+func getRanked(worldId *world.Id, page model.Page) database.EntityProvider[model.Paged[Entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[Entity]] {
+		q := db.Preload("Members").Preload("Titles").Order("points DESC")
+		if worldId != nil {
+			q = q.Where("world_id = ?", byte(*worldId))
+		}
+		return database.PagedQuery[Entity](q, page)
+	}
+}
+
 func getById(id uint32) database.EntityProvider[Entity] {
 	return func(db *gorm.DB) model.Provider[Entity] {
 		var result Entity
@@ -46,6 +59,17 @@ func getById(id uint32) database.EntityProvider[Entity] {
 	}
 }
 
+func getByAllianceId(allianceId uint32) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var results []Entity
+		err := db.Where("alliance_id = ?", allianceId).Preload("Members").Preload("Titles").Order("id").Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
+
 func getForName(worldId world.Id, name string) database.EntityProvider[[]Entity] {
 	return func(db *gorm.DB) model.Provider[[]Entity] {
 		var results []Entity
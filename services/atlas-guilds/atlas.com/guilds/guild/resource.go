@@ -1,15 +1,18 @@
 package guild
 
 import (
+	"atlas-guilds/guild/skill"
 	"atlas-guilds/rest"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/jtumidanski/api2go/jsonapi"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
@@ -23,7 +26,9 @@ func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteIn
 			r.HandleFunc("", registerGet("get_guilds_by_member_id", handleGetGuildsByMemberId(db))).Queries("filter[members.id]", "{memberId}").Methods(http.MethodGet)
 			r.HandleFunc("", registerGet("get_guilds_by_name_filter", handleGetGuildsByNameFilter(db))).Queries("filter[name]", "{name}").Methods(http.MethodGet)
 			r.HandleFunc("", registerGet("get_guilds", handleGetGuilds(db))).Methods(http.MethodGet)
+			r.HandleFunc("/rankings", registerGet("get_guild_rankings", handleGetGuildRankings(db))).Methods(http.MethodGet)
 			r.HandleFunc("/{guildId}", registerGet("get_guild", handleGetGuild(db))).Methods(http.MethodGet)
+			r.HandleFunc("/{guildId}/skills", registerGet("get_guild_skills", handleGetGuildSkills(db))).Methods(http.MethodGet)
 		}
 	}
 }
@@ -157,3 +162,74 @@ func handleGetGuild(db *gorm.DB) rest.GetHandler {
 		})
 	}
 }
+
+// handleGetGuildRankings pages guilds by lifetime guild points. The optional
+// filter[worldId] restricts the ranking to one world.
+func handleGetGuildRankings(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var worldId *world.Id
+			if wf := r.URL.Query().Get("filter[worldId]"); wf != "" {
+				id, err := strconv.Atoi(wf)
+				if err != nil || id < 0 || id > 255 {
+					server.WriteBadRequest(d.Logger(), w, "filter[worldId] must be a world id")
+					return
+				}
+				wid := world.Id(id)
+				worldId = &wid
+			}
+
+			page, err := paginate.ParseParams(r.URL.Query(), paginate.DefaultPageSize, paginate.MaxPageSize)
+			if err != nil {
+				server.WriteBadRequest(d.Logger(), w, err.Error())
+				return
+			}
+
+			paged, err := NewProcessor(d.Logger(), d.Context(), db).RankingProvider(worldId, page)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Unable to rank guilds.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			res, err := model.SliceMap(Transform)(model.FixedProvider(paged.Items))()()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			query := r.URL.Query()
+			queryParams := jsonapi.ParseQueryFields(&query)
+			server.MarshalPaginatedResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res, paginate.EnvelopeFor(paged), r)
+		}
+	}
+}
+
+// handleGetGuildSkills lists the guild skill catalog as the guild sees it:
+// which skills its level unlocks and which are currently running.
+func handleGetGuildSkills(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseGuildId(d.Logger(), func(guildId uint32) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				g, err := NewProcessor(d.Logger(), d.Context(), db).GetById(guildId)
+				if err != nil {
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				now := time.Now()
+				active, err := skill.NewProcessor(d.Logger(), d.Context(), db).GetActive(guildId, now)
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Unable to retrieve active skills for guild [%d].", guildId)
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[[]skill.RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(skill.Transform(g.Level(), active, now))
+			}
+		})
+	}
+}
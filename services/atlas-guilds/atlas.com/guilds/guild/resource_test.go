@@ -3,6 +3,7 @@ package guild
 import (
 	"atlas-guilds/guild/character"
 	"atlas-guilds/guild/member"
+	"atlas-guilds/guild/skill"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -41,7 +42,7 @@ func resourceTestDB(t *testing.T) *gorm.DB {
 			"index" INTEGER
 		)`).Error
 	}
-	return databasetest.NewInMemoryTenantDB(t, Migration, member.Migration, character.Migration, titlesMigration)
+	return databasetest.NewInMemoryTenantDB(t, Migration, member.Migration, character.Migration, skill.Migration, titlesMigration)
 }
 
 func setupGuildRouter(db *gorm.DB) *mux.Router {
@@ -223,3 +224,68 @@ func TestGetGuildsByMemberIdFilterResource(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+// TestGetGuildRankingsResource verifies guilds rank by points, highest first,
+// with the optional world filter narrowing the field.
+func TestGetGuildRankingsResource(t *testing.T) {
+	db := resourceTestDB(t)
+	tenantId := uuid.New()
+	require.NoError(t, db.Create(&Entity{Id: 1, TenantId: tenantId, WorldId: 0, Name: "Low", LeaderId: 100, Capacity: 30, Points: 10}).Error)
+	require.NoError(t, db.Create(&Entity{Id: 2, TenantId: tenantId, WorldId: 0, Name: "High", LeaderId: 101, Capacity: 30, Points: 5000}).Error)
+	require.NoError(t, db.Create(&Entity{Id: 3, TenantId: tenantId, WorldId: 1, Name: "Other", LeaderId: 102, Capacity: 30, Points: 900}).Error)
+
+	srv := httptest.NewServer(setupGuildRouter(db))
+	defer srv.Close()
+
+	ids := func(t *testing.T, url string) []string {
+		resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, url, tenantId))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var doc jsonapi.Document
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+		var result []string
+		for _, d := range doc.Data.DataArray {
+			result = append(result, d.ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"2", "3", "1"}, ids(t, fmt.Sprintf("%s/guilds/rankings", srv.URL)))
+	assert.Equal(t, []string{"2", "1"}, ids(t, fmt.Sprintf("%s/guilds/rankings?filter[worldId]=0", srv.URL)))
+
+	resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, fmt.Sprintf("%s/guilds/rankings?filter[worldId]=x", srv.URL), tenantId))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestGetGuildSkillsResource lists the whole catalog, unlocked by guild level.
+func TestGetGuildSkillsResource(t *testing.T) {
+	db := resourceTestDB(t)
+	tenantId := uuid.New()
+	require.NoError(t, db.Create(&Entity{Id: 1, TenantId: tenantId, WorldId: 0, Name: "Guild", LeaderId: 100, Capacity: 30, Points: PointsForLevel(3)}).Error)
+
+	srv := httptest.NewServer(setupGuildRouter(db))
+	defer srv.Close()
+
+	resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, fmt.Sprintf("%s/guilds/1/skills", srv.URL), tenantId))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var doc jsonapi.Document
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	require.Len(t, doc.Data.DataArray, len(skill.Definitions()))
+	unlocked := 0
+	for _, d := range doc.Data.DataArray {
+		assert.Equal(t, "guild-skills", d.Type)
+		var attrs skill.RestModel
+		require.NoError(t, json.Unmarshal(d.Attributes, &attrs))
+		if attrs.Unlocked {
+			unlocked++
+		}
+	}
+	assert.Equal(t, 2, unlocked)
+}
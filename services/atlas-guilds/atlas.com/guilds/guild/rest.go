@@ -15,12 +15,15 @@ type RestModel struct {
 	Name                string             `json:"name"`
 	Notice              string             `json:"notice"`
 	Points              uint32             `json:"points"`
+	SpentPoints         uint32             `json:"spentPoints"`
+	Level               byte               `json:"level"`
 	Capacity            uint32             `json:"capacity"`
 	Logo                uint16             `json:"logo"`
 	LogoColor           byte               `json:"logoColor"`
 	LogoBackground      uint16             `json:"logoBackground"`
 	LogoBackgroundColor byte               `json:"logoBackgroundColor"`
 	LeaderId            uint32             `json:"leaderId"`
+	AllianceId          uint32             `json:"allianceId"`
 	Members             []member.RestModel `json:"members"`
 	Titles              []title.RestModel  `json:"titles"`
 }
@@ -58,12 +61,15 @@ func Transform(m Model) (RestModel, error) {
 		Name:                m.name,
 		Notice:              m.notice,
 		Points:              m.points,
+		SpentPoints:         m.spentPoints,
+		Level:               m.Level(),
 		Capacity:            m.capacity,
 		Logo:                m.logo,
 		LogoColor:           m.logoColor,
 		LogoBackground:      m.logoBackground,
 		LogoBackgroundColor: m.logoBackgroundColor,
 		LeaderId:            m.leaderId,
+		AllianceId:          m.allianceId,
 		Members:             members,
 		Titles:              titles,
 	}, nil
@@ -0,0 +1,38 @@
+package skill
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// activate records a skill activation, replacing any lapsed activation of the
+// same skill by the same guild.
+func activate(db *gorm.DB, tenantId uuid.UUID, guildId uint32, skillId uint32, activatedBy uint32, activatedAt time.Time, expiresAt time.Time) (Model, error) {
+	err := db.Where("guild_id = ? AND skill_id = ?", guildId, skillId).Delete(&Entity{}).Error
+	if err != nil {
+		return Model{}, err
+	}
+	e := &Entity{
+		TenantId:    tenantId,
+		GuildId:     guildId,
+		SkillId:     skillId,
+		ActivatedBy: activatedBy,
+		ActivatedAt: activatedAt,
+		ExpiresAt:   expiresAt,
+	}
+	err = db.Create(e).Error
+	if err != nil {
+		return Model{}, err
+	}
+	return Make(*e)
+}
+
+func deleteByGuildId(db *gorm.DB, guildId uint32) error {
+	return db.Where("guild_id = ?", guildId).Delete(&Entity{}).Error
+}
+
+func deleteByGuildIdAndSkillId(db *gorm.DB, guildId uint32, skillId uint32) error {
+	return db.Where("guild_id = ? AND skill_id = ?", guildId, skillId).Delete(&Entity{}).Error
+}
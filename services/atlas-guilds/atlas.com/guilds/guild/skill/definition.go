@@ -0,0 +1,157 @@
+package skill
+
+import (
+	"sort"
+	"time"
+
+	charconst "github.com/Chronicle20/atlas/libs/atlas-constants/character"
+)
+
+// StatChange is one temporary stat a guild skill grants each online member.
+type StatChange struct {
+	statType charconst.TemporaryStatType
+	amount   int32
+}
+
+func (s StatChange) Type() charconst.TemporaryStatType {
+	return s.statType
+}
+
+func (s StatChange) Amount() int32 {
+	return s.amount
+}
+
+// Definition is a catalog entry describing a guild skill. The id doubles as
+// the atlas-buffs sourceId of the buff it grants, so one guild's activation
+// replaces rather than stacks with a previous one.
+type Definition struct {
+	id            uint32
+	name          string
+	requiredLevel byte
+	duration      time.Duration
+	pointCost     uint32
+	mesoCost      uint32
+	changes       []StatChange
+}
+
+func (d Definition) Id() uint32 {
+	return d.id
+}
+
+func (d Definition) Name() string {
+	return d.name
+}
+
+// RequiredLevel is the guild level at which the skill unlocks.
+func (d Definition) RequiredLevel() byte {
+	return d.requiredLevel
+}
+
+func (d Definition) Duration() time.Duration {
+	return d.duration
+}
+
+// PointCost is the price in guild points (GP) when paid from the guild's
+// balance.
+func (d Definition) PointCost() uint32 {
+	return d.pointCost
+}
+
+// MesoCost is the price in mesos when paid by the master instead.
+func (d Definition) MesoCost() uint32 {
+	return d.mesoCost
+}
+
+func (d Definition) Changes() []StatChange {
+	return d.changes
+}
+
+const (
+	MightId     = uint32(91000000)
+	FortitudeId = uint32(91000001)
+	PrecisionId = uint32(91000002)
+	HasteId     = uint32(91000003)
+	FortuneId   = uint32(91000004)
+)
+
+var definitions = map[uint32]Definition{
+	MightId: {
+		id:            MightId,
+		name:          "Guild Might",
+		requiredLevel: 2,
+		duration:      30 * time.Minute,
+		pointCost:     500,
+		mesoCost:      1000000,
+		changes: []StatChange{
+			{statType: charconst.TemporaryStatTypeWeaponAttack, amount: 10},
+			{statType: charconst.TemporaryStatTypeMagicAttack, amount: 10},
+		},
+	},
+	FortitudeId: {
+		id:            FortitudeId,
+		name:          "Guild Fortitude",
+		requiredLevel: 3,
+		duration:      30 * time.Minute,
+		pointCost:     500,
+		mesoCost:      1000000,
+		changes: []StatChange{
+			{statType: charconst.TemporaryStatTypeWeaponDefense, amount: 100},
+			{statType: charconst.TemporaryStatTypeMagicDefense, amount: 100},
+		},
+	},
+	PrecisionId: {
+		id:            PrecisionId,
+		name:          "Guild Precision",
+		requiredLevel: 4,
+		duration:      30 * time.Minute,
+		pointCost:     750,
+		mesoCost:      1500000,
+		changes: []StatChange{
+			{statType: charconst.TemporaryStatTypeAccuracy, amount: 30},
+			{statType: charconst.TemporaryStatTypeAvoidability, amount: 30},
+		},
+	},
+	HasteId: {
+		id:            HasteId,
+		name:          "Guild Haste",
+		requiredLevel: 5,
+		duration:      30 * time.Minute,
+		pointCost:     1000,
+		mesoCost:      2000000,
+		changes: []StatChange{
+			{statType: charconst.TemporaryStatTypeSpeed, amount: 20},
+			{statType: charconst.TemporaryStatTypeJump, amount: 10},
+		},
+	},
+	FortuneId: {
+		id:            FortuneId,
+		name:          "Guild Fortune",
+		requiredLevel: 7,
+		duration:      time.Hour,
+		pointCost:     2500,
+		mesoCost:      5000000,
+		changes: []StatChange{
+			// Rates are scaled by 100, matching atlas-buffs' EXP_BUFF_RATE
+			// convention (120 = 1.2x).
+			{statType: charconst.TemporaryStatTypeExpBuffRate, amount: 120},
+		},
+	},
+}
+
+// GetDefinition looks up a guild skill in the catalog.
+func GetDefinition(skillId uint32) (Definition, bool) {
+	d, ok := definitions[skillId]
+	return d, ok
+}
+
+// Definitions returns the whole catalog ordered by id.
+func Definitions() []Definition {
+	results := make([]Definition, 0, len(definitions))
+	for _, d := range definitions {
+		results = append(results, d)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].id < results[j].id
+	})
+	return results
+}
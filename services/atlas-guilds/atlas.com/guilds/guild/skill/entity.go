@@ -0,0 +1,36 @@
+package skill
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
+
+type Entity struct {
+	TenantId    uuid.UUID `gorm:"not null"`
+	GuildId     uint32    `gorm:"primaryKey;autoIncrement:false;not null"`
+	SkillId     uint32    `gorm:"primaryKey;autoIncrement:false;not null"`
+	ActivatedBy uint32    `gorm:"not null"`
+	ActivatedAt time.Time `gorm:"not null"`
+	ExpiresAt   time.Time `gorm:"not null"`
+}
+
+func (e Entity) TableName() string {
+	return "guild_skills"
+}
+
+func Make(e Entity) (Model, error) {
+	return Model{
+		tenantId:    e.TenantId,
+		guildId:     e.GuildId,
+		skillId:     e.SkillId,
+		activatedBy: e.ActivatedBy,
+		activatedAt: e.ActivatedAt,
+		expiresAt:   e.ExpiresAt,
+	}, nil
+}
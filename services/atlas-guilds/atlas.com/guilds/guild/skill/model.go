@@ -0,0 +1,51 @@
+package skill
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Model is one guild skill activation. A guild holds at most one activation
+// per skill; re-activating after expiry overwrites it.
+type Model struct {
+	tenantId    uuid.UUID
+	guildId     uint32
+	skillId     uint32
+	activatedBy uint32
+	activatedAt time.Time
+	expiresAt   time.Time
+}
+
+func (m Model) GuildId() uint32 {
+	return m.guildId
+}
+
+func (m Model) SkillId() uint32 {
+	return m.skillId
+}
+
+func (m Model) ActivatedBy() uint32 {
+	return m.activatedBy
+}
+
+func (m Model) ActivatedAt() time.Time {
+	return m.activatedAt
+}
+
+func (m Model) ExpiresAt() time.Time {
+	return m.expiresAt
+}
+
+// Active reports whether the activation is still running at now.
+func (m Model) Active(now time.Time) bool {
+	return now.Before(m.expiresAt)
+}
+
+// Remaining is how long the activation still runs at now, never negative.
+func (m Model) Remaining(now time.Time) time.Duration {
+	if !m.Active(now) {
+		return 0
+	}
+	return m.expiresAt.Sub(now)
+}
@@ -0,0 +1,67 @@
+package skill
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type Processor interface {
+	ByGuildIdProvider(guildId uint32) model.Provider[[]Model]
+	// GetActive returns the guild's activations still running at now.
+	GetActive(guildId uint32, now time.Time) ([]Model, error)
+	// Activate records skillId as running for guildId from now until
+	// duration elapses. It does not check cost or eligibility; that is the
+	// guild processor's concern.
+	Activate(guildId uint32, skillId uint32, activatedBy uint32, now time.Time, duration time.Duration) (Model, error)
+	Clear(guildId uint32) error
+	// Deactivate ends the guild's activation of skillId early.
+	Deactivate(guildId uint32, skillId uint32) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	db  *gorm.DB
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		db:  db,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) ByGuildIdProvider(guildId uint32) model.Provider[[]Model] {
+	return model.SliceMap[Entity, Model](Make)(getByGuildId(guildId)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) GetActive(guildId uint32, now time.Time) ([]Model, error) {
+	return model.FilteredProvider(p.ByGuildIdProvider(guildId), model.Filters(func(m Model) bool {
+		return m.Active(now)
+	}))()
+}
+
+func (p *ProcessorImpl) Activate(guildId uint32, skillId uint32, activatedBy uint32, now time.Time, duration time.Duration) (Model, error) {
+	p.l.Debugf("Activating guild [%d] skill [%d] for [%s].", guildId, skillId, duration)
+	return activate(p.db.WithContext(p.ctx), p.t.Id(), guildId, skillId, activatedBy, now, now.Add(duration))
+}
+
+func (p *ProcessorImpl) Clear(guildId uint32) error {
+	return deleteByGuildId(p.db.WithContext(p.ctx), guildId)
+}
+
+func (p *ProcessorImpl) Deactivate(guildId uint32, skillId uint32) error {
+	p.l.Debugf("Deactivating guild [%d] skill [%d].", guildId, skillId)
+	return deleteByGuildIdAndSkillId(p.db.WithContext(p.ctx), guildId, skillId)
+}
@@ -0,0 +1,70 @@
+package skill
+
+import (
+	"strconv"
+	"time"
+)
+
+// RestModel describes a catalog skill from one guild's point of view: what it
+// costs, whether the guild has unlocked it, and whether it is running.
+type RestModel struct {
+	Id            uint32     `json:"-"`
+	Name          string     `json:"name"`
+	RequiredLevel byte       `json:"requiredLevel"`
+	Duration      int64      `json:"duration"`
+	PointCost     uint32     `json:"pointCost"`
+	MesoCost      uint32     `json:"mesoCost"`
+	Unlocked      bool       `json:"unlocked"`
+	Active        bool       `json:"active"`
+	ActivatedBy   uint32     `json:"activatedBy,omitempty"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (r RestModel) GetName() string {
+	return "guild-skills"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+// Transform renders every catalog entry for a guild at guildLevel, marking
+// those with an activation still running at now. Duration is milliseconds.
+func Transform(guildLevel byte, active []Model, now time.Time) []RestModel {
+	running := make(map[uint32]Model)
+	for _, m := range active {
+		if m.Active(now) {
+			running[m.SkillId()] = m
+		}
+	}
+
+	results := make([]RestModel, 0)
+	for _, d := range Definitions() {
+		rm := RestModel{
+			Id:            d.Id(),
+			Name:          d.Name(),
+			RequiredLevel: d.RequiredLevel(),
+			Duration:      d.Duration().Milliseconds(),
+			PointCost:     d.PointCost(),
+			MesoCost:      d.MesoCost(),
+			Unlocked:      guildLevel >= d.RequiredLevel(),
+		}
+		if m, ok := running[d.Id()]; ok {
+			expiresAt := m.ExpiresAt()
+			rm.Active = true
+			rm.ActivatedBy = m.ActivatedBy()
+			rm.ExpiresAt = &expiresAt
+		}
+		results = append(results, rm)
+	}
+	return results
+}
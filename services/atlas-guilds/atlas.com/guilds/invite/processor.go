@@ -7,11 +7,13 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/invite"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
 type Processor interface {
 	Create(actorId uint32, worldId world.Id, referenceId uint32, targetId uint32) error
+	CreateAlliance(actorId uint32, worldId world.Id, allianceId uint32, targetId uint32) error
 }
 
 type ProcessorImpl struct {
@@ -30,5 +32,10 @@ var _ Processor = (*ProcessorImpl)(nil)
 
 func (p *ProcessorImpl) Create(actorId uint32, worldId world.Id, referenceId uint32, targetId uint32) error {
 	p.l.Debugf("Creating guild [%d] invitation for [%d] from [%d].", referenceId, targetId, actorId)
-	return producer.ProviderImpl(p.l)(p.ctx)(EnvCommandTopic)(createInviteCommandProvider(actorId, referenceId, worldId, targetId))
+	return producer.ProviderImpl(p.l)(p.ctx)(EnvCommandTopic)(createInviteCommandProvider(invite.TypeGuild, actorId, referenceId, worldId, targetId))
+}
+
+func (p *ProcessorImpl) CreateAlliance(actorId uint32, worldId world.Id, allianceId uint32, targetId uint32) error {
+	p.l.Debugf("Creating alliance [%d] invitation for [%d] from [%d].", allianceId, targetId, actorId)
+	return producer.ProviderImpl(p.l)(p.ctx)(EnvCommandTopic)(createInviteCommandProvider(invite.TypeAlliance, actorId, allianceId, worldId, targetId))
 }
@@ -10,11 +10,11 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
-func createInviteCommandProvider(actorId uint32, referenceId uint32, worldId world.Id, targetId uint32) model.Provider[[]kafka.Message] {
+func createInviteCommandProvider(inviteType invite.Type, actorId uint32, referenceId uint32, worldId world.Id, targetId uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(referenceId))
 	value := &commandEvent[createCommandBody]{
 		WorldId:    worldId,
-		InviteType: invite.TypeGuild,
+		InviteType: inviteType,
 		Type:       invite.CommandTypeCreate,
 		Body: createCommandBody{
 			OriginatorId: character.Id(actorId),
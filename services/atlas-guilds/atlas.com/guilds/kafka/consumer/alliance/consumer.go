@@ -0,0 +1,152 @@
+package alliance
+
+import (
+	"atlas-guilds/alliance"
+	consumer2 "atlas-guilds/kafka/consumer"
+	alliance2 "atlas-guilds/kafka/message/alliance"
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("guild_alliance_command")(alliance2.EnvCommandTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(alliance2.EnvCommandTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandCreate(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandDisband(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandRequestInvite(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandLeave(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandExpel(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandChangeNotice(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandChangeTitles(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandChangeMemberTitle(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandRequestCapacityIncrease(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+func handleCommandCreate(db *gorm.DB) message.Handler[alliance2.Command[alliance2.CreateBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.CreateBody]) {
+		if c.Type != alliance2.CommandTypeCreate {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).CreateAndEmit(c.WorldId, c.CharacterId, c.Body.Name, c.TransactionId)
+	}
+}
+
+func handleCommandDisband(db *gorm.DB) message.Handler[alliance2.Command[alliance2.DisbandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.DisbandBody]) {
+		if c.Type != alliance2.CommandTypeDisband {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).DisbandAndEmit(c.AllianceId, c.CharacterId, c.TransactionId)
+	}
+}
+
+func handleCommandRequestInvite(db *gorm.DB) message.Handler[alliance2.Command[alliance2.RequestInviteBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.RequestInviteBody]) {
+		if c.Type != alliance2.CommandTypeRequestInvite {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).RequestInviteAndEmit(c.AllianceId, c.CharacterId, c.Body.GuildId)
+	}
+}
+
+func handleCommandLeave(db *gorm.DB) message.Handler[alliance2.Command[alliance2.LeaveBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.LeaveBody]) {
+		if c.Type != alliance2.CommandTypeLeave {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).LeaveAndEmit(c.AllianceId, c.CharacterId, c.TransactionId)
+	}
+}
+
+func handleCommandExpel(db *gorm.DB) message.Handler[alliance2.Command[alliance2.ExpelBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.ExpelBody]) {
+		if c.Type != alliance2.CommandTypeExpel {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).ExpelAndEmit(c.AllianceId, c.CharacterId, c.Body.GuildId, c.TransactionId)
+	}
+}
+
+func handleCommandChangeNotice(db *gorm.DB) message.Handler[alliance2.Command[alliance2.ChangeNoticeBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.ChangeNoticeBody]) {
+		if c.Type != alliance2.CommandTypeChangeNotice {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).ChangeNoticeAndEmit(c.AllianceId, c.CharacterId, c.Body.Notice, c.TransactionId)
+	}
+}
+
+func handleCommandChangeTitles(db *gorm.DB) message.Handler[alliance2.Command[alliance2.ChangeTitlesBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.ChangeTitlesBody]) {
+		if c.Type != alliance2.CommandTypeChangeTitles {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).ChangeTitlesAndEmit(c.AllianceId, c.CharacterId, c.Body.Titles, c.TransactionId)
+	}
+}
+
+func handleCommandChangeMemberTitle(db *gorm.DB) message.Handler[alliance2.Command[alliance2.ChangeMemberTitleBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.ChangeMemberTitleBody]) {
+		if c.Type != alliance2.CommandTypeChangeMemberTitle {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).ChangeMemberTitleAndEmit(c.AllianceId, c.CharacterId, c.Body.TargetId, c.Body.Title, c.TransactionId)
+	}
+}
+
+func handleCommandRequestCapacityIncrease(db *gorm.DB) message.Handler[alliance2.Command[alliance2.RequestCapacityIncreaseBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c alliance2.Command[alliance2.RequestCapacityIncreaseBody]) {
+		if c.Type != alliance2.CommandTypeRequestCapacityIncrease {
+			return
+		}
+
+		_ = alliance.NewProcessor(l, ctx, db).RequestCapacityIncreaseAndEmit(c.AllianceId, c.CharacterId, c.TransactionId)
+	}
+}
@@ -1,10 +1,12 @@
 package character
 
 import (
+	"atlas-guilds/alliance"
 	"atlas-guilds/guild"
 	"atlas-guilds/guild/member"
 	consumer2 "atlas-guilds/kafka/consumer"
 	character2 "atlas-guilds/kafka/message/character"
+	"atlas-guilds/purchase"
 	"context"
 
 	"github.com/google/uuid"
@@ -35,13 +37,13 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventDeleted(db)))); err != nil {
 				return err
 			}
-			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventLogin(db)))); err != nil {
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCharacterNameChanged(db)))); err != nil {
 				return err
 			}
-			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventLogout(db)))); err != nil {
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventMesoChanged(db)))); err != nil {
 				return err
 			}
-			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCharacterNameChanged(db)))); err != nil {
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventMesoError(db)))); err != nil {
 				return err
 			}
 			return nil
@@ -69,49 +71,73 @@ func handleStatusEventDeleted(db *gorm.DB) func(l logrus.FieldLogger, ctx contex
 	}
 }
 
-func handleStatusEventLogin(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventLoginBody]) {
-	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventLoginBody]) {
-		if e.Type != character2.EventCharacterStatusTypeLogin {
+func handleCharacterNameChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventNameChangedBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventNameChangedBody]) {
+		if e.Type != character2.EventCharacterStatusTypeNameChanged {
 			return
 		}
 
-		err := guild.NewProcessor(l, ctx, db).UpdateMemberOnlineAndEmit(e.CharacterId, true, uuid.New())
+		err := member.NewProcessor(l, ctx, db).UpdateName(e.CharacterId, e.Body.NewName)
 		if err != nil {
-			l.WithError(err).Errorf("Unable to process login for character [%d].", e.CharacterId)
+			l.WithError(err).Errorf("Unable to update guild roster name for character [%d] from [%s] to [%s].", e.CharacterId, e.Body.OldName, e.Body.NewName)
+			return
 		}
+		t := tenant.MustFromContext(ctx)
+		l.WithFields(logrus.Fields{
+			"tenant":      t.Id(),
+			"characterId": e.CharacterId,
+			"oldName":     e.Body.OldName,
+			"newName":     e.Body.NewName,
+		}).Infof("Updated guild roster name for character [%d].", e.CharacterId)
 	}
 }
 
-func handleStatusEventLogout(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventLogoutBody]) {
-	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventLogoutBody]) {
-		if e.Type != character2.EventCharacterStatusTypeLogout {
+// handleStatusEventMesoChanged settles a meso purchase once atlas-character
+// has taken the mesos.
+func handleStatusEventMesoChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventMesoChangedBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventMesoChangedBody]) {
+		if e.Type != character2.EventCharacterStatusTypeMesoChanged || e.Body.Amount >= 0 {
 			return
 		}
 
-		err := guild.NewProcessor(l, ctx, db).UpdateMemberOnlineAndEmit(e.CharacterId, false, uuid.New())
+		pp := purchase.NewProcessor(l, ctx, db)
+		pu, err := pp.GetPending(e.TransactionId, e.CharacterId, e.Body.Amount)
 		if err != nil {
-			l.WithError(err).Errorf("Unable to process logout for character [%d].", e.CharacterId)
+			return
+		}
+		if _, err = pp.Settle(pu.Id()); err != nil {
+			l.WithError(err).Errorf("Unable to settle purchase [%d] for character [%d].", pu.Id(), e.CharacterId)
 		}
 	}
 }
 
-func handleCharacterNameChanged(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventNameChangedBody]) {
-	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventNameChangedBody]) {
-		if e.Type != character2.EventCharacterStatusTypeNameChanged {
+// handleStatusEventMesoError undoes a meso purchase whose debit
+// atlas-character refused, e.g. because the character spent the mesos
+// between the purchase check and the debit.
+func handleStatusEventMesoError(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event character2.StatusEvent[character2.StatusEventMesoErrorBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e character2.StatusEvent[character2.StatusEventMesoErrorBody]) {
+		if e.Type != character2.EventCharacterStatusTypeError {
+			return
+		}
+		if e.Body.Error != character2.StatusEventErrorTypeNotEnoughMeso && e.Body.Error != character2.StatusEventErrorTypeMesoOverflow {
 			return
 		}
 
-		err := member.NewProcessor(l, ctx, db).UpdateName(e.CharacterId, e.Body.NewName)
+		pu, err := purchase.NewProcessor(l, ctx, db).GetPending(e.TransactionId, e.CharacterId, e.Body.Amount)
 		if err != nil {
-			l.WithError(err).Errorf("Unable to update guild roster name for character [%d] from [%s] to [%s].", e.CharacterId, e.Body.OldName, e.Body.NewName)
 			return
 		}
-		t := tenant.MustFromContext(ctx)
-		l.WithFields(logrus.Fields{
-			"tenant":      t.Id(),
-			"characterId": e.CharacterId,
-			"oldName":     e.Body.OldName,
-			"newName":     e.Body.NewName,
-		}).Infof("Updated guild roster name for character [%d].", e.CharacterId)
+		switch pu.Kind() {
+		case purchase.KindAllianceCreate, purchase.KindAllianceCapacity:
+			err = alliance.NewProcessor(l, ctx, db).RevertPurchaseAndEmit(pu)
+		case purchase.KindGuildSkill:
+			err = guild.NewProcessor(l, ctx, db).RevertSkillPurchaseAndEmit(pu)
+		default:
+			l.Warnf("Unable to revert purchase [%d] of unknown kind [%s].", pu.Id(), pu.Kind())
+			return
+		}
+		if err != nil {
+			l.WithError(err).Errorf("Unable to revert [%s] purchase [%d] for character [%d].", pu.Kind(), pu.Id(), e.CharacterId)
+		}
 	}
 }
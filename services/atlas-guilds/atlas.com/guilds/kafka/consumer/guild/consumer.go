@@ -63,6 +63,12 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandRequestCapacityIncrease(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandAwardPoints(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCommandActivateSkill(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -182,3 +188,23 @@ func handleCommandRequestCapacityIncrease(db *gorm.DB) message.Handler[guild2.Co
 		_ = guild.NewProcessor(l, ctx, db).RequestCapacityIncreaseAndEmit(c.CharacterId, c.TransactionId)
 	}
 }
+
+func handleCommandAwardPoints(db *gorm.DB) message.Handler[guild2.Command[guild2.AwardPointsBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c guild2.Command[guild2.AwardPointsBody]) {
+		if c.Type != guild2.CommandTypeAwardPoints {
+			return
+		}
+
+		_ = guild.NewProcessor(l, ctx, db).AwardPointsAndEmit([]uint32{c.CharacterId}, c.Body.Amount, c.Body.Reason, c.TransactionId)
+	}
+}
+
+func handleCommandActivateSkill(db *gorm.DB) message.Handler[guild2.Command[guild2.ActivateSkillBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c guild2.Command[guild2.ActivateSkillBody]) {
+		if c.Type != guild2.CommandTypeActivateSkill {
+			return
+		}
+
+		_ = guild.NewProcessor(l, ctx, db).ActivateSkillAndEmit(c.CharacterId, c.Body.SkillId, c.Body.Payment, c.TransactionId)
+	}
+}
@@ -1,6 +1,7 @@
 package invite
 
 import (
+	"atlas-guilds/alliance"
 	"atlas-guilds/guild"
 	consumer2 "atlas-guilds/kafka/consumer"
 	invite2 "atlas-guilds/kafka/message/invite"
@@ -34,6 +35,9 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAcceptedInvite(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAcceptedAllianceInvite(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -54,3 +58,19 @@ func handleAcceptedInvite(db *gorm.DB) message.Handler[invite2.StatusEvent[invit
 		}
 	}
 }
+
+func handleAcceptedAllianceInvite(db *gorm.DB) message.Handler[invite2.StatusEvent[invite2.AcceptedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e invite2.StatusEvent[invite2.AcceptedEventBody]) {
+		if e.Type != invite.StatusTypeAccepted {
+			return
+		}
+		if e.InviteType != invite.TypeAlliance {
+			return
+		}
+
+		err := alliance.NewProcessor(l, ctx, db).JoinAndEmit(uint32(e.ReferenceId), uint32(e.Body.TargetId), uuid.New())
+		if err != nil {
+			l.WithError(err).Errorf("Guild of character [%d] unable to join alliance [%d].", e.Body.TargetId, e.ReferenceId)
+		}
+	}
+}
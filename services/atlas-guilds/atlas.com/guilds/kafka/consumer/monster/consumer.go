@@ -0,0 +1,61 @@
+package monster
+
+import (
+	"atlas-guilds/guild"
+	consumer2 "atlas-guilds/kafka/consumer"
+	"atlas-guilds/kafka/message/monster"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("monster_status")(monster.EnvEventTopicMonsterStatus)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(monster.EnvEventTopicMonsterStatus)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleMonsterKilledEvent(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleMonsterKilledEvent awards guild points to every guild that helped
+// bring down a boss.
+func handleMonsterKilledEvent(db *gorm.DB) message.Handler[monster.StatusEvent[monster.StatusEventKilledBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e monster.StatusEvent[monster.StatusEventKilledBody]) {
+		if e.Type != monster.EventMonsterStatusKilled {
+			return
+		}
+		if !e.Body.Boss {
+			return
+		}
+
+		characterIds := make([]uint32, 0, len(e.Body.DamageEntries))
+		for _, de := range e.Body.DamageEntries {
+			characterIds = append(characterIds, de.CharacterId)
+		}
+		err := guild.NewProcessor(l, ctx, db).AwardPointsAndEmit(characterIds, guild.BossPoints, guild.PointsReasonBoss, uuid.New())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to award guild points for boss [%d] kill.", e.MonsterId)
+		}
+	}
+}
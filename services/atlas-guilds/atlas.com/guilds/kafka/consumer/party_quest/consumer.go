@@ -0,0 +1,54 @@
+package party_quest
+
+import (
+	"atlas-guilds/guild"
+	consumer2 "atlas-guilds/kafka/consumer"
+	pq "atlas-guilds/kafka/message/party_quest"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("party_quest_status")(pq.EnvEventStatusTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(pq.EnvEventStatusTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCompletedEvent(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleCompletedEvent awards guild points to every guild represented in a
+// cleared party quest.
+func handleCompletedEvent(db *gorm.DB) message.Handler[pq.StatusEvent[pq.CompletedEventBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e pq.StatusEvent[pq.CompletedEventBody]) {
+		if e.Type != pq.EventTypeCompleted {
+			return
+		}
+
+		err := guild.NewProcessor(l, ctx, db).AwardPointsAndEmit(e.Body.CharacterIds, guild.PartyQuestPoints, guild.PointsReasonPartyQuest, uuid.New())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to award guild points for party quest [%s] instance [%s].", e.QuestId, e.InstanceId)
+		}
+	}
+}
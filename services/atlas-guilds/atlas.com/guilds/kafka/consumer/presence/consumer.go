@@ -0,0 +1,56 @@
+package presence
+
+import (
+	"atlas-guilds/guild"
+	consumer2 "atlas-guilds/kafka/consumer"
+	presence2 "atlas-guilds/kafka/message/presence"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("character_presence_status")(presence2.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(presence2.EnvStatusEventTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventUpdated(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleStatusEventUpdated follows every presence transition, so guild
+// members see logins, logouts, channel changes and cash shop visits alike.
+func handleStatusEventUpdated(db *gorm.DB) func(l logrus.FieldLogger, ctx context.Context, event presence2.StatusEvent[presence2.UpdatedStatusEventBody]) {
+	return func(l logrus.FieldLogger, ctx context.Context, e presence2.StatusEvent[presence2.UpdatedStatusEventBody]) {
+		if e.Type != presence2.StatusEventTypeUpdated {
+			return
+		}
+
+		online := e.Body.State != presence2.StateOffline
+		inCashShop := e.Body.State == presence2.StateCashShop
+		err := guild.NewProcessor(l, ctx, db).UpdateMemberPresenceAndEmit(e.CharacterId, online, e.Body.ChannelId, inCashShop, uuid.New())
+		if err != nil {
+			l.WithError(err).Errorf("Unable to process presence change for character [%d].", e.CharacterId)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package alliance
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic                    = "COMMAND_TOPIC_GUILD_ALLIANCE"
+	CommandTypeCreate                  = "CREATE"
+	CommandTypeDisband                 = "DISBAND"
+	CommandTypeRequestInvite           = "REQUEST_INVITE"
+	CommandTypeLeave                   = "LEAVE"
+	CommandTypeExpel                   = "EXPEL"
+	CommandTypeChangeNotice            = "CHANGE_NOTICE"
+	CommandTypeChangeTitles            = "CHANGE_TITLES"
+	CommandTypeChangeMemberTitle       = "CHANGE_MEMBER_TITLE"
+	CommandTypeRequestCapacityIncrease = "REQUEST_CAPACITY_INCREASE"
+)
+
+// Command is issued by the alliance NPC (create, disband, capacity) or by the
+// client's alliance window (everything else). CharacterId is always the actor;
+// AllianceId is zero for CREATE, and for REQUEST_CAPACITY_INCREASE when the NPC
+// leaves it to be resolved from the actor's guild.
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	CharacterId   uint32    `json:"characterId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type CreateBody struct {
+	Name string `json:"name"`
+}
+
+type DisbandBody struct{}
+
+type RequestInviteBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type LeaveBody struct{}
+
+type ExpelBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type ChangeNoticeBody struct {
+	Notice string `json:"notice"`
+}
+
+type ChangeTitlesBody struct {
+	Titles []string `json:"titles"`
+}
+
+type ChangeMemberTitleBody struct {
+	TargetId uint32 `json:"targetId"`
+	Title    byte   `json:"title"`
+}
+
+type RequestCapacityIncreaseBody struct{}
+
+const (
+	EnvStatusEventTopic               = "EVENT_TOPIC_GUILD_ALLIANCE_STATUS"
+	StatusEventTypeCreated            = "CREATED"
+	StatusEventTypeDisbanded          = "DISBANDED"
+	StatusEventTypeGuildJoined        = "GUILD_JOINED"
+	StatusEventTypeGuildLeft          = "GUILD_LEFT"
+	StatusEventTypeNoticeUpdated      = "NOTICE_UPDATED"
+	StatusEventTypeTitlesUpdated      = "TITLES_UPDATED"
+	StatusEventTypeMemberTitleUpdated = "MEMBER_TITLE_UPDATED"
+	StatusEventTypeCapacityUpdated    = "CAPACITY_UPDATED"
+	StatusEventTypeError              = "ERROR"
+)
+
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type StatusEventCreatedBody struct {
+	Name     string `json:"name"`
+	GuildId  uint32 `json:"guildId"`
+	LeaderId uint32 `json:"leaderId"`
+}
+
+type StatusEventDisbandedBody struct {
+	GuildIds []uint32 `json:"guildIds"`
+}
+
+type StatusEventGuildJoinedBody struct {
+	GuildId uint32 `json:"guildId"`
+}
+
+type StatusEventGuildLeftBody struct {
+	GuildId uint32 `json:"guildId"`
+	Force   bool   `json:"force"`
+}
+
+type StatusEventNoticeUpdatedBody struct {
+	Notice string `json:"notice"`
+}
+
+type StatusEventTitlesUpdatedBody struct {
+	Titles []string `json:"titles"`
+}
+
+type StatusEventMemberTitleUpdatedBody struct {
+	CharacterId uint32 `json:"characterId"`
+	Title       byte   `json:"title"`
+}
+
+type StatusEventCapacityUpdatedBody struct {
+	Capacity uint32 `json:"capacity"`
+}
+
+type StatusEventErrorBody struct {
+	ActorId uint32 `json:"actorId"`
+	Error   string `json:"error"`
+}
@@ -0,0 +1,51 @@
+// Package buff mirrors the atlas-buffs character-buff command this service
+// PRODUCES (source of truth:
+// services/atlas-buffs/atlas.com/buffs/kafka/message/character/kafka.go).
+// Guild skills APPLY and lapse on their own duration; a skill whose meso
+// payment is refused is withdrawn with CANCEL.
+package buff
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic   = "COMMAND_TOPIC_CHARACTER_BUFF"
+	CommandTypeApply  = "APPLY"
+	CommandTypeCancel = "CANCEL"
+)
+
+type Command[E any] struct {
+	WorldId     world.Id   `json:"worldId"`
+	ChannelId   channel.Id `json:"channelId"`
+	MapId       _map.Id    `json:"mapId"`
+	Instance    uuid.UUID  `json:"instance"`
+	CharacterId uint32     `json:"characterId"`
+	Type        string     `json:"type"`
+	Body        E          `json:"body"`
+}
+
+type ApplyCommandBody struct {
+	FromId   uint32 `json:"fromId"`
+	SourceId int32  `json:"sourceId"`
+	Level    byte   `json:"level"`
+	// Duration is MILLISECONDS (contract owner: atlas-buffs).
+	Duration      int32        `json:"duration"`
+	Changes       []StatChange `json:"changes"`
+	Accumulate    bool         `json:"accumulate,omitempty"`
+	NoExpiry      bool         `json:"noExpiry,omitempty"`
+	CorrelationId string       `json:"correlationId,omitempty"`
+}
+
+type StatChange struct {
+	Type   string `json:"type"`
+	Amount int32  `json:"amount"`
+}
+
+type CancelCommandBody struct {
+	SourceId int32 `json:"sourceId"`
+}
@@ -15,8 +15,30 @@ const (
 	EventCharacterStatusTypeLogout         = "LOGOUT"
 	EventCharacterStatusTypeChannelChanged = "CHANNEL_CHANGED"
 	EventCharacterStatusTypeNameChanged    = "NAME_CHANGED"
+	EventCharacterStatusTypeMesoChanged    = "MESO_CHANGED"
+	EventCharacterStatusTypeError          = "ERROR"
+
+	StatusEventErrorTypeNotEnoughMeso = "NOT_ENOUGH_MESO"
+	StatusEventErrorTypeMesoOverflow  = "MESO_OVERFLOW"
+
+	EnvCommandTopic          = "COMMAND_TOPIC_CHARACTER"
+	CommandRequestChangeMeso = "REQUEST_CHANGE_MESO"
 )
 
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	CharacterId   uint32    `json:"characterId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type RequestChangeMesoBody struct {
+	ActorId   uint32 `json:"actorId"`
+	ActorType string `json:"actorType"`
+	Amount    int32  `json:"amount"`
+}
+
 type StatusEvent[E any] struct {
 	TransactionId uuid.UUID `json:"transactionId"`
 	WorldId       world.Id  `json:"worldId"`
@@ -50,3 +72,16 @@ type StatusEventNameChangedBody struct {
 	OldName string `json:"oldName"`
 	NewName string `json:"newName"`
 }
+
+type StatusEventMesoChangedBody struct {
+	ActorId   uint32 `json:"actorId"`
+	ActorType string `json:"actorType"`
+	Amount    int32  `json:"amount"`
+}
+
+// StatusEventMesoErrorBody reports a REQUEST_CHANGE_MESO atlas-character
+// refused; Amount is the change that was requested.
+type StatusEventMesoErrorBody struct {
+	Error  string `json:"error"`
+	Amount int32  `json:"amount"`
+}
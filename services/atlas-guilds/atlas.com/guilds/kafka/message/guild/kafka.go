@@ -1,6 +1,8 @@
 package guild
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
@@ -28,6 +30,17 @@ const (
 	// the exact rank they held. It is deliberately NOT the invite/accept flow
 	// (which would require the player to act) and NOT REQUEST_INVITE.
 	CommandTypeRejoin = "REJOIN"
+	// CommandTypeAwardPoints credits guild points (GP) to the guild of the
+	// envelope's CharacterId, and the same amount to that member's
+	// contribution. Scripts and other services use it for donations and any
+	// GP source this service does not observe itself.
+	CommandTypeAwardPoints = "AWARD_POINTS"
+	// CommandTypeActivateSkill is sent on behalf of the guild master to start
+	// a guild skill for every member.
+	CommandTypeActivateSkill = "ACTIVATE_SKILL"
+
+	SkillPaymentPoints = "POINTS"
+	SkillPaymentMeso   = "MESO"
 )
 
 type Command[E any] struct {
@@ -101,6 +114,19 @@ type RequestCapacityIncreaseBody struct {
 	ChannelId channel.Id `json:"channelId"`
 }
 
+type AwardPointsBody struct {
+	Amount uint32 `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+// ActivateSkillBody names the catalog skill and how the master pays for it:
+// SkillPaymentPoints spends the guild's GP balance, SkillPaymentMeso charges
+// the master's mesos.
+type ActivateSkillBody struct {
+	SkillId uint32 `json:"skillId"`
+	Payment string `json:"payment"`
+}
+
 const (
 	EnvStatusEventTopic                = "EVENT_TOPIC_GUILD_STATUS"
 	StatusEventTypeCreated             = "CREATED"
@@ -114,6 +140,8 @@ const (
 	StatusEventTypeNoticeUpdated       = "NOTICE_UPDATED"
 	StatusEventTypeCapacityUpdated     = "CAPACITY_UPDATED"
 	StatusEventTypeTitlesUpdated       = "TITLES_UPDATED"
+	StatusEventTypePointsUpdated       = "POINTS_UPDATED"
+	StatusEventTypeSkillActivated      = "SKILL_ACTIVATED"
 	StatusEventTypeError               = "ERROR"
 )
 
@@ -144,8 +172,10 @@ type StatusEventEmblemUpdatedBody struct {
 }
 
 type StatusEventMemberStatusUpdatedBody struct {
-	CharacterId uint32 `json:"characterId"`
-	Online      bool   `json:"online"`
+	CharacterId uint32     `json:"characterId"`
+	Online      bool       `json:"online"`
+	ChannelId   channel.Id `json:"channelId"`
+	InCashShop  bool       `json:"inCashShop"`
 }
 
 type StatusEventMemberTitleUpdatedBody struct {
@@ -181,6 +211,27 @@ type StatusEventTitlesUpdatedBody struct {
 	Titles  []string `json:"titles"`
 }
 
+// StatusEventPointsUpdatedBody reports a GP award. Points is the new lifetime
+// total and Level the level it resolves to; LevelUp is set when this award
+// crossed a level threshold.
+type StatusEventPointsUpdatedBody struct {
+	CharacterIds    []uint32 `json:"characterIds"`
+	Amount          uint32   `json:"amount"`
+	Reason          string   `json:"reason"`
+	Points          uint32   `json:"points"`
+	AvailablePoints uint32   `json:"availablePoints"`
+	Level           byte     `json:"level"`
+	LevelUp         bool     `json:"levelUp"`
+}
+
+type StatusEventSkillActivatedBody struct {
+	ActorId         uint32    `json:"actorId"`
+	SkillId         uint32    `json:"skillId"`
+	Payment         string    `json:"payment"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	AvailablePoints uint32    `json:"availablePoints"`
+}
+
 type StatusEventErrorBody struct {
 	ActorId uint32 `json:"actorId"`
 	Error   string `json:"error"`
@@ -0,0 +1,38 @@
+package monster
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventTopicMonsterStatus = "EVENT_TOPIC_MONSTER_STATUS"
+	EventMonsterStatusKilled   = "KILLED"
+)
+
+type StatusEvent[E any] struct {
+	WorldId   world.Id   `json:"worldId"`
+	ChannelId channel.Id `json:"channelId"`
+	MapId     _map.Id    `json:"mapId"`
+	Instance  uuid.UUID  `json:"instance"`
+	UniqueId  uint32     `json:"uniqueId"`
+	MonsterId uint32     `json:"monsterId"`
+	Type      string     `json:"type"`
+	Body      E          `json:"body"`
+}
+
+type StatusEventKilledBody struct {
+	X             int16         `json:"x"`
+	Y             int16         `json:"y"`
+	ActorId       uint32        `json:"actorId"`
+	Boss          bool          `json:"boss"`
+	DamageEntries []DamageEntry `json:"damageEntries"`
+}
+
+type DamageEntry struct {
+	CharacterId uint32 `json:"characterId"`
+	Damage      uint32 `json:"damage"`
+}
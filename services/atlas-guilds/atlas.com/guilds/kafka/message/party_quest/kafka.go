@@ -0,0 +1,24 @@
+package party_quest
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventStatusTopic = "EVENT_TOPIC_PARTY_QUEST_STATUS"
+	EventTypeCompleted  = "COMPLETED"
+)
+
+type StatusEvent[E any] struct {
+	WorldId    world.Id  `json:"worldId"`
+	InstanceId uuid.UUID `json:"instanceId"`
+	QuestId    string    `json:"questId"`
+	Type       string    `json:"type"`
+	Body       E         `json:"body"`
+}
+
+type CompletedEventBody struct {
+	CharacterIds []uint32 `json:"characterIds"`
+}
@@ -0,0 +1,32 @@
+package presence
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvStatusEventTopic    = "EVENT_TOPIC_CHARACTER_PRESENCE_STATUS"
+	StatusEventTypeUpdated = "UPDATED"
+
+	StateOffline  = "OFFLINE"
+	StateOnline   = "ONLINE"
+	StateCashShop = "CASH_SHOP"
+)
+
+type StatusEvent[E any] struct {
+	WorldId     world.Id `json:"worldId"`
+	CharacterId uint32   `json:"characterId"`
+	Type        string   `json:"type"`
+	Body        E        `json:"body"`
+}
+
+type UpdatedStatusEventBody struct {
+	State             string     `json:"state"`
+	ChannelId         channel.Id `json:"channelId"`
+	MapId             _map.Id    `json:"mapId"`
+	PreviousState     string     `json:"previousState"`
+	PreviousChannelId channel.Id `json:"previousChannelId"`
+	PreviousMapId     _map.Id    `json:"previousMapId"`
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"atlas-guilds/alliance"
 	"atlas-guilds/coordinator"
 	"atlas-guilds/guild"
 	"atlas-guilds/guild/character"
 	"atlas-guilds/guild/member"
+	"atlas-guilds/guild/skill"
 	"atlas-guilds/guild/title"
 	"atlas-guilds/kafka/consumer/invite"
+	"atlas-guilds/purchase"
 	"atlas-guilds/tasks"
 	"atlas-guilds/thread"
 	"atlas-guilds/thread/reply"
@@ -16,8 +19,12 @@ import (
 
 	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
 
+	alliance2 "atlas-guilds/kafka/consumer/alliance"
 	character2 "atlas-guilds/kafka/consumer/character"
 	guild2 "atlas-guilds/kafka/consumer/guild"
+	monster2 "atlas-guilds/kafka/consumer/monster"
+	pq2 "atlas-guilds/kafka/consumer/party_quest"
+	presence2 "atlas-guilds/kafka/consumer/presence"
 
 	thread2 "atlas-guilds/kafka/consumer/thread"
 
@@ -63,7 +70,7 @@ func main() {
 	rc := atlas.Connect(l)
 	coordinator.InitRegistry(rc)
 
-	db := database.Connect(l, database.SetMigrations(guild.Migration, title.Migration, member.Migration, character.Migration, thread.Migration, reply.Migration, outboxlib.Migration))
+	db := database.Connect(l, database.SetMigrations(guild.Migration, title.Migration, member.Migration, skill.Migration, character.Migration, thread.Migration, reply.Migration, alliance.Migration, purchase.Migration, outboxlib.Migration))
 
 	// Boot the outbox drainer: publishes the transactional outbox to Kafka.
 	// Leadership is gated by a postgres advisory lock — replicas are safe.
@@ -90,6 +97,10 @@ func main() {
 	character2.InitConsumers(l)(cmf)(consumerGroupId)
 	invite.InitConsumers(l)(cmf)(consumerGroupId)
 	thread2.InitConsumers(l)(cmf)(consumerGroupId)
+	alliance2.InitConsumers(l)(cmf)(consumerGroupId)
+	monster2.InitConsumers(l)(cmf)(consumerGroupId)
+	pq2.InitConsumers(l)(cmf)(consumerGroupId)
+	presence2.InitConsumers(l)(cmf)(consumerGroupId)
 
 	if err := guild2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
@@ -103,6 +114,18 @@ func main() {
 	if err := thread2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := alliance2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+	if err := monster2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+	if err := pq2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+	if err := presence2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -113,6 +136,7 @@ func main() {
 		SetPort(os.Getenv("REST_PORT")).
 		AddRouteInitializer(guild.InitResource(GetServer())(db)).
 		AddRouteInitializer(thread.InitResource(GetServer())(db)).
+		AddRouteInitializer(alliance.InitResource(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
@@ -0,0 +1,36 @@
+package purchase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func create(db *gorm.DB, tenantId uuid.UUID, transactionId uuid.UUID, characterId uint32, amount int32, kind string, targetId uint32, skillId uint32) (Model, error) {
+	e := &Entity{
+		TenantId:      tenantId,
+		TransactionId: transactionId,
+		CharacterId:   characterId,
+		Amount:        amount,
+		Kind:          kind,
+		TargetId:      targetId,
+		SkillId:       skillId,
+		CreatedAt:     time.Now(),
+	}
+	err := db.Create(e).Error
+	if err != nil {
+		return Model{}, err
+	}
+	return Make(*e)
+}
+
+// deleteById removes the purchase and reports whether it was still pending,
+// so that only one of two racing settlements acts on it.
+func deleteById(db *gorm.DB, id uint32) (bool, error) {
+	res := db.Where("id = ?", id).Delete(&Entity{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,42 @@
+package purchase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
+
+// Entity is a meso purchase whose effect has been granted but whose debit
+// atlas-character has not yet settled.
+type Entity struct {
+	TenantId      uuid.UUID `gorm:"not null"`
+	Id            uint32    `gorm:"primaryKey;autoIncrement;not null"`
+	TransactionId uuid.UUID `gorm:"not null;index"`
+	CharacterId   uint32    `gorm:"not null"`
+	Amount        int32     `gorm:"not null"`
+	Kind          string    `gorm:"not null"`
+	TargetId      uint32    `gorm:"not null"`
+	SkillId       uint32    `gorm:"not null;default:0"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+func (e Entity) TableName() string {
+	return "meso_purchases"
+}
+
+func Make(e Entity) (Model, error) {
+	return Model{
+		id:            e.Id,
+		transactionId: e.TransactionId,
+		characterId:   e.CharacterId,
+		amount:        e.Amount,
+		kind:          e.Kind,
+		targetId:      e.TargetId,
+		skillId:       e.SkillId,
+	}, nil
+}
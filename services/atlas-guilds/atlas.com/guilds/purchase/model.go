@@ -0,0 +1,52 @@
+package purchase
+
+import "github.com/google/uuid"
+
+const (
+	// KindAllianceCreate is the alliance creation fee; TargetId is the alliance.
+	KindAllianceCreate = "ALLIANCE_CREATE"
+	// KindAllianceCapacity is an alliance capacity increase; TargetId is the alliance.
+	KindAllianceCapacity = "ALLIANCE_CAPACITY"
+	// KindGuildSkill is a guild skill activation; TargetId is the guild and
+	// SkillId the skill.
+	KindGuildSkill = "GUILD_SKILL"
+)
+
+type Model struct {
+	id            uint32
+	transactionId uuid.UUID
+	characterId   uint32
+	amount        int32
+	kind          string
+	targetId      uint32
+	skillId       uint32
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) TransactionId() uuid.UUID {
+	return m.transactionId
+}
+
+func (m Model) CharacterId() uint32 {
+	return m.characterId
+}
+
+// Amount is the meso change requested of atlas-character; negative for a debit.
+func (m Model) Amount() int32 {
+	return m.amount
+}
+
+func (m Model) Kind() string {
+	return m.kind
+}
+
+func (m Model) TargetId() uint32 {
+	return m.targetId
+}
+
+func (m Model) SkillId() uint32 {
+	return m.skillId
+}
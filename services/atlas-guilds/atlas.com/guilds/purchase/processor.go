@@ -0,0 +1,59 @@
+package purchase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// Processor tracks meso purchases between the grant and atlas-character's
+// answer to the debit. A purchase is granted in the same transaction that
+// queues its debit; MESO_CHANGED settles it, and a meso error hands it back
+// to its owner to undo.
+type Processor interface {
+	// Record notes a granted purchase whose debit of amount has just been
+	// queued under transactionId.
+	Record(transactionId uuid.UUID, characterId uint32, amount int32, kind string, targetId uint32, skillId uint32) (Model, error)
+	// GetPending returns the purchase a meso event answers, matched on the
+	// transaction, character and requested amount.
+	GetPending(transactionId uuid.UUID, characterId uint32, amount int32) (Model, error)
+	// Settle removes the purchase; false means another settlement got there
+	// first and the caller must do nothing.
+	Settle(id uint32) (bool, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	db  *gorm.DB
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		db:  db,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) Record(transactionId uuid.UUID, characterId uint32, amount int32, kind string, targetId uint32, skillId uint32) (Model, error) {
+	p.l.Debugf("Recording [%s] purchase [%d] by character [%d] pending debit of [%d] in transaction [%s].", kind, targetId, characterId, amount, transactionId)
+	return create(p.db.WithContext(p.ctx), p.t.Id(), transactionId, characterId, amount, kind, targetId, skillId)
+}
+
+func (p *ProcessorImpl) GetPending(transactionId uuid.UUID, characterId uint32, amount int32) (Model, error) {
+	return model.Map(Make)(getPending(transactionId, characterId, amount)(p.db.WithContext(p.ctx)))()
+}
+
+func (p *ProcessorImpl) Settle(id uint32) (bool, error) {
+	return deleteById(p.db.WithContext(p.ctx), id)
+}
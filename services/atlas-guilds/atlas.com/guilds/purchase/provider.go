@@ -0,0 +1,20 @@
+package purchase
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func getPending(transactionId uuid.UUID, characterId uint32, amount int32) database.EntityProvider[Entity] {
+	return func(db *gorm.DB) model.Provider[Entity] {
+		var result Entity
+		err := db.Where("transaction_id = ? AND character_id = ? AND amount = ?", transactionId, characterId, amount).First(&result).Error
+		if err != nil {
+			return model.ErrorProvider[Entity](err)
+		}
+		return model.FixedProvider(result)
+	}
+}
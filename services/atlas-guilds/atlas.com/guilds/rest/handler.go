@@ -34,3 +34,7 @@ func ParseGuildId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http
 func ParseThreadId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http.HandlerFunc {
 	return server.ParseIntId[uint32](l, "threadId", next)
 }
+
+func ParseAllianceId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http.HandlerFunc {
+	return server.ParseIntId[uint32](l, "allianceId", next)
+}
@@ -10,20 +10,22 @@ import (
 
 func Clone(m Model) *ModelBuilder {
 	return &ModelBuilder{
-		id:            m.id,
-		characterId:   m.characterId,
-		inventoryType: m.inventoryType,
-		capacity:      m.capacity,
-		assets:        m.assets,
+		id:             m.id,
+		characterId:    m.characterId,
+		inventoryType:  m.inventoryType,
+		capacity:       m.capacity,
+		assets:         m.assets,
+		slotExpansions: m.slotExpansions,
 	}
 }
 
 type ModelBuilder struct {
-	id            uuid.UUID
-	characterId   uint32
-	inventoryType inventory.Type
-	capacity      uint32
-	assets        []asset.Model
+	id             uuid.UUID
+	characterId    uint32
+	inventoryType  inventory.Type
+	capacity       uint32
+	assets         []asset.Model
+	slotExpansions []SlotExpansion
 }
 
 func NewBuilder(id uuid.UUID, characterId uint32, it inventory.Type, capacity uint32) *ModelBuilder {
@@ -51,12 +53,18 @@ func (b *ModelBuilder) SetAssets(as []asset.Model) *ModelBuilder {
 	return b
 }
 
+func (b *ModelBuilder) SetSlotExpansions(ses []SlotExpansion) *ModelBuilder {
+	b.slotExpansions = ses
+	return b
+}
+
 func (b *ModelBuilder) Build() Model {
 	return Model{
-		id:            b.id,
-		characterId:   b.characterId,
-		inventoryType: b.inventoryType,
-		capacity:      b.capacity,
-		assets:        b.assets,
+		id:             b.id,
+		characterId:    b.characterId,
+		inventoryType:  b.inventoryType,
+		capacity:       b.capacity,
+		assets:         b.assets,
+		slotExpansions: b.slotExpansions,
 	}
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Migration(db *gorm.DB) error {
-	return db.AutoMigrate(&Entity{})
+	return db.AutoMigrate(&Entity{}, &SlotExpansionEntity{})
 }
 
 type Entity struct {
@@ -41,6 +41,10 @@ type ProcessorMock struct {
 	MoveFunc                          func(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, source int16, destination int16) error
 	IncreaseCapacityAndEmitFunc       func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, amount uint32) error
 	IncreaseCapacityFunc              func(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, amount uint32) error
+	EnableSlotExpansionAndEmitFunc    func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error
+	EnableSlotExpansionFunc           func(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error
+	ExpireSlotExpansionAndEmitFunc    func(transactionId uuid.UUID, characterId uint32, position int16) error
+	ExpireSlotExpansionFunc           func(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16) error
 	DropAndEmitFunc                   func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, f field.Model, x int16, y int16, source int16, quantity int16) error
 	DropFunc                          func(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, f field.Model, x int16, y int16, source int16, quantity int16) error
 	RequestReserveAndEmitFunc         func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, expiry time.Duration, reservationRequests []compartment.ReservationRequest) error
@@ -267,6 +271,38 @@ func (m *ProcessorMock) IncreaseCapacity(mb *message.Buffer) func(transactionId
 	}
 }
 
+func (m *ProcessorMock) EnableSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+	if m.EnableSlotExpansionAndEmitFunc != nil {
+		return m.EnableSlotExpansionAndEmitFunc(transactionId, characterId, position, days)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) EnableSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+	if m.EnableSlotExpansionFunc != nil {
+		return m.EnableSlotExpansionFunc(mb)
+	}
+	return func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+		return nil
+	}
+}
+
+func (m *ProcessorMock) ExpireSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16) error {
+	if m.ExpireSlotExpansionAndEmitFunc != nil {
+		return m.ExpireSlotExpansionAndEmitFunc(transactionId, characterId, position)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) ExpireSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16) error {
+	if m.ExpireSlotExpansionFunc != nil {
+		return m.ExpireSlotExpansionFunc(mb)
+	}
+	return func(transactionId uuid.UUID, characterId uint32, position int16) error {
+		return nil
+	}
+}
+
 func (m *ProcessorMock) DropAndEmit(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, f field.Model, x int16, y int16, source int16, quantity int16) error {
 	if m.DropAndEmitFunc != nil {
 		return m.DropAndEmitFunc(transactionId, characterId, inventoryType, f, x, y, source, quantity)
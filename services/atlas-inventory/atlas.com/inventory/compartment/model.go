@@ -4,6 +4,7 @@ import (
 	"atlas-inventory/asset"
 	"errors"
 	"sort"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -16,11 +17,12 @@ import (
 var ErrNoFreeSlots = errors.New("no free slots")
 
 type Model struct {
-	id            uuid.UUID
-	characterId   uint32
-	inventoryType inventory.Type
-	capacity      uint32
-	assets        []asset.Model
+	id             uuid.UUID
+	characterId    uint32
+	inventoryType  inventory.Type
+	capacity       uint32
+	assets         []asset.Model
+	slotExpansions []SlotExpansion
 }
 
 func (m Model) Id() uuid.UUID {
@@ -43,6 +45,26 @@ func (m Model) CharacterId() uint32 {
 	return m.characterId
 }
 
+func (m Model) SlotExpansions() []SlotExpansion {
+	return m.slotExpansions
+}
+
+// SlotExpansion returns the expansion unlocking the given slot, if any.
+func (m Model) SlotExpansion(slot int16) (SlotExpansion, bool) {
+	for _, se := range m.slotExpansions {
+		if se.Slot() == slot {
+			return se, true
+		}
+	}
+	return SlotExpansion{}, false
+}
+
+// SlotUnlocked reports whether the given expansion slot is usable at the given time.
+func (m Model) SlotUnlocked(slot int16, now time.Time) bool {
+	se, ok := m.SlotExpansion(slot)
+	return ok && se.Active(now)
+}
+
 func (m Model) NextFreeSlot() (int16, error) {
 	if len(m.Assets()) == 0 {
 		return 1, nil
@@ -60,6 +60,10 @@ type Processor interface {
 	Move(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, source int16, destination int16) error
 	IncreaseCapacityAndEmit(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, amount uint32) error
 	IncreaseCapacity(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, amount uint32) error
+	EnableSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error
+	EnableSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error
+	ExpireSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16) error
+	ExpireSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16) error
 	DropAndEmit(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, f field.Model, x int16, y int16, source int16, quantity int16) error
 	Drop(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, inventoryType inventory.Type, f field.Model, x int16, y int16, source int16, quantity int16) error
 	// RequestReserveAndEmit holds `expiry` worth of claim on the requested slots.
@@ -205,7 +209,7 @@ func (p *ProcessorImpl) ByIdProvider(id uuid.UUID) model.Provider[Model] {
 	if err != nil {
 		return model.ErrorProvider[Model](err)
 	}
-	return model.Map(p.DecorateAsset)(model.FixedProvider(cs))
+	return model.Map(p.DecorateSlotExpansions)(model.Map(p.DecorateAsset)(model.FixedProvider(cs)))
 }
 
 func (p *ProcessorImpl) GetById(id uuid.UUID) (Model, error) {
@@ -217,7 +221,7 @@ func (p *ProcessorImpl) ByCharacterIdProvider(characterId uint32) model.Provider
 	if err != nil {
 		return model.ErrorProvider[[]Model](err)
 	}
-	return model.SliceMap(p.DecorateAsset)(model.FixedProvider(cs))(model.ParallelMap())
+	return model.SliceMap(p.DecorateSlotExpansions)(model.SliceMap(p.DecorateAsset)(model.FixedProvider(cs))(model.ParallelMap()))(model.ParallelMap())
 }
 
 func (p *ProcessorImpl) GetByCharacterId(characterId uint32) ([]Model, error) {
@@ -230,7 +234,7 @@ func (p *ProcessorImpl) ByCharacterAndTypeProvider(characterId uint32) func(inve
 		if err != nil {
 			return model.ErrorProvider[Model](err)
 		}
-		return model.Map(p.DecorateAsset)(model.FixedProvider(cs))
+		return model.Map(p.DecorateSlotExpansions)(model.Map(p.DecorateAsset)(model.FixedProvider(cs)))
 	}
 }
 
@@ -276,6 +280,10 @@ func (p *ProcessorImpl) DeleteByModel(mb *message.Buffer) func(transactionId uui
 			if err != nil {
 				return err
 			}
+			err = deleteSlotExpansionsByCompartmentId(tx, c.Id())
+			if err != nil {
+				return err
+			}
 			err = deleteById(tx, c.Id())
 			if err != nil {
 				return err
@@ -333,6 +341,11 @@ func (p *ProcessorImpl) EquipItem(mb *message.Buffer) func(transactionId uuid.UU
 				p.l.WithError(err).Errorf("Unable to determine actual destination for item being equipped.")
 				return err
 			}
+			actualDestination, err = resolveExpansionDestination(c, destination, actualDestination, time.Now())
+			if err != nil {
+				p.l.WithError(err).Errorf("Character [%d] cannot equip item [%d] into slot [%d].", characterId, a1.TemplateId(), destination)
+				return err
+			}
 			p.l.Debugf("Character [%d] moving asset from [%d] to [%d] if present.", characterId, actualDestination, temporarySlot())
 			err = p.assetProcessor.WithTransaction(tx).UpdateSlot(mb)(transactionId, characterId, c.Id(), assetProvider(actualDestination), model.FixedProvider(temporarySlot()))
 			if err != nil {
@@ -0,0 +1,154 @@
+package compartment
+
+import (
+	"atlas-inventory/kafka/message"
+	"atlas-inventory/kafka/message/compartment"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory/slot"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+)
+
+// DecorateSlotExpansions attaches the unlocked equip slot expansions to an
+// equip compartment. Other compartment types have no expansions.
+func (p *ProcessorImpl) DecorateSlotExpansions(m Model) (Model, error) {
+	if m.Type() != inventory.TypeValueEquip {
+		return m, nil
+	}
+	ses, err := model.SliceMap(MakeSlotExpansion)(getSlotExpansionsByCompartmentId(m.Id())(p.db.WithContext(p.ctx)))(model.ParallelMap())()
+	if err != nil {
+		return Model{}, err
+	}
+	return Clone(m).SetSlotExpansions(ses).Build(), nil
+}
+
+// resolveExpansionDestination lets an item be worn in an expansion slot when
+// it belongs to the expansion's base slot and the character has the
+// expansion unlocked. Requests for any other slot keep the item's natural
+// destination.
+func resolveExpansionDestination(c Model, requested int16, natural int16, now time.Time) (int16, error) {
+	e, ok := slot.GetExpansionByPosition(slot.Position(requested))
+	if !ok || int16(e.Base) != natural {
+		return natural, nil
+	}
+	if !c.SlotUnlocked(requested, now) {
+		return 0, ErrSlotLocked
+	}
+	return requested, nil
+}
+
+func (p *ProcessorImpl) EnableSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
+			return p.WithTransaction(tx).EnableSlotExpansion(buf)(transactionId, characterId, position, days)
+		})
+	})
+}
+
+// EnableSlotExpansion unlocks an equip slot expansion for the given number of
+// days, extending any time remaining on an existing expansion. Zero days
+// unlocks the slot permanently.
+func (p *ProcessorImpl) EnableSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+	return func(transactionId uuid.UUID, characterId uint32, position int16, days uint32) error {
+		p.l.Debugf("Character [%d] attempting to enable equip slot expansion [%d] for [%d] days.", characterId, position, days)
+		if _, ok := slot.GetExpansionByPosition(slot.Position(position)); !ok {
+			p.l.Errorf("Character [%d] requested expansion of slot [%d], which is not an expansion slot.", characterId, position)
+			return ErrNotExpansionSlot
+		}
+
+		invLock := LockRegistry().Get(p.t, characterId, inventory.TypeValueEquip)
+		invLock.Lock()
+		defer invLock.Unlock()
+
+		var se SlotExpansion
+		txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+			c, err := p.WithTransaction(tx).GetByCharacterAndType(characterId)(inventory.TypeValueEquip)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			expiration := NewSlotExpansion(position, now).Extend(now, days)
+			if existing, ok := c.SlotExpansion(position); ok {
+				expiration = existing.Extend(now, days)
+			}
+			se, err = upsertSlotExpansion(tx, p.t.Id(), c.Id(), position, expiration)
+			if err != nil {
+				return err
+			}
+			return mb.Put(compartment.EnvEventTopicStatus, SlotExpansionEnabledEventStatusProvider(transactionId, c.Id(), characterId, position, se.Expiration()))
+		})
+		if txErr != nil {
+			p.l.WithError(txErr).Errorf("Character [%d] unable to enable equip slot expansion [%d].", characterId, position)
+			return txErr
+		}
+		p.l.Debugf("Character [%d] enabled equip slot expansion [%d] until [%s].", characterId, position, se.Expiration())
+		return nil
+	}
+}
+
+func (p *ProcessorImpl) ExpireSlotExpansionAndEmit(transactionId uuid.UUID, characterId uint32, position int16) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
+			return p.WithTransaction(tx).ExpireSlotExpansion(buf)(transactionId, characterId, position)
+		})
+	})
+}
+
+// ExpireSlotExpansion locks an equip slot expansion whose time has run out.
+// An item worn in the slot is moved back into the equip inventory when there
+// is room. An expansion renewed after the expiration scan is left alone.
+func (p *ProcessorImpl) ExpireSlotExpansion(mb *message.Buffer) func(transactionId uuid.UUID, characterId uint32, position int16) error {
+	return func(transactionId uuid.UUID, characterId uint32, position int16) error {
+		p.l.Debugf("Character [%d] attempting to expire equip slot expansion [%d].", characterId, position)
+		invLock := LockRegistry().Get(p.t, characterId, inventory.TypeValueEquip)
+		invLock.Lock()
+		defer invLock.Unlock()
+
+		txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+			c, err := p.WithTransaction(tx).GetByCharacterAndType(characterId)(inventory.TypeValueEquip)
+			if err != nil {
+				return err
+			}
+			se, ok := c.SlotExpansion(position)
+			if !ok {
+				p.l.Debugf("Character [%d] has no equip slot expansion [%d] to expire.", characterId, position)
+				return nil
+			}
+			if se.Active(time.Now()) {
+				p.l.Debugf("Character [%d] equip slot expansion [%d] was renewed until [%s]. Not expiring.", characterId, position, se.Expiration())
+				return nil
+			}
+			if err = deleteSlotExpansion(tx, c.Id(), position); err != nil {
+				return err
+			}
+
+			for _, a := range c.Assets() {
+				if a.Slot() != position {
+					continue
+				}
+				nfs, err := c.NextFreeSlot()
+				if err != nil {
+					p.l.WithError(err).Warnf("Character [%d] has no free slot to unequip asset [%d] from expired slot [%d].", characterId, a.Id(), position)
+					break
+				}
+				err = p.assetProcessor.WithTransaction(tx).UpdateSlot(mb)(transactionId, characterId, c.Id(), model.FixedProvider(a), model.FixedProvider(nfs))
+				if err != nil {
+					return err
+				}
+				break
+			}
+			return mb.Put(compartment.EnvEventTopicStatus, SlotExpansionExpiredEventStatusProvider(transactionId, c.Id(), characterId, position))
+		})
+		if txErr != nil {
+			p.l.WithError(txErr).Errorf("Character [%d] unable to expire equip slot expansion [%d].", characterId, position)
+			return txErr
+		}
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package compartment_test
+
+import (
+	"atlas-inventory/asset"
+	"atlas-inventory/compartment"
+	"atlas-inventory/kafka/message"
+	compartmentMsg "atlas-inventory/kafka/message/compartment"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/inventory"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const pendantExpansionSlot = int16(-59)
+
+func slotExpansionFixture(t *testing.T, characterId uint32) (*gorm.DB, context.Context, compartment.Processor, asset.Processor, compartment.Model) {
+	t.Helper()
+	l := testLogger()
+	ctx := tenant.WithContext(context.Background(), testTenant())
+	db := testDatabase(t, l)
+	ap := asset.NewProcessor(l, ctx, db)
+	cp := compartment.NewProcessor(l, ctx, db).WithAssetProcessor(ap)
+	c, err := cp.Create(message.NewBuffer())(uuid.New(), characterId, inventory.TypeValueEquip, 24)
+	if err != nil {
+		t.Fatalf("Failed to create equip compartment: %v", err)
+	}
+	return db, ctx, cp, ap, c
+}
+
+func equipCompartment(t *testing.T, cp compartment.Processor, characterId uint32) compartment.Model {
+	t.Helper()
+	c, err := cp.GetByCharacterAndType(characterId)(inventory.TypeValueEquip)
+	if err != nil {
+		t.Fatalf("Failed to reload equip compartment: %v", err)
+	}
+	return c
+}
+
+func countCompartmentEvents(mb *message.Buffer, eventType string) int {
+	var count int
+	for _, msg := range mb.GetAll()[compartmentMsg.EnvEventTopicStatus] {
+		var ev compartmentMsg.StatusEvent[json.RawMessage]
+		if err := json.Unmarshal(msg.Value, &ev); err != nil {
+			continue
+		}
+		if ev.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+// TestEnableSlotExpansionExtendsRemainingTime verifies a first purchase
+// unlocks the slot for the purchased days, and a repeat purchase adds to the
+// time still remaining rather than restarting it.
+func TestEnableSlotExpansionExtendsRemainingTime(t *testing.T) {
+	characterId := uint32(800)
+	_, _, cp, _, _ := slotExpansionFixture(t, characterId)
+
+	mb := message.NewBuffer()
+	if err := cp.EnableSlotExpansion(mb)(uuid.New(), characterId, pendantExpansionSlot, 7); err != nil {
+		t.Fatalf("EnableSlotExpansion: %v", err)
+	}
+	se, ok := equipCompartment(t, cp, characterId).SlotExpansion(pendantExpansionSlot)
+	if !ok {
+		t.Fatalf("expected slot expansion to be persisted")
+	}
+	first := se.Expiration()
+	if d := time.Until(first); d < 6*24*time.Hour || d > 7*24*time.Hour {
+		t.Fatalf("expiration = %v, want ~7 days from now", first)
+	}
+
+	if err := cp.EnableSlotExpansion(mb)(uuid.New(), characterId, pendantExpansionSlot, 7); err != nil {
+		t.Fatalf("EnableSlotExpansion (repeat): %v", err)
+	}
+	c := equipCompartment(t, cp, characterId)
+	se, _ = c.SlotExpansion(pendantExpansionSlot)
+	if got := se.Expiration().Sub(first); got < 7*24*time.Hour-time.Second || got > 7*24*time.Hour+time.Second {
+		t.Fatalf("repeat purchase added %v, want 7 days", got)
+	}
+	if !c.SlotUnlocked(pendantExpansionSlot, time.Now()) {
+		t.Fatalf("expected slot to be unlocked")
+	}
+	if n := countCompartmentEvents(mb, compartmentMsg.StatusEventTypeSlotExpansionEnabled); n != 2 {
+		t.Fatalf("SLOT_EXPANSION_ENABLED events = %d, want 2", n)
+	}
+}
+
+// TestEnableSlotExpansionRejectsUngatedSlot verifies only slots registered as
+// expansions can be purchased.
+func TestEnableSlotExpansionRejectsUngatedSlot(t *testing.T) {
+	characterId := uint32(801)
+	_, _, cp, _, _ := slotExpansionFixture(t, characterId)
+
+	mb := message.NewBuffer()
+	err := cp.EnableSlotExpansion(mb)(uuid.New(), characterId, -17, 7)
+	if !errors.Is(err, compartment.ErrNotExpansionSlot) {
+		t.Fatalf("err = %v, want ErrNotExpansionSlot", err)
+	}
+	if len(equipCompartment(t, cp, characterId).SlotExpansions()) != 0 {
+		t.Fatalf("expected no slot expansions")
+	}
+}
+
+// TestExpireSlotExpansionUnequipsItem verifies an expired expansion is
+// removed and the item worn in the slot is returned to the inventory.
+func TestExpireSlotExpansionUnequipsItem(t *testing.T) {
+	characterId := uint32(802)
+	db, ctx, cp, ap, c := slotExpansionFixture(t, characterId)
+
+	mb := message.NewBuffer()
+	if err := cp.EnableSlotExpansion(mb)(uuid.New(), characterId, pendantExpansionSlot, 1); err != nil {
+		t.Fatalf("EnableSlotExpansion: %v", err)
+	}
+	pendant := asset.NewBuilder(c.Id(), 1122000).SetSlot(pendantExpansionSlot).Build()
+	if _, err := ap.CreateFromModel(mb)(uuid.New(), characterId, pendant); err != nil {
+		t.Fatalf("Failed to create pendant: %v", err)
+	}
+
+	// Not yet expired: the expiration scan raced a renewal, so nothing changes.
+	mb = message.NewBuffer()
+	if err := cp.ExpireSlotExpansion(mb)(uuid.New(), characterId, pendantExpansionSlot); err != nil {
+		t.Fatalf("ExpireSlotExpansion (active): %v", err)
+	}
+	if _, ok := equipCompartment(t, cp, characterId).SlotExpansion(pendantExpansionSlot); !ok {
+		t.Fatalf("active expansion should not be expired")
+	}
+	if n := countCompartmentEvents(mb, compartmentMsg.StatusEventTypeSlotExpansionExpired); n != 0 {
+		t.Fatalf("SLOT_EXPANSION_EXPIRED events = %d, want 0", n)
+	}
+
+	// Run the clock past the purchased day.
+	err := db.WithContext(ctx).Model(&compartment.SlotExpansionEntity{}).
+		Where("compartment_id = ? AND slot = ?", c.Id(), pendantExpansionSlot).
+		Update("expiration", time.Now().Add(-time.Minute)).Error
+	if err != nil {
+		t.Fatalf("Failed to back-date slot expansion: %v", err)
+	}
+
+	mb = message.NewBuffer()
+	if err := cp.ExpireSlotExpansion(mb)(uuid.New(), characterId, pendantExpansionSlot); err != nil {
+		t.Fatalf("ExpireSlotExpansion: %v", err)
+	}
+	reloaded := equipCompartment(t, cp, characterId)
+	if _, ok := reloaded.SlotExpansion(pendantExpansionSlot); ok {
+		t.Fatalf("expired expansion should be removed")
+	}
+	if reloaded.SlotUnlocked(pendantExpansionSlot, time.Now()) {
+		t.Fatalf("slot should be locked after expiry")
+	}
+	for _, a := range reloaded.Assets() {
+		if a.TemplateId() == 1122000 && a.Slot() <= 0 {
+			t.Fatalf("pendant still equipped at slot %d", a.Slot())
+		}
+	}
+	if n := countCompartmentEvents(mb, compartmentMsg.StatusEventTypeSlotExpansionExpired); n != 1 {
+		t.Fatalf("SLOT_EXPANSION_EXPIRED events = %d, want 1", n)
+	}
+}
@@ -2,6 +2,7 @@ package compartment
 
 import (
 	"atlas-inventory/kafka/message/compartment"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
@@ -171,3 +172,32 @@ func CreationFailedEventStatusProvider(transactionId uuid.UUID, id uuid.UUID, ch
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func SlotExpansionEnabledEventStatusProvider(transactionId uuid.UUID, id uuid.UUID, characterId uint32, slot int16, expiration time.Time) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &compartment.StatusEvent[compartment.SlotExpansionEnabledEventBody]{
+		TransactionId: transactionId,
+		CharacterId:   characterId,
+		CompartmentId: id,
+		Type:          compartment.StatusEventTypeSlotExpansionEnabled,
+		Body: compartment.SlotExpansionEnabledEventBody{
+			Slot:       slot,
+			Expiration: expiration,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func SlotExpansionExpiredEventStatusProvider(transactionId uuid.UUID, id uuid.UUID, characterId uint32, slot int16) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &compartment.StatusEvent[compartment.SlotExpansionExpiredEventBody]{
+		TransactionId: transactionId,
+		CharacterId:   characterId,
+		CompartmentId: id,
+		Type:          compartment.StatusEventTypeSlotExpansionExpired,
+		Body: compartment.SlotExpansionExpiredEventBody{
+			Slot: slot,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -3,6 +3,7 @@ package compartment
 import (
 	"atlas-inventory/asset"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jtumidanski/api2go/jsonapi"
@@ -12,10 +13,18 @@ import (
 )
 
 type RestModel struct {
-	Id            uuid.UUID         `json:"-"`
-	InventoryType inventory.Type    `json:"type"`
-	Capacity      uint32            `json:"capacity"`
-	Assets        []asset.RestModel `json:"-"`
+	Id             uuid.UUID                `json:"-"`
+	InventoryType  inventory.Type           `json:"type"`
+	Capacity       uint32                   `json:"capacity"`
+	SlotExpansions []SlotExpansionRestModel `json:"slotExpansions,omitempty"`
+	Assets         []asset.RestModel        `json:"-"`
+}
+
+// SlotExpansionRestModel is an unlocked equip slot expansion. A zero
+// expiration is permanent.
+type SlotExpansionRestModel struct {
+	Slot       int16     `json:"slot"`
+	Expiration time.Time `json:"expiration"`
 }
 
 func (r RestModel) GetName() string {
@@ -106,11 +115,17 @@ func Transform(m Model) (RestModel, error) {
 		return RestModel{}, err
 	}
 
+	var ses []SlotExpansionRestModel
+	for _, se := range m.slotExpansions {
+		ses = append(ses, SlotExpansionRestModel{Slot: se.Slot(), Expiration: se.Expiration()})
+	}
+
 	return RestModel{
-		Id:            m.id,
-		InventoryType: m.inventoryType,
-		Capacity:      m.capacity,
-		Assets:        as,
+		Id:             m.id,
+		InventoryType:  m.inventoryType,
+		Capacity:       m.capacity,
+		SlotExpansions: ses,
+		Assets:         as,
 	}, nil
 }
 
@@ -120,10 +135,16 @@ func Extract(rm RestModel) (Model, error) {
 		return Model{}, nil
 	}
 
+	ses := make([]SlotExpansion, 0, len(rm.SlotExpansions))
+	for _, se := range rm.SlotExpansions {
+		ses = append(ses, NewSlotExpansion(se.Slot, se.Expiration))
+	}
+
 	return Model{
-		id:            rm.Id,
-		inventoryType: rm.InventoryType,
-		capacity:      rm.Capacity,
-		assets:        as,
+		id:             rm.Id,
+		inventoryType:  rm.InventoryType,
+		capacity:       rm.Capacity,
+		assets:         as,
+		slotExpansions: ses,
 	}, nil
 }
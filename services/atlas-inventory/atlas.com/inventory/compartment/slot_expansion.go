@@ -0,0 +1,120 @@
+package compartment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// ErrSlotLocked is returned when an item is equipped into an expansion slot
+// the character has not (or no longer) unlocked.
+var ErrSlotLocked = errors.New("equip slot expansion not enabled")
+
+// ErrNotExpansionSlot is returned when an expansion is requested for a slot
+// that is not gated behind an equip slot expansion.
+var ErrNotExpansionSlot = errors.New("slot is not an expansion slot")
+
+// SlotExpansionEntity persists an unlocked equip slot expansion against the
+// character's equip compartment. A zero Expiration is permanent.
+type SlotExpansionEntity struct {
+	TenantId      uuid.UUID `gorm:"not null;uniqueIndex:idx_slot_expansion_tenant_compartment_slot"`
+	Id            uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CompartmentId uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_slot_expansion_tenant_compartment_slot"`
+	Slot          int16     `gorm:"not null;uniqueIndex:idx_slot_expansion_tenant_compartment_slot"`
+	Expiration    time.Time `gorm:"not null"`
+}
+
+func (e SlotExpansionEntity) TableName() string {
+	return "compartment_slot_expansions"
+}
+
+func (e *SlotExpansionEntity) BeforeCreate(_ *gorm.DB) (err error) {
+	if e.Id == uuid.Nil {
+		e.Id = uuid.New()
+	}
+	return
+}
+
+type SlotExpansion struct {
+	slot       int16
+	expiration time.Time
+}
+
+func NewSlotExpansion(slot int16, expiration time.Time) SlotExpansion {
+	return SlotExpansion{slot: slot, expiration: expiration}
+}
+
+func (s SlotExpansion) Slot() int16 {
+	return s.slot
+}
+
+func (s SlotExpansion) Expiration() time.Time {
+	return s.expiration
+}
+
+func (s SlotExpansion) Permanent() bool {
+	return s.expiration.IsZero()
+}
+
+// Active reports whether the expansion still unlocks its slot at the given time.
+func (s SlotExpansion) Active(now time.Time) bool {
+	return s.Permanent() || now.Before(s.expiration)
+}
+
+// Extend returns the expiration after adding the given number of days. Days
+// of zero grants a permanent expansion, and extending a permanent expansion
+// keeps it permanent. Time is added to the remaining duration when the
+// expansion is still active, otherwise from now.
+func (s SlotExpansion) Extend(now time.Time, days uint32) time.Time {
+	if days == 0 || s.Permanent() {
+		return time.Time{}
+	}
+	from := now
+	if s.expiration.After(now) {
+		from = s.expiration
+	}
+	return from.Add(time.Duration(days) * 24 * time.Hour)
+}
+
+func MakeSlotExpansion(e SlotExpansionEntity) (SlotExpansion, error) {
+	return NewSlotExpansion(e.Slot, e.Expiration), nil
+}
+
+func getSlotExpansionsByCompartmentId(compartmentId uuid.UUID) database.EntityProvider[[]SlotExpansionEntity] {
+	return func(db *gorm.DB) model.Provider[[]SlotExpansionEntity] {
+		return database.SliceQuery[SlotExpansionEntity](db.Where("compartment_id = ?", compartmentId), &SlotExpansionEntity{})
+	}
+}
+
+func upsertSlotExpansion(db *gorm.DB, tenantId uuid.UUID, compartmentId uuid.UUID, slot int16, expiration time.Time) (SlotExpansion, error) {
+	var e SlotExpansionEntity
+	err := db.Where("compartment_id = ? AND slot = ?", compartmentId, slot).First(&e).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return SlotExpansion{}, err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		e = SlotExpansionEntity{
+			TenantId:      tenantId,
+			CompartmentId: compartmentId,
+			Slot:          slot,
+		}
+	}
+	e.Expiration = expiration
+	if err = db.Save(&e).Error; err != nil {
+		return SlotExpansion{}, err
+	}
+	return MakeSlotExpansion(e)
+}
+
+func deleteSlotExpansion(db *gorm.DB, compartmentId uuid.UUID, slot int16) error {
+	return db.Where("compartment_id = ? AND slot = ?", compartmentId, slot).Delete(&SlotExpansionEntity{}).Error
+}
+
+func deleteSlotExpansionsByCompartmentId(db *gorm.DB, compartmentId uuid.UUID) error {
+	return db.Where("compartment_id = ?", compartmentId).Delete(&SlotExpansionEntity{}).Error
+}
@@ -63,6 +63,12 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleIncreaseCapacityCommand(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleEnableSlotExpansionCommand(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleExpireSlotExpansionCommand(db)))); err != nil {
+				return err
+			}
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleCreateAssetCommand(db)))); err != nil {
 				return err
 			}
@@ -146,6 +152,24 @@ func handleIncreaseCapacityCommand(db *gorm.DB) message.Handler[compartment2.Com
 	}
 }
 
+func handleEnableSlotExpansionCommand(db *gorm.DB) message.Handler[compartment2.Command[compartment2.EnableSlotExpansionCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c compartment2.Command[compartment2.EnableSlotExpansionCommandBody]) {
+		if c.Type != compartment2.CommandEnableSlotExpansion {
+			return
+		}
+		_ = compartment.NewProcessor(l, ctx, db).EnableSlotExpansionAndEmit(c.TransactionId, c.CharacterId, c.Body.Slot, c.Body.Days)
+	}
+}
+
+func handleExpireSlotExpansionCommand(db *gorm.DB) message.Handler[compartment2.Command[compartment2.ExpireSlotExpansionCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c compartment2.Command[compartment2.ExpireSlotExpansionCommandBody]) {
+		if c.Type != compartment2.CommandExpireSlotExpansion {
+			return
+		}
+		_ = compartment.NewProcessor(l, ctx, db).ExpireSlotExpansionAndEmit(c.TransactionId, c.CharacterId, c.Body.Slot)
+	}
+}
+
 func handleDropItemCommand(db *gorm.DB) message.Handler[compartment2.Command[compartment2.DropCommandBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, c compartment2.Command[compartment2.DropCommandBody]) {
 		if c.Type != compartment2.CommandDrop {
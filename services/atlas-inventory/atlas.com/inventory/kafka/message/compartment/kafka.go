@@ -12,30 +12,32 @@ import (
 )
 
 const (
-	EnvCommandTopic           = "COMMAND_TOPIC_COMPARTMENT"
-	CommandEquip              = "EQUIP"
-	CommandUnequip            = "UNEQUIP"
-	CommandMove               = "MOVE"
-	CommandDrop               = "DROP"
-	CommandRequestReserve     = "REQUEST_RESERVE"
-	CommandConsume            = "CONSUME"
-	CommandDestroy            = "DESTROY"
-	CommandCancelReservation  = "CANCEL_RESERVATION"
-	CommandIncreaseCapacity   = "INCREASE_CAPACITY"
-	CommandCreateAsset        = "CREATE_ASSET"
-	CommandRecharge           = "RECHARGE"
-	CommandMerge              = "MERGE"
-	CommandSort               = "SORT"
-	CommandAccept             = "ACCEPT"
-	CommandRelease            = "RELEASE"
-	CommandExpire             = "EXPIRE"
-	CommandModifyEquipment    = "MODIFY_EQUIPMENT"
-	CommandChangeTemplate     = "CHANGE_TEMPLATE"
-	CommandSetOwner           = "SET_OWNER"
-	CommandApplyLock          = "APPLY_LOCK"
-	CommandApplyKarma         = "APPLY_KARMA"
-	CommandExtendExpiration   = "EXTEND_EXPIRATION"
-	CommandResetPetExpiration = "RESET_PET_EXPIRATION"
+	EnvCommandTopic            = "COMMAND_TOPIC_COMPARTMENT"
+	CommandEquip               = "EQUIP"
+	CommandUnequip             = "UNEQUIP"
+	CommandMove                = "MOVE"
+	CommandDrop                = "DROP"
+	CommandRequestReserve      = "REQUEST_RESERVE"
+	CommandConsume             = "CONSUME"
+	CommandDestroy             = "DESTROY"
+	CommandCancelReservation   = "CANCEL_RESERVATION"
+	CommandIncreaseCapacity    = "INCREASE_CAPACITY"
+	CommandCreateAsset         = "CREATE_ASSET"
+	CommandRecharge            = "RECHARGE"
+	CommandMerge               = "MERGE"
+	CommandSort                = "SORT"
+	CommandAccept              = "ACCEPT"
+	CommandRelease             = "RELEASE"
+	CommandExpire              = "EXPIRE"
+	CommandModifyEquipment     = "MODIFY_EQUIPMENT"
+	CommandChangeTemplate      = "CHANGE_TEMPLATE"
+	CommandSetOwner            = "SET_OWNER"
+	CommandApplyLock           = "APPLY_LOCK"
+	CommandApplyKarma          = "APPLY_KARMA"
+	CommandExtendExpiration    = "EXTEND_EXPIRATION"
+	CommandResetPetExpiration  = "RESET_PET_EXPIRATION"
+	CommandEnableSlotExpansion = "ENABLE_SLOT_EXPANSION"
+	CommandExpireSlotExpansion = "EXPIRE_SLOT_EXPANSION"
 )
 
 type Command[E any] struct {
@@ -106,6 +108,19 @@ type IncreaseCapacityCommandBody struct {
 	Amount uint32 `json:"amount"`
 }
 
+// EnableSlotExpansionCommandBody unlocks an equip slot expansion for the
+// given number of days. Zero days unlocks it permanently.
+type EnableSlotExpansionCommandBody struct {
+	Slot int16  `json:"slot"`
+	Days uint32 `json:"days"`
+}
+
+// ExpireSlotExpansionCommandBody locks an equip slot expansion whose
+// expiration has passed.
+type ExpireSlotExpansionCommandBody struct {
+	Slot int16 `json:"slot"`
+}
+
 type CreateAssetCommandBody struct {
 	TemplateId      uint32    `json:"templateId"`
 	Quantity        uint32    `json:"quantity"`
@@ -252,6 +267,8 @@ const (
 	StatusEventTypeReleased             = "RELEASED"
 	StatusEventTypeCreationFailed       = "CREATION_FAILED"
 	StatusEventTypeError                = "ERROR"
+	StatusEventTypeSlotExpansionEnabled = "SLOT_EXPANSION_ENABLED"
+	StatusEventTypeSlotExpansionExpired = "SLOT_EXPANSION_EXPIRED"
 
 	AcceptCommandFailed  = "ACCEPT_COMMAND_FAILED"
 	ReleaseCommandFailed = "RELEASE_COMMAND_FAILED"
@@ -322,3 +339,14 @@ type CreationFailedStatusEventBody struct {
 	ErrorCode string `json:"errorCode"`
 	Message   string `json:"message"`
 }
+
+// SlotExpansionEnabledEventBody reports an unlocked equip slot expansion. A
+// zero expiration is permanent.
+type SlotExpansionEnabledEventBody struct {
+	Slot       int16     `json:"slot"`
+	Expiration time.Time `json:"expiration"`
+}
+
+type SlotExpansionExpiredEventBody struct {
+	Slot int16 `json:"slot"`
+}
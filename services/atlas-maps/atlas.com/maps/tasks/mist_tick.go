@@ -133,6 +133,9 @@ type applyDiseaseBody struct {
 	// milliseconds — contract owner: atlas-buffs kafka/message/character/kafka.go (task-190)
 	Duration int32        `json:"duration"`
 	Changes  []statChange `json:"changes"`
+	// Source is always MAP: a mist disease belongs to the field instance,
+	// so atlas-buffs drops it on a channel change, cash shop or relog.
+	Source string `json:"source"`
 }
 
 type statChange struct {
@@ -166,6 +169,7 @@ func applyDiseaseCommandProvider(m mist.Mist, characterId uint32) model.Provider
 			// seconds-valued emitter. (task-190 FR-1.2 / FR-1.4)
 			Duration: int32(m.DiseaseDuration().Milliseconds()),
 			Changes:  []statChange{{Type: m.Disease(), Amount: m.DiseaseValue()}},
+			Source:   "MAP",
 		},
 	}
 	return kafkaProducer.SingleMessageProvider(key, value)
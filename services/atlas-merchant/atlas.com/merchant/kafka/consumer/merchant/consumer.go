@@ -265,7 +265,7 @@ func handlePurchaseBundleCommand(db *gorm.DB) message.Handler[merchant2.Command[
 		}
 
 		p := shop.NewProcessor(l, ctx, db)
-		_, err = p.PurchaseBundleAndEmit(e.CharacterId, shopId, e.Body.ListingIndex, e.Body.BundleCount, e.WorldId)
+		_, err = p.PurchaseBundleAndEmit(e.CharacterId, e.Body.BuyerName, shopId, e.Body.ListingIndex, e.Body.BundleCount, e.WorldId)
 		if err != nil {
 			kp := producer.ProviderImpl(l)(ctx)
 			reason := "unavailable"
@@ -0,0 +1,59 @@
+package mts
+
+import (
+	consumer2 "atlas-merchant/kafka/consumer"
+	"atlas-merchant/kafka/message/mts"
+	"atlas-merchant/pricehistory"
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("mts_status")(mts.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			t, _ := topic.EnvProvider(l)(mts.EnvStatusEventTopic)()
+			_, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleListingSold(db))))
+			return err
+		}
+	}
+}
+
+// handleListingSold records a settled MTS sale in the price index. atlas-mts
+// re-emits LISTING_SOLD when a settle is replayed; the listing id is the
+// idempotency key, so a redelivery is a no-op.
+func handleListingSold(db *gorm.DB) message.Handler[mts.StatusEvent[mts.StatusEventListingSoldBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e mts.StatusEvent[mts.StatusEventListingSoldBody]) {
+		if e.Type != mts.StatusEventTypeListingSold {
+			return
+		}
+
+		err := pricehistory.NewProcessor(l, ctx, db).RecordSale(pricehistory.Sale{
+			WorldId:     world.Id(e.Body.WorldId),
+			ItemId:      e.Body.ItemId,
+			Source:      pricehistory.SourceMts,
+			ReferenceId: e.Body.ListingId,
+			Quantity:    e.Body.Quantity,
+			TotalPrice:  uint64(e.Body.Price),
+		})
+		if err != nil {
+			l.WithError(err).Errorf("Unable to record MTS sale of listing [%s] in the price index.", e.Body.ListingId)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package mts
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	EnvStatusEventTopic = "EVENT_TOPIC_MTS_STATUS"
+
+	StatusEventTypeListingSold = "LISTING_SOLD"
+)
+
+// StatusEvent mirrors atlas-mts's high-level status envelope. The merchant
+// only consumes the subset needed to feed the price index.
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+// StatusEventListingSoldBody reports a settled MTS sale. Price is the settled
+// base price in NX for the whole stack of Quantity items.
+type StatusEventListingSoldBody struct {
+	WorldId   byte      `json:"worldId"`
+	ListingId uuid.UUID `json:"listingId"`
+	SellerId  uint32    `json:"sellerId"`
+	BuyerId   uint32    `json:"buyerId"`
+	ItemId    uint32    `json:"itemId"`
+	Price     uint32    `json:"price"`
+	Quantity  uint32    `json:"quantity"`
+}
@@ -0,0 +1,30 @@
+package note
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic   = "COMMAND_TOPIC_NOTE"
+	CommandTypeCreate = "CREATE"
+)
+
+// Command mirrors atlas-notes' note command envelope. CharacterId is the
+// receiver; WorldId/ChannelId are unused by the CREATE handler.
+type Command[E any] struct {
+	TransactionId uuid.UUID  `json:"transactionId,omitempty"`
+	WorldId       world.Id   `json:"worldId"`
+	ChannelId     channel.Id `json:"channelId"`
+	CharacterId   uint32     `json:"characterId"`
+	Type          string     `json:"type"`
+	Body          E          `json:"body"`
+}
+
+type CommandCreateBody struct {
+	SenderId uint32 `json:"senderId"`
+	Message  string `json:"message"`
+	Flag     byte   `json:"flag"`
+}
@@ -6,8 +6,11 @@ import (
 	character "atlas-merchant/kafka/consumer/character"
 	compartment2 "atlas-merchant/kafka/consumer/compartment"
 	merchant2 "atlas-merchant/kafka/consumer/merchant"
+	mts "atlas-merchant/kafka/consumer/mts"
 	"atlas-merchant/listing"
 	"atlas-merchant/message"
+	"atlas-merchant/pricehistory"
+	"atlas-merchant/salereport"
 	"atlas-merchant/searchcount"
 	"atlas-merchant/shop"
 	"atlas-merchant/tasks"
@@ -61,7 +64,7 @@ func main() {
 	shop.InitRegistry(rc)
 	visitor.InitRegistry(rc)
 
-	db := database.Connect(l, database.SetMigrations(shop.Migration, listing.Migration, message.Migration, frederick.Migration, searchcount.Migration, blacklist.Migration, visit.Migration, outboxlib.Migration))
+	db := database.Connect(l, database.SetMigrations(shop.Migration, listing.Migration, message.Migration, frederick.Migration, searchcount.Migration, blacklist.Migration, visit.Migration, pricehistory.Migration, salereport.Migration, outboxlib.Migration))
 
 	// Boot the outbox drainer: publishes the transactional outbox to Kafka.
 	// Leadership is gated by a postgres advisory lock — replicas are safe.
@@ -87,10 +90,14 @@ func main() {
 	merchant2.InitConsumers(l)(cmf)(consumerGroupId)
 	character.InitConsumers(l)(cmf)(consumerGroupId)
 	compartment2.InitConsumers(l)(cmf)(consumerGroupId)
+	mts.InitConsumers(l)(cmf)(consumerGroupId)
 	merchant2.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler)
 	if err := character.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register character status handlers.")
 	}
+	if err := mts.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register MTS status handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -107,6 +114,7 @@ func main() {
 	tasks.Register(l, rt.Context())(shop.NewExpirationTask(l, rt.Context(), db, shop.DefaultExpirationInterval, envContext))
 	tasks.Register(l, rt.Context())(frederick.NewCleanupTask(l, rt.Context(), db, frederick.DefaultCleanupInterval))
 	tasks.Register(l, rt.Context())(frederick.NewNotificationTask(l, rt.Context(), db, frederick.DefaultNotificationInterval, envContext))
+	tasks.Register(l, rt.Context())(pricehistory.NewRetentionTask(l, rt.Context(), db, pricehistory.DefaultRetentionInterval))
 
 	server.New(l).
 		WithContext(rt.Context()).
@@ -115,6 +123,7 @@ func main() {
 		SetPort(os.Getenv("REST_PORT")).
 		AddRouteInitializer(shop.InitializeRoutes(GetServer())(db)).
 		AddRouteInitializer(frederick.InitializeRoutes(GetServer())(db)).
+		AddRouteInitializer(pricehistory.InitializeRoutes(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
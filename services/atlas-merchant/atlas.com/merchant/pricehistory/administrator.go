@@ -0,0 +1,50 @@
+package pricehistory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// recordSale inserts a sale, ignoring a replay of the same (tenant, source,
+// reference) — an MTS LISTING_SOLD redelivery must not count twice.
+func recordSale(tenantId uuid.UUID, s Sale) func(db *gorm.DB) error {
+	return func(db *gorm.DB) error {
+		soldAt := s.SoldAt
+		if soldAt.IsZero() {
+			soldAt = time.Now()
+		}
+		e := &Entity{
+			Id:          uuid.New(),
+			TenantId:    tenantId,
+			WorldId:     s.WorldId,
+			ItemId:      s.ItemId,
+			Source:      string(s.Source),
+			ReferenceId: s.ReferenceId,
+			Quantity:    s.Quantity,
+			TotalPrice:  s.TotalPrice,
+			SoldAt:      soldAt,
+		}
+		return db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "source"}, {Name: "reference_id"}},
+			DoNothing: true,
+		}).Create(e).Error
+	}
+}
+
+// deleteSoldBefore prunes sales older than the retention cutoff. Callers run
+// it under database.WithoutTenantFilter to sweep every tenant at once.
+func deleteSoldBefore(cutoff time.Time) database.EntityProvider[int64] {
+	return func(db *gorm.DB) model.Provider[int64] {
+		result := db.Where("sold_at < ?", cutoff).Delete(&Entity{})
+		if result.Error != nil {
+			return model.ErrorProvider[int64](result.Error)
+		}
+		return model.FixedProvider(result.RowsAffected)
+	}
+}
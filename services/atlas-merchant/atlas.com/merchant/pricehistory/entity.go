@@ -0,0 +1,46 @@
+package pricehistory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// Entity is one completed sale observed by the price index. Tenant-safe PK
+// pattern (FR-12): uuid surrogate PK + unique index on (tenant_id, source,
+// reference_id), which also makes recording idempotent under redelivery.
+type Entity struct {
+	Id          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantId    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_price_sales_tenant_source_reference;index:idx_price_sales_tenant_world_item_sold"`
+	WorldId     world.Id  `gorm:"not null;index:idx_price_sales_tenant_world_item_sold"`
+	ItemId      uint32    `gorm:"not null;index:idx_price_sales_tenant_world_item_sold"`
+	Source      string    `gorm:"not null;uniqueIndex:idx_price_sales_tenant_source_reference"`
+	ReferenceId uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_price_sales_tenant_source_reference"`
+	Quantity    uint32    `gorm:"not null"`
+	TotalPrice  uint64    `gorm:"not null"`
+	SoldAt      time.Time `gorm:"not null;index:idx_price_sales_tenant_world_item_sold"`
+}
+
+func (e *Entity) TableName() string {
+	return "price_sales"
+}
+
+func Make(e Entity) (Model, error) {
+	return Model{
+		id:          e.Id,
+		worldId:     e.WorldId,
+		itemId:      e.ItemId,
+		source:      Source(e.Source),
+		referenceId: e.ReferenceId,
+		quantity:    e.Quantity,
+		totalPrice:  e.TotalPrice,
+		soldAt:      e.SoldAt,
+	}, nil
+}
+
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
@@ -0,0 +1,193 @@
+package pricehistory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// Source identifies the marketplace a sale settled on. Prices are never
+// mixed across sources: merchant sales settle in mesos, MTS sales in NX.
+type Source string
+
+const (
+	SourceMerchant Source = "merchant"
+	SourceMts      Source = "mts"
+)
+
+func (s Source) Valid() bool {
+	return s == SourceMerchant || s == SourceMts
+}
+
+// Model is a single recorded sale.
+type Model struct {
+	id          uuid.UUID
+	worldId     world.Id
+	itemId      uint32
+	source      Source
+	referenceId uuid.UUID
+	quantity    uint32
+	totalPrice  uint64
+	soldAt      time.Time
+}
+
+func (m Model) Id() uuid.UUID {
+	return m.id
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) ItemId() uint32 {
+	return m.itemId
+}
+
+func (m Model) Source() Source {
+	return m.source
+}
+
+func (m Model) ReferenceId() uuid.UUID {
+	return m.referenceId
+}
+
+func (m Model) Quantity() uint32 {
+	return m.quantity
+}
+
+func (m Model) TotalPrice() uint64 {
+	return m.totalPrice
+}
+
+func (m Model) SoldAt() time.Time {
+	return m.soldAt
+}
+
+// UnitPrice is the per-item price of the sale. Bundled merchant listings of
+// arrows or stars routinely sell below one meso per unit, so it is fractional.
+func (m Model) UnitPrice() float64 {
+	if m.quantity == 0 {
+		return float64(m.totalPrice)
+	}
+	return float64(m.totalPrice) / float64(m.quantity)
+}
+
+// Sale is the input to RecordSale. ReferenceId is the idempotency key within
+// a source: the purchase transaction for a merchant sale, the listing id for
+// an MTS sale.
+type Sale struct {
+	WorldId     world.Id
+	ItemId      uint32
+	Source      Source
+	ReferenceId uuid.UUID
+	Quantity    uint32
+	TotalPrice  uint64
+	SoldAt      time.Time
+}
+
+// Statistics summarises the unit prices of a set of sales. Percentiles use
+// the nearest-rank method over individual sales (not quantity-weighted), so a
+// single bulk dump cannot drag the median on its own.
+type Statistics struct {
+	sales    uint32
+	quantity uint64
+	min      float64
+	p10      float64
+	p25      float64
+	median   float64
+	p75      float64
+	p90      float64
+	max      float64
+}
+
+func (s Statistics) Sales() uint32 {
+	return s.sales
+}
+
+func (s Statistics) Quantity() uint64 {
+	return s.quantity
+}
+
+func (s Statistics) Min() float64 {
+	return s.min
+}
+
+func (s Statistics) P10() float64 {
+	return s.p10
+}
+
+func (s Statistics) P25() float64 {
+	return s.p25
+}
+
+func (s Statistics) Median() float64 {
+	return s.median
+}
+
+func (s Statistics) P75() float64 {
+	return s.p75
+}
+
+func (s Statistics) P90() float64 {
+	return s.p90
+}
+
+func (s Statistics) Max() float64 {
+	return s.max
+}
+
+// Index is the price summary of one item over a window.
+type Index struct {
+	worldId    world.Id
+	itemId     uint32
+	source     Source
+	from       time.Time
+	to         time.Time
+	statistics Statistics
+}
+
+func (i Index) WorldId() world.Id {
+	return i.worldId
+}
+
+func (i Index) ItemId() uint32 {
+	return i.itemId
+}
+
+func (i Index) Source() Source {
+	return i.source
+}
+
+func (i Index) From() time.Time {
+	return i.from
+}
+
+func (i Index) To() time.Time {
+	return i.to
+}
+
+func (i Index) Statistics() Statistics {
+	return i.statistics
+}
+
+// Bucket is the price summary of one item over one history interval. Buckets
+// with no sales are omitted from the history.
+type Bucket struct {
+	start      time.Time
+	end        time.Time
+	statistics Statistics
+}
+
+func (b Bucket) Start() time.Time {
+	return b.start
+}
+
+func (b Bucket) End() time.Time {
+	return b.end
+}
+
+func (b Bucket) Statistics() Statistics {
+	return b.statistics
+}
@@ -0,0 +1,124 @@
+package pricehistory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	DefaultWindow   = 30 * 24 * time.Hour
+	MaxWindow       = RetentionAge
+	DefaultInterval = 24 * time.Hour
+	MinInterval     = time.Hour
+)
+
+var (
+	ErrInvalidSource   = errors.New("invalid price source")
+	ErrInvalidWindow   = errors.New("invalid price window")
+	ErrInvalidInterval = errors.New("invalid price interval")
+)
+
+type Processor interface {
+	WithTransaction(tx *gorm.DB) Processor
+	RecordSale(s Sale) error
+	GetSales(worldId world.Id, itemId uint32, source Source, from time.Time, to time.Time) ([]Model, error)
+	GetIndex(worldId world.Id, itemId uint32, source Source, window time.Duration) (Index, error)
+	GetHistory(worldId world.Id, itemId uint32, source Source, window time.Duration, interval time.Duration) ([]Bucket, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	db  *gorm.DB
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		db:  db,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   p.l,
+		ctx: p.ctx,
+		db:  tx,
+		t:   p.t,
+	}
+}
+
+// RecordSale adds a completed sale to the index. Zero-quantity and free
+// transfers carry no price signal and are skipped.
+func (p *ProcessorImpl) RecordSale(s Sale) error {
+	if !s.Source.Valid() {
+		return ErrInvalidSource
+	}
+	if s.Quantity == 0 || s.TotalPrice == 0 {
+		p.l.Debugf("Skipping price record for item [%d] on [%s] with quantity [%d] and price [%d].", s.ItemId, s.Source, s.Quantity, s.TotalPrice)
+		return nil
+	}
+	return recordSale(p.t.Id(), s)(p.db.WithContext(p.ctx))
+}
+
+func (p *ProcessorImpl) GetSales(worldId world.Id, itemId uint32, source Source, from time.Time, to time.Time) ([]Model, error) {
+	return model.SliceMap(Make)(getByItemInWindow(worldId, itemId, source, from, to)(p.db.WithContext(p.ctx)))(model.ParallelMap())()
+}
+
+// GetIndex summarises an item's unit prices over the trailing window.
+func (p *ProcessorImpl) GetIndex(worldId world.Id, itemId uint32, source Source, window time.Duration) (Index, error) {
+	if !source.Valid() {
+		return Index{}, ErrInvalidSource
+	}
+	if window <= 0 || window > MaxWindow {
+		return Index{}, ErrInvalidWindow
+	}
+	to := time.Now()
+	from := to.Add(-window)
+	sales, err := p.GetSales(worldId, itemId, source, from, to)
+	if err != nil {
+		return Index{}, err
+	}
+	return Index{
+		worldId:    worldId,
+		itemId:     itemId,
+		source:     source,
+		from:       from,
+		to:         to,
+		statistics: summarize(sales),
+	}, nil
+}
+
+// GetHistory summarises an item's unit prices per interval over the trailing
+// window. Intervals are aligned to the window start.
+func (p *ProcessorImpl) GetHistory(worldId world.Id, itemId uint32, source Source, window time.Duration, interval time.Duration) ([]Bucket, error) {
+	if !source.Valid() {
+		return nil, ErrInvalidSource
+	}
+	if window <= 0 || window > MaxWindow {
+		return nil, ErrInvalidWindow
+	}
+	if interval < MinInterval || interval > window {
+		return nil, ErrInvalidInterval
+	}
+	to := time.Now()
+	from := to.Add(-window)
+	sales, err := p.GetSales(worldId, itemId, source, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return bucketize(sales, from, to, interval), nil
+}
@@ -0,0 +1,140 @@
+package pricehistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-database/databasetest"
+)
+
+func newTestProcessors(t *testing.T) (Processor, Processor) {
+	t.Helper()
+	db := databasetest.NewInMemoryTenantDB(t, Migration)
+	l := logrus.New()
+	pA := NewProcessor(l, databasetest.TenantContext(uuid.New()), db)
+	pB := NewProcessor(l, databasetest.TenantContext(uuid.New()), db)
+	return pA, pB
+}
+
+func sale(itemId uint32, source Source, quantity uint32, totalPrice uint64, soldAt time.Time) Sale {
+	return Sale{
+		WorldId:     0,
+		ItemId:      itemId,
+		Source:      source,
+		ReferenceId: uuid.New(),
+		Quantity:    quantity,
+		TotalPrice:  totalPrice,
+		SoldAt:      soldAt,
+	}
+}
+
+func TestGetIndex_Percentiles(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	now := time.Now()
+	for i := uint64(1); i <= 10; i++ {
+		require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, i*100, now.Add(-time.Duration(i)*time.Hour))))
+	}
+
+	idx, err := p.GetIndex(0, 1302000, SourceMerchant, DefaultWindow)
+	require.NoError(t, err)
+	s := idx.Statistics()
+	require.Equal(t, uint32(10), s.Sales())
+	require.Equal(t, uint64(10), s.Quantity())
+	require.Equal(t, 100.0, s.Min())
+	require.Equal(t, 100.0, s.P10())
+	require.Equal(t, 300.0, s.P25())
+	require.Equal(t, 500.0, s.Median())
+	require.Equal(t, 800.0, s.P75())
+	require.Equal(t, 900.0, s.P90())
+	require.Equal(t, 1000.0, s.Max())
+}
+
+func TestGetIndex_UnitPriceIsPerItem(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	require.NoError(t, p.RecordSale(sale(2060000, SourceMerchant, 1000, 500, time.Now())))
+
+	idx, err := p.GetIndex(0, 2060000, SourceMerchant, DefaultWindow)
+	require.NoError(t, err)
+	require.Equal(t, 0.5, idx.Statistics().Median())
+	require.Equal(t, uint64(1000), idx.Statistics().Quantity())
+}
+
+func TestGetIndex_SeparatesSourcesAndTenants(t *testing.T) {
+	pA, pB := newTestProcessors(t)
+	now := time.Now()
+	require.NoError(t, pA.RecordSale(sale(1302000, SourceMerchant, 1, 1000000, now)))
+	require.NoError(t, pA.RecordSale(sale(1302000, SourceMts, 1, 3000, now)))
+	require.NoError(t, pB.RecordSale(sale(1302000, SourceMerchant, 1, 5, now)))
+
+	merchant, err := pA.GetIndex(0, 1302000, SourceMerchant, DefaultWindow)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), merchant.Statistics().Sales())
+	require.Equal(t, 1000000.0, merchant.Statistics().Median())
+
+	mts, err := pA.GetIndex(0, 1302000, SourceMts, DefaultWindow)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), mts.Statistics().Sales())
+	require.Equal(t, 3000.0, mts.Statistics().Median())
+}
+
+func TestGetIndex_ExcludesSalesOutsideWindow(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	now := time.Now()
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 100, now.Add(-time.Hour))))
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 900, now.Add(-48*time.Hour))))
+
+	idx, err := p.GetIndex(0, 1302000, SourceMerchant, 24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), idx.Statistics().Sales())
+	require.Equal(t, 100.0, idx.Statistics().Max())
+}
+
+func TestRecordSale_IdempotentOnReference(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	s := sale(1302000, SourceMts, 1, 3000, time.Now())
+	require.NoError(t, p.RecordSale(s))
+	require.NoError(t, p.RecordSale(s))
+
+	idx, err := p.GetIndex(0, 1302000, SourceMts, DefaultWindow)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), idx.Statistics().Sales())
+}
+
+func TestRecordSale_SkipsFreeTransfersAndRejectsUnknownSource(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 0, time.Now())))
+	require.ErrorIs(t, p.RecordSale(sale(1302000, Source("trade"), 1, 10, time.Now())), ErrInvalidSource)
+
+	idx, err := p.GetIndex(0, 1302000, SourceMerchant, DefaultWindow)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), idx.Statistics().Sales())
+}
+
+func TestGetHistory_BucketsByInterval(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	now := time.Now()
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 100, now.Add(-70*time.Hour))))
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 300, now.Add(-69*time.Hour))))
+	require.NoError(t, p.RecordSale(sale(1302000, SourceMerchant, 1, 700, now.Add(-time.Hour))))
+
+	buckets, err := p.GetHistory(0, 1302000, SourceMerchant, 3*24*time.Hour, 24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, buckets, 2)
+	require.Equal(t, uint32(2), buckets[0].Statistics().Sales())
+	require.Equal(t, 100.0, buckets[0].Statistics().Median())
+	require.Equal(t, uint32(1), buckets[1].Statistics().Sales())
+	require.Equal(t, 700.0, buckets[1].Statistics().Median())
+	require.True(t, buckets[0].Start().Before(buckets[1].Start()))
+}
+
+func TestGetHistory_RejectsInvalidInterval(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	_, err := p.GetHistory(0, 1302000, SourceMerchant, 24*time.Hour, time.Minute)
+	require.ErrorIs(t, err, ErrInvalidInterval)
+	_, err = p.GetHistory(0, 1302000, SourceMerchant, 24*time.Hour, 48*time.Hour)
+	require.ErrorIs(t, err, ErrInvalidInterval)
+}
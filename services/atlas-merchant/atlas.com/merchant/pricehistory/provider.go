@@ -0,0 +1,27 @@
+package pricehistory
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// getByItemInWindow returns an item's sales on one source within [from, to),
+// oldest first. Uses a schema-bound Find so the automatic tenant callback
+// scopes the query.
+func getByItemInWindow(worldId world.Id, itemId uint32, source Source, from time.Time, to time.Time) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var results []Entity
+		err := db.Where("world_id = ? AND item_id = ? AND source = ? AND sold_at >= ? AND sold_at < ?", worldId, itemId, string(source), from, to).
+			Order("sold_at ASC").
+			Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
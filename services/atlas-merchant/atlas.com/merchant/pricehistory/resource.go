@@ -0,0 +1,160 @@
+package pricehistory
+
+import (
+	"atlas-merchant/rest"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
+)
+
+func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerHandler := rest.RegisterHandler(l)(si)
+
+			ir := router.PathPrefix("/worlds/{worldId}/items/{itemId}").Subrouter()
+			ir.HandleFunc("/price-index", registerHandler("get_item_price_index", handleGetItemPriceIndex(db))).Methods(http.MethodGet)
+			ir.HandleFunc("/price-history", registerHandler("get_item_price_history", handleGetItemPriceHistory(db))).Methods(http.MethodGet)
+		}
+	}
+}
+
+func handleGetItemPriceIndex(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseWorldId(d.Logger(), func(worldId world.Id) http.HandlerFunc {
+			return rest.ParseItemId(d.Logger(), func(itemId uint32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					source, window, err := parseWindowQuery(r.URL.Query())
+					if err != nil {
+						server.WriteBadRequest(d.Logger(), w, err.Error())
+						return
+					}
+
+					idx, err := NewProcessor(d.Logger(), d.Context(), db).GetIndex(worldId, itemId, source, window)
+					if err != nil {
+						d.Logger().WithError(err).Errorf("Retrieving price index for item [%d].", itemId)
+						server.WriteErrorResponse(d.Logger())(w)(err)
+						return
+					}
+
+					res, err := TransformIndex(idx)
+					if err != nil {
+						d.Logger().WithError(err).Errorf("Creating REST model.")
+						server.WriteErrorResponse(d.Logger())(w)(err)
+						return
+					}
+
+					query := r.URL.Query()
+					queryParams := jsonapi.ParseQueryFields(&query)
+					server.MarshalResponse[IndexRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res)
+				}
+			})
+		})
+	}
+}
+
+func handleGetItemPriceHistory(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseWorldId(d.Logger(), func(worldId world.Id) http.HandlerFunc {
+			return rest.ParseItemId(d.Logger(), func(itemId uint32) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					page, err := paginate.ParseParams(r.URL.Query(), paginate.MaxPageSize, paginate.MaxPageSize)
+					if err != nil {
+						server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+						return
+					}
+
+					source, window, err := parseWindowQuery(r.URL.Query())
+					if err != nil {
+						server.WriteBadRequest(d.Logger(), w, err.Error())
+						return
+					}
+					interval, err := parseInterval(r.URL.Query().Get("interval"))
+					if err != nil {
+						server.WriteBadRequest(d.Logger(), w, err.Error())
+						return
+					}
+
+					buckets, err := NewProcessor(d.Logger(), d.Context(), db).GetHistory(worldId, itemId, source, window, interval)
+					if err != nil {
+						if errors.Is(err, ErrInvalidInterval) {
+							server.WriteBadRequest(d.Logger(), w, err.Error())
+							return
+						}
+						d.Logger().WithError(err).Errorf("Retrieving price history for item [%d].", itemId)
+						server.WriteErrorResponse(d.Logger())(w)(err)
+						return
+					}
+
+					// Bounded by window/interval and already oldest-first — the
+					// envelope comes from paginate.Slice over the materialized list.
+					paged := paginate.Slice(buckets, page)
+
+					res, err := model.SliceMap(TransformBucket)(model.FixedProvider(paged.Items))(model.ParallelMap())()
+					if err != nil {
+						d.Logger().WithError(err).Errorf("Creating REST models.")
+						server.WriteErrorResponse(d.Logger())(w)(err)
+						return
+					}
+
+					query := r.URL.Query()
+					queryParams := jsonapi.ParseQueryFields(&query)
+					server.MarshalPaginatedResponse[[]BucketRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res, paginate.EnvelopeFor(paged), r)
+				}
+			})
+		})
+	}
+}
+
+// parseWindowQuery reads the optional source (default merchant) and days
+// (default 30, at most the retention age) query parameters.
+func parseWindowQuery(q url.Values) (Source, time.Duration, error) {
+	source := SourceMerchant
+	if s := q.Get("source"); s != "" {
+		source = Source(s)
+		if !source.Valid() {
+			return "", 0, ErrInvalidSource
+		}
+	}
+
+	window := DefaultWindow
+	if ds := q.Get("days"); ds != "" {
+		days, err := strconv.ParseUint(ds, 10, 16)
+		if err != nil || days == 0 {
+			return "", 0, ErrInvalidWindow
+		}
+		window = time.Duration(days) * 24 * time.Hour
+		if window > MaxWindow {
+			return "", 0, ErrInvalidWindow
+		}
+	}
+	return source, window, nil
+}
+
+// parseInterval maps the interval query parameter (hour, day, week; default
+// day) to a bucket width.
+func parseInterval(s string) (time.Duration, error) {
+	switch s {
+	case "":
+		return DefaultInterval, nil
+	case "hour":
+		return time.Hour, nil
+	case "day":
+		return 24 * time.Hour, nil
+	case "week":
+		return 7 * 24 * time.Hour, nil
+	}
+	return 0, ErrInvalidInterval
+}
@@ -0,0 +1,104 @@
+package pricehistory
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// StatisticsRestModel is the unit-price summary shared by the index and each
+// history bucket. Prices are in the source's currency (mesos for merchant,
+// NX for MTS).
+type StatisticsRestModel struct {
+	Sales    uint32  `json:"sales"`
+	Quantity uint64  `json:"quantity"`
+	Min      float64 `json:"min"`
+	P10      float64 `json:"p10"`
+	P25      float64 `json:"p25"`
+	Median   float64 `json:"median"`
+	P75      float64 `json:"p75"`
+	P90      float64 `json:"p90"`
+	Max      float64 `json:"max"`
+}
+
+func TransformStatistics(s Statistics) StatisticsRestModel {
+	return StatisticsRestModel{
+		Sales:    s.Sales(),
+		Quantity: s.Quantity(),
+		Min:      s.Min(),
+		P10:      s.P10(),
+		P25:      s.P25(),
+		Median:   s.Median(),
+		P75:      s.P75(),
+		P90:      s.P90(),
+		Max:      s.Max(),
+	}
+}
+
+// IndexRestModel is the "typical price" resource for one item on one source.
+type IndexRestModel struct {
+	Id         string              `json:"-"`
+	WorldId    world.Id            `json:"worldId"`
+	ItemId     uint32              `json:"itemId"`
+	Source     string              `json:"source"`
+	From       time.Time           `json:"from"`
+	To         time.Time           `json:"to"`
+	Statistics StatisticsRestModel `json:"statistics"`
+}
+
+func (r IndexRestModel) GetName() string {
+	return "item-price-indexes"
+}
+
+func (r IndexRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *IndexRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func TransformIndex(m Index) (IndexRestModel, error) {
+	return IndexRestModel{
+		Id:         strconv.FormatUint(uint64(m.ItemId()), 10),
+		WorldId:    m.WorldId(),
+		ItemId:     m.ItemId(),
+		Source:     string(m.Source()),
+		From:       m.From(),
+		To:         m.To(),
+		Statistics: TransformStatistics(m.Statistics()),
+	}, nil
+}
+
+// BucketRestModel is one interval of an item's price history. The resource
+// id is the interval start as unix seconds.
+type BucketRestModel struct {
+	Id         string              `json:"-"`
+	Start      time.Time           `json:"start"`
+	End        time.Time           `json:"end"`
+	Statistics StatisticsRestModel `json:"statistics"`
+}
+
+func (r BucketRestModel) GetName() string {
+	return "item-price-history"
+}
+
+func (r BucketRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *BucketRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func TransformBucket(m Bucket) (BucketRestModel, error) {
+	return BucketRestModel{
+		Id:         strconv.FormatInt(m.Start().Unix(), 10),
+		Start:      m.Start(),
+		End:        m.End(),
+		Statistics: TransformStatistics(m.Statistics()),
+	}, nil
+}
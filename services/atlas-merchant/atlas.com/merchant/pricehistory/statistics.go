@@ -0,0 +1,74 @@
+package pricehistory
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// summarize computes the unit-price statistics of a set of sales.
+func summarize(sales []Model) Statistics {
+	if len(sales) == 0 {
+		return Statistics{}
+	}
+	prices := make([]float64, 0, len(sales))
+	var quantity uint64
+	for _, s := range sales {
+		prices = append(prices, s.UnitPrice())
+		quantity += uint64(s.Quantity())
+	}
+	sort.Float64s(prices)
+	return Statistics{
+		sales:    uint32(len(prices)),
+		quantity: quantity,
+		min:      prices[0],
+		p10:      percentile(prices, 10),
+		p25:      percentile(prices, 25),
+		median:   percentile(prices, 50),
+		p75:      percentile(prices, 75),
+		p90:      percentile(prices, 90),
+		max:      prices[len(prices)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of an ascending slice.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// bucketize groups sales into fixed intervals aligned to from and summarises
+// each non-empty interval, oldest first.
+func bucketize(sales []Model, from time.Time, to time.Time, interval time.Duration) []Bucket {
+	grouped := make(map[int64][]Model)
+	for _, s := range sales {
+		if s.SoldAt().Before(from) || !s.SoldAt().Before(to) {
+			continue
+		}
+		i := int64(s.SoldAt().Sub(from) / interval)
+		grouped[i] = append(grouped[i], s)
+	}
+
+	keys := make([]int64, 0, len(grouped))
+	for k := range grouped {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	results := make([]Bucket, 0, len(keys))
+	for _, k := range keys {
+		start := from.Add(time.Duration(k) * interval)
+		end := start.Add(interval)
+		if end.After(to) {
+			end = to
+		}
+		results = append(results, Bucket{start: start, end: end, statistics: summarize(grouped[k])})
+	}
+	return results
+}
@@ -0,0 +1,44 @@
+package pricehistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+)
+
+const (
+	DefaultRetentionInterval = 6 * time.Hour
+	RetentionAge             = 90 * 24 * time.Hour
+)
+
+type RetentionTask struct {
+	l        logrus.FieldLogger
+	ctx      context.Context
+	db       *gorm.DB
+	interval time.Duration
+}
+
+func NewRetentionTask(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, interval time.Duration) *RetentionTask {
+	l.Infof("Initializing price history retention task to run every %dms.", interval.Milliseconds())
+	return &RetentionTask{l: l, ctx: ctx, db: db, interval: interval}
+}
+
+func (t *RetentionTask) Run() {
+	noTenantCtx := database.WithoutTenantFilter(t.ctx)
+	cutoff := time.Now().Add(-RetentionAge)
+
+	rows, err := deleteSoldBefore(cutoff)(t.db.WithContext(noTenantCtx))()
+	if err != nil {
+		t.l.WithError(err).Errorln("Error pruning price history.")
+	} else if rows > 0 {
+		t.l.Infof("Pruned %d price history records.", rows)
+	}
+}
+
+func (t *RetentionTask) SleepTime() time.Duration {
+	return t.interval
+}
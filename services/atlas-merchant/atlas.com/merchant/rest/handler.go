@@ -53,3 +53,7 @@ func ParseMapId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http.H
 func ParseInstanceId(l logrus.FieldLogger, next func(uuid.UUID) http.HandlerFunc) http.HandlerFunc {
 	return server.ParseUUIDId(l, "instanceId", next)
 }
+
+func ParseItemId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http.HandlerFunc {
+	return server.ParseIntId[uint32](l, "itemId", next)
+}
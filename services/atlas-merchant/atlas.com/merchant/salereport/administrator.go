@@ -0,0 +1,18 @@
+package salereport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func createLine(db *gorm.DB, e *Entity) error {
+	if e.Id == uuid.Nil {
+		e.Id = uuid.New()
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	return db.Create(e).Error
+}
@@ -0,0 +1,55 @@
+package salereport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Entity is one line of a shop session's sale report: either a completed
+// sale (one PurchaseBundle) or an unsold remainder captured when the shop
+// closed. Tenant-safe PK pattern (FR-12): uuid surrogate PK; lines are always
+// read per (tenant, shop).
+type Entity struct {
+	Id             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TenantId       uuid.UUID `gorm:"type:uuid;not null;index:idx_merchant_sale_lines_tenant_shop"`
+	ShopId         uuid.UUID `gorm:"type:uuid;not null;index:idx_merchant_sale_lines_tenant_shop"`
+	Kind           string    `gorm:"not null"`
+	ItemId         uint32    `gorm:"not null"`
+	BuyerId        uint32    `gorm:"not null;default:0"`
+	BuyerName      string    `gorm:"type:varchar(13);not null;default:''"`
+	BundleSize     uint16    `gorm:"not null"`
+	Bundles        uint16    `gorm:"not null"`
+	Quantity       uint32    `gorm:"not null"`
+	PricePerBundle uint32    `gorm:"not null"`
+	TotalPrice     int64     `gorm:"not null;default:0"`
+	Fee            int64     `gorm:"not null;default:0"`
+	CreatedAt      time.Time `gorm:"not null"`
+}
+
+func (e *Entity) TableName() string {
+	return "merchant_sale_lines"
+}
+
+func Make(e Entity) (Model, error) {
+	return Model{
+		id:             e.Id,
+		shopId:         e.ShopId,
+		kind:           Kind(e.Kind),
+		itemId:         e.ItemId,
+		buyerId:        e.BuyerId,
+		buyerName:      e.BuyerName,
+		bundleSize:     e.BundleSize,
+		bundles:        e.Bundles,
+		quantity:       e.Quantity,
+		pricePerBundle: e.PricePerBundle,
+		totalPrice:     e.TotalPrice,
+		fee:            e.Fee,
+		createdAt:      e.CreatedAt,
+	}, nil
+}
+
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
@@ -0,0 +1,166 @@
+package salereport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Kind string
+
+const (
+	KindSold   Kind = "sold"
+	KindUnsold Kind = "unsold"
+)
+
+// Model is one sale-report line.
+type Model struct {
+	id             uuid.UUID
+	shopId         uuid.UUID
+	kind           Kind
+	itemId         uint32
+	buyerId        uint32
+	buyerName      string
+	bundleSize     uint16
+	bundles        uint16
+	quantity       uint32
+	pricePerBundle uint32
+	totalPrice     int64
+	fee            int64
+	createdAt      time.Time
+}
+
+func (m Model) Id() uuid.UUID          { return m.id }
+func (m Model) ShopId() uuid.UUID      { return m.shopId }
+func (m Model) Kind() Kind             { return m.kind }
+func (m Model) ItemId() uint32         { return m.itemId }
+func (m Model) BuyerId() uint32        { return m.buyerId }
+func (m Model) BuyerName() string      { return m.buyerName }
+func (m Model) BundleSize() uint16     { return m.bundleSize }
+func (m Model) Bundles() uint16        { return m.bundles }
+func (m Model) Quantity() uint32       { return m.quantity }
+func (m Model) PricePerBundle() uint32 { return m.pricePerBundle }
+func (m Model) TotalPrice() int64      { return m.totalPrice }
+func (m Model) Fee() int64             { return m.fee }
+func (m Model) NetAmount() int64       { return m.totalPrice - m.fee }
+func (m Model) CreatedAt() time.Time   { return m.createdAt }
+
+// Sale is the input for recording one completed purchase.
+type Sale struct {
+	ShopId         uuid.UUID
+	ItemId         uint32
+	BuyerId        uint32
+	BuyerName      string
+	BundleSize     uint16
+	Bundles        uint16
+	PricePerBundle uint32
+	TotalPrice     int64
+	Fee            int64
+}
+
+// Unsold is the input for recording a listing still on the shelf at close.
+type Unsold struct {
+	ItemId         uint32
+	BundleSize     uint16
+	Bundles        uint16
+	Quantity       uint32
+	PricePerBundle uint32
+}
+
+// Header identifies the shop session a report covers. The shop domain owns
+// these values; the report only carries them for presentation.
+type Header struct {
+	ShopId      uuid.UUID
+	CharacterId uint32
+	ShopType    byte
+	Title       string
+	WorldId     world.Id
+	OpenedAt    time.Time
+	ClosedAt    *time.Time
+	CloseReason byte
+	// UnsoldAtFrederick is set for hired merchants, whose unsold remainder is
+	// deposited with Fredrick rather than returned to the owner's inventory.
+	UnsoldAtFrederick bool
+}
+
+// Report is a shop session's sale report: every sale in the order it
+// happened, and whatever was left when the shop closed.
+type Report struct {
+	header Header
+	sold   []Model
+	unsold []Model
+}
+
+func NewReport(h Header, lines []Model) Report {
+	r := Report{header: h}
+	for _, l := range lines {
+		if l.Kind() == KindUnsold {
+			r.unsold = append(r.unsold, l)
+		} else {
+			r.sold = append(r.sold, l)
+		}
+	}
+	return r
+}
+
+func (r Report) Header() Header  { return r.header }
+func (r Report) Sold() []Model   { return r.sold }
+func (r Report) Unsold() []Model { return r.unsold }
+func (r Report) SaleCount() int  { return len(r.sold) }
+func (r Report) IsEmpty() bool   { return len(r.sold) == 0 && len(r.unsold) == 0 }
+
+func (r Report) QuantitySold() uint32 {
+	var q uint32
+	for _, l := range r.sold {
+		q += l.Quantity()
+	}
+	return q
+}
+
+func (r Report) Gross() int64 {
+	var g int64
+	for _, l := range r.sold {
+		g += l.TotalPrice()
+	}
+	return g
+}
+
+func (r Report) Fees() int64 {
+	var f int64
+	for _, l := range r.sold {
+		f += l.Fee()
+	}
+	return f
+}
+
+func (r Report) Net() int64 {
+	return r.Gross() - r.Fees()
+}
+
+func (r Report) QuantityUnsold() uint32 {
+	var q uint32
+	for _, l := range r.unsold {
+		q += l.Quantity()
+	}
+	return q
+}
+
+// Buyers returns the distinct buyer names in first-purchase order. A sale
+// recorded without a name (the channel could not resolve it) is skipped.
+func (r Report) Buyers() []string {
+	seen := make(map[string]struct{})
+	var names []string
+	for _, l := range r.sold {
+		if l.BuyerName() == "" {
+			continue
+		}
+		if _, ok := seen[l.BuyerName()]; ok {
+			continue
+		}
+		seen[l.BuyerName()] = struct{}{}
+		names = append(names, l.BuyerName())
+	}
+	return names
+}
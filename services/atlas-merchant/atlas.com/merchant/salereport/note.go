@@ -0,0 +1,44 @@
+package salereport
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxNoteBuyers bounds how many buyer names the close note spells out; the
+// full list is available from the REST report.
+const MaxNoteBuyers = 5
+
+// NoteMessage renders the report as the plain-text note delivered to the
+// owner when the shop closes.
+func NoteMessage(r Report) string {
+	h := r.Header()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Your shop \"%s\" has closed.", h.Title)
+
+	if r.SaleCount() == 0 {
+		sb.WriteString(" Nothing was sold.")
+	} else {
+		fmt.Fprintf(&sb, " Sold %d item(s) in %d sale(s) for %d mesos (%d fee, %d net).", r.QuantitySold(), r.SaleCount(), r.Gross(), r.Fees(), r.Net())
+		if buyers := r.Buyers(); len(buyers) > 0 {
+			shown := buyers
+			if len(shown) > MaxNoteBuyers {
+				shown = shown[:MaxNoteBuyers]
+			}
+			fmt.Fprintf(&sb, " Buyers: %s", strings.Join(shown, ", "))
+			if more := len(buyers) - len(shown); more > 0 {
+				fmt.Fprintf(&sb, " and %d more", more)
+			}
+			sb.WriteString(".")
+		}
+	}
+
+	if q := r.QuantityUnsold(); q > 0 {
+		if h.UnsoldAtFrederick {
+			fmt.Fprintf(&sb, " %d unsold item(s) are waiting with Fredrick.", q)
+		} else {
+			fmt.Fprintf(&sb, " %d unsold item(s) were returned to your inventory.", q)
+		}
+	}
+	return sb.String()
+}
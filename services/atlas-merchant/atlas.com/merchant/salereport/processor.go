@@ -0,0 +1,99 @@
+package salereport
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type Processor interface {
+	WithTransaction(tx *gorm.DB) Processor
+	RecordSale(s Sale) error
+	RecordUnsold(shopId uuid.UUID, items []Unsold) error
+	GetLines(shopId uuid.UUID) ([]Model, error)
+	GetReport(h Header) (Report, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	db  *gorm.DB
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		db:  db,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:   p.l,
+		ctx: p.ctx,
+		db:  tx,
+		t:   p.t,
+	}
+}
+
+// RecordSale appends a completed purchase to the shop's report. Callers run
+// it inside the purchase transaction so a rolled-back purchase leaves no line.
+func (p *ProcessorImpl) RecordSale(s Sale) error {
+	return createLine(p.db.WithContext(p.ctx), &Entity{
+		TenantId:       p.t.Id(),
+		ShopId:         s.ShopId,
+		Kind:           string(KindSold),
+		ItemId:         s.ItemId,
+		BuyerId:        s.BuyerId,
+		BuyerName:      s.BuyerName,
+		BundleSize:     s.BundleSize,
+		Bundles:        s.Bundles,
+		Quantity:       uint32(s.BundleSize) * uint32(s.Bundles),
+		PricePerBundle: s.PricePerBundle,
+		TotalPrice:     s.TotalPrice,
+		Fee:            s.Fee,
+	})
+}
+
+// RecordUnsold captures the listings still on the shelf when the shop closed.
+func (p *ProcessorImpl) RecordUnsold(shopId uuid.UUID, items []Unsold) error {
+	for _, i := range items {
+		err := createLine(p.db.WithContext(p.ctx), &Entity{
+			TenantId:       p.t.Id(),
+			ShopId:         shopId,
+			Kind:           string(KindUnsold),
+			ItemId:         i.ItemId,
+			BundleSize:     i.BundleSize,
+			Bundles:        i.Bundles,
+			Quantity:       i.Quantity,
+			PricePerBundle: i.PricePerBundle,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ProcessorImpl) GetLines(shopId uuid.UUID) ([]Model, error) {
+	return model.SliceMap(Make)(getByShopId(shopId)(p.db.WithContext(p.ctx)))()()
+}
+
+// GetReport assembles the report for the shop session identified by h.
+func (p *ProcessorImpl) GetReport(h Header) (Report, error) {
+	lines, err := p.GetLines(h.ShopId)
+	if err != nil {
+		return Report{}, err
+	}
+	return NewReport(h, lines), nil
+}
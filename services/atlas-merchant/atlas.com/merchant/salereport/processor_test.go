@@ -0,0 +1,110 @@
+package salereport
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-database/databasetest"
+)
+
+func newTestProcessors(t *testing.T) (Processor, Processor) {
+	t.Helper()
+	db := databasetest.NewInMemoryTenantDB(t, Migration)
+	l := logrus.New()
+	pA := NewProcessor(l, databasetest.TenantContext(uuid.New()), db)
+	pB := NewProcessor(l, databasetest.TenantContext(uuid.New()), db)
+	return pA, pB
+}
+
+func sale(shopId uuid.UUID, buyerId uint32, buyerName string, bundles uint16, price uint32, fee int64) Sale {
+	return Sale{
+		ShopId:         shopId,
+		ItemId:         2000000,
+		BuyerId:        buyerId,
+		BuyerName:      buyerName,
+		BundleSize:     10,
+		Bundles:        bundles,
+		PricePerBundle: price,
+		TotalPrice:     int64(bundles) * int64(price),
+		Fee:            fee,
+	}
+}
+
+func TestGetReport_Aggregates(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	shopId := uuid.New()
+	require.NoError(t, p.RecordSale(sale(shopId, 2000, "Alpha", 2, 1000, 20)))
+	require.NoError(t, p.RecordSale(sale(shopId, 2001, "Beta", 1, 1000, 10)))
+	require.NoError(t, p.RecordSale(sale(shopId, 2000, "Alpha", 3, 1000, 30)))
+	require.NoError(t, p.RecordUnsold(shopId, []Unsold{{ItemId: 2000001, BundleSize: 5, Bundles: 4, Quantity: 20, PricePerBundle: 50}}))
+
+	r, err := p.GetReport(Header{ShopId: shopId, CharacterId: 1000, Title: "Shop"})
+	require.NoError(t, err)
+	require.Equal(t, 3, r.SaleCount())
+	require.Len(t, r.Unsold(), 1)
+	require.Equal(t, uint32(60), r.QuantitySold())
+	require.Equal(t, int64(6000), r.Gross())
+	require.Equal(t, int64(60), r.Fees())
+	require.Equal(t, int64(5940), r.Net())
+	require.Equal(t, uint32(20), r.QuantityUnsold())
+	require.Equal(t, []string{"Alpha", "Beta"}, r.Buyers())
+	require.False(t, r.IsEmpty())
+}
+
+func TestGetReport_ScopedToShop(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	shopId := uuid.New()
+	require.NoError(t, p.RecordSale(sale(shopId, 2000, "Alpha", 1, 1000, 0)))
+	require.NoError(t, p.RecordSale(sale(uuid.New(), 2001, "Beta", 1, 1000, 0)))
+
+	r, err := p.GetReport(Header{ShopId: shopId})
+	require.NoError(t, err)
+	require.Equal(t, 1, r.SaleCount())
+	require.Equal(t, []string{"Alpha"}, r.Buyers())
+}
+
+func TestGetReport_TenantIsolation(t *testing.T) {
+	pA, pB := newTestProcessors(t)
+	shopId := uuid.New()
+	require.NoError(t, pA.RecordSale(sale(shopId, 2000, "Alpha", 1, 1000, 0)))
+
+	r, err := pB.GetReport(Header{ShopId: shopId})
+	require.NoError(t, err)
+	require.True(t, r.IsEmpty())
+}
+
+func TestNoteMessage_NothingSold(t *testing.T) {
+	r := NewReport(Header{Title: "Shop"}, nil)
+	require.Equal(t, `Your shop "Shop" has closed. Nothing was sold.`, NoteMessage(r))
+}
+
+func TestNoteMessage_SalesAndUnsold(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	shopId := uuid.New()
+	require.NoError(t, p.RecordSale(sale(shopId, 2000, "Alpha", 2, 1000, 20)))
+	require.NoError(t, p.RecordUnsold(shopId, []Unsold{{ItemId: 2000001, BundleSize: 5, Bundles: 4, Quantity: 20, PricePerBundle: 50}}))
+
+	r, err := p.GetReport(Header{ShopId: shopId, Title: "Shop", UnsoldAtFrederick: true})
+	require.NoError(t, err)
+	require.Equal(t, `Your shop "Shop" has closed. Sold 20 item(s) in 1 sale(s) for 2000 mesos (20 fee, 1980 net). Buyers: Alpha. 20 unsold item(s) are waiting with Fredrick.`, NoteMessage(r))
+
+	r, err = p.GetReport(Header{ShopId: shopId, Title: "Shop"})
+	require.NoError(t, err)
+	require.Contains(t, NoteMessage(r), "20 unsold item(s) were returned to your inventory.")
+}
+
+func TestNoteMessage_TruncatesBuyers(t *testing.T) {
+	p, _ := newTestProcessors(t)
+	shopId := uuid.New()
+	for i := 0; i < MaxNoteBuyers+2; i++ {
+		require.NoError(t, p.RecordSale(sale(shopId, uint32(2000+i), fmt.Sprintf("Buyer%d", i), 1, 100, 0)))
+	}
+
+	r, err := p.GetReport(Header{ShopId: shopId, Title: "Shop"})
+	require.NoError(t, err)
+	require.Contains(t, NoteMessage(r), "Buyers: Buyer0, Buyer1, Buyer2, Buyer3, Buyer4 and 2 more.")
+}
@@ -0,0 +1,28 @@
+package salereport
+
+import (
+	note "atlas-merchant/kafka/message/note"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// CreateNoteCommandProvider builds the atlas-notes CREATE command delivering
+// a report to the shop owner. The owner is also the sender: the report is a
+// self-addressed record, and atlas-notes has no system sender.
+func CreateNoteCommandProvider(r Report) model.Provider[[]kafka.Message] {
+	h := r.Header()
+	key := producer.CreateKey(int(h.CharacterId))
+	value := &note.Command[note.CommandCreateBody]{
+		WorldId:     h.WorldId,
+		CharacterId: h.CharacterId,
+		Type:        note.CommandTypeCreate,
+		Body: note.CommandCreateBody{
+			SenderId: h.CharacterId,
+			Message:  NoteMessage(r),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
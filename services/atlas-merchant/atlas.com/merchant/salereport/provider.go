@@ -0,0 +1,24 @@
+package salereport
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// getByShopId returns every line of a shop's report, oldest first. Uses a
+// schema-bound Find so the automatic tenant callback scopes the query.
+func getByShopId(shopId uuid.UUID) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var results []Entity
+		err := db.Where("shop_id = ?", shopId).
+			Order("created_at ASC").
+			Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
@@ -0,0 +1,111 @@
+package salereport
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// RestModel is the JSON:API resource for a shop session's sale report, keyed
+// by shop id.
+type RestModel struct {
+	Id             string            `json:"-"`
+	CharacterId    uint32            `json:"characterId"`
+	ShopType       byte              `json:"shopType"`
+	Title          string            `json:"title"`
+	WorldId        world.Id          `json:"worldId"`
+	OpenedAt       time.Time         `json:"openedAt"`
+	ClosedAt       *time.Time        `json:"closedAt,omitempty"`
+	CloseReason    byte              `json:"closeReason"`
+	SaleCount      int               `json:"saleCount"`
+	QuantitySold   uint32            `json:"quantitySold"`
+	Gross          int64             `json:"gross"`
+	Fees           int64             `json:"fees"`
+	Net            int64             `json:"net"`
+	QuantityUnsold uint32            `json:"quantityUnsold"`
+	Sales          []SaleRestModel   `json:"sales"`
+	Unsold         []UnsoldRestModel `json:"unsold"`
+}
+
+type SaleRestModel struct {
+	ItemId         uint32    `json:"itemId"`
+	BuyerId        uint32    `json:"buyerId"`
+	BuyerName      string    `json:"buyerName"`
+	BundleSize     uint16    `json:"bundleSize"`
+	Bundles        uint16    `json:"bundles"`
+	Quantity       uint32    `json:"quantity"`
+	PricePerBundle uint32    `json:"pricePerBundle"`
+	TotalPrice     int64     `json:"totalPrice"`
+	Fee            int64     `json:"fee"`
+	NetAmount      int64     `json:"netAmount"`
+	SoldAt         time.Time `json:"soldAt"`
+}
+
+type UnsoldRestModel struct {
+	ItemId         uint32 `json:"itemId"`
+	BundleSize     uint16 `json:"bundleSize"`
+	Bundles        uint16 `json:"bundles"`
+	Quantity       uint32 `json:"quantity"`
+	PricePerBundle uint32 `json:"pricePerBundle"`
+}
+
+func (r RestModel) GetName() string {
+	return "merchant-sale-reports"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+func (r *RestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func Transform(r Report) (RestModel, error) {
+	h := r.Header()
+	sales := make([]SaleRestModel, 0, len(r.Sold()))
+	for _, l := range r.Sold() {
+		sales = append(sales, SaleRestModel{
+			ItemId:         l.ItemId(),
+			BuyerId:        l.BuyerId(),
+			BuyerName:      l.BuyerName(),
+			BundleSize:     l.BundleSize(),
+			Bundles:        l.Bundles(),
+			Quantity:       l.Quantity(),
+			PricePerBundle: l.PricePerBundle(),
+			TotalPrice:     l.TotalPrice(),
+			Fee:            l.Fee(),
+			NetAmount:      l.NetAmount(),
+			SoldAt:         l.CreatedAt(),
+		})
+	}
+	unsold := make([]UnsoldRestModel, 0, len(r.Unsold()))
+	for _, l := range r.Unsold() {
+		unsold = append(unsold, UnsoldRestModel{
+			ItemId:         l.ItemId(),
+			BundleSize:     l.BundleSize(),
+			Bundles:        l.Bundles(),
+			Quantity:       l.Quantity(),
+			PricePerBundle: l.PricePerBundle(),
+		})
+	}
+	return RestModel{
+		Id:             h.ShopId.String(),
+		CharacterId:    h.CharacterId,
+		ShopType:       h.ShopType,
+		Title:          h.Title,
+		WorldId:        h.WorldId,
+		OpenedAt:       h.OpenedAt,
+		ClosedAt:       h.ClosedAt,
+		CloseReason:    h.CloseReason,
+		SaleCount:      r.SaleCount(),
+		QuantitySold:   r.QuantitySold(),
+		Gross:          r.Gross(),
+		Fees:           r.Fees(),
+		Net:            r.Net(),
+		QuantityUnsold: r.QuantityUnsold(),
+		Sales:          sales,
+		Unsold:         unsold,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	message "atlas-merchant/kafka/message"
 	"atlas-merchant/kafka/message/asset"
 	"atlas-merchant/listing"
+	"atlas-merchant/salereport"
 	"atlas-merchant/shop"
 	"atlas-merchant/visit"
 
@@ -49,7 +50,7 @@ type ProcessorMock struct {
 	EjectAllVisitorsFunc            func(shopId uuid.UUID) ([]uint32, error)
 	GetVisitorsFunc                 func(shopId uuid.UUID) ([]uint32, error)
 	GetShopForCharacterFunc         func(characterId uint32) (uuid.UUID, error)
-	PurchaseBundleFunc              func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error)
+	PurchaseBundleFunc              func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error)
 	SendMessageFunc                 func(shopId uuid.UUID, characterId uint32, content string) error
 	RetrieveFrederickFunc           func(characterId uint32, worldId world.Id) error
 	OpenShopAndEmitFunc             func(shopId uuid.UUID, characterId uint32) error
@@ -61,12 +62,14 @@ type ProcessorMock struct {
 	RemoveFromBlacklistFunc         func(mb *message.Buffer) func(shopId uuid.UUID, characterId uint32, name string) error
 	GetBlacklistPagedFunc           func(shopId uuid.UUID, page model.Page) (model.Paged[string], error)
 	GetVisitsPagedFunc              func(shopId uuid.UUID, page model.Page) (model.Paged[visit.Model], error)
+	GetSaleReportFunc               func(shopId uuid.UUID) (salereport.Report, error)
+	GetSaleReportsPagedFunc         func(characterId uint32, page model.Page) (model.Paged[salereport.Report], error)
 	AddToBlacklistAndEmitFunc       func(shopId uuid.UUID, characterId uint32, name string, bannedCharacterId uint32) error
 	RemoveFromBlacklistAndEmitFunc  func(shopId uuid.UUID, characterId uint32, name string) error
 	ExitShopAndEmitFunc             func(characterId uint32, shopId uuid.UUID) error
 	AddListingAndEmitFunc           func(shopId uuid.UUID, characterId uint32, itemId uint32, itemType byte, bundleSize uint16, bundleCount uint16, pricePerBundle uint32, itemSnapshot asset.AssetData, inventoryType byte, assetId uint32) (listing.Model, error)
 	RemoveListingAndEmitFunc        func(shopId uuid.UUID, characterId uint32, listingIndex uint16) (listing.Model, error)
-	PurchaseBundleAndEmitFunc       func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error)
+	PurchaseBundleAndEmitFunc       func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error)
 	SendMessageAndEmitFunc          func(shopId uuid.UUID, characterId uint32, content string) error
 	RetrieveFrederickAndEmitFunc    func(characterId uint32, worldId world.Id) error
 }
@@ -306,10 +309,10 @@ func (m *ProcessorMock) GetShopForCharacter(characterId uint32) (uuid.UUID, erro
 	return uuid.Nil, nil
 }
 
-func (m *ProcessorMock) PurchaseBundle(_ *message.Buffer) func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
-	return func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
+func (m *ProcessorMock) PurchaseBundle(_ *message.Buffer) func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
+	return func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
 		if m.PurchaseBundleFunc != nil {
-			return m.PurchaseBundleFunc(buyerCharacterId, shopId, listingIndex, bundleCount, worldId)
+			return m.PurchaseBundleFunc(buyerCharacterId, buyerName, shopId, listingIndex, bundleCount, worldId)
 		}
 		return shop.PurchaseResult{}, nil
 	}
@@ -396,6 +399,20 @@ func (m *ProcessorMock) GetVisitsPaged(shopId uuid.UUID, page model.Page) (model
 	return model.Paged[visit.Model]{Items: []visit.Model{}, Page: page}, nil
 }
 
+func (m *ProcessorMock) GetSaleReport(shopId uuid.UUID) (salereport.Report, error) {
+	if m.GetSaleReportFunc != nil {
+		return m.GetSaleReportFunc(shopId)
+	}
+	return salereport.Report{}, nil
+}
+
+func (m *ProcessorMock) GetSaleReportsPaged(characterId uint32, page model.Page) (model.Paged[salereport.Report], error) {
+	if m.GetSaleReportsPagedFunc != nil {
+		return m.GetSaleReportsPagedFunc(characterId, page)
+	}
+	return model.Paged[salereport.Report]{Items: []salereport.Report{}, Page: page}, nil
+}
+
 func (m *ProcessorMock) AddToBlacklistAndEmit(shopId uuid.UUID, characterId uint32, name string, bannedCharacterId uint32) error {
 	if m.AddToBlacklistAndEmitFunc != nil {
 		return m.AddToBlacklistAndEmitFunc(shopId, characterId, name, bannedCharacterId)
@@ -431,9 +448,9 @@ func (m *ProcessorMock) RemoveListingAndEmit(shopId uuid.UUID, characterId uint3
 	return listing.Model{}, nil
 }
 
-func (m *ProcessorMock) PurchaseBundleAndEmit(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
+func (m *ProcessorMock) PurchaseBundleAndEmit(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
 	if m.PurchaseBundleAndEmitFunc != nil {
-		return m.PurchaseBundleAndEmitFunc(buyerCharacterId, shopId, listingIndex, bundleCount, worldId)
+		return m.PurchaseBundleAndEmitFunc(buyerCharacterId, buyerName, shopId, listingIndex, bundleCount, worldId)
 	}
 	return shop.PurchaseResult{}, nil
 }
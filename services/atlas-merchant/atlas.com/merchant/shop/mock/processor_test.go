@@ -73,7 +73,7 @@ func TestProcessorMock_DefaultBehavior(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uuid.Nil, visitingId)
 
-	result, err := m.PurchaseBundle(mb)(2000, id, 0, 1, 0)
+	result, err := m.PurchaseBundle(mb)(2000, "Buyer", id, 0, 1, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, shop.PurchaseResult{}, result)
 
@@ -89,7 +89,7 @@ func TestProcessorMock_DefaultBehavior(t *testing.T) {
 	assert.NoError(t, m.SendMessageAndEmit(id, 1000, "hello"))
 	assert.NoError(t, m.RetrieveFrederickAndEmit(1000, 0))
 
-	result, err = m.PurchaseBundleAndEmit(2000, id, 0, 1, 0)
+	result, err = m.PurchaseBundleAndEmit(2000, "Buyer", id, 0, 1, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, shop.PurchaseResult{}, result)
 
@@ -108,7 +108,7 @@ func TestProcessorMock_CustomBehavior(t *testing.T) {
 		CloseShopFunc: func(shopId uuid.UUID, characterId uint32, reason shop.CloseReason) error {
 			return testErr
 		},
-		PurchaseBundleFunc: func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
+		PurchaseBundleFunc: func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (shop.PurchaseResult, error) {
 			return shop.PurchaseResult{TotalCost: 5000, Fee: 100}, nil
 		},
 	}
@@ -121,7 +121,7 @@ func TestProcessorMock_CustomBehavior(t *testing.T) {
 	err = m.CloseShop(mb)(uuid.New(), 1000, shop.CloseReasonManualClose)
 	assert.ErrorIs(t, err, testErr)
 
-	result, err := m.PurchaseBundle(mb)(2000, uuid.New(), 0, 1, 0)
+	result, err := m.PurchaseBundle(mb)(2000, "Buyer", uuid.New(), 0, 1, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(5000), result.TotalCost)
 	assert.Equal(t, int64(100), result.Fee)
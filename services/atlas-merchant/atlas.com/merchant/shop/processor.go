@@ -8,8 +8,11 @@ import (
 	character "atlas-merchant/kafka/message/character"
 	"atlas-merchant/kafka/message/compartment"
 	merchant "atlas-merchant/kafka/message/merchant"
+	note "atlas-merchant/kafka/message/note"
 	"atlas-merchant/listing"
 	msg "atlas-merchant/message"
+	"atlas-merchant/pricehistory"
+	"atlas-merchant/salereport"
 	"atlas-merchant/visit"
 	"atlas-merchant/visitor"
 	"context"
@@ -76,11 +79,13 @@ type Processor interface {
 	RemoveFromBlacklist(mb *message.Buffer) func(shopId uuid.UUID, characterId uint32, name string) error
 	GetBlacklistPaged(shopId uuid.UUID, page model.Page) (model.Paged[string], error)
 	GetVisitsPaged(shopId uuid.UUID, page model.Page) (model.Paged[visit.Model], error)
+	GetSaleReport(shopId uuid.UUID) (salereport.Report, error)
+	GetSaleReportsPaged(characterId uint32, page model.Page) (model.Paged[salereport.Report], error)
 	ExitShop(mb *message.Buffer) func(characterId uint32, shopId uuid.UUID) error
 	EjectAllVisitors(shopId uuid.UUID) ([]uint32, error)
 	GetVisitors(shopId uuid.UUID) ([]uint32, error)
 	GetShopForCharacter(characterId uint32) (uuid.UUID, error)
-	PurchaseBundle(mb *message.Buffer) func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error)
+	PurchaseBundle(mb *message.Buffer) func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error)
 	SendMessage(mb *message.Buffer) func(shopId uuid.UUID, characterId uint32, content string) error
 	RetrieveFrederick(mb *message.Buffer) func(characterId uint32, worldId world.Id) error
 	OpenShopAndEmit(shopId uuid.UUID, characterId uint32) error
@@ -93,7 +98,7 @@ type Processor interface {
 	ExitShopAndEmit(characterId uint32, shopId uuid.UUID) error
 	AddListingAndEmit(shopId uuid.UUID, characterId uint32, itemId uint32, itemType byte, bundleSize uint16, bundleCount uint16, pricePerBundle uint32, itemSnapshot asset2.AssetData, inventoryType byte, assetId uint32) (listing.Model, error)
 	RemoveListingAndEmit(shopId uuid.UUID, characterId uint32, listingIndex uint16) (listing.Model, error)
-	PurchaseBundleAndEmit(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error)
+	PurchaseBundleAndEmit(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error)
 	SendMessageAndEmit(shopId uuid.UUID, characterId uint32, content string) error
 	RetrieveFrederickAndEmit(characterId uint32, worldId world.Id) error
 }
@@ -570,6 +575,7 @@ func (p *ProcessorImpl) CloseShop(mb *message.Buffer) func(shopId uuid.UUID, cha
 		var mapId uint32
 		var shopType ShopType
 		var mesoBalance uint32
+		var closed Entity
 		err = database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 			e, err := getById(shopId)(tx)()
 			if err != nil {
@@ -598,6 +604,7 @@ func (p *ProcessorImpl) CloseShop(mb *message.Buffer) func(shopId uuid.UUID, cha
 			mapId = e.MapId
 			shopType = ShopType(e.ShopType)
 			mesoBalance = e.MesoBalance
+			closed = e
 			return nil
 		})
 		if err != nil {
@@ -617,6 +624,16 @@ func (p *ProcessorImpl) CloseShop(mb *message.Buffer) func(shopId uuid.UUID, cha
 		}
 		emitEjectionEvents(mb, visitors, shopId, leaveReason)
 
+		// Snapshot the unsold remainder for the sale report before Frederick
+		// storage or the inventory return takes custody of it.
+		unsold, err := p.GetListings(shopId)
+		if err != nil {
+			return err
+		}
+		if err := p.reportClose(mb, closed, unsold); err != nil {
+			return err
+		}
+
 		if shopType == HiredMerchant {
 			if err := p.storeToFrederick(shopId, characterId, mesoBalance); err != nil {
 				return err
@@ -633,6 +650,58 @@ func (p *ProcessorImpl) CloseShop(mb *message.Buffer) func(shopId uuid.UUID, cha
 	}
 }
 
+// reportClose records a closing shop's unsold remainder and delivers the
+// session's sale report to the owner as an atlas-notes note. A shop that
+// neither sold nor stocked anything (e.g. a Draft abandoned in setup) sends
+// no note.
+func (p *ProcessorImpl) reportClose(mb *message.Buffer, e Entity, unsold []listing.Model) error {
+	items := make([]salereport.Unsold, 0, len(unsold))
+	for _, l := range unsold {
+		items = append(items, salereport.Unsold{
+			ItemId:         l.ItemId(),
+			BundleSize:     l.BundleSize(),
+			Bundles:        l.BundlesRemaining(),
+			Quantity:       uint32(l.Quantity()),
+			PricePerBundle: l.PricePerBundle(),
+		})
+	}
+
+	sp := salereport.NewProcessor(p.l, p.ctx, p.db)
+	if err := sp.RecordUnsold(e.Id, items); err != nil {
+		p.l.WithError(err).Errorf("Error recording unsold remainder for shop [%s].", e.Id)
+		return err
+	}
+
+	m, err := Make(e)
+	if err != nil {
+		return err
+	}
+	r, err := sp.GetReport(ReportHeader(m))
+	if err != nil {
+		p.l.WithError(err).Errorf("Error building sale report for shop [%s].", e.Id)
+		return err
+	}
+	if r.IsEmpty() {
+		return nil
+	}
+	return mb.Put(note.EnvCommandTopic, salereport.CreateNoteCommandProvider(r))
+}
+
+// ReportHeader identifies a shop session for its sale report.
+func ReportHeader(m Model) salereport.Header {
+	return salereport.Header{
+		ShopId:            m.Id(),
+		CharacterId:       m.CharacterId(),
+		ShopType:          byte(m.ShopType()),
+		Title:             m.Title(),
+		WorldId:           m.WorldId(),
+		OpenedAt:          m.CreatedAt(),
+		ClosedAt:          m.ClosedAt(),
+		CloseReason:       byte(m.CloseReason()),
+		UnsoldAtFrederick: m.ShopType() == HiredMerchant,
+	}
+}
+
 // storeToFrederick persists unsold listing items and meso balance to
 // Frederick storage on shop close. Returns an error on the first failed
 // write so the caller (CloseShop, inside CloseShopAndEmit's outer tx) can
@@ -920,14 +989,15 @@ func (p *ProcessorImpl) OrganizeListings(mb *message.Buffer) func(shopId uuid.UU
 	}
 }
 
-func (p *ProcessorImpl) PurchaseBundle(mb *message.Buffer) func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
-	return func(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
+func (p *ProcessorImpl) PurchaseBundle(mb *message.Buffer) func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
+	return func(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
 		if bundleCount == 0 {
 			return PurchaseResult{}, errors.New("bundleCount must be at least 1")
 		}
 
 		var result PurchaseResult
 		var mapId uint32
+		var closed Entity
 		err := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 			e, err := getById(shopId)(tx)()
 			if err != nil {
@@ -978,6 +1048,35 @@ func (p *ProcessorImpl) PurchaseBundle(mb *message.Buffer) func(buyerCharacterId
 
 			result.BundlesRemaining = newBundlesRemaining
 
+			// Feed the price index in the same transaction so a rolled-back
+			// purchase never leaves a phantom sale behind.
+			err = pricehistory.NewProcessor(p.l, p.ctx, tx).RecordSale(pricehistory.Sale{
+				WorldId:     e.WorldId,
+				ItemId:      li.ItemId(),
+				Source:      pricehistory.SourceMerchant,
+				ReferenceId: uuid.New(),
+				Quantity:    uint32(li.BundleSize()) * uint32(bundleCount),
+				TotalPrice:  uint64(totalCost),
+			})
+			if err != nil {
+				return err
+			}
+
+			err = salereport.NewProcessor(p.l, p.ctx, tx).RecordSale(salereport.Sale{
+				ShopId:         shopId,
+				ItemId:         li.ItemId(),
+				BuyerId:        buyerCharacterId,
+				BuyerName:      buyerName,
+				BundleSize:     li.BundleSize(),
+				Bundles:        bundleCount,
+				PricePerBundle: li.PricePerBundle(),
+				TotalPrice:     totalCost,
+				Fee:            fee,
+			})
+			if err != nil {
+				return err
+			}
+
 			if newBundlesRemaining == 0 {
 				if err = lp.Delete(li.Id()); err != nil {
 					return err
@@ -1011,6 +1110,7 @@ func (p *ProcessorImpl) PurchaseBundle(mb *message.Buffer) func(buyerCharacterId
 					return err
 				}
 			}
+			closed = e
 
 			return nil
 		})
@@ -1025,6 +1125,9 @@ func (p *ProcessorImpl) PurchaseBundle(mb *message.Buffer) func(buyerCharacterId
 			soldOutVisitors, _ := p.GetVisitors(shopId)
 			p.EjectAllVisitors(shopId)
 			emitEjectionEvents(mb, soldOutVisitors, shopId, merchant.LeaveReasonOutOfStock)
+			if err := p.reportClose(mb, closed, nil); err != nil {
+				return result, err
+			}
 			p.l.Infof("Shop [%s] sold out and closed.", shopId)
 		}
 
@@ -1515,6 +1618,29 @@ func (p *ProcessorImpl) GetVisitsPaged(shopId uuid.UUID, page model.Page) (model
 	return visit.NewProcessor(p.l, p.ctx, p.db).ListPaged(shopId, page)
 }
 
+// GetSaleReport returns a shop session's sale report. An open shop yields a
+// live report (no closedAt, no unsold remainder yet).
+func (p *ProcessorImpl) GetSaleReport(shopId uuid.UUID) (salereport.Report, error) {
+	m, err := p.GetById(shopId)
+	if err != nil {
+		return salereport.Report{}, err
+	}
+	return salereport.NewProcessor(p.l, p.ctx, p.db).GetReport(ReportHeader(m))
+}
+
+// GetSaleReportsPaged returns one sale report per shop session the character
+// has run, paged over the character's shops.
+func (p *ProcessorImpl) GetSaleReportsPaged(characterId uint32, page model.Page) (model.Paged[salereport.Report], error) {
+	shops, err := p.GetByCharacterIdPaged(characterId, page)
+	if err != nil {
+		return model.Paged[salereport.Report]{}, err
+	}
+	sp := salereport.NewProcessor(p.l, p.ctx, p.db)
+	return model.MapPaged(func(m Model) (salereport.Report, error) {
+		return sp.GetReport(ReportHeader(m))
+	})(model.FixedProvider(shops))()()
+}
+
 func (p *ProcessorImpl) AddToBlacklistAndEmit(shopId uuid.UUID, characterId uint32, name string, bannedCharacterId uint32) error {
 	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
@@ -1564,12 +1690,12 @@ func (p *ProcessorImpl) RemoveListingAndEmit(shopId uuid.UUID, characterId uint3
 	return result, txErr
 }
 
-func (p *ProcessorImpl) PurchaseBundleAndEmit(buyerCharacterId uint32, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
+func (p *ProcessorImpl) PurchaseBundleAndEmit(buyerCharacterId uint32, buyerName string, shopId uuid.UUID, listingIndex uint16, bundleCount uint16, worldId world.Id) (PurchaseResult, error) {
 	var result PurchaseResult
 	txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(buf *message.Buffer) error {
 			var err error
-			result, err = p.WithTransaction(tx).PurchaseBundle(buf)(buyerCharacterId, shopId, listingIndex, bundleCount, worldId)
+			result, err = p.WithTransaction(tx).PurchaseBundle(buf)(buyerCharacterId, buyerName, shopId, listingIndex, bundleCount, worldId)
 			return err
 		})
 	})
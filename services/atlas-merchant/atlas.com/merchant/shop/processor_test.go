@@ -6,9 +6,13 @@ import (
 	asset2 "atlas-merchant/kafka/message/asset"
 	compartment "atlas-merchant/kafka/message/compartment"
 	merchantmsg "atlas-merchant/kafka/message/merchant"
+	note "atlas-merchant/kafka/message/note"
 	"atlas-merchant/listing"
+	"atlas-merchant/pricehistory"
+	"atlas-merchant/salereport"
 	"atlas-merchant/visitor"
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -41,6 +45,8 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	require.NoError(t, Migration(db))
 	require.NoError(t, listing.Migration(db))
 	require.NoError(t, frederick.Migration(db))
+	require.NoError(t, pricehistory.Migration(db))
+	require.NoError(t, salereport.Migration(db))
 	return db
 }
 
@@ -182,6 +188,90 @@ func TestCloseShop_Disconnect_ReturnsPersonalShopItems(t *testing.T) {
 		"disconnect close of a personal shop must return unsold items to the owner")
 }
 
+func decodeNoteCommand(t *testing.T, mb *message.Buffer) note.Command[note.CommandCreateBody] {
+	t.Helper()
+	msgs := mb.GetAll()[note.EnvCommandTopic]
+	require.Len(t, msgs, 1)
+	var c note.Command[note.CommandCreateBody]
+	require.NoError(t, json.Unmarshal(msgs[0].Value, &c))
+	return c
+}
+
+// Closing a shop mails the owner a sale report covering who bought what and
+// what was left on the shelf.
+func TestCloseShop_SendsSaleReportNote(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, _ := setupTestContext(t)
+	l, _ := test.NewNullLogger()
+	p := NewProcessor(l, ctx, db)
+	mb := testBuffer()
+
+	m, err := p.CreateShop(1000, CharacterShop, "Test Shop", 0, 0, 910000001, uuid.Nil, 0, 0, 0)
+	require.NoError(t, err)
+	_, err = p.AddListing(mb)(m.Id(), 1000, 2000000, 0, 1, 10, 1000, asset2.AssetData{}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
+	_, err = p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 4, 0)
+	require.NoError(t, err)
+
+	cmb := testBuffer()
+	require.NoError(t, p.CloseShop(cmb)(m.Id(), 1000, CloseReasonManualClose))
+
+	c := decodeNoteCommand(t, cmb)
+	assert.Equal(t, note.CommandTypeCreate, c.Type)
+	assert.Equal(t, uint32(1000), c.CharacterId)
+	assert.Equal(t, uint32(1000), c.Body.SenderId)
+	assert.Contains(t, c.Body.Message, "Sold 4 item(s) in 1 sale(s) for 4000 mesos")
+	assert.Contains(t, c.Body.Message, "Buyers: Buyer.")
+	assert.Contains(t, c.Body.Message, "6 unsold item(s) were returned to your inventory.")
+
+	r, err := p.GetSaleReport(m.Id())
+	require.NoError(t, err)
+	require.Len(t, r.Sold(), 1)
+	assert.Equal(t, uint32(2000), r.Sold()[0].BuyerId())
+	assert.Equal(t, "Buyer", r.Sold()[0].BuyerName())
+	require.Len(t, r.Unsold(), 1)
+	assert.Equal(t, uint32(6), r.QuantityUnsold())
+	assert.NotNil(t, r.Header().ClosedAt)
+}
+
+func TestPurchaseBundle_SoldOut_SendsSaleReportNote(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, _ := setupTestContext(t)
+	l, _ := test.NewNullLogger()
+	p := NewProcessor(l, ctx, db)
+	mb := testBuffer()
+
+	m, err := p.CreateShop(1000, CharacterShop, "Test Shop", 0, 0, 910000001, uuid.Nil, 0, 0, 0)
+	require.NoError(t, err)
+	_, err = p.AddListing(mb)(m.Id(), 1000, 2000000, 0, 1, 5, 1000, asset2.AssetData{}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
+
+	pmb := testBuffer()
+	_, err = p.PurchaseBundle(pmb)(2000, "Buyer", m.Id(), 0, 5, 0)
+	require.NoError(t, err)
+
+	c := decodeNoteCommand(t, pmb)
+	assert.Contains(t, c.Body.Message, "Sold 5 item(s) in 1 sale(s) for 5000 mesos")
+	assert.NotContains(t, c.Body.Message, "unsold")
+}
+
+// A shop that never traded has nothing to report, so no note is sent.
+func TestCloseShop_FromDraft_NoSaleReportNote(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, _ := setupTestContext(t)
+	l, _ := test.NewNullLogger()
+	p := NewProcessor(l, ctx, db)
+	mb := testBuffer()
+
+	m, err := p.CreateShop(1000, CharacterShop, "Test Shop", 0, 0, 910000001, uuid.Nil, 0, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, p.CloseShop(mb)(m.Id(), 1000, CloseReasonManualClose))
+
+	assert.Empty(t, mb.GetAll()[note.EnvCommandTopic])
+}
+
 func TestCloseShop_InvalidState(t *testing.T) {
 	db := setupTestDB(t)
 	ctx, _ := setupTestContext(t)
@@ -263,7 +353,7 @@ func TestPurchaseBundle(t *testing.T) {
 	err = p.OpenShop(mb)(m.Id(), 1000)
 	require.NoError(t, err)
 
-	result, err := p.PurchaseBundle(mb)(2000, m.Id(), 0, 3, 0)
+	result, err := p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 3, 0)
 	require.NoError(t, err)
 	assert.Equal(t, uint16(3), result.BundlesPurchased)
 	assert.Equal(t, uint16(7), result.BundlesRemaining)
@@ -271,6 +361,32 @@ func TestPurchaseBundle(t *testing.T) {
 	assert.False(t, result.ShopClosed)
 }
 
+func TestPurchaseBundle_RecordsPriceHistory(t *testing.T) {
+	db := setupTestDB(t)
+	ctx, _ := setupTestContext(t)
+	l, _ := test.NewNullLogger()
+	p := NewProcessor(l, ctx, db)
+	mb := testBuffer()
+
+	m, err := p.CreateShop(1000, CharacterShop, "Test Shop", 0, 0, 910000001, uuid.Nil, 0, 0, 0)
+	require.NoError(t, err)
+
+	snapshot := asset2.AssetData{}
+	_, err = p.AddListing(mb)(m.Id(), 1000, 2060000, 0, 100, 10, 500, snapshot, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
+
+	_, err = p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 2, 0)
+	require.NoError(t, err)
+
+	sales, err := pricehistory.NewProcessor(l, ctx, db).GetSales(0, 2060000, pricehistory.SourceMerchant, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, sales, 1)
+	assert.Equal(t, uint32(200), sales[0].Quantity())
+	assert.Equal(t, uint64(1000), sales[0].TotalPrice())
+	assert.Equal(t, 5.0, sales[0].UnitPrice())
+}
+
 func TestPurchaseBundle_SoldOut(t *testing.T) {
 	db := setupTestDB(t)
 	ctx, _ := setupTestContext(t)
@@ -288,7 +404,7 @@ func TestPurchaseBundle_SoldOut(t *testing.T) {
 	err = p.OpenShop(mb)(m.Id(), 1000)
 	require.NoError(t, err)
 
-	result, err := p.PurchaseBundle(mb)(2000, m.Id(), 0, 5, 0)
+	result, err := p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 5, 0)
 	require.NoError(t, err)
 	assert.Equal(t, uint16(0), result.BundlesRemaining)
 	assert.True(t, result.ShopClosed)
@@ -316,7 +432,7 @@ func TestPurchaseBundle_InsufficientBundles(t *testing.T) {
 	err = p.OpenShop(mb)(m.Id(), 1000)
 	require.NoError(t, err)
 
-	_, err = p.PurchaseBundle(mb)(2000, m.Id(), 0, 10, 0)
+	_, err = p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 10, 0)
 	assert.ErrorIs(t, err, ErrInsufficientBundles)
 }
 
@@ -584,7 +700,7 @@ func TestHiredMerchant_MesoAccumulation(t *testing.T) {
 	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
 
 	// Purchase 3 bundles at 1000 each = 3000 total. Below 100k so no fee.
-	result, err := p.PurchaseBundle(mb)(2000, m.Id(), 0, 3, 0)
+	result, err := p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 3, 0)
 	require.NoError(t, err)
 	assert.Equal(t, int64(3000), result.TotalCost)
 	assert.Equal(t, int64(0), result.Fee)
@@ -623,7 +739,7 @@ func TestCharacterShop_NoMesoAccumulation(t *testing.T) {
 
 	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
 
-	_, err = p.PurchaseBundle(mb)(2000, m.Id(), 0, 3, 0)
+	_, err = p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 3, 0)
 	require.NoError(t, err)
 
 	// Character shop should not accumulate meso balance.
@@ -648,7 +764,7 @@ func TestPurchaseBundle_ZeroBundleCount(t *testing.T) {
 
 	require.NoError(t, p.OpenShop(mb)(m.Id(), 1000))
 
-	_, err = p.PurchaseBundle(mb)(2000, m.Id(), 0, 0, 0)
+	_, err = p.PurchaseBundle(mb)(2000, "Buyer", m.Id(), 0, 0, 0)
 	assert.Error(t, err)
 }
 
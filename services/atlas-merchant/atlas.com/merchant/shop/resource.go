@@ -4,6 +4,7 @@ import (
 	"atlas-merchant/listing"
 	msg "atlas-merchant/message"
 	"atlas-merchant/rest"
+	"atlas-merchant/salereport"
 	"atlas-merchant/searchcount"
 	"errors"
 	"net/http"
@@ -35,9 +36,11 @@ func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.Rou
 			r.HandleFunc("/relationships/listings", registerHandler("get_merchant_listings", handleGetMerchantListings(db))).Methods(http.MethodGet)
 			r.HandleFunc("/blacklist", registerHandler("get_merchant_blacklist", handleGetMerchantBlacklist(db))).Methods(http.MethodGet)
 			r.HandleFunc("/visits", registerHandler("get_merchant_visits", handleGetMerchantVisits(db))).Methods(http.MethodGet)
+			r.HandleFunc("/sale-report", registerHandler("get_merchant_sale_report", handleGetMerchantSaleReport(db))).Methods(http.MethodGet)
 
 			cr := router.PathPrefix("/characters/{characterId}").Subrouter()
 			cr.HandleFunc("/merchants", registerHandler("get_character_merchants", handleGetCharacterMerchants(db))).Methods(http.MethodGet)
+			cr.HandleFunc("/merchants/sale-reports", registerHandler("get_character_sale_reports", handleGetCharacterSaleReports(db))).Methods(http.MethodGet)
 			cr.HandleFunc("/visiting", registerHandler("get_character_visiting", handleGetCharacterVisiting(db))).Methods(http.MethodGet)
 
 			wr := router.PathPrefix("/worlds/{worldId}").Subrouter()
@@ -476,3 +479,65 @@ func handleGetTopShopSearches(db *gorm.DB) rest.GetHandler {
 		})
 	}
 }
+
+func handleGetMerchantSaleReport(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseShopId(d.Logger(), func(shopId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				rep, err := NewProcessor(d.Logger(), d.Context(), db).GetSaleReport(shopId)
+				if err != nil {
+					if errors.Is(err, ErrNotFound) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					d.Logger().WithError(err).Errorf("Retrieving sale report.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				res, err := salereport.Transform(rep)
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Creating REST model.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[salereport.RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res)
+			}
+		})
+	}
+}
+
+func handleGetCharacterSaleReports(db *gorm.DB) rest.GetHandler {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				page, err := paginate.ParseParams(r.URL.Query(), paginate.MaxPageSize, paginate.MaxPageSize)
+				if err != nil {
+					server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+					return
+				}
+
+				reports, err := NewProcessor(d.Logger(), d.Context(), db).GetSaleReportsPaged(characterId, page)
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Retrieving sale reports for character.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				paged, err := model.MapPaged(salereport.Transform)(model.FixedProvider(reports))(model.ParallelMap())()
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Creating REST models.")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalPaginatedResponse[[]salereport.RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(paged.Items, paginate.EnvelopeFor(paged), r)
+			}
+		})
+	}
+}
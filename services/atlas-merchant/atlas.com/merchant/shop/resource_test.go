@@ -4,6 +4,8 @@ import (
 	"atlas-merchant/frederick"
 	"atlas-merchant/kafka/message/asset"
 	"atlas-merchant/listing"
+	"atlas-merchant/pricehistory"
+	"atlas-merchant/salereport"
 	"atlas-merchant/shop"
 	"context"
 	"encoding/json"
@@ -608,3 +610,58 @@ func TestGetTopShopSearchesPaginates(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+// TestGetSaleReports drives GET /merchants/{shopId}/sale-report and the
+// character-scoped paged GET /characters/{characterId}/merchants/sale-reports.
+func TestGetSaleReports(t *testing.T) {
+	db := databasetest.NewInMemoryTenantDB(t, shop.Migration, listing.Migration, frederick.Migration, pricehistory.Migration, salereport.Migration)
+	tenantId := uuid.New()
+	ctx := merchantTestContext(t, tenantId)
+
+	m := seedOpenShop(t, db, ctx, 2001, 910000001, 2000000, 1, "report shop")
+	l, _ := test.NewNullLogger()
+	_, err := shop.NewProcessor(l, ctx, db).PurchaseBundle(shopTestBuffer())(3001, "Buyer", m.Id(), 0, 3, 0)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(setupMerchantRouter(db))
+	defer srv.Close()
+
+	t.Run("ShopReport", func(t *testing.T) {
+		url := fmt.Sprintf("%s/merchants/%s/sale-report", srv.URL, m.Id())
+		resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, url, tenantId))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var doc jsonapi.Document
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+		require.NotNil(t, doc.Data.DataObject)
+		var attrs salereport.RestModel
+		require.NoError(t, json.Unmarshal(doc.Data.DataObject.Attributes, &attrs))
+		assert.Equal(t, 1, attrs.SaleCount)
+		assert.EqualValues(t, 3000, attrs.Gross)
+		require.Len(t, attrs.Sales, 1)
+		assert.Equal(t, "Buyer", attrs.Sales[0].BuyerName)
+	})
+
+	t.Run("UnknownShopIsNotFound", func(t *testing.T) {
+		url := fmt.Sprintf("%s/merchants/%s/sale-report", srv.URL, uuid.New())
+		resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, url, tenantId))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("CharacterReportsPaginate", func(t *testing.T) {
+		url := fmt.Sprintf("%s/characters/2001/merchants/sale-reports", srv.URL)
+		resp, err := (&http.Client{}).Do(requestWithTenant(http.MethodGet, url, tenantId))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var doc jsonapi.Document
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+		assert.Len(t, doc.Data.DataArray, 1)
+		assert.EqualValues(t, 1, doc.Meta["total"])
+	})
+}
@@ -65,7 +65,9 @@ func handleStatusEventLogin(l logrus.FieldLogger, ctx context.Context, e message
 	err := character.Login(l)(ctx)(e.TransactionId, f, e.CharacterId)
 	if err != nil {
 		l.WithError(err).Errorf("Unable to process login for character [%d].", e.CharacterId)
+		return
 	}
+	_, _ = messenger.NewProcessor(l, ctx).RejoinAndEmit(messenger.RejoinInput{TransactionID: e.TransactionId, CharacterId: e.CharacterId})
 }
 
 func handleStatusEventLogout(l logrus.FieldLogger, ctx context.Context, e messageCharacter.StatusEvent[messageCharacter.StatusEventLogoutBody]) {
@@ -75,15 +77,10 @@ func handleStatusEventLogout(l logrus.FieldLogger, ctx context.Context, e messag
 	err := character.Logout(l)(ctx)(e.TransactionId, e.CharacterId)
 	if err != nil {
 		l.WithError(err).Errorf("Unable to process logout for character [%d].", e.CharacterId)
-	}
-	m, err := model.FirstProvider(messenger.AllProvider(ctx), model.Filters(messenger.MemberFilter(e.CharacterId)))()
-	if err != nil {
-		return
-	}
-	_, err = messenger.Leave(l)(ctx)(e.TransactionId, m.Id(), e.CharacterId)
-	if err != nil {
 		return
 	}
+	// Rooms outlive a logout; the seat is held for the rejoin window.
+	_, _ = messenger.NewProcessor(l, ctx).DisconnectAndEmit(messenger.DisconnectInput{TransactionID: e.TransactionId, CharacterId: e.CharacterId})
 }
 
 func handleStatusEventChannelChanged(l logrus.FieldLogger, ctx context.Context, e messageCharacter.StatusEvent[messageCharacter.StatusEventChannelChangedBody]) {
@@ -93,5 +90,7 @@ func handleStatusEventChannelChanged(l logrus.FieldLogger, ctx context.Context,
 	err := character.ChannelChange(l)(ctx)(e.CharacterId, e.Body.ChannelId)
 	if err != nil {
 		l.WithError(err).Errorf("Unable to process channel changed for character [%d].", e.CharacterId)
+		return
 	}
+	_, _ = messenger.NewProcessor(l, ctx).RejoinAndEmit(messenger.RejoinInput{TransactionID: e.TransactionId, CharacterId: e.CharacterId})
 }
@@ -0,0 +1,45 @@
+package chat
+
+import (
+	consumer2 "atlas-messengers/kafka/consumer"
+	messageChat "atlas-messengers/kafka/message/chat"
+	"atlas-messengers/messenger"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("character_chat_event")(messageChat.EnvEventTopicChat)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		var t string
+		t, _ = topic.EnvProvider(l)(messageChat.EnvEventTopicChat)()
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleMessengerChat))); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func handleMessengerChat(l logrus.FieldLogger, ctx context.Context, e messageChat.ChatEvent[messageChat.MessengerChatBody]) {
+	if e.Type != messageChat.ChatTypeMessenger {
+		return
+	}
+	err := messenger.NewProcessor(l, ctx).RecordChat(e.ActorId, e.Message)
+	if err != nil {
+		l.WithError(err).Debugf("Unable to record messenger chat from character [%d].", e.ActorId)
+	}
+}
@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventTopicChat = "EVENT_TOPIC_CHARACTER_CHAT"
+	ChatTypeMessenger = "MESSENGER"
+)
+
+type ChatEvent[E any] struct {
+	WorldId   world.Id   `json:"worldId"`
+	ChannelId channel.Id `json:"channelId"`
+	MapId     _map.Id    `json:"mapId"`
+	Instance  uuid.UUID  `json:"instance"`
+	ActorId   uint32     `json:"actorId"`
+	Message   string     `json:"message"`
+	Type      string     `json:"type"`
+	Body      E          `json:"body"`
+}
+
+type MessengerChatBody struct {
+	Recipients []uint32 `json:"recipients"`
+}
@@ -13,11 +13,13 @@ const (
 	CommandMessengerLeave         = "LEAVE"
 	CommandMessengerRequestInvite = "REQUEST_INVITE"
 
-	EnvEventStatusTopic             = "EVENT_TOPIC_MESSENGER_STATUS"
-	EventMessengerStatusTypeCreated = "CREATED"
-	EventMessengerStatusTypeJoined  = "JOINED"
-	EventMessengerStatusTypeLeft    = "LEFT"
-	EventMessengerStatusTypeError   = "ERROR"
+	EnvEventStatusTopic                  = "EVENT_TOPIC_MESSENGER_STATUS"
+	EventMessengerStatusTypeCreated      = "CREATED"
+	EventMessengerStatusTypeJoined       = "JOINED"
+	EventMessengerStatusTypeLeft         = "LEFT"
+	EventMessengerStatusTypeDisconnected = "DISCONNECTED"
+	EventMessengerStatusTypeRejoined     = "REJOINED"
+	EventMessengerStatusTypeError        = "ERROR"
 
 	EventMessengerStatusErrorUnexpected                 = "ERROR_UNEXPECTED"
 	EventMessengerStatusErrorTypeAlreadyJoined1         = "ALREADY_HAVE_JOINED_A_MESSENGER_1"
@@ -77,6 +79,15 @@ type LeftEventBody struct {
 	Slot byte `json:"slot"`
 }
 
+type DisconnectedEventBody struct {
+	Slot byte `json:"slot"`
+}
+
+type RejoinedEventBody struct {
+	Slot        byte `json:"slot"`
+	Reconnected bool `json:"reconnected"`
+}
+
 type ErrorEventBody struct {
 	Type          string `json:"type"`
 	CharacterName string `json:"characterName"`
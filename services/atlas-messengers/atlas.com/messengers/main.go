@@ -3,6 +3,7 @@ package main
 import (
 	character2 "atlas-messengers/character"
 	"atlas-messengers/kafka/consumer/character"
+	"atlas-messengers/kafka/consumer/chat"
 	"atlas-messengers/kafka/consumer/invite"
 	messenger2 "atlas-messengers/kafka/consumer/messenger"
 	"atlas-messengers/messenger"
@@ -54,6 +55,7 @@ func main() {
 	messenger2.InitConsumers(l)(cmf)(consumerGroupId)
 	character.InitConsumers(l)(cmf)(consumerGroupId)
 	invite.InitConsumers(l)(cmf)(consumerGroupId)
+	chat.InitConsumers(l)(cmf)(consumerGroupId)
 	if err := messenger2.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
@@ -63,6 +65,9 @@ func main() {
 	if err := invite.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := chat.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -2,6 +2,7 @@ package messenger
 
 import (
 	"context"
+	"time"
 )
 
 func CreateMessenger(ctx context.Context) func(characterId uint32) Model {
@@ -21,3 +22,9 @@ func DeleteMessenger(ctx context.Context) func(messengerId uint32) {
 		GetRegistry().Remove(ctx, messengerId)
 	}
 }
+
+func AppendTranscriptLine(ctx context.Context) func(messengerId uint32, characterId uint32, message string, sentAt time.Time) (TranscriptLine, error) {
+	return func(messengerId uint32, characterId uint32, message string, sentAt time.Time) (TranscriptLine, error) {
+		return GetRegistry().AppendTranscript(ctx, messengerId, characterId, message, sentAt)
+	}
+}
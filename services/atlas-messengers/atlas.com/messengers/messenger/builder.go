@@ -1,11 +1,17 @@
 package messenger
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 const MaxMembers = 3
 
+// RejoinWindow is how long a disconnected member keeps their slot, and how
+// long a room with no connected members survives, before being evicted.
+const RejoinWindow = 30 * time.Minute
+
 type builder struct {
 	tenantId uuid.UUID
 	id       uint32
@@ -17,8 +17,12 @@ type ProcessorMock struct {
 	LeaveAndEmitFunc         func(input messenger.LeaveInput) (messenger.Model, error)
 	RequestInviteFunc        func(transactionID uuid.UUID, actorId uint32, characterId uint32) error
 	RequestInviteAndEmitFunc func(input messenger.RequestInviteInput) error
+	DisconnectAndEmitFunc    func(input messenger.DisconnectInput) (messenger.Model, error)
+	RejoinAndEmitFunc        func(input messenger.RejoinInput) (messenger.Model, error)
+	RecordChatFunc           func(characterId uint32, msg string) error
 	GetByIdFunc              func(messengerId uint32) (messenger.Model, error)
 	GetSliceFunc             func(filters ...model.Filter[messenger.Model]) ([]messenger.Model, error)
+	GetTranscriptFunc        func(messengerId uint32) ([]messenger.TranscriptLine, error)
 }
 
 var _ messenger.Processor = (*ProcessorMock)(nil)
@@ -79,6 +83,27 @@ func (m *ProcessorMock) RequestInviteAndEmit(input messenger.RequestInviteInput)
 	return nil
 }
 
+func (m *ProcessorMock) DisconnectAndEmit(input messenger.DisconnectInput) (messenger.Model, error) {
+	if m.DisconnectAndEmitFunc != nil {
+		return m.DisconnectAndEmitFunc(input)
+	}
+	return messenger.Model{}, nil
+}
+
+func (m *ProcessorMock) RejoinAndEmit(input messenger.RejoinInput) (messenger.Model, error) {
+	if m.RejoinAndEmitFunc != nil {
+		return m.RejoinAndEmitFunc(input)
+	}
+	return messenger.Model{}, nil
+}
+
+func (m *ProcessorMock) RecordChat(characterId uint32, msg string) error {
+	if m.RecordChatFunc != nil {
+		return m.RecordChatFunc(characterId, msg)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) GetById(messengerId uint32) (messenger.Model, error) {
 	if m.GetByIdFunc != nil {
 		return m.GetByIdFunc(messengerId)
@@ -92,3 +117,10 @@ func (m *ProcessorMock) GetSlice(filters ...model.Filter[messenger.Model]) ([]me
 	}
 	return nil, nil
 }
+
+func (m *ProcessorMock) GetTranscript(messengerId uint32) ([]messenger.TranscriptLine, error) {
+	if m.GetTranscriptFunc != nil {
+		return m.GetTranscriptFunc(messengerId)
+	}
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package messenger
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,8 +14,9 @@ type Model struct {
 }
 
 type MemberModel struct {
-	id   uint32
-	slot byte
+	id             uint32
+	slot           byte
+	disconnectedAt time.Time
 }
 
 func (m MemberModel) Id() uint32 {
@@ -25,6 +27,22 @@ func (m MemberModel) Slot() byte {
 	return m.slot
 }
 
+func (m MemberModel) DisconnectedAt() time.Time {
+	return m.disconnectedAt
+}
+
+// Connected reports whether the member currently has a live session. A
+// disconnected member keeps their slot until the rejoin window lapses.
+func (m MemberModel) Connected() bool {
+	return m.disconnectedAt.IsZero()
+}
+
+// Stale reports whether a disconnected member has been away longer than the
+// rejoin window and may be evicted from the room.
+func (m MemberModel) Stale(now time.Time) bool {
+	return !m.Connected() && now.Sub(m.disconnectedAt) >= RejoinWindow
+}
+
 func (m Model) FirstOpenSlot() byte {
 	usedSlots := make(map[byte]bool)
 
@@ -63,6 +81,56 @@ func (m Model) RemoveMember(memberId uint32) Model {
 	}
 }
 
+func (m Model) updateMember(memberId uint32, fn func(mm MemberModel) MemberModel) Model {
+	ms := make([]MemberModel, 0, len(m.members))
+	for _, mm := range m.members {
+		if mm.Id() == memberId {
+			mm = fn(mm)
+		}
+		ms = append(ms, mm)
+	}
+	return Model{
+		tenantId: m.tenantId,
+		id:       m.id,
+		members:  ms,
+	}
+}
+
+func (m Model) DisconnectMember(memberId uint32, at time.Time) Model {
+	return m.updateMember(memberId, func(mm MemberModel) MemberModel {
+		mm.disconnectedAt = at
+		return mm
+	})
+}
+
+func (m Model) ReconnectMember(memberId uint32) Model {
+	return m.updateMember(memberId, func(mm MemberModel) MemberModel {
+		mm.disconnectedAt = time.Time{}
+		return mm
+	})
+}
+
+// AllDisconnected reports whether no member of the room holds a live session.
+func (m Model) AllDisconnected() bool {
+	for _, mm := range m.members {
+		if mm.Connected() {
+			return false
+		}
+	}
+	return true
+}
+
+// StaleMembers returns the members whose rejoin window has lapsed.
+func (m Model) StaleMembers(now time.Time) []MemberModel {
+	results := make([]MemberModel, 0)
+	for _, mm := range m.members {
+		if mm.Stale(now) {
+			results = append(results, mm)
+		}
+	}
+	return results
+}
+
 func (m Model) Id() uint32 {
 	return m.id
 }
@@ -2,13 +2,15 @@ package messenger
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type memberModelJSON struct {
-	Id   uint32 `json:"id"`
-	Slot byte   `json:"slot"`
+	Id             uint32    `json:"id"`
+	Slot           byte      `json:"slot"`
+	DisconnectedAt time.Time `json:"disconnectedAt"`
 }
 
 type modelJSON struct {
@@ -20,7 +22,7 @@ type modelJSON struct {
 func (m Model) MarshalJSON() ([]byte, error) {
 	members := make([]memberModelJSON, len(m.members))
 	for i, mm := range m.members {
-		members[i] = memberModelJSON{Id: mm.id, Slot: mm.slot}
+		members[i] = memberModelJSON{Id: mm.id, Slot: mm.slot, DisconnectedAt: mm.disconnectedAt}
 	}
 	return json.Marshal(&modelJSON{
 		TenantId: m.tenantId,
@@ -38,7 +40,7 @@ func (m *Model) UnmarshalJSON(data []byte) error {
 	m.id = aux.Id
 	m.members = make([]MemberModel, len(aux.Members))
 	for i, mm := range aux.Members {
-		m.members[i] = MemberModel{id: mm.Id, slot: mm.Slot}
+		m.members[i] = MemberModel{id: mm.Id, slot: mm.Slot, disconnectedAt: mm.DisconnectedAt}
 	}
 	return nil
 }
@@ -0,0 +1,54 @@
+package messenger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelDisconnectAndReconnectMember(t *testing.T) {
+	m, _ := NewBuilder().SetId(1).AddMember(100, 0).AddMember(200, 1).Build()
+	at := time.Now()
+
+	m = m.DisconnectMember(100, at)
+	mm, err := m.FindMember(100)
+	assert.NoError(t, err)
+	assert.False(t, mm.Connected())
+	assert.Equal(t, byte(0), mm.Slot())
+	assert.False(t, m.AllDisconnected())
+
+	m = m.DisconnectMember(200, at)
+	assert.True(t, m.AllDisconnected())
+
+	m = m.ReconnectMember(100)
+	mm, _ = m.FindMember(100)
+	assert.True(t, mm.Connected())
+	assert.False(t, m.AllDisconnected())
+}
+
+func TestModelStaleMembers(t *testing.T) {
+	m, _ := NewBuilder().SetId(1).AddMember(100, 0).AddMember(200, 1).AddMember(300, 2).Build()
+	now := time.Now()
+
+	m = m.DisconnectMember(100, now.Add(-RejoinWindow-time.Minute))
+	m = m.DisconnectMember(200, now.Add(-time.Minute))
+
+	stale := m.StaleMembers(now)
+	assert.Len(t, stale, 1)
+	assert.Equal(t, uint32(100), stale[0].Id())
+}
+
+func TestModelJSONRoundTripKeepsDisconnect(t *testing.T) {
+	m, _ := NewBuilder().SetId(1).AddMember(100, 0).Build()
+	at := time.Now().UTC().Truncate(time.Second)
+	m = m.DisconnectMember(100, at)
+
+	data, err := m.MarshalJSON()
+	assert.NoError(t, err)
+
+	var out Model
+	assert.NoError(t, out.UnmarshalJSON(data))
+	mm, _ := out.FindMember(100)
+	assert.True(t, mm.DisconnectedAt().Equal(at))
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/uuid"
 	goredis "github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
@@ -220,7 +221,8 @@ func Join(l logrus.FieldLogger) func(ctx context.Context) func(transactionID uui
 				return Model{}, err
 			}
 
-			if len(p.Members()) >= 3 {
+			p = evictStale(l, ctx, inlineEmitter(l, ctx), transactionID, p)
+			if len(p.Members()) >= MaxMembers {
 				l.Errorf("Messenger [%d] already at capacity.", messengerId)
 				err = producer.ProviderImpl(l)(ctx)(messenger.EnvEventStatusTopic)(errorEventProvider(transactionID, characterId, messengerId, c.WorldId(), messenger.EventMessengerStatusErrorTypeAtCapacity, ""))
 				if err != nil {
@@ -398,7 +400,8 @@ func RequestInvite(l logrus.FieldLogger) func(ctx context.Context) func(transact
 				}
 			}
 
-			if len(p.Members()) >= 3 {
+			p = evictStale(l, ctx, inlineEmitter(l, ctx), transactionID, p)
+			if len(p.Members()) >= MaxMembers {
 				l.Errorf("Messenger [%d] already at capacity.", p.Id())
 				err = producer.ProviderImpl(l)(ctx)(messenger.EnvEventStatusTopic)(errorEventProvider(transactionID, actorId, p.Id(), c.WorldId(), messenger.EventMessengerStatusErrorTypeAtCapacity, ""))
 				if err != nil {
@@ -407,7 +410,7 @@ func RequestInvite(l logrus.FieldLogger) func(ctx context.Context) func(transact
 				return ErrAtCapacity
 			}
 
-			err = invite.NewProcessor(l, ctx).Create(transactionID, actorId, a.WorldId(), p.Id(), characterId)
+			err = invite.NewProcessor(l, ctx).Create(transactionID, actorId, c.WorldId(), p.Id(), characterId)
 			if err != nil {
 				l.WithError(err).Errorf("Unable to announce messenger [%d] invite.", p.Id())
 				err = producer.ProviderImpl(l)(ctx)(messenger.EnvEventStatusTopic)(errorEventProvider(transactionID, actorId, a.MessengerId(), c.WorldId(), messenger.EventMessengerStatusErrorUnexpected, ""))
@@ -437,8 +440,12 @@ type Processor interface {
 	LeaveAndEmit(input LeaveInput) (Model, error)
 	RequestInvite(transactionID uuid.UUID, actorId uint32, characterId uint32) error
 	RequestInviteAndEmit(input RequestInviteInput) error
+	DisconnectAndEmit(input DisconnectInput) (Model, error)
+	RejoinAndEmit(input RejoinInput) (Model, error)
+	RecordChat(characterId uint32, msg string) error
 	GetById(messengerId uint32) (Model, error)
 	GetSlice(filters ...model.Filter[Model]) ([]Model, error)
+	GetTranscript(messengerId uint32) ([]TranscriptLine, error)
 }
 
 // ProcessorImpl provides struct-based processor methods for messenger operations.
@@ -499,6 +506,21 @@ func (p *ProcessorImpl) RequestInviteAndEmit(input RequestInviteInput) error {
 	return RequestInviteAndEmit(p.l)(p.ctx)(input)
 }
 
+// DisconnectAndEmit keeps a member's seat while their session is gone and emits events via buffer pattern.
+func (p *ProcessorImpl) DisconnectAndEmit(input DisconnectInput) (Model, error) {
+	return DisconnectAndEmit(p.l)(p.ctx)(input)
+}
+
+// RejoinAndEmit restores a member's seat and emits events via buffer pattern.
+func (p *ProcessorImpl) RejoinAndEmit(input RejoinInput) (Model, error) {
+	return RejoinAndEmit(p.l)(p.ctx)(input)
+}
+
+// RecordChat appends a chat line to the sender's messenger transcript.
+func (p *ProcessorImpl) RecordChat(characterId uint32, msg string) error {
+	return RecordChat(p.l)(p.ctx)(characterId, msg)
+}
+
 // GetById retrieves a messenger by its ID.
 func (p *ProcessorImpl) GetById(messengerId uint32) (Model, error) {
 	return GetById(p.ctx)(messengerId)
@@ -509,6 +531,11 @@ func (p *ProcessorImpl) GetSlice(filters ...model.Filter[Model]) ([]Model, error
 	return GetSlice(p.ctx)(filters...)
 }
 
+// GetTranscript retrieves the retained chat lines of a messenger.
+func (p *ProcessorImpl) GetTranscript(messengerId uint32) ([]TranscriptLine, error) {
+	return GetTranscript(p.ctx)(messengerId)
+}
+
 // ============================================================================
 // AndEmit variants - separate business logic from event emission using buffer
 // ============================================================================
@@ -570,6 +597,7 @@ func JoinAndEmit(l logrus.FieldLogger) func(ctx context.Context) func(input Join
 					return Model{}, err
 				}
 
+				p = evictStale(l, ctx, buf.Put, input.TransactionID, p)
 				if len(p.Members()) >= MaxMembers {
 					l.Errorf("Messenger [%d] already at capacity.", input.MessengerId)
 					_ = buf.Put(messenger.EnvEventStatusTopic, errorEventProvider(input.TransactionID, input.CharacterId, input.MessengerId, c.WorldId(), messenger.EventMessengerStatusErrorTypeAtCapacity, ""))
@@ -719,13 +747,14 @@ func RequestInviteAndEmit(l logrus.FieldLogger) func(ctx context.Context) func(i
 					}
 				}
 
+				p = evictStale(l, ctx, buf.Put, input.TransactionID, p)
 				if len(p.Members()) >= MaxMembers {
 					l.Errorf("Messenger [%d] already at capacity.", p.Id())
 					_ = buf.Put(messenger.EnvEventStatusTopic, errorEventProvider(input.TransactionID, input.ActorId, p.Id(), c.WorldId(), messenger.EventMessengerStatusErrorTypeAtCapacity, ""))
 					return ErrAtCapacity
 				}
 
-				err = invite.NewProcessor(l, ctx).Create(input.TransactionID, input.ActorId, a.WorldId(), p.Id(), input.CharacterId)
+				err = invite.NewProcessor(l, ctx).Create(input.TransactionID, input.ActorId, c.WorldId(), p.Id(), input.CharacterId)
 				if err != nil {
 					l.WithError(err).Errorf("Unable to announce messenger [%d] invite.", p.Id())
 					_ = buf.Put(messenger.EnvEventStatusTopic, errorEventProvider(input.TransactionID, input.ActorId, a.MessengerId(), c.WorldId(), messenger.EventMessengerStatusErrorUnexpected, ""))
@@ -737,3 +766,164 @@ func RequestInviteAndEmit(l logrus.FieldLogger) func(ctx context.Context) func(i
 		})
 	}
 }
+
+// ============================================================================
+// Disconnect / rejoin - rooms outlive member sessions for the rejoin window
+// ============================================================================
+
+type emitFunc func(topic string, provider model.Provider[[]kafka.Message]) error
+
+func inlineEmitter(l logrus.FieldLogger, ctx context.Context) emitFunc {
+	return func(topic string, provider model.Provider[[]kafka.Message]) error {
+		return producer.ProviderImpl(l)(ctx)(topic)(provider)
+	}
+}
+
+// evictStale removes members whose rejoin window has lapsed, announcing each
+// departure, and returns the pruned room.
+func evictStale(l logrus.FieldLogger, ctx context.Context, emit emitFunc, transactionID uuid.UUID, p Model) Model {
+	for _, mm := range p.StaleMembers(time.Now()) {
+		np, err := UpdateMessenger(ctx)(p.Id(), func(m Model) Model { return Model.RemoveMember(m, mm.Id()) })
+		if err != nil {
+			l.WithError(err).Errorf("Unable to evict stale member [%d] from messenger [%d].", mm.Id(), p.Id())
+			continue
+		}
+		p = np
+
+		c, err := character.GetById(l)(ctx)(mm.Id())
+		if err == nil && c.MessengerId() == p.Id() {
+			_ = character.LeaveMessenger(l)(ctx)(transactionID, mm.Id())
+		}
+		l.Debugf("Evicted character [%d] from messenger [%d] after rejoin window lapsed.", mm.Id(), p.Id())
+		_ = emit(messenger.EnvEventStatusTopic, leftEventProvider(transactionID, mm.Id(), p.Id(), c.WorldId(), mm.Slot()))
+	}
+	if len(p.Members()) == 0 {
+		DeleteMessenger(ctx)(p.Id())
+		l.Debugf("Messenger [%d] has been disbanded.", p.Id())
+	}
+	return p
+}
+
+// DisconnectInput holds the input parameters for DisconnectAndEmit
+type DisconnectInput struct {
+	TransactionID uuid.UUID
+	CharacterId   uint32
+}
+
+// DisconnectAndEmit marks a member's session as gone while keeping their slot,
+// so the room survives logouts and channel changes.
+func DisconnectAndEmit(l logrus.FieldLogger) func(ctx context.Context) func(input DisconnectInput) (Model, error) {
+	return func(ctx context.Context) func(input DisconnectInput) (Model, error) {
+		ep := producer.ProviderImpl(l)(ctx)
+		return message.EmitAlways[Model, DisconnectInput](ep)(func(buf *message.Buffer) func(DisconnectInput) (Model, error) {
+			return func(input DisconnectInput) (Model, error) {
+				c, err := character.GetById(l)(ctx)(input.CharacterId)
+				if err != nil {
+					return Model{}, err
+				}
+				if c.MessengerId() == 0 {
+					return Model{}, ErrNotIn
+				}
+
+				p, err := ByIdProvider(ctx)(c.MessengerId())()
+				if err != nil {
+					l.Debugf("Messenger [%d] for character [%d] no longer exists.", c.MessengerId(), input.CharacterId)
+					_ = character.LeaveMessenger(l)(ctx)(input.TransactionID, input.CharacterId)
+					return Model{}, ErrNotFound
+				}
+				mm, err := p.FindMember(input.CharacterId)
+				if err != nil {
+					return Model{}, ErrNotIn
+				}
+
+				p, err = UpdateMessenger(ctx)(p.Id(), func(m Model) Model { return Model.DisconnectMember(m, input.CharacterId, time.Now()) })
+				if err != nil {
+					l.WithError(err).Errorf("Unable to mark character [%d] disconnected from messenger [%d].", input.CharacterId, c.MessengerId())
+					return Model{}, err
+				}
+
+				l.Debugf("Character [%d] disconnected from messenger [%d].", input.CharacterId, p.Id())
+				_ = buf.Put(messenger.EnvEventStatusTopic, disconnectedEventProvider(input.TransactionID, input.CharacterId, p.Id(), c.WorldId(), mm.Slot()))
+				return p, nil
+			}
+		})
+	}
+}
+
+// RejoinInput holds the input parameters for RejoinAndEmit
+type RejoinInput struct {
+	TransactionID uuid.UUID
+	CharacterId   uint32
+}
+
+// RejoinAndEmit restores a member's seat after a login or channel change. A
+// member whose rejoin window has lapsed is evicted instead, and a character
+// whose room has expired is released from it. REJOINED is marked reconnected
+// only when the member was disconnected; a plain channel change just moves
+// their slot.
+func RejoinAndEmit(l logrus.FieldLogger) func(ctx context.Context) func(input RejoinInput) (Model, error) {
+	return func(ctx context.Context) func(input RejoinInput) (Model, error) {
+		ep := producer.ProviderImpl(l)(ctx)
+		return message.EmitAlways[Model, RejoinInput](ep)(func(buf *message.Buffer) func(RejoinInput) (Model, error) {
+			return func(input RejoinInput) (Model, error) {
+				c, err := character.GetById(l)(ctx)(input.CharacterId)
+				if err != nil {
+					return Model{}, err
+				}
+				if c.MessengerId() == 0 {
+					return Model{}, ErrNotIn
+				}
+
+				p, err := ByIdProvider(ctx)(c.MessengerId())()
+				if err != nil {
+					l.Debugf("Messenger [%d] for character [%d] expired while they were away.", c.MessengerId(), input.CharacterId)
+					_ = character.LeaveMessenger(l)(ctx)(input.TransactionID, input.CharacterId)
+					return Model{}, ErrNotFound
+				}
+				mm, err := p.FindMember(input.CharacterId)
+				if err != nil {
+					_ = character.LeaveMessenger(l)(ctx)(input.TransactionID, input.CharacterId)
+					return Model{}, ErrNotIn
+				}
+
+				p = evictStale(l, ctx, buf.Put, input.TransactionID, p)
+				if _, err = p.FindMember(input.CharacterId); err != nil {
+					return Model{}, ErrNotIn
+				}
+
+				p, err = UpdateMessenger(ctx)(p.Id(), func(m Model) Model { return Model.ReconnectMember(m, input.CharacterId) })
+				if err != nil {
+					l.WithError(err).Errorf("Unable to rejoin character [%d] to messenger [%d].", input.CharacterId, c.MessengerId())
+					return Model{}, err
+				}
+
+				l.Debugf("Character [%d] rejoined messenger [%d].", input.CharacterId, p.Id())
+				_ = buf.Put(messenger.EnvEventStatusTopic, rejoinedEventProvider(input.TransactionID, input.CharacterId, p.Id(), c.WorldId(), mm.Slot(), !mm.Connected()))
+				return p, nil
+			}
+		})
+	}
+}
+
+// RecordChat appends a messenger chat line to the sender's room transcript.
+func RecordChat(l logrus.FieldLogger) func(ctx context.Context) func(characterId uint32, msg string) error {
+	return func(ctx context.Context) func(characterId uint32, msg string) error {
+		return func(characterId uint32, msg string) error {
+			c, err := character.GetById(l)(ctx)(characterId)
+			if err != nil {
+				return err
+			}
+			if c.MessengerId() == 0 {
+				return ErrNotIn
+			}
+			_, err = AppendTranscriptLine(ctx)(c.MessengerId(), characterId, msg, time.Now())
+			return err
+		}
+	}
+}
+
+func GetTranscript(ctx context.Context) func(messengerId uint32) ([]TranscriptLine, error) {
+	return func(messengerId uint32) ([]TranscriptLine, error) {
+		return TranscriptProvider(ctx)(messengerId)()
+	}
+}
@@ -91,6 +91,37 @@ func leftEventProvider(transactionID uuid.UUID, actorId uint32, messengerId uint
 	return producer.SingleMessageProvider(key, value)
 }
 
+func disconnectedEventProvider(transactionID uuid.UUID, actorId uint32, messengerId uint32, worldId world.Id, slot byte) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(messengerId))
+	value := &messenger.StatusEvent[messenger.DisconnectedEventBody]{
+		TransactionID: transactionID,
+		ActorId:       actorId,
+		MessengerId:   messengerId,
+		WorldId:       worldId,
+		Type:          messenger.EventMessengerStatusTypeDisconnected,
+		Body: messenger.DisconnectedEventBody{
+			Slot: slot,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func rejoinedEventProvider(transactionID uuid.UUID, actorId uint32, messengerId uint32, worldId world.Id, slot byte, reconnected bool) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(messengerId))
+	value := &messenger.StatusEvent[messenger.RejoinedEventBody]{
+		TransactionID: transactionID,
+		ActorId:       actorId,
+		MessengerId:   messengerId,
+		WorldId:       worldId,
+		Type:          messenger.EventMessengerStatusTypeRejoined,
+		Body: messenger.RejoinedEventBody{
+			Slot:        slot,
+			Reconnected: reconnected,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 func errorEventProvider(transactionID uuid.UUID, actorId uint32, messengerId uint32, worldId world.Id, errorType string, characterName string) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(messengerId))
 	value := &messenger.StatusEvent[messenger.ErrorEventBody]{
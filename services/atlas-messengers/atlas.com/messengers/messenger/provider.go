@@ -19,3 +19,11 @@ func GetAllProvider(ctx context.Context) model.Provider[[]Model] {
 		return GetRegistry().GetAll(ctx), nil
 	}
 }
+
+func TranscriptProvider(ctx context.Context) func(messengerId uint32) model.Provider[[]TranscriptLine] {
+	return func(messengerId uint32) model.Provider[[]TranscriptLine] {
+		return func() ([]TranscriptLine, error) {
+			return GetRegistry().GetTranscript(ctx, messengerId), nil
+		}
+	}
+}
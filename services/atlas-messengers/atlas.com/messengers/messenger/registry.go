@@ -3,6 +3,7 @@ package messenger
 import (
 	"context"
 	"strconv"
+	"time"
 
 	goredis "github.com/redis/go-redis/v9"
 
@@ -11,18 +12,21 @@ import (
 )
 
 type Registry struct {
-	messengers *atlas.TenantRegistry[uint32, Model]
-	idGen      *atlas.IDGenerator
+	messengers  *atlas.TenantRegistry[uint32, Model]
+	transcripts *atlas.TenantRegistry[uint32, []TranscriptLine]
+	idGen       *atlas.IDGenerator
 }
 
 var registry *Registry
 
 func InitRegistry(client *goredis.Client) {
+	keyFn := func(k uint32) string {
+		return strconv.FormatUint(uint64(k), 10)
+	}
 	registry = &Registry{
-		messengers: atlas.NewTenantRegistry[uint32, Model](client, "messenger", func(k uint32) string {
-			return strconv.FormatUint(uint64(k), 10)
-		}),
-		idGen: atlas.NewIDGenerator(client, "messenger"),
+		messengers:  atlas.NewTenantRegistry[uint32, Model](client, "messenger", keyFn),
+		transcripts: atlas.NewTenantRegistry[uint32, []TranscriptLine](client, "messenger-transcript", keyFn),
+		idGen:       atlas.NewIDGenerator(client, "messenger"),
 	}
 }
 
@@ -76,7 +80,12 @@ func (r *Registry) Update(ctx context.Context, id uint32, updaters ...func(m Mod
 		return Model{}, ErrAtCapacity
 	}
 
-	err = r.messengers.Put(ctx, t, id, m)
+	// A room nobody is connected to only survives for the rejoin window.
+	if len(m.members) > 0 && m.AllDisconnected() {
+		err = r.messengers.PutWithTTL(ctx, t, id, m, RejoinWindow)
+	} else {
+		err = r.messengers.Put(ctx, t, id, m)
+	}
 	if err != nil {
 		return Model{}, err
 	}
@@ -86,4 +95,44 @@ func (r *Registry) Update(ctx context.Context, id uint32, updaters ...func(m Mod
 func (r *Registry) Remove(ctx context.Context, messengerId uint32) {
 	t := tenant.MustFromContext(ctx)
 	_ = r.messengers.Remove(ctx, t, messengerId)
+	_ = r.transcripts.Remove(ctx, t, messengerId)
+}
+
+// AppendTranscript records a chat line against the room, keeping only the
+// most recent TranscriptSize lines. The append runs as one optimistic
+// transaction so lines sent at the same moment are all kept and numbered in
+// order.
+func (r *Registry) AppendTranscript(ctx context.Context, messengerId uint32, characterId uint32, message string, sentAt time.Time) (TranscriptLine, error) {
+	t := tenant.MustFromContext(ctx)
+
+	lines, err := r.transcripts.UpsertWithTTL(ctx, t, messengerId, TranscriptTTL, func(lines []TranscriptLine) []TranscriptLine {
+		var sequence uint32 = 1
+		if len(lines) > 0 {
+			sequence = lines[len(lines)-1].Sequence() + 1
+		}
+		lines = append(lines, TranscriptLine{
+			sequence:    sequence,
+			characterId: characterId,
+			message:     message,
+			sentAt:      sentAt,
+		})
+		if len(lines) > TranscriptSize {
+			lines = lines[len(lines)-TranscriptSize:]
+		}
+		return lines
+	})
+	if err != nil {
+		return TranscriptLine{}, err
+	}
+	return lines[len(lines)-1], nil
+}
+
+func (r *Registry) GetTranscript(ctx context.Context, messengerId uint32) []TranscriptLine {
+	t := tenant.MustFromContext(ctx)
+
+	lines, err := r.transcripts.Get(ctx, t, messengerId)
+	if err != nil {
+		return make([]TranscriptLine, 0)
+	}
+	return lines
 }
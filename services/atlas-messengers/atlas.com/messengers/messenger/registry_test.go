@@ -2,7 +2,10 @@ package messenger
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
@@ -145,3 +148,78 @@ func TestRegistry_Remove(t *testing.T) {
 	_, err := GetRegistry().Get(ctx, created.Id())
 	assert.Error(t, err)
 }
+
+func TestRegistry_Update_AllDisconnectedExpires(t *testing.T) {
+	setupTestRegistry(t)
+	ctx := createTestCtx(t)
+
+	created := GetRegistry().Create(ctx, 100)
+	client := GetRegistry().messengers.Client()
+	keys := client.Keys(ctx, fmt.Sprintf("*%s:*:%d", GetRegistry().messengers.Namespace(), created.Id())).Val()
+	assert.Len(t, keys, 1)
+	rk := keys[0]
+
+	updated, err := GetRegistry().Update(ctx, created.Id(), func(m Model) Model { return m.DisconnectMember(100, time.Now()) })
+	assert.NoError(t, err)
+	assert.False(t, updated.Members()[0].Connected())
+
+	ttl := client.TTL(ctx, rk).Val()
+	assert.Greater(t, ttl, time.Duration(0))
+
+	_, err = GetRegistry().Update(ctx, created.Id(), func(m Model) Model { return m.ReconnectMember(100) })
+	assert.NoError(t, err)
+	ttl = client.TTL(ctx, rk).Val()
+	assert.Equal(t, time.Duration(-1), ttl)
+}
+
+func TestRegistry_AppendTranscriptBounded(t *testing.T) {
+	setupTestRegistry(t)
+	ctx := createTestCtx(t)
+
+	created := GetRegistry().Create(ctx, 100)
+	for i := 0; i < TranscriptSize+5; i++ {
+		_, err := GetRegistry().AppendTranscript(ctx, created.Id(), 100, fmt.Sprintf("line %d", i), time.Now())
+		assert.NoError(t, err)
+	}
+
+	lines := GetRegistry().GetTranscript(ctx, created.Id())
+	assert.Len(t, lines, TranscriptSize)
+	assert.Equal(t, uint32(6), lines[0].Sequence())
+	assert.Equal(t, fmt.Sprintf("line %d", TranscriptSize+4), lines[len(lines)-1].Message())
+}
+
+func TestRegistry_AppendTranscriptConcurrent(t *testing.T) {
+	setupTestRegistry(t)
+	ctx := createTestCtx(t)
+
+	created := GetRegistry().Create(ctx, 100)
+	const senders = 20
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := GetRegistry().AppendTranscript(ctx, created.Id(), uint32(100+i), fmt.Sprintf("line %d", i), time.Now())
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	lines := GetRegistry().GetTranscript(ctx, created.Id())
+	assert.Len(t, lines, senders)
+	for i, l := range lines {
+		assert.Equal(t, uint32(i+1), l.Sequence())
+	}
+}
+
+func TestRegistry_RemoveClearsTranscript(t *testing.T) {
+	setupTestRegistry(t)
+	ctx := createTestCtx(t)
+
+	created := GetRegistry().Create(ctx, 100)
+	_, _ = GetRegistry().AppendTranscript(ctx, created.Id(), 100, "hello", time.Now())
+
+	GetRegistry().Remove(ctx, created.Id())
+
+	assert.Empty(t, GetRegistry().GetTranscript(ctx, created.Id()))
+}
@@ -27,6 +27,7 @@ func InitResource(si jsonapi.ServerInformation) server.RouteInitializer {
 		r.HandleFunc("", registerGet("get_messengers", handleGetMessengers)).Methods(http.MethodGet)
 		r.HandleFunc("/{messengerId}", registerGet("get_messenger", handleGetMessenger)).Methods(http.MethodGet)
 		r.HandleFunc("/{messengerId}/members", registerGet("get_messenger_members", handleGetMessengerMembers)).Methods(http.MethodGet)
+		r.HandleFunc("/{messengerId}/transcript", registerGet("get_messenger_transcript", handleGetMessengerTranscript)).Methods(http.MethodGet)
 		r.HandleFunc("/{messengerId}/relationships/members", registerGet("get_messenger_members", handleGetMessengerMembers)).Methods(http.MethodGet)
 		r.HandleFunc("/{messengerId}/members", rest.RegisterInputHandler[MemberRestModel](l)(si)("create_messenger_member", handleCreateMessengerMember)).Methods(http.MethodPost)
 		r.HandleFunc("/{messengerId}/members/{memberId}", registerGet("get_messenger_member", handleGetMessengerMember)).Methods(http.MethodGet)
@@ -130,6 +131,33 @@ func handleGetMessengerMembers(d *rest.HandlerDependency, c *rest.HandlerContext
 	})
 }
 
+func handleGetMessengerTranscript(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseMessengerId(d.Logger(), func(messengerId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			proc := NewProcessor(d.Logger(), d.Context())
+			if _, err := proc.GetById(messengerId); err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			ls, err := proc.GetTranscript(messengerId)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			res, err := model.SliceMap(TransformTranscriptLine)(model.FixedProvider(ls))()()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			server.MarshalResponse[[]TranscriptLineRestModel](d.Logger())(w)(c.ServerInformation())(r.URL.Query())(res)
+		}
+	})
+}
+
 func handleCreateMessengerMember(d *rest.HandlerDependency, _ *rest.HandlerContext, i MemberRestModel) http.HandlerFunc {
 	return rest.ParseMessengerId(d.Logger(), func(messengerId uint32) http.HandlerFunc {
 		return func(w http.ResponseWriter, r *http.Request) {
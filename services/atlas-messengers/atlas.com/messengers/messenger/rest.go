@@ -4,6 +4,7 @@ import (
 	"atlas-messengers/character"
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/jtumidanski/api2go/jsonapi"
 	"github.com/sirupsen/logrus"
@@ -150,3 +151,34 @@ func TransformMember(l logrus.FieldLogger) func(ctx context.Context) func(m Memb
 		}
 	}
 }
+
+type TranscriptLineRestModel struct {
+	Id          string    `json:"-"`
+	Sequence    uint32    `json:"sequence"`
+	CharacterId uint32    `json:"characterId"`
+	Message     string    `json:"message"`
+	SentAt      time.Time `json:"sentAt"`
+}
+
+func (r TranscriptLineRestModel) GetName() string {
+	return "transcript-lines"
+}
+
+func (r TranscriptLineRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *TranscriptLineRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func TransformTranscriptLine(l TranscriptLine) (TranscriptLineRestModel, error) {
+	return TranscriptLineRestModel{
+		Id:          strconv.Itoa(int(l.Sequence())),
+		Sequence:    l.Sequence(),
+		CharacterId: l.CharacterId(),
+		Message:     l.Message(),
+		SentAt:      l.SentAt(),
+	}, nil
+}
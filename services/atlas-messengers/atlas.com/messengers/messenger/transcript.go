@@ -0,0 +1,63 @@
+package messenger
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TranscriptSize bounds how many chat lines are retained per room.
+const TranscriptSize = 50
+
+// TranscriptTTL is how long a transcript outlives the last line written to it.
+const TranscriptTTL = 24 * time.Hour
+
+type TranscriptLine struct {
+	sequence    uint32
+	characterId uint32
+	message     string
+	sentAt      time.Time
+}
+
+func (l TranscriptLine) Sequence() uint32 {
+	return l.sequence
+}
+
+func (l TranscriptLine) CharacterId() uint32 {
+	return l.characterId
+}
+
+func (l TranscriptLine) Message() string {
+	return l.message
+}
+
+func (l TranscriptLine) SentAt() time.Time {
+	return l.sentAt
+}
+
+type transcriptLineJSON struct {
+	Sequence    uint32    `json:"sequence"`
+	CharacterId uint32    `json:"characterId"`
+	Message     string    `json:"message"`
+	SentAt      time.Time `json:"sentAt"`
+}
+
+func (l TranscriptLine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&transcriptLineJSON{
+		Sequence:    l.sequence,
+		CharacterId: l.characterId,
+		Message:     l.message,
+		SentAt:      l.sentAt,
+	})
+}
+
+func (l *TranscriptLine) UnmarshalJSON(data []byte) error {
+	var aux transcriptLineJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	l.sequence = aux.Sequence
+	l.characterId = aux.CharacterId
+	l.message = aux.Message
+	l.sentAt = aux.SentAt
+	return nil
+}
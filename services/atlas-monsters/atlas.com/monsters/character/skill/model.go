@@ -0,0 +1,18 @@
+package skill
+
+type Model struct {
+	id    uint32
+	level byte
+}
+
+func NewModel(id uint32, level byte) Model {
+	return Model{id: id, level: level}
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) Level() byte {
+	return m.level
+}
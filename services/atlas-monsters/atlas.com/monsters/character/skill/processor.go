@@ -0,0 +1,101 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type Processor interface {
+	GetById(characterId uint32, skillId uint32) (Model, error)
+	// GetLevel returns the caster's trained level of skillId, 0 when the
+	// character does not have the skill.
+	GetLevel(characterId uint32, skillId uint32) (byte, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{l: l, ctx: ctx}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetById(characterId uint32, skillId uint32) (Model, error) {
+	return requests.Provider[RestModel, Model](p.l, p.ctx)(requestById(p.ctx, characterId, skillId), Extract)()
+}
+
+// levelTTL bounds how stale a cached level may be. Status applies arrive per
+// hit and, for mists, once a second per monster, so every apply cannot cost a
+// round trip; a level only ever changes on skill-up, and a few seconds of lag
+// there merely delays the new ceiling.
+const levelTTL = 10 * time.Second
+
+type levelKey struct {
+	tenantId    uuid.UUID
+	characterId uint32
+	skillId     uint32
+}
+
+type levelEntry struct {
+	level     byte
+	fetchedAt time.Time
+}
+
+var (
+	levelMu       sync.Mutex
+	levelCache    = make(map[levelKey]levelEntry)
+	levelPrunedAt time.Time
+)
+
+// storeLevel caches e under key. At most once per levelTTL the write also
+// sweeps out every expired entry, so characters who log off or stop casting
+// do not stay in the cache for the life of the process.
+func storeLevel(key levelKey, e levelEntry) {
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	if e.fetchedAt.Sub(levelPrunedAt) >= levelTTL {
+		for k, old := range levelCache {
+			if e.fetchedAt.Sub(old.fetchedAt) >= levelTTL {
+				delete(levelCache, k)
+			}
+		}
+		levelPrunedAt = e.fetchedAt
+	}
+	levelCache[key] = e
+}
+
+// GetLevel caches both hits and misses (a character without the skill is
+// level 0). Transport errors are returned uncached so the next apply retries.
+func (p *ProcessorImpl) GetLevel(characterId uint32, skillId uint32) (byte, error) {
+	t := tenant.MustFromContext(p.ctx)
+	key := levelKey{tenantId: t.Id(), characterId: characterId, skillId: skillId}
+
+	levelMu.Lock()
+	e, ok := levelCache[key]
+	levelMu.Unlock()
+	if ok && time.Since(e.fetchedAt) < levelTTL {
+		return e.level, nil
+	}
+
+	var level byte
+	m, err := p.GetById(characterId, skillId)
+	if err == nil {
+		level = m.Level()
+	} else if !errors.Is(err, requests.ErrNotFound) {
+		return 0, err
+	}
+
+	storeLevel(key, levelEntry{level: level, fetchedAt: time.Now()})
+	return level, nil
+}
@@ -0,0 +1,87 @@
+package skill_test
+
+import (
+	"atlas-monsters/character/skill"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func skillDoc(id uint32, level byte) string {
+	return fmt.Sprintf(`{"data":{"id":"%d","type":"skills","attributes":{"level":%d,"masterLevel":30}}}`, id, level)
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ten, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tenant.WithContext(context.Background(), ten)
+}
+
+// TestGetLevel_HTTPRoundTrip exercises the real decode path and pins the
+// request path, then proves a second lookup inside the TTL is served from
+// the cache.
+func TestGetLevel_HTTPRoundTrip(t *testing.T) {
+	var calls atomic.Int32
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(skillDoc(4120005, 17)))
+	}))
+	defer srv.Close()
+	t.Setenv("SKILLS_SERVICE_URL", srv.URL+"/")
+
+	ctx := testContext(t)
+	l, _ := test.NewNullLogger()
+	p := skill.NewProcessor(l, ctx)
+
+	level, err := p.GetLevel(42, 4120005)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level != 17 {
+		t.Fatalf("GetLevel() = %d, want 17", level)
+	}
+	if gotPath != "/characters/42/skills/4120005" {
+		t.Fatalf("request path = %q", gotPath)
+	}
+
+	if _, err = p.GetLevel(42, 4120005); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("upstream calls = %d, want 1 (second lookup must be cached)", calls.Load())
+	}
+}
+
+// TestGetLevel_NotFoundIsLevelZero proves a character lacking the skill reads
+// as level 0 rather than an error, so the caller rejects instead of failing
+// open.
+func TestGetLevel_NotFoundIsLevelZero(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	t.Setenv("SKILLS_SERVICE_URL", srv.URL+"/")
+
+	l, _ := test.NewNullLogger()
+	level, err := skill.NewProcessor(l, testContext(t)).GetLevel(42, 2111003)
+	if err != nil {
+		t.Fatalf("GetLevel() error = %v, want nil", err)
+	}
+	if level != 0 {
+		t.Fatalf("GetLevel() = %d, want 0", level)
+	}
+}
@@ -0,0 +1,38 @@
+package skill
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TestStoreLevel_PrunesExpiredEntries verifies a write sweeps out entries
+// older than levelTTL while keeping live ones.
+func TestStoreLevel_PrunesExpiredEntries(t *testing.T) {
+	levelMu.Lock()
+	levelPrunedAt = time.Time{}
+	levelMu.Unlock()
+
+	tenantId := uuid.New()
+	now := time.Now()
+	expired := levelKey{tenantId: tenantId, characterId: 1, skillId: 2201004}
+	live := levelKey{tenantId: tenantId, characterId: 2, skillId: 2201004}
+	fresh := levelKey{tenantId: tenantId, characterId: 3, skillId: 2201004}
+
+	storeLevel(expired, levelEntry{level: 20, fetchedAt: now.Add(-2 * levelTTL)})
+	storeLevel(live, levelEntry{level: 10, fetchedAt: now.Add(-levelTTL / 2)})
+	storeLevel(fresh, levelEntry{level: 5, fetchedAt: now})
+
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	if _, ok := levelCache[expired]; ok {
+		t.Fatalf("expected the expired entry to be pruned")
+	}
+	if _, ok := levelCache[live]; !ok {
+		t.Fatalf("expected the live entry to be kept")
+	}
+	if e, ok := levelCache[fresh]; !ok || e.level != 5 {
+		t.Fatalf("expected the written entry to be cached, got %+v (%t)", e, ok)
+	}
+}
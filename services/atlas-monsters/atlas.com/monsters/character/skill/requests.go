@@ -0,0 +1,22 @@
+package skill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const characterSkillResource = "characters/%d/skills/%d"
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "SKILLS")
+}
+
+func requestById(ctx context.Context, characterId uint32, skillId uint32) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.GetRequest[RestModel](fmt.Sprintf(root+characterSkillResource, characterId, skillId))
+}
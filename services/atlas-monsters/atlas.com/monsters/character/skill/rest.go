@@ -0,0 +1,46 @@
+package skill
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RestModel is a subset of atlas-skills' "skills" JSON:API projection —
+// only the trained level is needed to validate a caster's status
+// application. Extra attributes (masterLevel, expiration, cooldown) are
+// ignored by JSON unmarshalling.
+type RestModel struct {
+	Id    uint32 `json:"-"`
+	Level byte   `json:"level"`
+}
+
+func (r RestModel) GetName() string {
+	return "skills"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return fmt.Errorf("invalid skill id: %s", strId)
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+// SetToOneReferenceID is a no-op required by api2go's interface.
+func (r *RestModel) SetToOneReferenceID(_, _ string) error {
+	return nil
+}
+
+// SetToManyReferenceIDs is a no-op required by api2go's interface.
+func (r *RestModel) SetToManyReferenceIDs(_ string, _ []string) error {
+	return nil
+}
+
+func Extract(rm RestModel) (Model, error) {
+	return NewModel(rm.Id, rm.Level), nil
+}
@@ -63,6 +63,9 @@ func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handl
 		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleForceControlCommand))); err != nil {
 			return err
 		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleWeakenElementCommand))); err != nil {
+			return err
+		}
 		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleApplyStatusFieldCommand))); err != nil {
 			return err
 		}
@@ -105,7 +108,7 @@ func handleDamageCommand(l logrus.FieldLogger, ctx context.Context, c command[da
 	}
 
 	p := monster.NewProcessor(l, ctx)
-	p.Damage(c.MonsterId, c.Body.CharacterId, c.Body.Damages, c.Body.AttackType)
+	p.Damage(c.MonsterId, c.Body.CharacterId, c.Body.Damages, c.Body.AttackType, c.Body.Element, c.Body.ElementalReset)
 }
 
 func handleDamageFriendlyCommand(l logrus.FieldLogger, ctx context.Context, c command[damageFriendlyCommandBody]) {
@@ -122,16 +125,7 @@ func handleApplyStatusCommand(l logrus.FieldLogger, ctx context.Context, c comma
 		return
 	}
 
-	tickInterval := time.Duration(c.Body.TickInterval) * time.Millisecond
-	// Auto-set tick interval for DoT statuses
-	if tickInterval == 0 {
-		for statusType := range c.Body.Statuses {
-			if statusType == "POISON" || statusType == "VENOM" {
-				tickInterval = 1000 * time.Millisecond
-				break
-			}
-		}
-	}
+	tickInterval := statusTickInterval(c.Body)
 
 	effect := monster.NewStatusEffect(
 		c.Body.SourceType,
@@ -147,6 +141,17 @@ func handleApplyStatusCommand(l logrus.FieldLogger, ctx context.Context, c comma
 	_ = p.ApplyStatusEffect(c.MonsterId, effect)
 }
 
+// statusTickInterval is the command's tick interval, defaulting to
+// monster.DefaultDoTTickInterval when it carries a damage-over-time status
+// and names none.
+func statusTickInterval(b applyStatusCommandBody) time.Duration {
+	tickInterval := time.Duration(b.TickInterval) * time.Millisecond
+	if tickInterval == 0 && monster.TicksDamage(b.Statuses) {
+		tickInterval = monster.DefaultDoTTickInterval
+	}
+	return tickInterval
+}
+
 func handleCancelStatusCommand(l logrus.FieldLogger, ctx context.Context, c command[cancelStatusCommandBody]) {
 	if c.Type != CommandTypeCancelStatus {
 		return
@@ -226,6 +231,17 @@ func handleForceControlCommand(l logrus.FieldLogger, ctx context.Context, c comm
 	}
 }
 
+func handleWeakenElementCommand(l logrus.FieldLogger, ctx context.Context, c command[weakenElementCommandBody]) {
+	if c.Type != CommandTypeWeakenElement {
+		return
+	}
+
+	p := monster.NewProcessor(l, ctx)
+	if err := p.WeakenElement(c.MonsterId, c.Body.CharacterId, c.Body.Element, time.Duration(c.Body.Duration)*time.Millisecond); err != nil {
+		l.WithError(err).Errorf("WEAKEN_ELEMENT failed for monster [%d] element [%s].", c.MonsterId, c.Body.Element)
+	}
+}
+
 func handleAddPuppetCommand(l logrus.FieldLogger, ctx context.Context, c addPuppetCommand) {
 	if c.Type != CommandTypeAddPuppet {
 		return
@@ -235,6 +251,9 @@ func handleAddPuppetCommand(l logrus.FieldLogger, ctx context.Context, c addPupp
 	t := tenant.MustFromContext(ctx)
 	monster.GetPuppetRegistry().Add(ctx, t, f, c.OwnerCharacterId, c.X, c.Y)
 	l.Debugf("Registered puppet for owner [%d] at (%d,%d) in field [%s].", c.OwnerCharacterId, c.X, c.Y, f.Id())
+	if err := monster.NewProcessor(l, ctx).DrawToPuppet(f, c.OwnerCharacterId, c.X, c.Y); err != nil {
+		l.WithError(err).Errorf("Unable to draw monsters to puppet of owner [%d] in field [%s].", c.OwnerCharacterId, f.Id())
+	}
 }
 
 func handleRemovePuppetCommand(l logrus.FieldLogger, ctx context.Context, c removePuppetCommand) {
@@ -266,15 +285,7 @@ func handleApplyStatusFieldCommand(l logrus.FieldLogger, ctx context.Context, c
 		return
 	}
 
-	tickInterval := time.Duration(c.Body.TickInterval) * time.Millisecond
-	if tickInterval == 0 {
-		for statusType := range c.Body.Statuses {
-			if statusType == "POISON" || statusType == "VENOM" {
-				tickInterval = 1000 * time.Millisecond
-				break
-			}
-		}
-	}
+	tickInterval := statusTickInterval(c.Body)
 
 	for _, m := range monsters {
 		effect := monster.NewStatusEffect(
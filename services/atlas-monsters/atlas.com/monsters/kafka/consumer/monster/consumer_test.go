@@ -4,6 +4,7 @@ import (
 	"atlas-monsters/monster"
 	"encoding/json"
 	"testing"
+	"time"
 )
 
 // FR-P5: a producer that omits the provenance fields must produce byte-identical
@@ -44,3 +45,22 @@ func TestNormalizeSpawnSourceType(t *testing.T) {
 		}
 	}
 }
+
+// A damage-over-time status with no tick interval gets the default cadence;
+// crowd control alone does not tick, and an explicit interval wins.
+func TestStatusTickInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		body applyStatusCommandBody
+		want time.Duration
+	}{
+		{"burned defaults", applyStatusCommandBody{Statuses: map[string]int32{monster.StatusBurned: 50}}, monster.DefaultDoTTickInterval},
+		{"venom defaults", applyStatusCommandBody{Statuses: map[string]int32{monster.StatusVenom: 40}}, monster.DefaultDoTTickInterval},
+		{"stun does not tick", applyStatusCommandBody{Statuses: map[string]int32{"STUN": 1}}, 0},
+		{"explicit wins", applyStatusCommandBody{Statuses: map[string]int32{monster.StatusPoison: 0}, TickInterval: 500}, 500 * time.Millisecond},
+	} {
+		if got := statusTickInterval(tc.body); got != tc.want {
+			t.Fatalf("%s: statusTickInterval() = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
@@ -29,6 +29,7 @@ const (
 	CommandTypeCatch             = "CATCH"
 	CommandTypeClearAggro        = "CLEAR_AGGRO"
 	CommandTypeForceControl      = "FORCE_CONTROL"
+	CommandTypeWeakenElement     = "WEAKEN_ELEMENT"
 
 	EnvCommandTopicMovement = "COMMAND_TOPIC_MONSTER_MOVEMENT"
 )
@@ -48,10 +49,15 @@ type damageFriendlyCommandBody struct {
 	AttackerUniqueId uint32 `json:"attackerUniqueId"`
 }
 
+// damageCommandBody carries one attack's damage lines. Element is the
+// attack's element ("" for physical) and ElementalReset the caster's Elemental
+// Reset percentage; both feed the processor's elemental adjustment.
 type damageCommandBody struct {
-	CharacterId uint32   `json:"characterId"`
-	Damages     []uint32 `json:"damages"`
-	AttackType  byte     `json:"attackType"`
+	CharacterId    uint32   `json:"characterId"`
+	Damages        []uint32 `json:"damages"`
+	AttackType     byte     `json:"attackType"`
+	Element        string   `json:"element,omitempty"`
+	ElementalReset uint32   `json:"elementalReset,omitempty"`
 }
 
 type applyStatusCommandBody struct {
@@ -164,6 +170,17 @@ type forceControlCommandBody struct {
 	CharacterId uint32 `json:"characterId"`
 }
 
+// weakenElementCommandBody temporarily makes a monster WEAK to Element for
+// Duration milliseconds (Fire Demon / Ice Demon). Duration shares its name
+// and uint32 type with applyStatusCommandBody.Duration on this shared topic.
+// Mirrors atlas-channel's monster2.WeakenElementCommandBody — edit both
+// together.
+type weakenElementCommandBody struct {
+	CharacterId uint32 `json:"characterId"`
+	Element     string `json:"element,omitempty"`
+	Duration    uint32 `json:"duration"`
+}
+
 // addPuppetCommand registers a player's puppet in a field so the monster
 // controller picker can bias toward the puppet's owner. Emitted by atlas-summons
 // on puppet spawn. Type must equal CommandTypeAddPuppet.
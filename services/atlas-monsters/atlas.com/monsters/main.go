@@ -61,6 +61,7 @@ func main() {
 	monster.InitIdAllocator(rc)
 	monster.InitCooldownRegistry(rc)
 	monster.InitAttackCooldownRegistry(rc)
+	monster.InitElementOverrideRegistry(rc)
 	monster.InitMonsterRegistry(rc)
 	monster.InitDropTimerRegistry(rc)
 	monster.InitPuppetRegistry(rc)
@@ -24,12 +24,17 @@ type taskEmitter func(t tenant.Model, topic string, provider model.Provider[[]ka
 // REST middleware rejects requests without a TENANT_ID header.
 type bossLookupFn func(t tenant.Model, monsterTemplateId uint32) bool
 
+// retargetFn hands a monster's control to its threat leader after its threat
+// decayed. Injected for tests; nil skips the retarget.
+type retargetFn func(t tenant.Model, uniqueId uint32)
+
 type MonsterAggroDecayTask struct {
 	l            logrus.FieldLogger
 	ctx          context.Context
 	interval     time.Duration
 	bossLookupFn bossLookupFn
 	emit         taskEmitter
+	retargetFn   retargetFn
 	nowFn        func() int64
 }
 
@@ -50,6 +55,10 @@ func NewMonsterAggroDecayTask(l logrus.FieldLogger, ctx context.Context, interva
 		}
 		return ma.Boss()
 	}
+	tk.retargetFn = func(t tenant.Model, uniqueId uint32) {
+		tctx := tenant.WithContext(tk.ctx, t)
+		_ = NewProcessor(tk.l, tctx).RetargetByThreat(uniqueId)
+	}
 	tk.emit = func(t tenant.Model, topic string, provider model.Provider[[]kafka.Message]) error {
 		tctx := tenant.WithContext(tk.ctx, t)
 		return producer.ProviderImpl(tk.l)(tctx)(topic)(provider)
@@ -72,6 +81,8 @@ func (tk *MonsterAggroDecayTask) Run() {
 			bossCache[tenantId] = make(map[uint32]bool)
 		}
 		for _, m := range mons {
+			tk.decayThreat(ten, m, nowMs)
+
 			templateId := m.MonsterId()
 			isBoss, ok := bossCache[tenantId][templateId]
 			if !ok {
@@ -107,3 +118,26 @@ func (tk *MonsterAggroDecayTask) Run() {
 		}
 	}
 }
+
+// decayThreat decays the monster's idle threat, bosses included, and lets
+// control follow the threat leader once the controller's own threat has
+// decayed away from it.
+func (tk *MonsterAggroDecayTask) decayThreat(ten tenant.Model, m Model, nowMs int64) {
+	idle := false
+	for _, e := range m.ThreatEntries() {
+		if nowMs-e.LastHitMs > AggroIdleThresholdMs {
+			idle = true
+			break
+		}
+	}
+	if !idle {
+		return
+	}
+	if _, err := GetMonsterRegistry().DecayThreat(ten, m.UniqueId(), nowMs); err != nil {
+		tk.l.WithError(err).Errorf("Threat decay failed for monster [%d].", m.UniqueId())
+		return
+	}
+	if tk.retargetFn != nil {
+		tk.retargetFn(ten, m.UniqueId())
+	}
+}
@@ -1,6 +1,8 @@
 package monster
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
@@ -32,6 +34,8 @@ func Clone(m Model) *ModelBuilder {
 		stance:             m.stance,
 		team:               m.team,
 		damageEntries:      m.damageEntries,
+		threatEntries:      m.threatEntries,
+		phase:              m.phase,
 		statusEffects:      effects,
 		nextSkillDecision:  m.nextSkillDecision,
 		lastDamageTakenMs:  m.lastDamageTakenMs,
@@ -60,6 +64,8 @@ type ModelBuilder struct {
 	stance             byte
 	team               int8
 	damageEntries      []entry
+	threatEntries      []threatEntry
+	phase              byte
 	statusEffects      []StatusEffect
 	nextSkillDecision  nextSkillDecision
 	lastDamageTakenMs  int64
@@ -145,31 +151,40 @@ func (b *ModelBuilder) AddDamageEntry(characterId uint32, damage uint32) *ModelB
 	return b
 }
 
-// AddStatusEffect adds a status effect, replacing any existing effect with overlapping status types.
-// Exception: VENOM stacks up to 3 times. When the cap is reached, the
-// VENOM-bearing effect with the earliest ExpiresAt is evicted (not the
-// first-inserted), per design D3 / PRD FR-4.4.2.
+// AddStatusEffect adds a status effect under each of its statuses' StatusRule:
+// a status already at MaxStacks (1 for most, 3 for VENOM) has its
+// earliest-expiring stack evicted, per design D3 / PRD FR-4.4.2. A ticking
+// effect inherits the tick clock of the earliest-ticked stack it displaced,
+// so a refresh neither resets nor skips the cadence.
 func (b *ModelBuilder) AddStatusEffect(effect StatusEffect) *ModelBuilder {
+	var inherited time.Time
 	for statusType := range effect.Statuses() {
-		if statusType == "VENOM" {
-			venomCount := 0
+		maxStacks := StatusRuleFor(statusType).MaxStacks
+		for {
+			count := 0
 			evictIdx := -1
 			for i, se := range b.statusEffects {
-				if !se.HasStatus("VENOM") {
+				if !se.HasStatus(statusType) {
 					continue
 				}
-				venomCount++
+				count++
 				if evictIdx < 0 || se.ExpiresAt().Before(b.statusEffects[evictIdx].ExpiresAt()) {
 					evictIdx = i
 				}
 			}
-			if venomCount >= 3 && evictIdx >= 0 {
-				b.statusEffects = append(b.statusEffects[:evictIdx], b.statusEffects[evictIdx+1:]...)
+			if count < maxStacks {
+				break
+			}
+			evicted := b.statusEffects[evictIdx]
+			if evicted.TickInterval() > 0 && (inherited.IsZero() || evicted.LastTick().Before(inherited)) {
+				inherited = evicted.LastTick()
 			}
-		} else {
-			b.RemoveStatusEffectByType(statusType)
+			b.statusEffects = append(b.statusEffects[:evictIdx], b.statusEffects[evictIdx+1:]...)
 		}
 	}
+	if effect.TickInterval() > 0 && !inherited.IsZero() && inherited.Before(effect.LastTick()) {
+		effect = effect.WithLastTick(inherited)
+	}
 	b.statusEffects = append(b.statusEffects, effect)
 	return b
 }
@@ -224,6 +239,8 @@ func (b *ModelBuilder) Build() Model {
 		stance:             b.stance,
 		team:               b.team,
 		damageEntries:      b.damageEntries,
+		threatEntries:      b.threatEntries,
+		phase:              b.phase,
 		statusEffects:      b.statusEffects,
 		nextSkillDecision:  b.nextSkillDecision,
 		lastDamageTakenMs:  b.lastDamageTakenMs,
@@ -0,0 +1,213 @@
+package monster
+
+import (
+	"atlas-monsters/monster/information"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+	atlasredis "github.com/Chronicle20/atlas/libs/atlas-redis"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// Elements resolved against a monster's WZ elemAttr. The strings are the
+// keys atlas-data emits for monster resistances and skill elements
+// ("LIGHTING" is its spelling). Physical / neutral attacks are not resolved.
+const (
+	ElementFire      = "FIRE"
+	ElementIce       = "ICE"
+	ElementLightning = "LIGHTING"
+	ElementPoison    = "POISON"
+	ElementHoly      = "HOLY"
+)
+
+// Effectiveness values, as atlas-data emits them. A monster that does not
+// list an element is EffectivenessNormal against it.
+const (
+	EffectivenessNormal = "NORMAL"
+	EffectivenessImmune = "IMMUNE"
+	EffectivenessStrong = "STRONG"
+	EffectivenessWeak   = "WEAK"
+)
+
+// Outcomes carried on the RESISTED status event.
+const (
+	ResistOutcomeImmune = "IMMUNE"
+	ResistOutcomeResist = "RESIST"
+)
+
+// Reasons carried on the RESISTED status event.
+const (
+	ResistReasonElement = "ELEMENT"
+	ResistReasonBoss    = "BOSS"
+)
+
+// strongStatusResistChance is the chance an element-bound status is shrugged
+// off by a monster STRONG against that element. IMMUNE always blocks.
+const strongStatusResistChance = 0.5
+
+// statusElements maps element-bound statuses to the element whose
+// resistance gates them.
+var statusElements = map[string]string{
+	StatusPoison:          ElementPoison,
+	monster2.StatusFreeze: ElementIce,
+}
+
+// IsResolvedElement reports whether atlas-monsters applies effectiveness for
+// the element.
+func IsResolvedElement(element string) bool {
+	switch element {
+	case ElementFire, ElementIce, ElementLightning, ElementPoison, ElementHoly:
+		return true
+	}
+	return false
+}
+
+// ElementalMultiplier is the damage factor of an effectiveness: WEAK 1.5,
+// STRONG 0.5, IMMUNE 0, anything else 1. Elemental Reset (resetPercent, the
+// caster's ELEMENTAL_RESET amount) closes that share of a resistance's gap to
+// normal damage; it never touches a weakness.
+func ElementalMultiplier(effectiveness string, resetPercent uint32) float64 {
+	r := math.Min(float64(resetPercent), 100) / 100
+	switch effectiveness {
+	case EffectivenessWeak:
+		return 1.5
+	case EffectivenessStrong:
+		return 0.5 + 0.5*r
+	case EffectivenessImmune:
+		return r
+	}
+	return 1
+}
+
+// elementalDamage rewrites client-reported damage lines for the monster's
+// current effectiveness. The client already applied the WZ (base)
+// effectiveness, so only the difference is applied here: lines scale by
+// current/base when a temporary override changed it, and collapse to at
+// most 1 when the monster is effectively immune. immune reports the latter.
+// A base-immune monster made weak keeps its lines: the client's ~1-damage
+// lines carry no information to scale.
+func elementalDamage(damages []uint32, base string, current string, resetPercent uint32) (out []uint32, immune bool) {
+	cur := ElementalMultiplier(current, resetPercent)
+	if cur == 0 {
+		out = make([]uint32, len(damages))
+		for i, d := range damages {
+			out[i] = min(d, 1)
+		}
+		return out, true
+	}
+	if base == current {
+		return damages, false
+	}
+	b := ElementalMultiplier(base, resetPercent)
+	if b == 0 {
+		return damages, false
+	}
+	out = make([]uint32, len(damages))
+	for i, d := range damages {
+		out[i] = uint32(math.Round(float64(d) * cur / b))
+	}
+	return out, false
+}
+
+// elementalStatusOutcome decides whether a player-sourced status effect is
+// blocked by the monster's resistance to an element-bound status in it.
+// effectiveness resolves the monster's current effectiveness for an element.
+// DOOM (Priest, 2311005) intentionally bypasses elemental resistance: the
+// polymorph-to-snail effect overrides it — a fire-immune mob still becomes a
+// snail. roll is a uniform [0,1) draw for the STRONG resist chance.
+func elementalStatusOutcome(effect StatusEffect, effectiveness func(element string) string, roll float64) (outcome string, element string) {
+	if _, ok := effect.Statuses()[monster2.StatusDoom]; ok {
+		return "", ""
+	}
+	for statusType := range effect.Statuses() {
+		e, ok := statusElements[statusType]
+		if !ok {
+			continue
+		}
+		switch effectiveness(e) {
+		case EffectivenessImmune:
+			return ResistOutcomeImmune, e
+		case EffectivenessStrong:
+			if roll < strongStatusResistChance {
+				return ResistOutcomeResist, e
+			}
+		}
+	}
+	return "", ""
+}
+
+// elementOverrideRegistry holds temporary effectiveness overrides (Fire
+// Demon / Ice Demon weaken) per monster and element. Entries carry a Redis
+// TTL equal to the override's duration, so expiry needs no task.
+type elementOverrideRegistry struct {
+	reg *atlasredis.TenantRegistry[string, string]
+}
+
+var (
+	elementOverrideReg  *elementOverrideRegistry
+	elementOverrideOnce sync.Once
+)
+
+func InitElementOverrideRegistry(rc *goredis.Client) {
+	elementOverrideOnce.Do(func() {
+		elementOverrideReg = &elementOverrideRegistry{
+			reg: atlasredis.NewTenantRegistry[string, string](rc, "monster-element-override", func(s string) string { return s }),
+		}
+	})
+}
+
+func GetElementOverrideRegistry() *elementOverrideRegistry {
+	return elementOverrideReg
+}
+
+func elementOverrideKey(uniqueId uint32, element string) string {
+	return fmt.Sprintf("%s:%s", strconv.FormatUint(uint64(uniqueId), 10), element)
+}
+
+func elementOverrideMonsterPrefix(uniqueId uint32) string {
+	return fmt.Sprintf("%s:", strconv.FormatUint(uint64(uniqueId), 10))
+}
+
+func (r *elementOverrideRegistry) Set(ctx context.Context, t tenant.Model, uniqueId uint32, element string, effectiveness string, duration time.Duration) error {
+	return r.reg.PutWithTTL(ctx, t, elementOverrideKey(uniqueId, element), effectiveness, duration)
+}
+
+// Get returns the active override for the element, if any.
+func (r *elementOverrideRegistry) Get(ctx context.Context, t tenant.Model, uniqueId uint32, element string) (string, bool) {
+	v, err := r.reg.Get(ctx, t, elementOverrideKey(uniqueId, element))
+	if err != nil || v == "" {
+		return "", false
+	}
+	return v, true
+}
+
+func (r *elementOverrideRegistry) ClearMonster(ctx context.Context, t tenant.Model, uniqueId uint32) {
+	_, _ = r.reg.ClearByPrefix(ctx, t, elementOverrideMonsterPrefix(uniqueId))
+}
+
+// elementEffectiveness resolves the monster's WZ (base) and current
+// effectiveness against element; current honours an active override.
+func (p *ProcessorImpl) elementEffectiveness(uniqueId uint32, info information.Model, element string) (base string, current string) {
+	base = info.ElementEffectiveness(element)
+	current = base
+	if r := GetElementOverrideRegistry(); r != nil {
+		if o, ok := r.Get(p.ctx, p.t, uniqueId, element); ok {
+			current = o
+		}
+	}
+	return base, current
+}
+
+// clearElementOverrides drops every override of a monster leaving the field.
+func (p *ProcessorImpl) clearElementOverrides(uniqueId uint32) {
+	if r := GetElementOverrideRegistry(); r != nil {
+		r.ClearMonster(p.ctx, p.t, uniqueId)
+	}
+}
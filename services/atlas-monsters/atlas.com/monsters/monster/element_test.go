@@ -0,0 +1,192 @@
+package monster
+
+import (
+	"atlas-monsters/monster/information"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func TestElementalMultiplier(t *testing.T) {
+	cases := []struct {
+		eff   string
+		reset uint32
+		want  float64
+	}{
+		{EffectivenessNormal, 0, 1},
+		{EffectivenessWeak, 0, 1.5},
+		{EffectivenessWeak, 50, 1.5},
+		{EffectivenessStrong, 0, 0.5},
+		{EffectivenessStrong, 50, 0.75},
+		{EffectivenessImmune, 0, 0},
+		{EffectivenessImmune, 40, 0.4},
+		{EffectivenessImmune, 250, 1},
+		{"NEUTRAL", 0, 1},
+	}
+	for _, c := range cases {
+		require.InDelta(t, c.want, ElementalMultiplier(c.eff, c.reset), 1e-9, "%s reset=%d", c.eff, c.reset)
+	}
+}
+
+func TestElementalDamage(t *testing.T) {
+	// Unchanged effectiveness: the client already applied it.
+	out, immune := elementalDamage([]uint32{100, 200}, EffectivenessWeak, EffectivenessWeak, 0)
+	require.False(t, immune)
+	require.Equal(t, []uint32{100, 200}, out)
+
+	// Normal monster weakened: scaled by 1.5.
+	out, immune = elementalDamage([]uint32{100, 200}, EffectivenessNormal, EffectivenessWeak, 0)
+	require.False(t, immune)
+	require.Equal(t, []uint32{150, 300}, out)
+
+	// Strong monster weakened: the client halved, the server triples.
+	out, _ = elementalDamage([]uint32{100}, EffectivenessStrong, EffectivenessWeak, 0)
+	require.Equal(t, []uint32{300}, out)
+
+	// Immune without Elemental Reset: every line collapses to at most 1.
+	out, immune = elementalDamage([]uint32{900, 0}, EffectivenessImmune, EffectivenessImmune, 0)
+	require.True(t, immune)
+	require.Equal(t, []uint32{1, 0}, out)
+
+	// Immune with Elemental Reset lets the client's reduced lines through.
+	out, immune = elementalDamage([]uint32{400}, EffectivenessImmune, EffectivenessImmune, 40)
+	require.False(t, immune)
+	require.Equal(t, []uint32{400}, out)
+}
+
+func TestElementalStatusOutcome(t *testing.T) {
+	freeze := NewStatusEffect(SourceTypePlayerSkill, 1, 2201004, 20, map[string]int32{monster2.StatusFreeze: 1}, time.Second, 0)
+	eff := func(v string) func(string) string { return func(string) string { return v } }
+
+	o, e := elementalStatusOutcome(freeze, eff(EffectivenessImmune), 0.99)
+	require.Equal(t, ResistOutcomeImmune, o)
+	require.Equal(t, ElementIce, e)
+
+	o, _ = elementalStatusOutcome(freeze, eff(EffectivenessStrong), 0.1)
+	require.Equal(t, ResistOutcomeResist, o)
+
+	o, _ = elementalStatusOutcome(freeze, eff(EffectivenessStrong), 0.9)
+	require.Empty(t, o)
+
+	o, _ = elementalStatusOutcome(freeze, eff(EffectivenessWeak), 0)
+	require.Empty(t, o)
+
+	stun := NewStatusEffect(SourceTypePlayerSkill, 1, 1111005, 20, map[string]int32{monster2.StatusStun: 1}, time.Second, 0)
+	o, _ = elementalStatusOutcome(stun, eff(EffectivenessImmune), 0)
+	require.Empty(t, o, "statuses without an element are never resisted elementally")
+}
+
+func TestElementOverrideRegistry_SetGetClear(t *testing.T) {
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := context.Background()
+	r := GetElementOverrideRegistry()
+
+	require.NoError(t, r.Set(ctx, ten, 10, ElementIce, EffectivenessWeak, time.Minute))
+	require.NoError(t, r.Set(ctx, ten, 100, ElementIce, EffectivenessWeak, time.Minute))
+	v, ok := r.Get(ctx, ten, 10, ElementIce)
+	require.True(t, ok)
+	require.Equal(t, EffectivenessWeak, v)
+	_, ok = r.Get(ctx, ten, 10, ElementFire)
+	require.False(t, ok)
+
+	r.ClearMonster(ctx, ten, 10)
+	_, ok = r.Get(ctx, ten, 10, ElementIce)
+	require.False(t, ok)
+	_, ok = r.Get(ctx, ten, 100, ElementIce)
+	require.True(t, ok, "clearing monster 10 must not clear monster 100")
+
+	testMiniRedis.FastForward(2 * time.Minute)
+	_, ok = r.Get(ctx, ten, 100, ElementIce)
+	require.False(t, ok, "override expires with its TTL")
+}
+
+func newElementTestMonster(t *testing.T, resistances map[string]string) (*ProcessorImpl, *[]emittedBody, tenant.Model, Model) {
+	t.Helper()
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := tenant.WithContext(context.Background(), ten)
+	GetMonsterRegistry().Clear(ctx)
+
+	testInformationLookup = func(uint32) (information.Model, error) {
+		return information.NewModelBuilder().SetResistances(resistances).Build(), nil
+	}
+	t.Cleanup(func() { testInformationLookup = nil })
+
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	m := GetMonsterRegistry().CreateMonster(ctx, ten, f, 9300018, 0, 0, 0, 5, 0, 10000, 50, "", "")
+	p, events := newRecordingProcessorWithBodies(t, ten)
+	p.ctx = ctx
+	return p, events, ten, m
+}
+
+func TestDamage_ImmuneElementCollapsesLinesAndReportsResisted(t *testing.T) {
+	p, events, ten, m := newElementTestMonster(t, map[string]string{"FIRE": "IMMUNE"})
+
+	p.Damage(m.UniqueId(), 7, []uint32{500, 500}, 0, ElementFire, 0)
+
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(10000-2), got.Hp())
+
+	require.NotEmpty(t, *events)
+	require.Equal(t, EventMonsterStatusResisted, (*events)[0].Type)
+	var body statusEventResistedBody
+	require.NoError(t, json.Unmarshal((*events)[0].Body, &body))
+	require.Equal(t, uint32(7), body.SourceCharacterId)
+	require.Equal(t, ElementFire, body.Element)
+	require.Equal(t, ResistOutcomeImmune, body.Outcome)
+}
+
+func TestDamage_WeakenedElementScalesLines(t *testing.T) {
+	p, events, ten, m := newElementTestMonster(t, nil)
+
+	require.NoError(t, p.WeakenElement(m.UniqueId(), 7, ElementIce, time.Minute))
+	p.Damage(m.UniqueId(), 7, []uint32{200}, 0, ElementIce, 0)
+	// Another element is untouched.
+	p.Damage(m.UniqueId(), 7, []uint32{200}, 0, ElementFire, 0)
+
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(10000-300-200), got.Hp())
+	for _, e := range *events {
+		require.NotEqual(t, EventMonsterStatusResisted, e.Type)
+	}
+}
+
+func TestWeakenElement_NoOpWhenAlreadyWeak(t *testing.T) {
+	p, _, ten, m := newElementTestMonster(t, map[string]string{"ICE": "WEAK"})
+
+	require.NoError(t, p.WeakenElement(m.UniqueId(), 7, ElementIce, time.Minute))
+	_, ok := GetElementOverrideRegistry().Get(p.ctx, ten, m.UniqueId(), ElementIce)
+	require.False(t, ok)
+}
+
+func TestApplyStatusEffect_ElementImmuneRejectedAndReported(t *testing.T) {
+	p, events, ten, m := newElementTestMonster(t, map[string]string{"ICE": "IMMUNE"})
+
+	freeze := NewStatusEffect(SourceTypePlayerSkill, 7, 2201004, 20, map[string]int32{monster2.StatusFreeze: 1}, 10*time.Second, 0)
+	require.Error(t, p.ApplyStatusEffect(m.UniqueId(), freeze))
+
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Empty(t, got.StatusEffects())
+
+	require.Len(t, *events, 1)
+	require.Equal(t, EventMonsterStatusResisted, (*events)[0].Type)
+	var body statusEventResistedBody
+	require.NoError(t, json.Unmarshal((*events)[0].Body, &body))
+	require.Equal(t, ResistReasonElement, body.Reason)
+	require.Equal(t, ElementIce, body.Element)
+	require.Equal(t, uint32(2201004), body.SourceSkillId)
+	require.Equal(t, []string{monster2.StatusFreeze}, body.ResistedStatuses)
+}
@@ -46,10 +46,10 @@ func (b *ModelBuilder) SetBoss(boss bool) *ModelBuilder {
 	return b
 }
 
-// SetResistances sets the elemental resistance map on the builder. Keys are
-// element letters ("P", "I", "F", "S", "L"); value "1" means immune (per
-// Model.IsImmuneToElement). Used by tests that drive elemental-immunity
-// branches in ApplyStatusEffect.
+// SetResistances sets the elemental resistance map on the builder, in the
+// atlas-data shape (element name → "IMMUNE"/"STRONG"/"WEAK"/"NEUTRAL", per
+// Model.ElementEffectiveness). Used by tests that drive the elemental
+// branches of Damage and ApplyStatusEffect.
 func (b *ModelBuilder) SetResistances(r map[string]string) *ModelBuilder {
 	b.resistances = r
 	return b
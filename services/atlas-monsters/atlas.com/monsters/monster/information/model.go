@@ -75,8 +75,6 @@ func (m Model) Banish() Banish {
 	return m.banish
 }
 
-// IsImmuneToElement checks if the monster is immune to a given element.
-// Resistance values: "1"=immune, "2"=strong, "3"=normal, "4"=weak
 func (m Model) Friendly() bool {
 	return m.friendly
 }
@@ -89,13 +87,15 @@ func (m Model) DropPeriod() uint32 {
 	return m.dropPeriod
 }
 
-// IsImmuneToElement checks if the monster is immune to a given element.
-// Resistance values: "1"=immune, "2"=strong, "3"=normal, "4"=weak
-func (m Model) IsImmuneToElement(element string) bool {
-	if r, ok := m.resistances[element]; ok {
-		return r == "1"
+// ElementEffectiveness returns the monster's WZ effectiveness against an
+// element. Resistances arrive from atlas-data keyed by element name ("FIRE",
+// "ICE", "LIGHTING", "POISON", "HOLY", ...) with values "IMMUNE", "STRONG",
+// "WEAK" or "NEUTRAL"; an element the monster does not list is "NORMAL".
+func (m Model) ElementEffectiveness(element string) string {
+	if r, ok := m.resistances[element]; ok && r != "" {
+		return r
 	}
-	return false
+	return "NORMAL"
 }
 
 func (m Model) HpRecovery() uint32 {
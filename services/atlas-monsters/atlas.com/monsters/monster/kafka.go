@@ -25,12 +25,14 @@ const (
 	EventMonsterStatusEffectExpired    = "STATUS_EXPIRED"
 	EventMonsterStatusEffectCancelled  = "STATUS_CANCELLED"
 	EventMonsterStatusDamageReflected  = "DAMAGE_REFLECTED"
+	EventMonsterStatusResisted         = "RESISTED"
 	EventMonsterStatusFriendlyDrop     = "FRIENDLY_DROP"
 	EventMonsterStatusAggroChanged     = "AGGRO_CHANGED"
 	EventMonsterStatusNextSkillDecided = "NEXT_SKILL_DECIDED"
 	EventMonsterStatusMpChanged        = "MP_CHANGED"
 	EventMonsterStatusCaught           = "CAUGHT"
 	EventMonsterStatusCatchFailed      = "CATCH_FAILED"
+	EventMonsterStatusPhaseChanged     = "PHASE_CHANGED"
 
 	EventMonsterCatchResolved = "CATCH_RESOLVED"
 
@@ -175,6 +177,18 @@ type statusEventDamageReflectedBody struct {
 	ReflectType   string `json:"reflectType"`
 }
 
+// statusEventResistedBody reports an attack or status the monster shrugged
+// off. Reason is ResistReasonElement or ResistReasonBoss; Element is set only
+// for the former. ResistedStatuses is empty for a damage (attack) immunity.
+type statusEventResistedBody struct {
+	SourceCharacterId uint32   `json:"sourceCharacterId"`
+	SourceSkillId     uint32   `json:"sourceSkillId"`
+	Reason            string   `json:"reason"`
+	Element           string   `json:"element,omitempty"`
+	Outcome           string   `json:"outcome"`
+	ResistedStatuses  []string `json:"resistedStatuses,omitempty"`
+}
+
 type statusEventFriendlyDropBody struct {
 	ItemCount uint32 `json:"itemCount"`
 }
@@ -186,6 +200,15 @@ type statusEventNextSkillDecidedBody struct {
 	NextEligibleRepickAtMs int64 `json:"nextEligibleRepickAtMs"`
 }
 
+// statusEventPhaseChangedBody reports a boss falling through one of its WZ
+// HP thresholds. HpThreshold is the gate that opened Phase; HpPercentage is
+// the HP that crossed it (a big hit can skip straight past several gates).
+type statusEventPhaseChangedBody struct {
+	Phase        byte   `json:"phase"`
+	HpThreshold  uint32 `json:"hpThreshold"`
+	HpPercentage uint32 `json:"hpPercentage"`
+}
+
 type statusEventMpChangedBody struct {
 	CharacterId    uint32 `json:"characterId"`
 	SkillId        uint32 `json:"skillId"`
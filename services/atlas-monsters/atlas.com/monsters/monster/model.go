@@ -61,9 +61,14 @@ type Model struct {
 	stance             byte
 	team               int8
 	damageEntries      []entry
-	statusEffects      []StatusEffect
-	nextSkillDecision  nextSkillDecision
-	lastDamageTakenMs  int64
+	// threatEntries is the aggro table: damage-weighted, decaying threat per
+	// character, kept apart from damageEntries so decay and taunts never
+	// move kill credit.
+	threatEntries     []threatEntry
+	phase             byte
+	statusEffects     []StatusEffect
+	nextSkillDecision nextSkillDecision
+	lastDamageTakenMs int64
 	// spawnSourceType / spawnSourceId are opaque provenance, set by whatever
 	// asked for the spawn (FR-P1). atlas-monsters stores, echoes and compares
 	// them for equality; it never interprets spawnSourceId (FR-P6). Empty means
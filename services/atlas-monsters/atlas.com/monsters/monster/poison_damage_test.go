@@ -131,18 +131,18 @@ func TestCalculatePoisonDamage_UsesResolvedMagnitude(t *testing.T) {
 
 	resolved := NewStatusEffect(SourceTypePlayerSkill, 1, 2111003, 30,
 		map[string]int32{StatusPoison: 380}, 40*time.Second, time.Second)
-	require.Equal(t, uint32(380), task.calculatePoisonDamage(m, resolved))
+	require.Equal(t, uint32(380), task.calculateTickDamage(m, resolved, StatusPoison))
 
 	// A magnitude the caster could not have known differs from the formula;
 	// the stored value still wins.
 	pinned := NewStatusEffect(SourceTypePlayerSkill, 1, 2111003, 30,
 		map[string]int32{StatusPoison: 42}, 40*time.Second, time.Second)
-	require.Equal(t, uint32(42), task.calculatePoisonDamage(m, pinned))
+	require.Equal(t, uint32(42), task.calculateTickDamage(m, pinned, StatusPoison))
 
 	// Fallback for an effect that never passed through ApplyStatusEffect.
 	unresolved := NewStatusEffect(SourceTypePlayerSkill, 1, 2111003, 30,
 		map[string]int32{StatusPoison: 0}, 40*time.Second, time.Second)
-	require.Equal(t, uint32(380), task.calculatePoisonDamage(m, unresolved))
+	require.Equal(t, uint32(380), task.calculateTickDamage(m, unresolved, StatusPoison))
 }
 
 // The DAMAGED event must report the damage THIS event applied. DamageEntries
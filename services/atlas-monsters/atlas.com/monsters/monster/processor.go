@@ -2,6 +2,7 @@ package monster
 
 import (
 	"atlas-monsters/character/hidden"
+	"atlas-monsters/character/skill"
 	mistKafka "atlas-monsters/kafka/message/mist"
 	_map "atlas-monsters/map"
 	"atlas-monsters/monster/information"
@@ -49,7 +50,8 @@ type Processor interface {
 	RelinquishControlOnHide(characterId uint32) error
 	RestoreCandidacyOnReveal(characterId uint32) error
 	FindNextController(idp model.Provider[[]uint32]) model.Operator[Model]
-	Damage(id uint32, characterId uint32, damages []uint32, attackType byte)
+	Damage(id uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32)
+	WeakenElement(uniqueId uint32, characterId uint32, element string, duration time.Duration) error
 	DamageFriendly(uniqueId uint32, attackerUniqueId uint32, observerUniqueId uint32)
 	Move(id uint32, x int16, y int16, fh int16, stance byte) error
 	Destroy(uniqueId uint32) error
@@ -68,6 +70,9 @@ type Processor interface {
 	Catch(uniqueId uint32, characterId uint32, itemId uint32)
 	ClearAggro(uniqueId uint32) error
 	ForceControl(uniqueId uint32, characterId uint32) error
+	Taunt(uniqueId uint32, characterId uint32) error
+	RetargetByThreat(uniqueId uint32) error
+	DrawToPuppet(f field.Model, ownerCharacterId uint32, x int16, y int16) error
 }
 
 // emitter publishes a kafka message provider to a topic. ProcessorImpl uses
@@ -80,6 +85,11 @@ type emitter func(topic string, provider model.Provider[[]kafka.Message]) error
 // normally.
 var testInformationLookup func(monsterId uint32) (information.Model, error)
 
+// testCasterSkillLevelLookup is a test-only override for the caster's
+// trained skill level. When nil (production), ApplyStatusEffect asks
+// atlas-skills.
+var testCasterSkillLevelLookup func(characterId uint32, skillId uint32) (byte, error)
+
 // testMobSkillLookup is a test-only override for mobskill.GetByIdAndLevel.
 // When nil (production), UseSkill calls mobskill.GetByIdAndLevel normally.
 var testMobSkillLookup func(skillId uint16, level uint16) (mobskill.Model, error)
@@ -90,6 +100,10 @@ var testMobSkillLookup func(skillId uint16, level uint16) (mobskill.Model, error
 // treat it as "leave uncontrolled", not an error.
 var ErrNoControllerCandidate = errors.New("no controller candidate")
 
+// ErrStatusSkillLevel rejects a player status whose source skill level
+// exceeds what the caster has trained.
+var ErrStatusSkillLevel = errors.New("status skill level exceeds caster's")
+
 // ProcessorImpl implements the Processor interface
 type ProcessorImpl struct {
 	l          logrus.FieldLogger
@@ -520,7 +534,9 @@ func (p *ProcessorImpl) RestoreCandidacyOnReveal(characterId uint32) error {
 // dropped (overkill discarded). Always emits a `damaged` event reflecting the
 // final state, plus a `killed` event when the attack lands a kill, so the
 // channel writes the final HP-bar packet before the death animation.
-func (p *ProcessorImpl) Damage(id uint32, characterId uint32, damages []uint32, attackType byte) {
+// element is the attack's element ("" for physical); elementalReset is the
+// caster's Elemental Reset percentage. See applyElement.
+func (p *ProcessorImpl) Damage(id uint32, characterId uint32, damages []uint32, attackType byte, element string, elementalReset uint32) {
 	if len(damages) == 0 {
 		return
 	}
@@ -538,9 +554,72 @@ func (p *ProcessorImpl) Damage(id uint32, characterId uint32, damages []uint32,
 	// Reflect runs once per attack, not once per line.
 	p.checkReflect(m, characterId, attackType)
 
+	damages = p.applyElement(m, characterId, damages, element, elementalReset)
+
 	p.damageCore(m, characterId, damages)
 }
 
+// applyElement adjusts an attack's damage lines for the monster's elemental
+// effectiveness (see elementalDamage) and reports an immune hit to the
+// attacker with a RESISTED event. Physical and unresolved elements, and a
+// failed information lookup, leave the lines untouched.
+func (p *ProcessorImpl) applyElement(m Model, characterId uint32, damages []uint32, element string, elementalReset uint32) []uint32 {
+	if !IsResolvedElement(element) {
+		return damages
+	}
+	var info information.Model
+	var err error
+	if testInformationLookup != nil {
+		info, err = testInformationLookup(m.MonsterId())
+	} else {
+		info, err = information.NewProcessor(p.l, p.ctx).GetById(m.MonsterId())
+	}
+	if err != nil {
+		return damages
+	}
+	base, current := p.elementEffectiveness(m.UniqueId(), info, element)
+	out, immune := elementalDamage(damages, base, current, elementalReset)
+	if immune {
+		p.l.Debugf("Monster [%d] is immune to element [%s]. Attack by character [%d] reduced.", m.UniqueId(), element, characterId)
+		_ = p.emit(EnvEventTopicMonsterStatus, resistedEventProvider(m, characterId, 0, ResistReasonElement, element, ResistOutcomeImmune, nil))
+	}
+	return out
+}
+
+// WeakenElement temporarily makes a monster WEAK to element (Fire Demon /
+// Ice Demon). A monster already weak to it is left alone; an existing
+// override is replaced, restarting its duration.
+func (p *ProcessorImpl) WeakenElement(uniqueId uint32, characterId uint32, element string, duration time.Duration) error {
+	if !IsResolvedElement(element) || duration <= 0 {
+		return errors.New("invalid element weaken")
+	}
+	m, err := GetMonsterRegistry().GetMonster(p.t, uniqueId)
+	if err != nil {
+		return err
+	}
+	if !m.Alive() {
+		return nil
+	}
+	var info information.Model
+	if testInformationLookup != nil {
+		info, err = testInformationLookup(m.MonsterId())
+	} else {
+		info, err = information.NewProcessor(p.l, p.ctx).GetById(m.MonsterId())
+	}
+	if err != nil {
+		return err
+	}
+	if info.ElementEffectiveness(element) == EffectivenessWeak {
+		return nil
+	}
+	r := GetElementOverrideRegistry()
+	if r == nil {
+		return errors.New("element override registry not initialized")
+	}
+	p.l.Debugf("Character [%d] weakened monster [%d] to element [%s] for [%s].", characterId, uniqueId, element, duration)
+	return r.Set(p.ctx, p.t, uniqueId, element, EffectivenessWeak, duration)
+}
+
 // damageCore applies damage lines to an already-fetched, alive monster and
 // runs the full post-damage flow: damaged event, damage picker, kill
 // handling (cooldown/drop-timer clears, status-cancel emits, killed event,
@@ -551,10 +630,17 @@ func (p *ProcessorImpl) Damage(id uint32, characterId uint32, damages []uint32,
 // gated the triggering hit on reflect, and a kill "attack" has no attack
 // type.
 func (p *ProcessorImpl) damageCore(m Model, characterId uint32, damages []uint32) {
-	// Fetch monster info for boss flag and revives
+	// Fetch monster info for boss flag, phases and revives
 	var isBoss bool
 	var revives []uint32
-	if ma, infoErr := information.NewProcessor(p.l, p.ctx).GetById(m.MonsterId()); infoErr == nil {
+	var ma information.Model
+	var infoErr error
+	if testInformationLookup != nil {
+		ma, infoErr = testInformationLookup(m.MonsterId())
+	} else {
+		ma, infoErr = information.NewProcessor(p.l, p.ctx).GetById(m.MonsterId())
+	}
+	if infoErr == nil {
 		isBoss = ma.Boss()
 		revives = ma.Revives()
 	}
@@ -598,6 +684,15 @@ func (p *ProcessorImpl) damageCore(m Model, characterId uint32, damages []uint32
 		p.l.WithError(err).Errorf("Monster [%d] damaged, but unable to display that for the characters in the field.", last.Monster.UniqueId())
 	}
 
+	// A boss falling through a WZ HP threshold changes phase; control then
+	// goes to the top of the threat table without hysteresis, so the new
+	// phase plays out against the same target whichever client controlled
+	// the boss before.
+	phaseChanged := false
+	if !killed && isBoss && last.Monster.HpPercentage() != oldHpPercentage {
+		phaseChanged = p.advancePhase(last.Monster, ma)
+	}
+
 	// FR-3.1: Fire the picker on every first hit (so a missed attack that
 	// flips controllerHasAggro can begin casting), and on every subsequent hit
 	// that changes HP percentage.
@@ -612,6 +707,7 @@ func (p *ProcessorImpl) damageCore(m Model, characterId uint32, damages []uint32
 		GetCooldownRegistry().ClearCooldowns(p.ctx, p.t, m.UniqueId())
 		GetAttackCooldownRegistry().ClearCooldowns(p.ctx, p.t, m.UniqueId())
 		GetDropTimerRegistry().Unregister(p.ctx, p.t, m.UniqueId())
+		p.clearElementOverrides(m.UniqueId())
 
 		// Emit cancellation events for any active status effects before death
 		for _, se := range last.Monster.StatusEffects() {
@@ -640,32 +736,13 @@ func (p *ProcessorImpl) damageCore(m Model, characterId uint32, damages []uint32
 	// STOP_CONTROL/START_CONTROL pairs; this is acceptable because Kafka
 	// partition ordering preserves causality and the channel re-applies
 	// idempotently for re-control to the same character.
-	controllerSwitched := false
-	// Controller-switch on DPS lead applies to bosses too. Only the decay sweep
+	// Control follows threat on bosses too; only the damage-entry decay
 	// (MonsterAggroDecayTask) treats bosses specially.
-	if characterId != last.Monster.ControlCharacterId() && last.Monster.DamageLeader() == characterId {
-		if _, isHidden := p.hiddenSet()[characterId]; isHidden {
-			p.l.Debugf("Skipping DPS-leader controller switch to GM-hidden character [%d] for monster [%d].", characterId, last.Monster.UniqueId())
-		} else {
-			inField, ferr := p.attackerInField(last.Monster.Field(), characterId)
-			if ferr != nil || !inField {
-				p.l.Debugf("FR-10: skipping controller switch for char [%d] not in field of monster [%d].", characterId, last.Monster.UniqueId())
-			} else {
-				p.l.Debugf("Character [%d] has become damage leader for monster [%d].", characterId, last.Monster.UniqueId())
-				// FR-9: only emit STOP_CONTROL when there's actually a previous controller.
-				if last.Monster.ControlCharacterId() != 0 {
-					if err := p.StopControl(last.Monster); err != nil {
-						p.l.WithError(err).Errorf("Unable to stop [%d] from controlling monster [%d].", last.Monster.ControlCharacterId(), last.Monster.UniqueId())
-					}
-				}
-				if _, err := p.StartControl(last.Monster.UniqueId(), characterId); err != nil {
-					p.l.WithError(err).Errorf("Unable to start [%d] controlling monster [%d].", characterId, last.Monster.UniqueId())
-				} else {
-					controllerSwitched = true
-				}
-			}
-		}
+	margin := ThreatSwitchMargin
+	if phaseChanged {
+		margin = 1
 	}
+	controllerSwitched := p.switchToThreatLeader(last.Monster, margin)
 
 	if firstHitObserved && !controllerSwitched {
 		// AGGRO_CHANGED is suppressed when a switch happened because START_CONTROL
@@ -724,6 +801,7 @@ func (p *ProcessorImpl) DamageFriendly(uniqueId uint32, attackerUniqueId uint32,
 		GetCooldownRegistry().ClearCooldowns(p.ctx, p.t, uniqueId)
 		GetAttackCooldownRegistry().ClearCooldowns(p.ctx, p.t, uniqueId)
 		GetDropTimerRegistry().Unregister(p.ctx, p.t, uniqueId)
+		p.clearElementOverrides(uniqueId)
 
 		for _, se := range s.Monster.StatusEffects() {
 			_ = producer.ProviderImpl(p.l)(p.ctx)(EnvEventTopicMonsterStatus)(statusEffectCancelledEventProvider(s.Monster, se))
@@ -743,7 +821,11 @@ func (p *ProcessorImpl) DamageFriendly(uniqueId uint32, attackerUniqueId uint32,
 	_ = producer.ProviderImpl(p.l)(p.ctx)(EnvEventTopicMonsterStatus)(damagedStatusEventProvider(s.Monster, observerUniqueId, attackerUniqueId, false, DamageSourceMonsterAttack, s.VisibleDamage, s.Monster.DamageSummary()))
 }
 
-// spawnRevives spawns the revive/next-phase monsters when a monster dies
+// spawnRevives spawns the revive/next-phase monsters when a monster dies.
+// Each body inherits the dead one's threat table, so the next phase's first
+// hit hands control to the player the last one was fighting. The handoff is
+// left to that hit rather than made here: a START_CONTROL racing the CREATED
+// event is the Spawn/Control ordering hazard Create avoids.
 func (p *ProcessorImpl) spawnRevives(m Model, revives []uint32) {
 	for _, reviveMonsterId := range revives {
 		input := RestModel{
@@ -753,9 +835,16 @@ func (p *ProcessorImpl) spawnRevives(m Model, revives []uint32) {
 			Fh:        m.Fh(),
 			Team:      m.Team(),
 		}
-		_, err := p.Create(m.Field(), input)
+		rm, err := p.Create(m.Field(), input)
 		if err != nil {
 			p.l.WithError(err).Errorf("Unable to spawn revive monster [%d] from monster [%d].", reviveMonsterId, m.UniqueId())
+			continue
+		}
+		if len(m.ThreatEntries()) == 0 {
+			continue
+		}
+		if _, err = GetMonsterRegistry().InheritThreat(p.t, rm.UniqueId(), m.ThreatEntries()); err != nil {
+			p.l.WithError(err).Warnf("Unable to carry threat from monster [%d] to revive [%d].", m.UniqueId(), rm.UniqueId())
 		}
 	}
 }
@@ -1340,6 +1429,7 @@ func (p *ProcessorImpl) executeSummon(m Model, sd mobskill.Model) {
 func (p *ProcessorImpl) Destroy(uniqueId uint32) error {
 	GetDropTimerRegistry().Unregister(p.ctx, p.t, uniqueId)
 	GetAttackCooldownRegistry().ClearCooldowns(p.ctx, p.t, uniqueId)
+	p.clearElementOverrides(uniqueId)
 	m, err := GetMonsterRegistry().RemoveMonster(p.ctx, p.t, uniqueId)
 	if err != nil {
 		return err
@@ -1379,6 +1469,11 @@ func (p *ProcessorImpl) ApplyStatusEffect(uniqueId uint32, effect StatusEffect)
 
 	// Only check immunities for player-sourced effects
 	if effect.SourceType() == SourceTypePlayerSkill {
+		if err = p.validateCasterSkill(effect); err != nil {
+			p.l.WithError(err).Warnf("Character [%d] status from skill [%d] level [%d] on monster [%d] rejected.", effect.SourceCharacterId(), effect.SourceSkillId(), effect.SourceSkillLevel(), uniqueId)
+			return err
+		}
+
 		var info information.Model
 		var infoErr error
 		if testInformationLookup != nil {
@@ -1387,15 +1482,21 @@ func (p *ProcessorImpl) ApplyStatusEffect(uniqueId uint32, effect StatusEffect)
 			info, infoErr = information.NewProcessor(p.l, p.ctx).GetById(m.MonsterId())
 		}
 		if infoErr == nil {
-			// Elemental immunity check
-			if blocked, element := isElementallyImmune(info, effect); blocked {
-				p.l.Debugf("Monster [%d] is immune to element [%s]. Status rejected.", uniqueId, element)
-				return errors.New("elemental immunity")
+			// Elemental resistance check
+			effectiveness := func(element string) string {
+				_, current := p.elementEffectiveness(uniqueId, info, element)
+				return current
+			}
+			if outcome, element := elementalStatusOutcome(effect, effectiveness, rand.Float64()); outcome != "" {
+				p.l.Debugf("Monster [%d] resisted element [%s] (%s). Status rejected.", uniqueId, element, outcome)
+				_ = p.emit(EnvEventTopicMonsterStatus, resistedEventProvider(m, effect.SourceCharacterId(), effect.SourceSkillId(), ResistReasonElement, element, outcome, statusNames(effect)))
+				return errors.New("elemental resistance")
 			}
 
 			// Boss immunity check
 			if info.Boss() && !isBossAllowedStatus(effect) {
 				p.l.Debugf("Monster [%d] is a boss. Status rejected.", uniqueId)
+				_ = p.emit(EnvEventTopicMonsterStatus, resistedEventProvider(m, effect.SourceCharacterId(), effect.SourceSkillId(), ResistReasonBoss, "", ResistOutcomeImmune, statusNames(effect)))
 				return errors.New("boss immunity")
 			}
 		}
@@ -1417,6 +1518,14 @@ func (p *ProcessorImpl) ApplyStatusEffect(uniqueId uint32, effect StatusEffect)
 		effect = effect.WithStatus(StatusPoison, ResolvePoisonDamage(m.MaxHp(), effect.SourceSkillLevel()))
 	}
 
+	// Mutually exclusive statuses (FREEZE vs BURNED): the newest wins, so the
+	// displaced one is cancelled first and the channel hears it go.
+	if excluded := excludedStatuses(effect); len(excluded) > 0 {
+		if err = p.CancelStatusEffect(uniqueId, excluded); err != nil {
+			p.l.WithError(err).Warnf("Unable to cancel statuses %v excluded by the new effect on monster [%d].", excluded, uniqueId)
+		}
+	}
+
 	m, err = GetMonsterRegistry().ApplyStatusEffect(p.t, uniqueId, effect)
 	if err != nil {
 		p.l.WithError(err).Errorf("Unable to apply status effect to monster [%d].", uniqueId)
@@ -1424,6 +1533,12 @@ func (p *ProcessorImpl) ApplyStatusEffect(uniqueId uint32, effect StatusEffect)
 	}
 
 	_ = producer.ProviderImpl(p.l)(p.ctx)(EnvEventTopicMonsterStatus)(statusEffectAppliedEventProvider(m, effect))
+	// Taunt (Showdown) is the player taunt: it pulls the monster onto the caster.
+	if effect.SourceType() == SourceTypePlayerSkill && effect.HasStatus(monster2.StatusShowdown) {
+		if err := p.Taunt(uniqueId, effect.SourceCharacterId()); err != nil {
+			p.l.WithError(err).Warnf("Unable to apply taunt of character [%d] to monster [%d].", effect.SourceCharacterId(), uniqueId)
+		}
+	}
 	if effectTouchesPicker(effect) {
 		if err := p.RepickAndEmit(uniqueId, RepickReasonStatusApplied); err != nil {
 			p.l.WithError(err).Warnf("Status-applied picker: monster [%d] re-pick failed.", uniqueId)
@@ -1432,44 +1547,42 @@ func (p *ProcessorImpl) ApplyStatusEffect(uniqueId uint32, effect StatusEffect)
 	return nil
 }
 
-// isElementallyImmune checks if a monster's resistances block the given status effect.
-// DOOM (Priest, 2311005) intentionally bypasses elemental immunity: the
-// polymorph-to-snail effect overrides resistance — a fire-immune mob still
-// becomes a snail.
-func isElementallyImmune(info information.Model, effect StatusEffect) (bool, string) {
-	if _, ok := effect.Statuses()[monster2.StatusDoom]; ok {
-		return false, ""
+// validateCasterSkill checks a player status against the caster's trained
+// level of the source skill: the level the effect was computed at must be one
+// the caster actually has. A character without the skill reads as level 0 and
+// is rejected. A status that names no source skill, or a level of 0 (unknown),
+// has nothing to check against and is let through, as is one whose lookup
+// fails, as the immunity gates do when monster information is unavailable --
+// an atlas-skills outage must not switch off every player status.
+func (p *ProcessorImpl) validateCasterSkill(effect StatusEffect) error {
+	if effect.SourceSkillId() == 0 || effect.SourceSkillLevel() == 0 {
+		return nil
 	}
-	for statusType := range effect.Statuses() {
-		switch statusType {
-		case "POISON":
-			if info.IsImmuneToElement("P") {
-				return true, "poison"
-			}
-		case "FREEZE":
-			if info.IsImmuneToElement("I") {
-				return true, "ice"
-			}
-		}
+	var level byte
+	var err error
+	if testCasterSkillLevelLookup != nil {
+		level, err = testCasterSkillLevelLookup(effect.SourceCharacterId(), effect.SourceSkillId())
+	} else {
+		level, err = skill.NewProcessor(p.l, p.ctx).GetLevel(effect.SourceCharacterId(), effect.SourceSkillId())
+	}
+	if err != nil {
+		p.l.WithError(err).Warnf("Unable to look up character [%d] skill [%d]; status applied unvalidated.", effect.SourceCharacterId(), effect.SourceSkillId())
+		return nil
+	}
+	if effect.SourceSkillLevel() > uint32(level) {
+		return ErrStatusSkillLevel
 	}
-	return false, ""
+	return nil
 }
 
-// isBossAllowedStatus returns true if the given status effect can be applied to boss monsters
-func isBossAllowedStatus(effect StatusEffect) bool {
+// statusNames lists an effect's status types in a stable order.
+func statusNames(effect StatusEffect) []string {
+	names := make([]string, 0, len(effect.Statuses()))
 	for statusType := range effect.Statuses() {
-		switch statusType {
-		case "SPEED", "WEAPON_ATTACK", "WEAPON_DEFENSE", "MAGIC_ATTACK", "MAGIC_DEFENSE",
-			"POWER_UP", "MAGIC_UP", "POWER_GUARD_UP", "MAGIC_GUARD_UP",
-			"SHOWDOWN", "NINJA_AMBUSH", "VENOM":
-			// These can affect bosses
-			continue
-		default:
-			// Other statuses (stun, seal, freeze, poison, etc.) cannot affect bosses
-			return false
-		}
+		names = append(names, statusType)
 	}
-	return true
+	sort.Strings(names)
+	return names
 }
 
 // CancelStatusEffect cancels status effects by type from a monster
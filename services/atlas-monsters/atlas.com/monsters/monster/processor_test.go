@@ -83,7 +83,7 @@ func TestDamageMultiLineKillOnLastLine(t *testing.T) {
 
 	charId := uint32(1)
 	p, events := newRecordingProcessor(t, ten)
-	p.Damage(uniqueId, charId, []uint32{40, 30, 50}, 0)
+	p.Damage(uniqueId, charId, []uint32{40, 30, 50}, 0, "", 0)
 
 	if len(*events) != 2 {
 		t.Fatalf("expected 2 events (damaged+killed), got %d: %v", len(*events), *events)
@@ -121,7 +121,7 @@ func TestDamageMultiLineKillOnMiddleLine(t *testing.T) {
 	charId := uint32(1)
 	p, events := newRecordingProcessor(t, ten)
 	// Line 1: 40 damage (HP→60), Line 2: 80 damage (kills; HP→0), Line 3: 50 (must NOT apply)
-	p.Damage(uniqueId, charId, []uint32{40, 80, 50}, 0)
+	p.Damage(uniqueId, charId, []uint32{40, 80, 50}, 0, "", 0)
 
 	if len(*events) != 2 {
 		t.Fatalf("expected 2 events (damaged+killed), got %d: %v", len(*events), *events)
@@ -155,7 +155,7 @@ func TestDamageSingleLineKill(t *testing.T) {
 
 	charId := uint32(1)
 	p, events := newRecordingProcessor(t, ten)
-	p.Damage(uniqueId, charId, []uint32{200}, 0)
+	p.Damage(uniqueId, charId, []uint32{200}, 0, "", 0)
 
 	if len(*events) != 2 {
 		t.Fatalf("expected 2 events (damaged+killed), got %d: %v", len(*events), *events)
@@ -186,7 +186,7 @@ func TestDamageEmptySlice(t *testing.T) {
 	uniqueId := m.UniqueId()
 
 	p, events := newRecordingProcessor(t, ten)
-	p.Damage(uniqueId, 1, []uint32{}, 0)
+	p.Damage(uniqueId, 1, []uint32{}, 0, "", 0)
 
 	if len(*events) != 0 {
 		t.Fatalf("expected 0 events for empty damages, got %d: %v", len(*events), *events)
@@ -219,7 +219,7 @@ func TestDamageAlreadyDeadMonster(t *testing.T) {
 	// Processor.Damage hits the !m.Alive() early-return path.
 
 	p, events := newRecordingProcessor(t, ten)
-	p.Damage(uniqueId, 1, []uint32{100}, 0)
+	p.Damage(uniqueId, 1, []uint32{100}, 0, "", 0)
 
 	if len(*events) != 0 {
 		t.Fatalf("expected 0 events for already-dead monster, got %d: %v", len(*events), *events)
@@ -285,7 +285,7 @@ func TestDamageControllerSwitchOnDpsLead(t *testing.T) {
 	}
 
 	p, events := newRecordingProcessorWithBodies(t, ten)
-	p.Damage(uniqueId, 2, []uint32{500}, 0)
+	p.Damage(uniqueId, 2, []uint32{500}, 0, "", 0)
 
 	var types []string
 	for _, e := range *events {
@@ -343,7 +343,7 @@ func TestDamageDPSLeadSwitchSkippedWhenLeaderHidden(t *testing.T) {
 	p, events := newRecordingProcessorWithBodies(t, ten)
 	// Character 2 is about to become damage leader — mark it GM-hidden.
 	p.hiddenFn = func() (map[uint32]struct{}, error) { return map[uint32]struct{}{2: {}}, nil }
-	p.Damage(uniqueId, 2, []uint32{500}, 0)
+	p.Damage(uniqueId, 2, []uint32{500}, 0, "", 0)
 
 	for _, e := range *events {
 		if e.Type == EventMonsterStatusStopControl || e.Type == EventMonsterStatusStartControl {
@@ -377,7 +377,7 @@ func TestDamageNoSwitchWhenLeaderUnchanged(t *testing.T) {
 	}
 
 	p, events := newRecordingProcessorWithBodies(t, ten)
-	p.Damage(uniqueId, 1, []uint32{30}, 0)
+	p.Damage(uniqueId, 1, []uint32{30}, 0, "", 0)
 
 	var types []string
 	for _, e := range *events {
@@ -416,7 +416,7 @@ func TestDamageAggroChangedSuppressedOnSwitch(t *testing.T) {
 	p, events := newRecordingProcessorWithBodies(t, ten)
 	// Character 2 hits first AND becomes leader (char 1 has no seed damage, so
 	// char 2's 500 damage immediately takes the DPS lead → controller switch).
-	p.Damage(uniqueId, 2, []uint32{500}, 0)
+	p.Damage(uniqueId, 2, []uint32{500}, 0, "", 0)
 
 	var types []string
 	for _, e := range *events {
@@ -447,7 +447,7 @@ func TestDamageFR9NoStopWhenControllerZero(t *testing.T) {
 	uniqueId := m.UniqueId()
 
 	p, events := newRecordingProcessorWithBodies(t, ten)
-	p.Damage(uniqueId, 7, []uint32{30}, 0)
+	p.Damage(uniqueId, 7, []uint32{30}, 0, "", 0)
 
 	for _, e := range *events {
 		if e.Type == EventMonsterStatusStopControl {
@@ -582,7 +582,7 @@ func TestDamageFR10OutOfFieldSkipsSwitch(t *testing.T) {
 	p.inFieldFn = func(_ field.Model) ([]uint32, error) {
 		return []uint32{1}, nil
 	}
-	p.Damage(uniqueId, 2, []uint32{500}, 0)
+	p.Damage(uniqueId, 2, []uint32{500}, 0, "", 0)
 
 	for _, e := range *events {
 		if e.Type == EventMonsterStatusStopControl || e.Type == EventMonsterStatusStartControl {
@@ -735,7 +735,7 @@ func TestDamage_TriggersRepick(t *testing.T) {
 		},
 	}
 
-	p.Damage(uniqueId, 1, []uint32{999}, 0)
+	p.Damage(uniqueId, 1, []uint32{999}, 0, "", 0)
 
 	if countByType[EventMonsterStatusNextSkillDecided] != 1 {
 		t.Errorf("expected exactly 1 NEXT_SKILL_DECIDED event from damage trigger; got %d (all events: %v)",
@@ -1816,7 +1816,7 @@ func applyDoomEffectFromPlayer(durationMs int) StatusEffect {
 // TestApplyStatusEffect_Doom_BypassesElementalImmunity verifies that DOOM is
 // applied to a monster with full elemental resistance — the skill's intended
 // counter-niche. Pins the explicit
-// short-circuit at the top of isElementallyImmune.
+// short-circuit at the top of elementalStatusOutcome.
 func TestApplyStatusEffect_Doom_BypassesElementalImmunity(t *testing.T) {
 	r := GetMonsterRegistry()
 	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
@@ -1828,7 +1828,7 @@ func TestApplyStatusEffect_Doom_BypassesElementalImmunity(t *testing.T) {
 
 	// Resist every element including poison and ice, which would otherwise
 	// fall through the existing POISON/FREEZE gates.
-	resistances := map[string]string{"P": "1", "I": "1", "F": "1", "S": "1", "L": "1"}
+	resistances := map[string]string{"POISON": "IMMUNE", "ICE": "IMMUNE", "FIRE": "IMMUNE", "HOLY": "IMMUNE", "LIGHTING": "IMMUNE"}
 	testInformationLookup = func(monsterId uint32) (information.Model, error) {
 		return information.NewModelBuilder().
 			SetBoss(false).
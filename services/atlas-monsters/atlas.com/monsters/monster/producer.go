@@ -126,6 +126,25 @@ func damageReflectedEventProvider(m Model, characterId uint32, reflectDamage uin
 	}, m.SpawnSourceType(), m.SpawnSourceId())
 }
 
+func resistedEventProvider(m Model, characterId uint32, skillId uint32, reason string, element string, outcome string, statuses []string) model.Provider[[]kafka.Message] {
+	return statusEventProvider(m.Field(), m.UniqueId(), m.MonsterId(), EventMonsterStatusResisted, statusEventResistedBody{
+		SourceCharacterId: characterId,
+		SourceSkillId:     skillId,
+		Reason:            reason,
+		Element:           element,
+		Outcome:           outcome,
+		ResistedStatuses:  statuses,
+	}, m.SpawnSourceType(), m.SpawnSourceId())
+}
+
+func phaseChangedStatusEventProvider(m Model, threshold uint32) model.Provider[[]kafka.Message] {
+	return statusEventProvider(m.Field(), m.UniqueId(), m.MonsterId(), EventMonsterStatusPhaseChanged, statusEventPhaseChangedBody{
+		Phase:        m.Phase(),
+		HpThreshold:  threshold,
+		HpPercentage: m.HpPercentage(),
+	}, m.SpawnSourceType(), m.SpawnSourceId())
+}
+
 // mpChangedStatusEventProvider builds a MP_CHANGED status event for any
 // monster MP mutation that the channel must react to. Reason
 // disambiguates the source (e.g., MP_EATER) so future passives can share
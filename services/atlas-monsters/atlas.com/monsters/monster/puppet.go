@@ -0,0 +1,38 @@
+package monster
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+)
+
+// DrawToPuppet redirects the monsters a freshly placed puppet covers: every
+// living monster in f within PuppetVicinityDistanceSq of (x,y) is handed to
+// the puppet's owner with aggro, so the client AI turns on the puppet at once
+// rather than at the next controller election. Monsters the owner already
+// controls with aggro are left alone.
+func (p *ProcessorImpl) DrawToPuppet(f field.Model, ownerCharacterId uint32, x int16, y int16) error {
+	ms, err := p.GetInField(f)
+	if err != nil {
+		return err
+	}
+	drawn := 0
+	for _, m := range ms {
+		if !m.Alive() {
+			continue
+		}
+		if m.ControlCharacterId() == ownerCharacterId && m.ControllerHasAggro() {
+			continue
+		}
+		dx := int64(m.X()) - int64(x)
+		dy := int64(m.Y()) - int64(y)
+		if dx*dx+dy*dy >= PuppetVicinityDistanceSq {
+			continue
+		}
+		if p.handOffControl(m, ownerCharacterId, true) {
+			drawn++
+		}
+	}
+	if drawn > 0 {
+		p.l.Debugf("Puppet of character [%d] drew [%d] monsters in field [%s].", ownerCharacterId, drawn, f.Id())
+	}
+	return nil
+}
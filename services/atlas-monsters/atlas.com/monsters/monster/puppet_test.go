@@ -147,3 +147,87 @@ func TestPuppetOutOfVicinityNoBias(t *testing.T) {
 		t.Fatalf("expected default candidate [1] (puppet out of vicinity), got [%d]", cid)
 	}
 }
+
+// TestDrawToPuppetHandsNearbyMonstersToOwner verifies that placing a puppet
+// hands every monster it covers to the puppet's owner with aggro, leaving
+// monsters outside the vicinity with their controller.
+func TestDrawToPuppetHandsNearbyMonstersToOwner(t *testing.T) {
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := tenant.WithContext(context.Background(), ten)
+	r := GetMonsterRegistry()
+	r.Clear(ctx)
+
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	near := r.CreateMonster(ctx, ten, f, 9300018, 100, 0, 0, 5, 0, 100, 50, "", "")
+	far := r.CreateMonster(ctx, ten, f, 9300018, 2000, 0, 0, 5, 0, 100, 50, "", "")
+	for _, m := range []Model{near, far} {
+		if _, err := r.ControlMonster(ten, m.UniqueId(), 1); err != nil {
+			t.Fatalf("ControlMonster: %v", err)
+		}
+	}
+
+	p, _ := newRecordingProcessorWithBodies(t, ten)
+	p.ctx = ctx
+	if err := p.DrawToPuppet(f, 2, 50, 0); err != nil {
+		t.Fatalf("DrawToPuppet: %v", err)
+	}
+
+	got, err := r.GetMonster(ten, near.UniqueId())
+	if err != nil {
+		t.Fatalf("GetMonster: %v", err)
+	}
+	if got.ControlCharacterId() != 2 || !got.ControllerHasAggro() {
+		t.Fatalf("expected puppet owner [2] to control nearby monster with aggro, got [%d] aggro [%t]", got.ControlCharacterId(), got.ControllerHasAggro())
+	}
+	got, err = r.GetMonster(ten, far.UniqueId())
+	if err != nil {
+		t.Fatalf("GetMonster: %v", err)
+	}
+	if got.ControlCharacterId() != 1 {
+		t.Fatalf("expected distant monster to stay with [1], got [%d]", got.ControlCharacterId())
+	}
+}
+
+// TestPuppetHoldsMonsterAgainstThreatLeader verifies that a monster drawn to a
+// puppet stays with the puppet's owner when another character out-damages
+// them, and follows threat again once the puppet is removed.
+func TestPuppetHoldsMonsterAgainstThreatLeader(t *testing.T) {
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := tenant.WithContext(context.Background(), ten)
+	r := GetMonsterRegistry()
+	r.Clear(ctx)
+	pr := GetPuppetRegistry()
+	pr.Clear(ctx)
+
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	m := r.CreateMonster(ctx, ten, f, 9300018, 100, 0, 0, 5, 0, 10000, 50, "", "")
+	if _, err := r.ControlMonster(ten, m.UniqueId(), 1); err != nil {
+		t.Fatalf("ControlMonster: %v", err)
+	}
+
+	p, _ := newRecordingProcessorWithBodies(t, ten)
+	p.ctx = ctx
+	pr.Add(ctx, ten, f, 2, 50, 0)
+	if err := p.DrawToPuppet(f, 2, 50, 0); err != nil {
+		t.Fatalf("DrawToPuppet: %v", err)
+	}
+
+	p.Damage(m.UniqueId(), 3, []uint32{500}, 0, "", 0)
+	got, err := r.GetMonster(ten, m.UniqueId())
+	if err != nil {
+		t.Fatalf("GetMonster: %v", err)
+	}
+	if got.ControlCharacterId() != 2 {
+		t.Fatalf("expected puppet owner [2] to keep the monster, got [%d]", got.ControlCharacterId())
+	}
+
+	pr.Remove(ctx, ten, f, 2)
+	p.Damage(m.UniqueId(), 3, []uint32{500}, 0, "", 0)
+	got, err = r.GetMonster(ten, m.UniqueId())
+	if err != nil {
+		t.Fatalf("GetMonster: %v", err)
+	}
+	if got.ControlCharacterId() != 3 {
+		t.Fatalf("expected threat leader [3] to take the monster once the puppet is gone, got [%d]", got.ControlCharacterId())
+	}
+}
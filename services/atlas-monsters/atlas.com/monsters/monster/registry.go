@@ -46,6 +46,8 @@ type storedMonster struct {
 	Stance                 byte             `json:"stance"`
 	Team                   int8             `json:"team"`
 	DamageEntries          damageEntryList  `json:"damageEntries"`
+	ThreatEntries          threatEntryList  `json:"threatEntries,omitempty"`
+	Phase                  byte             `json:"phase,omitempty"`
 	StatusEffects          statusEffectList `json:"statusEffects"`
 	NextEligibleRepickAtMs int64            `json:"nextEligibleRepickAtMs,omitempty"`
 	LastDamageTakenMs      int64            `json:"lastDamageTakenMs,omitempty"`
@@ -64,6 +66,12 @@ func (l *damageEntryList) UnmarshalJSON(data []byte) error {
 	return unmarshalTolerantArray(data, (*[]storedDamageEntry)(l))
 }
 
+type threatEntryList []storedThreatEntry
+
+func (l *threatEntryList) UnmarshalJSON(data []byte) error {
+	return unmarshalTolerantArray(data, (*[]storedThreatEntry)(l))
+}
+
 type statusEffectList []storedStatusEffect
 
 func (l *statusEffectList) UnmarshalJSON(data []byte) error {
@@ -85,6 +93,12 @@ type storedDamageEntry struct {
 	LastHitMs   int64  `json:"lastHitMs"`
 }
 
+type storedThreatEntry struct {
+	CharacterId uint32 `json:"characterId"`
+	Threat      uint32 `json:"threat"`
+	LastHitMs   int64  `json:"lastHitMs"`
+}
+
 type storedStatusEffect struct {
 	EffectId          string           `json:"effectId"`
 	SourceType        string           `json:"sourceType"`
@@ -115,6 +129,10 @@ func toStored(t tenant.Model, m Model) storedMonster {
 			LastHitMs:   e.LastHitMs,
 		})
 	}
+	var tes []storedThreatEntry
+	for _, e := range m.threatEntries {
+		tes = append(tes, storedThreatEntry(e))
+	}
 	ses := make([]storedStatusEffect, 0, len(m.statusEffects))
 	for _, se := range m.statusEffects {
 		ses = append(ses, storedStatusEffect{
@@ -161,6 +179,8 @@ func toStored(t tenant.Model, m Model) storedMonster {
 		Stance:                 m.stance,
 		Team:                   m.team,
 		DamageEntries:          des,
+		ThreatEntries:          tes,
+		Phase:                  m.phase,
 		StatusEffects:          ses,
 		NextEligibleRepickAtMs: m.nextSkillDecision.nextEligibleRepickAtMs,
 		LastDamageTakenMs:      m.lastDamageTakenMs,
@@ -205,6 +225,10 @@ func fromStored(sm storedMonster) (tenant.Model, Model, error) {
 	for _, cid := range order {
 		des = append(des, *agg[cid])
 	}
+	var tes []threatEntry
+	for _, te := range sm.ThreatEntries {
+		tes = append(tes, threatEntry(te))
+	}
 	ses := make([]StatusEffect, 0, len(sm.StatusEffects))
 	for _, sse := range sm.StatusEffects {
 		eid, err := uuid.Parse(sse.EffectId)
@@ -252,6 +276,8 @@ func fromStored(sm storedMonster) (tenant.Model, Model, error) {
 		stance:             sm.Stance,
 		team:               sm.Team,
 		damageEntries:      des,
+		threatEntries:      tes,
+		phase:              sm.Phase,
 		statusEffects:      ses,
 		nextSkillDecision: nextSkillDecision{
 			nextEligibleRepickAtMs: sm.NextEligibleRepickAtMs,
@@ -429,7 +455,7 @@ func (r *Registry) ClearControl(tenant tenant.Model, uniqueId uint32) (Model, er
 
 // ApplyDamage atomically applies clamped damage to the monster, aggregates the
 // per-character damage entry (summing damage and stamping lastHitMs=nowMs),
-// stamps lastDamageTakenMs=nowMs, and flips controllerHasAggro true on the first
+// credits the same landed damage as threat, stamps lastDamageTakenMs=nowMs, and flips controllerHasAggro true on the first
 // hit of a controlled monster. Ported from the former applyDamageScript Lua via
 // Registry.Update; the closure is pure (wasFirstHit derives only from cur), so
 // the captured summary reflects the final successful invocation under retry.
@@ -464,6 +490,7 @@ func (r *Registry) ApplyDamage(t tenant.Model, characterId uint32, damage uint32
 				LastHitMs:   nowMs,
 			})
 		}
+		cur.ThreatEntries = addThreat(cur.ThreatEntries, characterId, actual, nowMs)
 		cur.LastDamageTakenMs = nowMs
 
 		wasFirstHit = cur.ControlCharacterId != 0 && !cur.ControllerHasAggro
@@ -765,8 +792,8 @@ type ClearSummary struct {
 	AggroFlippedOff       bool
 }
 
-// ClearDamageEntries atomically wipes EVERY damage entry on the monster and
-// flips controllerHasAggro false when the monster had aggro. This is a full
+// ClearDamageEntries atomically wipes EVERY damage entry and the whole threat
+// table on the monster and flips controllerHasAggro false when the monster had aggro. This is a full
 // wipe, not a decay toward AggroDecayFloor (FR-4.2).
 //
 // It deliberately converges on the same state DecayDamageEntries reaches when
@@ -795,6 +822,7 @@ func (r *Registry) ClearDamageEntries(t tenant.Model, uniqueId uint32) (ClearSum
 		aggroFlippedOff = false
 
 		cur.DamageEntries = make([]storedDamageEntry, 0, len(cur.DamageEntries))
+		cur.ThreatEntries = nil
 		if cur.ControllerHasAggro {
 			cur.ControllerHasAggro = false
 			aggroFlippedOff = true
@@ -819,3 +847,81 @@ func (r *Registry) ClearDamageEntries(t tenant.Model, uniqueId uint32) (ClearSum
 		AggroFlippedOff:       aggroFlippedOff,
 	}, nil
 }
+
+// DecayThreat atomically decays idle threat entries and prunes any that fall
+// below AggroDecayFloor. It runs for bosses too (see ThreatPerDamage) and
+// never touches the damage entries or the aggro flag.
+func (r *Registry) DecayThreat(t tenant.Model, uniqueId uint32, nowMs int64) (Model, error) {
+	sm, err := r.reg.Update(context.Background(), t, uniqueId, func(cur storedMonster) storedMonster {
+		cur.ThreatEntries = decayThreat(cur.ThreatEntries, nowMs)
+		return cur
+	})
+	if errors.Is(err, atlasredis.ErrNotFound) {
+		return Model{}, errMonsterNotFound
+	}
+	if err != nil {
+		return Model{}, err
+	}
+	_, m, err := fromStored(sm)
+	return m, err
+}
+
+// Taunt atomically lifts characterId to the top of the threat table (see
+// tauntThreat).
+func (r *Registry) Taunt(t tenant.Model, uniqueId uint32, characterId uint32, nowMs int64) (Model, error) {
+	sm, err := r.reg.Update(context.Background(), t, uniqueId, func(cur storedMonster) storedMonster {
+		cur.ThreatEntries = tauntThreat(cur.ThreatEntries, characterId, nowMs)
+		return cur
+	})
+	if errors.Is(err, atlasredis.ErrNotFound) {
+		return Model{}, errMonsterNotFound
+	}
+	if err != nil {
+		return Model{}, err
+	}
+	_, m, err := fromStored(sm)
+	return m, err
+}
+
+// AdvancePhase atomically raises the monster's boss phase to phase. Phases
+// only move forward (a boss healing back over a threshold keeps its phase),
+// so advanced is true for exactly one of several concurrent damage flows
+// crossing the same threshold.
+func (r *Registry) AdvancePhase(t tenant.Model, uniqueId uint32, phase byte) (Model, bool, error) {
+	var advanced bool
+	sm, err := r.reg.Update(context.Background(), t, uniqueId, func(cur storedMonster) storedMonster {
+		advanced = phase > cur.Phase
+		if advanced {
+			cur.Phase = phase
+		}
+		return cur
+	})
+	if errors.Is(err, atlasredis.ErrNotFound) {
+		return Model{}, false, errMonsterNotFound
+	}
+	if err != nil {
+		return Model{}, false, err
+	}
+	_, m, err := fromStored(sm)
+	return m, advanced, err
+}
+
+// InheritThreat atomically replaces the monster's threat table with entries,
+// used when a boss revives into its next body.
+func (r *Registry) InheritThreat(t tenant.Model, uniqueId uint32, entries []threatEntry) (Model, error) {
+	sm, err := r.reg.Update(context.Background(), t, uniqueId, func(cur storedMonster) storedMonster {
+		cur.ThreatEntries = make([]storedThreatEntry, 0, len(entries))
+		for _, e := range entries {
+			cur.ThreatEntries = append(cur.ThreatEntries, storedThreatEntry(e))
+		}
+		return cur
+	})
+	if errors.Is(err, atlasredis.ErrNotFound) {
+		return Model{}, errMonsterNotFound
+	}
+	if err != nil {
+		return Model{}, err
+	}
+	_, m, err := fromStored(sm)
+	return m, err
+}
@@ -3,6 +3,7 @@ package monster
 import (
 	"atlas-monsters/character/hidden"
 	"context"
+	"math"
 	"os"
 	"strconv"
 	"sync"
@@ -36,11 +37,18 @@ func TestMain(m *testing.M) {
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	InitIdAllocator(rc)
 	InitCooldownRegistry(rc)
+	InitElementOverrideRegistry(rc)
 	InitMonsterRegistry(rc)
 	InitDropTimerRegistry(rc)
 	InitPuppetRegistry(rc)
 	hidden.InitRegistry(rc)
 
+	// Every caster trains every skill to the cap unless a test says
+	// otherwise, so status tests never reach for atlas-skills.
+	testCasterSkillLevelLookup = func(_ uint32, _ uint32) (byte, error) {
+		return math.MaxUint8, nil
+	}
+
 	os.Exit(m.Run())
 }
 
@@ -34,7 +34,7 @@ const MaxPoisonDamage int32 = 32767
 //
 // The magnitude is resolved ONCE, at apply time, and stored in the status
 // effect's statuses map. That single value then serves both consumers: the
-// damage the tick applies (StatusExpirationTask.calculatePoisonDamage) and the
+// damage the tick applies (StatusExpirationTask.calculateTickDamage) and the
 // number the client renders for itself from the temporary-stat packet. Those
 // two must not be computed independently or they will drift.
 func ResolvePoisonDamage(maxHp uint32, skillLevel uint32) int32 {
@@ -0,0 +1,120 @@
+package monster
+
+import (
+	"time"
+
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+)
+
+// StatusBurned is the fire damage-over-time status. Like VENOM it carries its
+// per-tick damage in the magnitude the caster sends.
+const StatusBurned = "BURNED"
+
+// DefaultDoTTickInterval is the tick cadence given to an effect carrying a
+// damage-over-time status when the command leaves TickInterval unset.
+const DefaultDoTTickInterval = 1000 * time.Millisecond
+
+// TickKind says whether, and from what, a status deals damage each tick.
+type TickKind string
+
+const (
+	TickNone TickKind = ""
+	// TickTargetDerived damage is resolved from the target at apply time
+	// (POISON, see ResolvePoisonDamage) and stored as the magnitude.
+	TickTargetDerived TickKind = "TARGET_DERIVED"
+	// TickMagnitude damage is the magnitude the caster sent (VENOM, BURNED).
+	TickMagnitude TickKind = "MAGNITUDE"
+)
+
+// StatusRule is how one status type behaves once a player lands it.
+//
+// Re-applying a status already at MaxStacks refreshes it: the stack closest to
+// expiry is replaced by the new effect, which carries the new duration and
+// the new caster. For a ticking status the tick clock carries over from the
+// replaced stack, so re-applying faster than the tick interval can neither
+// postpone the next tick nor fire an extra one.
+type StatusRule struct {
+	// MaxStacks is how many effects carrying the status may be active at
+	// once. Values below 1 are treated as 1.
+	MaxStacks int
+	Tick      TickKind
+	// BossAllowed lets a player land the status on a boss.
+	BossAllowed bool
+	// Excludes lists statuses that cannot coexist with this one. Landing this
+	// status cancels them first (latest wins).
+	Excludes []string
+}
+
+// statusRules is the player-status rule table. A status with no row gets the
+// zero rule: one stack, no tick, not on bosses, no exclusions.
+var statusRules = map[string]StatusRule{
+	StatusPoison:                                   {MaxStacks: 1, Tick: TickTargetDerived},
+	StatusVenom:                                    {MaxStacks: 3, Tick: TickMagnitude, BossAllowed: true},
+	StatusBurned:                                   {MaxStacks: 1, Tick: TickMagnitude, Excludes: []string{monster2.StatusFreeze}},
+	monster2.StatusFreeze:                          {MaxStacks: 1, Excludes: []string{StatusBurned}},
+	monster2.StatusStun:                            {MaxStacks: 1},
+	monster2.StatusSeal:                            {MaxStacks: 1},
+	monster2.StatusDoom:                            {MaxStacks: 1},
+	monster2.StatusShadowWeb:                       {MaxStacks: 1},
+	monster2.StatusInertMob:                        {MaxStacks: 1},
+	monster2.StatusAccuracy:                        {MaxStacks: 1},
+	monster2.StatusSpeed:                           {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusWeaponAttack:                    {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusWeaponDefense:                   {MaxStacks: 1, BossAllowed: true},
+	string(monster2.TemporaryStatTypeMagicAttack):  {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusMagicDefense:                    {MaxStacks: 1, BossAllowed: true},
+	string(monster2.TemporaryStatTypePowerUp):      {MaxStacks: 1, BossAllowed: true},
+	string(monster2.TemporaryStatTypeMagicUp):      {MaxStacks: 1, BossAllowed: true},
+	string(monster2.TemporaryStatTypePowerGuardUp): {MaxStacks: 1, BossAllowed: true},
+	string(monster2.TemporaryStatTypeMagicGuardUp): {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusShowdown:                        {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusNinjaAmbush:                     {MaxStacks: 1, BossAllowed: true},
+	monster2.StatusPhantomImprint:                  {MaxStacks: 1},
+}
+
+// StatusRuleFor returns the rule for statusType.
+func StatusRuleFor(statusType string) StatusRule {
+	r := statusRules[statusType]
+	if r.MaxStacks < 1 {
+		r.MaxStacks = 1
+	}
+	return r
+}
+
+// TicksDamage reports whether any of statuses deals damage over time.
+func TicksDamage(statuses map[string]int32) bool {
+	for statusType := range statuses {
+		if StatusRuleFor(statusType).Tick != TickNone {
+			return true
+		}
+	}
+	return false
+}
+
+// isBossAllowedStatus reports whether every status of the effect may land on
+// a boss.
+func isBossAllowedStatus(effect StatusEffect) bool {
+	for statusType := range effect.Statuses() {
+		if !StatusRuleFor(statusType).BossAllowed {
+			return false
+		}
+	}
+	return true
+}
+
+// excludedStatuses lists the statuses the effect displaces, in a stable
+// order and without the effect's own statuses.
+func excludedStatuses(effect StatusEffect) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, statusType := range statusNames(effect) {
+		for _, ex := range StatusRuleFor(statusType).Excludes {
+			if seen[ex] || effect.HasStatus(ex) {
+				continue
+			}
+			seen[ex] = true
+			out = append(out, ex)
+		}
+	}
+	return out
+}
@@ -0,0 +1,196 @@
+package monster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+)
+
+func TestStatusRuleFor_UnknownStatusIsSingleStackNotOnBosses(t *testing.T) {
+	r := StatusRuleFor("NOT_A_STATUS")
+	require.Equal(t, 1, r.MaxStacks)
+	require.Equal(t, TickNone, r.Tick)
+	require.False(t, r.BossAllowed)
+	require.Empty(t, r.Excludes)
+}
+
+func TestIsBossAllowedStatus(t *testing.T) {
+	venom := NewStatusEffect(SourceTypePlayerSkill, 1, 4120005, 30, map[string]int32{StatusVenom: 40}, 8*time.Second, time.Second)
+	require.True(t, isBossAllowedStatus(venom))
+
+	// One disallowed status sinks the whole effect.
+	mixed := NewStatusEffect(SourceTypePlayerSkill, 1, 1201006, 20, map[string]int32{monster2.StatusWeaponAttack: -10, monster2.StatusStun: 1}, 8*time.Second, 0)
+	require.False(t, isBossAllowedStatus(mixed))
+}
+
+func TestTicksDamage(t *testing.T) {
+	require.True(t, TicksDamage(map[string]int32{StatusPoison: 0}))
+	require.True(t, TicksDamage(map[string]int32{StatusBurned: 120}))
+	require.False(t, TicksDamage(map[string]int32{monster2.StatusFreeze: 1, monster2.StatusSeal: 1}))
+}
+
+func TestExcludedStatuses_FreezeAndBurnedDisplaceEachOther(t *testing.T) {
+	freeze := NewStatusEffect(SourceTypePlayerSkill, 1, 2201004, 30, map[string]int32{monster2.StatusFreeze: 1}, 8*time.Second, 0)
+	require.Equal(t, []string{StatusBurned}, excludedStatuses(freeze))
+
+	burn := NewStatusEffect(SourceTypePlayerSkill, 1, 0, 1, map[string]int32{StatusBurned: 50}, 8*time.Second, time.Second)
+	require.Equal(t, []string{monster2.StatusFreeze}, excludedStatuses(burn))
+
+	// An effect never displaces its own statuses.
+	both := NewStatusEffect(SourceTypePlayerSkill, 1, 0, 1, map[string]int32{StatusBurned: 50, monster2.StatusFreeze: 1}, 8*time.Second, time.Second)
+	require.Empty(t, excludedStatuses(both))
+}
+
+// Re-applying a ticking status faster than its interval must not keep
+// pushing the next tick back: the refresh inherits the replaced effect's
+// tick clock while taking the new duration and caster.
+func TestAddStatusEffect_RefreshKeepsTickClock(t *testing.T) {
+	first := NewStatusEffect(SourceTypePlayerSkill, 1, 2111003, 30, map[string]int32{StatusPoison: 380}, 10*time.Second, time.Second)
+	ticked := time.Now().Add(-900 * time.Millisecond)
+	first = first.WithLastTick(ticked)
+
+	refresh := NewStatusEffect(SourceTypePlayerSkill, 2, 2111003, 30, map[string]int32{StatusPoison: 380}, 10*time.Second, time.Second)
+	m := emptyBuilder().
+		AddStatusEffect(first).
+		AddStatusEffect(refresh).
+		Build()
+
+	require.Len(t, m.StatusEffects(), 1)
+	got := m.StatusEffects()[0]
+	require.Equal(t, refresh.EffectId(), got.EffectId())
+	require.Equal(t, uint32(2), got.SourceCharacterId(), "the refresh re-attributes the ticks to the newest caster")
+	require.Equal(t, refresh.ExpiresAt(), got.ExpiresAt())
+	require.True(t, got.LastTick().Equal(ticked))
+}
+
+// A non-ticking replacement keeps its own clock.
+func TestAddStatusEffect_NonTickingReplacementUntouched(t *testing.T) {
+	first := NewStatusEffect(SourceTypePlayerSkill, 1, 2201004, 30, map[string]int32{monster2.StatusFreeze: 1}, 10*time.Second, 0)
+	first = first.WithLastTick(time.Now().Add(-time.Minute))
+	again := NewStatusEffect(SourceTypePlayerSkill, 1, 2201004, 30, map[string]int32{monster2.StatusFreeze: 1}, 10*time.Second, 0)
+
+	m := emptyBuilder().AddStatusEffect(first).AddStatusEffect(again).Build()
+	require.Len(t, m.StatusEffects(), 1)
+	require.True(t, m.StatusEffects()[0].LastTick().Equal(again.LastTick()))
+}
+
+func TestCalculateTickDamage_Burned(t *testing.T) {
+	task := &StatusExpirationTask{l: logrus.New()}
+	m := NewMonster(testField(), 1, 7130002, 0, 0, 0, 0, 0, beetleMaxHp, 120, "", "")
+	burn := NewStatusEffect(SourceTypePlayerSkill, 1, 0, 1, map[string]int32{StatusBurned: 75, monster2.StatusSpeed: -20}, 8*time.Second, time.Second)
+
+	require.Equal(t, uint32(75), task.calculateTickDamage(m, burn, StatusBurned))
+	require.Equal(t, uint32(0), task.calculateTickDamage(m, burn, monster2.StatusSpeed))
+}
+
+func TestApplyStatusEffect_FreezeCancelsBurned(t *testing.T) {
+	p, tm, m := newPoisonTestProcessor(t, beetleMaxHp)
+
+	burn := NewStatusEffect(SourceTypePlayerSkill, 1, 2121003, 30, map[string]int32{StatusBurned: 75}, 8*time.Second, time.Second)
+	require.NoError(t, p.ApplyStatusEffect(m.UniqueId(), burn))
+
+	freeze := NewStatusEffect(SourceTypePlayerSkill, 1, 2201004, 30, map[string]int32{monster2.StatusFreeze: 1}, 8*time.Second, 0)
+	require.NoError(t, p.ApplyStatusEffect(m.UniqueId(), freeze))
+
+	stored, err := GetMonsterRegistry().GetMonster(tm, m.UniqueId())
+	require.NoError(t, err)
+	require.True(t, stored.HasStatusEffect(monster2.StatusFreeze))
+	require.False(t, stored.HasStatusEffect(StatusBurned), "freeze puts the fire out")
+}
+
+func withCasterSkillLevel(t *testing.T, fn func(characterId uint32, skillId uint32) (byte, error)) {
+	t.Helper()
+	prev := testCasterSkillLevelLookup
+	testCasterSkillLevelLookup = fn
+	t.Cleanup(func() { testCasterSkillLevelLookup = prev })
+}
+
+func TestApplyStatusEffect_ValidatesCasterSkillLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		trained byte
+		lookErr error
+		claimed uint32
+		wantErr error
+	}{
+		{name: "at trained level", trained: 20, claimed: 20},
+		{name: "below trained level", trained: 20, claimed: 5},
+		{name: "above trained level", trained: 20, claimed: 30, wantErr: ErrStatusSkillLevel},
+		{name: "untrained skill", trained: 0, claimed: 1, wantErr: ErrStatusSkillLevel},
+		// An atlas-skills outage must not switch off player statuses.
+		{name: "lookup failure lets it through", lookErr: errors.New("unavailable"), claimed: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, tm, m := newPoisonTestProcessor(t, beetleMaxHp)
+			withCasterSkillLevel(t, func(characterId uint32, skillId uint32) (byte, error) {
+				require.Equal(t, uint32(7), characterId)
+				require.Equal(t, uint32(2201004), skillId)
+				return tt.trained, tt.lookErr
+			})
+
+			effect := NewStatusEffect(SourceTypePlayerSkill, 7, 2201004, tt.claimed, map[string]int32{monster2.StatusFreeze: 1}, 8*time.Second, 0)
+			err := p.ApplyStatusEffect(m.UniqueId(), effect)
+
+			stored, gerr := GetMonsterRegistry().GetMonster(tm, m.UniqueId())
+			require.NoError(t, gerr)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.False(t, stored.HasStatusEffect(monster2.StatusFreeze))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, stored.HasStatusEffect(monster2.StatusFreeze))
+		})
+	}
+}
+
+// A player status that names no source skill, or carries level 0 (unknown),
+// has nothing to validate and is applied without a lookup.
+func TestApplyStatusEffect_UnknownSourceSkillSkipsCasterValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		skillId uint32
+		level   uint32
+	}{
+		{name: "zero skill id", skillId: 0, level: 20},
+		{name: "zero skill level", skillId: 2201004, level: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, tm, m := newPoisonTestProcessor(t, beetleMaxHp)
+			withCasterSkillLevel(t, func(uint32, uint32) (byte, error) {
+				t.Fatal("an unknown source skill must not look up a caster")
+				return 0, nil
+			})
+
+			effect := NewStatusEffect(SourceTypePlayerSkill, 7, tt.skillId, tt.level, map[string]int32{monster2.StatusFreeze: 1}, 8*time.Second, 0)
+			require.NoError(t, p.ApplyStatusEffect(m.UniqueId(), effect))
+
+			stored, err := GetMonsterRegistry().GetMonster(tm, m.UniqueId())
+			require.NoError(t, err)
+			require.True(t, stored.HasStatusEffect(monster2.StatusFreeze))
+		})
+	}
+}
+
+// Monster-cast statuses carry no character and are not validated.
+func TestApplyStatusEffect_MonsterSkillSkipsCasterValidation(t *testing.T) {
+	p, tm, m := newPoisonTestProcessor(t, beetleMaxHp)
+	withCasterSkillLevel(t, func(uint32, uint32) (byte, error) {
+		t.Fatal("monster skill must not look up a caster")
+		return 0, nil
+	})
+
+	effect := NewStatusEffect(SourceTypeMonsterSkill, 0, 140, 1, map[string]int32{string(monster2.TemporaryStatTypePowerUp): 20}, 8*time.Second, 0)
+	require.NoError(t, p.ApplyStatusEffect(m.UniqueId(), effect))
+
+	stored, err := GetMonsterRegistry().GetMonster(tm, m.UniqueId())
+	require.NoError(t, err)
+	require.Len(t, stored.StatusEffects(), 1)
+}
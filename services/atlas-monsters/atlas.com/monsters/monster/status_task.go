@@ -62,12 +62,8 @@ func (t *StatusExpirationTask) processMonsterEffects(ten tenant.Model, m Model)
 
 func (t *StatusExpirationTask) processDoTTick(ten tenant.Model, ctx context.Context, m Model, se StatusEffect) {
 	var totalDamage uint32
-
-	if se.HasStatus(StatusPoison) {
-		totalDamage += t.calculatePoisonDamage(m, se)
-	}
-	if se.HasStatus(StatusVenom) {
-		totalDamage += t.calculateVenomDamage(se)
+	for statusType := range se.Statuses() {
+		totalDamage += t.calculateTickDamage(m, se, statusType)
 	}
 
 	if totalDamage == 0 {
@@ -89,7 +85,9 @@ func (t *StatusExpirationTask) processDoTTick(ten tenant.Model, ctx context.Cont
 		return
 	}
 
-	// Apply damage
+	// The tick is the caster's damage: it lands in their damage entry (the
+	// EXP split and kill credit at death) and their threat like any hit of
+	// theirs, even though the kill itself must come from a live attack.
 	ds, err := GetMonsterRegistry().ApplyDamage(ten, se.SourceCharacterId(), totalDamage, m.UniqueId(), time.Now().UnixMilli())
 	if err != nil {
 		t.l.WithError(err).Errorf("Unable to apply DoT damage to monster [%d].", m.UniqueId())
@@ -103,25 +101,29 @@ func (t *StatusExpirationTask) processDoTTick(ten tenant.Model, ctx context.Cont
 	_ = producer.ProviderImpl(t.l)(ctx)(EnvEventTopicMonsterStatus)(damagedStatusEventProvider(ds.Monster, se.SourceCharacterId(), se.SourceCharacterId(), false, DamageSourceDamageOverTime, totalDamage, ds.Monster.DamageSummary()))
 }
 
-// calculatePoisonDamage reads the per-tick magnitude ApplyStatusEffect
-// resolved and stored on the effect (ResolvePoisonDamage). Reading the stored
-// value rather than recomputing is what keeps the damage applied here and the
-// magnitude the client renders from identical.
+// calculateTickDamage is one tick of statusType under its StatusRule.
 //
-// The recompute is a fallback for an effect that reached the registry without
+// A target-derived status (POISON) reads the per-tick magnitude
+// ApplyStatusEffect resolved and stored on the effect (ResolvePoisonDamage).
+// Reading the stored value rather than recomputing is what keeps the damage
+// applied here and the magnitude the client renders from identical. The
+// recompute is a fallback for an effect that reached the registry without
 // passing through ApplyStatusEffect (older persisted state across a rolling
 // deploy); it is the same function, so it cannot diverge.
-func (t *StatusExpirationTask) calculatePoisonDamage(m Model, se StatusEffect) uint32 {
-	if val, ok := se.Statuses()[StatusPoison]; ok && val > 0 {
-		return uint32(val)
-	}
-	return uint32(ResolvePoisonDamage(m.MaxHp(), se.SourceSkillLevel()))
-}
-
-func (t *StatusExpirationTask) calculateVenomDamage(se StatusEffect) uint32 {
-	// Venom damage is the stat value applied to the effect
-	if val, ok := se.Statuses()[StatusVenom]; ok && val > 0 {
-		return uint32(val)
+//
+// A magnitude status (VENOM, BURNED) deals the magnitude the caster sent.
+func (t *StatusExpirationTask) calculateTickDamage(m Model, se StatusEffect, statusType string) uint32 {
+	val := se.Statuses()[statusType]
+	switch StatusRuleFor(statusType).Tick {
+	case TickTargetDerived:
+		if val > 0 {
+			return uint32(val)
+		}
+		return uint32(ResolvePoisonDamage(m.MaxHp(), se.SourceSkillLevel()))
+	case TickMagnitude:
+		if val > 0 {
+			return uint32(val)
+		}
 	}
 	return 0
 }
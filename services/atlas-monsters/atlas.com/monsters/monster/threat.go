@@ -0,0 +1,300 @@
+package monster
+
+import (
+	"atlas-monsters/monster/information"
+	"atlas-monsters/monster/mobskill"
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// Threat constants. Threat is the server-side aggro table that decides who
+// controls (and so who the client AI chases) a monster. It is damage-weighted
+// and decays on the same idle schedule as the damage entries, but unlike
+// them it decays on bosses too: a boss must be able to walk off a player who
+// stopped fighting, while kill credit must not.
+const (
+	// ThreatPerDamage is the threat one point of landed damage generates.
+	ThreatPerDamage = 1.0
+
+	// ThreatSwitchMargin is how far a challenger's threat must exceed the
+	// current controller's before control moves (10% over). The hysteresis
+	// stops two evenly matched attackers from flapping control, and the
+	// client AI with it, on every hit.
+	ThreatSwitchMargin = 1.1
+
+	// TauntThreatMultiplier is where a taunt puts the taunter: this multiple
+	// of the current top threat, so only a sustained out-damaging pulls the
+	// monster back off them.
+	TauntThreatMultiplier = 1.5
+)
+
+type threatEntry struct {
+	CharacterId uint32
+	Threat      uint32
+	LastHitMs   int64
+}
+
+// ThreatEntries returns the monster's aggro table.
+func (m Model) ThreatEntries() []threatEntry {
+	return m.threatEntries
+}
+
+// Threat returns characterId's threat on the monster, 0 when absent.
+func (m Model) Threat(characterId uint32) uint32 {
+	for _, e := range m.threatEntries {
+		if e.CharacterId == characterId {
+			return e.Threat
+		}
+	}
+	return 0
+}
+
+// ThreatLeader returns the character holding the most threat. Ties go to the
+// earlier entry, so a newcomer must strictly overtake.
+func (m Model) ThreatLeader() (uint32, bool) {
+	index := -1
+	for i, e := range m.threatEntries {
+		if index == -1 || m.threatEntries[index].Threat < e.Threat {
+			index = i
+		}
+	}
+	if index == -1 {
+		return 0, false
+	}
+	return m.threatEntries[index].CharacterId, true
+}
+
+// Phase is the boss phase the monster has reached: the number of WZ HP
+// thresholds its HP has fallen through. 0 for ordinary monsters.
+func (m Model) Phase() byte {
+	return m.phase
+}
+
+// threatSwitchTarget returns the character control should move to, if any:
+// the threat leader, when it is not already the controller and either the
+// monster is uncontrolled or the leader beats the controller's threat by
+// margin (ThreatSwitchMargin; 1 on a phase change, where any lead wins).
+func threatSwitchTarget(m Model, margin float64) (uint32, bool) {
+	leader, ok := m.ThreatLeader()
+	if !ok || leader == m.ControlCharacterId() {
+		return 0, false
+	}
+	if m.ControlCharacterId() == 0 {
+		return leader, true
+	}
+	if float64(m.Threat(leader)) <= float64(m.Threat(m.ControlCharacterId()))*margin {
+		return 0, false
+	}
+	return leader, true
+}
+
+// addThreat credits characterId with damage-weighted threat and stamps the
+// hit time. Threat saturates rather than wrapping.
+func addThreat(entries []storedThreatEntry, characterId uint32, damage uint32, nowMs int64) []storedThreatEntry {
+	amount := uint32(math.Min(float64(damage)*ThreatPerDamage, math.MaxUint32))
+	for i := range entries {
+		if entries[i].CharacterId == characterId {
+			entries[i].Threat = saturatingAdd(entries[i].Threat, amount)
+			entries[i].LastHitMs = nowMs
+			return entries
+		}
+	}
+	return append(entries, storedThreatEntry{CharacterId: characterId, Threat: amount, LastHitMs: nowMs})
+}
+
+func saturatingAdd(a, b uint32) uint32 {
+	if a > math.MaxUint32-b {
+		return math.MaxUint32
+	}
+	return a + b
+}
+
+// decayThreat decays idle entries by AggroDecayMultiplier and prunes any that
+// fall below AggroDecayFloor.
+func decayThreat(entries []storedThreatEntry, nowMs int64) []storedThreatEntry {
+	var kept []storedThreatEntry
+	for _, e := range entries {
+		if (nowMs - e.LastHitMs) > AggroIdleThresholdMs {
+			e.Threat = uint32(math.Floor(float64(e.Threat) * AggroDecayMultiplier))
+		}
+		if e.Threat >= AggroDecayFloor {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// tauntThreat raises characterId to TauntThreatMultiplier times the highest
+// threat anyone else holds (never lowering it), so the taunter becomes the
+// leader by a margin wider than ThreatSwitchMargin.
+func tauntThreat(entries []storedThreatEntry, characterId uint32, nowMs int64) []storedThreatEntry {
+	var top uint32
+	for _, e := range entries {
+		if e.CharacterId != characterId && e.Threat > top {
+			top = e.Threat
+		}
+	}
+	target := uint32(math.Min(math.Max(float64(top)*TauntThreatMultiplier, 1), math.MaxUint32))
+	for i := range entries {
+		if entries[i].CharacterId == characterId {
+			entries[i].Threat = max(entries[i].Threat, target)
+			entries[i].LastHitMs = nowMs
+			return entries
+		}
+	}
+	return append(entries, storedThreatEntry{CharacterId: characterId, Threat: target, LastHitMs: nowMs})
+}
+
+// bossPhaseThresholds returns a boss's phase thresholds, highest first: the
+// distinct HP-percent gates (below 100) of its WZ mob skills. The gates are
+// where a boss's behaviour changes, since that is where new skills unlock;
+// the final transition, death into the next body, is its revive chain.
+func bossPhaseThresholds(info information.Model, skillsFn mobSkillFetcher) []uint32 {
+	seen := make(map[uint32]struct{})
+	var thresholds []uint32
+	for _, s := range info.Skills() {
+		sd, err := skillsFn(uint16(s.Id), uint16(s.Level))
+		if err != nil {
+			continue
+		}
+		hp := sd.Hp()
+		if hp == 0 || hp >= 100 {
+			continue
+		}
+		if _, ok := seen[hp]; ok {
+			continue
+		}
+		seen[hp] = struct{}{}
+		thresholds = append(thresholds, hp)
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] > thresholds[j] })
+	return thresholds
+}
+
+// phaseFor returns the phase at hpPercentage against thresholds (highest
+// first) and the threshold that opened it; a mob skill gated at N% is usable
+// once HP is at or below N%.
+func phaseFor(hpPercentage uint32, thresholds []uint32) (byte, uint32) {
+	var phase byte
+	var threshold uint32
+	for _, t := range thresholds {
+		if hpPercentage > t {
+			break
+		}
+		phase++
+		threshold = t
+	}
+	return phase, threshold
+}
+
+// mobSkillLookup resolves a mob skill, honouring testMobSkillLookup.
+func (p *ProcessorImpl) mobSkillLookup(skillId, skillLevel uint16) (mobskill.Model, error) {
+	if testMobSkillLookup != nil {
+		return testMobSkillLookup(skillId, skillLevel)
+	}
+	return mobskill.NewProcessor(p.l, p.ctx).GetByIdAndLevel(skillId, skillLevel)
+}
+
+// RetargetByThreat hands control of the monster to its threat leader once the
+// leader has overtaken the controller by ThreatSwitchMargin. Run after threat
+// decays, so a controller who walked away loses the monster to whoever is
+// still fighting it. A monster that no longer exists is a no-op.
+func (p *ProcessorImpl) RetargetByThreat(uniqueId uint32) error {
+	m, err := GetMonsterRegistry().GetMonster(p.t, uniqueId)
+	if err != nil || !m.Alive() {
+		return nil
+	}
+	p.switchToThreatLeader(m, ThreatSwitchMargin)
+	return nil
+}
+
+// Taunt puts characterId at the top of the monster's threat table and hands
+// them control with aggro, so the client AI chases the taunter whichever
+// client controlled the monster before.
+func (p *ProcessorImpl) Taunt(uniqueId uint32, characterId uint32) error {
+	m, err := GetMonsterRegistry().Taunt(p.t, uniqueId, characterId, time.Now().UnixMilli())
+	if err != nil {
+		if errors.Is(err, errMonsterNotFound) {
+			p.l.Debugf("Taunt of monster [%d]: monster no longer exists; dropping.", uniqueId)
+			return nil
+		}
+		return err
+	}
+	if !m.Alive() || m.ControlCharacterId() == characterId {
+		return nil
+	}
+	p.l.Debugf("Character [%d] taunted monster [%d].", characterId, uniqueId)
+	p.handOffControl(m, characterId, true)
+	return nil
+}
+
+// switchToThreatLeader moves control to the threat switch target, if there is
+// one, and reports whether control moved. A monster held by its controller's
+// puppet stays put: DrawToPuppet hands it to an owner who usually holds no
+// threat, and the puppet redirects the monster until it is removed.
+func (p *ProcessorImpl) switchToThreatLeader(m Model, margin float64) bool {
+	target, ok := threatSwitchTarget(m, margin)
+	if !ok {
+		return false
+	}
+	if p.heldByPuppet(m) {
+		p.l.Debugf("Monster [%d] is held by the puppet of controller [%d]; ignoring threat leader [%d].", m.UniqueId(), m.ControlCharacterId(), target)
+		return false
+	}
+	p.l.Debugf("Character [%d] has become threat leader for monster [%d].", target, m.UniqueId())
+	return p.handOffControl(m, target, false)
+}
+
+// heldByPuppet reports whether m's controller owns a puppet within vicinity
+// of it.
+func (p *ProcessorImpl) heldByPuppet(m Model) bool {
+	pr := GetPuppetRegistry()
+	if pr == nil {
+		return false
+	}
+	owner, ok := pr.VicinityOwner(p.ctx, p.t, m.Field(), m.X(), m.Y())
+	return ok && owner == m.ControlCharacterId()
+}
+
+// handOffControl moves control of m to characterId unless they are GM-hidden
+// or not in the monster's field, and reports whether control moved.
+func (p *ProcessorImpl) handOffControl(m Model, characterId uint32, forceAggro bool) bool {
+	if _, isHidden := p.hiddenSet()[characterId]; isHidden {
+		p.l.Debugf("Skipping controller switch to GM-hidden character [%d] for monster [%d].", characterId, m.UniqueId())
+		return false
+	}
+	inField, err := p.attackerInField(m.Field(), characterId)
+	if err != nil || !inField {
+		p.l.Debugf("FR-10: skipping controller switch for char [%d] not in field of monster [%d].", characterId, m.UniqueId())
+		return false
+	}
+	if _, err := p.startControl(m.UniqueId(), characterId, forceAggro); err != nil {
+		p.l.WithError(err).Errorf("Unable to start [%d] controlling monster [%d].", characterId, m.UniqueId())
+		return false
+	}
+	return true
+}
+
+// advancePhase moves a boss into the phase its HP has reached and emits
+// PHASE_CHANGED. It reports whether the phase moved.
+func (p *ProcessorImpl) advancePhase(m Model, info information.Model) bool {
+	phase, threshold := phaseFor(m.HpPercentage(), bossPhaseThresholds(info, p.mobSkillLookup))
+	if phase <= m.Phase() {
+		return false
+	}
+	updated, advanced, err := GetMonsterRegistry().AdvancePhase(p.t, m.UniqueId(), phase)
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to advance monster [%d] to phase [%d].", m.UniqueId(), phase)
+		return false
+	}
+	if !advanced {
+		return false
+	}
+	p.l.Debugf("Boss [%d] entered phase [%d] at [%d%%] HP.", m.UniqueId(), phase, updated.HpPercentage())
+	if err := p.emit(EnvEventTopicMonsterStatus, phaseChangedStatusEventProvider(updated, threshold)); err != nil {
+		p.l.WithError(err).Errorf("Unable to announce phase [%d] of monster [%d].", phase, m.UniqueId())
+	}
+	return true
+}
@@ -0,0 +1,279 @@
+package monster
+
+import (
+	"atlas-monsters/monster/information"
+	"atlas-monsters/monster/mobskill"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	monster2 "github.com/Chronicle20/atlas/libs/atlas-constants/monster"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func threatModel(controller uint32, entries ...threatEntry) Model {
+	m := NewMonster(field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build(), 1, 9300018, 0, 0, 0, 5, 0, 1000, 50, "", "")
+	m.controlCharacterId = controller
+	m.threatEntries = entries
+	return m
+}
+
+func TestThreatSwitchTarget(t *testing.T) {
+	// Uncontrolled: the leader takes it.
+	target, ok := threatSwitchTarget(threatModel(0, threatEntry{CharacterId: 1, Threat: 10}), ThreatSwitchMargin)
+	require.True(t, ok)
+	require.Equal(t, uint32(1), target)
+
+	// Within the hysteresis margin the controller keeps it.
+	_, ok = threatSwitchTarget(threatModel(1, threatEntry{CharacterId: 1, Threat: 100}, threatEntry{CharacterId: 2, Threat: 110}), ThreatSwitchMargin)
+	require.False(t, ok)
+
+	// Past the margin control moves.
+	target, ok = threatSwitchTarget(threatModel(1, threatEntry{CharacterId: 1, Threat: 100}, threatEntry{CharacterId: 2, Threat: 111}), ThreatSwitchMargin)
+	require.True(t, ok)
+	require.Equal(t, uint32(2), target)
+
+	// A phase change (margin 1) hands over on any lead.
+	_, ok = threatSwitchTarget(threatModel(1, threatEntry{CharacterId: 1, Threat: 100}, threatEntry{CharacterId: 2, Threat: 101}), 1)
+	require.True(t, ok)
+
+	// The controller already leads.
+	_, ok = threatSwitchTarget(threatModel(1, threatEntry{CharacterId: 1, Threat: 100}), ThreatSwitchMargin)
+	require.False(t, ok)
+}
+
+func TestDecayThreat(t *testing.T) {
+	entries := []storedThreatEntry{
+		{CharacterId: 1, Threat: 100, LastHitMs: 0},
+		{CharacterId: 2, Threat: 100, LastHitMs: AggroIdleThresholdMs},
+		{CharacterId: 3, Threat: 1, LastHitMs: 0},
+	}
+	got := decayThreat(entries, AggroIdleThresholdMs+1)
+	require.Equal(t, []storedThreatEntry{
+		{CharacterId: 1, Threat: 85, LastHitMs: 0},
+		{CharacterId: 2, Threat: 100, LastHitMs: AggroIdleThresholdMs},
+	}, got)
+}
+
+func TestTauntThreat(t *testing.T) {
+	entries := []storedThreatEntry{{CharacterId: 1, Threat: 200}, {CharacterId: 2, Threat: 50}}
+	got := tauntThreat(entries, 2, 5)
+	require.Equal(t, uint32(300), got[1].Threat)
+	require.Equal(t, int64(5), got[1].LastHitMs)
+
+	// A taunter with no prior threat joins the table; an empty table still
+	// gives them a non-zero entry.
+	got = tauntThreat(nil, 3, 5)
+	require.Equal(t, []storedThreatEntry{{CharacterId: 3, Threat: 1, LastHitMs: 5}}, got)
+}
+
+func TestBossPhaseThresholdsAndPhaseFor(t *testing.T) {
+	info := information.NewModelBuilder().SetSkills([]information.Skill{
+		{Id: 100, Level: 1}, {Id: 101, Level: 1}, {Id: 102, Level: 1}, {Id: 103, Level: 1}, {Id: 104, Level: 1},
+	}).Build()
+	skills := mobSkillTable(map[uint32]mobskill.Model{
+		100001: mskill(t, 100, 1, 100, 0, 50, 0),
+		101001: mskill(t, 101, 1, 100, 0, 20, 0),
+		102001: mskill(t, 102, 1, 100, 0, 50, 0),  // duplicate gate
+		103001: mskill(t, 103, 1, 100, 0, 100, 0), // always usable: no gate
+	})
+	thresholds := bossPhaseThresholds(info, skills)
+	require.Equal(t, []uint32{50, 20}, thresholds)
+
+	phase, threshold := phaseFor(80, thresholds)
+	require.Equal(t, byte(0), phase)
+	require.Equal(t, uint32(0), threshold)
+	phase, threshold = phaseFor(50, thresholds)
+	require.Equal(t, byte(1), phase)
+	require.Equal(t, uint32(50), threshold)
+	phase, threshold = phaseFor(10, thresholds)
+	require.Equal(t, byte(2), phase)
+	require.Equal(t, uint32(20), threshold)
+}
+
+func TestRegistry_ThreatFollowsDamageAndClears(t *testing.T) {
+	r := GetMonsterRegistry()
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	r.Clear(context.Background())
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	m := r.CreateMonster(context.Background(), ten, f, 9300018, 0, 0, 0, 5, 0, 1000, 50, "", "")
+
+	_, err := r.ApplyDamage(ten, 1, 300, m.UniqueId(), 10)
+	require.NoError(t, err)
+	s, err := r.ApplyDamage(ten, 2, 5000, m.UniqueId(), 20)
+	require.NoError(t, err)
+	// Threat is the landed (clamped) damage.
+	require.Equal(t, uint32(300), s.Monster.Threat(1))
+	require.Equal(t, uint32(700), s.Monster.Threat(2))
+
+	got, _, err := r.AdvancePhase(ten, m.UniqueId(), 2)
+	require.NoError(t, err)
+	require.Equal(t, byte(2), got.Phase())
+	_, advanced, err := r.AdvancePhase(ten, m.UniqueId(), 1)
+	require.NoError(t, err)
+	require.False(t, advanced, "phases never move backwards")
+
+	_, err = r.ClearDamageEntries(ten, m.UniqueId())
+	require.NoError(t, err)
+	got, err = r.GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Empty(t, got.ThreatEntries())
+	require.Equal(t, byte(2), got.Phase())
+}
+
+func newThreatTestMonster(t *testing.T, boss bool, skills []information.Skill) (*ProcessorImpl, *[]emittedBody, tenant.Model, Model) {
+	t.Helper()
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := tenant.WithContext(context.Background(), ten)
+	GetMonsterRegistry().Clear(ctx)
+
+	testInformationLookup = func(uint32) (information.Model, error) {
+		return information.NewModelBuilder().SetBoss(boss).SetSkills(skills).Build(), nil
+	}
+	t.Cleanup(func() { testInformationLookup = nil })
+
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	m := GetMonsterRegistry().CreateMonster(ctx, ten, f, 8800000, 0, 0, 0, 5, 0, 10000, 50, "", "")
+	p, events := newRecordingProcessorWithBodies(t, ten)
+	p.ctx = ctx
+	return p, events, ten, m
+}
+
+func TestDamage_ControlFollowsThreatWithHysteresis(t *testing.T) {
+	p, events, ten, m := newThreatTestMonster(t, false, nil)
+	_, err := GetMonsterRegistry().ControlMonster(ten, m.UniqueId(), 1)
+	require.NoError(t, err)
+
+	p.Damage(m.UniqueId(), 1, []uint32{1000}, 0, "", 0)
+	p.Damage(m.UniqueId(), 2, []uint32{1050}, 0, "", 0)
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), got.ControlCharacterId(), "a 5% lead stays inside the margin")
+	require.NotContains(t, eventTypes(events), EventMonsterStatusStartControl)
+
+	p.Damage(m.UniqueId(), 2, []uint32{100}, 0, "", 0)
+	got, err = GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), got.ControlCharacterId())
+	require.Contains(t, eventTypes(events), EventMonsterStatusStartControl)
+}
+
+func TestApplyStatusEffect_ShowdownTauntsOntoCaster(t *testing.T) {
+	p, events, ten, m := newThreatTestMonster(t, false, nil)
+	_, err := GetMonsterRegistry().ControlMonster(ten, m.UniqueId(), 1)
+	require.NoError(t, err)
+	p.Damage(m.UniqueId(), 1, []uint32{2000}, 0, "", 0)
+
+	showdown := NewStatusEffect(SourceTypePlayerSkill, 4, 4121003, 10, map[string]int32{monster2.StatusShowdown: 10}, time.Minute, 0)
+	require.NoError(t, p.ApplyStatusEffect(m.UniqueId(), showdown))
+
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), got.ControlCharacterId())
+	require.True(t, got.ControllerHasAggro())
+	require.Equal(t, uint32(3000), got.Threat(4))
+
+	var sawStart bool
+	for _, e := range *events {
+		if e.Type != EventMonsterStatusStartControl {
+			continue
+		}
+		var body statusEventStartControlBody
+		require.NoError(t, json.Unmarshal(e.Body, &body))
+		if body.ActorId == 4 {
+			sawStart = true
+			require.True(t, body.ControllerHasAggro)
+		}
+	}
+	require.True(t, sawStart)
+
+	// The taunter keeps the monster until out-damaged past the margin.
+	p.Damage(m.UniqueId(), 1, []uint32{1000}, 0, "", 0)
+	got, err = GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), got.ControlCharacterId())
+}
+
+func TestDamage_BossPhaseChangeEmitsAndHandsControlToThreatLeader(t *testing.T) {
+	testMobSkillLookup = func(id, lvl uint16) (mobskill.Model, error) {
+		if id == 100 {
+			return mskill(t, 100, 1, 100, 0, 50, 0), nil
+		}
+		return mobskill.Model{}, errors.New("not found")
+	}
+	t.Cleanup(func() { testMobSkillLookup = nil })
+	p, events, ten, m := newThreatTestMonster(t, true, []information.Skill{{Id: 100, Level: 1}})
+	_, err := GetMonsterRegistry().ControlMonster(ten, m.UniqueId(), 1)
+	require.NoError(t, err)
+
+	p.Damage(m.UniqueId(), 1, []uint32{2000}, 0, "", 0)
+	p.Damage(m.UniqueId(), 2, []uint32{2100}, 0, "", 0)
+	got, err := GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, byte(0), got.Phase())
+	require.Equal(t, uint32(1), got.ControlCharacterId())
+
+	// 10000 - 4100 - 1000 = 49%: the 50% gate opens phase 1, and control
+	// goes to character 2 without waiting for the margin.
+	p.Damage(m.UniqueId(), 2, []uint32{1000}, 0, "", 0)
+	got, err = GetMonsterRegistry().GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, byte(1), got.Phase())
+	require.Equal(t, uint32(2), got.ControlCharacterId())
+
+	var phaseEvents int
+	for _, e := range *events {
+		if e.Type != EventMonsterStatusPhaseChanged {
+			continue
+		}
+		phaseEvents++
+		var body statusEventPhaseChangedBody
+		require.NoError(t, json.Unmarshal(e.Body, &body))
+		require.Equal(t, byte(1), body.Phase)
+		require.Equal(t, uint32(50), body.HpThreshold)
+		require.Equal(t, uint32(49), body.HpPercentage)
+	}
+	require.Equal(t, 1, phaseEvents)
+
+	// Further damage inside the same phase announces nothing new.
+	p.Damage(m.UniqueId(), 2, []uint32{100}, 0, "", 0)
+	var again int
+	for _, e := range *events {
+		if e.Type == EventMonsterStatusPhaseChanged {
+			again++
+		}
+	}
+	require.Equal(t, 1, again)
+}
+
+func TestAggroDecayTask_DecaysBossThreatAndRetargets(t *testing.T) {
+	r := GetMonsterRegistry()
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	r.Clear(context.Background())
+	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(40000)).Build()
+	m := r.CreateMonster(context.Background(), ten, f, 8800000, 0, 0, 0, 5, 0, 10000, 50, "", "")
+	_, err := r.ApplyDamage(ten, 1, 100, m.UniqueId(), 0)
+	require.NoError(t, err)
+
+	tk, _, _ := newAggroTaskWithRecorder(t, map[uint32]bool{8800000: true})
+	var retargeted []uint32
+	tk.retargetFn = func(_ tenant.Model, uniqueId uint32) { retargeted = append(retargeted, uniqueId) }
+	tk.nowFn = func() int64 { return AggroIdleThresholdMs + 1_000 }
+	tk.Run()
+
+	got, err := r.GetMonster(ten, m.UniqueId())
+	require.NoError(t, err)
+	require.Equal(t, uint32(85), got.Threat(1), "boss threat decays")
+	require.Len(t, got.DamageEntries(), 1)
+	require.Equal(t, uint32(100), got.DamageEntries()[0].Damage, "boss kill credit does not")
+	require.Equal(t, []uint32{m.UniqueId()}, retargeted)
+}
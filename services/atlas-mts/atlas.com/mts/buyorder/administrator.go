@@ -0,0 +1,178 @@
+package buyorder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// GetById is the exported provider wrapper: it resolves a buy order by
+// surrogate id, mapping the entity to the immutable Model.
+func GetById(id string) database.EntityProvider[Model] {
+	return func(db *gorm.DB) model.Provider[Model] {
+		return model.Map(modelFromEntity)(getById(id)(db))
+	}
+}
+
+// GetExpiredOpen resolves up to limit open orders past their expiry. The sweep
+// passes a WithoutTenantFilter handle so discovery spans every tenant.
+func GetExpiredOpen(now time.Time, limit int) database.EntityProvider[[]Model] {
+	return func(db *gorm.DB) model.Provider[[]Model] {
+		return model.SliceMap(modelFromEntity)(getExpiredOpen(now, limit)(db))()
+	}
+}
+
+// GetStaleFilling resolves up to limit orders that entered filling before the
+// cutoff.
+func GetStaleFilling(cutoff time.Time, limit int) database.EntityProvider[[]Model] {
+	return func(db *gorm.DB) model.Provider[[]Model] {
+		return model.SliceMap(modelFromEntity)(getStaleFilling(cutoff, limit)(db))()
+	}
+}
+
+// CreateBuyOrder assigns a fresh surrogate id when unset, persists the row, and
+// returns the stored Model. A zero MinQuantity floors to 1 so every order asks
+// for at least one unit.
+func CreateBuyOrder(db *gorm.DB, m Model) (Model, error) {
+	id := m.Id()
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
+	now := time.Now()
+	createdAt := m.CreatedAt()
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	c := m.Constraints()
+	if c.MinQuantity == 0 {
+		c.MinQuantity = 1
+	}
+
+	e := entity{
+		Id:              id,
+		TenantId:        m.TenantId(),
+		WorldId:         byte(m.WorldId()),
+		CharacterId:     m.CharacterId(),
+		AccountId:       m.AccountId(),
+		ItemId:          m.ItemId(),
+		MinQuantity:     c.MinQuantity,
+		MinStrength:     c.MinStrength,
+		MinDexterity:    c.MinDexterity,
+		MinIntelligence: c.MinIntelligence,
+		MinLuck:         c.MinLuck,
+		MinWeaponAttack: c.MinWeaponAttack,
+		MinMagicAttack:  c.MinMagicAttack,
+		MinSlots:        c.MinSlots,
+		MaxPrice:        m.MaxPrice(),
+		EscrowAmount:    m.EscrowAmount(),
+		EscrowTxnId:     m.EscrowTxnId(),
+		State:           string(m.State()),
+		ExpiresAt:       m.ExpiresAt(),
+		CreatedAt:       createdAt,
+		UpdatedAt:       now,
+	}
+	if err := db.Create(&e).Error; err != nil {
+		return Model{}, err
+	}
+	return modelFromEntity(e)
+}
+
+// UpdateState performs the race-safe conditional transition. It updates the row
+// only when its current state equals `from`, returning the number of rows
+// affected: 1 on a successful transition, 0 if another writer already moved the
+// row out of `from`. The tenant callback scopes the write to the request's
+// tenant.
+func UpdateState(db *gorm.DB, id string, from State, to State) (int64, error) {
+	oid := parseId(id)
+	if oid == uuid.Nil {
+		// Guard against the GORM zero-value struct-condition elision: a uuid.Nil id
+		// would vanish from the WHERE, transitioning every order in `from`.
+		return 0, fmt.Errorf("invalid buy order id %q", id)
+	}
+	result := db.Model(&entity{}).
+		Where(map[string]interface{}{"id": oid, "state": string(from)}).
+		Updates(map[string]interface{}{
+			"state":      string(to),
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// resolveEscrow settles the order whose hold saga is escrowTxnId out of
+// pending_escrow (pending_escrow->to). It returns 1 iff the row was still
+// pending — 0 for another service's saga, a release saga, or a redelivery.
+func resolveEscrow(db *gorm.DB, escrowTxnId uuid.UUID, to State) (int64, error) {
+	if escrowTxnId == uuid.Nil {
+		return 0, nil
+	}
+	result := db.Model(&entity{}).
+		Where(map[string]interface{}{"escrow_txn_id": escrowTxnId, "state": string(StatePendingEscrow)}).
+		Updates(map[string]interface{}{
+			"state":      string(to),
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// markFilling claims an open order for a fill (open->filling) and records the
+// matched listing, fill transaction and claim time in the same conditional
+// update. It returns 1 iff this caller won the claim.
+func markFilling(db *gorm.DB, id uuid.UUID, listingId uuid.UUID, fillTxnId uuid.UUID, at time.Time) (int64, error) {
+	if id == uuid.Nil {
+		return 0, fmt.Errorf("invalid buy order id %q", id)
+	}
+	result := db.Model(&entity{}).
+		Where(map[string]interface{}{"id": id, "state": string(StateOpen)}).
+		Updates(map[string]interface{}{
+			"state":           string(StateFilling),
+			"fill_listing_id": listingId,
+			"fill_txn_id":     fillTxnId,
+			"filling_at":      at,
+			"updated_at":      at,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// revertFilling returns a filling order to open (filling->open) and clears its
+// fill bookkeeping, making it eligible to match again. It returns 1 iff the row
+// was still filling.
+func revertFilling(db *gorm.DB, id uuid.UUID) (int64, error) {
+	if id == uuid.Nil {
+		return 0, fmt.Errorf("invalid buy order id %q", id)
+	}
+	result := db.Model(&entity{}).
+		Where(map[string]interface{}{"id": id, "state": string(StateFilling)}).
+		Updates(map[string]interface{}{
+			"state":           string(StateOpen),
+			"fill_listing_id": uuid.Nil,
+			"fill_txn_id":     uuid.Nil,
+			"filling_at":      nil,
+			"updated_at":      time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// completeFill marks the order filling against listingId for buyerId as filled
+// (filling->filled). It returns 0 when no such order exists — the settle-move was
+// an ordinary buy, or a replay of an already-completed fill.
+func completeFill(db *gorm.DB, listingId uuid.UUID, buyerId uint32) (int64, error) {
+	if listingId == uuid.Nil {
+		return 0, nil
+	}
+	result := db.Model(&entity{}).
+		Where(map[string]interface{}{
+			"fill_listing_id": listingId,
+			"character_id":    buyerId,
+			"state":           string(StateFilling),
+		}).
+		Updates(map[string]interface{}{
+			"state":      string(StateFilled),
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
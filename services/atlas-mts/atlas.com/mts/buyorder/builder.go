@@ -0,0 +1,134 @@
+package buyorder
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// Builder constructs an immutable buy order Model. The id is assigned at create
+// time in the administrator when unset, so it is not required here.
+type Builder struct {
+	id            uuid.UUID
+	tenantId      uuid.UUID
+	worldId       world.Id
+	characterId   uint32
+	accountId     uint32
+	itemId        uint32
+	constraints   Constraints
+	maxPrice      uint32
+	escrowAmount  uint32
+	escrowTxnId   uuid.UUID
+	state         State
+	fillListingId uuid.UUID
+	fillTxnId     uuid.UUID
+	fillingAt     *time.Time
+	expiresAt     time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func NewBuilder(tenantId uuid.UUID, characterId uint32, itemId uint32) *Builder {
+	return &Builder{tenantId: tenantId, characterId: characterId, itemId: itemId, state: StateOpen}
+}
+
+func (b *Builder) SetId(id uuid.UUID) *Builder {
+	b.id = id
+	return b
+}
+
+func (b *Builder) SetWorldId(worldId world.Id) *Builder {
+	b.worldId = worldId
+	return b
+}
+
+func (b *Builder) SetAccountId(accountId uint32) *Builder {
+	b.accountId = accountId
+	return b
+}
+
+func (b *Builder) SetConstraints(c Constraints) *Builder {
+	b.constraints = c
+	return b
+}
+
+func (b *Builder) SetMaxPrice(v uint32) *Builder {
+	b.maxPrice = v
+	return b
+}
+
+func (b *Builder) SetEscrowAmount(v uint32) *Builder {
+	b.escrowAmount = v
+	return b
+}
+
+func (b *Builder) SetEscrowTxnId(v uuid.UUID) *Builder {
+	b.escrowTxnId = v
+	return b
+}
+
+func (b *Builder) SetState(s State) *Builder {
+	b.state = s
+	return b
+}
+
+func (b *Builder) SetFillListingId(v uuid.UUID) *Builder {
+	b.fillListingId = v
+	return b
+}
+
+func (b *Builder) SetFillTxnId(v uuid.UUID) *Builder {
+	b.fillTxnId = v
+	return b
+}
+
+func (b *Builder) SetFillingAt(v *time.Time) *Builder {
+	b.fillingAt = v
+	return b
+}
+
+func (b *Builder) SetExpiresAt(v time.Time) *Builder {
+	b.expiresAt = v
+	return b
+}
+
+func (b *Builder) SetCreatedAt(v time.Time) *Builder {
+	b.createdAt = v
+	return b
+}
+
+func (b *Builder) SetUpdatedAt(v time.Time) *Builder {
+	b.updatedAt = v
+	return b
+}
+
+func (b *Builder) Build() (Model, error) {
+	if b.tenantId == uuid.Nil {
+		return Model{}, errors.New("tenantId cannot be nil")
+	}
+	if b.itemId == 0 {
+		return Model{}, errors.New("itemId is required")
+	}
+	return Model{
+		id:            b.id,
+		tenantId:      b.tenantId,
+		worldId:       b.worldId,
+		characterId:   b.characterId,
+		accountId:     b.accountId,
+		itemId:        b.itemId,
+		constraints:   b.constraints,
+		maxPrice:      b.maxPrice,
+		escrowAmount:  b.escrowAmount,
+		escrowTxnId:   b.escrowTxnId,
+		state:         b.state,
+		fillListingId: b.fillListingId,
+		fillTxnId:     b.fillTxnId,
+		fillingAt:     b.fillingAt,
+		expiresAt:     b.expiresAt,
+		createdAt:     b.createdAt,
+		updatedAt:     b.updatedAt,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package buyorder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Migration creates the mts_buy_orders table. It is a brand-new table (no legacy
+// primary-key rewrite), so AutoMigrate alone produces the correct surrogate-key
+// shape and the composite indexes declared on the entity tags.
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&entity{})
+}
+
+// entity is the GORM row for a standing buy order (want-to-buy).
+//
+// The primary key is a surrogate UUID (Id); business identity is never the key,
+// and a (tenant_id, id) unique index keeps the row tenant-scoped. The stat
+// constraints are stored as explicit name-keyed columns — one column per stat,
+// no JSON blob — so the matcher can filter on them in SQL.
+//
+// Composite indexes back the design's hot queries:
+//   - (tenant_id, world_id, item_id, state) — candidate orders for a new listing
+//   - (tenant_id, character_id, state)      — a buyer's own orders + the open cap
+//   - (tenant_id, fill_listing_id)          — the settle-move completion lookup
+//   - (escrow_txn_id)                       — the hold saga's status lookup
+//   - (state, expires_at)                   — the cross-tenant expiry sweep
+type entity struct {
+	Id          uuid.UUID `gorm:"column:id;type:uuid;primaryKey;uniqueIndex:idx_mts_buy_orders_tenant_id,priority:2"`
+	TenantId    uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;uniqueIndex:idx_mts_buy_orders_tenant_id,priority:1;index:idx_mts_buy_orders_match,priority:1;index:idx_mts_buy_orders_character,priority:1;index:idx_mts_buy_orders_fill_listing,priority:1"`
+	WorldId     byte      `gorm:"column:world_id;not null;index:idx_mts_buy_orders_match,priority:2"`
+	CharacterId uint32    `gorm:"column:character_id;not null;index:idx_mts_buy_orders_character,priority:2"`
+	AccountId   uint32    `gorm:"column:account_id;not null"`
+	ItemId      uint32    `gorm:"column:item_id;not null;index:idx_mts_buy_orders_match,priority:3"`
+
+	// Constraints: a listing matches only when every stat is at least the minimum.
+	// A zero minimum places no constraint on that stat.
+	MinQuantity     uint32 `gorm:"column:min_quantity;not null;default:1"`
+	MinStrength     uint16 `gorm:"column:min_strength;not null;default:0"`
+	MinDexterity    uint16 `gorm:"column:min_dexterity;not null;default:0"`
+	MinIntelligence uint16 `gorm:"column:min_intelligence;not null;default:0"`
+	MinLuck         uint16 `gorm:"column:min_luck;not null;default:0"`
+	MinWeaponAttack uint16 `gorm:"column:min_weapon_attack;not null;default:0"`
+	MinMagicAttack  uint16 `gorm:"column:min_magic_attack;not null;default:0"`
+	MinSlots        uint16 `gorm:"column:min_slots;not null;default:0"`
+
+	// MaxPrice is the highest seller BASE price the buyer accepts; EscrowAmount is
+	// MarkedUp(MaxPrice) captured at create time — the prepaid NX held for the
+	// order's lifetime, and the exact amount every release reverses.
+	MaxPrice     uint32    `gorm:"column:max_price;not null"`
+	EscrowAmount uint32    `gorm:"column:escrow_amount;not null"`
+	EscrowTxnId  uuid.UUID `gorm:"column:escrow_txn_id;type:uuid;not null;index:idx_mts_buy_orders_escrow_txn"`
+
+	State string `gorm:"column:state;not null;index:idx_mts_buy_orders_match,priority:4;index:idx_mts_buy_orders_character,priority:3;index:idx_mts_buy_orders_expiry,priority:1"`
+
+	// Fill bookkeeping: the listing a filling/filled order was matched to, the
+	// fill saga's transaction id, and when the order entered filling (the stale
+	// revert clock). Zero/NULL while the order is open.
+	FillListingId uuid.UUID  `gorm:"column:fill_listing_id;type:uuid;index:idx_mts_buy_orders_fill_listing,priority:2"`
+	FillTxnId     uuid.UUID  `gorm:"column:fill_txn_id;type:uuid"`
+	FillingAt     *time.Time `gorm:"column:filling_at"`
+
+	ExpiresAt time.Time `gorm:"column:expires_at;not null;index:idx_mts_buy_orders_expiry,priority:2"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (e entity) TableName() string {
+	return "mts_buy_orders"
+}
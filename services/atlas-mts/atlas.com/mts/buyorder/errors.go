@@ -0,0 +1,24 @@
+package buyorder
+
+import "errors"
+
+// Typed failure sentinels for the buy-order create/cancel paths. The REST
+// handlers map them (via errors.Is) to 4xx responses. An under-funded create
+// reuses listing.ErrInsufficientPrepaid so every prepaid shortfall in the
+// service shares one sentinel.
+var (
+	// ErrBelowPriceFloor — the order's max price is under the tenant's MTS price
+	// floor, the same floor a listing's price must clear.
+	ErrBelowPriceFloor = errors.New("buy order max price is below the mts price floor")
+
+	// ErrOrderCapReached — the character already has the tenant's maximum number
+	// of escrowed (open or filling) buy orders.
+	ErrOrderCapReached = errors.New("character has reached the open buy order cap")
+
+	// ErrNotOwner — a cancel was attempted by someone other than the order's buyer.
+	ErrNotOwner = errors.New("buy order not owned by the requesting character")
+
+	// ErrNotOpen — the order is no longer open (already filling, filled,
+	// cancelled, or expired), so it cannot be cancelled.
+	ErrNotOpen = errors.New("buy order is not open")
+)
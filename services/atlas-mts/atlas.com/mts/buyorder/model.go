@@ -0,0 +1,87 @@
+package buyorder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// State enumerates the lifecycle state of a buy order.
+type State string
+
+const (
+	// StatePendingEscrow is a freshly created order whose hold saga has not yet
+	// reported. Nothing has been debited, so it cannot match, be cancelled or
+	// expire; the saga's COMPLETED status opens it and its FAILED status
+	// cancels it.
+	StatePendingEscrow State = "pending_escrow"
+	// StateOpen is a live order: its escrow is held and it is eligible to match.
+	StateOpen State = "open"
+	// StateFilling is the transient state an order enters SYNCHRONOUSLY the moment
+	// a fill saga is emitted against a matched listing (open->filling, a race-safe
+	// CAS), taking it out of the matcher's candidate set so a second listing cannot
+	// claim the same escrow. The settle-move completes it filling->filled; a fill
+	// that never completes is reverted filling->open by the sweep.
+	StateFilling   State = "filling"
+	StateFilled    State = "filled"
+	StateCancelled State = "cancelled"
+	StateExpired   State = "expired"
+)
+
+// Constraints is the match criteria a listing must satisfy beyond the item id:
+// a minimum stack quantity and a minimum for each equip stat. A zero stat
+// minimum places no constraint on that stat.
+type Constraints struct {
+	MinQuantity     uint32
+	MinStrength     uint16
+	MinDexterity    uint16
+	MinIntelligence uint16
+	MinLuck         uint16
+	MinWeaponAttack uint16
+	MinMagicAttack  uint16
+	MinSlots        uint16
+}
+
+// Model is the immutable standing buy order: a character's offer to buy any
+// listing of an item that meets the constraints at or below a maximum BASE
+// price. The marked-up maximum is escrowed from the buyer's prepaid NX for the
+// order's lifetime. Construct it via the Builder.
+type Model struct {
+	id            uuid.UUID
+	tenantId      uuid.UUID
+	worldId       world.Id
+	characterId   uint32
+	accountId     uint32
+	itemId        uint32
+	constraints   Constraints
+	maxPrice      uint32
+	escrowAmount  uint32
+	escrowTxnId   uuid.UUID
+	state         State
+	fillListingId uuid.UUID
+	fillTxnId     uuid.UUID
+	fillingAt     *time.Time
+	expiresAt     time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (m Model) Id() uuid.UUID            { return m.id }
+func (m Model) TenantId() uuid.UUID      { return m.tenantId }
+func (m Model) WorldId() world.Id        { return m.worldId }
+func (m Model) CharacterId() uint32      { return m.characterId }
+func (m Model) AccountId() uint32        { return m.accountId }
+func (m Model) ItemId() uint32           { return m.itemId }
+func (m Model) Constraints() Constraints { return m.constraints }
+func (m Model) MaxPrice() uint32         { return m.maxPrice }
+func (m Model) EscrowAmount() uint32     { return m.escrowAmount }
+func (m Model) EscrowTxnId() uuid.UUID   { return m.escrowTxnId }
+func (m Model) State() State             { return m.state }
+func (m Model) FillListingId() uuid.UUID { return m.fillListingId }
+func (m Model) FillTxnId() uuid.UUID     { return m.fillTxnId }
+func (m Model) FillingAt() *time.Time    { return m.fillingAt }
+func (m Model) ExpiresAt() time.Time     { return m.expiresAt }
+func (m Model) CreatedAt() time.Time     { return m.createdAt }
+func (m Model) UpdatedAt() time.Time     { return m.updatedAt }
@@ -0,0 +1,450 @@
+package buyorder
+
+import (
+	"atlas-mts/configuration"
+	"atlas-mts/holding"
+	mtsmsg "atlas-mts/kafka/message/mts"
+	"atlas-mts/listing"
+	"atlas-mts/saga"
+	"atlas-mts/wallet"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// sagaBaseTimeout and sagaPerStepTimeout mirror the listing flows'
+// step-count-scaled saga timeouts: the per-step budget covers one full
+// cross-service Kafka round-trip under a stressed broker.
+const (
+	sagaBaseTimeout    = 10 * time.Second
+	sagaPerStepTimeout = 15 * time.Second
+)
+
+// matchCandidateLimit bounds how many candidate orders a single listing tries to
+// claim. Candidates are already filtered to ones that match, so only a lost
+// claim race moves on to the next one.
+const matchCandidateLimit = 10
+
+// escrowTimeout scales the single-step escrow hold/release saga (N=1).
+func escrowTimeout() time.Duration {
+	const escrowSteps = 1
+	return sagaBaseTimeout + time.Duration(escrowSteps)*sagaPerStepTimeout
+}
+
+// fillTimeout scales the fill saga: the escrow release plus the
+// MtsSettlePurchase expansion (award_currency x2 + mts_move_listing_to_holding).
+func fillTimeout() time.Duration {
+	const fillSteps = 4 // mts_bid_escrow_release + award_currency(buyer) + award_currency(seller) + mts_move_listing_to_holding
+	return sagaBaseTimeout + time.Duration(fillSteps)*sagaPerStepTimeout
+}
+
+// StaleFillAge is how long an order may sit in filling before the sweep resolves
+// it. It is twice the fill saga's timeout, so by then the orchestrator has either
+// completed the fill or compensated it.
+func StaleFillAge() time.Duration {
+	return 2 * fillTimeout()
+}
+
+// CreateRequest carries the buyer-supplied parameters for a new buy order. The
+// buyer's id and account come from the caller (the channel session, or the REST
+// path); MaxPrice is the highest seller BASE price the buyer accepts.
+type CreateRequest struct {
+	WorldId     world.Id
+	CharacterId uint32
+	AccountId   uint32
+	ItemId      uint32
+	Constraints Constraints
+	MaxPrice    uint32
+}
+
+// MatchResult reports the outcome of MatchListing. Matched is true iff an order
+// was claimed and its fill saga emitted; OrderId/BuyerId identify that order.
+type MatchResult struct {
+	Matched bool
+	OrderId uuid.UUID
+	BuyerId uint32
+}
+
+// Processor exposes the buy-order lifecycle: create (escrow hold), cancel and
+// expire (escrow release), the automatic match against a newly accepted listing,
+// and the fill completion driven by the settle-move.
+type Processor interface {
+	GetById(id string) (Model, error)
+	// ByCharacterPagedProvider returns one page of a character's orders, newest
+	// first, optionally narrowed to one state.
+	ByCharacterPagedProvider(characterId uint32, state State, page model.Page) model.Provider[model.Paged[Model]]
+	// OpenByWorldPagedProvider returns one page of a world's open order book,
+	// optionally narrowed to one item, in match-priority order.
+	OpenByWorldPagedProvider(worldId world.Id, itemId uint32, page model.Page) model.Provider[model.Paged[Model]]
+	// Create validates the request against the tenant config (price floor, open
+	// cap), pre-checks the buyer's prepaid balance against the marked-up max
+	// price, persists the order pending_escrow, and emits an MtsBidEscrow hold
+	// saga for that marked-up amount.
+	Create(req CreateRequest) (Model, error)
+	// EscrowHeld opens the order whose hold saga completed
+	// (pending_escrow->open). Returns false when no pending order owns the
+	// transaction.
+	EscrowHeld(escrowTxnId uuid.UUID) (bool, error)
+	// EscrowFailed cancels the order whose hold saga failed
+	// (pending_escrow->cancelled). Nothing was debited, so nothing is released.
+	// Returns false when no pending order owns the transaction.
+	EscrowFailed(escrowTxnId uuid.UUID) (bool, error)
+	// Cancel enforces the buyer-only rule, transitions the order open->cancelled
+	// and releases its escrow.
+	Cancel(id string, characterId uint32) (Model, error)
+	// Expire transitions an open order open->expired and releases its escrow.
+	// Returns true iff this caller won the transition.
+	Expire(id string) (bool, error)
+	// MatchListing tries to fill the best open order against a newly accepted
+	// fixed-price listing. The claimed order moves open->filling and a fill saga
+	// is emitted: release the order's escrow, then settle through the ordinary
+	// MtsSettlePurchase flow at the listing's price.
+	MatchListing(listingId uuid.UUID) (MatchResult, error)
+	// CompleteFill marks the order filling against the listing for the buyer as
+	// filled. It runs inside the settle-move transaction; returns false when the
+	// settlement was not a buy-order fill.
+	CompleteFill(listingId uuid.UUID, buyerId uint32) (bool, error)
+	// ResolveStaleFill settles an order stuck in filling: filled when the buyer
+	// holding from the fill exists, otherwise back to open (the orchestrator has
+	// compensated the fill and re-held the escrow).
+	ResolveStaleFill(id string) (State, error)
+}
+
+type ProcessorImpl struct {
+	l       logrus.FieldLogger
+	ctx     context.Context
+	db      *gorm.DB
+	emitter listing.SagaEmitter
+	balance listing.BalanceReader
+}
+
+// Option mutates a ProcessorImpl during construction.
+type Option func(*ProcessorImpl)
+
+// WithSagaEmitter overrides the saga emitter (default: the real saga Processor).
+func WithSagaEmitter(e listing.SagaEmitter) Option {
+	return func(p *ProcessorImpl) {
+		p.emitter = e
+	}
+}
+
+// WithBalanceReader overrides the buyer-prepaid balance reader (default: the
+// real wallet Processor).
+func WithBalanceReader(b listing.BalanceReader) Option {
+	return func(p *ProcessorImpl) {
+		p.balance = b
+	}
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, opts ...Option) Processor {
+	p := &ProcessorImpl{l: l, ctx: ctx, db: db}
+	p.emitter = saga.NewProcessor(l, ctx)
+	p.balance = wallet.NewProcessor(l, ctx)
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *ProcessorImpl) GetById(id string) (Model, error) {
+	return GetById(id)(p.db.WithContext(p.ctx))()
+}
+
+func (p *ProcessorImpl) ByCharacterPagedProvider(characterId uint32, state State, page model.Page) model.Provider[model.Paged[Model]] {
+	return model.MapPaged(modelFromEntity)(getByCharacterPaged(characterId, state, page)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) OpenByWorldPagedProvider(worldId world.Id, itemId uint32, page model.Page) model.Provider[model.Paged[Model]] {
+	return model.MapPaged(modelFromEntity)(getOpenByWorldPaged(worldId, itemId, page)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+// Create places a standing buy order. The flow is:
+//
+//  1. Validate the max price against the tenant price floor and the character's
+//     escrowed-order count against the open cap.
+//  2. Escrow = MarkedUp(maxPrice) at the tenant's commission — the most the
+//     buyer could owe for any matching listing. Pre-check the buyer's prepaid
+//     balance against it (best-effort; the hold saga's debit is authoritative).
+//  3. Persist the order pending_escrow, then emit MtsBidEscrow{-escrow} to HOLD
+//     it. The order is not matchable until the hold saga's COMPLETED status
+//     opens it (EscrowHeld); a FAILED status, or a failed emit, cancels it, so
+//     an order can never match without escrow.
+func (p *ProcessorImpl) Create(req CreateRequest) (Model, error) {
+	t := tenant.MustFromContext(p.ctx)
+	cfg := configuration.GetRegistry().GetTenantConfig(p.l, p.ctx, t.Id())
+
+	if req.MaxPrice < cfg.PriceFloor() {
+		return Model{}, fmt.Errorf("max price %d is below the floor %d: %w", req.MaxPrice, cfg.PriceFloor(), ErrBelowPriceFloor)
+	}
+	open, err := countOpenByCharacter(p.db.WithContext(p.ctx), req.CharacterId)
+	if err != nil {
+		return Model{}, err
+	}
+	if open >= int64(cfg.MaxOpenBuyOrders()) {
+		return Model{}, fmt.Errorf("character %d has %d open buy orders: %w", req.CharacterId, open, ErrOrderCapReached)
+	}
+
+	escrow := listing.MarkedUp(req.MaxPrice, cfg.CommissionRate(), cfg.CommissionBase())
+	prepaid, err := p.balance.PrepaidBalance(req.AccountId)
+	if err != nil {
+		return Model{}, fmt.Errorf("read buyer %d prepaid balance: %w", req.AccountId, err)
+	}
+	if prepaid < escrow {
+		return Model{}, fmt.Errorf("buyer %d prepaid %d is below the escrow %d: %w", req.AccountId, prepaid, escrow, listing.ErrInsufficientPrepaid)
+	}
+
+	escrowTxnId := uuid.New()
+	m, err := NewBuilder(t.Id(), req.CharacterId, req.ItemId).
+		SetId(uuid.New()).
+		SetWorldId(req.WorldId).
+		SetAccountId(req.AccountId).
+		SetConstraints(req.Constraints).
+		SetMaxPrice(req.MaxPrice).
+		SetEscrowAmount(escrow).
+		SetEscrowTxnId(escrowTxnId).
+		SetState(StatePendingEscrow).
+		SetExpiresAt(time.Now().Add(time.Duration(cfg.BuyOrderHours()) * time.Hour)).
+		Build()
+	if err != nil {
+		return Model{}, err
+	}
+	created, err := CreateBuyOrder(p.db.WithContext(p.ctx), m)
+	if err != nil {
+		return Model{}, err
+	}
+
+	if eerr := p.emitEscrow(escrowTxnId, created, "mts_buy_order_escrow_hold", -int32(escrow)); eerr != nil {
+		if _, cerr := UpdateState(p.db.WithContext(p.ctx), created.Id().String(), StatePendingEscrow, StateCancelled); cerr != nil {
+			p.l.WithError(cerr).Errorf("Unable to cancel buy order [%s] after its escrow hold failed to emit.", created.Id())
+		}
+		return Model{}, eerr
+	}
+	return created, nil
+}
+
+func (p *ProcessorImpl) EscrowHeld(escrowTxnId uuid.UUID) (bool, error) {
+	affected, err := resolveEscrow(p.db.WithContext(p.ctx), escrowTxnId, StateOpen)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (p *ProcessorImpl) EscrowFailed(escrowTxnId uuid.UUID) (bool, error) {
+	affected, err := resolveEscrow(p.db.WithContext(p.ctx), escrowTxnId, StateCancelled)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// Cancel releases a buyer's open order. The open->cancelled CAS is the
+// cancel-vs-fill arbiter: an order already claimed by a fill is not cancellable.
+// Neither is one still pending_escrow — releasing a hold that may yet fail would
+// credit currency that was never debited.
+func (p *ProcessorImpl) Cancel(id string, characterId uint32) (Model, error) {
+	m, err := p.GetById(id)
+	if err != nil {
+		return Model{}, err
+	}
+	if m.CharacterId() != characterId {
+		return Model{}, ErrNotOwner
+	}
+	won, err := p.release(m, StateCancelled)
+	if err != nil {
+		return Model{}, err
+	}
+	if !won {
+		return Model{}, fmt.Errorf("buy order %s is %s: %w", id, m.State(), ErrNotOpen)
+	}
+	return p.GetById(id)
+}
+
+// Expire releases an open order whose term has passed.
+func (p *ProcessorImpl) Expire(id string) (bool, error) {
+	m, err := p.GetById(id)
+	if err != nil {
+		return false, err
+	}
+	return p.release(m, StateExpired)
+}
+
+// release transitions an open order to the terminal state and, on a won
+// transition, emits MtsBidEscrow{+escrow} to return the held prepaid.
+func (p *ProcessorImpl) release(m Model, terminal State) (bool, error) {
+	affected, err := UpdateState(p.db.WithContext(p.ctx), m.Id().String(), StateOpen, terminal)
+	if err != nil {
+		return false, err
+	}
+	if affected != 1 {
+		return false, nil
+	}
+	if eerr := p.emitEscrow(uuid.New(), m, "mts_buy_order_escrow_release", int32(m.EscrowAmount())); eerr != nil {
+		return true, eerr
+	}
+	return true, nil
+}
+
+func (p *ProcessorImpl) emitEscrow(txnId uuid.UUID, m Model, stepId string, amount int32) error {
+	b := saga.NewBuilder().
+		SetTransactionId(txnId).
+		SetSagaType(saga.MtsOperation).
+		SetInitiatedBy(fmt.Sprintf("character_%d", m.CharacterId()))
+	b.AddStep(stepId, saga.Pending, saga.MtsBidEscrow, saga.MtsBidEscrowPayload{
+		TransactionId:   txnId,
+		BidderId:        m.CharacterId(),
+		BidderAccountId: m.AccountId(),
+		Amount:          amount,
+	})
+	b.SetTimeout(escrowTimeout())
+	return p.emitter.Create(b.Build())
+}
+
+// MatchListing fills the best open order against a newly accepted listing. See
+// the interface doc. Only fixed-price listings match: an auction's price is not
+// final and an offer is reserved for its want-ad's poster.
+//
+// Currency correctness: the order's escrow (MarkedUp(maxPrice)) was debited by
+// the hold saga before the order opened (only open orders are candidates), and MtsSettlePurchase debits the buyer MarkedUp(listValue) first.
+// So the fill saga RELEASES the full escrow before the settle; the buyer nets
+// paying exactly the listing's marked-up price. If any later step fails, the
+// orchestrator's reverse-walk re-holds the released escrow, so a failed fill
+// leaves the order's escrow intact for the stale-fill sweep to reopen.
+func (p *ProcessorImpl) MatchListing(listingId uuid.UUID) (MatchResult, error) {
+	db := p.db.WithContext(p.ctx)
+	lm, err := listing.GetById(listingId.String())(db)()
+	if err != nil {
+		return MatchResult{}, fmt.Errorf("load listing %s: %w", listingId, err)
+	}
+	if lm.State() != listing.StateActive || lm.SaleType() != listing.SaleTypeFixed {
+		return MatchResult{}, nil
+	}
+	if lm.SellerAccountId() == 0 {
+		// Crediting account 0 would be a silent wrong-wallet bug; leave the
+		// listing to ordinary buyers.
+		return MatchResult{}, nil
+	}
+	if _, ferr := getFillingByListing(listingId)(db)(); ferr == nil {
+		return MatchResult{}, nil
+	} else if !errors.Is(ferr, gorm.ErrRecordNotFound) {
+		return MatchResult{}, ferr
+	}
+
+	cfg := configuration.GetRegistry().GetTenantConfig(p.l, p.ctx, lm.TenantId())
+	markedUp := listing.MarkedUp(lm.ListValue(), lm.CommissionRate(), cfg.CommissionBase())
+	now := time.Now()
+	candidates, err := getMatchCandidates(MatchCriteria{
+		WorldId:      lm.WorldId(),
+		ItemId:       lm.TemplateId(),
+		SellerId:     lm.SellerId(),
+		Quantity:     lm.Quantity(),
+		Strength:     lm.Strength(),
+		Dexterity:    lm.Dexterity(),
+		Intelligence: lm.Intelligence(),
+		Luck:         lm.Luck(),
+		WeaponAttack: lm.WeaponAttack(),
+		MagicAttack:  lm.MagicAttack(),
+		Slots:        lm.Slots(),
+		MarkedUp:     markedUp,
+		Now:          now,
+	}, matchCandidateLimit)(db)()
+	if err != nil {
+		return MatchResult{}, err
+	}
+
+	for _, c := range candidates {
+		fillTxnId := uuid.New()
+		affected, cerr := markFilling(db, c.Id, listingId, fillTxnId, now)
+		if cerr != nil {
+			return MatchResult{}, cerr
+		}
+		if affected != 1 {
+			// Lost the claim to a concurrent cancel/fill; try the next candidate.
+			continue
+		}
+		order, merr := modelFromEntity(c)
+		if merr != nil {
+			return MatchResult{}, merr
+		}
+		if eerr := p.emitFill(fillTxnId, order, lm, markedUp); eerr != nil {
+			if _, rerr := revertFilling(db, c.Id); rerr != nil {
+				p.l.WithError(rerr).Errorf("Unable to reopen buy order [%s] after its fill saga failed to emit.", c.Id)
+			}
+			return MatchResult{}, eerr
+		}
+		return MatchResult{Matched: true, OrderId: c.Id, BuyerId: c.CharacterId}, nil
+	}
+	return MatchResult{}, nil
+}
+
+// emitFill emits the two-step fill saga: release the order's escrow, then the
+// ordinary MtsSettlePurchase at the listing's price.
+func (p *ProcessorImpl) emitFill(txnId uuid.UUID, order Model, lm listing.Model, markedUp uint32) error {
+	b := saga.NewBuilder().
+		SetTransactionId(txnId).
+		SetSagaType(saga.MtsOperation).
+		SetInitiatedBy(fmt.Sprintf("character_%d", order.CharacterId()))
+	b.AddStep("mts_buy_order_escrow_release", saga.Pending, saga.MtsBidEscrow, saga.MtsBidEscrowPayload{
+		TransactionId:   txnId,
+		ListingId:       lm.Id(),
+		BidderId:        order.CharacterId(),
+		BidderAccountId: order.AccountId(),
+		Amount:          int32(order.EscrowAmount()),
+	})
+	b.AddStep("mts_settle_purchase", saga.Pending, saga.MtsSettlePurchase, saga.MtsSettlePurchasePayload{
+		TransactionId:   txnId,
+		ListingId:       lm.Id(),
+		WorldId:         lm.WorldId(),
+		BuyerId:         order.CharacterId(),
+		BuyerAccountId:  order.AccountId(),
+		SellerId:        lm.SellerId(),
+		SellerAccountId: lm.SellerAccountId(),
+		MarkedUpPrice:   int32(markedUp),
+		ListValue:       int32(lm.ListValue()),
+		ResultKind:      mtsmsg.ResultKindBuyOrder,
+		Price:           lm.ListValue(),
+	})
+	b.SetTimeout(fillTimeout())
+	return p.emitter.Create(b.Build())
+}
+
+func (p *ProcessorImpl) CompleteFill(listingId uuid.UUID, buyerId uint32) (bool, error) {
+	affected, err := completeFill(p.db.WithContext(p.ctx), listingId, buyerId)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (p *ProcessorImpl) ResolveStaleFill(id string) (State, error) {
+	db := p.db.WithContext(p.ctx)
+	m, err := GetById(id)(db)()
+	if err != nil {
+		return "", err
+	}
+	if m.State() != StateFilling {
+		return m.State(), nil
+	}
+	holdingId := listing.MoveHoldingId(m.FillListingId(), m.CharacterId())
+	if _, herr := holding.GetById(holdingId.String())(db)(); herr == nil {
+		if _, cerr := completeFill(db, m.FillListingId(), m.CharacterId()); cerr != nil {
+			return "", cerr
+		}
+		return StateFilled, nil
+	} else if !errors.Is(herr, gorm.ErrRecordNotFound) {
+		return "", herr
+	}
+	if _, rerr := revertFilling(db, m.Id()); rerr != nil {
+		return "", rerr
+	}
+	return StateOpen, nil
+}
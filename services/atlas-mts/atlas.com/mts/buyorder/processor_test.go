@@ -0,0 +1,390 @@
+package buyorder_test
+
+import (
+	"atlas-mts/buyorder"
+	"atlas-mts/holding"
+	"atlas-mts/listing"
+	"atlas-mts/saga"
+	"atlas-mts/test"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
+)
+
+// captureEmitter records every saga handed to it instead of producing to Kafka.
+type captureEmitter struct {
+	all []saga.Saga
+}
+
+func (e *captureEmitter) Create(s saga.Saga) error {
+	e.all = append(e.all, s)
+	return nil
+}
+
+func (e *captureEmitter) last() saga.Saga { return e.all[len(e.all)-1] }
+
+// stubBalanceReader returns a fixed prepaid balance.
+type stubBalanceReader struct {
+	prepaid uint32
+}
+
+func (r *stubBalanceReader) PrepaidBalance(_ uint32) (uint32, error) {
+	return r.prepaid, nil
+}
+
+const (
+	buyer       = uint32(200)
+	buyerAcct   = uint32(2000)
+	seller      = uint32(100)
+	sellerAcct  = uint32(1000)
+	itemId      = uint32(1302000)
+	maxPrice    = uint32(1000)
+	escrowFor1k = uint32(1570) // MarkedUp(1000, 0.07, 500) = ceil(1070)+500 under DefaultConfig
+)
+
+func setup(t *testing.T, prepaid uint32) (buyorder.Processor, *captureEmitter, *gorm.DB) {
+	t.Helper()
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration)
+	t.Cleanup(func() { test.CleanupTestDB(t, db) })
+	for _, tbl := range []string{"mts_buy_orders", "listings", "holdings"} {
+		if err := db.Exec("DELETE FROM " + tbl).Error; err != nil {
+			t.Fatalf("reset %s: %v", tbl, err)
+		}
+	}
+	emitter := &captureEmitter{}
+	p := buyorder.NewProcessor(logrus.New(), test.CreateTestContext(), db,
+		buyorder.WithSagaEmitter(emitter),
+		buyorder.WithBalanceReader(&stubBalanceReader{prepaid: prepaid}),
+	)
+	return p, emitter, db
+}
+
+func createRequest(characterId uint32, price uint32, c buyorder.Constraints) buyorder.CreateRequest {
+	return buyorder.CreateRequest{
+		WorldId:     0,
+		CharacterId: characterId,
+		AccountId:   buyerAcct,
+		ItemId:      itemId,
+		Constraints: c,
+		MaxPrice:    price,
+	}
+}
+
+// createOpen places an order and reports its hold saga completed, the way the
+// saga status consumer would.
+func createOpen(t *testing.T, p buyorder.Processor, req buyorder.CreateRequest) buyorder.Model {
+	t.Helper()
+	m, err := p.Create(req)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if ok, err := p.EscrowHeld(m.EscrowTxnId()); err != nil || !ok {
+		t.Fatalf("EscrowHeld = %v (err %v), want true", ok, err)
+	}
+	m, err = p.GetById(m.Id().String())
+	if err != nil {
+		t.Fatalf("GetById: %v", err)
+	}
+	return m
+}
+
+func seedListing(t *testing.T, db *gorm.DB, saleType listing.SaleType, listValue uint32, watk uint16) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	m, err := listing.NewBuilder(test.TestTenantId, 0, seller).
+		SetId(id).
+		SetSellerAccountId(sellerAcct).
+		SetSellerName("Seller").
+		SetSaleType(saleType).
+		SetState(listing.StateActive).
+		SetTemplateId(itemId).
+		SetQuantity(1).
+		SetWeaponAttack(watk).
+		SetListValue(listValue).
+		SetCommissionRate(0.07).
+		Build()
+	if err != nil {
+		t.Fatalf("build listing: %v", err)
+	}
+	if _, err := listing.CreateListing(db, m); err != nil {
+		t.Fatalf("create listing: %v", err)
+	}
+	return id
+}
+
+func TestCreateEscrowsMarkedUpMaxPrice(t *testing.T) {
+	p, emitter, _ := setup(t, escrowFor1k)
+
+	m, err := p.Create(createRequest(buyer, maxPrice, buyorder.Constraints{}))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if m.State() != buyorder.StatePendingEscrow {
+		t.Errorf("state = %s, want pending_escrow", m.State())
+	}
+	if m.EscrowAmount() != escrowFor1k {
+		t.Errorf("escrow = %d, want %d", m.EscrowAmount(), escrowFor1k)
+	}
+	if m.Constraints().MinQuantity != 1 {
+		t.Errorf("min quantity = %d, want the floor of 1", m.Constraints().MinQuantity)
+	}
+	if len(emitter.all) != 1 {
+		t.Fatalf("expected one hold saga, got %d", len(emitter.all))
+	}
+	sg := emitter.last()
+	if sg.TransactionId != m.EscrowTxnId() {
+		t.Errorf("hold txn = %s, want the order's escrow txn %s", sg.TransactionId, m.EscrowTxnId())
+	}
+	ep, ok := sg.Steps[0].Payload.(sharedsaga.MtsBidEscrowPayload)
+	if !ok || sg.Steps[0].Action != sharedsaga.MtsBidEscrow {
+		t.Fatalf("step[0] = %s %T, want MtsBidEscrow", sg.Steps[0].Action, sg.Steps[0].Payload)
+	}
+	if ep.Amount != -int32(escrowFor1k) || ep.BidderAccountId != buyerAcct {
+		t.Errorf("hold amount/account = %d/%d, want %d/%d", ep.Amount, ep.BidderAccountId, -int32(escrowFor1k), buyerAcct)
+	}
+}
+
+func TestCreateRejectsInvalidOrders(t *testing.T) {
+	p, emitter, _ := setup(t, escrowFor1k-1)
+
+	if _, err := p.Create(createRequest(buyer, 109, buyorder.Constraints{})); !errors.Is(err, buyorder.ErrBelowPriceFloor) {
+		t.Errorf("below floor: err = %v, want ErrBelowPriceFloor", err)
+	}
+	if _, err := p.Create(createRequest(buyer, maxPrice, buyorder.Constraints{})); !errors.Is(err, listing.ErrInsufficientPrepaid) {
+		t.Errorf("under-funded: err = %v, want ErrInsufficientPrepaid", err)
+	}
+	if len(emitter.all) != 0 {
+		t.Errorf("rejected creates emitted %d sagas, want 0", len(emitter.all))
+	}
+}
+
+func TestCreateEnforcesOpenCap(t *testing.T) {
+	p, _, _ := setup(t, 1_000_000)
+
+	for i := 0; i < 10; i++ {
+		if _, err := p.Create(createRequest(buyer, maxPrice, buyorder.Constraints{})); err != nil {
+			t.Fatalf("Create #%d: %v", i, err)
+		}
+	}
+	if _, err := p.Create(createRequest(buyer, maxPrice, buyorder.Constraints{})); !errors.Is(err, buyorder.ErrOrderCapReached) {
+		t.Errorf("11th order: err = %v, want ErrOrderCapReached", err)
+	}
+}
+
+func TestCancelReleasesEscrowOnce(t *testing.T) {
+	p, emitter, _ := setup(t, escrowFor1k)
+	m := createOpen(t, p, createRequest(buyer, maxPrice, buyorder.Constraints{}))
+
+	if _, err := p.Cancel(m.Id().String(), buyer+1); !errors.Is(err, buyorder.ErrNotOwner) {
+		t.Errorf("foreign cancel: err = %v, want ErrNotOwner", err)
+	}
+	cancelled, err := p.Cancel(m.Id().String(), buyer)
+	if err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if cancelled.State() != buyorder.StateCancelled {
+		t.Errorf("state = %s, want cancelled", cancelled.State())
+	}
+	ep := emitter.last().Steps[0].Payload.(sharedsaga.MtsBidEscrowPayload)
+	if ep.Amount != int32(escrowFor1k) {
+		t.Errorf("release amount = %d, want +%d", ep.Amount, escrowFor1k)
+	}
+	if _, err := p.Cancel(m.Id().String(), buyer); !errors.Is(err, buyorder.ErrNotOpen) {
+		t.Errorf("second cancel: err = %v, want ErrNotOpen", err)
+	}
+	if len(emitter.all) != 2 {
+		t.Errorf("sagas = %d, want hold + one release", len(emitter.all))
+	}
+}
+
+func TestExpireReleasesEscrow(t *testing.T) {
+	p, emitter, _ := setup(t, escrowFor1k)
+	m := createOpen(t, p, createRequest(buyer, maxPrice, buyorder.Constraints{}))
+	won, err := p.Expire(m.Id().String())
+	if err != nil || !won {
+		t.Fatalf("Expire: won=%v err=%v", won, err)
+	}
+	if again, _ := p.Expire(m.Id().String()); again {
+		t.Error("second expire won; want a no-op")
+	}
+	if len(emitter.all) != 2 {
+		t.Errorf("sagas = %d, want hold + one release", len(emitter.all))
+	}
+}
+
+// TestEscrowHoldFailureCancelsWithoutMatching asserts an order whose hold saga
+// has not reported is never matched, and one whose hold failed is cancelled
+// without a release — the fill's escrow release would otherwise credit
+// currency that was never debited.
+func TestEscrowHoldFailureCancelsWithoutMatching(t *testing.T) {
+	p, emitter, db := setup(t, escrowFor1k)
+	m, err := p.Create(createRequest(buyer, maxPrice, buyorder.Constraints{}))
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	listingId := seedListing(t, db, listing.SaleTypeFixed, 900, 0)
+
+	if res, err := p.MatchListing(listingId); err != nil || res.Matched {
+		t.Fatalf("pending order matched=%v err=%v, want no match", res.Matched, err)
+	}
+	if _, err := p.Cancel(m.Id().String(), buyer); !errors.Is(err, buyorder.ErrNotOpen) {
+		t.Errorf("pending cancel: err = %v, want ErrNotOpen", err)
+	}
+
+	if ok, err := p.EscrowFailed(m.EscrowTxnId()); err != nil || !ok {
+		t.Fatalf("EscrowFailed = %v (err %v), want true", ok, err)
+	}
+	if got, _ := p.GetById(m.Id().String()); got.State() != buyorder.StateCancelled {
+		t.Errorf("state = %s, want cancelled", got.State())
+	}
+	if ok, _ := p.EscrowHeld(m.EscrowTxnId()); ok {
+		t.Error("a late COMPLETED reopened a cancelled order")
+	}
+	if ok, _ := p.EscrowFailed(m.EscrowTxnId()); ok {
+		t.Error("a redelivered FAILED claimed the order twice")
+	}
+	if res, err := p.MatchListing(listingId); err != nil || res.Matched {
+		t.Errorf("cancelled order matched=%v err=%v, want no match", res.Matched, err)
+	}
+	if len(emitter.all) != 1 {
+		t.Errorf("sagas = %d, want only the hold", len(emitter.all))
+	}
+}
+
+// TestMatchListingFillsBestOrder places three orders — the seller's own, a
+// cheaper one, and the best — and asserts the best is claimed and the fill saga
+// releases its escrow before settling through MtsSettlePurchase.
+func TestMatchListingFillsBestOrder(t *testing.T) {
+	p, emitter, db := setup(t, 1_000_000)
+	createOpen(t, p, createRequest(seller, 5000, buyorder.Constraints{}))
+	cheap := createOpen(t, p, createRequest(buyer+1, 950, buyorder.Constraints{}))
+	best := createOpen(t, p, createRequest(buyer, maxPrice, buyorder.Constraints{}))
+	listingId := seedListing(t, db, listing.SaleTypeFixed, 900, 0)
+
+	res, err := p.MatchListing(listingId)
+	if err != nil {
+		t.Fatalf("MatchListing: %v", err)
+	}
+	if !res.Matched || res.OrderId != best.Id() {
+		t.Fatalf("matched = %v order = %s, want the best order %s", res.Matched, res.OrderId, best.Id())
+	}
+
+	filling, _ := p.GetById(best.Id().String())
+	if filling.State() != buyorder.StateFilling || filling.FillListingId() != listingId {
+		t.Errorf("best order = %s/%s, want filling against %s", filling.State(), filling.FillListingId(), listingId)
+	}
+	if c, _ := p.GetById(cheap.Id().String()); c.State() != buyorder.StateOpen {
+		t.Errorf("cheaper order state = %s, want open", c.State())
+	}
+
+	sg := emitter.last()
+	if sg.TransactionId != filling.FillTxnId() || len(sg.Steps) != 2 {
+		t.Fatalf("fill saga txn/steps = %s/%d, want %s/2", sg.TransactionId, len(sg.Steps), filling.FillTxnId())
+	}
+	release := sg.Steps[0].Payload.(sharedsaga.MtsBidEscrowPayload)
+	if release.Amount != int32(escrowFor1k) {
+		t.Errorf("release amount = %d, want +%d", release.Amount, escrowFor1k)
+	}
+	settle, ok := sg.Steps[1].Payload.(sharedsaga.MtsSettlePurchasePayload)
+	if !ok {
+		t.Fatalf("step[1] payload = %T, want MtsSettlePurchasePayload", sg.Steps[1].Payload)
+	}
+	if settle.MarkedUpPrice != 1463 || settle.ListValue != 900 {
+		t.Errorf("settle markedUp/listValue = %d/%d, want 1463/900", settle.MarkedUpPrice, settle.ListValue)
+	}
+	if settle.BuyerId != buyer || settle.SellerAccountId != sellerAcct {
+		t.Errorf("settle buyer/sellerAccount = %d/%d, want %d/%d", settle.BuyerId, settle.SellerAccountId, buyer, sellerAcct)
+	}
+
+	// A replayed accept must not claim a second order for the same listing.
+	again, err := p.MatchListing(listingId)
+	if err != nil || again.Matched {
+		t.Errorf("replayed match = %v (err %v), want no match", again.Matched, err)
+	}
+}
+
+func TestMatchListingHonoursConstraintsAndSaleType(t *testing.T) {
+	p, emitter, db := setup(t, 1_000_000)
+	createOpen(t, p, createRequest(buyer, maxPrice, buyorder.Constraints{MinWeaponAttack: 20}))
+	holds := len(emitter.all)
+
+	weak := seedListing(t, db, listing.SaleTypeFixed, 900, 19)
+	if res, err := p.MatchListing(weak); err != nil || res.Matched {
+		t.Errorf("weak listing matched=%v err=%v, want no match", res.Matched, err)
+	}
+	pricey := seedListing(t, db, listing.SaleTypeFixed, 1100, 25)
+	if res, err := p.MatchListing(pricey); err != nil || res.Matched {
+		t.Errorf("over-priced listing matched=%v err=%v, want no match", res.Matched, err)
+	}
+	auction := seedListing(t, db, listing.SaleTypeAuction, 900, 25)
+	if res, err := p.MatchListing(auction); err != nil || res.Matched {
+		t.Errorf("auction matched=%v err=%v, want no match", res.Matched, err)
+	}
+	if len(emitter.all) != holds {
+		t.Errorf("non-matching listings emitted %d sagas", len(emitter.all)-holds)
+	}
+	strong := seedListing(t, db, listing.SaleTypeFixed, 900, 20)
+	if res, err := p.MatchListing(strong); err != nil || !res.Matched {
+		t.Errorf("qualifying listing matched=%v err=%v, want a match", res.Matched, err)
+	}
+}
+
+func TestCompleteFillAndStaleResolution(t *testing.T) {
+	p, _, db := setup(t, 1_000_000)
+	a := createOpen(t, p, createRequest(buyer, maxPrice, buyorder.Constraints{}))
+	l1 := seedListing(t, db, listing.SaleTypeFixed, 900, 0)
+	if res, _ := p.MatchListing(l1); res.OrderId != a.Id() {
+		t.Fatalf("expected order %s to match", a.Id())
+	}
+
+	// An ordinary buyer's settle-move is not a fill.
+	if ok, err := p.CompleteFill(l1, buyer+9); err != nil || ok {
+		t.Errorf("foreign CompleteFill = %v (err %v), want false", ok, err)
+	}
+	if ok, err := p.CompleteFill(l1, buyer); err != nil || !ok {
+		t.Fatalf("CompleteFill = %v (err %v), want true", ok, err)
+	}
+	if m, _ := p.GetById(a.Id().String()); m.State() != buyorder.StateFilled {
+		t.Errorf("state = %s, want filled", m.State())
+	}
+
+	// A fill with no buyer holding reopens; one whose holding landed completes.
+	b := createOpen(t, p, createRequest(buyer+1, maxPrice, buyorder.Constraints{}))
+	l2 := seedListing(t, db, listing.SaleTypeFixed, 900, 0)
+	if res, _ := p.MatchListing(l2); res.OrderId != b.Id() {
+		t.Fatalf("expected order %s to match", b.Id())
+	}
+	if st, err := p.ResolveStaleFill(b.Id().String()); err != nil || st != buyorder.StateOpen {
+		t.Errorf("unsettled stale fill = %s (err %v), want open", st, err)
+	}
+	if res, _ := p.MatchListing(l2); res.OrderId != b.Id() {
+		t.Fatalf("expected reopened order %s to match again", b.Id())
+	}
+	hm, err := holding.NewBuilder(test.TestTenantId, 0, buyer+1).
+		SetId(listing.MoveHoldingId(l2, buyer+1)).
+		SetOrigin(holding.OriginPurchased).
+		SetTemplateId(itemId).
+		SetQuantity(1).
+		Build()
+	if err != nil {
+		t.Fatalf("build holding: %v", err)
+	}
+	if _, err := holding.CreateHolding(db.WithContext(test.CreateTestContext()), hm); err != nil {
+		t.Fatalf("create holding: %v", err)
+	}
+	if st, err := p.ResolveStaleFill(b.Id().String()); err != nil || st != buyorder.StateFilled {
+		t.Errorf("delivered stale fill = %s (err %v), want filled", st, err)
+	}
+}
+
+func TestStaleFillAgeExceedsFillTimeout(t *testing.T) {
+	if buyorder.StaleFillAge() < time.Minute {
+		t.Errorf("stale fill age %s is shorter than a fill saga could legitimately take", buyorder.StaleFillAge())
+	}
+}
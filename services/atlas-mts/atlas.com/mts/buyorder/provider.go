@@ -0,0 +1,192 @@
+package buyorder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// parseId converts a string id into a uuid, returning uuid.Nil on a malformed
+// value so a bad path param degrades to a not-found query rather than panicking.
+func parseId(id string) uuid.UUID {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil
+	}
+	return u
+}
+
+func getById(id string) database.EntityProvider[entity] {
+	return func(db *gorm.DB) model.Provider[entity] {
+		return database.Query[entity](db, &entity{Id: parseId(id)})
+	}
+}
+
+// getByCharacterPaged backs GET /characters/{characterId}/mts/buy-orders. The
+// optional state narrows the page to one lifecycle state; empty returns all.
+// The WHERE is an explicit name-keyed map so a zero characterId still reaches
+// the query (GORM elides zero-valued struct conditions).
+func getByCharacterPaged(characterId uint32, state State, page model.Page) database.EntityProvider[model.Paged[entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[entity]] {
+		where := map[string]interface{}{"character_id": characterId}
+		if state != "" {
+			where["state"] = string(state)
+		}
+		return database.PagedQuery[entity](db.Where(where).Order("created_at DESC"), page)
+	}
+}
+
+// getOpenByWorldPaged backs GET /worlds/{worldId}/mts/buy-orders — the world's
+// open order book. The optional itemId narrows it to one item; zero returns every
+// item. Highest max price first, then oldest, mirroring match priority.
+func getOpenByWorldPaged(worldId world.Id, itemId uint32, page model.Page) database.EntityProvider[model.Paged[entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[entity]] {
+		where := map[string]interface{}{
+			"world_id": byte(worldId),
+			"state":    string(StateOpen),
+		}
+		if itemId != 0 {
+			where["item_id"] = itemId
+		}
+		return database.PagedQuery[entity](db.Where(where).Order("max_price DESC").Order("created_at ASC"), page)
+	}
+}
+
+// countOpenByCharacter counts a character's orders holding, or about to hold,
+// escrow (pending_escrow, open or filling) — the per-character cap's measure.
+func countOpenByCharacter(db *gorm.DB, characterId uint32) (int64, error) {
+	var n int64
+	err := db.Model(&entity{}).
+		Where("character_id = ? AND state IN ?", characterId, []string{string(StatePendingEscrow), string(StateOpen), string(StateFilling)}).
+		Count(&n).Error
+	return n, err
+}
+
+// MatchCriteria is the listing-side view the matcher filters candidate orders
+// against: where and what is listed, by whom, its stack and stat block, and the
+// marked-up price a buyer would pay for it.
+type MatchCriteria struct {
+	WorldId      world.Id
+	ItemId       uint32
+	SellerId     uint32
+	Quantity     uint32
+	Strength     uint16
+	Dexterity    uint16
+	Intelligence uint16
+	Luck         uint16
+	WeaponAttack uint16
+	MagicAttack  uint16
+	Slots        uint16
+	MarkedUp     uint32
+	Now          time.Time
+}
+
+// getMatchCandidates returns up to limit open, unexpired orders for the item in
+// the world whose constraints the listing satisfies and whose escrow covers the
+// marked-up price, excluding the seller's own orders. Ordered highest max price
+// first, then oldest — the fill priority.
+func getMatchCandidates(c MatchCriteria, limit int) database.EntityProvider[[]entity] {
+	return func(db *gorm.DB) model.Provider[[]entity] {
+		var results []entity
+		err := db.Where(map[string]interface{}{
+			"world_id": byte(c.WorldId),
+			"item_id":  c.ItemId,
+			"state":    string(StateOpen),
+		}).
+			Where("character_id <> ?", c.SellerId).
+			Where("expires_at > ?", c.Now).
+			Where("escrow_amount >= ?", c.MarkedUp).
+			Where("min_quantity <= ?", c.Quantity).
+			Where("min_strength <= ? AND min_dexterity <= ? AND min_intelligence <= ? AND min_luck <= ?", c.Strength, c.Dexterity, c.Intelligence, c.Luck).
+			Where("min_weapon_attack <= ? AND min_magic_attack <= ? AND min_slots <= ?", c.WeaponAttack, c.MagicAttack, c.Slots).
+			Order("max_price DESC").
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
+
+// getFillingByListing resolves the order currently filling against a listing,
+// if any. A missing row yields gorm.ErrRecordNotFound.
+func getFillingByListing(listingId uuid.UUID) database.EntityProvider[entity] {
+	return func(db *gorm.DB) model.Provider[entity] {
+		var result entity
+		err := db.Where(map[string]interface{}{
+			"fill_listing_id": listingId,
+			"state":           string(StateFilling),
+		}).First(&result).Error
+		if err != nil {
+			return model.ErrorProvider[entity](err)
+		}
+		return model.FixedProvider(result)
+	}
+}
+
+// getExpiredOpen returns up to limit open orders whose expiry has passed. The
+// sweep calls it under a WithoutTenantFilter handle so it spans every tenant.
+func getExpiredOpen(now time.Time, limit int) database.EntityProvider[[]entity] {
+	return func(db *gorm.DB) model.Provider[[]entity] {
+		var results []entity
+		err := db.Where("state = ? AND expires_at < ?", string(StateOpen), now).
+			Order("expires_at ASC").
+			Limit(limit).
+			Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
+
+// getStaleFilling returns up to limit orders that entered filling before the
+// cutoff — fills whose saga never completed the settle-move.
+func getStaleFilling(cutoff time.Time, limit int) database.EntityProvider[[]entity] {
+	return func(db *gorm.DB) model.Provider[[]entity] {
+		var results []entity
+		err := db.Where("state = ? AND filling_at < ?", string(StateFilling), cutoff).
+			Order("filling_at ASC").
+			Limit(limit).
+			Find(&results).Error
+		if err != nil {
+			return model.ErrorProvider[[]entity](err)
+		}
+		return model.FixedProvider(results)
+	}
+}
+
+func modelFromEntity(e entity) (Model, error) {
+	return NewBuilder(e.TenantId, e.CharacterId, e.ItemId).
+		SetId(e.Id).
+		SetWorldId(world.Id(e.WorldId)).
+		SetAccountId(e.AccountId).
+		SetConstraints(Constraints{
+			MinQuantity:     e.MinQuantity,
+			MinStrength:     e.MinStrength,
+			MinDexterity:    e.MinDexterity,
+			MinIntelligence: e.MinIntelligence,
+			MinLuck:         e.MinLuck,
+			MinWeaponAttack: e.MinWeaponAttack,
+			MinMagicAttack:  e.MinMagicAttack,
+			MinSlots:        e.MinSlots,
+		}).
+		SetMaxPrice(e.MaxPrice).
+		SetEscrowAmount(e.EscrowAmount).
+		SetEscrowTxnId(e.EscrowTxnId).
+		SetState(State(e.State)).
+		SetFillListingId(e.FillListingId).
+		SetFillTxnId(e.FillTxnId).
+		SetFillingAt(e.FillingAt).
+		SetExpiresAt(e.ExpiresAt).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		Build()
+}
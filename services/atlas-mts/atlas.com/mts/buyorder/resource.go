@@ -0,0 +1,191 @@
+package buyorder
+
+import (
+	"atlas-mts/listing"
+	"atlas-mts/rest"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
+)
+
+// InitResource registers the buy-order routes:
+//   - GET    /characters/{characterId}/mts/buy-orders              — list a character's orders (?state=)
+//   - POST   /characters/{characterId}/mts/buy-orders              — place an order (escrow hold)
+//   - DELETE /characters/{characterId}/mts/buy-orders/{buyOrderId} — cancel an open order (escrow release)
+//   - GET    /worlds/{worldId}/mts/buy-orders                      — a world's open order book (?itemId=)
+//
+// Fills are never requested over REST: an order fills automatically when a
+// matching fixed-price listing is accepted.
+func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerGet := rest.RegisterHandler(l)(db)(si)
+			registerInput := rest.RegisterInputHandler[RestModel](l)(db)(si)
+
+			r := router.PathPrefix("/characters/{characterId}/mts/buy-orders").Subrouter()
+			r.HandleFunc("", registerGet("get_character_buy_orders", handleGetCharacterBuyOrders)).Methods(http.MethodGet)
+			r.HandleFunc("", registerInput("create_buy_order", handleCreateBuyOrder)).Methods(http.MethodPost)
+			r.HandleFunc("/{buyOrderId}", registerGet("cancel_buy_order", handleCancelBuyOrder)).Methods(http.MethodDelete)
+
+			wr := router.PathPrefix("/worlds/{worldId}/mts/buy-orders").Subrouter()
+			wr.HandleFunc("", registerGet("get_world_buy_orders", handleGetWorldBuyOrders)).Methods(http.MethodGet)
+		}
+	}
+}
+
+// handleGetCharacterBuyOrders is a game-capped list (a character's open orders
+// are bounded by the tenant cap) — page[size] defaults to and caps at
+// paginate.MaxPageSize.
+func handleGetCharacterBuyOrders(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			page, perr := paginate.ParseParams(r.URL.Query(), paginate.MaxPageSize, paginate.MaxPageSize)
+			if perr != nil {
+				server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+				return
+			}
+
+			paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).ByCharacterPagedProvider(characterId, State(r.URL.Query().Get("state")), page)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Retrieving buy orders for character [%d].", characterId)
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+			writePage(d, c, w, r, paged)
+		}
+	})
+}
+
+// handleGetWorldBuyOrders returns a world's open order book, highest max price
+// first. The optional itemId query narrows it to one item.
+func handleGetWorldBuyOrders(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseWorldId(d.Logger(), func(worldId world.Id) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			page, perr := paginate.ParseParams(r.URL.Query(), paginate.DefaultPageSize, paginate.MaxPageSize)
+			if perr != nil {
+				server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+				return
+			}
+			var itemId uint32
+			if v := r.URL.Query().Get("itemId"); v != "" {
+				parsed, err := strconv.ParseUint(v, 10, 32)
+				if err != nil {
+					server.WriteBadRequest(d.Logger(), w, "invalid itemId")
+					return
+				}
+				itemId = uint32(parsed)
+			}
+
+			paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).OpenByWorldPagedProvider(worldId, itemId, page)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Retrieving buy orders for world [%d].", byte(worldId))
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+			writePage(d, c, w, r, paged)
+		}
+	})
+}
+
+func writePage(d *rest.HandlerDependency, c *rest.HandlerContext, w http.ResponseWriter, r *http.Request, paged model.Paged[Model]) {
+	res, err := model.SliceMap(Transform)(model.FixedProvider(paged.Items))(model.ParallelMap())()
+	if err != nil {
+		d.Logger().WithError(err).Errorf("Creating REST model.")
+		server.WriteErrorResponse(d.Logger())(w)(err)
+		return
+	}
+
+	query := r.URL.Query()
+	queryParams := jsonapi.ParseQueryFields(&query)
+	server.MarshalPaginatedResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res, paginate.EnvelopeFor(paged), r)
+}
+
+// handleCreateBuyOrder places an order. Validation failures (price floor, open
+// cap, insufficient prepaid) are 400s; the order is returned 201 once persisted
+// and its escrow hold emitted.
+func handleCreateBuyOrder(d *rest.HandlerDependency, c *rest.HandlerContext, rm RestModel) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if rm.ItemId == 0 || rm.AccountId == 0 {
+				server.WriteBadRequest(d.Logger(), w, "itemId and accountId are required")
+				return
+			}
+			created, err := NewProcessor(d.Logger(), d.Context(), d.DB()).Create(CreateRequest{
+				WorldId:     world.Id(rm.WorldId),
+				CharacterId: characterId,
+				AccountId:   rm.AccountId,
+				ItemId:      rm.ItemId,
+				Constraints: rm.Constraints(),
+				MaxPrice:    rm.MaxPrice,
+			})
+			if err != nil {
+				if errors.Is(err, ErrBelowPriceFloor) || errors.Is(err, ErrOrderCapReached) || errors.Is(err, listing.ErrInsufficientPrepaid) {
+					server.WriteBadRequest(d.Logger(), w, err.Error())
+					return
+				}
+				d.Logger().WithError(err).Errorf("Creating buy order for character [%d].", characterId)
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			res, err := Transform(created)
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			query := r.URL.Query()
+			queryParams := jsonapi.ParseQueryFields(&query)
+			w.WriteHeader(http.StatusCreated)
+			server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res)
+		}
+	})
+}
+
+// handleCancelBuyOrder cancels an open order and releases its escrow:
+//   - 404 when the order does not exist
+//   - 403 when the path character is not the order's buyer
+//   - 409 when the order is no longer open (filling, filled, cancelled, expired)
+func handleCancelBuyOrder(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return rest.ParseBuyOrderId(d.Logger(), func(buyOrderId string) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				if _, err := uuid.Parse(buyOrderId); err != nil {
+					d.Logger().WithError(err).Errorf("Malformed buyOrderId [%s] in cancel path.", buyOrderId)
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				_, err := NewProcessor(d.Logger(), d.Context(), d.DB()).Cancel(buyOrderId, characterId)
+				if err != nil {
+					switch {
+					case errors.Is(err, gorm.ErrRecordNotFound):
+						w.WriteHeader(http.StatusNotFound)
+					case errors.Is(err, ErrNotOwner):
+						d.Logger().Errorf("Character [%d] attempted to cancel buy order [%s] they do not own; forbidden.", characterId, buyOrderId)
+						w.WriteHeader(http.StatusForbidden)
+					case errors.Is(err, ErrNotOpen):
+						w.WriteHeader(http.StatusConflict)
+					default:
+						d.Logger().WithError(err).Errorf("Cancelling buy order [%s].", buyOrderId)
+						server.WriteErrorResponse(d.Logger())(w)(err)
+					}
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+	})
+}
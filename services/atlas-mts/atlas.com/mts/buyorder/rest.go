@@ -0,0 +1,91 @@
+package buyorder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestModel is the JSON:API representation of a buy order. The resource type is
+// "mts-buy-orders". On create only WorldId, AccountId, ItemId, MaxPrice and the
+// Min* constraints are read from the request attributes (the character comes
+// from the path); the escrow, state and fill fields are server-assigned.
+type RestModel struct {
+	Id              string    `json:"-"`
+	WorldId         byte      `json:"worldId"`
+	CharacterId     uint32    `json:"characterId"`
+	AccountId       uint32    `json:"accountId"`
+	ItemId          uint32    `json:"itemId"`
+	MinQuantity     uint32    `json:"minQuantity"`
+	MinStrength     uint16    `json:"minStrength"`
+	MinDexterity    uint16    `json:"minDexterity"`
+	MinIntelligence uint16    `json:"minIntelligence"`
+	MinLuck         uint16    `json:"minLuck"`
+	MinWeaponAttack uint16    `json:"minWeaponAttack"`
+	MinMagicAttack  uint16    `json:"minMagicAttack"`
+	MinSlots        uint16    `json:"minSlots"`
+	MaxPrice        uint32    `json:"maxPrice"`
+	EscrowAmount    uint32    `json:"escrowAmount"`
+	State           string    `json:"state"`
+	FillListingId   *string   `json:"fillListingId,omitempty"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func (r RestModel) GetName() string {
+	return "mts-buy-orders"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+func (r *RestModel) SetID(idStr string) error {
+	r.Id = idStr
+	return nil
+}
+
+func Transform(m Model) (RestModel, error) {
+	c := m.Constraints()
+	rm := RestModel{
+		Id:              m.Id().String(),
+		WorldId:         byte(m.WorldId()),
+		CharacterId:     m.CharacterId(),
+		AccountId:       m.AccountId(),
+		ItemId:          m.ItemId(),
+		MinQuantity:     c.MinQuantity,
+		MinStrength:     c.MinStrength,
+		MinDexterity:    c.MinDexterity,
+		MinIntelligence: c.MinIntelligence,
+		MinLuck:         c.MinLuck,
+		MinWeaponAttack: c.MinWeaponAttack,
+		MinMagicAttack:  c.MinMagicAttack,
+		MinSlots:        c.MinSlots,
+		MaxPrice:        m.MaxPrice(),
+		EscrowAmount:    m.EscrowAmount(),
+		State:           string(m.State()),
+		ExpiresAt:       m.ExpiresAt(),
+		CreatedAt:       m.CreatedAt(),
+		UpdatedAt:       m.UpdatedAt(),
+	}
+	if m.FillListingId() != uuid.Nil {
+		id := m.FillListingId().String()
+		rm.FillListingId = &id
+	}
+	return rm, nil
+}
+
+// Constraints extracts the match constraints from a create request's attributes.
+func (r RestModel) Constraints() Constraints {
+	return Constraints{
+		MinQuantity:     r.MinQuantity,
+		MinStrength:     r.MinStrength,
+		MinDexterity:    r.MinDexterity,
+		MinIntelligence: r.MinIntelligence,
+		MinLuck:         r.MinLuck,
+		MinWeaponAttack: r.MinWeaponAttack,
+		MinMagicAttack:  r.MinMagicAttack,
+		MinSlots:        r.MinSlots,
+	}
+}
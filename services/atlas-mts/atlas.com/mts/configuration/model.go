@@ -15,6 +15,8 @@ type Model struct {
 	priceFloor        uint32  // minimum NX price (IDA-verified floor)
 	pageSize          int     // results returned per browse page
 	minBidIncrement   uint32  // minimum increment over the current bid
+	maxOpenBuyOrders  int     // per-character cap on concurrently escrowed buy orders
+	buyOrderHours     int     // buy-order term in hours; an unfilled order expires and its escrow is released
 }
 
 func (m Model) ListingFee() uint32 {
@@ -61,6 +63,14 @@ func (m Model) MinBidIncrement() uint32 {
 	return m.minBidIncrement
 }
 
+func (m Model) MaxOpenBuyOrders() int {
+	return m.maxOpenBuyOrders
+}
+
+func (m Model) BuyOrderHours() int {
+	return m.buyOrderHours
+}
+
 // DefaultConfig returns the Model populated with the economic-knob defaults.
 // The registry falls back to these whenever the tenant has not configured the
 // MTS (a fetch miss or error), so the service never hard-fails on a missing
@@ -78,5 +88,7 @@ func DefaultConfig() Model {
 		priceFloor:        110,  // NX, IDA-verified
 		pageSize:          16,   //
 		minBidIncrement:   1,    // chosen default (no IDA reference)
+		maxOpenBuyOrders:  10,   // mirrors maxActiveListings
+		buyOrderHours:     168,  // hours — same 7-day term as a fixed sale
 	}
 }
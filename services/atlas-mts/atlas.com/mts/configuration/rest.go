@@ -17,6 +17,8 @@ type RestModel struct {
 	PriceFloor        uint32  `json:"priceFloor"`
 	PageSize          int     `json:"pageSize"`
 	MinBidIncrement   uint32  `json:"minBidIncrement"`
+	MaxOpenBuyOrders  int     `json:"maxOpenBuyOrders"`
+	BuyOrderHours     int     `json:"buyOrderHours"`
 }
 
 func (r RestModel) GetName() string {
@@ -48,6 +50,8 @@ func Extract(r RestModel) Model {
 		priceFloor:        r.PriceFloor,
 		pageSize:          r.PageSize,
 		minBidIncrement:   r.MinBidIncrement,
+		maxOpenBuyOrders:  r.MaxOpenBuyOrders,
+		buyOrderHours:     r.BuyOrderHours,
 	}
 	if m.listingFee == 0 {
 		m.listingFee = d.listingFee
@@ -82,5 +86,11 @@ func Extract(r RestModel) Model {
 	if m.minBidIncrement == 0 {
 		m.minBidIncrement = d.minBidIncrement
 	}
+	if m.maxOpenBuyOrders == 0 {
+		m.maxOpenBuyOrders = d.maxOpenBuyOrders
+	}
+	if m.buyOrderHours == 0 {
+		m.buyOrderHours = d.buyOrderHours
+	}
 	return m
 }
@@ -1,6 +1,7 @@
 package custody
 
 import (
+	"atlas-mts/buyorder"
 	"atlas-mts/holding"
 	consumer2 "atlas-mts/kafka/consumer"
 	msg "atlas-mts/kafka/message"
@@ -159,6 +160,18 @@ func handleAcceptToMtsListing(pf providerFn) func(db *gorm.DB) message.Handler[c
 				_ = msg.Emit(p)(func(buf *msg.Buffer) error {
 					return buf.Put(custody.EnvStatusEventTopic, custodyproducer.ErrorStatusEventProvider(c.TransactionId, terr.Error()))
 				})
+				return
+			}
+
+			// A newly active fixed-price listing may satisfy a standing buy order.
+			// Best-effort post-commit: the listing is already live, so a match
+			// failure only leaves it to ordinary buyers. A replayed accept re-runs
+			// the match harmlessly — a listing already claimed by a filling order is
+			// skipped, and an already-sold listing is no longer active.
+			if mr, merr := buyorder.NewProcessor(l, ctx, db).MatchListing(b.ListingId); merr != nil {
+				l.WithError(merr).Warnf("Unable to match listing [%s] against buy orders.", b.ListingId.String())
+			} else if mr.Matched {
+				l.Infof("Listing [%s] matched buy order [%s] for character [%d]; fill saga emitted.", b.ListingId.String(), mr.OrderId.String(), mr.BuyerId)
 			}
 		}
 	}
@@ -278,6 +291,12 @@ func handleMtsMoveListingToHolding(pf providerFn) func(db *gorm.DB) message.Hand
 					return err
 				}
 				res = r
+				// A buy-order fill completes with its settle-move: mark the order
+				// filled in the same tx so it commits iff the sale does. An ordinary
+				// buy has no filling order and is a no-op.
+				if _, ferr := buyorder.NewProcessor(l, ctx, tx).CompleteFill(b.ListingId, b.BuyerId); ferr != nil {
+					return ferr
+				}
 				// On success emit BOTH the custody MOVED ack (drives the saga forward) AND
 				// the high-level LISTING_SOLD MTS status event so the channel writes
 				// BuyItemDone to the buyer. The buyer (or auction winner) is b.BuyerId.
@@ -285,7 +304,7 @@ func handleMtsMoveListingToHolding(pf providerFn) func(db *gorm.DB) message.Hand
 					if perr := buf.Put(custody.EnvStatusEventTopic, custodyproducer.MovedStatusEventProvider(c.TransactionId, b.ListingId, r.HoldingId)); perr != nil {
 						return perr
 					}
-					return buf.Put(mtsmsg.EnvStatusEventTopic, mtsproducer.ListingSoldStatusEventProvider(c.TransactionId, b.WorldId, b.ListingId, r.SellerId, b.BuyerId, r.ItemId, r.SoldSaleType, b.ResultKind, b.Price))
+					return buf.Put(mtsmsg.EnvStatusEventTopic, mtsproducer.ListingSoldStatusEventProvider(c.TransactionId, b.WorldId, b.ListingId, r.SellerId, b.BuyerId, r.ItemId, r.SoldSaleType, b.ResultKind, b.Price, r.Quantity))
 				})
 			})
 			if terr != nil {
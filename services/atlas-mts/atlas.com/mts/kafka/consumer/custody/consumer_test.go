@@ -1,6 +1,7 @@
 package custody
 
 import (
+	"atlas-mts/buyorder"
 	"atlas-mts/holding"
 	"atlas-mts/kafka/message/custody"
 	mtsmsg "atlas-mts/kafka/message/mts"
@@ -140,7 +141,7 @@ func newAcceptCommand(transactionId uuid.UUID, listingId uuid.UUID) custody.Comm
 }
 
 func TestAcceptToMtsListing_CreatesListingAndAcks(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -199,7 +200,7 @@ func TestAcceptToMtsListing_CreatesListingAndAcks(t *testing.T) {
 }
 
 func TestAcceptToMtsListing_ReplayIsNoOpAndReacks(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -274,7 +275,7 @@ func newAuctionAcceptCommand(transactionId uuid.UUID, listingId uuid.UUID, listV
 // the client's first bid — always current_bid + increment — lands exactly on the
 // seller's advertised starting price (listValue), not one increment above it.
 func TestAcceptToMtsListing_SeedsAuctionCurrentBidBelowListValueByIncrement(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -301,7 +302,7 @@ func TestAcceptToMtsListing_SeedsAuctionCurrentBidBelowListValueByIncrement(t *t
 // asserts the seed floors at 0 rather than underflowing when listValue does not
 // exceed the increment.
 func TestAcceptToMtsListing_SeedsAuctionCurrentBidZeroWhenListValueBelowIncrement(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -325,7 +326,7 @@ func TestAcceptToMtsListing_SeedsAuctionCurrentBidZeroWhenListValueBelowIncremen
 // zero MinIncrement (older channels / unset) falls back to the default increment
 // of 1 for the currentBid seed.
 func TestAcceptToMtsListing_SeedsAuctionCurrentBidDefaultIncrementWhenZero(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -405,7 +406,7 @@ func newMoveCommand(transactionId uuid.UUID, listingId uuid.UUID, buyerId uint32
 }
 
 func TestMtsMoveListingToHolding_MarksSoldCreatesHoldingAndAcks(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, transaction.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, transaction.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -511,7 +512,7 @@ func TestMtsMoveListingToHolding_MarksSoldCreatesHoldingAndAcks(t *testing.T) {
 // listing row's list value / current bid. A buy-now of an auction previously
 // recorded the last BID here (task-102 live finding).
 func TestMtsMoveListingToHolding_UsesSettlePriceNotListingRow(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, transaction.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, transaction.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -561,7 +562,7 @@ func TestMtsMoveListingToHolding_UsesSettlePriceNotListingRow(t *testing.T) {
 }
 
 func TestMtsMoveListingToHolding_ReplayCreatesNoSecondHoldingAndReacks(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -611,7 +612,7 @@ func TestMtsMoveListingToHolding_ReplayCreatesNoSecondHoldingAndReacks(t *testin
 }
 
 func TestReleaseFromMtsHolding_SoftDeletesAndIsIdempotent(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -675,7 +676,7 @@ func TestReleaseFromMtsHolding_SoftDeletesAndIsIdempotent(t *testing.T) {
 // and is idempotent: a replayed restore on an already-live row re-acks RESTORED
 // without error. This is the dupe-safety inverse of ReleaseFromMtsHolding.
 func TestRestoreMtsHolding_UndoesReleaseAndIsIdempotent(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -732,7 +733,7 @@ func TestRestoreMtsHolding_UndoesReleaseAndIsIdempotent(t *testing.T) {
 // RestoreListingFromHolding returns the listing to active and soft-deletes the
 // buyer holding, so a late buy delivers no free item.
 func TestRestoreListingFromHolding_ReversesMove(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, transaction.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, transaction.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -770,7 +771,7 @@ func TestRestoreListingFromHolding_ReversesMove(t *testing.T) {
 // TestRemoveMtsListing_DeletesActiveOnly pins the late-comp inverse of a spurious
 // accept: an active listing is removed; a sold one is left untouched.
 func TestRemoveMtsListing_DeletesActiveOnly(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, transaction.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, transaction.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
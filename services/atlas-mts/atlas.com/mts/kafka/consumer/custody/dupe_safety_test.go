@@ -16,6 +16,7 @@ package custody
 // arm half of scenario 4 lives in listing/dupe_safety_test.go.)
 
 import (
+	"atlas-mts/buyorder"
 	"atlas-mts/holding"
 	"atlas-mts/kafka/message/custody"
 	"atlas-mts/listing"
@@ -78,7 +79,7 @@ func countListingRows(t *testing.T, db *gorm.DB, ctx context.Context, listingId
 // AcceptToMtsListing custody-create command twice yields EXACTLY ONE listing row
 // (the item is custodied in one place, not duplicated by a redelivery).
 func TestDupeSafety_DoubleGrantReplay_AcceptListing(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -102,7 +103,7 @@ func TestDupeSafety_DoubleGrantReplay_AcceptListing(t *testing.T) {
 // holding (the deterministic moveHoldingId idempotency guard prevents a second
 // copy), and the listing stays sold.
 func TestDupeSafety_DoubleGrantReplay_MoveToHolding(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -148,7 +149,7 @@ func TestDupeSafety_DoubleGrantReplay_MoveToHolding(t *testing.T) {
 // on the now-non-active listing is the loser: it creates NO buyer holding. The
 // item exists in EXACTLY ONE holding (the seller's).
 func TestDupeSafety_CancelRacingPurchase_CancelWins(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -205,7 +206,7 @@ func TestDupeSafety_CancelRacingPurchase_CancelWins(t *testing.T) {
 // Cancel on the now-non-active listing is the loser: it creates NO seller holding.
 // The item exists in EXACTLY ONE holding (the buyer's).
 func TestDupeSafety_CancelRacingPurchase_SettleWins(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -260,7 +261,7 @@ func TestDupeSafety_CancelRacingPurchase_SettleWins(t *testing.T) {
 // the first delivery, and the second delivery affects ZERO rows — so the
 // downstream AcceptToCharacter grant fires exactly once (no item duplicated home).
 func TestDupeSafety_TakeHomeReplay(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	ctx := test.CreateTestContext()
 	l := logrus.New()
 
@@ -328,7 +329,7 @@ func TestDupeSafety_TakeHomeReplay(t *testing.T) {
 // replay AND a subsequent genuine create gets the very next serial — not one
 // skipped past a serial burned by the replay.
 func TestDupeSafety_AcceptReplayDoesNotConsumeSerial(t *testing.T) {
-	db := test.SetupTestDB(t, listing.Migration, holding.Migration, outbox.Migration)
+	db := test.SetupTestDB(t, listing.Migration, holding.Migration, buyorder.Migration, outbox.Migration)
 	l := logrus.New()
 	rp := &recordingProducer{}
 
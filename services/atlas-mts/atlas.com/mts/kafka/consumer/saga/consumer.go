@@ -0,0 +1,86 @@
+// Package saga consumes EVENT_TOPIC_SAGA_STATUS, the orchestrator's terminal
+// saga outcomes, and settles the buy orders waiting on their escrow hold.
+//
+// The topic carries every saga in the deployment, so both handlers discriminate
+// on StatusEvent.Type before touching Body. The filter is then the order row
+// itself: a status whose transaction id names no pending_escrow order is
+// another service's saga, one of atlas-mts' own release/fill sagas, or a
+// redelivery, and the processor reports it unclaimed.
+package saga
+
+import (
+	"atlas-mts/buyorder"
+	consumer2 "atlas-mts/kafka/consumer"
+	sagamsg "atlas-mts/kafka/message/saga"
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("mts_saga_status")(sagamsg.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(sagamsg.EnvStatusEventTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleSagaCompleted(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleSagaFailed(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// handleSagaCompleted opens the buy order whose escrow hold just landed, making
+// it eligible to match.
+func handleSagaCompleted(db *gorm.DB) message.Handler[sagamsg.StatusEvent[sagamsg.StatusEventCompletedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e sagamsg.StatusEvent[sagamsg.StatusEventCompletedBody]) {
+		if e.Type != sagamsg.StatusEventTypeCompleted {
+			return
+		}
+		claimed, err := buyorder.NewProcessor(l, ctx, db).EscrowHeld(e.TransactionId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to open the buy order held by transaction [%s].", e.TransactionId.String())
+			return
+		}
+		if claimed {
+			l.Debugf("Buy order escrow held by transaction [%s]; order is open.", e.TransactionId.String())
+		}
+	}
+}
+
+// handleSagaFailed cancels the buy order whose escrow hold failed (for
+// example, the buyer's prepaid no longer covers it). The order never opened,
+// so it was never matchable and has nothing to release.
+func handleSagaFailed(db *gorm.DB) message.Handler[sagamsg.StatusEvent[sagamsg.StatusEventFailedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e sagamsg.StatusEvent[sagamsg.StatusEventFailedBody]) {
+		if e.Type != sagamsg.StatusEventTypeFailed {
+			return
+		}
+		claimed, err := buyorder.NewProcessor(l, ctx, db).EscrowFailed(e.TransactionId)
+		if err != nil {
+			l.WithError(err).Errorf("Unable to cancel the buy order of failed escrow hold [%s].", e.TransactionId.String())
+			return
+		}
+		if claimed {
+			l.Infof("Cancelled buy order of failed escrow hold [%s]: %s.", e.TransactionId.String(), e.Body.Reason)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package saga
+
+import (
+	"atlas-mts/buyorder"
+	sagamsg "atlas-mts/kafka/message/saga"
+	"atlas-mts/saga"
+	"atlas-mts/test"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type discardEmitter struct{}
+
+func (discardEmitter) Create(saga.Saga) error { return nil }
+
+type fundedBalance struct{}
+
+func (fundedBalance) PrepaidBalance(uint32) (uint32, error) { return 1_000_000, nil }
+
+func pendingOrder(t *testing.T, db *gorm.DB) buyorder.Model {
+	t.Helper()
+	p := buyorder.NewProcessor(logrus.New(), test.CreateTestContext(), db,
+		buyorder.WithSagaEmitter(discardEmitter{}),
+		buyorder.WithBalanceReader(fundedBalance{}),
+	)
+	m, err := p.Create(buyorder.CreateRequest{CharacterId: 200, AccountId: 2000, ItemId: 1302000, MaxPrice: 1000})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	return m
+}
+
+func stateOf(t *testing.T, db *gorm.DB, id uuid.UUID) buyorder.State {
+	t.Helper()
+	m, err := buyorder.GetById(id.String())(db)()
+	if err != nil {
+		t.Fatalf("GetById(%s): %v", id, err)
+	}
+	return m.State()
+}
+
+func TestSagaCompletedOpensPendingOrder(t *testing.T) {
+	db := test.SetupTestDB(t, buyorder.Migration).WithContext(test.CreateTestContext())
+	defer test.CleanupTestDB(t, db)
+	m := pendingOrder(t, db)
+
+	// Another saga's status, and a FAILED shape on the completed handler, are ignored.
+	handleSagaCompleted(db)(logrus.New(), test.CreateTestContext(), sagamsg.StatusEvent[sagamsg.StatusEventCompletedBody]{TransactionId: uuid.New(), Type: sagamsg.StatusEventTypeCompleted})
+	handleSagaCompleted(db)(logrus.New(), test.CreateTestContext(), sagamsg.StatusEvent[sagamsg.StatusEventCompletedBody]{TransactionId: m.EscrowTxnId(), Type: sagamsg.StatusEventTypeFailed})
+	if got := stateOf(t, db, m.Id()); got != buyorder.StatePendingEscrow {
+		t.Fatalf("state = %s, want pending_escrow", got)
+	}
+
+	handleSagaCompleted(db)(logrus.New(), test.CreateTestContext(), sagamsg.StatusEvent[sagamsg.StatusEventCompletedBody]{TransactionId: m.EscrowTxnId(), Type: sagamsg.StatusEventTypeCompleted})
+	if got := stateOf(t, db, m.Id()); got != buyorder.StateOpen {
+		t.Errorf("state = %s, want open", got)
+	}
+}
+
+func TestSagaFailedCancelsPendingOrder(t *testing.T) {
+	db := test.SetupTestDB(t, buyorder.Migration).WithContext(test.CreateTestContext())
+	defer test.CleanupTestDB(t, db)
+	m := pendingOrder(t, db)
+
+	handleSagaFailed(db)(logrus.New(), test.CreateTestContext(), sagamsg.StatusEvent[sagamsg.StatusEventFailedBody]{
+		TransactionId: m.EscrowTxnId(),
+		Type:          sagamsg.StatusEventTypeFailed,
+		Body:          sagamsg.StatusEventFailedBody{Reason: "insufficient prepaid", FailedStep: "mts_buy_order_escrow_hold"},
+	})
+	if got := stateOf(t, db, m.Id()); got != buyorder.StateCancelled {
+		t.Errorf("state = %s, want cancelled", got)
+	}
+}
@@ -163,14 +163,16 @@ const (
 
 // ResultKind* discriminate which client result mode a buy/settle routes to on its
 // LISTING_SOLD / BUY_FAILED event. The channel sets item/zzim/wish from the ITC
-// buy arm; the auction ticker's settle sets auction_settle. They round-trip the
-// settle chain so the channel picks the matching CITC::OnNormalItemResult arm.
+// buy arm; the auction ticker's settle sets auction_settle; a standing buy
+// order's automatic fill sets buy_order. They round-trip the settle chain so the
+// channel picks the matching CITC::OnNormalItemResult arm.
 // Must match the channel's ResultKind* byte-for-byte.
 const (
 	ResultKindItem          = "item"
 	ResultKindZzim          = "zzim"
 	ResultKindWish          = "wish"
 	ResultKindAuctionSettle = "auction_settle"
+	ResultKindBuyOrder      = "buy_order"
 )
 
 // CreateListingCommandBody initiates a listing (the channel ITC register-sale /
@@ -335,6 +337,9 @@ type StatusEventListingSoldBody struct {
 	// carried for the auction-settle SuccessBidInfo arm.
 	ResultKind string `json:"resultKind"`
 	Price      uint32 `json:"price"`
+	// Quantity is the sold stack size, so consumers (the merchant price index)
+	// can derive a unit price from Price.
+	Quantity uint32 `json:"quantity"`
 }
 
 // StatusEventListingExpiredBody reports an expired listing.
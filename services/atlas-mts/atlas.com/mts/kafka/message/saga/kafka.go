@@ -1,5 +1,37 @@
+// Package saga carries the COMMAND_TOPIC_SAGA / EVENT_TOPIC_SAGA_STATUS topic
+// tokens and the status envelope atlas-mts reads. The envelope mirrors
+// services/atlas-saga-orchestrator/atlas.com/saga-orchestrator/kafka/message/saga/kafka.go;
+// struct names, field names and json tags must match that file. Only the
+// fields this service reads are carried over.
 package saga
 
+import (
+	"github.com/google/uuid"
+)
+
 const (
 	EnvCommandTopic = "COMMAND_TOPIC_SAGA"
 )
+
+const (
+	EnvStatusEventTopic      = "EVENT_TOPIC_SAGA_STATUS"
+	StatusEventTypeCompleted = "COMPLETED"
+	StatusEventTypeFailed    = "FAILED"
+)
+
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type StatusEventCompletedBody struct {
+	SagaType string `json:"sagaType,omitempty"`
+}
+
+type StatusEventFailedBody struct {
+	Reason     string `json:"reason"`
+	FailedStep string `json:"failedStep"`
+	SagaType   string `json:"sagaType"`
+	ErrorCode  string `json:"errorCode"`
+}
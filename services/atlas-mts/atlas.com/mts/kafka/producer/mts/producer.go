@@ -80,7 +80,7 @@ func OutbidStatusEventProvider(transactionId uuid.UUID, worldId byte, listingId
 }
 
 // ListingSoldStatusEventProvider builds a LISTING_SOLD event.
-func ListingSoldStatusEventProvider(transactionId uuid.UUID, worldId byte, listingId uuid.UUID, sellerId uint32, buyerId uint32, itemId uint32, saleType string, resultKind string, price uint32) model.Provider[[]kafka.Message] {
+func ListingSoldStatusEventProvider(transactionId uuid.UUID, worldId byte, listingId uuid.UUID, sellerId uint32, buyerId uint32, itemId uint32, saleType string, resultKind string, price uint32, quantity uint32) model.Provider[[]kafka.Message] {
 	value := &mts.StatusEvent[mts.StatusEventListingSoldBody]{
 		TransactionId: transactionId,
 		Type:          mts.StatusEventTypeListingSold,
@@ -93,6 +93,7 @@ func ListingSoldStatusEventProvider(transactionId uuid.UUID, worldId byte, listi
 			SaleType:   saleType,
 			ResultKind: resultKind,
 			Price:      price,
+			Quantity:   quantity,
 		},
 	}
 	return producer.SingleMessageProvider(keyFor(transactionId), value)
@@ -213,6 +213,7 @@ type SettleMoveRequest struct {
 type SettleMoveResult struct {
 	HoldingId           uuid.UUID
 	ItemId              uint32
+	Quantity            uint32
 	SellerId            uint32
 	SoldSaleType        string
 	SoldOfferWishSerial uint32
@@ -234,12 +235,14 @@ func (p *ProcessorImpl) SettleMove(req SettleMoveRequest) (SettleMoveResult, err
 	tdb := p.db.WithContext(ctx)
 	hid := MoveHoldingId(b.ListingId, b.BuyerId)
 
-	// itemId + sellerId are captured from the listing row inside the tx so the
-	// LISTING_SOLD notice emitted on success can carry the sold item id and
+	// itemId + quantity + sellerId are captured from the listing row inside the
+	// tx so the LISTING_SOLD notice emitted on success can carry the sold item,
+	// its quantity (the merchant price index derives a unit price from it) and
 	// the seller (so the channel can refresh the seller's panels/wallet).
 	// soldSaleType + soldOfferWishSerial drive the offer-purchase side-effects
 	// (want-ad consume + sibling-offer release) run after the tx commits.
 	var itemId uint32
+	var quantity uint32
 	var sellerId uint32
 	var soldSaleType string
 	var soldOfferWishSerial uint32
@@ -250,6 +253,7 @@ func (p *ProcessorImpl) SettleMove(req SettleMoveRequest) (SettleMoveResult, err
 			return gerr
 		}
 		itemId = lm.TemplateId()
+		quantity = lm.Quantity()
 		sellerId = lm.SellerId()
 		soldSaleType = string(lm.SaleType())
 		soldOfferWishSerial = lm.OfferWishSerial()
@@ -405,6 +409,7 @@ func (p *ProcessorImpl) SettleMove(req SettleMoveRequest) (SettleMoveResult, err
 	return SettleMoveResult{
 		HoldingId:           hid,
 		ItemId:              itemId,
+		Quantity:            quantity,
 		SellerId:            sellerId,
 		SoldSaleType:        soldSaleType,
 		SoldOfferWishSerial: soldOfferWishSerial,
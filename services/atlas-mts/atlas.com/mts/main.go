@@ -2,10 +2,12 @@ package main
 
 import (
 	"atlas-mts/bid"
+	"atlas-mts/buyorder"
 	"atlas-mts/holding"
 	characterConsumer "atlas-mts/kafka/consumer/character"
 	custodyConsumer "atlas-mts/kafka/consumer/custody"
 	mtsConsumer "atlas-mts/kafka/consumer/mts"
+	sagaConsumer "atlas-mts/kafka/consumer/saga"
 	"atlas-mts/listing"
 	"atlas-mts/task"
 	"atlas-mts/testsupport"
@@ -60,6 +62,7 @@ func main() {
 		holding.Migration,
 		bid.Migration,
 		wish.Migration,
+		buyorder.Migration,
 		transaction.Migration,
 		outboxlib.Migration,
 	))
@@ -88,6 +91,7 @@ func main() {
 	custodyConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	mtsConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	characterConsumer.InitConsumers(l)(cmf)(consumerGroupId)
+	sagaConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	if err := custodyConsumer.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
@@ -97,6 +101,9 @@ func main() {
 	if err := characterConsumer.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := sagaConsumer.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -114,6 +121,7 @@ func main() {
 		AddRouteInitializer(listing.InitResource(GetServer())(db)).
 		AddRouteInitializer(holding.InitResource(GetServer())(db)).
 		AddRouteInitializer(wish.InitResource(GetServer())(db)).
+		AddRouteInitializer(buyorder.InitResource(GetServer())(db)).
 		AddRouteInitializer(transaction.InitResource(GetServer())(db)).
 		AddRouteInitializer(wallet.InitResource(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
@@ -178,3 +178,16 @@ func ParseHoldingId(l logrus.FieldLogger, next func(holdingId string) http.Handl
 		next(holdingId)(w, r)
 	}
 }
+
+// ParseBuyOrderId parses the {buyOrderId} path var (a UUID string).
+func ParseBuyOrderId(l logrus.FieldLogger, next func(buyOrderId string) http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		buyOrderId, ok := mux.Vars(r)["buyOrderId"]
+		if !ok || buyOrderId == "" {
+			l.Errorf("Unable to properly parse buyOrderId from path.")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		next(buyOrderId)(w, r)
+	}
+}
@@ -2,6 +2,7 @@ package task
 
 import (
 	"atlas-mts/bid"
+	"atlas-mts/buyorder"
 	"atlas-mts/listing"
 	"atlas-mts/wish"
 	"context"
@@ -132,6 +133,7 @@ func Sweep(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, opts ...listi
 	}
 	if total == 0 {
 		l.Debugln("MTS expiration sweep: no expired auction listings.")
+		sweepBuyOrders(l, ctx, db, now)
 		return 0, nil
 	}
 
@@ -237,9 +239,90 @@ func Sweep(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, opts ...listi
 		l.Infof("MTS expiration sweep: deleted [%d] expired want-ad(s).", deleted)
 	}
 
+	// Buy orders: release the escrow of open orders past their term and resolve
+	// fills stuck in filling. Best-effort tail like the want-ad delete above.
+	sweepBuyOrders(l, ctx, db, now)
+
 	return swept, nil
 }
 
+// sweepBuyOrders expires open buy orders past their term (open->expired plus an
+// escrow release saga) and resolves orders stuck in filling longer than
+// buyorder.StaleFillAge (filled if the fill's buyer holding exists, else reopened
+// — the orchestrator has compensated the fill and re-held the escrow). Discovery
+// runs cross-tenant like the listing sweep; each order is then processed under
+// its own tenant's owned environment so the release saga carries the right
+// tenant headers. Failures are logged and retried next tick.
+func sweepBuyOrders(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, now time.Time) {
+	sdb := db.WithContext(database.WithoutTenantFilter(ctx))
+
+	expired, err := buyorder.GetExpiredOpen(now, sweepBatchLimit)(sdb)()
+	if err != nil {
+		l.WithError(err).Warnf("MTS expiration sweep: failed to load expired buy orders; will retry next tick.")
+		expired = nil
+	}
+	stale, err := buyorder.GetStaleFilling(now.Add(-buyorder.StaleFillAge()), sweepBatchLimit)(sdb)()
+	if err != nil {
+		l.WithError(err).Warnf("MTS expiration sweep: failed to load stale buy-order fills; will retry next tick.")
+		stale = nil
+	}
+	if len(expired) == 0 && len(stale) == 0 {
+		return
+	}
+
+	listTenants := func(_ context.Context) ([]tenant.Model, error) {
+		seen := make(map[uuid.UUID]bool)
+		var ts []tenant.Model
+		for _, o := range append(append([]buyorder.Model{}, expired...), stale...) {
+			if seen[o.TenantId()] {
+				continue
+			}
+			tm, terr := tenant.Create(o.TenantId(), "", 0, 0)
+			if terr != nil {
+				l.WithError(terr).Warnf("MTS expiration sweep: failed to reconstruct tenant [%s]; its buy orders will be skipped this tick.", o.TenantId())
+				continue
+			}
+			seen[o.TenantId()] = true
+			ts = append(ts, tm)
+		}
+		return ts, nil
+	}
+
+	var released, resolved int
+	service.ForEachOwnedEnvironment(l, ctx, serviceName, listTenants, func(envCtx context.Context) {
+		tm := tenant.MustFromContext(envCtx)
+		p := buyorder.NewProcessor(l, database.WithoutTenantFilter(envCtx), db)
+		for _, o := range expired {
+			if o.TenantId() != tm.Id() {
+				continue
+			}
+			won, eerr := p.Expire(o.Id().String())
+			if eerr != nil {
+				l.WithError(eerr).Warnf("MTS expiration sweep: failed to expire buy order [%s] (tenant [%s]); will retry next tick.", o.Id(), o.TenantId())
+				continue
+			}
+			if won {
+				released++
+			}
+		}
+		for _, o := range stale {
+			if o.TenantId() != tm.Id() {
+				continue
+			}
+			st, rerr := p.ResolveStaleFill(o.Id().String())
+			if rerr != nil {
+				l.WithError(rerr).Warnf("MTS expiration sweep: failed to resolve stale fill of buy order [%s] (tenant [%s]); will retry next tick.", o.Id(), o.TenantId())
+				continue
+			}
+			resolved++
+			l.Debugf("MTS expiration sweep: stale fill of buy order [%s] resolved to [%s].", o.Id(), st)
+		}
+	})
+	if released > 0 || resolved > 0 {
+		l.Infof("MTS expiration sweep: expired [%d] buy order(s), resolved [%d] stale fill(s).", released, resolved)
+	}
+}
+
 // winnerAccountFor resolves the auction winner's cash-shop account id from THEIR
 // winning held bid row (the bid carries the bidder account captured at bid time).
 // It returns 0 if no held bid is found for the winner, in which case SettleAuction
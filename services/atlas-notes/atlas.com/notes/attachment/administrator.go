@@ -0,0 +1,80 @@
+package attachment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// ErrNotEscrowed is returned by release when the row is already released or
+// does not exist — the losing side of a claim racing a claim or a return.
+var ErrNotEscrowed = errors.New("note attachment is not in escrow")
+
+func create(db *gorm.DB, t tenant.Model, m Model) error {
+	e := MakeEntity(t, m)
+	return db.Create(&e).Error
+}
+
+// release soft-deletes an escrowed row. The `deleted_at IS NULL` predicate GORM
+// adds makes it a compare-and-set: of two releases for one row exactly one
+// affects it, and the other is told so rather than acked.
+func release(db *gorm.DB, id uuid.UUID) error {
+	res := db.Where("id = ?", id).Delete(&Entity{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotEscrowed
+	}
+	return nil
+}
+
+// restore returns a released row to escrow and clears its return latch, so a
+// failed claim or return leaves the attachment exactly as claimable as before.
+func restore(db *gorm.DB, id uuid.UUID) error {
+	return db.Unscoped().Model(&Entity{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "return_claimed_at": nil}).Error
+}
+
+// remove hard-deletes a row. A missing row is success: the inverse may be
+// redelivered after it already ran.
+func remove(db *gorm.DB, id uuid.UUID) error {
+	return db.Unscoped().Where("id = ?", id).Delete(&Entity{}).Error
+}
+
+// bind attaches every row escrowed by the send saga to the note that saga just
+// created, and starts the expiry clock.
+func bind(db *gorm.DB, transactionId uuid.UUID, noteId uint32, expiresAt time.Time) (int64, error) {
+	res := db.Model(&Entity{}).
+		Where("transaction_id = ? AND note_id = ?", transactionId, 0).
+		Updates(map[string]interface{}{"note_id": noteId, "expires_at": expiresAt})
+	return res.RowsAffected, res.Error
+}
+
+// claimForReturn stamps the expiry latch if it is unset or older than
+// staleBefore. It reports whether this caller won the latch.
+func claimForReturn(db *gorm.DB, id uuid.UUID, now time.Time, staleBefore time.Time) (bool, error) {
+	res := db.Model(&Entity{}).
+		Where("id = ? AND (return_claimed_at IS NULL OR return_claimed_at < ?)", id, staleBefore).
+		Update("return_claimed_at", now)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// GetExpired returns up to limit bound attachments past their expiry that no
+// live return claims. The expiry sweep runs it under
+// database.WithoutTenantFilter; each model carries its own tenant.
+func GetExpired(now time.Time, limit int) database.EntityProvider[[]Model] {
+	return func(db *gorm.DB) model.Provider[[]Model] {
+		return model.SliceMap(Make)(getExpiredProvider(now, now.Add(-returnRetryAfter), limit)(db))()
+	}
+}
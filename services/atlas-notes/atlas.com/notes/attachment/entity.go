@@ -0,0 +1,234 @@
+// Package attachment is atlas-notes' custody store for the items and mesos
+// carried by notes — the note limb of the accept/release custody family
+// (atlas-storage, atlas-mts, atlas-trades).
+//
+// An attached item genuinely leaves its sender's compartment when the note is
+// sent, so something durable has to name it until the recipient claims it or
+// it is returned. That is this package. Its row shape follows the trade escrow
+// table: a surrogate UUID key, the tenant's region and version alongside its id
+// so the cross-tenant expiry sweep can rebuild the tenant, and the item
+// snapshot as explicit name-keyed columns rather than a JSON blob.
+package attachment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const table = "note_attachments"
+
+// Entity is one attachment held in note custody.
+//
+// NoteId is zero between the accept_to_note that escrows the attachment and the
+// create_note that ends the send saga; Bind sets it, keyed by TransactionId. A
+// row whose note never arrives is removed by the send saga's reverse walk.
+//
+// DeletedAt is a GORM soft-delete column. A release (claim or return)
+// soft-deletes so the compensating restore can bring the row back; a spurious
+// accept is HARD deleted (see remove) because a restorable row could resurrect
+// an item its sender already holds again.
+//
+// Minted marks a GM reward attachment that no character ever held. It has no
+// sender to return to, so on expiry it simply lapses.
+type Entity struct {
+	Id           uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	TenantId     uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;index:idx_note_attachments_note,priority:1;index:idx_note_attachments_transaction,priority:1"`
+	TenantRegion string    `gorm:"column:tenant_region;type:varchar(32);not null;default:''"`
+	TenantMajor  uint16    `gorm:"column:tenant_major;not null;default:0"`
+	TenantMinor  uint16    `gorm:"column:tenant_minor;not null;default:0"`
+
+	TransactionId uuid.UUID `gorm:"column:transaction_id;type:uuid;not null;index:idx_note_attachments_transaction,priority:2"`
+	NoteId        uint32    `gorm:"column:note_id;not null;default:0;index:idx_note_attachments_note,priority:2"`
+	SenderId      uint32    `gorm:"column:sender_id;not null"`
+	RecipientId   uint32    `gorm:"column:recipient_id;not null"`
+	WorldId       byte      `gorm:"column:world_id;not null"`
+	Minted        bool      `gorm:"column:minted;not null;default:false"`
+
+	// Provenance. A return does NOT replay these — it accepts to the sender's
+	// compartment and lets atlas-inventory pick the slot.
+	SourceInventoryType byte   `gorm:"column:source_inventory_type;not null;default:0"`
+	AssetId             uint32 `gorm:"column:asset_id;not null;default:0"`
+
+	Mesos uint32 `gorm:"column:mesos;not null;default:0"`
+
+	// Minted NX, credited to AccountId's wallet on claim.
+	AccountId    uint32 `gorm:"column:account_id;not null;default:0"`
+	Currency     uint32 `gorm:"column:currency;not null;default:0"`
+	CurrencyType uint32 `gorm:"column:currency_type;not null;default:0"`
+
+	TemplateId   uint32    `gorm:"column:template_id;not null;default:0"`
+	Quantity     uint32    `gorm:"column:quantity;not null;default:0"`
+	Expiration   time.Time `gorm:"column:expiration"`
+	CashId       int64     `gorm:"column:cash_id;not null;default:0"`
+	Rechargeable uint64    `gorm:"column:rechargeable;not null;default:0"`
+	Flag         uint16    `gorm:"column:flag;not null;default:0"`
+	Owner        string    `gorm:"column:owner;not null;default:''"`
+
+	Strength      uint16 `gorm:"column:strength;not null;default:0"`
+	Dexterity     uint16 `gorm:"column:dexterity;not null;default:0"`
+	Intelligence  uint16 `gorm:"column:intelligence;not null;default:0"`
+	Luck          uint16 `gorm:"column:luck;not null;default:0"`
+	HP            uint16 `gorm:"column:hp;not null;default:0"`
+	MP            uint16 `gorm:"column:mp;not null;default:0"`
+	WeaponAttack  uint16 `gorm:"column:weapon_attack;not null;default:0"`
+	MagicAttack   uint16 `gorm:"column:magic_attack;not null;default:0"`
+	WeaponDefense uint16 `gorm:"column:weapon_defense;not null;default:0"`
+	MagicDefense  uint16 `gorm:"column:magic_defense;not null;default:0"`
+	Accuracy      uint16 `gorm:"column:accuracy;not null;default:0"`
+	Avoidability  uint16 `gorm:"column:avoidability;not null;default:0"`
+	Hands         uint16 `gorm:"column:hands;not null;default:0"`
+	Speed         uint16 `gorm:"column:speed;not null;default:0"`
+	Jump          uint16 `gorm:"column:jump;not null;default:0"`
+	Slots         uint16 `gorm:"column:slots;not null;default:0"`
+
+	LevelType      byte   `gorm:"column:level_type;not null;default:0"`
+	Level          byte   `gorm:"column:level;not null;default:0"`
+	Experience     uint32 `gorm:"column:experience;not null;default:0"`
+	HammersApplied uint32 `gorm:"column:hammers_applied;not null;default:0"`
+
+	PetId     uint32 `gorm:"column:pet_id;not null;default:0"`
+	PetName   string `gorm:"column:pet_name;not null;default:''"`
+	PetLevel  byte   `gorm:"column:pet_level;not null;default:0"`
+	Closeness uint16 `gorm:"column:closeness;not null;default:0"`
+	Fullness  byte   `gorm:"column:fullness;not null;default:0"`
+
+	// ExpiresAt is set when the row is bound to its note; nil while unbound.
+	ExpiresAt *time.Time `gorm:"column:expires_at;index"`
+
+	// ReturnClaimedAt is the expiry sweep's single-claimant latch. The sweep
+	// stamps it in the same UPDATE that decides whether this tick may submit the
+	// return saga, so two replicas (or two ticks) cannot both submit one. A latch
+	// older than returnRetryAfter is re-claimable: a return saga that failed
+	// restores the row, and the next claim must be able to try again.
+	ReturnClaimedAt *time.Time `gorm:"column:return_claimed_at"`
+
+	CreatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (Entity) TableName() string {
+	return table
+}
+
+// Migration sets up the note_attachments table in the database
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&Entity{})
+}
+
+// Make converts an Entity to a Model domain model
+func Make(e Entity) (Model, error) {
+	t, err := tenant.Create(e.TenantId, e.TenantRegion, e.TenantMajor, e.TenantMinor)
+	if err != nil {
+		return Model{}, err
+	}
+	m := NewBuilder(e.Id, e.SenderId, e.RecipientId).
+		SetTransactionId(e.TransactionId).
+		SetNoteId(e.NoteId).
+		SetWorldId(world.Id(e.WorldId)).
+		SetMinted(e.Minted).
+		SetSource(e.SourceInventoryType, e.AssetId).
+		SetMesos(e.Mesos).
+		SetCurrency(e.AccountId, e.Currency, e.CurrencyType).
+		SetSnapshot(sharedsaga.AssetSnapshot{
+			TemplateId:     e.TemplateId,
+			Quantity:       e.Quantity,
+			Expiration:     e.Expiration,
+			CashId:         e.CashId,
+			Rechargeable:   e.Rechargeable,
+			Flag:           e.Flag,
+			Owner:          e.Owner,
+			Strength:       e.Strength,
+			Dexterity:      e.Dexterity,
+			Intelligence:   e.Intelligence,
+			Luck:           e.Luck,
+			Hp:             e.HP,
+			Mp:             e.MP,
+			WeaponAttack:   e.WeaponAttack,
+			MagicAttack:    e.MagicAttack,
+			WeaponDefense:  e.WeaponDefense,
+			MagicDefense:   e.MagicDefense,
+			Accuracy:       e.Accuracy,
+			Avoidability:   e.Avoidability,
+			Hands:          e.Hands,
+			Speed:          e.Speed,
+			Jump:           e.Jump,
+			Slots:          e.Slots,
+			LevelType:      e.LevelType,
+			Level:          e.Level,
+			Experience:     e.Experience,
+			HammersApplied: e.HammersApplied,
+			PetId:          e.PetId,
+			PetName:        e.PetName,
+			PetLevel:       e.PetLevel,
+			Closeness:      e.Closeness,
+			Fullness:       e.Fullness,
+		}).
+		SetExpiresAt(e.ExpiresAt).
+		Build()
+	m.tenant = t
+	m.released = e.DeletedAt.Valid
+	return m, nil
+}
+
+// MakeEntity converts a Model domain model to an Entity owned by the tenant
+func MakeEntity(t tenant.Model, m Model) Entity {
+	s := m.Snapshot()
+	return Entity{
+		Id:                  m.Id(),
+		TenantId:            t.Id(),
+		TenantRegion:        t.Region(),
+		TenantMajor:         t.MajorVersion(),
+		TenantMinor:         t.MinorVersion(),
+		TransactionId:       m.TransactionId(),
+		NoteId:              m.NoteId(),
+		SenderId:            m.SenderId(),
+		RecipientId:         m.RecipientId(),
+		WorldId:             byte(m.WorldId()),
+		Minted:              m.Minted(),
+		SourceInventoryType: m.SourceInventoryType(),
+		AssetId:             m.AssetId(),
+		Mesos:               m.Mesos(),
+		AccountId:           m.AccountId(),
+		Currency:            m.Currency(),
+		CurrencyType:        m.CurrencyType(),
+		TemplateId:          s.TemplateId,
+		Quantity:            s.Quantity,
+		Expiration:          s.Expiration,
+		CashId:              s.CashId,
+		Rechargeable:        s.Rechargeable,
+		Flag:                s.Flag,
+		Owner:               s.Owner,
+		Strength:            s.Strength,
+		Dexterity:           s.Dexterity,
+		Intelligence:        s.Intelligence,
+		Luck:                s.Luck,
+		HP:                  s.Hp,
+		MP:                  s.Mp,
+		WeaponAttack:        s.WeaponAttack,
+		MagicAttack:         s.MagicAttack,
+		WeaponDefense:       s.WeaponDefense,
+		MagicDefense:        s.MagicDefense,
+		Accuracy:            s.Accuracy,
+		Avoidability:        s.Avoidability,
+		Hands:               s.Hands,
+		Speed:               s.Speed,
+		Jump:                s.Jump,
+		Slots:               s.Slots,
+		LevelType:           s.LevelType,
+		Level:               s.Level,
+		Experience:          s.Experience,
+		HammersApplied:      s.HammersApplied,
+		PetId:               s.PetId,
+		PetName:             s.PetName,
+		PetLevel:            s.PetLevel,
+		Closeness:           s.Closeness,
+		Fullness:            s.Fullness,
+		ExpiresAt:           m.ExpiresAt(),
+	}
+}
@@ -0,0 +1,197 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// Model is one item or meso attachment held in note custody.
+type Model struct {
+	id                  uuid.UUID
+	tenant              tenant.Model
+	transactionId       uuid.UUID
+	noteId              uint32
+	senderId            uint32
+	recipientId         uint32
+	worldId             world.Id
+	minted              bool
+	sourceInventoryType byte
+	assetId             uint32
+	mesos               uint32
+	accountId           uint32
+	currency            uint32
+	currencyType        uint32
+	snapshot            sharedsaga.AssetSnapshot
+	expiresAt           *time.Time
+	released            bool
+}
+
+// Id returns the attachment's id
+func (m Model) Id() uuid.UUID {
+	return m.id
+}
+
+// Tenant returns the tenant owning the attachment, as stored on its row
+func (m Model) Tenant() tenant.Model {
+	return m.tenant
+}
+
+// TenantId returns the id of the tenant owning the attachment
+func (m Model) TenantId() uuid.UUID {
+	return m.tenant.Id()
+}
+
+// TransactionId returns the saga transaction that escrowed the attachment
+func (m Model) TransactionId() uuid.UUID {
+	return m.transactionId
+}
+
+// NoteId returns the note the attachment is bound to; zero while unbound
+func (m Model) NoteId() uint32 {
+	return m.noteId
+}
+
+// SenderId returns the character who attached it; zero for reward mail
+func (m Model) SenderId() uint32 {
+	return m.senderId
+}
+
+// RecipientId returns the character the attachment is addressed to
+func (m Model) RecipientId() uint32 {
+	return m.recipientId
+}
+
+// WorldId returns the world the attachment was sent in
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+// Minted reports whether the attachment was created by reward mail rather
+// than escrowed from a character
+func (m Model) Minted() bool {
+	return m.minted
+}
+
+// SourceInventoryType returns the inventory the item was attached from
+func (m Model) SourceInventoryType() byte {
+	return m.sourceInventoryType
+}
+
+// AssetId returns the asset the item was attached from
+func (m Model) AssetId() uint32 {
+	return m.assetId
+}
+
+// Mesos returns the escrowed meso amount; zero for an item attachment
+func (m Model) Mesos() uint32 {
+	return m.mesos
+}
+
+// AccountId returns the wallet a currency reward is credited to
+func (m Model) AccountId() uint32 {
+	return m.accountId
+}
+
+// Currency returns the minted NX amount; zero for every other attachment
+func (m Model) Currency() uint32 {
+	return m.currency
+}
+
+// CurrencyType returns the wallet a currency reward is credited to (1=credit,
+// 2=points, 3=prepaid)
+func (m Model) CurrencyType() uint32 {
+	return m.currencyType
+}
+
+// Snapshot returns the escrowed item; zero for a meso attachment
+func (m Model) Snapshot() sharedsaga.AssetSnapshot {
+	return m.snapshot
+}
+
+// ExpiresAt returns when an unclaimed attachment is returned; nil while unbound
+func (m Model) ExpiresAt() *time.Time {
+	return m.expiresAt
+}
+
+// Released reports whether the attachment has been claimed or returned
+func (m Model) Released() bool {
+	return m.released
+}
+
+// Builder is a builder for creating Model instances
+type Builder struct {
+	m Model
+}
+
+// NewBuilder creates a Builder for an attachment addressed from sender to
+// recipient
+func NewBuilder(id uuid.UUID, senderId uint32, recipientId uint32) *Builder {
+	return &Builder{m: Model{id: id, senderId: senderId, recipientId: recipientId}}
+}
+
+// SetTransactionId sets the saga transaction that escrows the attachment
+func (b *Builder) SetTransactionId(transactionId uuid.UUID) *Builder {
+	b.m.transactionId = transactionId
+	return b
+}
+
+// SetNoteId sets the note the attachment is bound to
+func (b *Builder) SetNoteId(noteId uint32) *Builder {
+	b.m.noteId = noteId
+	return b
+}
+
+// SetWorldId sets the world the attachment was sent in
+func (b *Builder) SetWorldId(worldId world.Id) *Builder {
+	b.m.worldId = worldId
+	return b
+}
+
+// SetMinted marks the attachment as created by reward mail
+func (b *Builder) SetMinted(minted bool) *Builder {
+	b.m.minted = minted
+	return b
+}
+
+// SetSource sets the inventory and asset the item was attached from
+func (b *Builder) SetSource(inventoryType byte, assetId uint32) *Builder {
+	b.m.sourceInventoryType = inventoryType
+	b.m.assetId = assetId
+	return b
+}
+
+// SetMesos sets the escrowed meso amount
+func (b *Builder) SetMesos(mesos uint32) *Builder {
+	b.m.mesos = mesos
+	return b
+}
+
+// SetCurrency sets a minted NX reward and the account it is credited to
+func (b *Builder) SetCurrency(accountId uint32, amount uint32, currencyType uint32) *Builder {
+	b.m.accountId = accountId
+	b.m.currency = amount
+	b.m.currencyType = currencyType
+	return b
+}
+
+// SetSnapshot sets the escrowed item
+func (b *Builder) SetSnapshot(snapshot sharedsaga.AssetSnapshot) *Builder {
+	b.m.snapshot = snapshot
+	return b
+}
+
+// SetExpiresAt sets when an unclaimed attachment is returned
+func (b *Builder) SetExpiresAt(expiresAt *time.Time) *Builder {
+	b.m.expiresAt = expiresAt
+	return b
+}
+
+// Build creates the Model
+func (b *Builder) Build() Model {
+	return b.m
+}
@@ -0,0 +1,471 @@
+package attachment
+
+import (
+	"atlas-notes/kafka/message"
+	custodymsg "atlas-notes/kafka/message/note/custody"
+	"atlas-notes/saga"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	af "github.com/Chronicle20/atlas/libs/atlas-constants/asset"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	// EnvExpiryHours names how long an unclaimed attachment waits before it is
+	// returned to its sender (or, for reward mail, lapses).
+	EnvExpiryHours = "NOTE_ATTACHMENT_EXPIRY_HOURS"
+	// defaultExpiry applies when EnvExpiryHours is unset or invalid.
+	defaultExpiry = 30 * 24 * time.Hour
+
+	// MaxItemsPerNote caps the items one note may carry, so one send saga stays
+	// a bounded number of steps.
+	MaxItemsPerNote = 5
+
+	// returnRetryAfter is how long a return latch holds before the sweep may try
+	// the same row again. A return saga that fails restores the row; one that is
+	// still in flight after this long is assumed lost.
+	returnRetryAfter = 10 * time.Minute
+)
+
+var (
+	ErrNothingAttached = errors.New("note carries no attachments")
+	ErrTooManyItems    = fmt.Errorf("a note may carry at most %d items", MaxItemsPerNote)
+	ErrMesosOverflow   = errors.New("attached mesos exceed the supported range")
+	ErrNoAccount       = errors.New("a currency reward needs the account it is credited to")
+)
+
+// Item names one asset a sender attaches to a note.
+type Item struct {
+	InventoryType byte
+	AssetId       uint32
+	Quantity      uint32
+}
+
+// RewardItem names one item reward mail mints for its recipient.
+type RewardItem struct {
+	TemplateId uint32
+	Quantity   uint32
+}
+
+// Reward is everything one reward note mints for its recipient. Currency is
+// NX credited to AccountId's CurrencyType wallet (1=credit, 2=points,
+// 3=prepaid).
+type Reward struct {
+	Mesos        uint32
+	Items        []RewardItem
+	AccountId    uint32
+	Currency     uint32
+	CurrencyType uint32
+}
+
+// Empty reports whether the reward mints nothing.
+func (r Reward) Empty() bool {
+	if r.Mesos > 0 || r.Currency > 0 {
+		return false
+	}
+	for _, i := range r.Items {
+		if i.TemplateId != 0 && i.Quantity != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type Processor interface {
+	WithTransaction(tx *gorm.DB) Processor
+	Accept(mb *message.Buffer) func(transactionId uuid.UUID) func(m Model) error
+	AcceptAndEmit(transactionId uuid.UUID, m Model) error
+	Release(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error
+	ReleaseAndEmit(transactionId uuid.UUID, id uuid.UUID) error
+	Restore(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error
+	RestoreAndEmit(transactionId uuid.UUID, id uuid.UUID) error
+	Remove(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error
+	RemoveAndEmit(transactionId uuid.UUID, id uuid.UUID) error
+	Mint(noteId uint32, recipientId uint32, worldId world.Id, r Reward) ([]Model, error)
+	Bind(transactionId uuid.UUID, noteId uint32) (int64, error)
+	ByIdProvider(id uuid.UUID) model.Provider[Model]
+	ByNoteProvider(noteId uint32) model.Provider[[]Model]
+	Send(ch channel.Model, senderId uint32, recipientId uint32, msg string, items []Item, mesos uint32) (uuid.UUID, error)
+	Claim(ch channel.Model, characterId uint32, noteId uint32) (int, error)
+	Return(m Model) (bool, error)
+}
+
+type ProcessorImpl struct {
+	l     logrus.FieldLogger
+	ctx   context.Context
+	db    *gorm.DB
+	t     tenant.Model
+	sagaP saga.Processor
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   ctx,
+		db:    db,
+		t:     tenant.MustFromContext(ctx),
+		sagaP: saga.NewProcessor(l, ctx),
+	}
+}
+
+// WithTransaction returns a copy of the processor bound to the given
+// transaction, so a reward's note rows and attachment rows commit together.
+func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:     p.l,
+		ctx:   p.ctx,
+		db:    tx,
+		t:     p.t,
+		sagaP: p.sagaP,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+// Accept escrows one attachment and buffers its ACCEPTED ack.
+func (p *ProcessorImpl) Accept(mb *message.Buffer) func(transactionId uuid.UUID) func(m Model) error {
+	return func(transactionId uuid.UUID) func(m Model) error {
+		return func(m Model) error {
+			if err := create(p.db.WithContext(p.ctx), p.t, m); err != nil {
+				return err
+			}
+			return mb.Put(custodymsg.EnvStatusEventTopic, acceptedStatusProvider(transactionId, m.Id()))
+		}
+	}
+}
+
+// AcceptAndEmit escrows one attachment; the row and its ack commit together.
+func (p *ProcessorImpl) AcceptAndEmit(transactionId uuid.UUID, m Model) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		tp := p.WithTransaction(tx)
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return tp.Accept(mb)(transactionId)(m)
+		})
+	})
+}
+
+// Release takes an attachment out of escrow for delivery. It fails with
+// ErrNotEscrowed when another claim or return already won the row.
+func (p *ProcessorImpl) Release(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error {
+	return func(transactionId uuid.UUID) func(id uuid.UUID) error {
+		return func(id uuid.UUID) error {
+			if err := release(p.db.WithContext(p.ctx), id); err != nil {
+				return err
+			}
+			return mb.Put(custodymsg.EnvStatusEventTopic, releasedStatusProvider(transactionId, id))
+		}
+	}
+}
+
+// ReleaseAndEmit releases an attachment; the release and its ack commit together.
+func (p *ProcessorImpl) ReleaseAndEmit(transactionId uuid.UUID, id uuid.UUID) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		tp := p.WithTransaction(tx)
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return tp.Release(mb)(transactionId)(id)
+		})
+	})
+}
+
+// Restore returns a released attachment to escrow — the inverse of Release.
+func (p *ProcessorImpl) Restore(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error {
+	return func(transactionId uuid.UUID) func(id uuid.UUID) error {
+		return func(id uuid.UUID) error {
+			if err := restore(p.db.WithContext(p.ctx), id); err != nil {
+				return err
+			}
+			return mb.Put(custodymsg.EnvStatusEventTopic, restoredStatusProvider(transactionId, id))
+		}
+	}
+}
+
+// RestoreAndEmit restores an attachment; the restore and its ack commit together.
+func (p *ProcessorImpl) RestoreAndEmit(transactionId uuid.UUID, id uuid.UUID) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		tp := p.WithTransaction(tx)
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return tp.Restore(mb)(transactionId)(id)
+		})
+	})
+}
+
+// Remove hard-deletes an attachment whose contents were handed back — the
+// inverse of Accept. It acks with REMOVED, which the orchestrator observes but
+// never routes into step completion: the saga is already terminating.
+func (p *ProcessorImpl) Remove(mb *message.Buffer) func(transactionId uuid.UUID) func(id uuid.UUID) error {
+	return func(transactionId uuid.UUID) func(id uuid.UUID) error {
+		return func(id uuid.UUID) error {
+			if err := remove(p.db.WithContext(p.ctx), id); err != nil {
+				return err
+			}
+			return mb.Put(custodymsg.EnvStatusEventTopic, removedStatusProvider(transactionId, id))
+		}
+	}
+}
+
+// RemoveAndEmit removes an attachment; the delete and its ack commit together.
+func (p *ProcessorImpl) RemoveAndEmit(transactionId uuid.UUID, id uuid.UUID) error {
+	return database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		tp := p.WithTransaction(tx)
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			return tp.Remove(mb)(transactionId)(id)
+		})
+	})
+}
+
+// Mint creates reward attachments already bound to their note. Nothing is
+// escrowed from anyone, so no saga is involved; the caller runs it inside the
+// transaction that creates the note.
+func (p *ProcessorImpl) Mint(noteId uint32, recipientId uint32, worldId world.Id, r Reward) ([]Model, error) {
+	if r.Mesos > math.MaxInt32 || r.Currency > math.MaxInt32 {
+		return nil, ErrMesosOverflow
+	}
+	if len(r.Items) > MaxItemsPerNote {
+		return nil, ErrTooManyItems
+	}
+	if r.Currency > 0 && r.AccountId == 0 {
+		return nil, ErrNoAccount
+	}
+	expiresAt := time.Now().Add(Expiry())
+	var results []Model
+	put := func(b *Builder) error {
+		m := b.SetNoteId(noteId).SetWorldId(worldId).SetMinted(true).SetExpiresAt(&expiresAt).Build()
+		if err := create(p.db.WithContext(p.ctx), p.t, m); err != nil {
+			return err
+		}
+		results = append(results, m)
+		return nil
+	}
+	for _, i := range r.Items {
+		if i.TemplateId == 0 || i.Quantity == 0 {
+			continue
+		}
+		b := NewBuilder(uuid.New(), 0, recipientId).SetSnapshot(saga.AssetSnapshot{TemplateId: i.TemplateId, Quantity: i.Quantity})
+		if err := put(b); err != nil {
+			return nil, err
+		}
+	}
+	if r.Mesos > 0 {
+		if err := put(NewBuilder(uuid.New(), 0, recipientId).SetMesos(r.Mesos)); err != nil {
+			return nil, err
+		}
+	}
+	if r.Currency > 0 {
+		if err := put(NewBuilder(uuid.New(), 0, recipientId).SetCurrency(r.AccountId, r.Currency, r.CurrencyType)); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// Bind attaches the rows a send saga escrowed to the note the saga created and
+// starts their expiry clock.
+func (p *ProcessorImpl) Bind(transactionId uuid.UUID, noteId uint32) (int64, error) {
+	return bind(p.db.WithContext(p.ctx), transactionId, noteId, time.Now().Add(Expiry()))
+}
+
+// ByIdProvider retrieves an escrowed attachment by id
+func (p *ProcessorImpl) ByIdProvider(id uuid.UUID) model.Provider[Model] {
+	return model.Map[Entity, Model](Make)(getByIdProvider(id)(p.db.WithContext(p.ctx)))
+}
+
+// ByNoteProvider retrieves the escrowed attachments of a note
+func (p *ProcessorImpl) ByNoteProvider(noteId uint32) model.Provider[[]Model] {
+	return model.SliceMap[Entity, Model](Make)(getByNoteIdProvider(noteId)(p.db.WithContext(p.ctx)))(model.ParallelMap())
+}
+
+// Send submits the saga that sends a note with attachments: each item moves
+// from the sender's compartment into escrow, attached mesos are debited and
+// escrowed, and the trailing create_note binds the escrowed rows to the note it
+// creates. Any failure reverse-walks what already moved, so the sender is never
+// left without both the goods and the note.
+func (p *ProcessorImpl) Send(ch channel.Model, senderId uint32, recipientId uint32, msg string, items []Item, mesos uint32) (uuid.UUID, error) {
+	if len(items) == 0 && mesos == 0 {
+		return uuid.Nil, ErrNothingAttached
+	}
+	if len(items) > MaxItemsPerNote {
+		return uuid.Nil, ErrTooManyItems
+	}
+	if mesos > math.MaxInt32 {
+		return uuid.Nil, ErrMesosOverflow
+	}
+
+	transactionId := uuid.New()
+	b := saga.NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(saga.NoteAttachment).
+		SetInitiatedBy("note-send-attachments")
+	for _, i := range items {
+		id := uuid.New()
+		b.AddStep(fmt.Sprintf("transfer_to_note_%s", id), saga.Pending, saga.TransferToNote, saga.TransferToNotePayload{
+			TransactionId:       transactionId,
+			AttachmentId:        id,
+			CharacterId:         senderId,
+			RecipientId:         recipientId,
+			WorldId:             ch.WorldId(),
+			SourceInventoryType: i.InventoryType,
+			AssetId:             i.AssetId,
+			Quantity:            i.Quantity,
+		})
+	}
+	if mesos > 0 {
+		id := uuid.New()
+		b.AddStep(fmt.Sprintf("award_mesos_%s", id), saga.Pending, saga.AwardMesos, saga.AwardMesosPayload{
+			CharacterId: senderId,
+			WorldId:     ch.WorldId(),
+			ChannelId:   ch.Id(),
+			ActorId:     senderId,
+			ActorType:   "CHARACTER",
+			Amount:      -int32(mesos),
+		})
+		b.AddStep(fmt.Sprintf("accept_to_note_%s", id), saga.Pending, saga.AcceptToNote, saga.AcceptToNotePayload{
+			TransactionId: transactionId,
+			AttachmentId:  id,
+			SenderId:      senderId,
+			RecipientId:   recipientId,
+			WorldId:       ch.WorldId(),
+			Mesos:         mesos,
+		})
+	}
+	b.AddStep("create_note", saga.Pending, saga.CreateNote, saga.CreateNotePayload{
+		SenderId:   senderId,
+		ReceiverId: recipientId,
+		Message:    msg,
+	})
+
+	if err := p.sagaP.Create(b.Build()); err != nil {
+		return uuid.Nil, err
+	}
+	return transactionId, nil
+}
+
+// Claim submits one saga delivering every escrowed attachment of the note to
+// its recipient. Attachments addressed to someone else are ignored, so a
+// character can only ever claim their own mail. It returns how many
+// attachments the saga carries; zero means there was nothing to claim.
+//
+// The world comes from each row — mail never crosses worlds — and the channel
+// from the caller, which only decides where the meso-gain notice is shown.
+//
+// A karma mark is consumed by the delivery, exactly as a trade settlement
+// consumes it: the mark bought one transfer and this was it.
+func (p *ProcessorImpl) Claim(ch channel.Model, characterId uint32, noteId uint32) (int, error) {
+	ms, err := p.ByNoteProvider(noteId)()
+	if err != nil {
+		return 0, err
+	}
+	transactionId := uuid.New()
+	b := saga.NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(saga.NoteAttachment).
+		SetInitiatedBy("note-claim-attachments")
+	count := 0
+	for _, m := range ms {
+		if m.RecipientId() != characterId {
+			continue
+		}
+		snapshot := m.Snapshot()
+		if !m.Minted() && m.SenderId() != characterId {
+			snapshot = clearKarma(snapshot)
+		}
+		b.AddStep(fmt.Sprintf("withdraw_from_note_%s", m.Id()), saga.Pending, saga.WithdrawFromNote, saga.WithdrawFromNotePayload{
+			TransactionId: transactionId,
+			AttachmentId:  m.Id(),
+			CharacterId:   characterId,
+			WorldId:       m.WorldId(),
+			ChannelId:     ch.Id(),
+			Snapshot:      snapshot,
+			Mesos:         m.Mesos(),
+			Minted:        m.Minted(),
+			AccountId:     m.AccountId(),
+			Currency:      m.Currency(),
+			CurrencyType:  m.CurrencyType(),
+		})
+		count++
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	if err = p.sagaP.Create(b.Build()); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Return settles one expired attachment. A sent attachment goes back to its
+// sender through a withdraw saga, guarded by the return latch; a minted reward
+// has no sender and simply lapses. It reports whether this call acted on the
+// row — false means another sweep holds the latch.
+//
+// Both arms stamp the latch before the row is released, so a released row with
+// no latch is always a claim; campaign claim counts rely on that.
+func (p *ProcessorImpl) Return(m Model) (bool, error) {
+	now := time.Now()
+	won, err := claimForReturn(p.db.WithContext(p.ctx), m.Id(), now, now.Add(-returnRetryAfter))
+	if err != nil || !won {
+		return false, err
+	}
+
+	if m.Minted() {
+		err = release(p.db.WithContext(p.ctx), m.Id())
+		if errors.Is(err, ErrNotEscrowed) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	transactionId := uuid.New()
+	s := saga.NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(saga.NoteAttachment).
+		SetInitiatedBy("note-attachment-expiry").
+		AddStep(fmt.Sprintf("withdraw_from_note_%s", m.Id()), saga.Pending, saga.WithdrawFromNote, saga.WithdrawFromNotePayload{
+			TransactionId: transactionId,
+			AttachmentId:  m.Id(),
+			CharacterId:   m.SenderId(),
+			WorldId:       m.WorldId(),
+			Snapshot:      m.Snapshot(),
+			Mesos:         m.Mesos(),
+		}).
+		Build()
+	if err = p.sagaP.Create(s); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// clearKarma consumes the karma mark of an item changing hands. Pets carry no
+// karma bit, so they are returned unchanged.
+func clearKarma(s saga.AssetSnapshot) saga.AssetSnapshot {
+	f, ok := af.KarmaFlagFor(s.TemplateId)
+	if !ok {
+		return s
+	}
+	s.Flag = af.ClearFlag(s.Flag, f)
+	return s
+}
+
+// Expiry reads how long an unclaimed attachment is held, falling back to
+// defaultExpiry when EnvExpiryHours is unset or invalid.
+func Expiry() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv(EnvExpiryHours))
+	if err != nil || hours <= 0 {
+		return defaultExpiry
+	}
+	return time.Duration(hours) * time.Hour
+}
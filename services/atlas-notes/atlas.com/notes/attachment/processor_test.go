@@ -0,0 +1,256 @@
+package attachment
+
+import (
+	"atlas-notes/saga"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	af "github.com/Chronicle20/atlas/libs/atlas-constants/asset"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// fakeSagaProcessor records submitted sagas in place of the Kafka-producing
+// saga.Processor.
+type fakeSagaProcessor struct {
+	calls []saga.Saga
+}
+
+func (f *fakeSagaProcessor) Create(s saga.Saga) error {
+	f.calls = append(f.calls, s)
+	return nil
+}
+
+func testDatabase(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	l, _ := test.NewNullLogger()
+	database.RegisterTenantCallbacks(l, db)
+	if err := Migration(db); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	if err := outbox.Migration(db); err != nil {
+		t.Fatalf("Failed to migrate outbox table: %v", err)
+	}
+	return db
+}
+
+func testProcessor(t *testing.T) (*ProcessorImpl, *fakeSagaProcessor) {
+	l, _ := test.NewNullLogger()
+	te, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	sp := &fakeSagaProcessor{}
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   tenant.WithContext(context.Background(), te),
+		db:    testDatabase(t),
+		t:     te,
+		sagaP: sp,
+	}, sp
+}
+
+func escrowed(t *testing.T, p *ProcessorImpl, transactionId uuid.UUID, senderId uint32, recipientId uint32, snapshot saga.AssetSnapshot) Model {
+	t.Helper()
+	m := NewBuilder(uuid.New(), senderId, recipientId).
+		SetTransactionId(transactionId).
+		SetWorldId(1).
+		SetSource(1, 42).
+		SetSnapshot(snapshot).
+		Build()
+	if err := p.AcceptAndEmit(transactionId, m); err != nil {
+		t.Fatalf("AcceptAndEmit: %v", err)
+	}
+	return m
+}
+
+func TestBindAttachesOnlyTheSendersRows(t *testing.T) {
+	p, _ := testProcessor(t)
+	txId := uuid.New()
+	a := escrowed(t, p, txId, 1, 2, saga.AssetSnapshot{TemplateId: 1302000, Quantity: 1})
+	_ = escrowed(t, p, uuid.New(), 1, 2, saga.AssetSnapshot{TemplateId: 2000000, Quantity: 5})
+
+	n, err := p.Bind(txId, 77)
+	if err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("bound %d rows, want 1", n)
+	}
+	ms, err := p.ByNoteProvider(77)()
+	if err != nil {
+		t.Fatalf("ByNoteProvider: %v", err)
+	}
+	if len(ms) != 1 || ms[0].Id() != a.Id() {
+		t.Fatalf("note carries %v, want only %s", ms, a.Id())
+	}
+	if ms[0].ExpiresAt() == nil {
+		t.Fatal("bound attachment has no expiry")
+	}
+	if ms[0].Snapshot().TemplateId != 1302000 {
+		t.Fatalf("snapshot template %d, want 1302000", ms[0].Snapshot().TemplateId)
+	}
+}
+
+func TestReleaseIsACompareAndSet(t *testing.T) {
+	p, _ := testProcessor(t)
+	txId := uuid.New()
+	m := escrowed(t, p, txId, 1, 2, saga.AssetSnapshot{TemplateId: 2000000, Quantity: 5})
+
+	if err := p.ReleaseAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("first release: %v", err)
+	}
+	if err := p.ReleaseAndEmit(uuid.New(), m.Id()); !errors.Is(err, ErrNotEscrowed) {
+		t.Fatalf("second release err = %v, want ErrNotEscrowed", err)
+	}
+
+	if err := p.RestoreAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if err := p.ReleaseAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("release after restore: %v", err)
+	}
+}
+
+func TestRemoveCannotBeRestored(t *testing.T) {
+	p, _ := testProcessor(t)
+	m := escrowed(t, p, uuid.New(), 1, 2, saga.AssetSnapshot{TemplateId: 2000000, Quantity: 5})
+
+	if err := p.RemoveAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if err := p.RemoveAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("redelivered remove: %v", err)
+	}
+	if err := p.RestoreAndEmit(uuid.New(), m.Id()); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, err := p.ByIdProvider(m.Id())(); err == nil {
+		t.Fatal("removed attachment came back")
+	}
+}
+
+func TestClaimDeliversOnlyTheRecipientsAttachments(t *testing.T) {
+	p, sp := testProcessor(t)
+	txId := uuid.New()
+	flagged := af.SetFlag(0, af.FlagKarmaEquip)
+	_ = escrowed(t, p, txId, 1, 2, saga.AssetSnapshot{TemplateId: 1302000, Quantity: 1, Flag: flagged})
+	if _, err := p.Bind(txId, 80); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+
+	n, err := p.Claim(channel.NewModel(1, 0), 3, 80)
+	if err != nil || n != 0 {
+		t.Fatalf("stranger claim = %d, %v; want 0, nil", n, err)
+	}
+	if len(sp.calls) != 0 {
+		t.Fatalf("stranger claim submitted %d sagas", len(sp.calls))
+	}
+
+	n, err = p.Claim(channel.NewModel(1, 0), 2, 80)
+	if err != nil || n != 1 {
+		t.Fatalf("recipient claim = %d, %v; want 1, nil", n, err)
+	}
+	steps := sp.calls[0].Steps
+	if len(steps) != 1 || steps[0].Action != saga.WithdrawFromNote {
+		t.Fatalf("claim steps = %v", steps)
+	}
+	pl, ok := steps[0].Payload.(saga.WithdrawFromNotePayload)
+	if !ok {
+		t.Fatalf("payload %T", steps[0].Payload)
+	}
+	if pl.CharacterId != 2 {
+		t.Fatalf("delivered to %d, want 2", pl.CharacterId)
+	}
+	if af.HasFlag(pl.Snapshot.Flag, af.FlagKarmaEquip) {
+		t.Fatal("karma mark survived the hand-over")
+	}
+}
+
+func TestReturnLatchesOncePerRetryWindow(t *testing.T) {
+	p, sp := testProcessor(t)
+	txId := uuid.New()
+	m := escrowed(t, p, txId, 1, 2, saga.AssetSnapshot{TemplateId: 2000000, Quantity: 5})
+	if _, err := p.Bind(txId, 81); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+
+	won, err := p.Return(m)
+	if err != nil || !won {
+		t.Fatalf("first return = %v, %v; want true, nil", won, err)
+	}
+	won, err = p.Return(m)
+	if err != nil || won {
+		t.Fatalf("second return = %v, %v; want false, nil", won, err)
+	}
+	if len(sp.calls) != 1 {
+		t.Fatalf("submitted %d return sagas, want 1", len(sp.calls))
+	}
+	pl := sp.calls[0].Steps[0].Payload.(saga.WithdrawFromNotePayload)
+	if pl.CharacterId != 1 {
+		t.Fatalf("returned to %d, want sender 1", pl.CharacterId)
+	}
+}
+
+func TestMintedRewardsLapseOnExpiry(t *testing.T) {
+	p, sp := testProcessor(t)
+	ms, err := p.Mint(90, 2, 1, Reward{Mesos: 1000, Items: []RewardItem{{TemplateId: 2000000, Quantity: 10}, {TemplateId: 0, Quantity: 1}}})
+	if err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if len(ms) != 2 {
+		t.Fatalf("minted %d attachments, want 2", len(ms))
+	}
+
+	for _, m := range ms {
+		won, rerr := p.Return(m)
+		if rerr != nil || !won {
+			t.Fatalf("lapse = %v, %v; want true, nil", won, rerr)
+		}
+	}
+	if len(sp.calls) != 0 {
+		t.Fatalf("lapsing rewards submitted %d sagas", len(sp.calls))
+	}
+	left, err := p.ByNoteProvider(90)()
+	if err != nil {
+		t.Fatalf("ByNoteProvider: %v", err)
+	}
+	if len(left) != 0 {
+		t.Fatalf("%d rewards outlived their expiry", len(left))
+	}
+}
+
+func TestGetExpiredCarriesTheRowTenant(t *testing.T) {
+	p, _ := testProcessor(t)
+	txId := uuid.New()
+	m := escrowed(t, p, txId, 1, 2, saga.AssetSnapshot{TemplateId: 2000000, Quantity: 5})
+	if _, err := bind(p.db.WithContext(p.ctx), txId, 82, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	sdb := p.db.WithContext(database.WithoutTenantFilter(context.Background()))
+	ms, err := GetExpired(time.Now(), 100)(sdb)()
+	if err != nil {
+		t.Fatalf("GetExpired: %v", err)
+	}
+	for _, e := range ms {
+		if e.Id() != m.Id() {
+			continue
+		}
+		rt := e.Tenant()
+		if rt.Id() != p.t.Id() || rt.Region() != "GMS" || rt.MajorVersion() != 83 {
+			t.Fatalf("row tenant = %v, want %v", rt, p.t)
+		}
+		return
+	}
+	t.Fatal("expired attachment was not discovered")
+}
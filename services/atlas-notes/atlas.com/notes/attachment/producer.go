@@ -0,0 +1,60 @@
+package attachment
+
+import (
+	custodymsg "atlas-notes/kafka/message/note/custody"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// Every custody ack is keyed by the attachment id, so the acks for one row stay
+// ordered on one partition.
+func attachmentKey(id uuid.UUID) []byte {
+	return []byte(id.String())
+}
+
+func acceptedStatusProvider(transactionId uuid.UUID, id uuid.UUID) model.Provider[[]kafka.Message] {
+	return producer.SingleMessageProvider(attachmentKey(id), &custodymsg.StatusEvent[custodymsg.StatusEventAcceptedBody]{
+		TransactionId: transactionId,
+		Type:          custodymsg.StatusEventTypeAccepted,
+		Body:          custodymsg.StatusEventAcceptedBody{AttachmentId: id},
+	})
+}
+
+func releasedStatusProvider(transactionId uuid.UUID, id uuid.UUID) model.Provider[[]kafka.Message] {
+	return producer.SingleMessageProvider(attachmentKey(id), &custodymsg.StatusEvent[custodymsg.StatusEventReleasedBody]{
+		TransactionId: transactionId,
+		Type:          custodymsg.StatusEventTypeReleased,
+		Body:          custodymsg.StatusEventReleasedBody{AttachmentId: id},
+	})
+}
+
+func restoredStatusProvider(transactionId uuid.UUID, id uuid.UUID) model.Provider[[]kafka.Message] {
+	return producer.SingleMessageProvider(attachmentKey(id), &custodymsg.StatusEvent[custodymsg.StatusEventRestoredBody]{
+		TransactionId: transactionId,
+		Type:          custodymsg.StatusEventTypeRestored,
+		Body:          custodymsg.StatusEventRestoredBody{AttachmentId: id},
+	})
+}
+
+func removedStatusProvider(transactionId uuid.UUID, id uuid.UUID) model.Provider[[]kafka.Message] {
+	return producer.SingleMessageProvider(attachmentKey(id), &custodymsg.StatusEvent[custodymsg.StatusEventRemovedBody]{
+		TransactionId: transactionId,
+		Type:          custodymsg.StatusEventTypeRemoved,
+		Body:          custodymsg.StatusEventRemovedBody{AttachmentId: id},
+	})
+}
+
+// ErrorStatusProvider reports a custody failure. It is exported for the custody
+// consumer, which emits it directly when the write's transaction rolled back
+// and took the buffered ack with it.
+func ErrorStatusProvider(transactionId uuid.UUID, id uuid.UUID, reason string) model.Provider[[]kafka.Message] {
+	return producer.SingleMessageProvider(attachmentKey(id), &custodymsg.StatusEvent[custodymsg.StatusEventErrorBody]{
+		TransactionId: transactionId,
+		Type:          custodymsg.StatusEventTypeError,
+		Body:          custodymsg.StatusEventErrorBody{AttachmentId: id, Error: reason},
+	})
+}
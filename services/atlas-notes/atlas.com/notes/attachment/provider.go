@@ -0,0 +1,63 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func getByIdProvider(id uuid.UUID) database.EntityProvider[Entity] {
+	return func(db *gorm.DB) model.Provider[Entity] {
+		var entity Entity
+		err := db.Where("id = ?", id).First(&entity).Error
+		if err != nil {
+			return model.ErrorProvider[Entity](err)
+		}
+		return model.FixedProvider(entity)
+	}
+}
+
+// getByNoteIdProvider returns the attachments of a note still in escrow.
+func getByNoteIdProvider(noteId uint32) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var entities []Entity
+		err := db.Where("note_id = ?", noteId).Order("created_at ASC").Find(&entities).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider(entities)
+	}
+}
+
+// getExpiredProvider returns up to limit bound, escrowed attachments whose
+// expiry has passed and whose return latch is unset or stale. Callers run it
+// under database.WithoutTenantFilter to sweep every tenant at once.
+func getExpiredProvider(now time.Time, staleBefore time.Time, limit int) database.EntityProvider[[]Entity] {
+	return func(db *gorm.DB) model.Provider[[]Entity] {
+		var entities []Entity
+		err := db.
+			Where("note_id <> ? AND expires_at IS NOT NULL AND expires_at <= ?", 0, now).
+			Where("return_claimed_at IS NULL OR return_claimed_at < ?", staleBefore).
+			Order("expires_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return model.ErrorProvider[[]Entity](err)
+		}
+		return model.FixedProvider(entities)
+	}
+}
+
+// ClaimedNoteIds is a subquery selecting the ids of the tenant's notes with at
+// least one claimed attachment. A released row with no return latch can only
+// have been claimed: expiry stamps the latch before it releases, sent and
+// minted rows alike.
+func ClaimedNoteIds(db *gorm.DB, tenantId uuid.UUID) *gorm.DB {
+	return db.Unscoped().Model(&Entity{}).
+		Select("note_id").
+		Where("tenant_id = ? AND deleted_at IS NOT NULL AND return_claimed_at IS NULL", tenantId)
+}
@@ -0,0 +1,83 @@
+package attachment
+
+import (
+	"atlas-notes/rest"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+)
+
+func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerHandler := rest.RegisterHandler(l)(db)(si)
+
+			// Escrowed attachments of a note
+			router.HandleFunc(
+				"/notes/{noteId}/attachments",
+				registerHandler("get_note_attachments", GetNoteAttachmentsHandler),
+			).Methods(http.MethodGet)
+
+			// Claim a note's attachments for its recipient
+			router.HandleFunc(
+				"/characters/{characterId}/notes/{noteId}/claim",
+				registerHandler("claim_note_attachments", ClaimNoteAttachmentsHandler),
+			).Methods(http.MethodPost)
+		}
+	}
+}
+
+// GetNoteAttachmentsHandler handles GET /api/notes/{noteId}/attachments
+func GetNoteAttachmentsHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseNoteId(d.Logger(), func(noteId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ms, err := NewProcessor(d.Logger(), d.Context(), d.DB()).ByNoteProvider(noteId)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Unable to locate attachments for note [%d].", noteId)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			rm, err := model.SliceMap(Transform)(model.FixedProvider(ms))(model.ParallelMap())()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			query := r.URL.Query()
+			queryParams := jsonapi.ParseQueryFields(&query)
+			server.MarshalResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+		}
+	})
+}
+
+// ClaimNoteAttachmentsHandler handles POST /api/characters/{characterId}/notes/{noteId}/claim.
+// The claim is a saga, so success is 202 Accepted; 404 means the note carries
+// nothing claimable by this character.
+func ClaimNoteAttachmentsHandler(d *rest.HandlerDependency, _ *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseCharacterId(d.Logger(), func(characterId uint32) http.HandlerFunc {
+		return rest.ParseNoteId(d.Logger(), func(noteId uint32) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				n, err := NewProcessor(d.Logger(), d.Context(), d.DB()).Claim(channel.NewModel(0, 0), characterId, noteId)
+				if err != nil {
+					d.Logger().WithError(err).Errorf("Unable to claim attachments of note [%d] for character [%d].", noteId, characterId)
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				if n == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}
+		})
+	})
+}
@@ -0,0 +1,61 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RestModel is the JSON:API resource for an escrowed note attachment
+type RestModel struct {
+	Id           uuid.UUID  `json:"-"`
+	NoteId       uint32     `json:"noteId"`
+	SenderId     uint32     `json:"senderId"`
+	RecipientId  uint32     `json:"recipientId"`
+	WorldId      byte       `json:"worldId"`
+	Minted       bool       `json:"minted"`
+	Mesos        uint32     `json:"mesos"`
+	Currency     uint32     `json:"currency"`
+	CurrencyType uint32     `json:"currencyType"`
+	TemplateId   uint32     `json:"templateId"`
+	Quantity     uint32     `json:"quantity"`
+	ExpiresAt    *time.Time `json:"expiresAt"`
+}
+
+// GetID returns the resource ID
+func (r RestModel) GetID() string {
+	return r.Id.String()
+}
+
+// SetID sets the resource ID
+func (r *RestModel) SetID(strId string) error {
+	id, err := uuid.Parse(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = id
+	return nil
+}
+
+// GetName returns the resource name
+func (r RestModel) GetName() string {
+	return "attachments"
+}
+
+// Transform converts a Model domain model to a RestModel
+func Transform(m Model) (RestModel, error) {
+	return RestModel{
+		Id:           m.Id(),
+		NoteId:       m.NoteId(),
+		SenderId:     m.SenderId(),
+		RecipientId:  m.RecipientId(),
+		WorldId:      byte(m.WorldId()),
+		Minted:       m.Minted(),
+		Mesos:        m.Mesos(),
+		Currency:     m.Currency(),
+		CurrencyType: m.CurrencyType(),
+		TemplateId:   m.Snapshot().TemplateId,
+		Quantity:     m.Snapshot().Quantity,
+		ExpiresAt:    m.ExpiresAt(),
+	}, nil
+}
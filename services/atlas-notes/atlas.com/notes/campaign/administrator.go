@@ -0,0 +1,66 @@
+package campaign
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// createCampaign inserts a campaign unless its key is already taken. It reports
+// whether this call inserted the row; when it did not, the caller reads the
+// existing campaign by key.
+func createCampaign(db *gorm.DB, t tenant.Model, m Model) (entity, bool, error) {
+	e := makeEntity(t, m)
+	e.Id = uuid.New()
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&e)
+	if res.Error != nil {
+		return entity{}, false, res.Error
+	}
+	return e, res.RowsAffected == 1, nil
+}
+
+// advanceCursor moves a campaign's cursor forward to characterId. It never
+// moves it back, so a slower replica finishing an older batch is harmless.
+func advanceCursor(db *gorm.DB, id uuid.UUID, characterId uint32) error {
+	return db.Model(&entity{}).
+		Where("id = ? AND delivery_cursor < ?", id, characterId).
+		Update("delivery_cursor", characterId).Error
+}
+
+// complete marks a delivering campaign completed.
+func complete(db *gorm.DB, id uuid.UUID, now time.Time) error {
+	return db.Model(&entity{}).
+		Where("id = ? AND status = ?", id, string(StatusDelivering)).
+		Updates(map[string]interface{}{"status": string(StatusCompleted), "completed_at": now}).Error
+}
+
+// recordDelivery claims a recipient for a campaign. It reports false when the
+// recipient was already mailed — by an earlier pass, another replica, or
+// another character of the same account under account scope.
+func recordDelivery(db *gorm.DB, tenantId uuid.UUID, d deliveryEntity) (bool, error) {
+	d.TenantId = tenantId
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&d)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func setDeliveryNote(db *gorm.DB, id uuid.UUID, noteId uint32) error {
+	return db.Model(&deliveryEntity{}).Where("id = ?", id).Update("note_id", noteId).Error
+}
+
+// GetDelivering returns up to limit campaigns still delivering. The delivery
+// pass runs it under database.WithoutTenantFilter; each model carries its own
+// tenant.
+func GetDelivering(limit int) database.EntityProvider[[]Model] {
+	return func(db *gorm.DB) model.Provider[[]Model] {
+		return model.SliceMap(modelFromEntity)(getDeliveringProvider(limit)(db))()
+	}
+}
@@ -0,0 +1,174 @@
+// Package campaign delivers bulk GM mail: one note, with optional minted
+// rewards, to every character a filter or an explicit list selects.
+//
+// Delivery is resumable and idempotent. A campaign row carries a keyset cursor
+// over atlas-character's id-ordered listing, and every delivery is a row unique
+// on (campaign, recipient) written in the same transaction as its note and
+// rewards — so a crashed or repeated pass can never mail anyone twice.
+package campaign
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	campaignTable = "note_campaigns"
+	deliveryTable = "note_campaign_deliveries"
+)
+
+// itemEntity is one reward item, persisted inside the campaign row.
+type itemEntity struct {
+	TemplateId uint32 `json:"templateId"`
+	Quantity   uint32 `json:"quantity"`
+}
+
+// entity is one campaign. Key is the caller's idempotency key: a second
+// create with the same key returns the first campaign.
+//
+// The tenant's region and version sit beside its id so the cross-tenant
+// delivery pass can rebuild the tenant for its character lookups.
+type entity struct {
+	Id           uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	TenantId     uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;uniqueIndex:idx_note_campaigns_key,priority:1"`
+	TenantRegion string    `gorm:"column:tenant_region;type:varchar(32);not null;default:''"`
+	TenantMajor  uint16    `gorm:"column:tenant_major;not null;default:0"`
+	TenantMinor  uint16    `gorm:"column:tenant_minor;not null;default:0"`
+
+	Key  string `gorm:"column:idempotency_key;type:varchar(128);not null;uniqueIndex:idx_note_campaigns_key,priority:2"`
+	Name string `gorm:"column:name;not null;default:''"`
+
+	// Targeting. A non-empty CharacterIds is an explicit list and the filter
+	// columns are ignored.
+	WorldId      *byte      `gorm:"column:world_id"`
+	MinLevel     byte       `gorm:"column:min_level;not null;default:0"`
+	MaxLevel     byte       `gorm:"column:max_level;not null;default:0"`
+	ActiveFrom   *time.Time `gorm:"column:active_from"`
+	ActiveTo     *time.Time `gorm:"column:active_to"`
+	CharacterIds []uint32   `gorm:"column:character_ids;serializer:json"`
+	ClaimScope   string     `gorm:"column:claim_scope;type:varchar(16);not null"`
+
+	SenderId     uint32       `gorm:"column:sender_id;not null"`
+	Message      string       `gorm:"column:message;not null"`
+	Flag         byte         `gorm:"column:flag;not null;default:0"`
+	Mesos        uint32       `gorm:"column:mesos;not null;default:0"`
+	Currency     uint32       `gorm:"column:currency;not null;default:0"`
+	CurrencyType uint32       `gorm:"column:currency_type;not null;default:0"`
+	Items        []itemEntity `gorm:"column:items;serializer:json"`
+
+	Status      string     `gorm:"column:status;type:varchar(16);not null;index"`
+	Cursor      uint32     `gorm:"column:delivery_cursor;not null;default:0"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+}
+
+func (entity) TableName() string {
+	return campaignTable
+}
+
+// deliveryEntity records one recipient mailed by a campaign. RecipientKey is
+// the character id, or the account id under account claim scope, and is
+// unique per campaign.
+type deliveryEntity struct {
+	Id           uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	TenantId     uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;uniqueIndex:idx_note_campaign_deliveries_recipient,priority:1"`
+	CampaignId   uuid.UUID `gorm:"column:campaign_id;type:uuid;not null;uniqueIndex:idx_note_campaign_deliveries_recipient,priority:2"`
+	RecipientKey uint32    `gorm:"column:recipient_key;not null;uniqueIndex:idx_note_campaign_deliveries_recipient,priority:3"`
+	CharacterId  uint32    `gorm:"column:character_id;not null"`
+	AccountId    uint32    `gorm:"column:account_id;not null;default:0"`
+	NoteId       uint32    `gorm:"column:note_id;not null;default:0;index"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+}
+
+func (deliveryEntity) TableName() string {
+	return deliveryTable
+}
+
+// Migration sets up the campaign tables in the database
+func Migration(db *gorm.DB) error {
+	return db.AutoMigrate(&entity{}, &deliveryEntity{})
+}
+
+func modelFromEntity(e entity) (Model, error) {
+	t, err := tenant.Create(e.TenantId, e.TenantRegion, e.TenantMajor, e.TenantMinor)
+	if err != nil {
+		return Model{}, err
+	}
+	items := make([]Item, 0, len(e.Items))
+	for _, i := range e.Items {
+		items = append(items, Item{TemplateId: i.TemplateId, Quantity: i.Quantity})
+	}
+	var worldId *world.Id
+	if e.WorldId != nil {
+		w := world.Id(*e.WorldId)
+		worldId = &w
+	}
+	return Model{
+		id:     e.Id,
+		tenant: t,
+		key:    e.Key,
+		name:   e.Name,
+		target: Target{
+			WorldId:      worldId,
+			MinLevel:     e.MinLevel,
+			MaxLevel:     e.MaxLevel,
+			ActiveFrom:   e.ActiveFrom,
+			ActiveTo:     e.ActiveTo,
+			CharacterIds: e.CharacterIds,
+		},
+		claimScope:   ClaimScope(e.ClaimScope),
+		senderId:     e.SenderId,
+		message:      e.Message,
+		flag:         e.Flag,
+		mesos:        e.Mesos,
+		currency:     e.Currency,
+		currencyType: e.CurrencyType,
+		items:        items,
+		status:       Status(e.Status),
+		cursor:       e.Cursor,
+		createdAt:    e.CreatedAt,
+		completedAt:  e.CompletedAt,
+	}, nil
+}
+
+func makeEntity(t tenant.Model, m Model) entity {
+	items := make([]itemEntity, 0, len(m.items))
+	for _, i := range m.items {
+		items = append(items, itemEntity{TemplateId: i.TemplateId, Quantity: i.Quantity})
+	}
+	var worldId *byte
+	if m.target.WorldId != nil {
+		w := byte(*m.target.WorldId)
+		worldId = &w
+	}
+	return entity{
+		Id:           m.id,
+		TenantId:     t.Id(),
+		TenantRegion: t.Region(),
+		TenantMajor:  t.MajorVersion(),
+		TenantMinor:  t.MinorVersion(),
+		Key:          m.key,
+		Name:         m.name,
+		WorldId:      worldId,
+		MinLevel:     m.target.MinLevel,
+		MaxLevel:     m.target.MaxLevel,
+		ActiveFrom:   m.target.ActiveFrom,
+		ActiveTo:     m.target.ActiveTo,
+		CharacterIds: m.target.CharacterIds,
+		ClaimScope:   string(m.claimScope),
+		SenderId:     m.senderId,
+		Message:      m.message,
+		Flag:         m.flag,
+		Mesos:        m.mesos,
+		Currency:     m.currency,
+		CurrencyType: m.currencyType,
+		Items:        items,
+		Status:       string(m.status),
+		Cursor:       m.cursor,
+	}
+}
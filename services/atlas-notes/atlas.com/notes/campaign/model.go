@@ -0,0 +1,239 @@
+package campaign
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// ClaimScope decides who a campaign rewards once.
+type ClaimScope string
+
+const (
+	// ClaimScopeCharacter mails every targeted character.
+	ClaimScopeCharacter ClaimScope = "CHARACTER"
+	// ClaimScopeAccount mails the first targeted character of each account
+	// only, so an account collects the reward once however many of its
+	// characters match.
+	ClaimScopeAccount ClaimScope = "ACCOUNT"
+)
+
+// Status is where a campaign's delivery stands.
+type Status string
+
+const (
+	StatusDelivering Status = "DELIVERING"
+	StatusCompleted  Status = "COMPLETED"
+)
+
+var (
+	ErrKeyRequired     = errors.New("campaign key is required")
+	ErrMessageRequired = errors.New("campaign message is required")
+	ErrSenderRequired  = errors.New("campaign sender is required")
+	ErrInvalidScope    = errors.New("claim scope must be CHARACTER or ACCOUNT")
+	ErrInvalidWindow   = errors.New("activeFrom must not be after activeTo")
+	ErrInvalidLevels   = errors.New("minLevel must not exceed maxLevel")
+)
+
+// Target selects a campaign's recipients. A non-empty CharacterIds is an
+// explicit list; otherwise the filter fields apply, and a zero filter targets
+// every character in the tenant.
+type Target struct {
+	WorldId      *world.Id
+	MinLevel     byte
+	MaxLevel     byte
+	ActiveFrom   *time.Time
+	ActiveTo     *time.Time
+	CharacterIds []uint32
+}
+
+// Explicit reports whether the target is an explicit character list.
+func (t Target) Explicit() bool {
+	return len(t.CharacterIds) > 0
+}
+
+// Item is one reward item every recipient receives.
+type Item struct {
+	TemplateId uint32
+	Quantity   uint32
+}
+
+// Model is a bulk GM mail campaign.
+type Model struct {
+	id           uuid.UUID
+	tenant       tenant.Model
+	key          string
+	name         string
+	target       Target
+	claimScope   ClaimScope
+	senderId     uint32
+	message      string
+	flag         byte
+	mesos        uint32
+	currency     uint32
+	currencyType uint32
+	items        []Item
+	status       Status
+	cursor       uint32
+	createdAt    time.Time
+	completedAt  *time.Time
+}
+
+func (m Model) Id() uuid.UUID {
+	return m.id
+}
+
+// Tenant returns the tenant owning the campaign, as stored on its row
+func (m Model) Tenant() tenant.Model {
+	return m.tenant
+}
+
+func (m Model) Key() string {
+	return m.key
+}
+
+func (m Model) Name() string {
+	return m.name
+}
+
+func (m Model) Target() Target {
+	return m.target
+}
+
+func (m Model) ClaimScope() ClaimScope {
+	return m.claimScope
+}
+
+func (m Model) SenderId() uint32 {
+	return m.senderId
+}
+
+func (m Model) Message() string {
+	return m.message
+}
+
+func (m Model) Flag() byte {
+	return m.flag
+}
+
+func (m Model) Mesos() uint32 {
+	return m.mesos
+}
+
+// Currency returns the NX each recipient's account is credited
+func (m Model) Currency() uint32 {
+	return m.currency
+}
+
+// CurrencyType returns the wallet NX is credited to (1=credit, 2=points,
+// 3=prepaid)
+func (m Model) CurrencyType() uint32 {
+	return m.currencyType
+}
+
+func (m Model) Items() []Item {
+	return m.items
+}
+
+func (m Model) Status() Status {
+	return m.status
+}
+
+// Cursor returns the greatest character id delivery has walked past
+func (m Model) Cursor() uint32 {
+	return m.cursor
+}
+
+func (m Model) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m Model) CompletedAt() *time.Time {
+	return m.completedAt
+}
+
+// Builder is a builder for creating campaign Models
+type Builder struct {
+	m Model
+}
+
+// NewBuilder creates a Builder for a campaign identified by the caller's
+// idempotency key
+func NewBuilder(key string) *Builder {
+	return &Builder{m: Model{key: key, claimScope: ClaimScopeCharacter, status: StatusDelivering}}
+}
+
+func (b *Builder) SetName(name string) *Builder {
+	b.m.name = name
+	return b
+}
+
+func (b *Builder) SetTarget(target Target) *Builder {
+	b.m.target = target
+	return b
+}
+
+func (b *Builder) SetClaimScope(scope ClaimScope) *Builder {
+	b.m.claimScope = scope
+	return b
+}
+
+func (b *Builder) SetSenderId(senderId uint32) *Builder {
+	b.m.senderId = senderId
+	return b
+}
+
+func (b *Builder) SetMessage(message string) *Builder {
+	b.m.message = message
+	return b
+}
+
+func (b *Builder) SetFlag(flag byte) *Builder {
+	b.m.flag = flag
+	return b
+}
+
+func (b *Builder) SetMesos(mesos uint32) *Builder {
+	b.m.mesos = mesos
+	return b
+}
+
+// SetCurrency sets the NX credited to each recipient's account
+func (b *Builder) SetCurrency(amount uint32, currencyType uint32) *Builder {
+	b.m.currency = amount
+	b.m.currencyType = currencyType
+	return b
+}
+
+func (b *Builder) SetItems(items []Item) *Builder {
+	b.m.items = items
+	return b
+}
+
+// Build validates and creates the Model
+func (b *Builder) Build() (Model, error) {
+	if b.m.key == "" {
+		return Model{}, ErrKeyRequired
+	}
+	if b.m.message == "" {
+		return Model{}, ErrMessageRequired
+	}
+	if b.m.senderId == 0 {
+		return Model{}, ErrSenderRequired
+	}
+	if b.m.claimScope != ClaimScopeCharacter && b.m.claimScope != ClaimScopeAccount {
+		return Model{}, ErrInvalidScope
+	}
+	t := b.m.target
+	if t.ActiveFrom != nil && t.ActiveTo != nil && t.ActiveFrom.After(*t.ActiveTo) {
+		return Model{}, ErrInvalidWindow
+	}
+	if t.MinLevel > 0 && t.MaxLevel > 0 && t.MinLevel > t.MaxLevel {
+		return Model{}, ErrInvalidLevels
+	}
+	return b.m, nil
+}
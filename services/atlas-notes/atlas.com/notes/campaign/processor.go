@@ -0,0 +1,244 @@
+package campaign
+
+import (
+	"atlas-notes/attachment"
+	"atlas-notes/character"
+	"atlas-notes/kafka/message"
+	"atlas-notes/note"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// targetPageSize is how many characters one atlas-character listing call
+// returns during delivery.
+const targetPageSize = 100
+
+// Stats are a campaign's delivery counters.
+type Stats struct {
+	Delivered int64
+	Claimed   int64
+}
+
+type Processor interface {
+	WithTransaction(tx *gorm.DB) Processor
+	// Create stores a campaign, or returns the campaign already holding its key.
+	// The bool reports whether this call created it.
+	Create(m Model) (Model, bool, error)
+	ByIdProvider(id uuid.UUID) model.Provider[Model]
+	AllProvider(page model.Page) model.Provider[model.Paged[Model]]
+	StatsById(id uuid.UUID) (Stats, error)
+	// Deliver walks up to budget more targets of a delivering campaign (rounded
+	// up to a whole listing page) and returns how many it mailed. It marks the
+	// campaign completed once its targets are exhausted.
+	Deliver(m Model, budget int) (int, error)
+}
+
+type ProcessorImpl struct {
+	l     logrus.FieldLogger
+	ctx   context.Context
+	db    *gorm.DB
+	t     tenant.Model
+	charP character.Processor
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   ctx,
+		db:    db,
+		t:     tenant.MustFromContext(ctx),
+		charP: character.NewProcessor(l, ctx),
+	}
+}
+
+// WithTransaction returns a copy of the processor bound to the given transaction.
+func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:     p.l,
+		ctx:   p.ctx,
+		db:    tx,
+		t:     p.t,
+		charP: p.charP,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) Create(m Model) (Model, bool, error) {
+	db := p.db.WithContext(p.ctx)
+	e, created, err := createCampaign(db, p.t, m)
+	if err != nil {
+		return Model{}, false, err
+	}
+	if !created {
+		e, err = getByKeyProvider(m.Key())(db)()
+		if err != nil {
+			return Model{}, false, err
+		}
+	}
+	r, err := modelFromEntity(e)
+	if err != nil {
+		return Model{}, false, err
+	}
+	return r, created, nil
+}
+
+func (p *ProcessorImpl) ByIdProvider(id uuid.UUID) model.Provider[Model] {
+	return model.Map(modelFromEntity)(getByIdProvider(id)(p.db.WithContext(p.ctx)))
+}
+
+func (p *ProcessorImpl) AllProvider(page model.Page) model.Provider[model.Paged[Model]] {
+	ep := getAllInTenantProvider(page)(p.db.WithContext(p.ctx))
+	return model.MapPaged(modelFromEntity)(ep)(model.ParallelMap())
+}
+
+func (p *ProcessorImpl) StatsById(id uuid.UUID) (Stats, error) {
+	db := p.db.WithContext(p.ctx)
+	delivered, err := countDelivered(db, id)
+	if err != nil {
+		return Stats{}, err
+	}
+	claimed, err := countClaimed(db, id, attachment.ClaimedNoteIds(db, p.t.Id()))
+	if err != nil {
+		return Stats{}, err
+	}
+	return Stats{Delivered: delivered, Claimed: claimed}, nil
+}
+
+func (p *ProcessorImpl) Deliver(m Model, budget int) (int, error) {
+	db := p.db.WithContext(p.ctx)
+	cursor := m.Cursor()
+	walked := 0
+	delivered := 0
+	for walked < budget {
+		targets, err := p.targetsAfter(m.Target(), cursor)
+		if err != nil {
+			return delivered, err
+		}
+		if len(targets) == 0 {
+			if err = complete(db, m.Id(), time.Now()); err != nil {
+				return delivered, err
+			}
+			p.l.Infof("Campaign [%s] completed delivery.", m.Id())
+			return delivered, nil
+		}
+		for _, c := range targets {
+			sent, err := p.deliverTo(m, c)
+			if err != nil {
+				return delivered, err
+			}
+			if sent {
+				delivered++
+			}
+			cursor = c.Id()
+			walked++
+		}
+		if err = advanceCursor(db, m.Id(), cursor); err != nil {
+			return delivered, err
+		}
+	}
+	return delivered, nil
+}
+
+// targetsAfter returns the next page of targets with an id above cursor. An
+// explicit list is walked in id order so the same cursor serves both modes;
+// listed characters that no longer exist are skipped.
+func (p *ProcessorImpl) targetsAfter(t Target, cursor uint32) ([]character.Model, error) {
+	if !t.Explicit() {
+		return p.charP.TargetsAfter(character.Filter{
+			WorldId:    t.WorldId,
+			MinLevel:   t.MinLevel,
+			MaxLevel:   t.MaxLevel,
+			ActiveFrom: t.ActiveFrom,
+			ActiveTo:   t.ActiveTo,
+		}, cursor, targetPageSize)
+	}
+
+	ids := append([]uint32(nil), t.CharacterIds...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	var results []character.Model
+	for _, id := range ids {
+		if id <= cursor || (len(results) > 0 && results[len(results)-1].Id() == id) {
+			continue
+		}
+		c, err := p.charP.GetById(id)
+		if errors.Is(err, requests.ErrNotFound) {
+			p.l.Warnf("Campaign target character [%d] does not exist; skipping.", id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, c)
+		if len(results) == targetPageSize {
+			break
+		}
+	}
+	return results, nil
+}
+
+// deliverTo mails one target. The delivery row, note, and minted rewards are
+// written in one transaction, so a recipient either has all of them or none,
+// and a repeated pass finds the delivery row and does nothing.
+func (p *ProcessorImpl) deliverTo(m Model, c character.Model) (bool, error) {
+	recipientKey := c.Id()
+	if m.ClaimScope() == ClaimScopeAccount {
+		recipientKey = c.AccountId()
+	}
+	reward := attachment.Reward{
+		Mesos:        m.Mesos(),
+		AccountId:    c.AccountId(),
+		Currency:     m.Currency(),
+		CurrencyType: m.CurrencyType(),
+	}
+	for _, i := range m.Items() {
+		reward.Items = append(reward.Items, attachment.RewardItem{TemplateId: i.TemplateId, Quantity: i.Quantity})
+	}
+
+	sent := false
+	txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		d := deliveryEntity{
+			Id:           uuid.New(),
+			CampaignId:   m.Id(),
+			RecipientKey: recipientKey,
+			CharacterId:  c.Id(),
+			AccountId:    c.AccountId(),
+		}
+		inserted, err := recordDelivery(tx, p.t.Id(), d)
+		if err != nil || !inserted {
+			return err
+		}
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			n, err := note.NewProcessor(p.l, p.ctx, tx).Create(mb)(uuid.Nil)(c.Id())(m.SenderId())(m.Message())(m.Flag())
+			if err != nil {
+				return err
+			}
+			if !reward.Empty() {
+				if _, err = attachment.NewProcessor(p.l, p.ctx, tx).Mint(n.Id(), c.Id(), c.WorldId(), reward); err != nil {
+					return err
+				}
+			}
+			if err = setDeliveryNote(tx, d.Id, n.Id()); err != nil {
+				return err
+			}
+			sent = true
+			return nil
+		})
+	})
+	if txErr != nil {
+		return false, txErr
+	}
+	return sent, nil
+}
@@ -0,0 +1,237 @@
+package campaign
+
+import (
+	"atlas-notes/attachment"
+	"atlas-notes/character"
+	"atlas-notes/note"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// fakeCharacterProcessor serves targets from memory in place of atlas-character.
+type fakeCharacterProcessor struct {
+	characters []character.Model
+}
+
+func (f *fakeCharacterProcessor) GetById(characterId uint32) (character.Model, error) {
+	for _, c := range f.characters {
+		if c.Id() == characterId {
+			return c, nil
+		}
+	}
+	return character.Model{}, requests.ErrNotFound
+}
+
+func (f *fakeCharacterProcessor) TargetsAfter(_ character.Filter, afterId uint32, size int) ([]character.Model, error) {
+	var results []character.Model
+	for _, c := range f.characters {
+		if c.Id() > afterId {
+			results = append(results, c)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Id() < results[j].Id() })
+	if len(results) > size {
+		results = results[:size]
+	}
+	return results, nil
+}
+
+func testCharacter(id uint32, accountId uint32) character.Model {
+	c, _ := character.Extract(character.RestModel{Id: id, AccountId: accountId, WorldId: 1, Level: 50})
+	return c
+}
+
+func testDatabase(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	l, _ := test.NewNullLogger()
+	database.RegisterTenantCallbacks(l, db)
+	for _, m := range []func(*gorm.DB) error{Migration, note.Migration, attachment.Migration, outbox.Migration} {
+		if err := m(db); err != nil {
+			t.Fatalf("Failed to migrate database: %v", err)
+		}
+	}
+	return db
+}
+
+func testProcessor(t *testing.T, characters ...character.Model) *ProcessorImpl {
+	l, _ := test.NewNullLogger()
+	te, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   tenant.WithContext(context.Background(), te),
+		db:    testDatabase(t),
+		t:     te,
+		charP: &fakeCharacterProcessor{characters: characters},
+	}
+}
+
+func testCampaign(t *testing.T, p *ProcessorImpl, key string, scope ClaimScope, target Target) Model {
+	t.Helper()
+	m, err := NewBuilder(key).
+		SetTarget(target).
+		SetClaimScope(scope).
+		SetSenderId(9000000).
+		SetMessage("Sorry for the downtime!").
+		SetMesos(10000).
+		SetItems([]Item{{TemplateId: 2000005, Quantity: 10}}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	m, created, err := p.Create(m)
+	if err != nil || !created {
+		t.Fatalf("Create: created=%v err=%v", created, err)
+	}
+	return m
+}
+
+func notesOf(t *testing.T, p *ProcessorImpl, characterId uint32) int {
+	t.Helper()
+	var n int64
+	if err := p.db.WithContext(p.ctx).Model(&note.Entity{}).Where("character_id = ?", characterId).Count(&n).Error; err != nil {
+		t.Fatalf("count notes: %v", err)
+	}
+	return int(n)
+}
+
+func TestCreateIsIdempotentByKey(t *testing.T) {
+	p := testProcessor(t)
+	first := testCampaign(t, p, "outage-2026-10-18", ClaimScopeCharacter, Target{})
+
+	again, err := NewBuilder("outage-2026-10-18").SetSenderId(1).SetMessage("different").Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	got, created, err := p.Create(again)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created {
+		t.Fatal("a repeated key must not create a second campaign")
+	}
+	if got.Id() != first.Id() || got.Message() != first.Message() {
+		t.Fatalf("a repeated key must return the original campaign, got %+v", got)
+	}
+}
+
+func TestDeliverMailsEachTargetOnceWithRewards(t *testing.T) {
+	p := testProcessor(t, testCharacter(3, 30), testCharacter(1, 10), testCharacter(2, 20))
+	m := testCampaign(t, p, "outage", ClaimScopeCharacter, Target{})
+
+	n, err := p.Deliver(m, 1000)
+	if err != nil || n != 3 {
+		t.Fatalf("Deliver: mailed=%d err=%v", n, err)
+	}
+	for _, id := range []uint32{1, 2, 3} {
+		if got := notesOf(t, p, id); got != 1 {
+			t.Fatalf("character %d has %d notes, want 1", id, got)
+		}
+	}
+	var minted int64
+	p.db.WithContext(p.ctx).Model(&attachment.Entity{}).Where("minted = ?", true).Count(&minted)
+	if minted != 6 {
+		t.Fatalf("minted %d attachments, want an item and mesos for each of 3 recipients", minted)
+	}
+
+	got, err := p.ByIdProvider(m.Id())()
+	if err != nil {
+		t.Fatalf("ByIdProvider: %v", err)
+	}
+	if got.Status() != StatusCompleted || got.Cursor() != 3 || got.CompletedAt() == nil {
+		t.Fatalf("campaign not completed: status=%s cursor=%d", got.Status(), got.Cursor())
+	}
+
+	// A stale replica replaying the original cursor mails no one again.
+	n, err = p.Deliver(m, 1000)
+	if err != nil || n != 0 {
+		t.Fatalf("replayed Deliver: mailed=%d err=%v", n, err)
+	}
+	if got := notesOf(t, p, 1); got != 1 {
+		t.Fatalf("replay mailed character 1 again: %d notes", got)
+	}
+}
+
+func TestDeliverResumesFromCursor(t *testing.T) {
+	var cs []character.Model
+	for id := uint32(1); id <= targetPageSize+5; id++ {
+		cs = append(cs, testCharacter(id, id))
+	}
+	p := testProcessor(t, cs...)
+	m := testCampaign(t, p, "outage", ClaimScopeCharacter, Target{})
+
+	n, err := p.Deliver(m, 1)
+	if err != nil || n != targetPageSize {
+		t.Fatalf("first Deliver: mailed=%d err=%v", n, err)
+	}
+	m, _ = p.ByIdProvider(m.Id())()
+	if m.Status() != StatusDelivering || m.Cursor() != targetPageSize {
+		t.Fatalf("after first pass: status=%s cursor=%d", m.Status(), m.Cursor())
+	}
+
+	n, err = p.Deliver(m, 1000)
+	if err != nil || n != 5 {
+		t.Fatalf("second Deliver: mailed=%d err=%v", n, err)
+	}
+}
+
+func TestAccountScopeMailsOneCharacterPerAccount(t *testing.T) {
+	p := testProcessor(t, testCharacter(1, 10), testCharacter(2, 10), testCharacter(3, 20))
+	m := testCampaign(t, p, "outage", ClaimScopeAccount, Target{})
+
+	n, err := p.Deliver(m, 1000)
+	if err != nil || n != 2 {
+		t.Fatalf("Deliver: mailed=%d err=%v", n, err)
+	}
+	if notesOf(t, p, 1) != 1 || notesOf(t, p, 2) != 0 || notesOf(t, p, 3) != 1 {
+		t.Fatal("account scope must mail only the first character of each account")
+	}
+}
+
+func TestExplicitListSkipsMissingCharacters(t *testing.T) {
+	p := testProcessor(t, testCharacter(5, 50), testCharacter(7, 70), testCharacter(9, 90))
+	m := testCampaign(t, p, "outage", ClaimScopeCharacter, Target{CharacterIds: []uint32{9, 6, 5, 5}})
+
+	n, err := p.Deliver(m, 1000)
+	if err != nil || n != 2 {
+		t.Fatalf("Deliver: mailed=%d err=%v", n, err)
+	}
+	if notesOf(t, p, 5) != 1 || notesOf(t, p, 7) != 0 || notesOf(t, p, 9) != 1 {
+		t.Fatal("an explicit list must mail exactly its listed, existing characters")
+	}
+}
+
+func TestStatsCountDeliveredAndClaimed(t *testing.T) {
+	p := testProcessor(t, testCharacter(1, 10), testCharacter(2, 20))
+	m := testCampaign(t, p, "outage", ClaimScopeCharacter, Target{})
+	if _, err := p.Deliver(m, 1000); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+
+	// Character 1 claims: its attachments are released with no return latch.
+	db := p.db.WithContext(p.ctx)
+	if err := db.Where("recipient_id = ?", 1).Delete(&attachment.Entity{}).Error; err != nil {
+		t.Fatalf("release: %v", err)
+	}
+
+	s, err := p.StatsById(m.Id())
+	if err != nil {
+		t.Fatalf("StatsById: %v", err)
+	}
+	if s.Delivered != 2 || s.Claimed != 1 {
+		t.Fatalf("stats = %+v, want 2 delivered and 1 claimed", s)
+	}
+}
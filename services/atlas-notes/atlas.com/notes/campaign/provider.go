@@ -0,0 +1,69 @@
+package campaign
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func getByIdProvider(id uuid.UUID) database.EntityProvider[entity] {
+	return func(db *gorm.DB) model.Provider[entity] {
+		var e entity
+		err := db.Where("id = ?", id).First(&e).Error
+		if err != nil {
+			return model.ErrorProvider[entity](err)
+		}
+		return model.FixedProvider(e)
+	}
+}
+
+func getByKeyProvider(key string) database.EntityProvider[entity] {
+	return func(db *gorm.DB) model.Provider[entity] {
+		var e entity
+		err := db.Where("idempotency_key = ?", key).First(&e).Error
+		if err != nil {
+			return model.ErrorProvider[entity](err)
+		}
+		return model.FixedProvider(e)
+	}
+}
+
+func getAllInTenantProvider(page model.Page) database.EntityProvider[model.Paged[entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[entity]] {
+		return database.PagedQuery[entity](db, page)
+	}
+}
+
+// getDeliveringProvider returns up to limit campaigns still delivering, oldest
+// first. The delivery pass runs it under database.WithoutTenantFilter.
+func getDeliveringProvider(limit int) database.EntityProvider[[]entity] {
+	return func(db *gorm.DB) model.Provider[[]entity] {
+		var entities []entity
+		err := db.Where("status = ?", string(StatusDelivering)).
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&entities).Error
+		if err != nil {
+			return model.ErrorProvider[[]entity](err)
+		}
+		return model.FixedProvider(entities)
+	}
+}
+
+// countDelivered counts the recipients a campaign has mailed.
+func countDelivered(db *gorm.DB, campaignId uuid.UUID) (int64, error) {
+	var n int64
+	err := db.Model(&deliveryEntity{}).Where("campaign_id = ?", campaignId).Count(&n).Error
+	return n, err
+}
+
+// countClaimed counts the recipients who claimed their campaign reward.
+func countClaimed(db *gorm.DB, campaignId uuid.UUID, claimed *gorm.DB) (int64, error) {
+	var n int64
+	err := db.Model(&deliveryEntity{}).
+		Where("campaign_id = ? AND note_id <> ? AND note_id IN (?)", campaignId, 0, claimed).
+		Count(&n).Error
+	return n, err
+}
@@ -0,0 +1,159 @@
+package campaign
+
+import (
+	"atlas-notes/attachment"
+	"atlas-notes/rest"
+	"errors"
+	"math"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
+)
+
+// InitializeRoutes registers the campaign routes. They must be registered
+// before the note routes, whose GET /notes/{noteId} would otherwise match
+// /notes/campaigns.
+func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerHandler := rest.RegisterHandler(l)(db)(si)
+			registerInputHandler := rest.RegisterInputHandler[RestModel](l)(db)(si)
+
+			// Start (or look up by key) a campaign
+			router.HandleFunc("/notes/campaigns", registerInputHandler("create_note_campaign", CreateCampaignHandler)).Methods(http.MethodPost)
+
+			// List campaigns
+			router.HandleFunc("/notes/campaigns", registerHandler("get_note_campaigns", GetCampaignsHandler)).Methods(http.MethodGet)
+
+			// A campaign with its delivery counters
+			router.HandleFunc(
+				"/notes/campaigns/{"+campaignIdPattern+"}",
+				registerHandler("get_note_campaign", GetCampaignHandler),
+			).Methods(http.MethodGet)
+		}
+	}
+}
+
+// CreateCampaignHandler handles POST /api/notes/campaigns. A new campaign is
+// 201 Created; a key that already names a campaign returns that campaign with
+// 200 OK and changes nothing.
+func CreateCampaignHandler(d *rest.HandlerDependency, c *rest.HandlerContext, i RestModel) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		im, err := Extract(i)
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, err.Error())
+			return
+		}
+		if len(im.Items()) > attachment.MaxItemsPerNote {
+			server.WriteBadRequest(d.Logger(), w, attachment.ErrTooManyItems.Error())
+			return
+		}
+		if im.Mesos() > math.MaxInt32 || im.Currency() > math.MaxInt32 {
+			server.WriteBadRequest(d.Logger(), w, attachment.ErrMesosOverflow.Error())
+			return
+		}
+
+		p := NewProcessor(d.Logger(), d.Context(), d.DB())
+		m, created, err := p.Create(im)
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to create note campaign [%s].", im.Key())
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+		s, err := p.StatsById(m.Id())
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to count deliveries of note campaign [%s].", m.Id())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rm, err := TransformWithStats(m, s)
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		if created {
+			w.WriteHeader(http.StatusCreated)
+		}
+		query := r.URL.Query()
+		queryParams := jsonapi.ParseQueryFields(&query)
+		server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+	}
+}
+
+// GetCampaignsHandler handles GET /api/notes/campaigns
+func GetCampaignsHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, err := paginate.ParseParams(r.URL.Query(), paginate.DefaultPageSize, paginate.MaxPageSize)
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+			return
+		}
+
+		paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).AllProvider(page)()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to locate note campaigns.")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		rm, err := model.SliceMap(Transform)(model.FixedProvider(paged.Items))(model.ParallelMap())()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		query := r.URL.Query()
+		queryParams := jsonapi.ParseQueryFields(&query)
+		server.MarshalPaginatedResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm, paginate.EnvelopeFor(paged), r)
+	}
+}
+
+// GetCampaignHandler handles GET /api/notes/campaigns/{campaignId}
+func GetCampaignHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(mux.Vars(r)[campaignIdPattern])
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, "invalid campaignId")
+			return
+		}
+
+		p := NewProcessor(d.Logger(), d.Context(), d.DB())
+		m, err := p.ByIdProvider(id)()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to locate note campaign [%s].", id)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s, err := p.StatsById(id)
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to count deliveries of note campaign [%s].", id)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rm, err := TransformWithStats(m, s)
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		query := r.URL.Query()
+		queryParams := jsonapi.ParseQueryFields(&query)
+		server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+	}
+}
@@ -0,0 +1,139 @@
+package campaign
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const campaignIdPattern = "campaignId"
+
+// RestModel is the JSON:API resource for note campaigns. Status, Delivered,
+// Claimed, CreatedAt and CompletedAt are read-only.
+type RestModel struct {
+	Id           string          `json:"-"`
+	Key          string          `json:"key"`
+	Name         string          `json:"name"`
+	WorldId      *byte           `json:"worldId,omitempty"`
+	MinLevel     byte            `json:"minLevel,omitempty"`
+	MaxLevel     byte            `json:"maxLevel,omitempty"`
+	ActiveFrom   *time.Time      `json:"activeFrom,omitempty"`
+	ActiveTo     *time.Time      `json:"activeTo,omitempty"`
+	CharacterIds []uint32        `json:"characterIds,omitempty"`
+	ClaimScope   string          `json:"claimScope"`
+	SenderId     uint32          `json:"senderId"`
+	Message      string          `json:"message"`
+	Flag         byte            `json:"flag"`
+	Mesos        uint32          `json:"mesos"`
+	Currency     uint32          `json:"currency"`
+	CurrencyType uint32          `json:"currencyType"`
+	Items        []ItemRestModel `json:"items"`
+	Status       string          `json:"status"`
+	Delivered    int64           `json:"delivered"`
+	Claimed      int64           `json:"claimed"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	CompletedAt  *time.Time      `json:"completedAt,omitempty"`
+}
+
+// ItemRestModel names one item every campaign recipient receives
+type ItemRestModel struct {
+	TemplateId uint32 `json:"templateId"`
+	Quantity   uint32 `json:"quantity"`
+}
+
+// GetID returns the resource ID
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+// SetID sets the resource ID
+func (r *RestModel) SetID(strId string) error {
+	r.Id = strId
+	return nil
+}
+
+// GetName returns the resource name
+func (r RestModel) GetName() string {
+	return "note-campaigns"
+}
+
+// Transform converts a Model domain model to a RestModel without counters
+func Transform(m Model) (RestModel, error) {
+	t := m.Target()
+	var worldId *byte
+	if t.WorldId != nil {
+		w := byte(*t.WorldId)
+		worldId = &w
+	}
+	items := make([]ItemRestModel, 0, len(m.Items()))
+	for _, i := range m.Items() {
+		items = append(items, ItemRestModel{TemplateId: i.TemplateId, Quantity: i.Quantity})
+	}
+	return RestModel{
+		Id:           m.Id().String(),
+		Key:          m.Key(),
+		Name:         m.Name(),
+		WorldId:      worldId,
+		MinLevel:     t.MinLevel,
+		MaxLevel:     t.MaxLevel,
+		ActiveFrom:   t.ActiveFrom,
+		ActiveTo:     t.ActiveTo,
+		CharacterIds: t.CharacterIds,
+		ClaimScope:   string(m.ClaimScope()),
+		SenderId:     m.SenderId(),
+		Message:      m.Message(),
+		Flag:         m.Flag(),
+		Mesos:        m.Mesos(),
+		Currency:     m.Currency(),
+		CurrencyType: m.CurrencyType(),
+		Items:        items,
+		Status:       string(m.Status()),
+		CreatedAt:    m.CreatedAt(),
+		CompletedAt:  m.CompletedAt(),
+	}, nil
+}
+
+// TransformWithStats converts a Model and its delivery counters to a RestModel
+func TransformWithStats(m Model, s Stats) (RestModel, error) {
+	rm, err := Transform(m)
+	if err != nil {
+		return RestModel{}, err
+	}
+	rm.Delivered = s.Delivered
+	rm.Claimed = s.Claimed
+	return rm, nil
+}
+
+// Extract converts a RestModel to a new campaign Model. An empty claim scope
+// defaults to per-character.
+func Extract(r RestModel) (Model, error) {
+	var worldId *world.Id
+	if r.WorldId != nil {
+		w := world.Id(*r.WorldId)
+		worldId = &w
+	}
+	items := make([]Item, 0, len(r.Items))
+	for _, i := range r.Items {
+		items = append(items, Item{TemplateId: i.TemplateId, Quantity: i.Quantity})
+	}
+	b := NewBuilder(r.Key).
+		SetName(r.Name).
+		SetTarget(Target{
+			WorldId:      worldId,
+			MinLevel:     r.MinLevel,
+			MaxLevel:     r.MaxLevel,
+			ActiveFrom:   r.ActiveFrom,
+			ActiveTo:     r.ActiveTo,
+			CharacterIds: r.CharacterIds,
+		}).
+		SetSenderId(r.SenderId).
+		SetMessage(r.Message).
+		SetFlag(r.Flag).
+		SetMesos(r.Mesos).
+		SetCurrency(r.Currency, r.CurrencyType).
+		SetItems(items)
+	if r.ClaimScope != "" {
+		b.SetClaimScope(ClaimScope(r.ClaimScope))
+	}
+	return b.Build()
+}
@@ -0,0 +1,33 @@
+package character
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Model struct {
+	id        uint32
+	accountId uint32
+	worldId   world.Id
+	name      string
+	level     byte
+}
+
+func (m Model) Id() uint32 {
+	return m.id
+}
+
+func (m Model) AccountId() uint32 {
+	return m.accountId
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) Name() string {
+	return m.name
+}
+
+func (m Model) Level() byte {
+	return m.level
+}
@@ -0,0 +1,59 @@
+package character
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+// Filter narrows the characters a campaign targets. It mirrors the targeting
+// query parameters of atlas-character's GET /characters.
+type Filter struct {
+	WorldId    *world.Id
+	MinLevel   byte
+	MaxLevel   byte
+	ActiveFrom *time.Time
+	ActiveTo   *time.Time
+}
+
+type Processor interface {
+	GetById(characterId uint32) (Model, error)
+	// TargetsAfter lists up to size characters matching f whose id is greater
+	// than afterId, in id order.
+	TargetsAfter(f Filter, afterId uint32, size int) ([]Model, error)
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) GetById(characterId uint32) (Model, error) {
+	return requests.Provider[RestModel, Model](p.l, p.ctx)(requestById(p.ctx, characterId), Extract)()
+}
+
+func (p *ProcessorImpl) TargetsAfter(f Filter, afterId uint32, size int) ([]Model, error) {
+	u, err := targetsUrl(p.ctx, f, afterId)
+	if err != nil {
+		return nil, err
+	}
+	paged, err := requests.PagedProvider[RestModel, Model](p.l, p.ctx)(u, model.Page{Number: 1, Size: size}, Extract)()
+	if err != nil {
+		return nil, err
+	}
+	return paged.Items, nil
+}
@@ -0,0 +1,60 @@
+package character
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const (
+	Resource = "characters"
+	ById     = Resource + "/%d"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "CHARACTERS")
+}
+
+func requestById(ctx context.Context, id uint32) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.GetRequest[RestModel](fmt.Sprintf(root+ById, id))
+}
+
+// targetsUrl renders the atlas-character targeting filter as query parameters.
+func targetsUrl(ctx context.Context, f Filter, afterId uint32) (string, error) {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	if f.WorldId != nil {
+		q.Set("worldId", strconv.Itoa(int(*f.WorldId)))
+	}
+	if f.MinLevel > 0 {
+		q.Set("minLevel", strconv.Itoa(int(f.MinLevel)))
+	}
+	if f.MaxLevel > 0 {
+		q.Set("maxLevel", strconv.Itoa(int(f.MaxLevel)))
+	}
+	if f.ActiveFrom != nil {
+		q.Set("activeFrom", f.ActiveFrom.UTC().Format(time.RFC3339))
+	}
+	if f.ActiveTo != nil {
+		q.Set("activeTo", f.ActiveTo.UTC().Format(time.RFC3339))
+	}
+	if afterId > 0 {
+		q.Set("afterId", strconv.FormatUint(uint64(afterId), 10))
+	}
+	u := root + Resource
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return u, nil
+}
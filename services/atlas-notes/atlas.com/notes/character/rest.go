@@ -0,0 +1,81 @@
+package character
+
+import (
+	"strconv"
+
+	"github.com/jtumidanski/api2go/jsonapi"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// RestModel is the slice of atlas-character's character resource campaign
+// targeting reads.
+type RestModel struct {
+	Id        uint32 `json:"-"`
+	AccountId uint32 `json:"accountId"`
+	WorldId   byte   `json:"worldId"`
+	Name      string `json:"name"`
+	Level     byte   `json:"level"`
+}
+
+func (r *RestModel) GetName() string {
+	return "characters"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(idStr string) error {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+func (r RestModel) GetReferences() []jsonapi.Reference {
+	return []jsonapi.Reference{
+		{
+			Type: "equipment",
+			Name: "equipment",
+		},
+		{
+			Type: "inventories",
+			Name: "inventories",
+		},
+	}
+}
+
+func (r RestModel) GetReferencedIDs() []jsonapi.ReferenceID {
+	var result []jsonapi.ReferenceID
+	return result
+}
+
+func (r RestModel) GetReferencedStructs() []jsonapi.MarshalIdentifier {
+	var result []jsonapi.MarshalIdentifier
+	return result
+}
+
+func (r *RestModel) SetToOneReferenceID(_, _ string) error {
+	return nil
+}
+
+func (r *RestModel) SetToManyReferenceIDs(_ string, _ []string) error {
+	return nil
+}
+
+func (r *RestModel) SetReferencedStructs(_ map[string]map[string]jsonapi.Data) error {
+	return nil
+}
+
+func Extract(rm RestModel) (Model, error) {
+	return Model{
+		id:        rm.Id,
+		accountId: rm.AccountId,
+		worldId:   world.Id(rm.WorldId),
+		name:      rm.Name,
+		level:     rm.Level,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package note
 
 import (
+	"atlas-notes/attachment"
 	consumer2 "atlas-notes/kafka/consumer"
 	note2 "atlas-notes/kafka/message/note"
 	"atlas-notes/note"
@@ -37,6 +38,12 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleNoteDiscard(db)))); err != nil {
 				return err
 			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleNoteSend(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleNoteClaim(db)))); err != nil {
+				return err
+			}
 			return nil
 		}
 	}
@@ -70,3 +77,33 @@ func handleNoteDiscard(db *gorm.DB) message.Handler[note2.Command[note2.CommandD
 		_ = note.NewProcessor(l, ctx, db).DiscardAndEmit(ch, c.CharacterId, c.Body.NoteIds)
 	}
 }
+
+func handleNoteSend(db *gorm.DB) message.Handler[note2.Command[note2.CommandSendBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c note2.Command[note2.CommandSendBody]) {
+		if c.Type != note2.CommandTypeSend {
+			return
+		}
+
+		items := make([]attachment.Item, 0, len(c.Body.Items))
+		for _, i := range c.Body.Items {
+			items = append(items, attachment.Item{InventoryType: i.InventoryType, AssetId: i.AssetId, Quantity: i.Quantity})
+		}
+		ch := channel.NewModel(c.WorldId, c.ChannelId)
+		if _, err := attachment.NewProcessor(l, ctx, db).Send(ch, c.CharacterId, c.Body.RecipientId, c.Body.Message, items, c.Body.Mesos); err != nil {
+			l.WithError(err).Errorf("Unable to send note with attachments from character [%d] to [%d].", c.CharacterId, c.Body.RecipientId)
+		}
+	}
+}
+
+func handleNoteClaim(db *gorm.DB) message.Handler[note2.Command[note2.CommandClaimBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c note2.Command[note2.CommandClaimBody]) {
+		if c.Type != note2.CommandTypeClaim {
+			return
+		}
+
+		ch := channel.NewModel(c.WorldId, c.ChannelId)
+		if _, err := attachment.NewProcessor(l, ctx, db).Claim(ch, c.CharacterId, c.Body.NoteId); err != nil {
+			l.WithError(err).Errorf("Unable to claim attachments of note [%d] for character [%d].", c.Body.NoteId, c.CharacterId)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package custody consumes COMMAND_TOPIC_NOTE_CUSTODY, the
+// atlas-saga-orchestrator -> atlas-notes attachment custody stream.
+//
+// Every handler registered on the topic discriminates on Command.Type BEFORE it
+// touches Body; a handler that skipped the guard would unmarshal another
+// command's body into its own shape and act on the nil attachment id.
+package custody
+
+import (
+	"atlas-notes/attachment"
+	consumer2 "atlas-notes/kafka/consumer"
+	custodymsg "atlas-notes/kafka/message/note/custody"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("note_custody_command")(custodymsg.EnvCommandTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(db *gorm.DB) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+			var t string
+			t, _ = topic.EnvProvider(l)(custodymsg.EnvCommandTopic)()
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAccept(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRelease(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRestore(db)))); err != nil {
+				return err
+			}
+			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRemove(db)))); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// emitError reports a failed custody write. The write's transaction rolled back
+// and took its buffered ack with it, so the ERROR ack goes out directly — the
+// orchestrator must hear something, or the step waits out the saga timeout.
+func emitError(l logrus.FieldLogger, ctx context.Context, transactionId uuid.UUID, attachmentId uuid.UUID, err error) {
+	emitErr := producer.ProviderImpl(l)(ctx)(custodymsg.EnvStatusEventTopic)(attachment.ErrorStatusProvider(transactionId, attachmentId, err.Error()))
+	if emitErr != nil {
+		l.WithError(emitErr).Errorf("Unable to emit note custody ERROR for attachment [%s] transaction [%s].", attachmentId, transactionId)
+	}
+}
+
+// handleAccept writes the escrow row for an attachment. For an item the asset
+// has already left the sender's compartment, so a failure here is not cosmetic —
+// the ERROR ack fails the saga, whose reverse walk re-grants the item.
+func handleAccept(db *gorm.DB) message.Handler[custodymsg.Command[custodymsg.AcceptToNoteCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c custodymsg.Command[custodymsg.AcceptToNoteCommandBody]) {
+		if c.Type != custodymsg.CommandAcceptToNote {
+			return
+		}
+		b := c.Body
+		m := attachment.NewBuilder(b.AttachmentId, b.SenderId, b.RecipientId).
+			SetTransactionId(c.TransactionId).
+			SetWorldId(b.WorldId).
+			SetSource(b.SourceInventoryType, b.AssetId).
+			SetMesos(b.Mesos).
+			SetSnapshot(b.Snapshot).
+			Build()
+		if err := attachment.NewProcessor(l, ctx, db).AcceptAndEmit(c.TransactionId, m); err != nil {
+			l.WithError(err).Errorf("Unable to accept note attachment [%s] from character [%d].", b.AttachmentId, b.SenderId)
+			emitError(l, ctx, c.TransactionId, b.AttachmentId, err)
+		}
+	}
+}
+
+func handleRelease(db *gorm.DB) message.Handler[custodymsg.Command[custodymsg.ReleaseFromNoteCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c custodymsg.Command[custodymsg.ReleaseFromNoteCommandBody]) {
+		if c.Type != custodymsg.CommandReleaseFromNote {
+			return
+		}
+		if err := attachment.NewProcessor(l, ctx, db).ReleaseAndEmit(c.TransactionId, c.Body.AttachmentId); err != nil {
+			l.WithError(err).Errorf("Unable to release note attachment [%s].", c.Body.AttachmentId)
+			emitError(l, ctx, c.TransactionId, c.Body.AttachmentId, err)
+		}
+	}
+}
+
+func handleRestore(db *gorm.DB) message.Handler[custodymsg.Command[custodymsg.RestoreNoteAttachmentCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c custodymsg.Command[custodymsg.RestoreNoteAttachmentCommandBody]) {
+		if c.Type != custodymsg.CommandRestoreNoteAttachment {
+			return
+		}
+		if err := attachment.NewProcessor(l, ctx, db).RestoreAndEmit(c.TransactionId, c.Body.AttachmentId); err != nil {
+			l.WithError(err).Errorf("Unable to restore note attachment [%s].", c.Body.AttachmentId)
+			emitError(l, ctx, c.TransactionId, c.Body.AttachmentId, err)
+		}
+	}
+}
+
+func handleRemove(db *gorm.DB) message.Handler[custodymsg.Command[custodymsg.RemoveNoteAttachmentCommandBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, c custodymsg.Command[custodymsg.RemoveNoteAttachmentCommandBody]) {
+		if c.Type != custodymsg.CommandRemoveNoteAttachment {
+			return
+		}
+		if err := attachment.NewProcessor(l, ctx, db).RemoveAndEmit(c.TransactionId, c.Body.AttachmentId); err != nil {
+			l.WithError(err).Errorf("Unable to remove note attachment [%s].", c.Body.AttachmentId)
+			emitError(l, ctx, c.TransactionId, c.Body.AttachmentId, err)
+		}
+	}
+}
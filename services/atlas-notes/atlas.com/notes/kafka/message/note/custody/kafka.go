@@ -0,0 +1,120 @@
+// Package custody owns the COMMAND_TOPIC_NOTE_CUSTODY /
+// EVENT_TOPIC_NOTE_CUSTODY_STATUS contract — the note-attachment limb of the
+// accept/release custody family.
+//
+// atlas-notes OWNS this contract; atlas-saga-orchestrator carries a mirror at
+// services/atlas-saga-orchestrator/atlas.com/saga-orchestrator/kafka/message/note/custody/kafka.go
+// because the two services live in separate Go modules and nothing in the
+// compiler links them. tools/note-custody-contract-mirror-guard.sh checks the
+// pair.
+package custody
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
+)
+
+const (
+	// EnvCommandTopic is the env var naming the note custody command topic.
+	EnvCommandTopic = "COMMAND_TOPIC_NOTE_CUSTODY"
+
+	CommandAcceptToNote    = "ACCEPT_TO_NOTE"
+	CommandReleaseFromNote = "RELEASE_FROM_NOTE"
+	// CommandRestoreNoteAttachment un-releases an attachment row (the
+	// reverse-walk inverse of ReleaseFromNote).
+	CommandRestoreNoteAttachment = "RESTORE_NOTE_ATTACHMENT"
+	// CommandRemoveNoteAttachment hard-deletes an attachment row whose item or
+	// mesos has already been handed back (the reverse-walk inverse of
+	// AcceptToNote).
+	CommandRemoveNoteAttachment = "REMOVE_NOTE_ATTACHMENT"
+)
+
+// Command is the generic custody command envelope. TransactionId keys the saga
+// step; Type discriminates which body is carried.
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+// AcceptToNoteCommandBody carries every field needed to CREATE an attachment
+// row. An item attachment carries the snapshot; a meso attachment carries
+// Mesos and a zero snapshot.
+type AcceptToNoteCommandBody struct {
+	AttachmentId        uuid.UUID `json:"attachmentId"`
+	SenderId            uint32    `json:"senderId"`
+	RecipientId         uint32    `json:"recipientId"`
+	WorldId             world.Id  `json:"worldId"`
+	SourceInventoryType byte      `json:"sourceInventoryType"`
+	AssetId             uint32    `json:"assetId"`
+	Mesos               uint32    `json:"mesos"`
+
+	Snapshot sharedsaga.AssetSnapshot `json:"snapshot"`
+}
+
+// ReleaseFromNoteCommandBody releases the attachment row. The release is a
+// compare-and-set on the row still being escrowed, so of two claims (or a
+// claim racing an expiry return) exactly one is acked.
+type ReleaseFromNoteCommandBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// RestoreNoteAttachmentCommandBody returns a released row to escrow by id.
+type RestoreNoteAttachmentCommandBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// RemoveNoteAttachmentCommandBody hard-deletes an attachment row by id.
+type RemoveNoteAttachmentCommandBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+const (
+	// EnvStatusEventTopic names the note custody status (ack) topic.
+	EnvStatusEventTopic = "EVENT_TOPIC_NOTE_CUSTODY_STATUS"
+
+	StatusEventTypeAccepted = "ACCEPTED"
+	StatusEventTypeReleased = "RELEASED"
+	// StatusEventTypeRestored and StatusEventTypeRemoved ack the reverse-walk
+	// inverses. Both are dispatched while their saga is already terminating,
+	// so neither is routed into step completion.
+	StatusEventTypeRestored = "RESTORED"
+	StatusEventTypeRemoved  = "REMOVED"
+	StatusEventTypeError    = "ERROR"
+)
+
+// StatusEvent is the generic custody ack envelope. TransactionId echoes the
+// command so the orchestrator can complete/fail the saga step.
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+// StatusEventAcceptedBody acks attachment escrow, echoing the attachment id.
+type StatusEventAcceptedBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// StatusEventReleasedBody acks an attachment release.
+type StatusEventReleasedBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// StatusEventRestoredBody acks an attachment restore.
+type StatusEventRestoredBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// StatusEventRemovedBody acks an attachment hard delete.
+type StatusEventRemovedBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+// StatusEventErrorBody reports a custody failure with a human-readable reason.
+type StatusEventErrorBody struct {
+	AttachmentId uuid.UUID `json:"attachmentId"`
+	Error        string    `json:"error"`
+}
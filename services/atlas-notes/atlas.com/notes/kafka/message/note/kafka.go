@@ -15,6 +15,12 @@ const (
 
 	CommandTypeCreate  = "CREATE"
 	CommandTypeDiscard = "DISCARD"
+	// CommandTypeSend sends a note carrying items and/or mesos from
+	// Command.CharacterId. Plain notes keep using the channel's note_send saga.
+	CommandTypeSend = "SEND"
+	// CommandTypeClaim delivers a note's escrowed attachments to
+	// Command.CharacterId.
+	CommandTypeClaim = "CLAIM"
 
 	StatusEventTypeCreated      = "CREATED"
 	StatusEventTypeUpdated      = "UPDATED"
@@ -44,6 +50,26 @@ type CommandDiscardBody struct {
 	NoteIds []uint32 `json:"noteIds"`
 }
 
+// CommandSendBody contains data for sending a note with attachments
+type CommandSendBody struct {
+	RecipientId uint32                `json:"recipientId"`
+	Message     string                `json:"message"`
+	Mesos       uint32                `json:"mesos"`
+	Items       []CommandSendItemBody `json:"items"`
+}
+
+// CommandSendItemBody names one asset attached to a sent note
+type CommandSendItemBody struct {
+	InventoryType byte   `json:"inventoryType"`
+	AssetId       uint32 `json:"assetId"`
+	Quantity      uint32 `json:"quantity"`
+}
+
+// CommandClaimBody contains data for claiming a note's attachments
+type CommandClaimBody struct {
+	NoteId uint32 `json:"noteId"`
+}
+
 // StatusEvent represents a Kafka status event for note operations
 type StatusEvent[E any] struct {
 	TransactionId uuid.UUID `json:"transactionId,omitempty"` // Saga transaction id (uuid.Nil when not saga-driven)
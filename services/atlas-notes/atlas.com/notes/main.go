@@ -1,11 +1,17 @@
 package main
 
 import (
+	"atlas-notes/attachment"
+	"atlas-notes/campaign"
 	"atlas-notes/kafka/consumer/character"
 	note_consumer "atlas-notes/kafka/consumer/note"
+	note_custody "atlas-notes/kafka/consumer/note/custody"
 	"atlas-notes/note"
+	"atlas-notes/task"
 	"context"
 	"os"
+	"strconv"
+	"time"
 
 	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
 	service "github.com/Chronicle20/atlas/libs/atlas-service"
@@ -47,7 +53,7 @@ func main() {
 	l := rt.Logger()
 
 	// Connect to the database
-	db := database.Connect(l, database.SetMigrations(note.Migration, outboxlib.Migration))
+	db := database.Connect(l, database.SetMigrations(note.Migration, attachment.Migration, campaign.Migration, outboxlib.Migration))
 
 	// Boot the outbox drainer: publishes the transactional outbox to Kafka.
 	// Leadership is gated by a postgres advisory lock — replicas are safe.
@@ -72,12 +78,20 @@ func main() {
 	cmf := consumer.GetManager().AddConsumer(l, rt.Context(), rt.WaitGroup())
 	character.InitConsumers(l)(cmf)(consumerGroupId)
 	note_consumer.InitConsumers(l)(cmf)(consumerGroupId)
+	note_custody.InitConsumers(l)(cmf)(consumerGroupId)
 	if err := character.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
 	if err := note_consumer.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := note_custody.InitHandlers(l)(db)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+
+	expiryTask := task.NewPeriodicTask(l, rt.Context(), db, getExpiryInterval())
+	expiryTask.Start()
+	rt.TeardownFunc(expiryTask.Stop)
 
 	rt.TeardownFunc(func() { _ = producer.GetManager().Close(l) })
 
@@ -86,10 +100,26 @@ func main() {
 		WithWaitGroup(rt.WaitGroup()).
 		SetBasePath(GetServer().GetPrefix()).
 		SetPort(os.Getenv("REST_PORT")).
+		AddRouteInitializer(campaign.InitializeRoutes(GetServer())(db)).
 		AddRouteInitializer(note.InitializeRoutes(GetServer())(db)).
+		AddRouteInitializer(attachment.InitializeRoutes(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
 
 	rt.Wait()
 }
+
+// getExpiryInterval reads the attachment expiry sweep cadence from
+// EXPIRATION_CHECK_INTERVAL_SECONDS, falling back to 60s when unset or invalid.
+func getExpiryInterval() time.Duration {
+	intervalStr := os.Getenv("EXPIRATION_CHECK_INTERVAL_SECONDS")
+	if intervalStr == "" {
+		return 60 * time.Second
+	}
+	seconds, err := strconv.Atoi(intervalStr)
+	if err != nil || seconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -1,6 +1,7 @@
 package note
 
 import (
+	"atlas-notes/attachment"
 	"atlas-notes/kafka/message"
 	"atlas-notes/kafka/message/note"
 	"atlas-notes/saga"
@@ -12,6 +13,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	database "github.com/Chronicle20/atlas/libs/atlas-database"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	outbox "github.com/Chronicle20/atlas/libs/atlas-outbox"
@@ -30,6 +32,7 @@ type Processor interface {
 	DeleteAllAndEmit(characterId uint32) error
 	Discard(mb *message.Buffer) func(ch channel.Model) func(characterId uint32) func(noteIds []uint32) ([]pendingFameAward, error)
 	DiscardAndEmit(ch channel.Model, characterId uint32, noteIds []uint32) error
+	RewardAndEmit(worldId world.Id, senderId uint32, recipientIds []uint32, msg string, flag byte, mesos uint32, items []attachment.RewardItem) ([]Model, error)
 	ByIdProvider(id uint32) model.Provider[Model]
 	ByCharacterProvider(characterId uint32, page model.Page) model.Provider[model.Paged[Model]]
 	AllProvider(page model.Page) model.Provider[model.Paged[Model]]
@@ -96,6 +99,13 @@ func (p *ProcessorImpl) Create(mb *message.Buffer) func(transactionId uuid.UUID)
 						if err != nil {
 							return Model{}, err
 						}
+						// A saga-driven create may be the tail of a send with
+						// attachments; bind whatever that saga escrowed to this note.
+						if transactionId != uuid.Nil {
+							if _, err = attachment.NewProcessor(p.l, p.ctx, p.db).Bind(transactionId, m.Id()); err != nil {
+								return Model{}, err
+							}
+						}
 						err = mb.Put(note.EnvEventTopicNoteStatus, CreateNoteStatusEventProvider(transactionId, m.CharacterId(), m.Id(), m.SenderId(), m.Message(), m.Flag(), m.Timestamp()))
 						if err != nil {
 							return Model{}, err
@@ -381,5 +391,47 @@ func (p *ProcessorImpl) DiscardAndEmit(ch channel.Model, characterId uint32, not
 	for _, pa := range pending {
 		p.fireFameAwardSaga(pa)
 	}
+	// Discarding a note must not forfeit what it carries. Any attachment still
+	// in escrow is claimed on the way out; Claim only picks up rows addressed to
+	// this character, so a note id that was skipped above claims nothing.
+	ap := attachment.NewProcessor(p.l, p.ctx, p.db)
+	for _, noteId := range noteIds {
+		if n, err := ap.Claim(ch, characterId, noteId); err != nil {
+			p.l.WithError(err).Errorf("Unable to claim attachments of discarded note [%d] for character [%d]. They remain claimable until they expire.", noteId, characterId)
+		} else if n > 0 {
+			p.l.Debugf("Claimed [%d] attachments of discarded note [%d] for character [%d].", n, noteId, characterId)
+		}
+	}
 	return nil
 }
+
+// RewardAndEmit sends reward mail: one note per recipient, each carrying the
+// same minted items and mesos. Notes and attachments are written in one
+// transaction, so a failure part-way leaves no recipient with a note that lost
+// its reward, and the created events only publish once everything committed.
+// Nothing is escrowed from anyone, so unlike a player send this needs no saga;
+// the recipient's claim is what delivers the goods.
+func (p *ProcessorImpl) RewardAndEmit(worldId world.Id, senderId uint32, recipientIds []uint32, msg string, flag byte, mesos uint32, items []attachment.RewardItem) ([]Model, error) {
+	var results []Model
+	txErr := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		tp := p.WithTransaction(tx)
+		ap := attachment.NewProcessor(p.l, p.ctx, tx)
+		return message.Emit(outbox.EmitProvider(p.l, p.ctx, tx))(func(mb *message.Buffer) error {
+			for _, recipientId := range recipientIds {
+				m, err := tp.Create(mb)(uuid.Nil)(recipientId)(senderId)(msg)(flag)
+				if err != nil {
+					return err
+				}
+				if _, err = ap.Mint(m.Id(), recipientId, worldId, attachment.Reward{Mesos: mesos, Items: items}); err != nil {
+					return err
+				}
+				results = append(results, m)
+			}
+			return nil
+		})
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+	return results, nil
+}
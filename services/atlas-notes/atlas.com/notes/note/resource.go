@@ -1,6 +1,7 @@
 package note
 
 import (
+	"atlas-notes/attachment"
 	"atlas-notes/rest"
 	"net/http"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
@@ -20,6 +22,7 @@ func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.Rou
 		return func(router *mux.Router, l logrus.FieldLogger) {
 			registerHandler := rest.RegisterHandler(l)(db)(si)
 			registerInputHandler := rest.RegisterInputHandler[RestModel](l)(db)(si)
+			registerRewardHandler := rest.RegisterInputHandler[RewardRestModel](l)(db)(si)
 
 			// ByIdProvider all notes
 			router.HandleFunc("/notes", registerHandler("get_all_notes", GetAllNotesHandler)).Methods(http.MethodGet)
@@ -39,6 +42,9 @@ func InitializeRoutes(si jsonapi.ServerInformation) func(db *gorm.DB) server.Rou
 			// Create a note
 			router.HandleFunc("/notes", registerInputHandler("create_note", CreateNoteHandler)).Methods(http.MethodPost)
 
+			// Send reward mail to many characters at once
+			router.HandleFunc("/notes/rewards", registerRewardHandler("create_note_rewards", CreateNoteRewardsHandler)).Methods(http.MethodPost)
+
 			// Update a note
 			router.HandleFunc(
 				"/notes/{"+noteIdPattern+"}",
@@ -168,6 +174,41 @@ func CreateNoteHandler(d *rest.HandlerDependency, c *rest.HandlerContext, i Rest
 	}
 }
 
+// CreateNoteRewardsHandler handles POST /api/notes/rewards
+func CreateNoteRewardsHandler(d *rest.HandlerDependency, c *rest.HandlerContext, i RewardRestModel) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if len(i.RecipientIds) == 0 {
+			server.WriteBadRequest(d.Logger(), w, "recipientIds must not be empty")
+			return
+		}
+		if len(i.Items) > attachment.MaxItemsPerNote {
+			server.WriteBadRequest(d.Logger(), w, attachment.ErrTooManyItems.Error())
+			return
+		}
+		items := make([]attachment.RewardItem, 0, len(i.Items))
+		for _, it := range i.Items {
+			items = append(items, attachment.RewardItem{TemplateId: it.TemplateId, Quantity: it.Quantity})
+		}
+
+		ms, err := NewProcessor(d.Logger(), d.Context(), d.DB()).RewardAndEmit(world.Id(i.WorldId), i.SenderId, i.RecipientIds, i.Message, i.Flag, i.Mesos, items)
+		if err != nil {
+			d.Logger().WithError(err).Errorln("Error sending reward notes")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+		rm, err := model.SliceMap(Transform)(model.FixedProvider(ms))(model.ParallelMap())()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		query := r.URL.Query()
+		queryParams := jsonapi.ParseQueryFields(&query)
+		server.MarshalResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+	}
+}
+
 // UpdateNoteHandler handles PATCH /api/notes/{noteId}
 func UpdateNoteHandler(d *rest.HandlerDependency, c *rest.HandlerContext, i RestModel) http.HandlerFunc {
 	return rest.ParseNoteId(d.Logger(), func(noteId uint32) http.HandlerFunc {
@@ -63,3 +63,38 @@ func Extract(r RestModel) (Model, error) {
 		SetTimestamp(r.Timestamp).
 		Build()
 }
+
+// RewardRestModel is the JSON:API input for reward mail: one note per
+// recipient, each carrying the same minted items and mesos.
+type RewardRestModel struct {
+	Id           string                `json:"-"`
+	WorldId      byte                  `json:"worldId"`
+	SenderId     uint32                `json:"senderId"`
+	RecipientIds []uint32              `json:"recipientIds"`
+	Message      string                `json:"message"`
+	Flag         byte                  `json:"flag"`
+	Mesos        uint32                `json:"mesos"`
+	Items        []RewardItemRestModel `json:"items"`
+}
+
+// RewardItemRestModel names one item reward mail mints
+type RewardItemRestModel struct {
+	TemplateId uint32 `json:"templateId"`
+	Quantity   uint32 `json:"quantity"`
+}
+
+// GetID returns the resource ID
+func (r RewardRestModel) GetID() string {
+	return r.Id
+}
+
+// SetID sets the resource ID
+func (r *RewardRestModel) SetID(strId string) error {
+	r.Id = strId
+	return nil
+}
+
+// GetName returns the resource name
+func (r RewardRestModel) GetName() string {
+	return "note-rewards"
+}
@@ -3,6 +3,8 @@ package saga
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
 )
@@ -15,18 +17,30 @@ type (
 	Action = sharedsaga.Action
 
 	// Payload types
-	AwardFamePayload = sharedsaga.AwardFamePayload
+	AwardFamePayload        = sharedsaga.AwardFamePayload
+	AwardMesosPayload       = sharedsaga.AwardMesosPayload
+	CreateNotePayload       = sharedsaga.CreateNotePayload
+	TransferToNotePayload   = sharedsaga.TransferToNotePayload
+	AcceptToNotePayload     = sharedsaga.AcceptToNotePayload
+	WithdrawFromNotePayload = sharedsaga.WithdrawFromNotePayload
+	AssetSnapshot           = sharedsaga.AssetSnapshot
 )
 
 // Re-export constants from atlas-saga shared library
 const (
 	InventoryTransaction = sharedsaga.InventoryTransaction
+	NoteAttachment       = sharedsaga.NoteAttachment
 
 	Pending   = sharedsaga.Pending
 	Completed = sharedsaga.Completed
 	Failed    = sharedsaga.Failed
 
-	AwardFame = sharedsaga.AwardFame
+	AwardFame        = sharedsaga.AwardFame
+	AwardMesos       = sharedsaga.AwardMesos
+	CreateNote       = sharedsaga.CreateNote
+	TransferToNote   = sharedsaga.TransferToNote
+	AcceptToNote     = sharedsaga.AcceptToNote
+	WithdrawFromNote = sharedsaga.WithdrawFromNote
 )
 
 // Builder helps construct sagas with multiple steps
@@ -43,6 +57,13 @@ func NewBuilder() *Builder {
 	}
 }
 
+// SetTransactionId sets the saga's transaction id. Callers that embed the id in
+// step payloads set it before adding those steps.
+func (b *Builder) SetTransactionId(transactionId uuid.UUID) *Builder {
+	b.b.SetTransactionId(transactionId)
+	return b
+}
+
 // SetSagaType sets the saga type
 func (b *Builder) SetSagaType(sagaType Type) *Builder {
 	b.b.SetSagaType(sagaType)
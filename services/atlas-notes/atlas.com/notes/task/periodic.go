@@ -0,0 +1,209 @@
+package task
+
+import (
+	"atlas-notes/attachment"
+	"atlas-notes/campaign"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	routine "github.com/Chronicle20/atlas/libs/atlas-routine"
+	service "github.com/Chronicle20/atlas/libs/atlas-service"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	// defaultInterval is the sweep cadence when the env var is unset/invalid.
+	defaultInterval = 60 * time.Second
+	// sweepBatchLimit bounds how many expired attachments a single sweep
+	// processes. The remainder is picked up on the next tick.
+	sweepBatchLimit = 500
+	// campaignBatchLimit bounds how many delivering campaigns one tick visits,
+	// and campaignBudget how many targets each of them walks per tick.
+	campaignBatchLimit = 20
+	campaignBudget     = 1000
+
+	// serviceName is the environment-registry owner name for atlas-notes,
+	// matching the const declared in package main.
+	serviceName = "atlas-notes"
+)
+
+// PeriodicTask runs the attachment expiry sweep, and then a campaign delivery
+// pass, at a fixed interval. It
+// follows the atlas-mts expiration sweep: a time.Ticker + stopCh +
+// sync.WaitGroup loop that queries the note_attachments table directly across
+// every tenant.
+type PeriodicTask struct {
+	l        logrus.FieldLogger
+	ctx      context.Context
+	db       *gorm.DB
+	interval time.Duration
+	stopCh   chan struct{}
+	wg       *sync.WaitGroup
+}
+
+// NewPeriodicTask creates the expiry sweep task. A non-positive interval falls
+// back to defaultInterval.
+func NewPeriodicTask(l logrus.FieldLogger, ctx context.Context, db *gorm.DB, interval time.Duration) *PeriodicTask {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &PeriodicTask{
+		l:        l,
+		ctx:      ctx,
+		db:       db,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+		wg:       &sync.WaitGroup{},
+	}
+}
+
+// Start launches the ticker loop.
+func (t *PeriodicTask) Start() {
+	t.wg.Add(1)
+	routine.Go(t.l, t.ctx, func(context.Context) { t.run() })
+	t.l.Infof("Note attachment expiry sweep started with interval [%v].", t.interval)
+}
+
+// Stop signals the loop to exit and waits for the in-flight tick to finish.
+func (t *PeriodicTask) Stop() {
+	close(t.stopCh)
+	t.wg.Wait()
+	t.l.Infoln("Note attachment expiry sweep stopped.")
+}
+
+func (t *PeriodicTask) run() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := Sweep(t.l, t.ctx, t.db); err != nil {
+				t.l.WithError(err).Errorf("Note attachment expiry sweep failed.")
+			}
+			if _, err := DeliverCampaigns(t.l, t.ctx, t.db); err != nil {
+				t.l.WithError(err).Errorf("Note campaign delivery failed.")
+			}
+		case <-t.stopCh:
+			return
+		}
+	}
+}
+
+// Sweep performs one expiry pass: it discovers bound attachments past their
+// expiry across ALL tenants and returns each one — a sent attachment through a
+// withdraw saga back to its sender, a minted reward by lapsing. It returns the
+// number of attachments acted on.
+//
+// Unlike the MTS listings table, note_attachments stores the tenant's region
+// and version beside its id, so each row rebuilds its full tenant and the
+// return saga carries the right tenant headers. Rows whose tenant belongs to
+// an environment this deployment does not own are never visited.
+func Sweep(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) (int, error) {
+	sdb := db.WithContext(database.WithoutTenantFilter(ctx))
+
+	expired, err := attachment.GetExpired(time.Now(), sweepBatchLimit)(sdb)()
+	if err != nil {
+		return 0, err
+	}
+	if len(expired) == 0 {
+		l.Debugln("Note attachment expiry sweep: no expired attachments.")
+		return 0, nil
+	}
+
+	listTenants := func(_ context.Context) ([]tenant.Model, error) {
+		seen := make(map[uuid.UUID]bool, len(expired))
+		ts := make([]tenant.Model, 0, len(expired))
+		for _, m := range expired {
+			if seen[m.TenantId()] {
+				continue
+			}
+			seen[m.TenantId()] = true
+			ts = append(ts, m.Tenant())
+		}
+		return ts, nil
+	}
+
+	swept := 0
+	service.ForEachOwnedEnvironment(l, ctx, serviceName, listTenants, func(envCtx context.Context) {
+		tm := tenant.MustFromContext(envCtx)
+		p := attachment.NewProcessor(l, envCtx, db)
+		for _, m := range expired {
+			if m.TenantId() != tm.Id() {
+				continue
+			}
+			acted, rerr := p.Return(m)
+			if rerr != nil {
+				l.WithError(rerr).Warnf("Note attachment expiry sweep: failed to return attachment [%s] of note [%d] (tenant [%s]); will retry next tick.", m.Id(), m.NoteId(), m.TenantId())
+				continue
+			}
+			if acted {
+				swept++
+			}
+		}
+	})
+
+	l.Infof("Note attachment expiry sweep: returned [%d] of [%d] expired attachment(s).", swept, len(expired))
+	return swept, nil
+}
+
+// DeliverCampaigns performs one delivery pass: it discovers campaigns still
+// delivering across ALL tenants and advances each by up to campaignBudget
+// targets. It returns the number of notes mailed.
+//
+// A campaign resumes from its stored cursor, and every recipient is recorded
+// in the transaction that mails them, so a pass interrupted anywhere — or run
+// concurrently by two replicas — never mails anyone twice.
+func DeliverCampaigns(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) (int, error) {
+	sdb := db.WithContext(database.WithoutTenantFilter(ctx))
+
+	delivering, err := campaign.GetDelivering(campaignBatchLimit)(sdb)()
+	if err != nil {
+		return 0, err
+	}
+	if len(delivering) == 0 {
+		return 0, nil
+	}
+
+	listTenants := func(_ context.Context) ([]tenant.Model, error) {
+		seen := make(map[uuid.UUID]bool, len(delivering))
+		ts := make([]tenant.Model, 0, len(delivering))
+		for _, m := range delivering {
+			t := m.Tenant()
+			if seen[t.Id()] {
+				continue
+			}
+			seen[t.Id()] = true
+			ts = append(ts, t)
+		}
+		return ts, nil
+	}
+
+	mailed := 0
+	service.ForEachOwnedEnvironment(l, ctx, serviceName, listTenants, func(envCtx context.Context) {
+		tm := tenant.MustFromContext(envCtx)
+		p := campaign.NewProcessor(l, envCtx, db)
+		for _, m := range delivering {
+			t := m.Tenant()
+			if t.Id() != tm.Id() {
+				continue
+			}
+			n, derr := p.Deliver(m, campaignBudget)
+			mailed += n
+			if derr != nil {
+				l.WithError(derr).Warnf("Note campaign delivery: campaign [%s] (tenant [%s]) stopped after [%d] note(s); will resume next tick.", m.Id(), t.Id(), n)
+			}
+		}
+	})
+
+	l.Infof("Note campaign delivery: mailed [%d] note(s) across [%d] campaign(s).", mailed, len(delivering))
+	return mailed, nil
+}
@@ -1436,6 +1436,38 @@ func (e *OperationExecutorImpl) createStepForOperation(f field.Model, characterI
 
 		return stepId, saga.Pending, saga.IncreaseBuddyCapacity, payload, nil
 
+	case "create_alliance":
+		// Format: create_alliance
+		// Context: name (string)
+		nameValue, exists := operation.Params()["name"]
+		if !exists {
+			return "", "", "", nil, errors.New("missing name parameter for create_alliance operation")
+		}
+
+		// Evaluate the name value
+		name, err := e.evaluateContextValue(characterId, "name", nameValue)
+		if err != nil {
+			return "", "", "", nil, err
+		}
+
+		payload := saga.CreateAlliancePayload{
+			CharacterId: characterId,
+			WorldId:     f.WorldId(),
+			Name:        name,
+		}
+
+		return stepId, saga.Pending, saga.CreateAlliance, payload, nil
+
+	case "increase_alliance_capacity":
+		// Format: increase_alliance_capacity
+		// atlas-guilds resolves the alliance from the character's guild and debits the cost.
+		payload := saga.RequestAllianceCapacityIncreasePayload{
+			CharacterId: characterId,
+			WorldId:     f.WorldId(),
+		}
+
+		return stepId, saga.Pending, saga.RequestAllianceCapacityIncrease, payload, nil
+
 	case "create_skill":
 		// Format: create_skill
 		// Context: skillId (uint32), level (byte), masterLevel (byte), expiration (time.Time)
@@ -2100,9 +2132,10 @@ func (e *OperationExecutorImpl) createStepForOperation(f field.Model, characterI
 
 	case "open_storage":
 		// Format: open_storage
-		// Params: accountId (uint32, required)
+		// Params: accountId (uint32, required), tab (byte, optional)
 		// Opens the storage UI for the character via the NPC they're talking to
 		// Used for storage keeper NPCs (e.g., Fredrick in FM)
+		// Tab selects an extra storage tab (0 = main, 255 = account-wide shared tab)
 		accountIdValue, exists := operation.Params()["accountId"]
 		if !exists {
 			return "", "", "", nil, errors.New("missing accountId parameter for open_storage operation")
@@ -2120,12 +2153,22 @@ func (e *OperationExecutorImpl) createStepForOperation(f field.Model, characterI
 		}
 		npcId := ctx.NpcId()
 
+		// Tab is optional, defaults to the main tab
+		tabInt := 0
+		if tabValue, exists := operation.Params()["tab"]; exists {
+			tabInt, err = e.evaluateContextValueAsInt(characterId, "tab", tabValue)
+			if err != nil {
+				return "", "", "", nil, err
+			}
+		}
+
 		payload := saga.ShowStoragePayload{
 			CharacterId: characterId,
 			NpcId:       npcId,
 			WorldId:     f.WorldId(),
 			ChannelId:   f.ChannelId(),
 			AccountId:   uint32(accountIdInt),
+			Tab:         byte(tabInt),
 		}
 
 		return stepId, saga.Pending, saga.ShowStorage, payload, nil
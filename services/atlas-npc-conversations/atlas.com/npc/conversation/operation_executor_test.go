@@ -843,3 +843,63 @@ func TestCreateSagaForOperations_ChangeJobAppendsCancelAllBuffs(t *testing.T) {
 		t.Errorf("last step action = %v, want CancelAllBuffs", last.Action)
 	}
 }
+
+func TestCreateStepForOperation_AllianceOperations(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	InitRegistry(rc)
+	l, _ := test.NewNullLogger()
+	var tm tenant.Model
+	tctx := tenant.WithContext(context.Background(), tm)
+	characterId := uint32(77)
+	GetRegistry().SetContext(tctx, characterId, NewConversationContextBuilder().SetCharacterId(characterId).AddContextValue("allianceName", "Alliance").Build())
+	defer GetRegistry().ClearContext(tctx, characterId)
+
+	executor := &OperationExecutorImpl{l: l, ctx: tctx, t: tm}
+	f := field.NewBuilder(world.Id(1), channel.Id(1), _map.Id(200000301)).Build()
+
+	op, err := NewOperationBuilder().
+		SetType("create_alliance").
+		AddParamValue("name", "{context.allianceName}").
+		Build()
+	if err != nil {
+		t.Fatalf("build op: %v", err)
+	}
+	_, _, action, payload, err := executor.createStepForOperation(f, characterId, op)
+	if err != nil {
+		t.Fatalf("createStepForOperation returned error: %v", err)
+	}
+	if action != saga.CreateAlliance {
+		t.Fatalf("expected action CreateAlliance, got %q", action)
+	}
+	cp, ok := payload.(saga.CreateAlliancePayload)
+	if !ok {
+		t.Fatalf("expected CreateAlliancePayload, got %T", payload)
+	}
+	if cp.CharacterId != characterId || cp.WorldId != world.Id(1) || cp.Name != "Alliance" {
+		t.Errorf("unexpected payload: %+v", cp)
+	}
+
+	op, err = NewOperationBuilder().SetType("create_alliance").Build()
+	if err != nil {
+		t.Fatalf("build op: %v", err)
+	}
+	if _, _, _, _, err = executor.createStepForOperation(f, characterId, op); err == nil {
+		t.Fatal("expected error for create_alliance without a name")
+	}
+
+	op, err = NewOperationBuilder().SetType("increase_alliance_capacity").Build()
+	if err != nil {
+		t.Fatalf("build op: %v", err)
+	}
+	_, _, action, payload, err = executor.createStepForOperation(f, characterId, op)
+	if err != nil {
+		t.Fatalf("createStepForOperation returned error: %v", err)
+	}
+	if action != saga.RequestAllianceCapacityIncrease {
+		t.Fatalf("expected action RequestAllianceCapacityIncrease, got %q", action)
+	}
+	if p, ok := payload.(saga.RequestAllianceCapacityIncreasePayload); !ok || p.CharacterId != characterId || p.WorldId != world.Id(1) {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+}
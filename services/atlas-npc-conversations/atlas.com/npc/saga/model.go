@@ -80,6 +80,10 @@ type (
 
 	// Pet evolution payload types
 	EvolvePetPayload = sharedsaga.EvolvePetPayload
+
+	// Alliance payload types
+	CreateAlliancePayload                  = sharedsaga.CreateAlliancePayload
+	RequestAllianceCapacityIncreasePayload = sharedsaga.RequestAllianceCapacityIncreasePayload
 )
 
 // Re-export constants from atlas-saga shared library
@@ -147,6 +151,10 @@ const (
 	// Transport actions
 	StartInstanceTransport = sharedsaga.StartInstanceTransport
 
+	// Alliance actions
+	CreateAlliance                  = sharedsaga.CreateAlliance
+	RequestAllianceCapacityIncrease = sharedsaga.RequestAllianceCapacityIncrease
+
 	// Party quest actions
 	RegisterPartyQuest         = sharedsaga.RegisterPartyQuest
 	WarpPartyQuestMembersToMap = sharedsaga.WarpPartyQuestMembersToMap
@@ -2,6 +2,7 @@ package character
 
 import (
 	"atlas-parties/kafka/message"
+	"atlas-parties/listing"
 	"atlas-parties/location"
 	"context"
 	"errors"
@@ -38,6 +39,7 @@ type Processor interface {
 	Delete(characterId uint32) error
 	ByIdProvider(characterId uint32) model.Provider[Model]
 	GetById(characterId uint32) (Model, error)
+	GetSlice(filters ...model.Filter[Model]) ([]Model, error)
 	GetForeignCharacterInfo(characterId uint32) (ForeignModel, error)
 }
 
@@ -139,6 +141,16 @@ func (p *ProcessorImpl) Logout(mb *message.Buffer) func(characterId uint32) erro
 				p.l.WithError(err).Errorf("Unable to announce the party [%d] member [%d] logged out.", c.PartyId(), c.Id())
 				return err
 			}
+
+			// A listing is owned by its leader; it should not outlive their session.
+			lp := listing.NewProcessor(p.l, p.ctx)
+			if lm, err := lp.GetByPartyId(c.PartyId()); err == nil && lm.LeaderId() == characterId {
+				err = lp.Remove(mb)(characterId, c.PartyId(), listing.RemovedReasonLeaderOffline)
+				if err != nil {
+					p.l.WithError(err).Errorf("Unable to remove party [%d] listing after leader [%d] logged out.", c.PartyId(), c.Id())
+					return err
+				}
+			}
 		}
 
 		return nil
@@ -370,6 +382,15 @@ func (p *ProcessorImpl) GetById(characterId uint32) (Model, error) {
 	return p.ByIdProvider(characterId)()
 }
 
+// GetSlice returns characters tracked by this service. Only characters that
+// have logged in since the service started are known, which is sufficient for
+// presence-based lookups such as party-search matching.
+func (p *ProcessorImpl) GetSlice(filters ...model.Filter[Model]) ([]Model, error) {
+	return model.FilteredProvider(func() ([]Model, error) {
+		return GetRegistry().GetAll(p.ctx), nil
+	}, model.Filters[Model](filters...))()
+}
+
 func (p *ProcessorImpl) GetForeignCharacterInfo(characterId uint32) (ForeignModel, error) {
 	return requests.Provider[ForeignRestModel, ForeignModel](p.l, p.ctx)(requestById(p.ctx, characterId), ExtractForeign)()
 }
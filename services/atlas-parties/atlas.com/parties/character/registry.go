@@ -59,6 +59,15 @@ func (r *Registry) Get(ctx context.Context, id uint32) (Model, error) {
 	return m, nil
 }
 
+func (r *Registry) GetAll(ctx context.Context) []Model {
+	t := tenant.MustFromContext(ctx)
+	vals, err := r.characters.GetAllValues(ctx, t)
+	if err != nil {
+		return make([]Model, 0)
+	}
+	return vals
+}
+
 func (r *Registry) Update(ctx context.Context, id uint32, updaters ...func(m Model) Model) Model {
 	t := tenant.MustFromContext(ctx)
 	m, err := r.characters.Get(ctx, t, id)
@@ -2,6 +2,7 @@ package character
 
 import (
 	"atlas-parties/character"
+	"atlas-parties/listing"
 	"context"
 	"testing"
 
@@ -24,6 +25,7 @@ func setupEventHandlerTest(t *testing.T) (logrus.FieldLogger, context.Context) {
 	mr := miniredis.RunT(t)
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	character.InitRegistry(rc)
+	listing.InitRegistry(rc)
 
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
@@ -41,6 +42,18 @@ func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handl
 		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleRequestInvite))); err != nil {
 			return err
 		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handlePublishListing))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleWithdrawListing))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleApplyListing))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleMatchListing))); err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -104,3 +117,43 @@ func handleRequestInvite(l logrus.FieldLogger, ctx context.Context, c commandEve
 		l.WithError(err).Errorf("Unable to invite [%d] to party.", c.Body.CharacterId)
 	}
 }
+
+func handlePublishListing(l logrus.FieldLogger, ctx context.Context, c commandEvent[publishListingBody]) {
+	if c.Type != CommandPartyPublishListing {
+		return
+	}
+	_, err := party.NewProcessor(l, ctx).PublishListingAndEmit(c.ActorId, c.Body.MinLevel, c.Body.MaxLevel, c.Body.JobMask, c.Body.MaxMembers, _map.Id(c.Body.TargetMapId), c.Body.Description)
+	if err != nil {
+		l.WithError(err).Errorf("Unable to publish party listing for leader [%d].", c.ActorId)
+	}
+}
+
+func handleWithdrawListing(l logrus.FieldLogger, ctx context.Context, c commandEvent[withdrawListingBody]) {
+	if c.Type != CommandPartyWithdrawListing {
+		return
+	}
+	err := party.NewProcessor(l, ctx).WithdrawListingAndEmit(c.ActorId)
+	if err != nil {
+		l.WithError(err).Errorf("Unable to withdraw party listing for leader [%d].", c.ActorId)
+	}
+}
+
+func handleApplyListing(l logrus.FieldLogger, ctx context.Context, c commandEvent[applyListingBody]) {
+	if c.Type != CommandPartyApplyListing {
+		return
+	}
+	_, err := party.NewProcessor(l, ctx).ApplyListingAndEmit(c.Body.PartyId, c.ActorId)
+	if err != nil {
+		l.WithError(err).Errorf("Character [%d] unable to apply to party [%d].", c.ActorId, c.Body.PartyId)
+	}
+}
+
+func handleMatchListing(l logrus.FieldLogger, ctx context.Context, c commandEvent[matchListingBody]) {
+	if c.Type != CommandPartyMatchListing {
+		return
+	}
+	_, err := party.NewProcessor(l, ctx).MatchListingAndEmit(c.ActorId)
+	if err != nil {
+		l.WithError(err).Errorf("Unable to match character [%d] to a party listing.", c.ActorId)
+	}
+}
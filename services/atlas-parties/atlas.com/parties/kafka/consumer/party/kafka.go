@@ -9,6 +9,11 @@ const (
 	CommandPartyLeave         = "LEAVE"
 	CommandPartyChangeLeader  = "CHANGE_LEADER"
 	CommandPartyRequestInvite = "REQUEST_INVITE"
+
+	CommandPartyPublishListing  = "PUBLISH_LISTING"
+	CommandPartyWithdrawListing = "WITHDRAW_LISTING"
+	CommandPartyApplyListing    = "APPLY_LISTING"
+	CommandPartyMatchListing    = "MATCH_LISTING"
 )
 
 type commandEvent[E any] struct {
@@ -40,3 +45,20 @@ type changeLeaderBody struct {
 type requestInviteBody struct {
 	CharacterId uint32 `json:"characterId"`
 }
+
+type publishListingBody struct {
+	MinLevel    byte   `json:"minLevel"`
+	MaxLevel    byte   `json:"maxLevel"`
+	JobMask     uint32 `json:"jobMask"`
+	MaxMembers  byte   `json:"maxMembers"`
+	TargetMapId uint32 `json:"targetMapId"`
+	Description string `json:"description"`
+}
+
+type withdrawListingBody struct{}
+
+type applyListingBody struct {
+	PartyId uint32 `json:"partyId"`
+}
+
+type matchListingBody struct{}
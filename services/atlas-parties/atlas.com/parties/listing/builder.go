@@ -0,0 +1,118 @@
+package listing
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+var (
+	ErrInvalidLevelRange = errors.New("invalid level range")
+	ErrInvalidMaxMembers = errors.New("invalid max members")
+)
+
+type Builder struct {
+	tenantId    uuid.UUID
+	partyId     uint32
+	leaderId    uint32
+	worldId     world.Id
+	channelId   channel.Id
+	minLevel    byte
+	maxLevel    byte
+	jobMask     uint32
+	maxMembers  byte
+	memberCount byte
+	targetMapId _map.Id
+	description string
+	createdAt   time.Time
+}
+
+func NewBuilder(tenantId uuid.UUID, partyId uint32, leaderId uint32) *Builder {
+	return &Builder{
+		tenantId:   tenantId,
+		partyId:    partyId,
+		leaderId:   leaderId,
+		minLevel:   MinLevel,
+		maxLevel:   MaxLevel,
+		maxMembers: MaxMembers,
+		createdAt:  time.Now(),
+	}
+}
+
+func (b *Builder) SetLocation(worldId world.Id, channelId channel.Id) *Builder {
+	b.worldId = worldId
+	b.channelId = channelId
+	return b
+}
+
+// SetLevelRange sets the inclusive level range. A zero bound is left open.
+func (b *Builder) SetLevelRange(minLevel byte, maxLevel byte) *Builder {
+	if minLevel == 0 {
+		minLevel = MinLevel
+	}
+	if maxLevel == 0 {
+		maxLevel = MaxLevel
+	}
+	b.minLevel = minLevel
+	b.maxLevel = maxLevel
+	return b
+}
+
+func (b *Builder) SetJobMask(jobMask uint32) *Builder {
+	b.jobMask = jobMask
+	return b
+}
+
+// SetMaxMembers sets the party size the leader is recruiting towards. Zero
+// means a full party.
+func (b *Builder) SetMaxMembers(maxMembers byte) *Builder {
+	if maxMembers == 0 {
+		maxMembers = MaxMembers
+	}
+	b.maxMembers = maxMembers
+	return b
+}
+
+func (b *Builder) SetMemberCount(memberCount byte) *Builder {
+	b.memberCount = memberCount
+	return b
+}
+
+func (b *Builder) SetTargetMapId(targetMapId _map.Id) *Builder {
+	b.targetMapId = targetMapId
+	return b
+}
+
+func (b *Builder) SetDescription(description string) *Builder {
+	b.description = description
+	return b
+}
+
+func (b *Builder) Build() (Model, error) {
+	if b.minLevel < MinLevel || b.maxLevel > MaxLevel || b.minLevel > b.maxLevel {
+		return Model{}, ErrInvalidLevelRange
+	}
+	if b.maxMembers < 2 || b.maxMembers > MaxMembers {
+		return Model{}, ErrInvalidMaxMembers
+	}
+	return Model{
+		tenantId:    b.tenantId,
+		partyId:     b.partyId,
+		leaderId:    b.leaderId,
+		worldId:     b.worldId,
+		channelId:   b.channelId,
+		minLevel:    b.minLevel,
+		maxLevel:    b.maxLevel,
+		jobMask:     b.jobMask,
+		maxMembers:  b.maxMembers,
+		memberCount: b.memberCount,
+		targetMapId: b.targetMapId,
+		description: b.description,
+		createdAt:   b.createdAt,
+	}, nil
+}
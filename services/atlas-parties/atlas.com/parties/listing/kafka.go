@@ -0,0 +1,42 @@
+package listing
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventStatusTopic                  = "EVENT_TOPIC_PARTY_STATUS"
+	EventPartyStatusTypeListingPublished = "LISTING_PUBLISHED"
+	EventPartyStatusTypeListingRemoved   = "LISTING_REMOVED"
+
+	RemovedReasonWithdrawn     = "WITHDRAWN"
+	RemovedReasonFilled        = "FILLED"
+	RemovedReasonDisbanded     = "DISBANDED"
+	RemovedReasonLeaderChanged = "LEADER_CHANGED"
+	RemovedReasonLeaderOffline = "LEADER_LOGGED_OUT"
+)
+
+type statusEvent[E any] struct {
+	ActorId       uint32    `json:"actorId"`
+	WorldId       world.Id  `json:"worldId"`
+	PartyId       uint32    `json:"partyId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+	TransactionId uuid.UUID `json:"transactionId,omitempty"`
+}
+
+type listingPublishedEventBody struct {
+	ChannelId   byte   `json:"channelId"`
+	MinLevel    byte   `json:"minLevel"`
+	MaxLevel    byte   `json:"maxLevel"`
+	JobMask     uint32 `json:"jobMask"`
+	MaxMembers  byte   `json:"maxMembers"`
+	TargetMapId uint32 `json:"targetMapId"`
+	Description string `json:"description"`
+}
+
+type listingRemovedEventBody struct {
+	Reason string `json:"reason"`
+}
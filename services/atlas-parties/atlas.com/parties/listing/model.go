@@ -0,0 +1,121 @@
+package listing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	MinLevel   = byte(1)
+	MaxLevel   = byte(200)
+	MaxMembers = byte(6)
+)
+
+// JobBit is the job-mix flag for jobId. Flags follow the job branch
+// (beginner, warrior, magician, bowman, thief, pirate) so Cygnus and Aran
+// jobs share the flag of their explorer counterpart. A listing whose job mask
+// is zero accepts every job.
+func JobBit(jobId job.Id) uint32 {
+	return 1 << ((uint32(jobId) % 1000) / 100)
+}
+
+// Model is a party's entry on the party-search board. It is keyed by party
+// and lives only as long as the party is recruiting.
+type Model struct {
+	tenantId    uuid.UUID
+	partyId     uint32
+	leaderId    uint32
+	worldId     world.Id
+	channelId   channel.Id
+	minLevel    byte
+	maxLevel    byte
+	jobMask     uint32
+	maxMembers  byte
+	memberCount byte
+	targetMapId _map.Id
+	description string
+	createdAt   time.Time
+}
+
+func (m Model) TenantId() uuid.UUID {
+	return m.tenantId
+}
+
+func (m Model) PartyId() uint32 {
+	return m.partyId
+}
+
+func (m Model) LeaderId() uint32 {
+	return m.leaderId
+}
+
+func (m Model) WorldId() world.Id {
+	return m.worldId
+}
+
+func (m Model) ChannelId() channel.Id {
+	return m.channelId
+}
+
+func (m Model) MinLevel() byte {
+	return m.minLevel
+}
+
+func (m Model) MaxLevel() byte {
+	return m.maxLevel
+}
+
+func (m Model) JobMask() uint32 {
+	return m.jobMask
+}
+
+func (m Model) MaxMembers() byte {
+	return m.maxMembers
+}
+
+func (m Model) MemberCount() byte {
+	return m.memberCount
+}
+
+func (m Model) TargetMapId() _map.Id {
+	return m.targetMapId
+}
+
+func (m Model) Description() string {
+	return m.description
+}
+
+func (m Model) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// Open reports whether the party still has room for the listing's target
+// size.
+func (m Model) Open() bool {
+	return m.memberCount < m.maxMembers
+}
+
+func (m Model) AcceptsLevel(level byte) bool {
+	return level >= m.minLevel && level <= m.maxLevel
+}
+
+func (m Model) AcceptsJob(jobId job.Id) bool {
+	return m.jobMask == 0 || m.jobMask&JobBit(jobId) != 0
+}
+
+// Eligible reports whether a character of the given level and job satisfies
+// the listing's level range and job mix.
+func (m Model) Eligible(level byte, jobId job.Id) bool {
+	return m.AcceptsLevel(level) && m.AcceptsJob(jobId)
+}
+
+func (m Model) SetMemberCount(memberCount byte) Model {
+	m.memberCount = memberCount
+	return m
+}
@@ -0,0 +1,67 @@
+package listing
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type modelJSON struct {
+	TenantId    uuid.UUID  `json:"tenantId"`
+	PartyId     uint32     `json:"partyId"`
+	LeaderId    uint32     `json:"leaderId"`
+	WorldId     world.Id   `json:"worldId"`
+	ChannelId   channel.Id `json:"channelId"`
+	MinLevel    byte       `json:"minLevel"`
+	MaxLevel    byte       `json:"maxLevel"`
+	JobMask     uint32     `json:"jobMask"`
+	MaxMembers  byte       `json:"maxMembers"`
+	MemberCount byte       `json:"memberCount"`
+	TargetMapId _map.Id    `json:"targetMapId"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func (m Model) MarshalJSON() ([]byte, error) {
+	return json.Marshal(modelJSON{
+		TenantId:    m.tenantId,
+		PartyId:     m.partyId,
+		LeaderId:    m.leaderId,
+		WorldId:     m.worldId,
+		ChannelId:   m.channelId,
+		MinLevel:    m.minLevel,
+		MaxLevel:    m.maxLevel,
+		JobMask:     m.jobMask,
+		MaxMembers:  m.maxMembers,
+		MemberCount: m.memberCount,
+		TargetMapId: m.targetMapId,
+		Description: m.description,
+		CreatedAt:   m.createdAt,
+	})
+}
+
+func (m *Model) UnmarshalJSON(data []byte) error {
+	var aux modelJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	m.tenantId = aux.TenantId
+	m.partyId = aux.PartyId
+	m.leaderId = aux.LeaderId
+	m.worldId = aux.WorldId
+	m.channelId = aux.ChannelId
+	m.minLevel = aux.MinLevel
+	m.maxLevel = aux.MaxLevel
+	m.jobMask = aux.JobMask
+	m.maxMembers = aux.MaxMembers
+	m.memberCount = aux.MemberCount
+	m.targetMapId = aux.TargetMapId
+	m.description = aux.Description
+	m.createdAt = aux.CreatedAt
+	return nil
+}
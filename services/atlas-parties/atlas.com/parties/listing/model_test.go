@@ -0,0 +1,115 @@
+package listing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+)
+
+func TestBuildValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		minLevel   byte
+		maxLevel   byte
+		maxMembers byte
+		want       error
+	}{
+		{name: "defaults", want: nil},
+		{name: "explicit range", minLevel: 30, maxLevel: 70, maxMembers: 4, want: nil},
+		{name: "inverted range", minLevel: 70, maxLevel: 30, want: ErrInvalidLevelRange},
+		{name: "above max level", minLevel: 10, maxLevel: 201, want: ErrInvalidLevelRange},
+		{name: "solo party", maxMembers: 1, want: ErrInvalidMaxMembers},
+		{name: "oversized party", maxMembers: 7, want: ErrInvalidMaxMembers},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBuilder(uuid.New(), 1, 1).SetLevelRange(tt.minLevel, tt.maxLevel).SetMaxMembers(tt.maxMembers).Build()
+			if err != tt.want {
+				t.Errorf("Build() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEligible(t *testing.T) {
+	m, err := NewBuilder(uuid.New(), 1, 1).
+		SetLevelRange(30, 50).
+		SetJobMask(JobBit(job.Id(100)) | JobBit(job.Id(500))).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		level byte
+		jobId job.Id
+		want  bool
+	}{
+		{name: "warrior in range", level: 30, jobId: 110, want: true},
+		{name: "pirate at upper bound", level: 50, jobId: 520, want: true},
+		{name: "dawn warrior shares warrior flag", level: 40, jobId: 1110, want: true},
+		{name: "magician excluded", level: 40, jobId: 210, want: false},
+		{name: "below range", level: 29, jobId: 110, want: false},
+		{name: "above range", level: 51, jobId: 110, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Eligible(tt.level, tt.jobId); got != tt.want {
+				t.Errorf("Eligible(%d, %d) = %v, want %v", tt.level, tt.jobId, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyJobWhenMaskEmpty(t *testing.T) {
+	m, _ := NewBuilder(uuid.New(), 1, 1).Build()
+	for _, j := range []job.Id{0, 100, 232, 412, 522, 2112} {
+		if !m.AcceptsJob(j) {
+			t.Errorf("AcceptsJob(%d) = false with empty mask", j)
+		}
+	}
+}
+
+func TestOpen(t *testing.T) {
+	m, _ := NewBuilder(uuid.New(), 1, 1).SetMaxMembers(3).SetMemberCount(2).Build()
+	if !m.Open() {
+		t.Error("expected listing with 2/3 members to be open")
+	}
+	if m.SetMemberCount(3).Open() {
+		t.Error("expected listing with 3/3 members to be closed")
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	in, err := NewBuilder(uuid.New(), 1000000001, 7).
+		SetLocation(1, 2).
+		SetLevelRange(21, 30).
+		SetJobMask(JobBit(job.Id(200))).
+		SetMaxMembers(4).
+		SetMemberCount(2).
+		SetTargetMapId(103000800).
+		SetDescription("Kerning PQ").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var out Model
+	if err = json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.PartyId() != in.PartyId() || out.LeaderId() != in.LeaderId() || out.WorldId() != in.WorldId() ||
+		out.ChannelId() != in.ChannelId() || out.MinLevel() != in.MinLevel() || out.MaxLevel() != in.MaxLevel() ||
+		out.JobMask() != in.JobMask() || out.MaxMembers() != in.MaxMembers() || out.MemberCount() != in.MemberCount() ||
+		out.TargetMapId() != in.TargetMapId() || out.Description() != in.Description() || !out.CreatedAt().Equal(in.CreatedAt()) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", out, in)
+	}
+}
@@ -0,0 +1,153 @@
+package listing
+
+import (
+	"atlas-parties/kafka/message"
+	"context"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+type Processor interface {
+	AllProvider() ([]Model, error)
+	ByPartyIdProvider(partyId uint32) model.Provider[Model]
+	GetByPartyId(partyId uint32) (Model, error)
+	GetByLeaderId(leaderId uint32) (Model, error)
+	GetSlice(filters ...model.Filter[Model]) ([]Model, error)
+
+	Publish(mb *message.Buffer) func(m Model) error
+	Remove(mb *message.Buffer) func(actorId uint32, partyId uint32, reason string) error
+	SetMemberCount(mb *message.Buffer) func(actorId uint32, partyId uint32, count byte) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+	t   tenant.Model
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+		t:   tenant.MustFromContext(ctx),
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+// AllProvider returns every listing for the tenant, oldest first, so browsers
+// and the matcher favour parties that have been waiting longest.
+func (p *ProcessorImpl) AllProvider() ([]Model, error) {
+	ms := GetRegistry().GetAll(p.ctx)
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].CreatedAt().Equal(ms[j].CreatedAt()) {
+			return ms[i].PartyId() < ms[j].PartyId()
+		}
+		return ms[i].CreatedAt().Before(ms[j].CreatedAt())
+	})
+	return ms, nil
+}
+
+func (p *ProcessorImpl) ByPartyIdProvider(partyId uint32) model.Provider[Model] {
+	return func() (Model, error) {
+		return GetRegistry().Get(p.ctx, partyId)
+	}
+}
+
+func (p *ProcessorImpl) GetByPartyId(partyId uint32) (Model, error) {
+	return p.ByPartyIdProvider(partyId)()
+}
+
+func (p *ProcessorImpl) GetByLeaderId(leaderId uint32) (Model, error) {
+	return model.FirstProvider(p.AllProvider, model.Filters[Model](LeaderFilter(leaderId)))()
+}
+
+func (p *ProcessorImpl) GetSlice(filters ...model.Filter[Model]) ([]Model, error) {
+	return model.FilteredProvider(p.AllProvider, model.Filters[Model](filters...))()
+}
+
+func LeaderFilter(leaderId uint32) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.LeaderId() == leaderId
+	}
+}
+
+func WorldFilter(worldId world.Id) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.WorldId() == worldId
+	}
+}
+
+func ChannelFilter(channelId channel.Id) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.ChannelId() == channelId
+	}
+}
+
+func LevelFilter(level byte) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.AcceptsLevel(level)
+	}
+}
+
+func JobFilter(jobId job.Id) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.AcceptsJob(jobId)
+	}
+}
+
+// EligibleFilter keeps listings a character of the given level and job could apply to.
+func EligibleFilter(level byte, jobId job.Id) model.Filter[Model] {
+	return func(m Model) bool {
+		return m.Eligible(level, jobId)
+	}
+}
+
+func (p *ProcessorImpl) Publish(mb *message.Buffer) func(m Model) error {
+	return func(m Model) error {
+		if err := GetRegistry().Put(p.ctx, m); err != nil {
+			return err
+		}
+		p.l.Debugf("Party [%d] listed by leader [%d] for levels [%d-%d].", m.PartyId(), m.LeaderId(), m.MinLevel(), m.MaxLevel())
+		return mb.Put(EnvEventStatusTopic, listingPublishedEventProvider(m))
+	}
+}
+
+// Remove withdraws the party's listing, if one exists. Parties without a
+// listing are not an error, which lets party lifecycle hooks call this blindly.
+func (p *ProcessorImpl) Remove(mb *message.Buffer) func(actorId uint32, partyId uint32, reason string) error {
+	return func(actorId uint32, partyId uint32, reason string) error {
+		m, err := GetRegistry().Get(p.ctx, partyId)
+		if err != nil {
+			return nil
+		}
+		if err = GetRegistry().Remove(p.ctx, partyId); err != nil {
+			return err
+		}
+		p.l.Debugf("Party [%d] listing removed. Reason [%s].", partyId, reason)
+		return mb.Put(EnvEventStatusTopic, listingRemovedEventProvider(actorId, m, reason))
+	}
+}
+
+// SetMemberCount records the party's new size on its listing, removing the
+// listing once the party reaches the size the leader asked for.
+func (p *ProcessorImpl) SetMemberCount(mb *message.Buffer) func(actorId uint32, partyId uint32, count byte) error {
+	return func(actorId uint32, partyId uint32, count byte) error {
+		m, err := GetRegistry().Get(p.ctx, partyId)
+		if err != nil {
+			return nil
+		}
+		m = m.SetMemberCount(count)
+		if !m.Open() {
+			return p.Remove(mb)(actorId, partyId, RemovedReasonFilled)
+		}
+		return GetRegistry().Put(p.ctx, m)
+	}
+}
@@ -0,0 +1,42 @@
+package listing
+
+import (
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func listingPublishedEventProvider(m Model) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(m.PartyId()))
+	value := &statusEvent[listingPublishedEventBody]{
+		ActorId: m.LeaderId(),
+		WorldId: m.WorldId(),
+		PartyId: m.PartyId(),
+		Type:    EventPartyStatusTypeListingPublished,
+		Body: listingPublishedEventBody{
+			ChannelId:   byte(m.ChannelId()),
+			MinLevel:    m.MinLevel(),
+			MaxLevel:    m.MaxLevel(),
+			JobMask:     m.JobMask(),
+			MaxMembers:  m.MaxMembers(),
+			TargetMapId: uint32(m.TargetMapId()),
+			Description: m.Description(),
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func listingRemovedEventProvider(actorId uint32, m Model, reason string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(m.PartyId()))
+	value := &statusEvent[listingRemovedEventBody]{
+		ActorId: actorId,
+		WorldId: m.WorldId(),
+		PartyId: m.PartyId(),
+		Type:    EventPartyStatusTypeListingRemoved,
+		Body: listingRemovedEventBody{
+			Reason: reason,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
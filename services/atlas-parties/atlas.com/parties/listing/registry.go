@@ -0,0 +1,60 @@
+package listing
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	atlas "github.com/Chronicle20/atlas/libs/atlas-redis"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Registry struct {
+	listings *atlas.TenantRegistry[uint32, Model]
+}
+
+var registry *Registry
+
+func InitRegistry(client *goredis.Client) {
+	registry = &Registry{
+		listings: atlas.NewTenantRegistry[uint32, Model](client, "party-listing", func(k uint32) string {
+			return strconv.FormatUint(uint64(k), 10)
+		}),
+	}
+}
+
+func GetRegistry() *Registry {
+	return registry
+}
+
+func (r *Registry) Put(ctx context.Context, m Model) error {
+	t := tenant.MustFromContext(ctx)
+	return r.listings.Put(ctx, t, m.PartyId(), m)
+}
+
+func (r *Registry) Get(ctx context.Context, partyId uint32) (Model, error) {
+	t := tenant.MustFromContext(ctx)
+	m, err := r.listings.Get(ctx, t, partyId)
+	if err != nil {
+		return Model{}, ErrNotFound
+	}
+	return m, nil
+}
+
+func (r *Registry) GetAll(ctx context.Context) []Model {
+	t := tenant.MustFromContext(ctx)
+	vals, err := r.listings.GetAllValues(ctx, t)
+	if err != nil {
+		return make([]Model, 0)
+	}
+	return vals
+}
+
+func (r *Registry) Remove(ctx context.Context, partyId uint32) error {
+	t := tenant.MustFromContext(ctx)
+	return r.listings.Remove(ctx, t, partyId)
+}
@@ -0,0 +1,86 @@
+package listing
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// RestModel is a party-search listing. Its id is the listed party's id.
+type RestModel struct {
+	Id          uint32     `json:"-"`
+	LeaderId    uint32     `json:"leaderId"`
+	WorldId     world.Id   `json:"worldId"`
+	ChannelId   channel.Id `json:"channelId"`
+	MinLevel    byte       `json:"minLevel"`
+	MaxLevel    byte       `json:"maxLevel"`
+	JobMask     uint32     `json:"jobMask"`
+	MaxMembers  byte       `json:"maxMembers"`
+	MemberCount byte       `json:"memberCount"`
+	TargetMapId _map.Id    `json:"targetMapId"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func (r RestModel) GetName() string {
+	return "listings"
+}
+
+func (r RestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *RestModel) SetID(strId string) error {
+	if strId == "" {
+		return nil
+	}
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+func Transform(m Model) (RestModel, error) {
+	return RestModel{
+		Id:          m.partyId,
+		LeaderId:    m.leaderId,
+		WorldId:     m.worldId,
+		ChannelId:   m.channelId,
+		MinLevel:    m.minLevel,
+		MaxLevel:    m.maxLevel,
+		JobMask:     m.jobMask,
+		MaxMembers:  m.maxMembers,
+		MemberCount: m.memberCount,
+		TargetMapId: m.targetMapId,
+		Description: m.description,
+		CreatedAt:   m.createdAt,
+	}, nil
+}
+
+// ApplicationRestModel is a character's request to join a listed party. Its
+// id is the applying character's id.
+type ApplicationRestModel struct {
+	Id uint32 `json:"-"`
+}
+
+func (r ApplicationRestModel) GetName() string {
+	return "applications"
+}
+
+func (r ApplicationRestModel) GetID() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+func (r *ApplicationRestModel) SetID(strId string) error {
+	id, err := strconv.Atoi(strId)
+	if err != nil {
+		return err
+	}
+	r.Id = uint32(id)
+	return nil
+}
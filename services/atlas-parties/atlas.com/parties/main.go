@@ -5,6 +5,7 @@ import (
 	"atlas-parties/kafka/consumer/character"
 	"atlas-parties/kafka/consumer/invite"
 	party2 "atlas-parties/kafka/consumer/party"
+	"atlas-parties/listing"
 	"atlas-parties/party"
 	"os"
 
@@ -48,6 +49,7 @@ func main() {
 	rc := atlas.Connect(l)
 	party.InitRegistry(rc)
 	partyChar.InitRegistry(rc)
+	listing.InitRegistry(rc)
 
 	cmf := consumer.GetManager().AddConsumer(l, rt.Context(), rt.WaitGroup())
 	party2.InitConsumers(l)(cmf)(consumerGroupId)
@@ -14,6 +14,11 @@ const (
 	CommandPartyChangeLeader  = "CHANGE_LEADER"
 	CommandPartyRequestInvite = "REQUEST_INVITE"
 
+	CommandPartyPublishListing  = "PUBLISH_LISTING"
+	CommandPartyWithdrawListing = "WITHDRAW_LISTING"
+	CommandPartyApplyListing    = "APPLY_LISTING"
+	CommandPartyMatchListing    = "MATCH_LISTING"
+
 	EnvEventStatusTopic              = "EVENT_TOPIC_PARTY_STATUS"
 	EventPartyStatusTypeCreated      = "CREATED"
 	EventPartyStatusTypeJoined       = "JOINED"
@@ -39,6 +44,8 @@ const (
 	EventPartyStatusErrorTypeNotInChannel           = "YOU_MAY_ONLY_CHANGE_WITH_THE_PARTY_MEMBER_THATS_ON_THE_SAME_CHANNEL"
 	EventPartyStatusErrorTypeGmCannotCreate         = "AS_A_GM_YOURE_FORBIDDEN_FROM_CREATING_A_PARTY"
 	EventPartyStatusErrorTypeCannotFindCharacter    = "UNABLE_TO_FIND_THE_CHARACTER"
+	EventPartyStatusErrorTypeNotEligible            = "NOT_ELIGIBLE_FOR_PARTY_LISTING"
+	EventPartyStatusErrorTypeNoListing              = "NO_MATCHING_PARTY_LISTING"
 )
 
 type commandEvent[E any] struct {
@@ -63,6 +70,21 @@ type changeLeaderBody struct {
 	LeaderId uint32 `json:"leaderId"`
 }
 
+type publishListingCommandBody struct {
+	MinLevel    byte   `json:"minLevel"`
+	MaxLevel    byte   `json:"maxLevel"`
+	JobMask     uint32 `json:"jobMask"`
+	MaxMembers  byte   `json:"maxMembers"`
+	TargetMapId uint32 `json:"targetMapId"`
+	Description string `json:"description"`
+}
+
+type withdrawListingCommandBody struct{}
+
+type applyListingCommandBody struct {
+	PartyId uint32 `json:"partyId"`
+}
+
 type statusEvent[E any] struct {
 	ActorId       uint32    `json:"actorId"`
 	WorldId       world.Id  `json:"worldId"`
@@ -4,8 +4,10 @@ import (
 	"atlas-parties/character"
 	"atlas-parties/invite"
 	"atlas-parties/kafka/message"
+	"atlas-parties/listing"
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/google/uuid"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
@@ -27,6 +30,8 @@ var (
 	ErrNotIn         = errors.New("not in party")
 	ErrNotAsBeginner = errors.New("not as beginner")
 	ErrNotAsGm       = errors.New("not as gm")
+	ErrNotLeader     = errors.New("not leader")
+	ErrNotEligible   = errors.New("not eligible")
 )
 
 type Processor interface {
@@ -49,6 +54,14 @@ type Processor interface {
 	ChangeLeaderAndEmit(actorId uint32, partyId uint32, characterId uint32) (Model, error)
 	RequestInvite(mb *message.Buffer) func(actorId uint32, characterId uint32) error
 	RequestInviteAndEmit(actorId uint32, characterId uint32) error
+	PublishListing(mb *message.Buffer) func(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id, description string) (listing.Model, error)
+	PublishListingAndEmit(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id, description string) (listing.Model, error)
+	WithdrawListing(mb *message.Buffer) func(actorId uint32) error
+	WithdrawListingAndEmit(actorId uint32) error
+	ApplyListing(mb *message.Buffer) func(partyId uint32, characterId uint32) (Model, error)
+	ApplyListingAndEmit(partyId uint32, characterId uint32) (Model, error)
+	MatchListing(mb *message.Buffer) func(characterId uint32) (Model, error)
+	MatchListingAndEmit(characterId uint32) (Model, error)
 }
 
 type ProcessorImpl struct {
@@ -58,6 +71,7 @@ type ProcessorImpl struct {
 	p   producer.Provider
 	cp  character.Processor
 	ip  invite.Processor
+	lp  listing.Processor
 }
 
 func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
@@ -68,6 +82,7 @@ func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
 		p:   producer.ProviderImpl(l)(ctx),
 		cp:  character.NewProcessor(l, ctx),
 		ip:  invite.NewProcessor(l, ctx),
+		lp:  listing.NewProcessor(l, ctx),
 	}
 }
 
@@ -281,6 +296,11 @@ func (p *ProcessorImpl) Join(mb *message.Buffer) func(partyId uint32, characterI
 			return Model{}, err
 		}
 
+		err = p.lp.SetMemberCount(mb)(characterId, partyId, byte(len(party.Members())))
+		if err != nil {
+			p.l.WithError(err).Warnf("Unable to update party [%d] listing.", partyId)
+		}
+
 		return party, nil
 	}
 }
@@ -367,6 +387,11 @@ func (p *ProcessorImpl) Expel(mb *message.Buffer) func(actorId uint32, partyId u
 				p.l.Infof("Emitted disband event for party [%d] due to last member [%d] expulsion.", partyId, characterId)
 			}
 
+			err = p.lp.Remove(mb)(actorId, partyId, listing.RemovedReasonDisbanded)
+			if err != nil {
+				p.l.WithError(err).Warnf("Unable to remove party [%d] listing.", partyId)
+			}
+
 			// Party is empty, disband it
 			GetRegistry().Remove(p.ctx, partyId)
 			p.l.Infof("Party [%d] disbanded after expelling last member [%d].", partyId, characterId)
@@ -384,6 +409,11 @@ func (p *ProcessorImpl) Expel(mb *message.Buffer) func(actorId uint32, partyId u
 			return Model{}, err
 		}
 
+		err = p.lp.SetMemberCount(mb)(actorId, partyId, byte(len(party.Members())))
+		if err != nil {
+			p.l.WithError(err).Warnf("Unable to update party [%d] listing.", partyId)
+		}
+
 		return party, nil
 	}
 }
@@ -470,6 +500,11 @@ func (p *ProcessorImpl) Leave(mb *message.Buffer) func(partyId uint32, character
 				}
 			}
 
+			err = p.lp.Remove(mb)(characterId, partyId, listing.RemovedReasonDisbanded)
+			if err != nil {
+				p.l.WithError(err).Warnf("Unable to remove party [%d] listing.", partyId)
+			}
+
 			GetRegistry().Remove(p.ctx, partyId)
 			p.l.Debugf("Party [%d] has been disbanded.", partyId)
 			err = mb.Put(EnvEventStatusTopic, disbandEventProvider(characterId, partyId, c.WorldId(), formerMembers, transactionId))
@@ -492,6 +527,11 @@ func (p *ProcessorImpl) Leave(mb *message.Buffer) func(partyId uint32, character
 				}
 				return Model{}, err
 			}
+
+			err = p.lp.SetMemberCount(mb)(characterId, partyId, byte(len(party.Members())))
+			if err != nil {
+				p.l.WithError(err).Warnf("Unable to update party [%d] listing.", partyId)
+			}
 		}
 
 		return party, nil
@@ -579,6 +619,11 @@ func (p *ProcessorImpl) ChangeLeader(mb *message.Buffer) func(actorId uint32, pa
 		}
 
 		p.l.Debugf("Character [%d] became leader of party [%d].", characterId, partyId)
+		err = p.lp.Remove(mb)(actorId, partyId, listing.RemovedReasonLeaderChanged)
+		if err != nil {
+			p.l.WithError(err).Warnf("Unable to remove party [%d] listing.", partyId)
+		}
+
 		err = mb.Put(EnvEventStatusTopic, changeLeaderEventProvider(actorId, party.Id(), c.WorldId(), characterId))
 		if err != nil {
 			p.l.WithError(err).Errorf("Unable to announce leadership change in party [%d].", c.Id())
@@ -669,3 +714,278 @@ func (p *ProcessorImpl) RequestInvite(mb *message.Buffer) func(actorId uint32, c
 		return nil
 	}
 }
+
+func (p *ProcessorImpl) PublishListingAndEmit(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id, description string) (listing.Model, error) {
+	var lm listing.Model
+	var domainErr error
+	err := message.Emit(p.p)(func(buf *message.Buffer) error {
+		lm, domainErr = p.PublishListing(buf)(actorId, minLevel, maxLevel, jobMask, maxMembers, targetMapId, description)
+		return nil
+	})
+	if err != nil {
+		return lm, err
+	}
+	return lm, domainErr
+}
+
+// PublishListing places the actor's party on the party-search board and
+// invites eligible, unpartied characters standing in the leader's map until
+// the listing's open slots are spoken for. Publishing again replaces the
+// previous listing.
+func (p *ProcessorImpl) PublishListing(mb *message.Buffer) func(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id, description string) (listing.Model, error) {
+	return func(actorId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId _map.Id, description string) (listing.Model, error) {
+		a, err := p.cp.GetById(actorId)
+		if err != nil {
+			p.l.WithError(err).Errorf("Error getting character [%d].", actorId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, 0, a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party listing error to [%d].", actorId)
+			}
+			return listing.Model{}, err
+		}
+
+		if a.PartyId() == 0 {
+			p.l.Errorf("Character [%d] not in a party. Cannot publish a listing.", actorId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, 0, a.WorldId(), EventPartyStatusErrorTypeDoNotYetHaveParty, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party listing error to [%d].", actorId)
+			}
+			return listing.Model{}, ErrNotIn
+		}
+
+		party, err := GetRegistry().Get(p.ctx, a.PartyId())
+		if err != nil {
+			p.l.WithError(err).Errorf("Unable to retrieve party [%d].", a.PartyId())
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, a.PartyId(), a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", a.PartyId())
+			}
+			return listing.Model{}, err
+		}
+
+		if party.LeaderId() != actorId {
+			p.l.Errorf("Character [%d] is not leader of party [%d]. Cannot publish a listing.", actorId, party.Id())
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, party.Id(), a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", party.Id())
+			}
+			return listing.Model{}, ErrNotLeader
+		}
+
+		lm, err := listing.NewBuilder(p.t.Id(), party.Id(), actorId).
+			SetLocation(a.WorldId(), a.ChannelId()).
+			SetLevelRange(minLevel, maxLevel).
+			SetJobMask(jobMask).
+			SetMaxMembers(maxMembers).
+			SetMemberCount(byte(len(party.Members()))).
+			SetTargetMapId(targetMapId).
+			SetDescription(description).
+			Build()
+		if err != nil {
+			p.l.WithError(err).Errorf("Invalid listing for party [%d].", party.Id())
+			err2 := mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, party.Id(), a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err2 != nil {
+				p.l.WithError(err2).Errorf("Unable to announce party [%d] error.", party.Id())
+			}
+			return listing.Model{}, err
+		}
+
+		if !lm.Open() {
+			p.l.Errorf("Party [%d] already at capacity. Cannot publish a listing.", party.Id())
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, party.Id(), a.WorldId(), EventPartyStatusErrorTypeAtCapacity, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", party.Id())
+			}
+			return listing.Model{}, ErrAtCapacity
+		}
+
+		err = p.lp.Publish(mb)(lm)
+		if err != nil {
+			p.l.WithError(err).Errorf("Unable to publish party [%d] listing.", party.Id())
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, party.Id(), a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", party.Id())
+			}
+			return listing.Model{}, err
+		}
+
+		p.inviteListingCandidates(lm, a)
+		return lm, nil
+	}
+}
+
+// inviteListingCandidates sends party invites on the leader's behalf to
+// characters in the leader's map who fit the listing. Invites may still be
+// declined, so the listing stays up until the party actually fills.
+func (p *ProcessorImpl) inviteListingCandidates(lm listing.Model, leader character.Model) {
+	cs, err := p.cp.GetSlice(func(c character.Model) bool {
+		return c.Id() != leader.Id() && c.Online() && c.PartyId() == 0 && c.GM() == 0 &&
+			c.WorldId() == leader.WorldId() && c.ChannelId() == leader.ChannelId() && c.MapId() == leader.MapId() &&
+			lm.Eligible(c.Level(), c.JobId())
+	})
+	if err != nil {
+		p.l.WithError(err).Warnf("Unable to locate candidates for party [%d] listing.", lm.PartyId())
+		return
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Id() < cs[j].Id() })
+
+	open := int(lm.MaxMembers()) - int(lm.MemberCount())
+	for _, c := range cs {
+		if open <= 0 {
+			return
+		}
+		err = p.ip.Create(leader.Id(), leader.WorldId(), lm.PartyId(), c.Id())
+		if err != nil {
+			p.l.WithError(err).Warnf("Unable to invite [%d] to listed party [%d].", c.Id(), lm.PartyId())
+			continue
+		}
+		open--
+	}
+}
+
+func (p *ProcessorImpl) WithdrawListingAndEmit(actorId uint32) error {
+	var domainErr error
+	err := message.Emit(p.p)(func(buf *message.Buffer) error {
+		domainErr = p.WithdrawListing(buf)(actorId)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return domainErr
+}
+
+func (p *ProcessorImpl) WithdrawListing(mb *message.Buffer) func(actorId uint32) error {
+	return func(actorId uint32) error {
+		a, err := p.cp.GetById(actorId)
+		if err != nil {
+			p.l.WithError(err).Errorf("Error getting character [%d].", actorId)
+			return err
+		}
+
+		lm, err := p.lp.GetByPartyId(a.PartyId())
+		if err != nil {
+			p.l.Debugf("Party [%d] has no listing to withdraw.", a.PartyId())
+			return nil
+		}
+
+		if lm.LeaderId() != actorId {
+			p.l.Errorf("Character [%d] is not leader of party [%d]. Cannot withdraw its listing.", actorId, lm.PartyId())
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(actorId, lm.PartyId(), a.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", lm.PartyId())
+			}
+			return ErrNotLeader
+		}
+
+		return p.lp.Remove(mb)(actorId, lm.PartyId(), listing.RemovedReasonWithdrawn)
+	}
+}
+
+func (p *ProcessorImpl) ApplyListingAndEmit(partyId uint32, characterId uint32) (Model, error) {
+	var party Model
+	var domainErr error
+	err := message.Emit(p.p)(func(buf *message.Buffer) error {
+		party, domainErr = p.ApplyListing(buf)(partyId, characterId)
+		return nil
+	})
+	if err != nil {
+		return party, err
+	}
+	return party, domainErr
+}
+
+// ApplyListing joins characterId to a listed party once the listing's level
+// and job requirements are satisfied. Membership rules are left to Join.
+func (p *ProcessorImpl) ApplyListing(mb *message.Buffer) func(partyId uint32, characterId uint32) (Model, error) {
+	return func(partyId uint32, characterId uint32) (Model, error) {
+		c, err := p.cp.GetById(characterId)
+		if err != nil {
+			p.l.WithError(err).Errorf("Error getting character [%d].", characterId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, partyId, c.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", partyId)
+			}
+			return Model{}, err
+		}
+
+		lm, err := p.lp.GetByPartyId(partyId)
+		if err != nil {
+			p.l.Errorf("Party [%d] is not listed. Character [%d] cannot apply.", partyId, characterId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, partyId, c.WorldId(), EventPartyStatusErrorTypeNoListing, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", partyId)
+			}
+			return Model{}, listing.ErrNotFound
+		}
+
+		if lm.WorldId() != c.WorldId() || !lm.Eligible(c.Level(), c.JobId()) {
+			p.l.Errorf("Character [%d] does not meet party [%d] listing requirements.", characterId, partyId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, partyId, c.WorldId(), EventPartyStatusErrorTypeNotEligible, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", partyId)
+			}
+			return Model{}, ErrNotEligible
+		}
+
+		return p.Join(mb)(partyId, characterId)
+	}
+}
+
+func (p *ProcessorImpl) MatchListingAndEmit(characterId uint32) (Model, error) {
+	var party Model
+	var domainErr error
+	err := message.Emit(p.p)(func(buf *message.Buffer) error {
+		party, domainErr = p.MatchListing(buf)(characterId)
+		return nil
+	})
+	if err != nil {
+		return party, err
+	}
+	return party, domainErr
+}
+
+// MatchListing applies characterId to the longest-waiting listing they are
+// eligible for, preferring parties recruiting on the character's own channel.
+func (p *ProcessorImpl) MatchListing(mb *message.Buffer) func(characterId uint32) (Model, error) {
+	return func(characterId uint32) (Model, error) {
+		c, err := p.cp.GetById(characterId)
+		if err != nil {
+			p.l.WithError(err).Errorf("Error getting character [%d].", characterId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, 0, c.WorldId(), EventPartyStatusErrorUnexpected, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party listing error to [%d].", characterId)
+			}
+			return Model{}, err
+		}
+
+		if c.PartyId() != 0 {
+			p.l.Errorf("Character [%d] already in party. Cannot be matched to another one.", characterId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, c.PartyId(), c.WorldId(), EventPartyStatusErrorTypeAlreadyJoined2, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party [%d] error.", c.PartyId())
+			}
+			return Model{}, ErrAlreadyIn
+		}
+
+		ls, err := p.lp.GetSlice(listing.WorldFilter(c.WorldId()), listing.EligibleFilter(c.Level(), c.JobId()))
+		if err != nil || len(ls) == 0 {
+			p.l.Debugf("No party listing matches character [%d].", characterId)
+			err = mb.Put(EnvEventStatusTopic, errorEventProvider(characterId, 0, c.WorldId(), EventPartyStatusErrorTypeNoListing, ""))
+			if err != nil {
+				p.l.WithError(err).Errorf("Unable to announce party listing error to [%d].", characterId)
+			}
+			return Model{}, listing.ErrNotFound
+		}
+
+		match := ls[0]
+		for _, lm := range ls {
+			if lm.ChannelId() == c.ChannelId() {
+				match = lm
+				break
+			}
+		}
+		p.l.Debugf("Matched character [%d] to party [%d].", characterId, match.PartyId())
+		return p.Join(mb)(match.PartyId(), characterId)
+	}
+}
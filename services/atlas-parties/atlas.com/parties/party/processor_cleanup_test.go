@@ -3,6 +3,7 @@ package party
 import (
 	"atlas-parties/character"
 	"atlas-parties/kafka/message"
+	"atlas-parties/listing"
 	"context"
 	"testing"
 
@@ -27,6 +28,7 @@ func setupCleanupTestRegistry(t *testing.T) {
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	InitRegistry(rc)
 	character.InitRegistry(rc)
+	listing.InitRegistry(rc)
 }
 
 func TestCharacterDeletion_EdgeCases(t *testing.T) {
@@ -74,6 +76,7 @@ func TestDeletionIdempotency(t *testing.T) {
 			t:   ten,
 			cp:  character.NewProcessor(logger, ctx),
 			p:   nil,
+			lp:  listing.NewProcessor(logger, ctx),
 		}
 
 		// Create party and character
@@ -123,6 +126,7 @@ func TestCharacterDeletionEventFlow(t *testing.T) {
 			p:   producer.ProviderImpl(logger)(ctx), // Real producer that will fail to emit
 			cp:  charProcessor,
 			ip:  &mockInviteProcessor{}, // Add mock invite processor
+			lp:  listing.NewProcessor(logger, ctx),
 		}
 
 		// Create party with character
@@ -235,6 +239,7 @@ func TestCharacterDeletionEventFlow(t *testing.T) {
 			p:   producer.ProviderImpl(logger)(ctx), // Real producer that will fail to emit
 			cp:  charProcessor,
 			ip:  &mockInviteProcessor{}, // Add mock invite processor
+			lp:  listing.NewProcessor(logger, ctx),
 		}
 
 		// Create party with leader
@@ -0,0 +1,206 @@
+package party
+
+import (
+	"atlas-parties/character"
+	"atlas-parties/kafka/message"
+	"atlas-parties/listing"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type recordingInviteProcessor struct {
+	targets []uint32
+}
+
+func (m *recordingInviteProcessor) Create(_ uint32, _ world.Id, _ uint32, targetId uint32) error {
+	m.targets = append(m.targets, targetId)
+	return nil
+}
+
+// createOnlineCharacter registers a logged-in character standing in mapId.
+func createOnlineCharacter(ctx context.Context, id uint32, level byte, jobId job.Id, mapId _map.Id) {
+	f := field.NewBuilder(1, 1, mapId).Build()
+	character.GetRegistry().Create(ctx, f, id, "TestChar", level, jobId, 0)
+	character.GetRegistry().Update(ctx, id, character.Model.Login)
+}
+
+// createListedParty creates a party led by leaderId and publishes a listing for it.
+func createListedParty(t *testing.T, p *ProcessorImpl, ctx context.Context, leaderId uint32, maxMembers byte) uint32 {
+	t.Helper()
+	createOnlineCharacter(ctx, leaderId, 50, job.Id(110), 100000)
+	party, err := p.Create(message.NewBuffer())(leaderId)
+	require.NoError(t, err)
+
+	_, err = p.PublishListing(message.NewBuffer())(leaderId, 40, 60, 0, maxMembers, 103000800, "")
+	require.NoError(t, err)
+	return party.Id()
+}
+
+func statusEventTypes(t *testing.T, mb *message.Buffer) []string {
+	t.Helper()
+	var result []string
+	for _, m := range mb.GetAll()[EnvEventStatusTopic] {
+		var e statusEvent[json.RawMessage]
+		require.NoError(t, json.Unmarshal(m.Value, &e))
+		result = append(result, e.Type)
+	}
+	return result
+}
+
+func TestPublishListing_InvitesEligibleCandidates(t *testing.T) {
+	p, ctx := setupTest(t)
+	invites := &recordingInviteProcessor{}
+	p.ip = invites
+
+	createOnlineCharacter(ctx, 1, 50, job.Id(110), 100000)
+	party, err := p.Create(message.NewBuffer())(1)
+	require.NoError(t, err)
+
+	createOnlineCharacter(ctx, 2, 45, job.Id(210), 100000) // eligible
+	createOnlineCharacter(ctx, 3, 20, job.Id(210), 100000) // under level
+	createOnlineCharacter(ctx, 4, 45, job.Id(410), 100000) // excluded job
+	createOnlineCharacter(ctx, 5, 45, job.Id(210), 100001) // another map
+	createOnlineCharacter(ctx, 6, 45, job.Id(210), 100000) // eligible, but no slot left
+
+	mb := message.NewBuffer()
+	lm, err := p.PublishListing(mb)(1, 40, 60, listing.JobBit(job.Id(100))|listing.JobBit(job.Id(200)), 2, 103000800, "Kerning PQ")
+	require.NoError(t, err)
+	assert.Equal(t, party.Id(), lm.PartyId())
+	assert.Equal(t, byte(1), lm.MemberCount())
+	assert.Equal(t, []string{listing.EventPartyStatusTypeListingPublished}, statusEventTypes(t, mb))
+	assert.Equal(t, []uint32{2}, invites.targets)
+
+	stored, err := p.lp.GetByPartyId(party.Id())
+	require.NoError(t, err)
+	assert.Equal(t, "Kerning PQ", stored.Description())
+}
+
+func TestPublishListing_Rejections(t *testing.T) {
+	p, ctx := setupTest(t)
+	createOnlineCharacter(ctx, 1, 50, job.Id(110), 100000)
+	createOnlineCharacter(ctx, 2, 50, job.Id(110), 100000)
+
+	_, err := p.PublishListing(message.NewBuffer())(1, 40, 60, 0, 6, 0, "")
+	assert.ErrorIs(t, err, ErrNotIn)
+
+	party, err := p.Create(message.NewBuffer())(1)
+	require.NoError(t, err)
+	_, err = p.Join(message.NewBuffer())(party.Id(), 2)
+	require.NoError(t, err)
+
+	_, err = p.PublishListing(message.NewBuffer())(2, 40, 60, 0, 6, 0, "")
+	assert.ErrorIs(t, err, ErrNotLeader)
+
+	_, err = p.PublishListing(message.NewBuffer())(1, 40, 60, 0, 2, 0, "")
+	assert.ErrorIs(t, err, ErrAtCapacity)
+
+	ls, err := p.lp.GetSlice()
+	require.NoError(t, err)
+	assert.Empty(t, ls)
+}
+
+func TestApplyListing_FillingPartyRemovesListing(t *testing.T) {
+	p, ctx := setupTest(t)
+	partyId := createListedParty(t, p, ctx, 1, 3)
+	createOnlineCharacter(ctx, 2, 45, job.Id(210), 100000)
+	createOnlineCharacter(ctx, 3, 55, job.Id(310), 100000)
+
+	mb := message.NewBuffer()
+	_, err := p.ApplyListing(mb)(partyId, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{EventPartyStatusTypeJoined}, statusEventTypes(t, mb))
+	lm, err := p.lp.GetByPartyId(partyId)
+	require.NoError(t, err)
+	assert.Equal(t, byte(2), lm.MemberCount())
+
+	mb = message.NewBuffer()
+	_, err = p.ApplyListing(mb)(partyId, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{EventPartyStatusTypeJoined, listing.EventPartyStatusTypeListingRemoved}, statusEventTypes(t, mb))
+	_, err = p.lp.GetByPartyId(partyId)
+	assert.ErrorIs(t, err, listing.ErrNotFound)
+}
+
+func TestApplyListing_RejectsIneligible(t *testing.T) {
+	p, ctx := setupTest(t)
+	partyId := createListedParty(t, p, ctx, 1, 6)
+	createOnlineCharacter(ctx, 2, 10, job.Id(210), 100000)
+
+	mb := message.NewBuffer()
+	_, err := p.ApplyListing(mb)(partyId, 2)
+	assert.ErrorIs(t, err, ErrNotEligible)
+	assert.Equal(t, []string{EventPartyStatusTypeError}, statusEventTypes(t, mb))
+
+	c, err := character.GetRegistry().Get(ctx, 2)
+	require.NoError(t, err)
+	assert.Zero(t, c.PartyId())
+}
+
+func TestMatchListing_PrefersOwnChannel(t *testing.T) {
+	p, ctx := setupTest(t)
+	first := createListedParty(t, p, ctx, 1, 6)
+	second := createListedParty(t, p, ctx, 2, 6)
+
+	// Move the older listing to another channel.
+	lm, err := p.lp.GetByPartyId(first)
+	require.NoError(t, err)
+	moved, err := listing.NewBuilder(p.t.Id(), lm.PartyId(), lm.LeaderId()).SetLocation(lm.WorldId(), 2).SetLevelRange(lm.MinLevel(), lm.MaxLevel()).Build()
+	require.NoError(t, err)
+	require.NoError(t, listing.GetRegistry().Put(ctx, moved))
+
+	createOnlineCharacter(ctx, 3, 45, job.Id(210), 100000)
+	party, err := p.MatchListing(message.NewBuffer())(3)
+	require.NoError(t, err)
+	assert.Equal(t, second, party.Id())
+
+	createOnlineCharacter(ctx, 4, 90, job.Id(210), 100000)
+	_, err = p.MatchListing(message.NewBuffer())(4)
+	assert.ErrorIs(t, err, listing.ErrNotFound)
+}
+
+func TestListingRemovedWithParty(t *testing.T) {
+	tests := []struct {
+		name   string
+		action func(p *ProcessorImpl, mb *message.Buffer, partyId uint32) error
+	}{
+		{name: "leader disbands", action: func(p *ProcessorImpl, mb *message.Buffer, partyId uint32) error {
+			_, err := p.Leave(mb)(partyId, 1, uuid.Nil)
+			return err
+		}},
+		{name: "leadership changes", action: func(p *ProcessorImpl, mb *message.Buffer, partyId uint32) error {
+			_, err := p.ChangeLeader(mb)(1, partyId, 2)
+			return err
+		}},
+		{name: "leader logs out", action: func(p *ProcessorImpl, mb *message.Buffer, _ uint32) error {
+			return character.NewProcessor(p.l, p.ctx).Logout(mb)(1)
+		}},
+		{name: "leader withdraws", action: func(p *ProcessorImpl, mb *message.Buffer, _ uint32) error {
+			return p.WithdrawListing(mb)(1)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ctx := setupTest(t)
+			partyId := createListedParty(t, p, ctx, 1, 6)
+			createOnlineCharacter(ctx, 2, 45, job.Id(210), 100000)
+			_, err := p.Join(message.NewBuffer())(partyId, 2)
+			require.NoError(t, err)
+
+			mb := message.NewBuffer()
+			require.NoError(t, tt.action(p, mb, partyId))
+			assert.Contains(t, statusEventTypes(t, mb), listing.EventPartyStatusTypeListingRemoved)
+			_, err = p.lp.GetByPartyId(partyId)
+			assert.ErrorIs(t, err, listing.ErrNotFound)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package party
 import (
 	"atlas-parties/character"
 	"atlas-parties/kafka/message"
+	"atlas-parties/listing"
 	"context"
 	"encoding/json"
 	"errors"
@@ -35,6 +36,7 @@ func setupTest(t *testing.T) (*ProcessorImpl, context.Context) {
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	InitRegistry(rc)
 	character.InitRegistry(rc)
+	listing.InitRegistry(rc)
 
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
@@ -51,6 +53,7 @@ func setupTest(t *testing.T) (*ProcessorImpl, context.Context) {
 		p:   nil, // Use nil for Leave tests, separate setup for LeaveAndEmit tests
 		cp:  character.NewProcessor(logger, ctx),
 		ip:  mockInvite,
+		lp:  listing.NewProcessor(logger, ctx),
 	}
 
 	return processor, ctx
@@ -62,6 +65,7 @@ func setupTestWithProducer(t *testing.T) (*ProcessorImpl, context.Context) {
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	InitRegistry(rc)
 	character.InitRegistry(rc)
+	listing.InitRegistry(rc)
 
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
@@ -81,6 +85,7 @@ func setupTestWithProducer(t *testing.T) (*ProcessorImpl, context.Context) {
 		p:   mockProducerProvider,
 		cp:  character.NewProcessor(logger, ctx),
 		ip:  mockInvite,
+		lp:  listing.NewProcessor(logger, ctx),
 	}
 
 	return processor, ctx
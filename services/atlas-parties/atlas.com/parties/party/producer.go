@@ -57,6 +57,45 @@ func changeLeaderCommandProvider(characterId uint32, partyId uint32, leaderId ui
 	return producer.SingleMessageProvider(key, value)
 }
 
+func publishCommandProvider(leaderId uint32, minLevel byte, maxLevel byte, jobMask uint32, maxMembers byte, targetMapId uint32, description string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(leaderId))
+	value := &commandEvent[publishListingCommandBody]{
+		ActorId: leaderId,
+		Type:    CommandPartyPublishListing,
+		Body: publishListingCommandBody{
+			MinLevel:    minLevel,
+			MaxLevel:    maxLevel,
+			JobMask:     jobMask,
+			MaxMembers:  maxMembers,
+			TargetMapId: targetMapId,
+			Description: description,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func withdrawCommandProvider(leaderId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(leaderId))
+	value := &commandEvent[withdrawListingCommandBody]{
+		ActorId: leaderId,
+		Type:    CommandPartyWithdrawListing,
+		Body:    withdrawListingCommandBody{},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func applyCommandProvider(partyId uint32, characterId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &commandEvent[applyListingCommandBody]{
+		ActorId: characterId,
+		Type:    CommandPartyApplyListing,
+		Body: applyListingCommandBody{
+			PartyId: partyId,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 func createdEventProvider(actorId uint32, partyId uint32, worldId world.Id) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(partyId))
 	value := &statusEvent[createdEventBody]{
@@ -1,8 +1,10 @@
 package party
 
 import (
+	"atlas-parties/listing"
 	"atlas-parties/rest"
 	"net/http"
+	"net/url"
 	"sort"
 	"strconv"
 
@@ -12,6 +14,9 @@ import (
 	"github.com/jtumidanski/api2go/jsonapi"
 	"github.com/sirupsen/logrus"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
 	"github.com/Chronicle20/atlas/libs/atlas-rest/server/paginate"
@@ -27,6 +32,11 @@ const (
 	CreatePartyMember    = "create_party_member"
 	GetPartyMember       = "get_party_member"
 	RemovePartyMember    = "remove_party_member"
+	GetListings          = "get_party_listings"
+	GetListing           = "get_party_listing"
+	CreateListing        = "create_party_listing"
+	DeleteListing        = "delete_party_listing"
+	CreateApplication    = "create_party_listing_application"
 )
 
 func InitResource(si jsonapi.ServerInformation) server.RouteInitializer {
@@ -36,6 +46,7 @@ func InitResource(si jsonapi.ServerInformation) server.RouteInitializer {
 		r.HandleFunc("", registerGet(GetPartiesByMemberId, handleGetParties)).Queries("filter[members.id]", "{memberId}").Methods(http.MethodGet)
 		r.HandleFunc("", registerGet(GetParties, handleGetParties)).Methods(http.MethodGet)
 		r.HandleFunc("", rest.RegisterInputHandler[RestModel](l)(si)(CreateParty, handleCreateParty)).Methods(http.MethodPost)
+		r.HandleFunc("/listings", registerGet(GetListings, handleGetListings)).Methods(http.MethodGet)
 		r.HandleFunc("/{partyId}", registerGet(GetParty, handleGetParty)).Methods(http.MethodGet)
 		r.HandleFunc("/{partyId}", rest.RegisterInputHandler[RestModel](l)(si)(UpdateParty, handleUpdateParty)).Methods(http.MethodPatch)
 		r.HandleFunc("/{partyId}/members", registerGet(GetPartyMembers, handleGetPartyMembers)).Methods(http.MethodGet)
@@ -43,6 +54,10 @@ func InitResource(si jsonapi.ServerInformation) server.RouteInitializer {
 		r.HandleFunc("/{partyId}/members", rest.RegisterInputHandler[MemberRestModel](l)(si)(CreatePartyMember, handleCreatePartyMember)).Methods(http.MethodPost)
 		r.HandleFunc("/{partyId}/members/{memberId}", registerGet(GetPartyMember, handleGetPartyMember)).Methods(http.MethodGet)
 		r.HandleFunc("/{partyId}/members/{memberId}", rest.RegisterHandler(l)(si)(RemovePartyMember, handleRemovePartyMember)).Methods(http.MethodDelete)
+		r.HandleFunc("/{partyId}/listing", registerGet(GetListing, handleGetListing)).Methods(http.MethodGet)
+		r.HandleFunc("/{partyId}/listing", rest.RegisterInputHandler[listing.RestModel](l)(si)(CreateListing, handleCreateListing)).Methods(http.MethodPost)
+		r.HandleFunc("/{partyId}/listing", rest.RegisterHandler(l)(si)(DeleteListing, handleDeleteListing)).Methods(http.MethodDelete)
+		r.HandleFunc("/{partyId}/listing/applications", rest.RegisterInputHandler[listing.ApplicationRestModel](l)(si)(CreateApplication, handleCreateApplication)).Methods(http.MethodPost)
 	}
 }
 
@@ -241,3 +256,148 @@ func handleRemovePartyMember(d *rest.HandlerDependency, _ *rest.HandlerContext)
 		})
 	})
 }
+
+// listingFilters translates the board's query parameters. level and jobId
+// narrow the board to listings a given character could apply to.
+func listingFilters(q url.Values) ([]model.Filter[listing.Model], error) {
+	filters := make([]model.Filter[listing.Model], 0)
+	parse := func(key string, bits int, apply func(uint64)) error {
+		v := q.Get(key)
+		if v == "" {
+			return nil
+		}
+		n, err := strconv.ParseUint(v, 10, bits)
+		if err != nil {
+			return err
+		}
+		apply(n)
+		return nil
+	}
+	if err := parse("filter[worldId]", 8, func(n uint64) { filters = append(filters, listing.WorldFilter(world.Id(n))) }); err != nil {
+		return nil, err
+	}
+	if err := parse("filter[channelId]", 8, func(n uint64) { filters = append(filters, listing.ChannelFilter(channel.Id(n))) }); err != nil {
+		return nil, err
+	}
+	if err := parse("filter[level]", 8, func(n uint64) { filters = append(filters, listing.LevelFilter(byte(n))) }); err != nil {
+		return nil, err
+	}
+	if err := parse("filter[jobId]", 16, func(n uint64) { filters = append(filters, listing.JobFilter(job.Id(n))) }); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+func handleGetListings(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, err := paginate.ParseParams(r.URL.Query(), paginate.DefaultPageSize, paginate.MaxPageSize)
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, "invalid page[number]/page[size]")
+			return
+		}
+
+		filters, err := listingFilters(r.URL.Query())
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, "invalid listing filter")
+			return
+		}
+
+		ls, err := listing.NewProcessor(d.Logger(), d.Context()).GetSlice(filters...)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		paged := paginate.Slice(ls, page)
+
+		res, err := model.SliceMap(listing.Transform)(model.FixedProvider(paged.Items))()()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating REST model.")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		server.MarshalPaginatedResponse[[]listing.RestModel](d.Logger())(w)(c.ServerInformation())(r.URL.Query())(res, paginate.EnvelopeFor(paged), r)
+	}
+}
+
+func handleGetListing(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParsePartyId(d.Logger(), func(partyId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			lm, err := listing.NewProcessor(d.Logger(), d.Context()).GetByPartyId(partyId)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			res, err := model.Map(listing.Transform)(model.FixedProvider(lm))()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			server.MarshalResponse[listing.RestModel](d.Logger())(w)(c.ServerInformation())(r.URL.Query())(res)
+		}
+	})
+}
+
+func handleCreateListing(d *rest.HandlerDependency, _ *rest.HandlerContext, i listing.RestModel) http.HandlerFunc {
+	return rest.ParsePartyId(d.Logger(), func(partyId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			p, err := NewProcessor(d.Logger(), d.Context()).GetById(partyId)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if i.LeaderId != 0 && i.LeaderId != p.LeaderId() {
+				server.WriteBadRequest(d.Logger(), w, "leaderId does not lead this party")
+				return
+			}
+
+			ep := producer.ProviderImpl(d.Logger())(d.Context())
+			err = ep(EnvCommandTopic)(publishCommandProvider(p.LeaderId(), i.MinLevel, i.MaxLevel, i.JobMask, i.MaxMembers, uint32(i.TargetMapId), i.Description))
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+}
+
+func handleDeleteListing(d *rest.HandlerDependency, _ *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParsePartyId(d.Logger(), func(partyId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			lm, err := listing.NewProcessor(d.Logger(), d.Context()).GetByPartyId(partyId)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			ep := producer.ProviderImpl(d.Logger())(d.Context())
+			err = ep(EnvCommandTopic)(withdrawCommandProvider(lm.LeaderId()))
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+}
+
+func handleCreateApplication(d *rest.HandlerDependency, _ *rest.HandlerContext, i listing.ApplicationRestModel) http.HandlerFunc {
+	return rest.ParsePartyId(d.Logger(), func(partyId uint32) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ep := producer.ProviderImpl(d.Logger())(d.Context())
+			err := ep(EnvCommandTopic)(applyCommandProvider(partyId, i.Id))
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package party
 
 import (
 	"atlas-parties/character"
+	"atlas-parties/listing"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -31,6 +32,7 @@ func setupPartiesRegistries(t *testing.T) {
 	rc := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
 	InitRegistry(rc)
 	character.InitRegistry(rc)
+	listing.InitRegistry(rc)
 }
 
 type partiesTestServerInformation struct{}
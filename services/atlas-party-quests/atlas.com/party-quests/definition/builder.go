@@ -22,6 +22,7 @@ type Builder struct {
 	failRequirements  []condition.Model
 	exit              uint32
 	bonus             *Bonus
+	expedition        *Expedition
 	stages            []stage.Model
 	rewards           []reward.Model
 	createdAt         time.Time
@@ -96,6 +97,11 @@ func (b *Builder) SetBonus(bonus *Bonus) *Builder {
 	return b
 }
 
+func (b *Builder) SetExpedition(expedition *Expedition) *Builder {
+	b.expedition = expedition
+	return b
+}
+
 func (b *Builder) SetStages(stages []stage.Model) *Builder {
 	b.stages = stages
 	return b
@@ -135,6 +141,7 @@ func (b *Builder) Build() (Model, error) {
 		failRequirements:  b.failRequirements,
 		exit:              b.exit,
 		bonus:             b.bonus,
+		expedition:        b.expedition,
 		stages:            b.stages,
 		rewards:           b.rewards,
 		createdAt:         b.createdAt,
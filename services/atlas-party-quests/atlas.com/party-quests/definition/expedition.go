@@ -0,0 +1,24 @@
+package definition
+
+import (
+	"github.com/Chronicle20/atlas/libs/atlas-script-core/condition"
+)
+
+// Expedition is the squad policy of a boss expedition (registration type
+// "expedition"). The first character to register leads the squad; the squad
+// starts only with at least minMembers and never grows past maxMembers. Every
+// member must pass the entry conditions and still have entries left in the
+// current daily and weekly windows. A limit of 0 means unlimited.
+type Expedition struct {
+	minMembers      uint32
+	maxMembers      uint32
+	dailyLimit      uint32
+	weeklyLimit     uint32
+	entryConditions []condition.Model
+}
+
+func (e Expedition) MinMembers() uint32                 { return e.minMembers }
+func (e Expedition) MaxMembers() uint32                 { return e.maxMembers }
+func (e Expedition) DailyLimit() uint32                 { return e.dailyLimit }
+func (e Expedition) WeeklyLimit() uint32                { return e.weeklyLimit }
+func (e Expedition) EntryConditions() []condition.Model { return e.entryConditions }
@@ -6,6 +6,8 @@ import (
 	"atlas-party-quests/stage"
 	"fmt"
 
+	scriptcondition "github.com/Chronicle20/atlas/libs/atlas-script-core/condition"
+
 	"github.com/google/uuid"
 	"github.com/jtumidanski/api2go/jsonapi"
 )
@@ -28,6 +30,25 @@ type BonusRestModel struct {
 	Properties      map[string]any `json:"properties,omitempty"`
 }
 
+// ExpeditionConditionRestModel is an atlas-script-core condition. Value is a
+// string so it may carry the script arithmetic the evaluator understands.
+type ExpeditionConditionRestModel struct {
+	Type            string `json:"type"`
+	Operator        string `json:"operator"`
+	Value           string `json:"value"`
+	ReferenceId     string `json:"referenceId,omitempty"`
+	Step            string `json:"step,omitempty"`
+	IncludeEquipped bool   `json:"includeEquipped,omitempty"`
+}
+
+type ExpeditionRestModel struct {
+	MinMembers      uint32                         `json:"minMembers"`
+	MaxMembers      uint32                         `json:"maxMembers"`
+	DailyLimit      uint32                         `json:"dailyLimit,omitempty"`
+	WeeklyLimit     uint32                         `json:"weeklyLimit,omitempty"`
+	EntryConditions []ExpeditionConditionRestModel `json:"entryConditions,omitempty"`
+}
+
 type EventTriggerRestModel struct {
 	Type   string `json:"type"`
 	Target string `json:"target"`
@@ -46,6 +67,7 @@ type RestModel struct {
 	FailRequirements  []condition.RestModel   `json:"failRequirements"`
 	Exit              uint32                  `json:"exit"`
 	Bonus             *BonusRestModel         `json:"bonus,omitempty"`
+	Expedition        *ExpeditionRestModel    `json:"expedition,omitempty"`
 	Stages            []stage.RestModel       `json:"stages"`
 	Rewards           []reward.RestModel      `json:"rewards"`
 }
@@ -148,6 +170,29 @@ func Transform(m Model) (RestModel, error) {
 		}
 	}
 
+	var expeditionRest *ExpeditionRestModel
+	if m.Expedition() != nil {
+		e := m.Expedition()
+		conditions := make([]ExpeditionConditionRestModel, 0, len(e.EntryConditions()))
+		for _, c := range e.EntryConditions() {
+			conditions = append(conditions, ExpeditionConditionRestModel{
+				Type:            c.Type(),
+				Operator:        c.Operator(),
+				Value:           c.Value(),
+				ReferenceId:     c.ReferenceIdRaw(),
+				Step:            c.Step(),
+				IncludeEquipped: c.IncludeEquipped(),
+			})
+		}
+		expeditionRest = &ExpeditionRestModel{
+			MinMembers:      e.MinMembers(),
+			MaxMembers:      e.MaxMembers(),
+			DailyLimit:      e.DailyLimit(),
+			WeeklyLimit:     e.WeeklyLimit(),
+			EntryConditions: conditions,
+		}
+	}
+
 	reg := m.Registration()
 	return RestModel{
 		Id:        m.Id(),
@@ -167,6 +212,7 @@ func Transform(m Model) (RestModel, error) {
 		FailRequirements:  failReqs,
 		Exit:              m.Exit(),
 		Bonus:             bonusRest,
+		Expedition:        expeditionRest,
 		Stages:            stages,
 		Rewards:           rewards,
 	}, nil
@@ -236,6 +282,32 @@ func Extract(r RestModel) (Model, error) {
 		}
 	}
 
+	var expedition *Expedition
+	if r.Expedition != nil {
+		conditions := make([]scriptcondition.Model, 0, len(r.Expedition.EntryConditions))
+		for _, rc := range r.Expedition.EntryConditions {
+			c, err := scriptcondition.NewBuilder().
+				SetType(rc.Type).
+				SetOperator(rc.Operator).
+				SetValue(rc.Value).
+				SetReferenceId(rc.ReferenceId).
+				SetStep(rc.Step).
+				SetIncludeEquipped(rc.IncludeEquipped).
+				Build()
+			if err != nil {
+				return Model{}, err
+			}
+			conditions = append(conditions, c)
+		}
+		expedition = &Expedition{
+			minMembers:      r.Expedition.MinMembers,
+			maxMembers:      r.Expedition.MaxMembers,
+			dailyLimit:      r.Expedition.DailyLimit,
+			weeklyLimit:     r.Expedition.WeeklyLimit,
+			entryConditions: conditions,
+		}
+	}
+
 	builder := NewBuilder()
 	if r.Id != uuid.Nil {
 		builder.SetId(r.Id)
@@ -258,6 +330,7 @@ func Extract(r RestModel) (Model, error) {
 		SetFailRequirements(failReqs).
 		SetExit(r.Exit).
 		SetBonus(bonus).
+		SetExpedition(expedition).
 		SetStages(stages).
 		SetRewards(rewards).
 		Build()
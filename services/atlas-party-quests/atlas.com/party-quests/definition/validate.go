@@ -85,6 +85,7 @@ var validFieldLocks = map[string]bool{
 var validRegTypes = map[string]bool{
 	"party":      true,
 	"individual": true,
+	"expedition": true,
 }
 
 var validAffinities = map[string]bool{
@@ -176,6 +177,7 @@ func Validate(rm RestModel) ValidationResult {
 	}
 
 	validateRegistration(&result, rm.Registration)
+	validateExpedition(&result, rm.Registration, rm.Expedition)
 	validateRequirementConditions(&result, rm.StartRequirements, "startRequirements")
 	validateRequirementConditions(&result, rm.FailRequirements, "failRequirements")
 	validateBonus(&result, rm.Bonus)
@@ -191,7 +193,7 @@ func Validate(rm RestModel) ValidationResult {
 
 func validateRegistration(result *ValidationResult, reg RegistrationRestModel) {
 	if reg.Type != "" && !validRegTypes[reg.Type] {
-		result.addError(fmt.Sprintf("invalid registration type %q, must be one of: party, individual", reg.Type))
+		result.addError(fmt.Sprintf("invalid registration type %q, must be one of: party, individual, expedition", reg.Type))
 	}
 	if reg.Mode != "" && !validRegModes[reg.Mode] {
 		result.addError(fmt.Sprintf("invalid registration mode %q, must be one of: instant, timed", reg.Mode))
@@ -204,6 +206,38 @@ func validateRegistration(result *ValidationResult, reg RegistrationRestModel) {
 	}
 }
 
+func validateExpedition(result *ValidationResult, reg RegistrationRestModel, expedition *ExpeditionRestModel) {
+	if expedition == nil {
+		if reg.Type == "expedition" {
+			result.addError("expedition registration requires an expedition block")
+		}
+		return
+	}
+	if reg.Type != "expedition" {
+		result.addError(fmt.Sprintf("expedition block requires registration type expedition, got %q", reg.Type))
+	}
+	if expedition.MinMembers == 0 {
+		result.addError("expedition.minMembers must be at least 1")
+	}
+	if expedition.MaxMembers < expedition.MinMembers {
+		result.addError(fmt.Sprintf("expedition.maxMembers %d is below minMembers %d", expedition.MaxMembers, expedition.MinMembers))
+	}
+	if expedition.DailyLimit > 0 && expedition.WeeklyLimit > 0 && expedition.WeeklyLimit < expedition.DailyLimit {
+		result.addWarning("expedition.weeklyLimit is below dailyLimit, the daily limit can never be reached")
+	}
+	for i, c := range expedition.EntryConditions {
+		if c.Type == "" {
+			result.addError(fmt.Sprintf("expedition.entryConditions[%d] has no type", i))
+		}
+		if !validClearOperators[c.Operator] {
+			result.addError(fmt.Sprintf("expedition.entryConditions[%d] has invalid operator %q", i, c.Operator))
+		}
+		if c.Value == "" {
+			result.addError(fmt.Sprintf("expedition.entryConditions[%d] has no value", i))
+		}
+	}
+}
+
 func validateBonus(result *ValidationResult, bonus *BonusRestModel) {
 	if bonus == nil {
 		return
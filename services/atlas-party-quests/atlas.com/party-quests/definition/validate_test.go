@@ -155,3 +155,73 @@ func TestValidate_NoStubDefinitionsHaveInvalidData(t *testing.T) {
 		})
 	}
 }
+
+func expeditionRestModel(e *ExpeditionRestModel) RestModel {
+	return RestModel{
+		QuestId:  "zakum_expedition",
+		Name:     "Zakum Expedition",
+		Exit:     211042300,
+		Duration: 7200,
+		Registration: RegistrationRestModel{
+			Type:     "expedition",
+			Mode:     "timed",
+			Duration: 300,
+			MapId:    211042300,
+		},
+		Expedition: e,
+	}
+}
+
+func TestValidate_Expedition(t *testing.T) {
+	valid := &ExpeditionRestModel{
+		MinMembers:      6,
+		MaxMembers:      30,
+		DailyLimit:      2,
+		EntryConditions: []ExpeditionConditionRestModel{{Type: "level", Operator: ">=", Value: "50"}},
+	}
+	result := Validate(expeditionRestModel(valid))
+	assert.True(t, result.Valid, strings.Join(result.Errors, "; "))
+
+	result = Validate(expeditionRestModel(nil))
+	assert.False(t, result.Valid, "expedition registration requires an expedition block")
+
+	rm := expeditionRestModel(valid)
+	rm.Registration.Type = "party"
+	result = Validate(rm)
+	assert.False(t, result.Valid, "an expedition block requires expedition registration")
+
+	result = Validate(expeditionRestModel(&ExpeditionRestModel{MinMembers: 0, MaxMembers: 30}))
+	assert.False(t, result.Valid, "minMembers must be at least 1")
+
+	result = Validate(expeditionRestModel(&ExpeditionRestModel{MinMembers: 10, MaxMembers: 6}))
+	assert.False(t, result.Valid, "maxMembers must not be below minMembers")
+
+	result = Validate(expeditionRestModel(&ExpeditionRestModel{MinMembers: 1, MaxMembers: 6,
+		EntryConditions: []ExpeditionConditionRestModel{{Type: "level", Operator: "gte", Value: "50"}}}))
+	assert.False(t, result.Valid, "entry condition operators follow clear condition operators")
+
+	result = Validate(expeditionRestModel(&ExpeditionRestModel{MinMembers: 1, MaxMembers: 6, DailyLimit: 3, WeeklyLimit: 2}))
+	assert.True(t, result.Valid)
+	assert.NotEmpty(t, result.Warnings, "a weekly limit below the daily limit is suspicious")
+}
+
+func TestExtract_Expedition(t *testing.T) {
+	m, err := Extract(expeditionRestModel(&ExpeditionRestModel{
+		MinMembers:      6,
+		MaxMembers:      30,
+		WeeklyLimit:     3,
+		EntryConditions: []ExpeditionConditionRestModel{{Type: "level", Operator: ">=", Value: "120"}},
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, m.Expedition())
+	assert.Equal(t, uint32(6), m.Expedition().MinMembers())
+	assert.Equal(t, uint32(3), m.Expedition().WeeklyLimit())
+	require.Len(t, m.Expedition().EntryConditions(), 1)
+	assert.Equal(t, "120", m.Expedition().EntryConditions()[0].Value())
+
+	rm, err := Transform(m)
+	require.NoError(t, err)
+	require.NotNil(t, rm.Expedition)
+	assert.Equal(t, uint32(30), rm.Expedition.MaxMembers)
+	assert.Equal(t, ">=", rm.Expedition.EntryConditions[0].Operator)
+}
@@ -0,0 +1,56 @@
+package expedition
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func createEntry(db *gorm.DB, questId string, instanceId uuid.UUID, characterId uint32, enteredAt time.Time) error {
+	e := entryEntity{
+		ID:          uuid.New(),
+		CharacterId: characterId,
+		QuestId:     questId,
+		InstanceId:  instanceId,
+		EnteredAt:   enteredAt,
+	}
+	return db.Create(&e).Error
+}
+
+func createRun(db *gorm.DB, r Run) (Run, error) {
+	ids, err := json.Marshal(r.MemberIds())
+	if err != nil {
+		return Run{}, err
+	}
+	e := runEntity{
+		ID:         uuid.New(),
+		QuestId:    r.QuestId(),
+		Outcome:    r.Outcome(),
+		DurationMs: r.Duration().Milliseconds(),
+		InstanceId: r.InstanceId(),
+		WorldId:    byte(r.WorldId()),
+		ChannelId:  byte(r.ChannelId()),
+		LeaderId:   r.LeaderId(),
+		MemberIds:  string(ids),
+		Reason:     r.Reason(),
+		StartedAt:  r.StartedAt(),
+		EndedAt:    r.EndedAt(),
+	}
+	if err := db.Create(&e).Error; err != nil {
+		return Run{}, err
+	}
+	return makeRun(e)
+}
+
+func decodeMemberIds(s string) ([]uint32, error) {
+	ids := make([]uint32, 0)
+	if s == "" {
+		return ids, nil
+	}
+	if err := json.Unmarshal([]byte(s), &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,48 @@
+package expedition
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// entryEntity records one character entering one expedition run. The rows
+// are what the daily and weekly entry limits count.
+type entryEntity struct {
+	ID          uuid.UUID `gorm:"primaryKey;column:id;type:uuid"`
+	TenantId    uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;index:idx_expedition_entry_lookup,priority:1"`
+	CharacterId uint32    `gorm:"column:character_id;not null;index:idx_expedition_entry_lookup,priority:2"`
+	QuestId     string    `gorm:"column:quest_id;not null;index:idx_expedition_entry_lookup,priority:3"`
+	InstanceId  uuid.UUID `gorm:"column:instance_id;type:uuid;not null"`
+	EnteredAt   time.Time `gorm:"column:entered_at;not null;index:idx_expedition_entry_lookup,priority:4"`
+}
+
+func (entryEntity) TableName() string {
+	return "expedition_entries"
+}
+
+// runEntity is the outcome of one expedition run, kept for rankings.
+type runEntity struct {
+	ID         uuid.UUID `gorm:"primaryKey;column:id;type:uuid"`
+	TenantId   uuid.UUID `gorm:"column:tenant_id;type:uuid;not null;index:idx_expedition_run_ranking,priority:1"`
+	QuestId    string    `gorm:"column:quest_id;not null;index:idx_expedition_run_ranking,priority:2"`
+	Outcome    string    `gorm:"column:outcome;not null;index:idx_expedition_run_ranking,priority:3"`
+	DurationMs int64     `gorm:"column:duration_ms;not null;index:idx_expedition_run_ranking,priority:4"`
+	InstanceId uuid.UUID `gorm:"column:instance_id;type:uuid;not null"`
+	WorldId    byte      `gorm:"column:world_id;not null"`
+	ChannelId  byte      `gorm:"column:channel_id;not null"`
+	LeaderId   uint32    `gorm:"column:leader_id;not null"`
+	MemberIds  string    `gorm:"column:member_ids;not null"`
+	Reason     string    `gorm:"column:reason"`
+	StartedAt  time.Time `gorm:"column:started_at;not null"`
+	EndedAt    time.Time `gorm:"column:ended_at;not null"`
+}
+
+func (runEntity) TableName() string {
+	return "expedition_runs"
+}
+
+func MigrateTable(db *gorm.DB) error {
+	return db.AutoMigrate(&entryEntity{}, &runEntity{})
+}
@@ -0,0 +1,110 @@
+package expedition
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	OutcomeCleared = "cleared"
+	OutcomeFailed  = "failed"
+)
+
+// Run is the logged outcome of one expedition: who went, who led, how it
+// ended and how long it took. Cleared runs ranked by duration are the
+// expedition's leaderboard.
+type Run struct {
+	id         uuid.UUID
+	questId    string
+	instanceId uuid.UUID
+	worldId    world.Id
+	channelId  channel.Id
+	leaderId   uint32
+	memberIds  []uint32
+	outcome    string
+	reason     string
+	startedAt  time.Time
+	endedAt    time.Time
+}
+
+func (r Run) Id() uuid.UUID         { return r.id }
+func (r Run) QuestId() string       { return r.questId }
+func (r Run) InstanceId() uuid.UUID { return r.instanceId }
+func (r Run) WorldId() world.Id     { return r.worldId }
+func (r Run) ChannelId() channel.Id { return r.channelId }
+func (r Run) LeaderId() uint32      { return r.leaderId }
+func (r Run) MemberIds() []uint32   { return r.memberIds }
+func (r Run) Outcome() string       { return r.outcome }
+func (r Run) Reason() string        { return r.reason }
+func (r Run) StartedAt() time.Time  { return r.startedAt }
+func (r Run) EndedAt() time.Time    { return r.endedAt }
+
+// Duration is the run's length, start to end.
+func (r Run) Duration() time.Duration { return r.endedAt.Sub(r.startedAt) }
+
+type RunBuilder struct {
+	questId    string
+	instanceId uuid.UUID
+	worldId    world.Id
+	channelId  channel.Id
+	leaderId   uint32
+	memberIds  []uint32
+	outcome    string
+	reason     string
+	startedAt  time.Time
+	endedAt    time.Time
+}
+
+func NewRunBuilder() *RunBuilder {
+	return &RunBuilder{memberIds: make([]uint32, 0)}
+}
+
+func (b *RunBuilder) SetQuestId(questId string) *RunBuilder        { b.questId = questId; return b }
+func (b *RunBuilder) SetInstanceId(id uuid.UUID) *RunBuilder       { b.instanceId = id; return b }
+func (b *RunBuilder) SetWorldId(worldId world.Id) *RunBuilder      { b.worldId = worldId; return b }
+func (b *RunBuilder) SetChannelId(id channel.Id) *RunBuilder       { b.channelId = id; return b }
+func (b *RunBuilder) SetLeaderId(leaderId uint32) *RunBuilder      { b.leaderId = leaderId; return b }
+func (b *RunBuilder) SetMemberIds(ids []uint32) *RunBuilder        { b.memberIds = ids; return b }
+func (b *RunBuilder) SetOutcome(outcome string) *RunBuilder        { b.outcome = outcome; return b }
+func (b *RunBuilder) SetReason(reason string) *RunBuilder          { b.reason = reason; return b }
+func (b *RunBuilder) SetStartedAt(startedAt time.Time) *RunBuilder { b.startedAt = startedAt; return b }
+func (b *RunBuilder) SetEndedAt(endedAt time.Time) *RunBuilder     { b.endedAt = endedAt; return b }
+
+func (b *RunBuilder) Build() Run {
+	return Run{
+		questId:    b.questId,
+		instanceId: b.instanceId,
+		worldId:    b.worldId,
+		channelId:  b.channelId,
+		leaderId:   b.leaderId,
+		memberIds:  b.memberIds,
+		outcome:    b.outcome,
+		reason:     b.reason,
+		startedAt:  b.startedAt,
+		endedAt:    b.endedAt,
+	}
+}
+
+func makeRun(e runEntity) (Run, error) {
+	ids, err := decodeMemberIds(e.MemberIds)
+	if err != nil {
+		return Run{}, err
+	}
+	return Run{
+		id:         e.ID,
+		questId:    e.QuestId,
+		instanceId: e.InstanceId,
+		worldId:    world.Id(e.WorldId),
+		channelId:  channel.Id(e.ChannelId),
+		leaderId:   e.LeaderId,
+		memberIds:  ids,
+		outcome:    e.Outcome,
+		reason:     e.Reason,
+		startedAt:  e.StartedAt,
+		endedAt:    e.EndedAt,
+	}, nil
+}
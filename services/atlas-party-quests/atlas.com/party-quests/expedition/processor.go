@@ -0,0 +1,107 @@
+package expedition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+var (
+	ErrDailyLimitReached  = errors.New("daily expedition entry limit reached")
+	ErrWeeklyLimitReached = errors.New("weekly expedition entry limit reached")
+)
+
+const (
+	DefaultRankingSize = 10
+	MaxRankingSize     = 100
+)
+
+type Processor interface {
+	CheckEntryLimits(questId string, characterId uint32, dailyLimit uint32, weeklyLimit uint32) error
+	RecordEntry(questId string, instanceId uuid.UUID, characterId uint32) error
+	LogRun(r Run) (Run, error)
+	RankingProvider(questId string, limit int) model.Provider[[]Run]
+}
+
+type ProcessorImpl struct {
+	l     logrus.FieldLogger
+	ctx   context.Context
+	t     tenant.Model
+	db    *gorm.DB
+	nowFn func() time.Time
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   ctx,
+		t:     tenant.MustFromContext(ctx),
+		db:    db,
+		nowFn: time.Now,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+// CheckEntryLimits reports whether characterId may enter another run of
+// questId: ErrDailyLimitReached or ErrWeeklyLimitReached once the character
+// has used the window's entries. A limit of 0 is unlimited.
+func (p *ProcessorImpl) CheckEntryLimits(questId string, characterId uint32, dailyLimit uint32, weeklyLimit uint32) error {
+	now := p.nowFn()
+	if dailyLimit > 0 {
+		count, err := countEntriesSinceProvider(characterId, questId, dailyWindowStart(now))(p.db.WithContext(p.ctx))()
+		if err != nil {
+			return err
+		}
+		if count >= int64(dailyLimit) {
+			return ErrDailyLimitReached
+		}
+	}
+	if weeklyLimit > 0 {
+		count, err := countEntriesSinceProvider(characterId, questId, weeklyWindowStart(now))(p.db.WithContext(p.ctx))()
+		if err != nil {
+			return err
+		}
+		if count >= int64(weeklyLimit) {
+			return ErrWeeklyLimitReached
+		}
+	}
+	return nil
+}
+
+// RecordEntry spends one of characterId's entries on a run of questId.
+func (p *ProcessorImpl) RecordEntry(questId string, instanceId uuid.UUID, characterId uint32) error {
+	return createEntry(p.db.WithContext(p.ctx), questId, instanceId, characterId, p.nowFn())
+}
+
+// LogRun persists a run's outcome and writes the structured log line the
+// ranking pipeline keys on.
+func (p *ProcessorImpl) LogRun(r Run) (Run, error) {
+	created, err := createRun(p.db.WithContext(p.ctx), r)
+	if err != nil {
+		return Run{}, err
+	}
+	p.l.WithFields(logrus.Fields{
+		"quest_id":     created.QuestId(),
+		"instance_id":  created.InstanceId().String(),
+		"leader_id":    created.LeaderId(),
+		"member_ids":   created.MemberIds(),
+		"member_count": len(created.MemberIds()),
+		"outcome":      created.Outcome(),
+		"reason":       created.Reason(),
+		"duration_ms":  created.Duration().Milliseconds(),
+	}).Infof("Expedition [%s] run [%s] ended: %s.", created.QuestId(), created.InstanceId(), created.Outcome())
+	return created, nil
+}
+
+// RankingProvider returns questId's fastest cleared runs, at most limit.
+func (p *ProcessorImpl) RankingProvider(questId string, limit int) model.Provider[[]Run] {
+	return model.SliceMap(makeRun)(getRankingProvider(questId, limit)(p.db.WithContext(p.ctx)))()
+}
@@ -0,0 +1,134 @@
+package expedition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func setupProcessor(t *testing.T, now time.Time) *ProcessorImpl {
+	t.Helper()
+
+	l, _ := test.NewNullLogger()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	database.RegisterTenantCallbacks(l, db)
+	require.NoError(t, MigrateTable(db))
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	ten, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	require.NoError(t, err)
+
+	return &ProcessorImpl{
+		l:     l,
+		ctx:   tenant.WithContext(context.Background(), ten),
+		t:     ten,
+		db:    db,
+		nowFn: func() time.Time { return now },
+	}
+}
+
+func TestWindowStarts(t *testing.T) {
+	// Wednesday afternoon.
+	now := time.Date(2026, time.October, 14, 15, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC), dailyWindowStart(now))
+	assert.Equal(t, time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC), weeklyWindowStart(now))
+
+	// Sunday still belongs to the week that began the previous Monday.
+	sunday := time.Date(2026, time.October, 18, 23, 59, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC), weeklyWindowStart(sunday))
+
+	// Monday starts a new week.
+	monday := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, weeklyWindowStart(monday))
+}
+
+func TestCheckEntryLimits_Daily(t *testing.T) {
+	now := time.Date(2026, time.October, 14, 15, 0, 0, 0, time.UTC)
+	p := setupProcessor(t, now)
+
+	require.NoError(t, p.CheckEntryLimits("zakum_expedition", 1, 2, 0))
+	require.NoError(t, p.RecordEntry("zakum_expedition", uuid.New(), 1))
+	require.NoError(t, p.CheckEntryLimits("zakum_expedition", 1, 2, 0))
+	require.NoError(t, p.RecordEntry("zakum_expedition", uuid.New(), 1))
+	assert.ErrorIs(t, p.CheckEntryLimits("zakum_expedition", 1, 2, 0), ErrDailyLimitReached)
+
+	// Other characters and other expeditions have their own entries.
+	assert.NoError(t, p.CheckEntryLimits("zakum_expedition", 2, 2, 0))
+	assert.NoError(t, p.CheckEntryLimits("horntail_expedition", 1, 2, 0))
+
+	// The next day the daily window resets.
+	p.nowFn = func() time.Time { return now.Add(24 * time.Hour) }
+	assert.NoError(t, p.CheckEntryLimits("zakum_expedition", 1, 2, 0))
+}
+
+func TestCheckEntryLimits_Weekly(t *testing.T) {
+	monday := time.Date(2026, time.October, 12, 10, 0, 0, 0, time.UTC)
+	p := setupProcessor(t, monday)
+
+	for i := 0; i < 3; i++ {
+		p.nowFn = func() time.Time { return monday.Add(time.Duration(i) * 24 * time.Hour) }
+		require.NoError(t, p.CheckEntryLimits("pink_bean_expedition", 1, 0, 3))
+		require.NoError(t, p.RecordEntry("pink_bean_expedition", uuid.New(), 1))
+	}
+	p.nowFn = func() time.Time { return monday.Add(5 * 24 * time.Hour) }
+	assert.ErrorIs(t, p.CheckEntryLimits("pink_bean_expedition", 1, 0, 3), ErrWeeklyLimitReached)
+
+	p.nowFn = func() time.Time { return monday.Add(7 * 24 * time.Hour) }
+	assert.NoError(t, p.CheckEntryLimits("pink_bean_expedition", 1, 0, 3))
+}
+
+func TestCheckEntryLimits_Unlimited(t *testing.T) {
+	p := setupProcessor(t, time.Now())
+	for i := 0; i < 5; i++ {
+		require.NoError(t, p.RecordEntry("zakum_expedition", uuid.New(), 1))
+	}
+	assert.NoError(t, p.CheckEntryLimits("zakum_expedition", 1, 0, 0))
+}
+
+func TestRankingProvider_FastestClearsFirst(t *testing.T) {
+	p := setupProcessor(t, time.Now())
+	start := time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+
+	logRun := func(outcome string, d time.Duration, leaderId uint32) {
+		_, err := p.LogRun(NewRunBuilder().
+			SetQuestId("horntail_expedition").
+			SetInstanceId(uuid.New()).
+			SetLeaderId(leaderId).
+			SetMemberIds([]uint32{leaderId, leaderId + 1}).
+			SetOutcome(outcome).
+			SetStartedAt(start).
+			SetEndedAt(start.Add(d)).
+			Build())
+		require.NoError(t, err)
+	}
+	logRun(OutcomeCleared, 40*time.Minute, 10)
+	logRun(OutcomeFailed, 5*time.Minute, 20)
+	logRun(OutcomeCleared, 25*time.Minute, 30)
+	logRun(OutcomeCleared, 55*time.Minute, 40)
+
+	runs, err := p.RankingProvider("horntail_expedition", 2)()
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, uint32(30), runs[0].LeaderId())
+	assert.Equal(t, 25*time.Minute, runs[0].Duration())
+	assert.Equal(t, []uint32{30, 31}, runs[0].MemberIds())
+	assert.Equal(t, uint32(10), runs[1].LeaderId())
+}
@@ -0,0 +1,35 @@
+package expedition
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func countEntriesSinceProvider(characterId uint32, questId string, since time.Time) func(db *gorm.DB) model.Provider[int64] {
+	return func(db *gorm.DB) model.Provider[int64] {
+		return func() (int64, error) {
+			var count int64
+			result := db.Model(&entryEntity{}).
+				Where("character_id = ? AND quest_id = ? AND entered_at >= ?", characterId, questId, since).
+				Count(&count)
+			return count, result.Error
+		}
+	}
+}
+
+func getRankingProvider(questId string, limit int) func(db *gorm.DB) model.Provider[[]runEntity] {
+	return func(db *gorm.DB) model.Provider[[]runEntity] {
+		return func() ([]runEntity, error) {
+			var results []runEntity
+			result := db.Where("quest_id = ? AND outcome = ?", questId, OutcomeCleared).
+				Order("duration_ms ASC").
+				Order("ended_at ASC").
+				Limit(limit).
+				Find(&results)
+			return results, result.Error
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package expedition
+
+import (
+	"atlas-party-quests/rest"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/jtumidanski/api2go/jsonapi"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+	"github.com/Chronicle20/atlas/libs/atlas-rest/server"
+)
+
+func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteInitializer {
+	return func(db *gorm.DB) server.RouteInitializer {
+		return func(router *mux.Router, l logrus.FieldLogger) {
+			registerHandler := rest.RegisterHandler(l)(db)(si)
+
+			router.HandleFunc("/party-quests/expeditions/{questId}/rankings", registerHandler("get_expedition_rankings", GetRankingsHandler)).Methods(http.MethodGet)
+		}
+	}
+}
+
+// GetRankingsHandler returns the quest's fastest cleared runs, rank 1 first.
+// The optional limit query parameter caps the result (default
+// DefaultRankingSize, at most MaxRankingSize).
+func GetRankingsHandler(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return rest.ParseQuestId(d.Logger(), func(questId string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			limit := DefaultRankingSize
+			if v := r.URL.Query().Get("limit"); v != "" {
+				parsed, err := strconv.Atoi(v)
+				if err != nil || parsed < 1 || parsed > MaxRankingSize {
+					server.WriteBadRequest(d.Logger(), w, "invalid limit")
+					return
+				}
+				limit = parsed
+			}
+
+			runs, err := NewProcessor(d.Logger(), d.Context(), d.DB()).RankingProvider(questId, limit)()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Retrieving expedition rankings for [%s].", questId)
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+
+			rm, err := model.SliceMap(TransformRun)(model.FixedProvider(runs))()()
+			if err != nil {
+				d.Logger().WithError(err).Errorf("Creating REST model.")
+				server.WriteErrorResponse(d.Logger())(w)(err)
+				return
+			}
+			for i := range rm {
+				rm[i].Rank = i + 1
+			}
+
+			query := r.URL.Query()
+			queryParams := jsonapi.ParseQueryFields(&query)
+			server.MarshalResponse[[]RunRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package expedition
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jtumidanski/api2go/jsonapi"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const Resource = "expedition-runs"
+
+type RunRestModel struct {
+	Id         uuid.UUID  `json:"-"`
+	Rank       int        `json:"rank"`
+	QuestId    string     `json:"questId"`
+	InstanceId uuid.UUID  `json:"instanceId"`
+	WorldId    world.Id   `json:"worldId"`
+	ChannelId  channel.Id `json:"channelId"`
+	LeaderId   uint32     `json:"leaderId"`
+	MemberIds  []uint32   `json:"memberIds"`
+	Outcome    string     `json:"outcome"`
+	Reason     string     `json:"reason,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	EndedAt    time.Time  `json:"endedAt"`
+	DurationMs int64      `json:"durationMs"`
+}
+
+func (r RunRestModel) GetName() string {
+	return Resource
+}
+
+func (r RunRestModel) GetID() string {
+	return r.Id.String()
+}
+
+func (r *RunRestModel) SetID(idStr string) error {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid run ID: %w", err)
+	}
+	r.Id = id
+	return nil
+}
+
+func (r RunRestModel) GetReferences() []jsonapi.Reference {
+	return []jsonapi.Reference{}
+}
+
+func (r RunRestModel) GetReferencedIDs() []jsonapi.ReferenceID {
+	return []jsonapi.ReferenceID{}
+}
+
+func (r RunRestModel) GetReferencedStructs() []jsonapi.MarshalIdentifier {
+	return []jsonapi.MarshalIdentifier{}
+}
+
+func (r *RunRestModel) SetToOneReferenceID(_, _ string) error {
+	return nil
+}
+
+func (r *RunRestModel) SetToManyReferenceIDs(_ string, _ []string) error {
+	return nil
+}
+
+func (r *RunRestModel) SetReferencedStructs(_ map[string]map[string]jsonapi.Data) error {
+	return nil
+}
+
+func TransformRun(r Run) (RunRestModel, error) {
+	return RunRestModel{
+		Id:         r.Id(),
+		QuestId:    r.QuestId(),
+		InstanceId: r.InstanceId(),
+		WorldId:    r.WorldId(),
+		ChannelId:  r.ChannelId(),
+		LeaderId:   r.LeaderId(),
+		MemberIds:  r.MemberIds(),
+		Outcome:    r.Outcome(),
+		Reason:     r.Reason(),
+		StartedAt:  r.StartedAt(),
+		EndedAt:    r.EndedAt(),
+		DurationMs: r.Duration().Milliseconds(),
+	}, nil
+}
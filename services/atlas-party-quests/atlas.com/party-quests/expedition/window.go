@@ -0,0 +1,19 @@
+package expedition
+
+import "time"
+
+// Entry windows reset at 00:00 UTC: the daily window every day, the weekly
+// window every Monday.
+
+// dailyWindowStart returns the start of the daily entry window holding now.
+func dailyWindowStart(now time.Time) time.Time {
+	u := now.UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weeklyWindowStart returns the start of the weekly entry window holding now.
+func weeklyWindowStart(now time.Time) time.Time {
+	day := dailyWindowStart(now)
+	sinceMonday := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -sinceMonday)
+}
@@ -0,0 +1,319 @@
+package instance
+
+import (
+	"atlas-party-quests/definition"
+	"atlas-party-quests/kafka/message"
+	monsterMessage "atlas-party-quests/kafka/message/monster"
+	"atlas-party-quests/monster"
+	"atlas-party-quests/stage"
+	"errors"
+	"slices"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+)
+
+var errNoBossEncounter = errors.New("no active PQ instance with a boss encounter for this monster")
+
+// BossEncounter is the live state of a stage's multi-part boss: the unique
+// ids of the core (the body holding the boss HP bar, Zakum's body or
+// Horntail's HP holder) and of the parts still standing (arms, heads,
+// statues). coreUniqueId is 0 while a core that waits on its parts has not
+// spawned yet. With sharedHp, damage landed on a part is mirrored onto the
+// core so every part drains the one HP bar.
+type BossEncounter struct {
+	coreUniqueId  uint32
+	partUniqueIds []uint32
+	sharedHp      bool
+}
+
+func (b BossEncounter) CoreUniqueId() uint32    { return b.coreUniqueId }
+func (b BossEncounter) PartUniqueIds() []uint32 { return b.partUniqueIds }
+func (b BossEncounter) SharedHp() bool          { return b.sharedHp }
+
+// Active reports whether an encounter is in progress.
+func (b BossEncounter) Active() bool {
+	return b.coreUniqueId != 0 || len(b.partUniqueIds) > 0
+}
+
+func (b BossEncounter) IsPart(uniqueId uint32) bool {
+	return slices.Contains(b.partUniqueIds, uniqueId)
+}
+
+func (b BossEncounter) WithoutPart(uniqueId uint32) BossEncounter {
+	parts := make([]uint32, 0, len(b.partUniqueIds))
+	for _, id := range b.partUniqueIds {
+		if id != uniqueId {
+			parts = append(parts, id)
+		}
+	}
+	b.partUniqueIds = parts
+	return b
+}
+
+func (b BossEncounter) WithCore(uniqueId uint32) BossEncounter {
+	b.coreUniqueId = uniqueId
+	return b
+}
+
+type bossSpawn struct {
+	monsterId uint32
+	x, y      int16
+	fh        int16
+}
+
+type bossEncounterConfig struct {
+	core           bossSpawn
+	parts          []bossSpawn
+	sharedHp       bool
+	coreAfterParts bool
+	spawnMessage   string
+	coreMessage    string
+}
+
+// extractBossEncounterConfig reads a stage's "bossEncounter" property:
+//
+//	{"core": {"monsterId", "x", "y", "fh"}, "parts": [{...}],
+//	 "sharedHp": bool, "coreAfterParts": bool,
+//	 "spawnMessage": string, "coreMessage": string}
+//
+// A core is required; parts are optional.
+func extractBossEncounterConfig(properties map[string]any) (bossEncounterConfig, bool) {
+	raw, ok := properties["bossEncounter"].(map[string]any)
+	if !ok {
+		return bossEncounterConfig{}, false
+	}
+	core, ok := extractBossSpawn(raw["core"])
+	if !ok {
+		return bossEncounterConfig{}, false
+	}
+	cfg := bossEncounterConfig{core: core}
+	if parts, ok := raw["parts"].([]any); ok {
+		for _, rp := range parts {
+			if part, ok := extractBossSpawn(rp); ok {
+				cfg.parts = append(cfg.parts, part)
+			}
+		}
+	}
+	if v, ok := raw["sharedHp"].(bool); ok {
+		cfg.sharedHp = v
+	}
+	if v, ok := raw["coreAfterParts"].(bool); ok {
+		cfg.coreAfterParts = v && len(cfg.parts) > 0
+	}
+	if v, ok := raw["spawnMessage"].(string); ok {
+		cfg.spawnMessage = v
+	}
+	if v, ok := raw["coreMessage"].(string); ok {
+		cfg.coreMessage = v
+	}
+	return cfg, true
+}
+
+func extractBossSpawn(v any) (bossSpawn, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return bossSpawn{}, false
+	}
+	id, ok := m["monsterId"].(float64)
+	if !ok {
+		return bossSpawn{}, false
+	}
+	s := bossSpawn{monsterId: uint32(id)}
+	if x, ok := m["x"].(float64); ok {
+		s.x = int16(x)
+	}
+	if y, ok := m["y"].(float64); ok {
+		s.y = int16(y)
+	}
+	if fh, ok := m["fh"].(float64); ok {
+		s.fh = int16(fh)
+	}
+	return s, true
+}
+
+// spawnBossEncounter spawns the stage's multi-part boss, if it has one, into
+// the instance's copy of the stage's first map and records the spawned ids.
+// A core configured to wait on its parts spawns once the last part falls.
+func (p *ProcessorImpl) spawnBossEncounter(mb *message.Buffer, stg stage.Model, inst Model) {
+	cfg, ok := extractBossEncounterConfig(stg.Properties())
+	if !ok || len(stg.MapIds()) == 0 {
+		return
+	}
+
+	f := field.NewBuilder(inst.WorldId(), inst.ChannelId(), _map.Id(stg.MapIds()[0])).SetInstance(inst.Id()).Build()
+	mp := monster.NewProcessor(p.l, p.ctx)
+
+	be := BossEncounter{sharedHp: cfg.sharedHp}
+	for _, part := range cfg.parts {
+		uniqueId, err := mp.SpawnInField(f, part.monsterId, part.x, part.y, part.fh)
+		if err != nil {
+			p.l.WithError(err).Errorf("Failed to spawn boss part [%d] for PQ instance [%s].", part.monsterId, inst.Id())
+			continue
+		}
+		be.partUniqueIds = append(be.partUniqueIds, uniqueId)
+	}
+	if !cfg.coreAfterParts || len(be.partUniqueIds) == 0 {
+		uniqueId, err := mp.SpawnInField(f, cfg.core.monsterId, cfg.core.x, cfg.core.y, cfg.core.fh)
+		if err != nil {
+			p.l.WithError(err).Errorf("Failed to spawn boss core [%d] for PQ instance [%s].", cfg.core.monsterId, inst.Id())
+		} else {
+			be.coreUniqueId = uniqueId
+		}
+	}
+
+	if _, err := GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+		return m.SetBossEncounter(be)
+	}); err != nil {
+		p.l.WithError(err).Errorf("Failed to record boss encounter for PQ instance [%s].", inst.Id())
+		return
+	}
+
+	if cfg.spawnMessage != "" {
+		_ = p.BroadcastMessage(mb)(inst.Id(), "PINK_TEXT", cfg.spawnMessage)
+	}
+
+	p.l.Infof("Spawned boss encounter for PQ instance [%s] in field [%s]: core [%d], [%d] parts.", inst.Id(), f.Id(), be.coreUniqueId, len(be.partUniqueIds))
+}
+
+// bossEncounterInstance returns the active instance whose boss encounter
+// includes uniqueId in field f.
+func (p *ProcessorImpl) bossEncounterInstance(f field.Model, uniqueId uint32) (Model, error) {
+	inst, err := GetRegistry().Get(p.t, f.Instance())
+	if err != nil || inst.State() != StateActive {
+		return Model{}, errNoBossEncounter
+	}
+	be := inst.BossEncounter()
+	if be.CoreUniqueId() != uniqueId && !be.IsPart(uniqueId) {
+		return Model{}, errNoBossEncounter
+	}
+	return inst, nil
+}
+
+func (p *ProcessorImpl) HandleBossMonsterDamagedAndEmit(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error {
+	return message.Emit(p.p)(func(buf *message.Buffer) error {
+		return p.HandleBossMonsterDamaged(buf)(f, uniqueId, characterId, damage, source)
+	})
+}
+
+// HandleBossMonsterDamaged mirrors a character's hit on a shared-HP part onto
+// the core, so the core's HP bar (and kill credit) reflects the whole fight.
+// Only character attacks are mirrored; damage over time and reflected damage
+// stay on the part they landed on.
+func (p *ProcessorImpl) HandleBossMonsterDamaged(mb *message.Buffer) func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error {
+	return func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error {
+		inst, err := p.bossEncounterInstance(f, uniqueId)
+		if err != nil {
+			return err
+		}
+		be := inst.BossEncounter()
+		if !be.SharedHp() || !be.IsPart(uniqueId) || be.CoreUniqueId() == 0 {
+			return nil
+		}
+		if source != monsterMessage.DamageSourceCharacterAttack || damage == 0 || characterId == 0 {
+			return nil
+		}
+		return mb.Put(monsterMessage.EnvCommandTopic, damageMonsterCommandProvider(f, be.CoreUniqueId(), characterId, damage))
+	}
+}
+
+func (p *ProcessorImpl) HandleBossMonsterKilledAndEmit(f field.Model, uniqueId uint32) error {
+	return message.Emit(p.p)(func(buf *message.Buffer) error {
+		return p.HandleBossMonsterKilled(buf)(f, uniqueId)
+	})
+}
+
+// HandleBossMonsterKilled advances the encounter: the core falling clears the
+// stage; the last part falling either spawns a core that waited on its parts
+// or, with shared HP, brings the boss down with it.
+func (p *ProcessorImpl) HandleBossMonsterKilled(mb *message.Buffer) func(f field.Model, uniqueId uint32) error {
+	return func(f field.Model, uniqueId uint32) error {
+		inst, err := p.bossEncounterInstance(f, uniqueId)
+		if err != nil {
+			return err
+		}
+		be := inst.BossEncounter()
+
+		if be.CoreUniqueId() == uniqueId {
+			p.l.Infof("Boss core [%d] of PQ instance [%s] defeated.", uniqueId, inst.Id())
+			return p.clearBossEncounter(mb, inst)
+		}
+
+		// Parts can fall together, so the part comes off the encounter the
+		// registry holds now, not the snapshot above; only the kill that
+		// actually removed the last part moves the encounter on.
+		removed := false
+		inst, err = GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+			cur := m.BossEncounter()
+			if !cur.IsPart(uniqueId) {
+				return m
+			}
+			removed = true
+			return m.SetBossEncounter(cur.WithoutPart(uniqueId))
+		})
+		if err != nil {
+			return err
+		}
+		if !removed {
+			p.l.Debugf("Boss part [%d] of PQ instance [%s] already counted; ignoring.", uniqueId, inst.Id())
+			return nil
+		}
+		be = inst.BossEncounter()
+		p.l.Debugf("Boss part [%d] of PQ instance [%s] defeated, [%d] remain.", uniqueId, inst.Id(), len(be.PartUniqueIds()))
+		if len(be.PartUniqueIds()) > 0 {
+			return nil
+		}
+
+		if be.CoreUniqueId() == 0 {
+			return p.spawnDeferredBossCore(mb, inst, f)
+		}
+		if be.SharedHp() {
+			p.l.Infof("Every part of boss [%d] in PQ instance [%s] defeated.", be.CoreUniqueId(), inst.Id())
+			return p.clearBossEncounter(mb, inst)
+		}
+		return nil
+	}
+}
+
+// spawnDeferredBossCore spawns a core that waited on its parts, once they
+// have all fallen.
+func (p *ProcessorImpl) spawnDeferredBossCore(mb *message.Buffer, inst Model, f field.Model) error {
+	def, err := definition.NewProcessor(p.l, p.ctx, p.db).ByIdProvider(inst.DefinitionId())()
+	if err != nil {
+		return err
+	}
+	stageIdx := inst.CurrentStageIndex()
+	if int(stageIdx) >= len(def.Stages()) {
+		return errors.New("invalid stage index")
+	}
+	cfg, ok := extractBossEncounterConfig(def.Stages()[stageIdx].Properties())
+	if !ok {
+		return errNoBossEncounter
+	}
+
+	uniqueId, err := monster.NewProcessor(p.l, p.ctx).SpawnInField(f, cfg.core.monsterId, cfg.core.x, cfg.core.y, cfg.core.fh)
+	if err != nil {
+		return err
+	}
+	if _, err = GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+		return m.SetBossEncounter(m.BossEncounter().WithCore(uniqueId))
+	}); err != nil {
+		return err
+	}
+	p.l.Infof("Spawned boss core [%d] as [%d] for PQ instance [%s].", cfg.core.monsterId, uniqueId, inst.Id())
+	if cfg.coreMessage != "" {
+		return p.BroadcastMessage(mb)(inst.Id(), "PINK_TEXT", cfg.coreMessage)
+	}
+	return nil
+}
+
+// clearBossEncounter ends the encounter and clears the boss stage; the stage
+// advance despawns whatever parts are left.
+func (p *ProcessorImpl) clearBossEncounter(mb *message.Buffer, inst Model) error {
+	if _, err := GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+		return m.SetBossEncounter(BossEncounter{})
+	}); err != nil {
+		return err
+	}
+	return p.ForceStageComplete(mb)(inst.Id())
+}
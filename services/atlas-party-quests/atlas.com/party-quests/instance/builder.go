@@ -27,6 +27,7 @@ type Builder struct {
 	fieldInstances    []uuid.UUID
 	stageState        StageState
 	affinityId        uint32
+	leaderId          uint32
 }
 
 func NewBuilder() *Builder {
@@ -50,6 +51,7 @@ func (b *Builder) SetChannelId(cid channel.Id) *Builder      { b.channelId = cid
 func (b *Builder) SetPartyId(pid uint32) *Builder            { b.partyId = pid; return b }
 func (b *Builder) SetCharacters(c []CharacterEntry) *Builder { b.characters = c; return b }
 func (b *Builder) SetAffinityId(id uint32) *Builder          { b.affinityId = id; return b }
+func (b *Builder) SetLeaderId(id uint32) *Builder            { b.leaderId = id; return b }
 
 func (b *Builder) Build() (Model, error) {
 	if b.questId == "" {
@@ -72,5 +74,6 @@ func (b *Builder) Build() (Model, error) {
 		fieldInstances:    b.fieldInstances,
 		stageState:        b.stageState,
 		affinityId:        b.affinityId,
+		leaderId:          b.leaderId,
 	}, nil
 }
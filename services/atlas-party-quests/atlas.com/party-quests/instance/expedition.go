@@ -0,0 +1,265 @@
+package instance
+
+import (
+	"atlas-party-quests/definition"
+	"atlas-party-quests/expedition"
+	"atlas-party-quests/kafka/message"
+	pq "atlas-party-quests/kafka/message/party_quest"
+	systemMessage "atlas-party-quests/kafka/message/system_message"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const registrationTypeExpedition = "expedition"
+
+var (
+	ErrNotExpeditionLeader  = errors.New("only the expedition leader may start the expedition")
+	ErrExpeditionFull       = errors.New("expedition squad is full")
+	ErrEntryConditionNotMet = errors.New("expedition entry conditions not met")
+	ErrExpeditionUndersized = errors.New("expedition squad is below its minimum size")
+)
+
+func isExpedition(def definition.Model) bool {
+	return def.Registration().Type() == registrationTypeExpedition && def.Expedition() != nil
+}
+
+// registerExpedition signs a character up for the expedition registering on
+// their world and channel, or opens a new one with them as leader. Every
+// character must pass the entry conditions and have entries left before
+// joining; a rejected character is told why.
+func (p *ProcessorImpl) registerExpedition(mb *message.Buffer, def definition.Model, questId string, worldId world.Id, channelId channel.Id, mapId uint32, character CharacterEntry) (Model, error) {
+	reg := def.Registration()
+	exp := def.Expedition()
+	if exp == nil {
+		return Model{}, errors.New("expedition definition has no expedition block")
+	}
+
+	if reg.MapId() != 0 && mapId != 0 && reg.MapId() != mapId {
+		return Model{}, errors.New("character is not on the registration map")
+	}
+
+	existing, found := p.findRegistering(questId, worldId, channelId, 0)
+	if found {
+		for _, c := range existing.Characters() {
+			if c.CharacterId() == character.CharacterId() {
+				p.l.Infof("Character [%d] already registered in expedition [%s].", character.CharacterId(), existing.Id())
+				return existing, nil
+			}
+		}
+		if exp.MaxMembers() > 0 && uint32(len(existing.Characters())) >= exp.MaxMembers() {
+			p.rejectExpeditionEntry(mb, character, questId, ErrExpeditionFull)
+			return Model{}, ErrExpeditionFull
+		}
+	}
+
+	if err := p.checkExpeditionEligibility(def, character.CharacterId()); err != nil {
+		p.rejectExpeditionEntry(mb, character, questId, err)
+		return Model{}, err
+	}
+
+	if found {
+		updated, err := GetRegistry().Update(p.t, existing.Id(), func(m Model) Model {
+			return m.AddCharacter(character)
+		})
+		if err != nil {
+			return Model{}, err
+		}
+		p.l.Infof("Character [%d] joined expedition [%s] for quest [%s], squad size [%d].",
+			character.CharacterId(), existing.Id(), questId, len(updated.Characters()))
+		err = mb.Put(pq.EnvEventStatusTopic, characterRegisteredEventProvider(worldId, existing.Id(), questId, character.CharacterId()))
+		if err != nil {
+			return Model{}, err
+		}
+		return updated, nil
+	}
+
+	inst, err := NewBuilder().
+		SetTenantId(p.t.Id()).
+		SetDefinitionId(def.Id()).
+		SetQuestId(questId).
+		SetWorldId(worldId).
+		SetChannelId(channelId).
+		SetLeaderId(character.CharacterId()).
+		SetCharacters([]CharacterEntry{character}).
+		Build()
+	if err != nil {
+		return Model{}, err
+	}
+
+	inst = inst.SetState(StateRegistering)
+	inst = GetRegistry().Create(p.t, inst)
+
+	p.l.Infof("Expedition [%s] created for quest [%s], leader [%d].", inst.Id(), questId, character.CharacterId())
+
+	err = mb.Put(pq.EnvEventStatusTopic, instanceCreatedEventProvider(worldId, inst.Id(), questId, 0, channelId))
+	if err != nil {
+		return Model{}, err
+	}
+
+	if reg.Mode() == "instant" {
+		return inst, p.Start(mb)(inst.Id())
+	}
+
+	if reg.Mode() == "timed" && reg.Duration() > 0 {
+		err = mb.Put(pq.EnvEventStatusTopic, registrationOpenedEventProvider(worldId, inst.Id(), questId, reg.Duration()))
+		if err != nil {
+			return Model{}, err
+		}
+	}
+
+	return inst, nil
+}
+
+// checkExpeditionEligibility checks a character's remaining entries, then
+// their entry conditions (evaluated through atlas-script-core).
+func (p *ProcessorImpl) checkExpeditionEligibility(def definition.Model, characterId uint32) error {
+	exp := def.Expedition()
+	err := expedition.NewProcessor(p.l, p.ctx, p.db).CheckEntryLimits(def.QuestId(), characterId, exp.DailyLimit(), exp.WeeklyLimit())
+	if err != nil {
+		return err
+	}
+	for _, c := range exp.EntryConditions() {
+		passed, err := p.evaluator.EvaluateCondition(characterId, c)
+		if err != nil {
+			return err
+		}
+		if !passed {
+			p.l.Debugf("Character [%d] failed expedition [%s] entry condition [%s %s %s].", characterId, def.QuestId(), c.Type(), c.Operator(), c.Value())
+			return ErrEntryConditionNotMet
+		}
+	}
+	return nil
+}
+
+func (p *ProcessorImpl) rejectExpeditionEntry(mb *message.Buffer, character CharacterEntry, questId string, reason error) {
+	p.l.Infof("Character [%d] rejected from expedition [%s]: %s.", character.CharacterId(), questId, reason.Error())
+	msg := "You cannot join this expedition."
+	switch {
+	case errors.Is(reason, expedition.ErrDailyLimitReached):
+		msg = "You have already entered this expedition the maximum number of times today."
+	case errors.Is(reason, expedition.ErrWeeklyLimitReached):
+		msg = "You have already entered this expedition the maximum number of times this week."
+	case errors.Is(reason, ErrExpeditionFull):
+		msg = "This expedition squad is already full."
+	case errors.Is(reason, ErrEntryConditionNotMet):
+		msg = "You do not meet the requirements for this expedition."
+	}
+	err := mb.Put(systemMessage.EnvCommandTopic, sendMessageProvider(character.WorldId(), character.ChannelId(), character.CharacterId(), "PINK_TEXT", msg))
+	if err != nil {
+		p.l.WithError(err).Errorf("Failed to tell character [%d] why they were rejected.", character.CharacterId())
+	}
+}
+
+func (p *ProcessorImpl) StartByAndEmit(instanceId uuid.UUID, characterId uint32) error {
+	return message.Emit(p.p)(func(buf *message.Buffer) error {
+		return p.StartBy(buf)(instanceId, characterId)
+	})
+}
+
+// StartBy starts an instance on a character's request. An expedition only
+// starts at its leader's word; other instances start for anyone.
+func (p *ProcessorImpl) StartBy(mb *message.Buffer) func(instanceId uuid.UUID, characterId uint32) error {
+	return func(instanceId uuid.UUID, characterId uint32) error {
+		inst, err := GetRegistry().Get(p.t, instanceId)
+		if err != nil {
+			return err
+		}
+		if inst.LeaderId() != 0 && inst.LeaderId() != characterId {
+			p.l.Infof("Character [%d] is not the leader of expedition [%s]; ignoring start.", characterId, instanceId)
+			return ErrNotExpeditionLeader
+		}
+		return p.Start(mb)(instanceId)
+	}
+}
+
+// admitExpedition runs when an expedition starts and returns the squad that
+// goes in. Entry limits are checked again, since a member may have spent
+// their entries on another run since signing up: anyone out of entries is
+// dropped and told why, and a dropped leader hands the lead to the next
+// member. An undersized squad then fails without spending anyone's entries;
+// otherwise each member spends one.
+func (p *ProcessorImpl) admitExpedition(mb *message.Buffer, inst Model, def definition.Model) (Model, error) {
+	exp := def.Expedition()
+	ep := expedition.NewProcessor(p.l, p.ctx, p.db)
+
+	var dropped []CharacterEntry
+	for _, c := range inst.Characters() {
+		err := ep.CheckEntryLimits(inst.QuestId(), c.CharacterId(), exp.DailyLimit(), exp.WeeklyLimit())
+		if errors.Is(err, expedition.ErrDailyLimitReached) || errors.Is(err, expedition.ErrWeeklyLimitReached) {
+			dropped = append(dropped, c)
+			p.rejectExpeditionEntry(mb, c, inst.QuestId(), err)
+			continue
+		}
+		if err != nil {
+			p.l.WithError(err).Warnf("Unable to recheck expedition [%s] entry limits for character [%d]; admitting.", inst.Id(), c.CharacterId())
+		}
+	}
+	if len(dropped) > 0 {
+		updated, err := GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+			for _, c := range dropped {
+				m = m.RemoveCharacter(c.CharacterId())
+			}
+			if !slices.ContainsFunc(m.Characters(), func(c CharacterEntry) bool { return c.CharacterId() == m.LeaderId() }) && len(m.Characters()) > 0 {
+				m = m.SetLeaderId(m.Characters()[0].CharacterId())
+			}
+			return m
+		})
+		if err != nil {
+			return Model{}, err
+		}
+		for _, c := range dropped {
+			_ = mb.Put(pq.EnvEventStatusTopic, characterLeftEventProvider(inst.WorldId(), inst.Id(), inst.QuestId(), c.CharacterId(), c.ChannelId(), "entry_limit"))
+		}
+		inst = updated
+	}
+
+	if uint32(len(inst.Characters())) < exp.MinMembers() {
+		p.l.Infof("Expedition [%s] has [%d] of the [%d] members it needs; disbanding.", inst.Id(), len(inst.Characters()), exp.MinMembers())
+		_, _ = GetRegistry().Update(p.t, inst.Id(), func(m Model) Model {
+			return m.SetState(StateFailed)
+		})
+		_ = mb.Put(pq.EnvEventStatusTopic, failedEventProvider(inst.WorldId(), inst.Id(), inst.QuestId(), "insufficient_members"))
+		_ = p.Destroy(mb)(inst.Id(), "insufficient_members")
+		return Model{}, ErrExpeditionUndersized
+	}
+
+	for _, c := range inst.Characters() {
+		if err := ep.RecordEntry(inst.QuestId(), inst.Id(), c.CharacterId()); err != nil {
+			p.l.WithError(err).Errorf("Failed to record expedition [%s] entry for character [%d].", inst.Id(), c.CharacterId())
+		}
+	}
+	return inst, nil
+}
+
+// logExpeditionRun records how an expedition ended, for rankings. Runs that
+// never started are not runs.
+func (p *ProcessorImpl) logExpeditionRun(inst Model, def definition.Model, outcome string, reason string) {
+	if !isExpedition(def) || inst.StartedAt().IsZero() {
+		return
+	}
+	memberIds := make([]uint32, 0, len(inst.Characters()))
+	for _, c := range inst.Characters() {
+		memberIds = append(memberIds, c.CharacterId())
+	}
+	r := expedition.NewRunBuilder().
+		SetQuestId(inst.QuestId()).
+		SetInstanceId(inst.Id()).
+		SetWorldId(inst.WorldId()).
+		SetChannelId(inst.ChannelId()).
+		SetLeaderId(inst.LeaderId()).
+		SetMemberIds(memberIds).
+		SetOutcome(outcome).
+		SetReason(reason).
+		SetStartedAt(inst.StartedAt()).
+		SetEndedAt(time.Now()).
+		Build()
+	if _, err := expedition.NewProcessor(p.l, p.ctx, p.db).LogRun(r); err != nil {
+		p.l.WithError(err).Errorf("Failed to log expedition [%s] run.", inst.Id())
+	}
+}
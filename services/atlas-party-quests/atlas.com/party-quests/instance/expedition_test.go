@@ -0,0 +1,300 @@
+package instance
+
+import (
+	"atlas-party-quests/definition"
+	"atlas-party-quests/expedition"
+	"atlas-party-quests/kafka/message"
+	monsterMessage "atlas-party-quests/kafka/message/monster"
+	pq "atlas-party-quests/kafka/message/party_quest"
+	systemMessage "atlas-party-quests/kafka/message/system_message"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
+	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	database "github.com/Chronicle20/atlas/libs/atlas-database"
+	scriptcondition "github.com/Chronicle20/atlas/libs/atlas-script-core/condition"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// fakeEvaluator passes every condition for the characters it lists.
+type fakeEvaluator struct {
+	eligible map[uint32]bool
+}
+
+func (f fakeEvaluator) EvaluateCondition(characterId uint32, _ scriptcondition.Model) (bool, error) {
+	return f.eligible[characterId], nil
+}
+
+func setupExpeditionProcessor(t *testing.T, eligible ...uint32) (*ProcessorImpl, definition.Model) {
+	t.Helper()
+
+	l, _ := test.NewNullLogger()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	database.RegisterTenantCallbacks(l, db)
+	require.NoError(t, definition.MigrateTable(db))
+	require.NoError(t, expedition.MigrateTable(db))
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	ten, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	require.NoError(t, err)
+	ctx := tenant.WithContext(context.Background(), ten)
+
+	def, err := definition.Extract(definition.RestModel{
+		QuestId:  "zakum_expedition",
+		Name:     "Zakum Expedition",
+		Duration: 7200,
+		Exit:     211042300,
+		Registration: definition.RegistrationRestModel{
+			Type:     "expedition",
+			Mode:     "timed",
+			Duration: 300,
+			MapId:    211042300,
+		},
+		Expedition: &definition.ExpeditionRestModel{
+			MinMembers: 2,
+			MaxMembers: 3,
+			DailyLimit: 1,
+			EntryConditions: []definition.ExpeditionConditionRestModel{
+				{Type: "level", Operator: ">=", Value: "50"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	GetRegistry().ResetForTesting()
+
+	ev := fakeEvaluator{eligible: make(map[uint32]bool)}
+	for _, id := range eligible {
+		ev.eligible[id] = true
+	}
+	p := &ProcessorImpl{
+		l:         l,
+		ctx:       ctx,
+		t:         ten,
+		db:        db,
+		evaluator: ev,
+	}
+	return p, def
+}
+
+func TestRegisterExpedition_FirstRegistrantLeads(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1, 2)
+
+	buf := message.NewBuffer()
+	inst, err := p.registerExpedition(buf, def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(1, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), inst.LeaderId())
+	assert.Equal(t, StateRegistering, inst.State())
+
+	joined, err := p.registerExpedition(buf, def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(2, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, inst.Id(), joined.Id())
+	assert.Equal(t, uint32(1), joined.LeaderId())
+	assert.Len(t, joined.Characters(), 2)
+
+	assert.NotEmpty(t, buf.GetAll()[pq.EnvEventStatusTopic])
+}
+
+func TestRegisterExpedition_WrongMapRejected(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1)
+
+	_, err := p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 100000000, NewCharacterEntry(1, 0, 1))
+	assert.Error(t, err)
+}
+
+func TestRegisterExpedition_IneligibleCharacterToldWhy(t *testing.T) {
+	p, def := setupExpeditionProcessor(t)
+
+	buf := message.NewBuffer()
+	_, err := p.registerExpedition(buf, def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(7, 0, 1))
+	assert.ErrorIs(t, err, ErrEntryConditionNotMet)
+	assert.NotEmpty(t, buf.GetAll()[systemMessage.EnvCommandTopic])
+	assert.Empty(t, GetRegistry().GetAll(p.t))
+}
+
+func TestRegisterExpedition_FullSquadRejected(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1, 2, 3, 4)
+
+	for _, id := range []uint32{1, 2, 3} {
+		_, err := p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(id, 0, 1))
+		require.NoError(t, err)
+	}
+	_, err := p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(4, 0, 1))
+	assert.ErrorIs(t, err, ErrExpeditionFull)
+}
+
+func TestRegisterExpedition_DailyLimitSpent(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1)
+
+	require.NoError(t, expedition.NewProcessor(p.l, p.ctx, p.db).RecordEntry(def.QuestId(), uuid.New(), 1))
+
+	_, err := p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(1, 0, 1))
+	assert.ErrorIs(t, err, expedition.ErrDailyLimitReached)
+}
+
+func TestStartBy_OnlyLeaderStartsExpedition(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1, 2)
+
+	inst, err := p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(1, 0, 1))
+	require.NoError(t, err)
+	_, err = p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(2, 0, 1))
+	require.NoError(t, err)
+
+	err = p.StartBy(message.NewBuffer())(inst.Id(), 2)
+	assert.ErrorIs(t, err, ErrNotExpeditionLeader)
+
+	got, err := GetRegistry().Get(p.t, inst.Id())
+	require.NoError(t, err)
+	assert.Equal(t, StateRegistering, got.State())
+}
+
+func TestAdmitExpedition_DropsMembersOutOfEntries(t *testing.T) {
+	p, def := setupExpeditionProcessor(t, 1, 2, 3)
+
+	var inst Model
+	for _, id := range []uint32{1, 2, 3} {
+		var err error
+		inst, err = p.registerExpedition(message.NewBuffer(), def, def.QuestId(), 0, 1, 211042300, NewCharacterEntry(id, 0, 1))
+		require.NoError(t, err)
+	}
+	// The leader spends their entry on another run while registered.
+	ep := expedition.NewProcessor(p.l, p.ctx, p.db)
+	require.NoError(t, ep.RecordEntry(def.QuestId(), uuid.New(), 1))
+
+	buf := message.NewBuffer()
+	admitted, err := p.admitExpedition(buf, inst, def)
+	require.NoError(t, err)
+	require.Len(t, admitted.Characters(), 2)
+	assert.Equal(t, uint32(2), admitted.Characters()[0].CharacterId())
+	assert.Equal(t, uint32(3), admitted.Characters()[1].CharacterId())
+	assert.Equal(t, uint32(2), admitted.LeaderId())
+	assert.NotEmpty(t, buf.GetAll()[systemMessage.EnvCommandTopic])
+	assert.NotEmpty(t, buf.GetAll()[pq.EnvEventStatusTopic])
+
+	_, err = GetRegistry().GetByCharacter(p.t, 1)
+	assert.Error(t, err, "the dropped leader is no longer in the expedition")
+
+	// Only the admitted members spent an entry.
+	for _, id := range []uint32{2, 3} {
+		assert.ErrorIs(t, ep.CheckEntryLimits(def.QuestId(), id, 1, 0), expedition.ErrDailyLimitReached)
+	}
+	assert.NoError(t, ep.CheckEntryLimits(def.QuestId(), 1, 2, 0), "the dropped leader's only entry is the other run's")
+}
+
+func setupBossInstance(t *testing.T, be BossEncounter) (*ProcessorImpl, Model, field.Model) {
+	t.Helper()
+	p, _ := setupExpeditionProcessor(t)
+
+	inst, err := NewBuilder().
+		SetTenantId(p.t.Id()).
+		SetDefinitionId(uuid.New()).
+		SetQuestId("horntail_expedition").
+		SetCharacters([]CharacterEntry{NewCharacterEntry(1, 0, 1)}).
+		Build()
+	require.NoError(t, err)
+	inst = inst.SetState(StateActive).SetBossEncounter(be)
+	inst = GetRegistry().Create(p.t, inst)
+
+	f := field.NewBuilder(0, 1, _map.Id(240060200)).SetInstance(inst.Id()).Build()
+	return p, inst, f
+}
+
+func TestHandleBossMonsterDamaged_SharedHpMirrorsCharacterAttack(t *testing.T) {
+	p, _, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, partUniqueIds: []uint32{101, 102}, sharedHp: true})
+
+	buf := message.NewBuffer()
+	require.NoError(t, p.HandleBossMonsterDamaged(buf)(f, 101, 1, 5000, monsterMessage.DamageSourceCharacterAttack))
+	assert.Len(t, buf.GetAll()[monsterMessage.EnvCommandTopic], 1)
+}
+
+func TestHandleBossMonsterDamaged_IgnoresOtherSources(t *testing.T) {
+	p, _, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, partUniqueIds: []uint32{101}, sharedHp: true})
+
+	buf := message.NewBuffer()
+	require.NoError(t, p.HandleBossMonsterDamaged(buf)(f, 101, 1, 5000, "DAMAGE_OVER_TIME"))
+	require.NoError(t, p.HandleBossMonsterDamaged(buf)(f, 100, 1, 5000, monsterMessage.DamageSourceCharacterAttack))
+	assert.Empty(t, buf.GetAll()[monsterMessage.EnvCommandTopic])
+}
+
+func TestHandleBossMonsterDamaged_NoSharedHpNoMirror(t *testing.T) {
+	p, _, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, partUniqueIds: []uint32{101}})
+
+	buf := message.NewBuffer()
+	require.NoError(t, p.HandleBossMonsterDamaged(buf)(f, 101, 1, 5000, monsterMessage.DamageSourceCharacterAttack))
+	assert.Empty(t, buf.GetAll()[monsterMessage.EnvCommandTopic])
+}
+
+func TestHandleBossMonsterDamaged_UnrelatedMonster(t *testing.T) {
+	p, _, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, sharedHp: true})
+
+	err := p.HandleBossMonsterDamaged(message.NewBuffer())(f, 555, 1, 5000, monsterMessage.DamageSourceCharacterAttack)
+	assert.ErrorIs(t, err, errNoBossEncounter)
+}
+
+func TestHandleBossMonsterKilled_PartRemoved(t *testing.T) {
+	p, inst, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, partUniqueIds: []uint32{101, 102}, sharedHp: true})
+
+	require.NoError(t, p.HandleBossMonsterKilled(message.NewBuffer())(f, 101))
+
+	got, err := GetRegistry().Get(p.t, inst.Id())
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{102}, got.BossEncounter().PartUniqueIds())
+	assert.Equal(t, uint32(100), got.BossEncounter().CoreUniqueId())
+}
+
+func TestHandleBossMonsterKilled_PartsFallingTogether(t *testing.T) {
+	parts := []uint32{101, 102, 103, 104, 105, 106, 107, 108}
+	p, inst, f := setupBossInstance(t, BossEncounter{coreUniqueId: 100, partUniqueIds: parts})
+
+	var wg sync.WaitGroup
+	for _, id := range parts {
+		wg.Add(1)
+		go func(id uint32) {
+			defer wg.Done()
+			assert.NoError(t, p.HandleBossMonsterKilled(message.NewBuffer())(f, id))
+		}(id)
+	}
+	wg.Wait()
+
+	got, err := GetRegistry().Get(p.t, inst.Id())
+	require.NoError(t, err)
+	assert.Empty(t, got.BossEncounter().PartUniqueIds())
+	assert.Equal(t, uint32(100), got.BossEncounter().CoreUniqueId())
+}
+
+func TestExtractBossEncounterConfig(t *testing.T) {
+	cfg, ok := extractBossEncounterConfig(map[string]any{
+		"bossEncounter": map[string]any{
+			"core":           map[string]any{"monsterId": float64(8800000), "x": float64(-10), "y": float64(-215)},
+			"parts":          []any{map[string]any{"monsterId": float64(8800003)}, map[string]any{"x": float64(1)}},
+			"coreAfterParts": true,
+			"coreMessage":    "exposed",
+		},
+	})
+	require.True(t, ok)
+	assert.Equal(t, uint32(8800000), cfg.core.monsterId)
+	assert.Equal(t, int16(-215), cfg.core.y)
+	assert.Len(t, cfg.parts, 1, "a part without a monsterId is skipped")
+	assert.True(t, cfg.coreAfterParts)
+	assert.Equal(t, "exposed", cfg.coreMessage)
+
+	_, ok = extractBossEncounterConfig(map[string]any{"bossEncounter": map[string]any{"parts": []any{}}})
+	assert.False(t, ok, "a core is required")
+}
@@ -15,6 +15,8 @@ type ProcessorMock struct {
 	RegisterAndEmitFunc                     func(questId string, partyId uint32, channelId channel.Id, mapId uint32, characters []instance.CharacterEntry) (instance.Model, error)
 	StartFunc                               func(mb *message.Buffer) func(instanceId uuid.UUID) error
 	StartAndEmitFunc                        func(instanceId uuid.UUID) error
+	StartByFunc                             func(mb *message.Buffer) func(instanceId uuid.UUID, characterId uint32) error
+	StartByAndEmitFunc                      func(instanceId uuid.UUID, characterId uint32) error
 	StageClearAttemptFunc                   func(mb *message.Buffer) func(instanceId uuid.UUID) error
 	StageClearAttemptAndEmitFunc            func(instanceId uuid.UUID) error
 	StageAdvanceFunc                        func(mb *message.Buffer) func(instanceId uuid.UUID) error
@@ -33,6 +35,10 @@ type ProcessorMock struct {
 	HandleFriendlyMonsterKilledAndEmitFunc  func(f field.Model, monsterId uint32) error
 	HandleFriendlyMonsterDropFunc           func(mb *message.Buffer) func(f field.Model, monsterId uint32, itemCount uint32) error
 	HandleFriendlyMonsterDropAndEmitFunc    func(f field.Model, monsterId uint32, itemCount uint32) error
+	HandleBossMonsterDamagedFunc            func(mb *message.Buffer) func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error
+	HandleBossMonsterDamagedAndEmitFunc     func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error
+	HandleBossMonsterKilledFunc             func(mb *message.Buffer) func(f field.Model, uniqueId uint32) error
+	HandleBossMonsterKilledAndEmitFunc      func(f field.Model, uniqueId uint32) error
 	GetByFieldInstanceFunc                  func(fieldInstance uuid.UUID) (instance.Model, error)
 	DestroyFunc                             func(mb *message.Buffer) func(instanceId uuid.UUID, reason string) error
 	DestroyAndEmitFunc                      func(instanceId uuid.UUID, reason string) error
@@ -86,6 +92,20 @@ func (m *ProcessorMock) StartAndEmit(instanceId uuid.UUID) error {
 	return nil
 }
 
+func (m *ProcessorMock) StartBy(mb *message.Buffer) func(instanceId uuid.UUID, characterId uint32) error {
+	if m.StartByFunc != nil {
+		return m.StartByFunc(mb)
+	}
+	return func(uuid.UUID, uint32) error { return nil }
+}
+
+func (m *ProcessorMock) StartByAndEmit(instanceId uuid.UUID, characterId uint32) error {
+	if m.StartByAndEmitFunc != nil {
+		return m.StartByAndEmitFunc(instanceId, characterId)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) StageClearAttempt(mb *message.Buffer) func(instanceId uuid.UUID) error {
 	if m.StageClearAttemptFunc != nil {
 		return m.StageClearAttemptFunc(mb)
@@ -212,6 +232,34 @@ func (m *ProcessorMock) HandleFriendlyMonsterDropAndEmit(f field.Model, monsterI
 	return nil
 }
 
+func (m *ProcessorMock) HandleBossMonsterDamaged(mb *message.Buffer) func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error {
+	if m.HandleBossMonsterDamagedFunc != nil {
+		return m.HandleBossMonsterDamagedFunc(mb)
+	}
+	return func(field.Model, uint32, uint32, uint32, string) error { return nil }
+}
+
+func (m *ProcessorMock) HandleBossMonsterDamagedAndEmit(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error {
+	if m.HandleBossMonsterDamagedAndEmitFunc != nil {
+		return m.HandleBossMonsterDamagedAndEmitFunc(f, uniqueId, characterId, damage, source)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) HandleBossMonsterKilled(mb *message.Buffer) func(f field.Model, uniqueId uint32) error {
+	if m.HandleBossMonsterKilledFunc != nil {
+		return m.HandleBossMonsterKilledFunc(mb)
+	}
+	return func(field.Model, uint32) error { return nil }
+}
+
+func (m *ProcessorMock) HandleBossMonsterKilledAndEmit(f field.Model, uniqueId uint32) error {
+	if m.HandleBossMonsterKilledAndEmitFunc != nil {
+		return m.HandleBossMonsterKilledAndEmitFunc(f, uniqueId)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) GetByFieldInstance(fieldInstance uuid.UUID) (instance.Model, error) {
 	if m.GetByFieldInstanceFunc != nil {
 		return m.GetByFieldInstanceFunc(fieldInstance)
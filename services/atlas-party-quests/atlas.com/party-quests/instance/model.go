@@ -132,6 +132,8 @@ type Model struct {
 	fieldInstances    []uuid.UUID
 	stageState        StageState
 	affinityId        uint32
+	leaderId          uint32
+	bossEncounter     BossEncounter
 }
 
 func (m Model) Id() uuid.UUID                { return m.id }
@@ -150,12 +152,19 @@ func (m Model) RegisteredAt() time.Time      { return m.registeredAt }
 func (m Model) FieldInstances() []uuid.UUID  { return m.fieldInstances }
 func (m Model) StageState() StageState       { return m.stageState }
 func (m Model) AffinityId() uint32           { return m.affinityId }
+func (m Model) LeaderId() uint32             { return m.leaderId }
+func (m Model) BossEncounter() BossEncounter { return m.bossEncounter }
 
 func (m Model) SetState(s State) Model {
 	m.state = s
 	return m
 }
 
+func (m Model) SetLeaderId(characterId uint32) Model {
+	m.leaderId = characterId
+	return m
+}
+
 func (m Model) SetCurrentStageIndex(idx uint32) Model {
 	m.currentStageIndex = idx
 	return m
@@ -186,6 +195,11 @@ func (m Model) SetAffinityId(id uint32) Model {
 	return m
 }
 
+func (m Model) SetBossEncounter(be BossEncounter) Model {
+	m.bossEncounter = be
+	return m
+}
+
 func (m Model) AddCharacter(entry CharacterEntry) Model {
 	m.characters = append(m.characters, entry)
 	return m
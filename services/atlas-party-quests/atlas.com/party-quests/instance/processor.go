@@ -3,6 +3,7 @@ package instance
 import (
 	"atlas-party-quests/condition"
 	"atlas-party-quests/definition"
+	"atlas-party-quests/expedition"
 	"atlas-party-quests/guild"
 	"atlas-party-quests/kafka/message"
 	character2 "atlas-party-quests/kafka/message/character"
@@ -14,6 +15,7 @@ import (
 	"atlas-party-quests/party"
 	"atlas-party-quests/reward"
 	"atlas-party-quests/stage"
+	"atlas-party-quests/validation"
 	"context"
 	"errors"
 	"fmt"
@@ -31,6 +33,7 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	scriptcondition "github.com/Chronicle20/atlas/libs/atlas-script-core/condition"
 	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
 )
 
@@ -41,6 +44,9 @@ type Processor interface {
 	Start(mb *message.Buffer) func(instanceId uuid.UUID) error
 	StartAndEmit(instanceId uuid.UUID) error
 
+	StartBy(mb *message.Buffer) func(instanceId uuid.UUID, characterId uint32) error
+	StartByAndEmit(instanceId uuid.UUID, characterId uint32) error
+
 	StageClearAttempt(mb *message.Buffer) func(instanceId uuid.UUID) error
 	StageClearAttemptAndEmit(instanceId uuid.UUID) error
 
@@ -71,6 +77,12 @@ type Processor interface {
 	HandleFriendlyMonsterDrop(mb *message.Buffer) func(f field.Model, monsterId uint32, itemCount uint32) error
 	HandleFriendlyMonsterDropAndEmit(f field.Model, monsterId uint32, itemCount uint32) error
 
+	HandleBossMonsterDamaged(mb *message.Buffer) func(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error
+	HandleBossMonsterDamagedAndEmit(f field.Model, uniqueId uint32, characterId uint32, damage uint32, source string) error
+
+	HandleBossMonsterKilled(mb *message.Buffer) func(f field.Model, uniqueId uint32) error
+	HandleBossMonsterKilledAndEmit(f field.Model, uniqueId uint32) error
+
 	GetByFieldInstance(fieldInstance uuid.UUID) (Model, error)
 
 	EnterBonus(mb *message.Buffer) func(instanceId uuid.UUID) error
@@ -104,20 +116,22 @@ type Processor interface {
 }
 
 type ProcessorImpl struct {
-	l   logrus.FieldLogger
-	ctx context.Context
-	t   tenant.Model
-	p   producer.Provider
-	db  *gorm.DB
+	l         logrus.FieldLogger
+	ctx       context.Context
+	t         tenant.Model
+	p         producer.Provider
+	db        *gorm.DB
+	evaluator scriptcondition.Evaluator
 }
 
 func NewProcessor(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) Processor {
 	return &ProcessorImpl{
-		l:   l,
-		ctx: ctx,
-		t:   tenant.MustFromContext(ctx),
-		p:   producer.ProviderImpl(l)(ctx),
-		db:  db,
+		l:         l,
+		ctx:       ctx,
+		t:         tenant.MustFromContext(ctx),
+		p:         producer.ProviderImpl(l)(ctx),
+		db:        db,
+		evaluator: validation.NewEvaluator(l, ctx),
 	}
 }
 
@@ -227,6 +241,8 @@ func (p *ProcessorImpl) Register(mb *message.Buffer) func(questId string, partyI
 			return p.registerParty(mb, def, questId, partyId, channelId, characters)
 		case "individual":
 			return p.registerIndividual(mb, def, questId, characters[0].WorldId(), channelId, mapId, characters[0])
+		case registrationTypeExpedition:
+			return p.registerExpedition(mb, def, questId, characters[0].WorldId(), channelId, mapId, characters[0])
 		default:
 			return p.registerParty(mb, def, questId, partyId, channelId, characters)
 		}
@@ -445,6 +461,12 @@ func (p *ProcessorImpl) Start(mb *message.Buffer) func(instanceId uuid.UUID) err
 			return errors.New("definition has no stages")
 		}
 
+		if isExpedition(def) && inst.State() == StateRegistering {
+			if inst, err = p.admitExpedition(mb, inst, def); err != nil {
+				return err
+			}
+		}
+
 		now := time.Now()
 		stg := def.Stages()[0]
 
@@ -461,7 +483,8 @@ func (p *ProcessorImpl) Start(mb *message.Buffer) func(instanceId uuid.UUID) err
 				SetStartedAt(now).
 				SetStageStartedAt(now).
 				SetCurrentStageIndex(0).
-				SetStageState(ss)
+				SetStageState(ss).
+				SetBossEncounter(BossEncounter{})
 		})
 		if err != nil {
 			return err
@@ -483,6 +506,9 @@ func (p *ProcessorImpl) Start(mb *message.Buffer) func(instanceId uuid.UUID) err
 		// Spawn friendly monster if configured for this stage
 		p.spawnFriendlyMonster(mb, stg, inst)
 
+		// Spawn the stage's multi-part boss if configured
+		p.spawnBossEncounter(mb, stg, inst)
+
 		// Emit weather effect if configured for this stage
 		p.emitWeatherEffect(mb, stg, inst)
 
@@ -607,7 +633,7 @@ func (p *ProcessorImpl) spawnFriendlyMonster(mb *message.Buffer, stg stage.Model
 	f := field.NewBuilder(inst.WorldId(), inst.ChannelId(), targetMapId).SetInstance(inst.Id()).Build()
 
 	mp := monster.NewProcessor(p.l, p.ctx)
-	err := mp.SpawnInField(f, cfg.monsterId, cfg.x, cfg.y, cfg.fh)
+	_, err := mp.SpawnInField(f, cfg.monsterId, cfg.x, cfg.y, cfg.fh)
 	if err != nil {
 		p.l.WithError(err).Errorf("Failed to spawn friendly monster [%d] for PQ instance [%s].", cfg.monsterId, inst.Id())
 		return
@@ -676,7 +702,8 @@ func (p *ProcessorImpl) StageAdvance(mb *message.Buffer) func(instanceId uuid.UU
 				SetState(StateActive).
 				SetCurrentStageIndex(nextStageIdx).
 				SetStageStartedAt(now).
-				SetStageState(ss)
+				SetStageState(ss).
+				SetBossEncounter(BossEncounter{})
 		})
 		if err != nil {
 			return err
@@ -699,6 +726,9 @@ func (p *ProcessorImpl) StageAdvance(mb *message.Buffer) func(instanceId uuid.UU
 		// Spawn friendly monster if configured for the new stage
 		p.spawnFriendlyMonster(mb, nextStage, inst)
 
+		// Spawn the new stage's multi-part boss if configured
+		p.spawnBossEncounter(mb, nextStage, inst)
+
 		// Emit weather effect if configured for the new stage
 		p.emitWeatherEffect(mb, nextStage, inst)
 
@@ -767,11 +797,17 @@ func (p *ProcessorImpl) complete(mb *message.Buffer, inst Model, def definition.
 
 	p.l.Infof("PQ instance [%s] completed.", inst.Id())
 
+	p.logExpeditionRun(inst, def, expedition.OutcomeCleared, "completed")
+
 	// Distribute completion rewards
 	p.emitExperienceRewards(mb, inst, def.Rewards())
 
 	// Emit COMPLETED event
-	err = mb.Put(pq.EnvEventStatusTopic, completedEventProvider(inst.WorldId(), inst.Id(), inst.QuestId()))
+	characterIds := make([]uint32, 0, len(inst.Characters()))
+	for _, c := range inst.Characters() {
+		characterIds = append(characterIds, c.CharacterId())
+	}
+	err = mb.Put(pq.EnvEventStatusTopic, completedEventProvider(inst.WorldId(), inst.Id(), inst.QuestId(), characterIds))
 	if err != nil {
 		return err
 	}
@@ -1074,6 +1110,11 @@ func (p *ProcessorImpl) Destroy(mb *message.Buffer) func(instanceId uuid.UUID, r
 			p.emitDestroyReactorsInField(mb, inst, []uint32{def.Bonus().MapId()})
 		}
 
+		// A run destroyed before it completed is a failed run.
+		if inst.State() != StateCompleted && inst.State() != StateBonus {
+			p.logExpeditionRun(inst, def, expedition.OutcomeFailed, reason)
+		}
+
 		exitMap := _map.Id(def.Exit())
 
 		// Warp all characters to exit map
@@ -3,6 +3,7 @@ package instance
 import (
 	character2 "atlas-party-quests/kafka/message/character"
 	mapKafka "atlas-party-quests/kafka/message/map"
+	monsterMessage "atlas-party-quests/kafka/message/monster"
 	pq "atlas-party-quests/kafka/message/party_quest"
 	reactorMessage "atlas-party-quests/kafka/message/reactor"
 	"atlas-party-quests/kafka/message/system_message"
@@ -11,6 +12,7 @@ import (
 	"github.com/segmentio/kafka-go"
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
@@ -127,14 +129,16 @@ func stageAdvancedEventProvider(worldId world.Id, instanceId uuid.UUID, questId
 	return producer.SingleMessageProvider(key, value)
 }
 
-func completedEventProvider(worldId world.Id, instanceId uuid.UUID, questId string) model.Provider[[]kafka.Message] {
+func completedEventProvider(worldId world.Id, instanceId uuid.UUID, questId string, characterIds []uint32) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(0))
 	value := &pq.StatusEvent[pq.CompletedEventBody]{
 		WorldId:    worldId,
 		InstanceId: instanceId,
 		QuestId:    questId,
 		Type:       pq.EventTypeCompleted,
-		Body:       pq.CompletedEventBody{},
+		Body: pq.CompletedEventBody{
+			CharacterIds: characterIds,
+		},
 	}
 	return producer.SingleMessageProvider(key, value)
 }
@@ -254,3 +258,20 @@ func weatherStartCommandProvider(worldId world.Id, channelId channel.Id, mapId _
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+func damageMonsterCommandProvider(f field.Model, uniqueId uint32, characterId uint32, damage uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(uniqueId))
+	value := &monsterMessage.Command[monsterMessage.DamageCommandBody]{
+		WorldId:   f.WorldId(),
+		ChannelId: f.ChannelId(),
+		MapId:     f.MapId(),
+		Instance:  f.Instance(),
+		MonsterId: uniqueId,
+		Type:      monsterMessage.CommandTypeDamage,
+		Body: monsterMessage.DamageCommandBody{
+			CharacterId: characterId,
+			Damages:     []uint32{damage},
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -35,6 +35,7 @@ type RestModel struct {
 	WorldId           world.Id                  `json:"worldId"`
 	ChannelId         channel.Id                `json:"channelId"`
 	PartyId           uint32                    `json:"partyId"`
+	LeaderId          uint32                    `json:"leaderId,omitempty"`
 	Characters        []CharacterEntryRestModel `json:"characters"`
 	CurrentStageIndex uint32                    `json:"currentStageIndex"`
 	StartedAt         time.Time                 `json:"startedAt"`
@@ -104,6 +105,7 @@ func Transform(m Model) (RestModel, error) {
 		WorldId:           m.WorldId(),
 		ChannelId:         m.ChannelId(),
 		PartyId:           m.PartyId(),
+		LeaderId:          m.LeaderId(),
 		Characters:        chars,
 		CurrentStageIndex: m.CurrentStageIndex(),
 		StartedAt:         m.StartedAt(),
@@ -37,6 +37,12 @@ func InitHandlers(l logrus.FieldLogger, db *gorm.DB) func(rf func(topic string,
 		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventFriendlyDrop(db)))); err != nil {
 			return err
 		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventBossDamaged(db)))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventBossKilled(db)))); err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -79,3 +85,29 @@ func handleStatusEventFriendlyDrop(db *gorm.DB) message.Handler[monsterMessage.S
 		}
 	}
 }
+
+func handleStatusEventBossDamaged(db *gorm.DB) message.Handler[monsterMessage.StatusEvent[monsterMessage.DamagedBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e monsterMessage.StatusEvent[monsterMessage.DamagedBody]) {
+		if e.Type != monsterMessage.EventStatusDamaged {
+			return
+		}
+
+		err := instance.NewProcessor(l, ctx, db).HandleBossMonsterDamagedAndEmit(e.Field(), e.UniqueId, e.Body.ActorId, e.Body.Damage, e.Body.DamageSource)
+		if err != nil {
+			l.Debugf("Monster [%d] damaged in field [%s] not part of any PQ boss encounter: %s.", e.UniqueId, e.Field().Id(), err.Error())
+		}
+	}
+}
+
+func handleStatusEventBossKilled(db *gorm.DB) message.Handler[monsterMessage.StatusEvent[monsterMessage.KilledBody]] {
+	return func(l logrus.FieldLogger, ctx context.Context, e monsterMessage.StatusEvent[monsterMessage.KilledBody]) {
+		if e.Type != monsterMessage.EventStatusKilled {
+			return
+		}
+
+		err := instance.NewProcessor(l, ctx, db).HandleBossMonsterKilledAndEmit(e.Field(), e.UniqueId)
+		if err != nil {
+			l.Debugf("Monster [%d] killed in field [%s] not part of any PQ boss encounter: %s.", e.UniqueId, e.Field().Id(), err.Error())
+		}
+	}
+}
@@ -85,8 +85,8 @@ func handleStartCommand(db *gorm.DB) message.Handler[pq.Command[pq.StartCommandB
 			return
 		}
 
-		l.Debugf("Handling START command for instance [%s].", c.Body.InstanceId)
-		_ = instance.NewProcessor(l, ctx, db).StartAndEmit(c.Body.InstanceId)
+		l.Debugf("Handling START command for instance [%s] from character [%d].", c.Body.InstanceId, c.CharacterId)
+		_ = instance.NewProcessor(l, ctx, db).StartByAndEmit(c.Body.InstanceId, c.CharacterId)
 	}
 }
 
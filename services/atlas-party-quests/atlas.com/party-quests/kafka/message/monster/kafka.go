@@ -15,6 +15,11 @@ const (
 	EventStatusDamaged      = "DAMAGED"
 	EventStatusKilled       = "KILLED"
 	EventStatusFriendlyDrop = "FRIENDLY_DROP"
+
+	DamageSourceCharacterAttack = "CHARACTER_ATTACK"
+
+	EnvCommandTopic   = "COMMAND_TOPIC_MONSTER"
+	CommandTypeDamage = "DAMAGE"
 )
 
 type StatusEvent[E any] struct {
@@ -32,12 +37,17 @@ func (e StatusEvent[E]) Field() field.Model {
 	return field.NewBuilder(e.WorldId, e.ChannelId, e.MapId).SetInstance(e.Instance).Build()
 }
 
+// DamagedBody mirrors atlas-monsters' DAMAGED body. Damage is the amount this
+// event applied and DamageSource where it came from; DamageEntries is the
+// monster's running per-character total.
 type DamagedBody struct {
 	X             int16         `json:"x"`
 	Y             int16         `json:"y"`
 	ObserverId    uint32        `json:"observerId"`
 	ActorId       uint32        `json:"actorId"`
 	Boss          bool          `json:"boss"`
+	Damage        uint32        `json:"damage"`
+	DamageSource  string        `json:"damageSource"`
 	DamageEntries []DamageEntry `json:"damageEntries"`
 }
 
@@ -57,3 +67,24 @@ type KilledBody struct {
 type FriendlyDropBody struct {
 	ItemCount uint32 `json:"itemCount"`
 }
+
+// Command mirrors the per-monster command envelope atlas-monsters consumes
+// (source of truth: services/atlas-monsters/atlas.com/monsters/kafka/consumer/monster/kafka.go).
+// MonsterId is the monster's unique id.
+type Command[E any] struct {
+	WorldId   world.Id   `json:"worldId"`
+	ChannelId channel.Id `json:"channelId"`
+	MapId     _map.Id    `json:"mapId"`
+	Instance  uuid.UUID  `json:"instance"`
+	MonsterId uint32     `json:"monsterId"`
+	Type      string     `json:"type"`
+	Body      E          `json:"body"`
+}
+
+// DamageCommandBody applies damage lines to a monster on behalf of a
+// character. Only the fields this service produces are mirrored.
+type DamageCommandBody struct {
+	CharacterId uint32   `json:"characterId"`
+	Damages     []uint32 `json:"damages"`
+	AttackType  byte     `json:"attackType"`
+}
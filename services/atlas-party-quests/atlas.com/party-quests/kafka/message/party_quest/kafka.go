@@ -124,7 +124,11 @@ type StageAdvancedEventBody struct {
 	MapIds     []uint32 `json:"mapIds"`
 }
 
-type CompletedEventBody struct{}
+// CompletedEventBody lists the characters still registered when the quest
+// completed, so consumers can credit them without looking the instance up.
+type CompletedEventBody struct {
+	CharacterIds []uint32 `json:"characterIds"`
+}
 
 type FailedEventBody struct {
 	Reason string `json:"reason"`
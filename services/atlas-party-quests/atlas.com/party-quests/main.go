@@ -2,6 +2,7 @@ package main
 
 import (
 	"atlas-party-quests/definition"
+	"atlas-party-quests/expedition"
 	"atlas-party-quests/instance"
 	"context"
 	"os"
@@ -55,7 +56,7 @@ func main() {
 	rt := service.Bootstrap(serviceName, service.WithEnvironmentRegistry(serviceName))
 	l := rt.Logger()
 
-	db := database.Connect(l, database.SetMigrations(definition.MigrateTable, func(db *gorm.DB) error {
+	db := database.Connect(l, database.SetMigrations(definition.MigrateTable, expedition.MigrateTable, func(db *gorm.DB) error {
 		return db.AutoMigrate(&seeder.SeedState{})
 	}))
 
@@ -132,6 +133,7 @@ func main() {
 		AddRouteInitializer(definition.InitResource(GetServer())(db)).
 		AddRouteInitializer(definition.InitSeedResource(GetServer())(db)).
 		AddRouteInitializer(instance.InitResource(GetServer())(db)).
+		AddRouteInitializer(expedition.InitResource(GetServer())(db)).
 		AddRouteInitializer(server.MountHandler("/debug/consumers", consumer.GetManager().DebugHandler())).
 		AddRouteInitializer(server.MountReadiness("/readyz", rt.Ready)).
 		Run()
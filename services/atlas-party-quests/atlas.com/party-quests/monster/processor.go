@@ -2,6 +2,7 @@ package monster
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -14,7 +15,7 @@ import (
 
 type Processor interface {
 	DestroyInField(worldId world.Id, channelId channel.Id, mapId _map.Id, instance uuid.UUID) error
-	SpawnInField(f field.Model, monsterId uint32, x int16, y int16, fh int16) error
+	SpawnInField(f field.Model, monsterId uint32, x int16, y int16, fh int16) (uint32, error)
 }
 
 type ProcessorImpl struct {
@@ -32,7 +33,8 @@ func (p *ProcessorImpl) DestroyInField(worldId world.Id, channelId channel.Id, m
 	return requestDestroyInField(p.ctx, worldId, channelId, mapId, instance)(p.l, p.ctx)
 }
 
-func (p *ProcessorImpl) SpawnInField(f field.Model, monsterId uint32, x int16, y int16, fh int16) error {
+// SpawnInField spawns monsterId in the field and returns its unique id.
+func (p *ProcessorImpl) SpawnInField(f field.Model, monsterId uint32, x int16, y int16, fh int16) (uint32, error) {
 	input := SpawnInputRestModel{
 		Id:        "0",
 		MonsterId: monsterId,
@@ -41,11 +43,16 @@ func (p *ProcessorImpl) SpawnInField(f field.Model, monsterId uint32, x int16, y
 		Fh:        fh,
 		Team:      0,
 	}
-	_, err := requestSpawnInField(p.ctx, f, input)(p.l, p.ctx)
+	resp, err := requestSpawnInField(p.ctx, f, input)(p.l, p.ctx)
 	if err != nil {
 		p.l.WithError(err).Errorf("Failed to spawn monster [%d] in field [%s].", monsterId, f.Id())
-		return err
+		return 0, err
 	}
-	p.l.Debugf("Spawned monster [%d] at (%d, %d) in field [%s].", monsterId, x, y, f.Id())
-	return nil
+	uniqueId, err := strconv.ParseUint(resp.Id, 10, 32)
+	if err != nil {
+		p.l.WithError(err).Errorf("Spawned monster [%d] in field [%s] returned invalid id [%s].", monsterId, f.Id(), resp.Id)
+		return 0, err
+	}
+	p.l.Debugf("Spawned monster [%d] as [%d] at (%d, %d) in field [%s].", monsterId, uniqueId, x, y, f.Id())
+	return uint32(uniqueId), nil
 }
@@ -55,9 +55,10 @@ func (r SpawnInputRestModel) GetID() string {
 	return r.Id
 }
 
+// SpawnResponseRestModel is the spawned monster. Its resource id is the
+// monster's unique id.
 type SpawnResponseRestModel struct {
 	Id        string `json:"-"`
-	UniqueId  uint32 `json:"uniqueId"`
 	MonsterId uint32 `json:"monsterId"`
 }
 
@@ -0,0 +1,11 @@
+package validation
+
+// ConditionInput is one condition sent to the query-aggregator for evaluation.
+type ConditionInput struct {
+	Type            string `json:"type"`
+	Operator        string `json:"operator"`
+	Value           int    `json:"value"`
+	ReferenceId     uint32 `json:"referenceId,omitempty"`
+	Step            string `json:"step,omitempty"`
+	IncludeEquipped bool   `json:"includeEquipped,omitempty"`
+}
@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-script-core/condition"
+	scriptctx "github.com/Chronicle20/atlas/libs/atlas-script-core/context"
+)
+
+// Evaluator evaluates atlas-script-core conditions against a character's
+// state through the query-aggregator's validations endpoint.
+type Evaluator struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewEvaluator(l logrus.FieldLogger, ctx context.Context) condition.Evaluator {
+	return &Evaluator{l: l, ctx: ctx}
+}
+
+var _ condition.Evaluator = (*Evaluator)(nil)
+
+func (e *Evaluator) EvaluateCondition(characterId uint32, c condition.Model) (bool, error) {
+	value, err := scriptctx.EvaluateValueAsInt(c.Value())
+	if err != nil {
+		return false, err
+	}
+
+	var referenceId uint32
+	if raw := c.ReferenceIdRaw(); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return false, fmt.Errorf("referenceId [%s] is not a valid uint32", raw)
+		}
+		referenceId = uint32(id)
+	}
+
+	body := RestModel{
+		Id: characterId,
+		Conditions: []ConditionInput{{
+			Type:            c.Type(),
+			Operator:        c.Operator(),
+			Value:           value,
+			ReferenceId:     referenceId,
+			Step:            c.Step(),
+			IncludeEquipped: c.IncludeEquipped(),
+		}},
+	}
+	resp, err := requestValidation(e.ctx, body)(e.l, e.ctx)
+	if err != nil {
+		return false, err
+	}
+	e.l.Debugf("Condition [%s] evaluated to [%t] for character [%d].", c.Type(), resp.Passed, characterId)
+	return resp.Passed, nil
+}
@@ -0,0 +1,20 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "QUERY_AGGREGATOR")
+}
+
+func requestValidation(ctx context.Context, body RestModel) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	return requests.PostRequest[RestModel](fmt.Sprint(root+"validations"), body)
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jtumidanski/api2go/jsonapi"
+)
+
+const Resource = "validations"
+
+type RestModel struct {
+	Id         uint32           `json:"-"`
+	Conditions []ConditionInput `json:"conditions,omitempty"`
+	Passed     bool             `json:"passed"`
+}
+
+func (r RestModel) GetName() string {
+	return Resource
+}
+
+func (r RestModel) GetID() string {
+	return strconv.FormatUint(uint64(r.Id), 10)
+}
+
+func (r *RestModel) SetID(idStr string) error {
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid character ID: %w", err)
+	}
+	r.Id = uint32(id)
+	return nil
+}
+
+func (r RestModel) GetReferences() []jsonapi.Reference {
+	return []jsonapi.Reference{}
+}
+
+func (r RestModel) GetReferencedIDs() []jsonapi.ReferenceID {
+	return []jsonapi.ReferenceID{}
+}
+
+func (r RestModel) GetReferencedStructs() []jsonapi.MarshalIdentifier {
+	return []jsonapi.MarshalIdentifier{}
+}
+
+func (r *RestModel) SetToOneReferenceID(_, _ string) error {
+	return nil
+}
+
+func (r *RestModel) SetToManyReferenceIDs(_ string, _ []string) error {
+	return nil
+}
+
+func (r *RestModel) SetReferencedStructs(_ map[string]map[string]jsonapi.Data) error {
+	return nil
+}
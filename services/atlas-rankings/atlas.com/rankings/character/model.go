@@ -14,6 +14,7 @@ type Model struct {
 	jobId      job.Id
 	level      byte
 	experience uint32
+	fame       int16
 	gm         int
 }
 
@@ -23,4 +24,5 @@ func (m Model) WorldId() world.Id  { return m.worldId }
 func (m Model) JobId() job.Id      { return m.jobId }
 func (m Model) Level() byte        { return m.level }
 func (m Model) Experience() uint32 { return m.experience }
+func (m Model) Fame() int16        { return m.fame }
 func (m Model) Gm() int            { return m.gm }
@@ -18,6 +18,7 @@ type RestModel struct {
 	WorldId    world.Id `json:"worldId"`
 	Level      byte     `json:"level"`
 	Experience uint32   `json:"experience"`
+	Fame       int16    `json:"fame"`
 	JobId      job.Id   `json:"jobId"`
 	Gm         int      `json:"gm"`
 }
@@ -61,6 +62,7 @@ func Extract(r RestModel) (Model, error) {
 		jobId:      r.JobId,
 		level:      r.Level,
 		experience: r.Experience,
+		fame:       r.Fame,
 		gm:         r.Gm,
 	}, nil
 }
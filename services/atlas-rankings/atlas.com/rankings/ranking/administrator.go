@@ -36,6 +36,7 @@ func upsertBatch(db *gorm.DB, tenantId uuid.UUID, entities []Entity) error {
 			"name", "world_id", "job_category", "level", "job_id",
 			"overall_rank", "overall_rank_move",
 			"job_rank", "job_rank_move",
+			"fame", "fame_rank", "fame_rank_move",
 			"computed_at",
 		}),
 	}).CreateInBatches(&entities, upsertBatchSize).Error
@@ -18,6 +18,9 @@ type Builder struct {
 	overallRankMove int32
 	jobRank         uint32
 	jobRankMove     int32
+	fame            int16
+	fameRank        uint32
+	fameRankMove    int32
 	computedAt      time.Time
 }
 
@@ -35,6 +38,9 @@ func (b *Builder) SetOverallRank(v uint32) *Builder    { b.overallRank = v; retu
 func (b *Builder) SetOverallRankMove(v int32) *Builder { b.overallRankMove = v; return b }
 func (b *Builder) SetJobRank(v uint32) *Builder        { b.jobRank = v; return b }
 func (b *Builder) SetJobRankMove(v int32) *Builder     { b.jobRankMove = v; return b }
+func (b *Builder) SetFame(v int16) *Builder            { b.fame = v; return b }
+func (b *Builder) SetFameRank(v uint32) *Builder       { b.fameRank = v; return b }
+func (b *Builder) SetFameRankMove(v int32) *Builder    { b.fameRankMove = v; return b }
 func (b *Builder) SetComputedAt(v time.Time) *Builder  { b.computedAt = v; return b }
 
 func (b *Builder) Build() Model {
@@ -49,6 +55,9 @@ func (b *Builder) Build() Model {
 		overallRankMove: b.overallRankMove,
 		jobRank:         b.jobRank,
 		jobRankMove:     b.jobRankMove,
+		fame:            b.fame,
+		fameRank:        b.fameRank,
+		fameRankMove:    b.fameRankMove,
 		computedAt:      b.computedAt,
 	}
 }
@@ -16,6 +16,7 @@ type Input struct {
 	JobId       job.Id
 	Level       byte
 	Experience  uint32
+	Fame        int16
 }
 
 // Ranked is the computed placement for one character. Ranks are 1-based and
@@ -28,8 +29,10 @@ type Ranked struct {
 	JobCategory uint16
 	Level       byte
 	JobId       job.Id
+	Fame        int16
 	OverallRank uint32
 	JobRank     uint32
+	FameRank    uint32
 }
 
 // JobCategory buckets a job id into its top-level job division: jobId / 100.
@@ -49,9 +52,18 @@ func less(a Input, b Input) bool {
 	return a.CharacterId < b.CharacterId
 }
 
+// lessFame orders the fame leaderboard: fame DESC, then the overall order.
+func lessFame(a Input, b Input) bool {
+	if a.Fame != b.Fame {
+		return a.Fame > b.Fame
+	}
+	return less(a, b)
+}
+
 // Rank computes per-world overall and job-category placements ordered by
 // level DESC, experience DESC, characterId ASC. Job ranks reuse the same
-// sorted order restricted to each category.
+// sorted order restricted to each category. Fame ranks order the same
+// world by fame DESC, falling back to the overall order on ties.
 func Rank(inputs []Input) []Ranked {
 	byWorld := make(map[world.Id][]Input)
 	for _, i := range inputs {
@@ -60,6 +72,13 @@ func Rank(inputs []Input) []Ranked {
 
 	results := make([]Ranked, 0, len(inputs))
 	for wid, ws := range byWorld {
+		byFame := append([]Input(nil), ws...)
+		sort.Slice(byFame, func(i, j int) bool { return lessFame(byFame[i], byFame[j]) })
+		famePos := make(map[uint32]uint32, len(byFame))
+		for idx, c := range byFame {
+			famePos[c.CharacterId] = uint32(idx + 1)
+		}
+
 		sort.Slice(ws, func(i, j int) bool { return less(ws[i], ws[j]) })
 
 		jobPos := make(map[uint16]uint32)
@@ -73,8 +92,10 @@ func Rank(inputs []Input) []Ranked {
 				JobCategory: cat,
 				Level:       c.Level,
 				JobId:       c.JobId,
+				Fame:        c.Fame,
 				OverallRank: uint32(idx + 1),
 				JobRank:     jobPos[cat],
+				FameRank:    famePos[c.CharacterId],
 			})
 		}
 	}
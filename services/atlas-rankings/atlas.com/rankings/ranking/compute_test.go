@@ -61,6 +61,26 @@ func TestRankOrderingAndTiebreaks(t *testing.T) {
 	}
 }
 
+func TestFameRankOrderingAndTiebreaks(t *testing.T) {
+	// fame DESC, then level DESC, experience DESC, characterId ASC
+	inputs := []Input{
+		{CharacterId: 1, WorldId: 0, JobId: 100, Level: 90, Experience: 100, Fame: 5},
+		{CharacterId: 2, WorldId: 0, JobId: 100, Level: 30, Experience: 5, Fame: 40},
+		{CharacterId: 3, WorldId: 0, JobId: 100, Level: 50, Experience: 200, Fame: 5},
+		{CharacterId: 4, WorldId: 1, JobId: 100, Level: 10, Experience: 0, Fame: -3},
+	}
+	got := rankedById(Rank(inputs))
+	if got[2].FameRank != 1 || got[2].Fame != 40 {
+		t.Errorf("char 2 (most fame) fame rank = %d fame = %d, want 1 and 40", got[2].FameRank, got[2].Fame)
+	}
+	if got[1].FameRank != 2 || got[3].FameRank != 3 {
+		t.Errorf("fame tie should fall back to level: char1=%d char3=%d, want 2 and 3", got[1].FameRank, got[3].FameRank)
+	}
+	if got[4].FameRank != 1 {
+		t.Errorf("char 4 is alone on world 1: fame rank = %d, want 1", got[4].FameRank)
+	}
+}
+
 func TestRankUniquePerWorld(t *testing.T) {
 	inputs := []Input{
 		{CharacterId: 1, Name: "Char1", WorldId: 0, JobId: 0, Level: 10, Experience: 0},
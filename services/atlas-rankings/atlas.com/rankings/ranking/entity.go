@@ -29,6 +29,9 @@ type Entity struct {
 	OverallRankMove int32     `gorm:"not null"`
 	JobRank         uint32    `gorm:"not null"`
 	JobRankMove     int32     `gorm:"not null"`
+	Fame            int16     `gorm:"not null;default:0"`
+	FameRank        uint32    `gorm:"not null;default:0"`
+	FameRankMove    int32     `gorm:"not null;default:0"`
 	ComputedAt      time.Time `gorm:"not null"`
 }
 
@@ -78,6 +81,9 @@ func Make(e Entity) (Model, error) {
 		SetOverallRankMove(e.OverallRankMove).
 		SetJobRank(e.JobRank).
 		SetJobRankMove(e.JobRankMove).
+		SetFame(e.Fame).
+		SetFameRank(e.FameRank).
+		SetFameRankMove(e.FameRankMove).
 		SetComputedAt(e.ComputedAt).
 		Build(), nil
 }
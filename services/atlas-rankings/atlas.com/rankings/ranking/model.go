@@ -18,6 +18,9 @@ type Model struct {
 	overallRankMove int32
 	jobRank         uint32
 	jobRankMove     int32
+	fame            int16
+	fameRank        uint32
+	fameRankMove    int32
 	computedAt      time.Time
 }
 
@@ -31,6 +34,9 @@ func (m Model) OverallRank() uint32    { return m.overallRank }
 func (m Model) OverallRankMove() int32 { return m.overallRankMove }
 func (m Model) JobRank() uint32        { return m.jobRank }
 func (m Model) JobRankMove() int32     { return m.jobRankMove }
+func (m Model) Fame() int16            { return m.fame }
+func (m Model) FameRank() uint32       { return m.fameRank }
+func (m Model) FameRankMove() int32    { return m.fameRankMove }
 func (m Model) ComputedAt() time.Time  { return m.computedAt }
 
 type CycleModel struct {
@@ -26,6 +26,9 @@ type Processor interface {
 	// LeaderboardProvider returns one page of ranked characters for a world,
 	// ordered overall (jobCategory nil) or within a job category.
 	LeaderboardProvider(worldId world.Id, jobCategory *uint16, page model.Page) model.Provider[model.Paged[Model]]
+	// FameLeaderboardProvider returns one page of ranked characters for a
+	// world, ordered by fame.
+	FameLeaderboardProvider(worldId world.Id, page model.Page) model.Provider[model.Paged[Model]]
 	// IsDue reports whether the tenant's recompute interval has elapsed
 	// since the last cycle start (true when no cycle has ever run).
 	IsDue(interval time.Duration, now time.Time) (bool, error)
@@ -89,6 +92,11 @@ func (p *ProcessorImpl) LeaderboardProvider(worldId world.Id, jobCategory *uint1
 	return model.MapPaged(Make)(ep)(model.ParallelMap())
 }
 
+func (p *ProcessorImpl) FameLeaderboardProvider(worldId world.Id, page model.Page) model.Provider[model.Paged[Model]] {
+	ep := byWorldFamePagedEntityProvider(worldId, page)(p.db.WithContext(p.ctx))
+	return model.MapPaged(Make)(ep)(model.ParallelMap())
+}
+
 func (p *ProcessorImpl) IsDue(interval time.Duration, now time.Time) (bool, error) {
 	c, err := cycleEntityProvider()(p.db.WithContext(p.ctx))()
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -125,6 +133,7 @@ func (p *ProcessorImpl) Recompute(now time.Time) error {
 			JobId:       c.JobId(),
 			Level:       c.Level(),
 			Experience:  c.Experience(),
+			Fame:        c.Fame(),
 		})
 	}
 
@@ -142,10 +151,11 @@ func (p *ProcessorImpl) Recompute(now time.Time) error {
 	entities := make([]Entity, 0, len(ranked))
 	worldCounts := make(map[byte]int)
 	for _, r := range ranked {
-		var prevOverall, prevJob uint32
+		var prevOverall, prevJob, prevFame uint32
 		if pe, ok := prevById[r.CharacterId]; ok {
 			prevOverall = pe.OverallRank
 			prevJob = pe.JobRank
+			prevFame = pe.FameRank
 		}
 		entities = append(entities, Entity{
 			CharacterId:     r.CharacterId,
@@ -158,6 +168,9 @@ func (p *ProcessorImpl) Recompute(now time.Time) error {
 			OverallRankMove: Move(prevOverall, r.OverallRank),
 			JobRank:         r.JobRank,
 			JobRankMove:     Move(prevJob, r.JobRank),
+			Fame:            r.Fame,
+			FameRank:        r.FameRank,
+			FameRankMove:    Move(prevFame, r.FameRank),
 			ComputedAt:      now,
 		})
 		worldCounts[byte(r.WorldId)]++
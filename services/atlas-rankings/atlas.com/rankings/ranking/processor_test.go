@@ -11,6 +11,7 @@ import (
 
 	"github.com/Chronicle20/atlas/libs/atlas-constants/job"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 )
 
 // characterFixture builds a character.Model via its JSON:API extract path —
@@ -74,6 +75,60 @@ func TestRecomputeRanksAndExcludesGms(t *testing.T) {
 	}
 }
 
+// fameFixture is characterFixture with a fame value.
+func fameFixture(t *testing.T, id uint32, level byte, fame int16) character.Model {
+	t.Helper()
+	rm := character.RestModel{AccountId: 1, Level: level, JobId: job.Id(100), Fame: fame}
+	rm.Id = id
+	m, err := character.Extract(rm)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+	return m
+}
+
+func TestRecomputeStoresFameRanksAndMoves(t *testing.T) {
+	db := testDatabase(t)
+	_, ctx := testTenantContext(t)
+	l := logrus.New()
+
+	p := NewProcessor(l, ctx, db).WithCharacterSupplier(supplierOf(
+		fameFixture(t, 1, 90, 2),
+		fameFixture(t, 2, 30, 50),
+	))
+	if err := p.Recompute(time.Now()); err != nil {
+		t.Fatalf("recompute: %v", err)
+	}
+	m2, _ := p.GetByCharacterId(2)
+	if m2.FameRank() != 1 || m2.Fame() != 50 || m2.OverallRank() != 2 {
+		t.Fatalf("char 2 after cycle 1: %+v", m2)
+	}
+
+	p = p.WithCharacterSupplier(supplierOf(
+		fameFixture(t, 1, 90, 80),
+		fameFixture(t, 2, 30, 50),
+	))
+	if err := p.Recompute(time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("recompute: %v", err)
+	}
+	m1, _ := p.GetByCharacterId(1)
+	if m1.FameRank() != 1 || m1.FameRankMove() != 1 {
+		t.Fatalf("char 1 after cycle 2: %+v", m1)
+	}
+	m2, _ = p.GetByCharacterId(2)
+	if m2.FameRank() != 2 || m2.FameRankMove() != -1 {
+		t.Fatalf("char 2 after cycle 2: %+v", m2)
+	}
+
+	paged, err := p.FameLeaderboardProvider(0, model.Page{Number: 1, Size: 10})()
+	if err != nil {
+		t.Fatalf("fame leaderboard: %v", err)
+	}
+	if len(paged.Items) != 2 || paged.Items[0].CharacterId() != 1 {
+		t.Fatalf("fame leaderboard order: %+v", paged.Items)
+	}
+}
+
 func TestRecomputeMovesAcrossTwoCycles(t *testing.T) {
 	db := testDatabase(t)
 	_, ctx := testTenantContext(t)
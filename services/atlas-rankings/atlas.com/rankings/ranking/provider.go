@@ -75,3 +75,11 @@ func byWorldPagedEntityProvider(worldId world.Id, jobCategory *uint16, page mode
 		return database.PagedQuery[Entity](q, page)
 	}
 }
+
+// byWorldFamePagedEntityProvider reads one page of ranking rows for a world
+// ordered for the fame leaderboard (fame_rank ASC).
+func byWorldFamePagedEntityProvider(worldId world.Id, page model.Page) database.EntityProvider[model.Paged[Entity]] {
+	return func(db *gorm.DB) model.Provider[model.Paged[Entity]] {
+		return database.PagedQuery[Entity](db.Where("world_id = ?", worldId).Order("fame_rank ASC"), page)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"atlas-rankings/rest"
 	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -24,6 +25,7 @@ func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteIn
 			registerGet := rest.RegisterHandler(l)(db)(si)
 			r := router.PathPrefix("/rankings").Subrouter()
 			r.HandleFunc("", registerGet("get_leaderboard", handleGetLeaderboard)).Methods(http.MethodGet)
+			r.HandleFunc("/fame", registerGet("get_fame_leaderboard", handleGetFameLeaderboard)).Methods(http.MethodGet)
 			r.HandleFunc("/characters", registerGet("get_rankings_for_characters", handleGetRankingsForCharacters)).Methods(http.MethodGet).Queries("ids", "{ids}")
 			// Bare /characters (no ids query) is a caller error, not a missing route.
 			r.HandleFunc("/characters", registerGet("get_rankings_missing_ids", handleMissingIds)).Methods(http.MethodGet)
@@ -32,21 +34,29 @@ func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteIn
 	}
 }
 
+// parseWorldId reads the required filter[worldId]. A non-empty message
+// reports why it is missing or malformed.
+func parseWorldId(q url.Values) (world.Id, string) {
+	rawWorld := q.Get("filter[worldId]")
+	if rawWorld == "" {
+		return 0, "filter[worldId] query parameter is required"
+	}
+	wid64, err := strconv.ParseUint(rawWorld, 10, 8)
+	if err != nil {
+		return 0, "filter[worldId] must be a valid world id"
+	}
+	return world.Id(wid64), ""
+}
+
 func handleGetLeaderboard(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
 
-		rawWorld := q.Get("filter[worldId]")
-		if rawWorld == "" {
-			server.WriteBadRequest(d.Logger(), w, "filter[worldId] query parameter is required")
+		worldId, msg := parseWorldId(q)
+		if msg != "" {
+			server.WriteBadRequest(d.Logger(), w, msg)
 			return
 		}
-		wid64, err := strconv.ParseUint(rawWorld, 10, 8)
-		if err != nil {
-			server.WriteBadRequest(d.Logger(), w, "filter[worldId] must be a valid world id")
-			return
-		}
-		worldId := world.Id(wid64)
 
 		var jobCategory *uint16
 		if rawCat := q.Get("filter[jobCategory]"); rawCat != "" {
@@ -84,6 +94,42 @@ func handleGetLeaderboard(d *rest.HandlerDependency, c *rest.HandlerContext) htt
 	}
 }
 
+// handleGetFameLeaderboard serves a world's characters ordered by fame.
+func handleGetFameLeaderboard(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		worldId, msg := parseWorldId(q)
+		if msg != "" {
+			server.WriteBadRequest(d.Logger(), w, msg)
+			return
+		}
+
+		page, err := paginate.ParseParams(q, paginate.DefaultPageSize, paginate.MaxPageSize)
+		if err != nil {
+			server.WriteBadRequest(d.Logger(), w, err.Error())
+			return
+		}
+
+		paged, err := NewProcessor(d.Logger(), d.Context(), d.DB()).FameLeaderboardProvider(worldId, page)()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Unable to read fame leaderboard for world [%d].", worldId)
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		res, err := model.SliceMap(TransformLeaderboard)(model.FixedProvider(paged.Items))(model.ParallelMap())()
+		if err != nil {
+			d.Logger().WithError(err).Errorf("Creating leaderboard REST model.")
+			server.WriteErrorResponse(d.Logger())(w)(err)
+			return
+		}
+
+		queryParams := jsonapi.ParseQueryFields(&q)
+		server.MarshalPaginatedResponse[[]LeaderboardRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(res, paginate.EnvelopeFor(paged), r)
+	}
+}
+
 func handleMissingIds(d *rest.HandlerDependency, _ *rest.HandlerContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, _ *http.Request) {
 		server.WriteBadRequest(d.Logger(), w, "ids query parameter is required")
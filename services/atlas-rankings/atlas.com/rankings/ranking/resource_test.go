@@ -218,6 +218,43 @@ func TestLeaderboardOrdersByOverallRank(t *testing.T) {
 	}
 }
 
+func TestFameLeaderboardOrdersByFameRank(t *testing.T) {
+	db := testDatabase(t)
+	tm, _ := testTenantContext(t)
+	seedRankings(t, db, tm, []Entity{
+		{CharacterId: 1, Name: "A", WorldId: 0, JobCategory: 1, Level: 90, JobId: 110, OverallRank: 1, JobRank: 1, Fame: 3, FameRank: 2, ComputedAt: time.Unix(1, 0)},
+		{CharacterId: 2, Name: "B", WorldId: 0, JobCategory: 1, Level: 20, JobId: 110, OverallRank: 2, JobRank: 2, Fame: 70, FameRank: 1, ComputedAt: time.Unix(1, 0)},
+	})
+	router := testRouter(t, db)
+
+	rr := doGet(t, router, tm, "/rankings/fame?filter[worldId]=0&page[number]=1&page[size]=10")
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200; body=%s", rr.Code, rr.Body.String())
+	}
+	var body struct {
+		Data []struct {
+			Attributes struct {
+				CharacterId uint32 `json:"characterId"`
+				Fame        int16  `json:"fame"`
+				FameRank    uint32 `json:"fameRank"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(body.Data) != 2 {
+		t.Fatalf("expected 2 entries, got %d: %s", len(body.Data), rr.Body.String())
+	}
+	if body.Data[0].Attributes.CharacterId != 2 || body.Data[0].Attributes.Fame != 70 || body.Data[0].Attributes.FameRank != 1 {
+		t.Fatalf("data[0] = %+v, want character 2 with fame 70 at rank 1", body.Data[0].Attributes)
+	}
+
+	if rr := doGet(t, router, tm, "/rankings/fame?page[number]=1"); rr.Code != http.StatusBadRequest {
+		t.Fatalf("missing worldId status = %d, want 400", rr.Code)
+	}
+}
+
 func TestLeaderboardRequiresWorldId(t *testing.T) {
 	db := testDatabase(t)
 	tm, _ := testTenantContext(t)
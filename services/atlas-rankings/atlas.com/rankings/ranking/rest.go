@@ -9,13 +9,15 @@ import (
 )
 
 type RestModel struct {
-	Id          uint32    `json:"-"`
-	WorldId     world.Id  `json:"worldId"`
-	Rank        uint32    `json:"rank"`
-	RankMove    int32     `json:"rankMove"`
-	JobRank     uint32    `json:"jobRank"`
-	JobRankMove int32     `json:"jobRankMove"`
-	ComputedAt  time.Time `json:"computedAt"`
+	Id           uint32    `json:"-"`
+	WorldId      world.Id  `json:"worldId"`
+	Rank         uint32    `json:"rank"`
+	RankMove     int32     `json:"rankMove"`
+	JobRank      uint32    `json:"jobRank"`
+	JobRankMove  int32     `json:"jobRankMove"`
+	FameRank     uint32    `json:"fameRank"`
+	FameRankMove int32     `json:"fameRankMove"`
+	ComputedAt   time.Time `json:"computedAt"`
 }
 
 func (r RestModel) GetName() string {
@@ -37,13 +39,15 @@ func (r *RestModel) SetID(strId string) error {
 
 func Transform(m Model) (RestModel, error) {
 	return RestModel{
-		Id:          m.CharacterId(),
-		WorldId:     m.WorldId(),
-		Rank:        m.OverallRank(),
-		RankMove:    m.OverallRankMove(),
-		JobRank:     m.JobRank(),
-		JobRankMove: m.JobRankMove(),
-		ComputedAt:  m.ComputedAt(),
+		Id:           m.CharacterId(),
+		WorldId:      m.WorldId(),
+		Rank:         m.OverallRank(),
+		RankMove:     m.OverallRankMove(),
+		JobRank:      m.JobRank(),
+		JobRankMove:  m.JobRankMove(),
+		FameRank:     m.FameRank(),
+		FameRankMove: m.FameRankMove(),
+		ComputedAt:   m.ComputedAt(),
 	}, nil
 }
 
@@ -65,18 +69,21 @@ func TransformSlice(ms []Model) ([]RestModel, error) {
 // It intentionally differs from the single-character RestModel (which the
 // login decoration depends on) by carrying the display fields.
 type LeaderboardRestModel struct {
-	Id          uint32    `json:"-"`
-	CharacterId uint32    `json:"characterId"`
-	Name        string    `json:"name"`
-	WorldId     world.Id  `json:"worldId"`
-	JobId       job.Id    `json:"jobId"`
-	JobCategory uint16    `json:"jobCategory"`
-	Level       byte      `json:"level"`
-	Rank        uint32    `json:"rank"`
-	RankMove    int32     `json:"rankMove"`
-	JobRank     uint32    `json:"jobRank"`
-	JobRankMove int32     `json:"jobRankMove"`
-	ComputedAt  time.Time `json:"computedAt"`
+	Id           uint32    `json:"-"`
+	CharacterId  uint32    `json:"characterId"`
+	Name         string    `json:"name"`
+	WorldId      world.Id  `json:"worldId"`
+	JobId        job.Id    `json:"jobId"`
+	JobCategory  uint16    `json:"jobCategory"`
+	Level        byte      `json:"level"`
+	Rank         uint32    `json:"rank"`
+	RankMove     int32     `json:"rankMove"`
+	JobRank      uint32    `json:"jobRank"`
+	JobRankMove  int32     `json:"jobRankMove"`
+	Fame         int16     `json:"fame"`
+	FameRank     uint32    `json:"fameRank"`
+	FameRankMove int32     `json:"fameRankMove"`
+	ComputedAt   time.Time `json:"computedAt"`
 }
 
 func (r LeaderboardRestModel) GetName() string { return "rankings" }
@@ -93,17 +100,20 @@ func (r *LeaderboardRestModel) SetID(strId string) error {
 
 func TransformLeaderboard(m Model) (LeaderboardRestModel, error) {
 	return LeaderboardRestModel{
-		Id:          m.CharacterId(),
-		CharacterId: m.CharacterId(),
-		Name:        m.Name(),
-		WorldId:     m.WorldId(),
-		JobId:       m.JobId(),
-		JobCategory: m.JobCategory(),
-		Level:       m.Level(),
-		Rank:        m.OverallRank(),
-		RankMove:    m.OverallRankMove(),
-		JobRank:     m.JobRank(),
-		JobRankMove: m.JobRankMove(),
-		ComputedAt:  m.ComputedAt(),
+		Id:           m.CharacterId(),
+		CharacterId:  m.CharacterId(),
+		Name:         m.Name(),
+		WorldId:      m.WorldId(),
+		JobId:        m.JobId(),
+		JobCategory:  m.JobCategory(),
+		Level:        m.Level(),
+		Rank:         m.OverallRank(),
+		RankMove:     m.OverallRankMove(),
+		JobRank:      m.JobRank(),
+		JobRankMove:  m.JobRankMove(),
+		Fame:         m.Fame(),
+		FameRank:     m.FameRank(),
+		FameRankMove: m.FameRankMove(),
+		ComputedAt:   m.ComputedAt(),
 	}, nil
 }
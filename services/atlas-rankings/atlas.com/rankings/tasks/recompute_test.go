@@ -69,6 +69,9 @@ func (f fakeProcessor) GetByCharacterIds([]uint32) ([]ranking.Model, error) { re
 func (f fakeProcessor) LeaderboardProvider(world.Id, *uint16, model.Page) model.Provider[model.Paged[ranking.Model]] {
 	return func() (model.Paged[ranking.Model], error) { return model.Paged[ranking.Model]{}, nil }
 }
+func (f fakeProcessor) FameLeaderboardProvider(world.Id, model.Page) model.Provider[model.Paged[ranking.Model]] {
+	return func() (model.Paged[ranking.Model], error) { return model.Paged[ranking.Model]{}, nil }
+}
 func (f fakeProcessor) IsDue(time.Duration, time.Time) (bool, error) { return f.due, f.dueErr }
 func (f fakeProcessor) Recompute(time.Time) error {
 	if f.recomputeErr != nil {
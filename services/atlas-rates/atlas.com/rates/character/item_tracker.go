@@ -24,6 +24,7 @@ type ItemType int
 const (
 	ItemTypeBonusExp ItemType = iota // Equipment with bonusExp tiers
 	ItemTypeCoupon                   // Cash coupons with rate/time
+	ItemTypeFamily                   // Family entitlement rate bonuses
 )
 
 // TrackedItem represents an item being tracked for time-based rate calculations
@@ -67,9 +68,9 @@ func (t TrackedItem) MarshalJSON() ([]byte, error) {
 	})
 }
 
-// IsExpired returns true if a coupon has expired
+// IsExpired returns true if a coupon or family bonus has expired
 func (t TrackedItem) IsExpired() bool {
-	if t.ItemType != ItemTypeCoupon || t.DurationMins <= 0 {
+	if (t.ItemType != ItemTypeCoupon && t.ItemType != ItemTypeFamily) || t.DurationMins <= 0 {
 		return false
 	}
 	expiresAt := t.AcquiredAt.Add(time.Duration(t.DurationMins) * time.Minute)
@@ -148,6 +149,8 @@ func (t TrackedItem) IsActiveAt(checkTime time.Time, isHoliday bool) bool {
 
 // ItemTracker tracks time-based rate items per character: one Redis HASH per
 // (tenant, character), hash field = templateId, value = TrackedItem JSON.
+// Family bonuses have no item; their TemplateId holds the entitlement type and
+// their hash field is namespaced so it cannot collide with an item.
 type ItemTracker struct {
 	items *atlas.TenantKeyedHash[uint32] // key = characterId
 }
@@ -172,6 +175,17 @@ func templateField(templateId uint32) string {
 	return strconv.FormatUint(uint64(templateId), 10)
 }
 
+func trackedField(item TrackedItem) string {
+	if item.ItemType == ItemTypeFamily {
+		return familyField(item.TemplateId, item.RateType)
+	}
+	return templateField(item.TemplateId)
+}
+
+func familyField(entitlement uint32, rateType rate.Type) string {
+	return fmt.Sprintf("family:%d:%s", entitlement, rateType)
+}
+
 // TrackItem starts tracking a time-based rate item
 func (t *ItemTracker) TrackItem(ctx context.Context, characterId uint32, item TrackedItem) {
 	ten := tenant.MustFromContext(ctx)
@@ -179,7 +193,7 @@ func (t *ItemTracker) TrackItem(ctx context.Context, characterId uint32, item Tr
 	if err != nil {
 		return
 	}
-	_ = t.items.Set(ctx, ten, characterId, templateField(item.TemplateId), string(data))
+	_ = t.items.Set(ctx, ten, characterId, trackedField(item), string(data))
 }
 
 // UntrackItem stops tracking an item
@@ -258,12 +272,21 @@ func (t *ItemTracker) ComputeItemRateFactors(l logrus.FieldLogger, ctx context.C
 		case ItemTypeCoupon:
 			multiplier = item.GetCouponMultiplier()
 
+		case ItemTypeFamily:
+			if item.IsExpired() {
+				continue
+			}
+			multiplier = item.BaseRate
+
 		default:
 			continue
 		}
 
 		if multiplier != 1.0 {
 			source := itemSource(item.TemplateId)
+			if item.ItemType == ItemTypeFamily {
+				source = familySource(item.TemplateId)
+			}
 			factors = append(factors, rate.NewFactor(source, item.RateType, multiplier))
 		}
 	}
@@ -271,14 +294,16 @@ func (t *ItemTracker) ComputeItemRateFactors(l logrus.FieldLogger, ctx context.C
 	return factors
 }
 
-// CleanupExpiredItems removes expired coupon items and returns removed templateIds
+// CleanupExpiredItems removes expired coupon items and family bonuses and
+// returns their templateIds
 func (t *ItemTracker) CleanupExpiredItems(ctx context.Context, characterId uint32) []uint32 {
+	ten := tenant.MustFromContext(ctx)
 	items := t.GetAllTrackedItems(ctx, characterId)
 	removed := make([]uint32, 0)
 
 	for _, item := range items {
 		if item.IsExpired() {
-			t.UntrackItem(ctx, characterId, item.TemplateId)
+			_ = t.items.Del(ctx, ten, characterId, trackedField(item))
 			removed = append(removed, item.TemplateId)
 		}
 	}
@@ -289,3 +314,7 @@ func (t *ItemTracker) CleanupExpiredItems(ctx context.Context, characterId uint3
 func itemSource(templateId uint32) string {
 	return fmt.Sprintf("item:%d", templateId)
 }
+
+func familySource(entitlement uint32) string {
+	return fmt.Sprintf("family:%d", entitlement)
+}
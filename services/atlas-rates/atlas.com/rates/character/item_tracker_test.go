@@ -295,6 +295,71 @@ func TestItemTrackerComputeItemRateFactors_Filters1x(t *testing.T) {
 	}
 }
 
+// A family bonus and a coupon of the same rate coexist, and Bonding's EXP and
+// drop bonuses are tracked separately under one entitlement.
+func TestItemTrackerComputeItemRateFactors_FamilyBonus(t *testing.T) {
+	setupTestRegistries(t)
+	ten := createTestTenantForTracker()
+	ctx := createTestCtx(ten)
+	l, _ := test.NewNullLogger()
+
+	GetItemTracker().TrackItem(ctx, 12345, TrackedItem{
+		TemplateId:   5210000,
+		ItemType:     ItemTypeCoupon,
+		AcquiredAt:   time.Now(),
+		RateType:     rate.TypeExp,
+		BaseRate:     2.0,
+		DurationMins: 60,
+	})
+	for _, rt := range []rate.Type{rate.TypeExp, rate.TypeItemDrop} {
+		GetItemTracker().TrackItem(ctx, 12345, TrackedItem{
+			TemplateId:   4,
+			ItemType:     ItemTypeFamily,
+			AcquiredAt:   time.Now(),
+			RateType:     rt,
+			BaseRate:     2.0,
+			DurationMins: 30,
+		})
+	}
+
+	factors := GetItemTracker().ComputeItemRateFactors(l, ctx, 12345)
+	if len(factors) != 3 {
+		t.Fatalf("ComputeItemRateFactors() returned %v factors, want 3", len(factors))
+	}
+	family := 0
+	for _, f := range factors {
+		if f.Source() == "family:4" {
+			family++
+		}
+	}
+	if family != 2 {
+		t.Errorf("family factors = %v, want 2", family)
+	}
+}
+
+func TestItemTrackerCleanupExpiredItems_FamilyBonus(t *testing.T) {
+	setupTestRegistries(t)
+	ten := createTestTenantForTracker()
+	ctx := createTestCtx(ten)
+
+	GetItemTracker().TrackItem(ctx, 12345, TrackedItem{
+		TemplateId:   3,
+		ItemType:     ItemTypeFamily,
+		AcquiredAt:   time.Now().Add(-time.Hour),
+		RateType:     rate.TypeExp,
+		BaseRate:     1.5,
+		DurationMins: 15,
+	})
+
+	removed := GetItemTracker().CleanupExpiredItems(ctx, 12345)
+	if len(removed) != 1 {
+		t.Fatalf("CleanupExpiredItems() removed %v items, want 1", len(removed))
+	}
+	if items := GetItemTracker().GetAllTrackedItems(ctx, 12345); len(items) != 0 {
+		t.Errorf("Remaining items = %v, want 0", len(items))
+	}
+}
+
 func TestItemTrackerCleanupExpiredItems(t *testing.T) {
 	setupTestRegistries(t)
 	ten := createTestTenantForTracker()
@@ -27,6 +27,7 @@ type ProcessorMock struct {
 	RemoveAllItemFactorsFunc        func(characterId uint32, templateId uint32) error
 	TrackBonusExpItemFunc           func(characterId uint32, templateId uint32, tiers []character.BonusExpTier, equippedSince *time.Time) error
 	TrackCouponItemFunc             func(characterId uint32, templateId uint32, rateType rate.Type, rateMultiplier float64, durationMins int32, createdAt time.Time, timeWindows []cash.TimeWindow) error
+	TrackFamilyBonusFunc            func(characterId uint32, entitlement byte, rateType rate.Type, rateMultiplier float64, duration time.Duration, startedAt time.Time) error
 	UntrackItemFunc                 func(characterId uint32, templateId uint32) error
 	UpdateBonusExpEquippedSinceFunc func(characterId uint32, templateId uint32, equippedSince *time.Time) error
 	GetItemRateFactorsFunc          func(characterId uint32) []rate.Factor
@@ -122,6 +123,13 @@ func (m *ProcessorMock) TrackCouponItem(characterId uint32, templateId uint32, r
 	return nil
 }
 
+func (m *ProcessorMock) TrackFamilyBonus(characterId uint32, entitlement byte, rateType rate.Type, rateMultiplier float64, duration time.Duration, startedAt time.Time) error {
+	if m.TrackFamilyBonusFunc != nil {
+		return m.TrackFamilyBonusFunc(characterId, entitlement, rateType, rateMultiplier, duration, startedAt)
+	}
+	return nil
+}
+
 func (m *ProcessorMock) UntrackItem(characterId uint32, templateId uint32) error {
 	if m.UntrackItemFunc != nil {
 		return m.UntrackItemFunc(characterId, templateId)
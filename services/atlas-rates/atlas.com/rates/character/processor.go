@@ -33,6 +33,7 @@ type Processor interface {
 	// Time-based item tracking methods
 	TrackBonusExpItem(characterId uint32, templateId uint32, tiers []BonusExpTier, equippedSince *time.Time) error
 	TrackCouponItem(characterId uint32, templateId uint32, rateType rate.Type, rateMultiplier float64, durationMins int32, createdAt time.Time, timeWindows []cash.TimeWindow) error
+	TrackFamilyBonus(characterId uint32, entitlement byte, rateType rate.Type, rateMultiplier float64, duration time.Duration, startedAt time.Time) error
 	UntrackItem(characterId uint32, templateId uint32) error
 	UpdateBonusExpEquippedSince(characterId uint32, templateId uint32, equippedSince *time.Time) error
 	GetItemRateFactors(characterId uint32) []rate.Factor
@@ -197,6 +198,24 @@ func (p *ProcessorImpl) TrackCouponItem(characterId uint32, templateId uint32, r
 	return nil
 }
 
+// TrackFamilyBonus starts tracking a family entitlement rate bonus. Using the
+// same entitlement again replaces the bonus, restarting its duration.
+func (p *ProcessorImpl) TrackFamilyBonus(characterId uint32, entitlement byte, rateType rate.Type, rateMultiplier float64, duration time.Duration, startedAt time.Time) error {
+	item := TrackedItem{
+		TemplateId:   uint32(entitlement),
+		ItemType:     ItemTypeFamily,
+		AcquiredAt:   startedAt,
+		RateType:     rateType,
+		BaseRate:     rateMultiplier,
+		DurationMins: int32(duration / time.Minute),
+	}
+
+	GetItemTracker().TrackItem(p.ctx, characterId, item)
+	p.l.Debugf("Started tracking family entitlement [%d] for character [%d]: rate type [%s], multiplier [%.2f], duration [%s].",
+		entitlement, characterId, rateType, rateMultiplier, duration)
+	return nil
+}
+
 // UntrackItem stops tracking a time-based rate item
 func (p *ProcessorImpl) UntrackItem(characterId uint32, templateId uint32) error {
 	GetItemTracker().UntrackItem(p.ctx, characterId, templateId)
@@ -0,0 +1,72 @@
+package family
+
+import (
+	"atlas-rates/character"
+	consumer2 "atlas-rates/kafka/consumer"
+	"atlas-rates/kafka/message/family"
+	"atlas-rates/rate"
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("family_reputation")(family.EnvEventTopicRep)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		var t string
+		t, _ = topic.EnvProvider(l)(family.EnvEventTopicRep)()
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleEntitlementUsed))); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func handleEntitlementUsed(l logrus.FieldLogger, ctx context.Context, e family.Event[family.EntitlementUsedEventBody]) {
+	handleEntitlementUsedFor(character.NewProcessor(l, ctx), l, e)
+}
+
+// handleEntitlementUsedFor applies a family entitlement's rate bonus to each
+// beneficiary. Entitlements that move characters are ignored here.
+func handleEntitlementUsedFor(p character.Processor, l logrus.FieldLogger, e family.Event[family.EntitlementUsedEventBody]) {
+	if e.Type != family.EventTypeEntitlementUsed {
+		return
+	}
+
+	ent, ok := familyconst.GetEntitlement(familyconst.EntitlementType(e.Body.Entitlement))
+	if !ok || !ent.IsBuff() {
+		return
+	}
+
+	beneficiaries := e.Body.BeneficiaryIds
+	if len(beneficiaries) == 0 {
+		beneficiaries = []uint32{e.CharacterId}
+	}
+
+	for _, characterId := range beneficiaries {
+		if ent.ExpRate > 1.0 {
+			if err := p.TrackFamilyBonus(characterId, e.Body.Entitlement, rate.TypeExp, ent.ExpRate, ent.Duration, e.Body.Timestamp); err != nil {
+				l.WithError(err).Errorf("Unable to apply family EXP bonus to character [%d].", characterId)
+			}
+		}
+		if ent.DropRate > 1.0 {
+			if err := p.TrackFamilyBonus(characterId, e.Body.Entitlement, rate.TypeItemDrop, ent.DropRate, ent.Duration, e.Body.Timestamp); err != nil {
+				l.WithError(err).Errorf("Unable to apply family drop bonus to character [%d].", characterId)
+			}
+		}
+	}
+}
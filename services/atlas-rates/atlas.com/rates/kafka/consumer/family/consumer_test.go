@@ -0,0 +1,84 @@
+package family
+
+import (
+	"atlas-rates/kafka/message/family"
+	"atlas-rates/rate"
+	"io"
+	"testing"
+	"time"
+
+	charmock "atlas-rates/character/mock"
+
+	"github.com/sirupsen/logrus"
+
+	familyconst "github.com/Chronicle20/atlas/libs/atlas-constants/family"
+)
+
+func discardLogger() logrus.FieldLogger {
+	l := logrus.New()
+	l.Out = io.Discard
+	return l
+}
+
+type tracked struct {
+	characterId uint32
+	rateType    rate.Type
+	multiplier  float64
+	duration    time.Duration
+}
+
+func usedEvent(entitlement familyconst.EntitlementType, beneficiaryIds []uint32) family.Event[family.EntitlementUsedEventBody] {
+	return family.Event[family.EntitlementUsedEventBody]{
+		CharacterId: 1000,
+		Type:        family.EventTypeEntitlementUsed,
+		Body: family.EntitlementUsedEventBody{
+			Entitlement:    byte(entitlement),
+			BeneficiaryIds: beneficiaryIds,
+			Timestamp:      time.Now(),
+		},
+	}
+}
+
+func recordingMock(calls *[]tracked) *charmock.ProcessorMock {
+	return &charmock.ProcessorMock{
+		TrackFamilyBonusFunc: func(characterId uint32, _ byte, rateType rate.Type, rateMultiplier float64, duration time.Duration, _ time.Time) error {
+			*calls = append(*calls, tracked{characterId, rateType, rateMultiplier, duration})
+			return nil
+		},
+	}
+}
+
+func TestHandleEntitlementUsed_BondingBuffsEveryBeneficiary(t *testing.T) {
+	var calls []tracked
+	handleEntitlementUsedFor(recordingMock(&calls), discardLogger(), usedEvent(familyconst.EntitlementBonding, []uint32{1000, 1001}))
+
+	if len(calls) != 4 {
+		t.Fatalf("TrackFamilyBonus calls = %d, want 4", len(calls))
+	}
+	for _, c := range calls {
+		if c.multiplier != 2.0 || c.duration != 30*time.Minute {
+			t.Errorf("call %+v, want 2.0x for 30m", c)
+		}
+	}
+}
+
+func TestHandleEntitlementUsed_SelfBuffDefaultsToUser(t *testing.T) {
+	var calls []tracked
+	handleEntitlementUsedFor(recordingMock(&calls), discardLogger(), usedEvent(familyconst.EntitlementSelfExp15, nil))
+
+	if len(calls) != 1 {
+		t.Fatalf("TrackFamilyBonus calls = %d, want 1", len(calls))
+	}
+	if calls[0].characterId != 1000 || calls[0].rateType != rate.TypeExp || calls[0].multiplier != 1.5 {
+		t.Errorf("call %+v, want character 1000 exp 1.5x", calls[0])
+	}
+}
+
+func TestHandleEntitlementUsed_IgnoresMovementEntitlements(t *testing.T) {
+	var calls []tracked
+	handleEntitlementUsedFor(recordingMock(&calls), discardLogger(), usedEvent(familyconst.EntitlementTeleport, []uint32{1001}))
+
+	if len(calls) != 0 {
+		t.Fatalf("TrackFamilyBonus calls = %d, want 0", len(calls))
+	}
+}
@@ -0,0 +1,28 @@
+package family
+
+import (
+	"time"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvEventTopicRep         = "EVENT_TOPIC_FAMILY_REPUTATION"
+	EventTypeEntitlementUsed = "ENTITLEMENT_USED"
+)
+
+type Event[E any] struct {
+	WorldId     world.Id `json:"worldId"`
+	CharacterId uint32   `json:"characterId"`
+	Type        string   `json:"type"`
+	Body        E        `json:"body"`
+}
+
+type EntitlementUsedEventBody struct {
+	Entitlement    byte      `json:"entitlement"`
+	RepCost        uint32    `json:"repCost"`
+	Rep            uint32    `json:"rep"`
+	TargetId       uint32    `json:"targetId,omitempty"`
+	BeneficiaryIds []uint32  `json:"beneficiaryIds,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
@@ -5,6 +5,7 @@ import (
 	asset2 "atlas-rates/kafka/consumer/asset"
 	buff2 "atlas-rates/kafka/consumer/buff"
 	character2 "atlas-rates/kafka/consumer/character"
+	family2 "atlas-rates/kafka/consumer/family"
 	rate2 "atlas-rates/kafka/consumer/rate"
 	"os"
 
@@ -76,6 +77,12 @@ func main() {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
 
+	// Family reputation events consumer (for family entitlement rate bonuses)
+	family2.InitConsumers(l)(cmf)(consumerGroupId)
+	if err := family2.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
+
 	// Start REST server
 	server.New(l).
 		WithContext(rt.Context()).
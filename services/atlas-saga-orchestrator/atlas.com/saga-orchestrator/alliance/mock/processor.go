@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// ProcessorMock is a mock implementation of the alliance.Processor interface.
+type ProcessorMock struct {
+	CreateFunc                  func(transactionId uuid.UUID, worldId world.Id, characterId uint32, name string) error
+	RequestCapacityIncreaseFunc func(transactionId uuid.UUID, worldId world.Id, characterId uint32) error
+}
+
+func (m *ProcessorMock) Create(transactionId uuid.UUID, worldId world.Id, characterId uint32, name string) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(transactionId, worldId, characterId, name)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) RequestCapacityIncrease(transactionId uuid.UUID, worldId world.Id, characterId uint32) error {
+	if m.RequestCapacityIncreaseFunc != nil {
+		return m.RequestCapacityIncreaseFunc(transactionId, worldId, characterId)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package alliance
+
+import (
+	"atlas-saga-orchestrator/kafka/message/alliance"
+	"context"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+type Processor interface {
+	Create(transactionId uuid.UUID, worldId world.Id, characterId uint32, name string) error
+	RequestCapacityIncrease(transactionId uuid.UUID, worldId world.Id, characterId uint32) error
+}
+
+type ProcessorImpl struct {
+	l   logrus.FieldLogger
+	ctx context.Context
+}
+
+func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
+	return &ProcessorImpl{
+		l:   l,
+		ctx: ctx,
+	}
+}
+
+var _ Processor = (*ProcessorImpl)(nil)
+
+func (p *ProcessorImpl) Create(transactionId uuid.UUID, worldId world.Id, characterId uint32, name string) error {
+	p.l.Debugf("Character [%d] attempting to create alliance [%s].", characterId, name)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance.EnvCommandTopic)(CreateProvider(transactionId, worldId, characterId, name))
+}
+
+func (p *ProcessorImpl) RequestCapacityIncrease(transactionId uuid.UUID, worldId world.Id, characterId uint32) error {
+	p.l.Debugf("Character [%d] attempting to increase alliance capacity.", characterId)
+	return producer.ProviderImpl(p.l)(p.ctx)(alliance.EnvCommandTopic)(RequestCapacityIncreaseProvider(transactionId, worldId, characterId))
+}
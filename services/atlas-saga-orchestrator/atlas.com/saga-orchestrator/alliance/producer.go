@@ -0,0 +1,38 @@
+package alliance
+
+import (
+	"atlas-saga-orchestrator/kafka/message/alliance"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func CreateProvider(transactionId uuid.UUID, worldId world.Id, characterId uint32, name string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &alliance.Command[alliance.CreateBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          alliance.CommandTypeCreate,
+		Body: alliance.CreateBody{
+			Name: name,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+func RequestCapacityIncreaseProvider(transactionId uuid.UUID, worldId world.Id, characterId uint32) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(characterId))
+	value := &alliance.Command[alliance.RequestCapacityIncreaseBody]{
+		TransactionId: transactionId,
+		WorldId:       worldId,
+		CharacterId:   characterId,
+		Type:          alliance.CommandTypeRequestCapacityIncrease,
+		Body:          alliance.RequestCapacityIncreaseBody{},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
@@ -0,0 +1,77 @@
+package alliance
+
+import (
+	consumer2 "atlas-saga-orchestrator/kafka/consumer"
+	alliance2 "atlas-saga-orchestrator/kafka/message/alliance"
+	"atlas-saga-orchestrator/saga"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("alliance_status_event")(alliance2.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		var t string
+		t, _ = topic.EnvProvider(l)(alliance2.EnvStatusEventTopic)()
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAllianceCreatedEvent))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAllianceCapacityUpdatedEvent))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAllianceErrorEvent))); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func handleAllianceCreatedEvent(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventCreatedBody]) {
+	if e.Type != alliance2.StatusEventTypeCreated {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindAllianceCreated); !ok {
+		return
+	}
+	_ = p.StepCompleted(e.TransactionId, true)
+}
+
+func handleAllianceCapacityUpdatedEvent(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventCapacityUpdatedBody]) {
+	if e.Type != alliance2.StatusEventTypeCapacityUpdated {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindAllianceCapacityUpdated); !ok {
+		return
+	}
+	_ = p.StepCompleted(e.TransactionId, true)
+}
+
+// handleAllianceErrorEvent fails the step when atlas-guilds rejects the
+// request (name taken, not enough mesos, not the leader, ...).
+func handleAllianceErrorEvent(l logrus.FieldLogger, ctx context.Context, e alliance2.StatusEvent[alliance2.StatusEventErrorBody]) {
+	if e.Type != alliance2.StatusEventTypeError {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindAllianceError); !ok {
+		return
+	}
+	_ = p.StepCompleted(e.TransactionId, false)
+}
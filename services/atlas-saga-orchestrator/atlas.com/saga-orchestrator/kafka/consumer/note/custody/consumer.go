@@ -0,0 +1,102 @@
+package custody
+
+import (
+	consumer2 "atlas-saga-orchestrator/kafka/consumer"
+	noteCustody "atlas-saga-orchestrator/kafka/message/note/custody"
+	"atlas-saga-orchestrator/saga"
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/consumer"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/handler"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/message"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/topic"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+// InitConsumers registers the EVENT_TOPIC_NOTE_CUSTODY_STATUS consumer. It
+// mirrors the trade custody status consumer: atlas-notes acks each attachment
+// custody command with the saga transactionId, and the orchestrator feeds
+// ACCEPTED / RELEASED / ERROR into the step-completion path.
+//
+// RESTORED and REMOVED are deliberately not routed into StepCompleted: both ack
+// reverse-walk inverses dispatched while their saga is already terminating, so
+// there is no pending step for them to complete.
+func InitConsumers(l logrus.FieldLogger) func(func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+	return func(rf func(config consumer.Config, decorators ...model.Decorator[consumer.Config])) func(consumerGroupId string) {
+		return func(consumerGroupId string) {
+			rf(consumer2.NewConfig(l)("note_custody_status_event")(noteCustody.EnvStatusEventTopic)(consumerGroupId), consumer.SetHeaderParsers(consumer.SpanHeaderParser, consumer.TenantHeaderParser, consumer.EnvHeaderParser), consumer.SetStartOffset(kafka.LastOffset))
+		}
+	}
+}
+
+func InitHandlers(l logrus.FieldLogger) func(rf func(topic string, handler handler.Handler) (string, error)) error {
+	return func(rf func(topic string, handler handler.Handler) (string, error)) error {
+		var t string
+		t, _ = topic.EnvProvider(l)(noteCustody.EnvStatusEventTopic)()
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleAcceptedEvent))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleReleasedEvent))); err != nil {
+			return err
+		}
+		if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleErrorEvent))); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func handleAcceptedEvent(l logrus.FieldLogger, ctx context.Context, e noteCustody.StatusEvent[noteCustody.StatusEventAcceptedBody]) {
+	if e.Type != noteCustody.StatusEventTypeAccepted {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindNoteCustodyAccepted); !ok {
+		return
+	}
+
+	l.WithFields(logrus.Fields{
+		"transaction_id": e.TransactionId.String(),
+		"attachment_id":  e.Body.AttachmentId.String(),
+	}).Debug("Note attachment escrowed successfully")
+
+	_ = p.StepCompleted(e.TransactionId, true)
+}
+
+func handleReleasedEvent(l logrus.FieldLogger, ctx context.Context, e noteCustody.StatusEvent[noteCustody.StatusEventReleasedBody]) {
+	if e.Type != noteCustody.StatusEventTypeReleased {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindNoteCustodyReleased); !ok {
+		return
+	}
+
+	l.WithFields(logrus.Fields{
+		"transaction_id": e.TransactionId.String(),
+		"attachment_id":  e.Body.AttachmentId.String(),
+	}).Debug("Note attachment released successfully")
+
+	_ = p.StepCompleted(e.TransactionId, true)
+}
+
+func handleErrorEvent(l logrus.FieldLogger, ctx context.Context, e noteCustody.StatusEvent[noteCustody.StatusEventErrorBody]) {
+	if e.Type != noteCustody.StatusEventTypeError {
+		return
+	}
+	p := saga.NewProcessor(l, ctx)
+	if _, ok := p.AcceptEvent(e.TransactionId, saga.EventKindNoteCustodyError); !ok {
+		return
+	}
+
+	l.WithFields(logrus.Fields{
+		"transaction_id": e.TransactionId.String(),
+		"attachment_id":  e.Body.AttachmentId.String(),
+		"error":          e.Body.Error,
+	}).Error("Note attachment custody operation failed")
+
+	_ = p.StepCompleted(e.TransactionId, false)
+}
@@ -0,0 +1,61 @@
+package alliance
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	EnvCommandTopic                    = "COMMAND_TOPIC_GUILD_ALLIANCE"
+	CommandTypeCreate                  = "CREATE"
+	CommandTypeRequestCapacityIncrease = "REQUEST_CAPACITY_INCREASE"
+)
+
+// Command mirrors atlas-guilds' alliance command. AllianceId is left zero for
+// CREATE, and for REQUEST_CAPACITY_INCREASE, where atlas-guilds resolves it
+// from the actor's guild.
+type Command[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	CharacterId   uint32    `json:"characterId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type CreateBody struct {
+	Name string `json:"name"`
+}
+
+type RequestCapacityIncreaseBody struct{}
+
+const (
+	EnvStatusEventTopic            = "EVENT_TOPIC_GUILD_ALLIANCE_STATUS"
+	StatusEventTypeCreated         = "CREATED"
+	StatusEventTypeCapacityUpdated = "CAPACITY_UPDATED"
+	StatusEventTypeError           = "ERROR"
+)
+
+type StatusEvent[E any] struct {
+	TransactionId uuid.UUID `json:"transactionId"`
+	WorldId       world.Id  `json:"worldId"`
+	AllianceId    uint32    `json:"allianceId"`
+	Type          string    `json:"type"`
+	Body          E         `json:"body"`
+}
+
+type StatusEventCreatedBody struct {
+	Name     string `json:"name"`
+	GuildId  uint32 `json:"guildId"`
+	LeaderId uint32 `json:"leaderId"`
+}
+
+type StatusEventCapacityUpdatedBody struct {
+	Capacity uint32 `json:"capacity"`
+}
+
+type StatusEventErrorBody struct {
+	ActorId uint32 `json:"actorId"`
+	Error   string `json:"error"`
+}
@@ -115,5 +115,6 @@ type ShowStorageCommand struct {
 	CharacterId   uint32     `json:"characterId"`
 	NpcId         uint32     `json:"npcId"`
 	AccountId     uint32     `json:"accountId"`
+	Tab           byte       `json:"tab,omitempty"`
 	Type          string     `json:"type"`
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"atlas-saga-orchestrator/kafka/consumer/alliance"
 	"atlas-saga-orchestrator/kafka/consumer/asset"
 	"atlas-saga-orchestrator/kafka/consumer/buddylist"
 	"atlas-saga-orchestrator/kafka/consumer/cashshop"
@@ -29,6 +30,7 @@ import (
 	inventoryConsumer "atlas-saga-orchestrator/kafka/consumer/inventory"
 	mtsCustody "atlas-saga-orchestrator/kafka/consumer/mts/custody"
 	noteConsumer "atlas-saga-orchestrator/kafka/consumer/note"
+	noteCustody "atlas-saga-orchestrator/kafka/consumer/note/custody"
 	npcconversationconsumer "atlas-saga-orchestrator/kafka/consumer/npcconversation"
 	npcshopconsumer "atlas-saga-orchestrator/kafka/consumer/npcshop"
 	tradeCustody "atlas-saga-orchestrator/kafka/consumer/trade/custody"
@@ -125,10 +127,12 @@ func main() {
 	cashshopCompartment.InitConsumers(l)(cmf)(consumerGroupId)
 	mtsCustody.InitConsumers(l)(cmf)(consumerGroupId)
 	tradeCustody.InitConsumers(l)(cmf)(consumerGroupId)
+	noteCustody.InitConsumers(l)(cmf)(consumerGroupId)
 	character.InitConsumers(l)(cmf)(consumerGroupId)
 	compartment.InitConsumers(l)(cmf)(consumerGroupId)
 	consumable.InitConsumers(l)(cmf)(consumerGroupId)
 	guild.InitConsumers(l)(cmf)(consumerGroupId)
+	alliance.InitConsumers(l)(cmf)(consumerGroupId)
 	inventoryConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	noteConsumer.InitConsumers(l)(cmf)(consumerGroupId)
 	party.InitConsumers(l)(cmf)(consumerGroupId)
@@ -149,6 +153,9 @@ func main() {
 	if err := cashshop.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := noteCustody.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatalf("Unable to register note custody status handlers.")
+	}
 	if err := tradeCustody.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatalf("Unable to register trade custody status handlers.")
 	}
@@ -170,6 +177,9 @@ func main() {
 	if err := guild.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
+	if err := alliance.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
+		l.WithError(err).Fatal("Unable to register kafka handlers.")
+	}
 	if err := inventoryConsumer.InitHandlers(l)(consumer.GetManager().RegisterHandler); err != nil {
 		l.WithError(err).Fatal("Unable to register kafka handlers.")
 	}
@@ -1,12 +1,18 @@
 package mock
 
 import (
+	"atlas-saga-orchestrator/note"
+
 	"github.com/google/uuid"
 )
 
 // ProcessorMock is a mock implementation of the note.Processor interface
 type ProcessorMock struct {
-	CreateNoteFunc func(transactionId uuid.UUID, receiverId uint32, senderId uint32, message string, flag byte) error
+	CreateNoteFunc                   func(transactionId uuid.UUID, receiverId uint32, senderId uint32, message string, flag byte) error
+	AcceptToNoteAndEmitFunc          func(transactionId uuid.UUID, params note.AcceptToNoteParams) error
+	ReleaseFromNoteAndEmitFunc       func(transactionId uuid.UUID, attachmentId uuid.UUID) error
+	RestoreNoteAttachmentAndEmitFunc func(transactionId uuid.UUID, attachmentId uuid.UUID) error
+	RemoveNoteAttachmentAndEmitFunc  func(transactionId uuid.UUID, attachmentId uuid.UUID) error
 }
 
 // CreateNote is a mock implementation of the note.Processor.CreateNote method
@@ -16,3 +22,31 @@ func (m *ProcessorMock) CreateNote(transactionId uuid.UUID, receiverId uint32, s
 	}
 	return nil
 }
+
+func (m *ProcessorMock) AcceptToNoteAndEmit(transactionId uuid.UUID, params note.AcceptToNoteParams) error {
+	if m.AcceptToNoteAndEmitFunc != nil {
+		return m.AcceptToNoteAndEmitFunc(transactionId, params)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) ReleaseFromNoteAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	if m.ReleaseFromNoteAndEmitFunc != nil {
+		return m.ReleaseFromNoteAndEmitFunc(transactionId, attachmentId)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) RestoreNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	if m.RestoreNoteAttachmentAndEmitFunc != nil {
+		return m.RestoreNoteAttachmentAndEmitFunc(transactionId, attachmentId)
+	}
+	return nil
+}
+
+func (m *ProcessorMock) RemoveNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	if m.RemoveNoteAttachmentAndEmitFunc != nil {
+		return m.RemoveNoteAttachmentAndEmitFunc(transactionId, attachmentId)
+	}
+	return nil
+}
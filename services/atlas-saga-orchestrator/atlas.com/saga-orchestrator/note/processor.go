@@ -1,24 +1,51 @@
 package note
 
 import (
+	"atlas-saga-orchestrator/kafka/message"
 	note2 "atlas-saga-orchestrator/kafka/message/note"
+	noteCustody "atlas-saga-orchestrator/kafka/message/note/custody"
 	"context"
 
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
+// AcceptToNoteParams carries the attachment escrow row dispatched to atlas-notes'
+// custody consumer. It mirrors AcceptToNoteCommandBody rather than reusing it so
+// the saga layer never depends on the wire struct directly — the same
+// separation trade.AcceptToTradeParams keeps.
+type AcceptToNoteParams struct {
+	AttachmentId        uuid.UUID
+	SenderId            uint32
+	RecipientId         uint32
+	WorldId             world.Id
+	SourceInventoryType byte
+	AssetId             uint32
+	Mesos               uint32
+
+	Snapshot sharedsaga.AssetSnapshot
+}
+
 // Processor is the interface for note operations
 type Processor interface {
 	CreateNote(transactionId uuid.UUID, receiverId uint32, senderId uint32, message string, flag byte) error
+
+	// The attachment custody commands, dispatched on COMMAND_TOPIC_NOTE_CUSTODY.
+	AcceptToNoteAndEmit(transactionId uuid.UUID, params AcceptToNoteParams) error
+	ReleaseFromNoteAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error
+	RestoreNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error
+	RemoveNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error
 }
 
 // ProcessorImpl is the implementation of the Processor interface
 type ProcessorImpl struct {
 	l   logrus.FieldLogger
 	ctx context.Context
+	p   producer.Provider
 }
 
 // NewProcessor creates a new note processor
@@ -26,6 +53,7 @@ func NewProcessor(l logrus.FieldLogger, ctx context.Context) Processor {
 	return &ProcessorImpl{
 		l:   l,
 		ctx: ctx,
+		p:   producer.ProviderImpl(l)(ctx),
 	}
 }
 
@@ -35,5 +63,31 @@ var _ Processor = (*ProcessorImpl)(nil)
 // The step completes when atlas-notes' CREATED/CREATE_FAILED status event
 // arrives (kafka/consumer/note/consumer.go).
 func (p *ProcessorImpl) CreateNote(transactionId uuid.UUID, receiverId uint32, senderId uint32, message string, flag byte) error {
-	return producer.ProviderImpl(p.l)(p.ctx)(note2.EnvCommandTopic)(CreateNoteCommandProvider(transactionId, receiverId, senderId, message, flag))
+	return p.p(note2.EnvCommandTopic)(CreateNoteCommandProvider(transactionId, receiverId, senderId, message, flag))
+}
+
+// AcceptToNoteAndEmit dispatches ACCEPT_TO_NOTE. The step completes on the
+// custody consumer's ACCEPTED/ERROR ack (kafka/consumer/note/custody).
+func (p *ProcessorImpl) AcceptToNoteAndEmit(transactionId uuid.UUID, params AcceptToNoteParams) error {
+	return message.Emit(p.p)(func(mb *message.Buffer) error {
+		return mb.Put(noteCustody.EnvCommandTopic, AcceptToNoteProvider(transactionId, params))
+	})
+}
+
+func (p *ProcessorImpl) ReleaseFromNoteAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	return message.Emit(p.p)(func(mb *message.Buffer) error {
+		return mb.Put(noteCustody.EnvCommandTopic, ReleaseFromNoteProvider(transactionId, attachmentId))
+	})
+}
+
+func (p *ProcessorImpl) RestoreNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	return message.Emit(p.p)(func(mb *message.Buffer) error {
+		return mb.Put(noteCustody.EnvCommandTopic, RestoreNoteAttachmentProvider(transactionId, attachmentId))
+	})
+}
+
+func (p *ProcessorImpl) RemoveNoteAttachmentAndEmit(transactionId uuid.UUID, attachmentId uuid.UUID) error {
+	return message.Emit(p.p)(func(mb *message.Buffer) error {
+		return mb.Put(noteCustody.EnvCommandTopic, RemoveNoteAttachmentProvider(transactionId, attachmentId))
+	})
 }
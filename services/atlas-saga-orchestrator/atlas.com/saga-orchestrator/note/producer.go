@@ -2,6 +2,7 @@ package note
 
 import (
 	note2 "atlas-saga-orchestrator/kafka/message/note"
+	noteCustody "atlas-saga-orchestrator/kafka/message/note/custody"
 
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
@@ -27,3 +28,62 @@ func CreateNoteCommandProvider(transactionId uuid.UUID, receiverId uint32, sende
 	}
 	return producer.SingleMessageProvider(key, value)
 }
+
+// attachmentKey derives the partition key from the attachment row id, so every
+// command touching one row — accept, release and their inverses — lands on the
+// same partition and cannot be reordered relative to the others.
+func attachmentKey(attachmentId uuid.UUID) []byte {
+	return producer.CreateKey(int(attachmentId.ID()))
+}
+
+// AcceptToNoteProvider creates an ACCEPT_TO_NOTE command for the atlas-notes
+// custody consumer.
+func AcceptToNoteProvider(transactionId uuid.UUID, params AcceptToNoteParams) model.Provider[[]kafka.Message] {
+	value := &noteCustody.Command[noteCustody.AcceptToNoteCommandBody]{
+		TransactionId: transactionId,
+		Type:          noteCustody.CommandAcceptToNote,
+		Body: noteCustody.AcceptToNoteCommandBody{
+			AttachmentId:        params.AttachmentId,
+			SenderId:            params.SenderId,
+			RecipientId:         params.RecipientId,
+			WorldId:             params.WorldId,
+			SourceInventoryType: params.SourceInventoryType,
+			AssetId:             params.AssetId,
+			Mesos:               params.Mesos,
+			Snapshot:            params.Snapshot,
+		},
+	}
+	return producer.SingleMessageProvider(attachmentKey(params.AttachmentId), value)
+}
+
+// ReleaseFromNoteProvider creates a RELEASE_FROM_NOTE command.
+func ReleaseFromNoteProvider(transactionId uuid.UUID, attachmentId uuid.UUID) model.Provider[[]kafka.Message] {
+	value := &noteCustody.Command[noteCustody.ReleaseFromNoteCommandBody]{
+		TransactionId: transactionId,
+		Type:          noteCustody.CommandReleaseFromNote,
+		Body:          noteCustody.ReleaseFromNoteCommandBody{AttachmentId: attachmentId},
+	}
+	return producer.SingleMessageProvider(attachmentKey(attachmentId), value)
+}
+
+// RestoreNoteAttachmentProvider creates a RESTORE_NOTE_ATTACHMENT command — the
+// compensating inverse of a release.
+func RestoreNoteAttachmentProvider(transactionId uuid.UUID, attachmentId uuid.UUID) model.Provider[[]kafka.Message] {
+	value := &noteCustody.Command[noteCustody.RestoreNoteAttachmentCommandBody]{
+		TransactionId: transactionId,
+		Type:          noteCustody.CommandRestoreNoteAttachment,
+		Body:          noteCustody.RestoreNoteAttachmentCommandBody{AttachmentId: attachmentId},
+	}
+	return producer.SingleMessageProvider(attachmentKey(attachmentId), value)
+}
+
+// RemoveNoteAttachmentProvider creates a REMOVE_NOTE_ATTACHMENT command — the
+// compensating inverse of an accept.
+func RemoveNoteAttachmentProvider(transactionId uuid.UUID, attachmentId uuid.UUID) model.Provider[[]kafka.Message] {
+	value := &noteCustody.Command[noteCustody.RemoveNoteAttachmentCommandBody]{
+		TransactionId: transactionId,
+		Type:          noteCustody.CommandRemoveNoteAttachment,
+		Body:          noteCustody.RemoveNoteAttachmentCommandBody{AttachmentId: attachmentId},
+	}
+	return producer.SingleMessageProvider(attachmentKey(attachmentId), value)
+}
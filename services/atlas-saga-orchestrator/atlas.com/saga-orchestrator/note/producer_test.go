@@ -5,8 +5,11 @@ import (
 	"testing"
 
 	note2 "atlas-saga-orchestrator/kafka/message/note"
+	noteCustody "atlas-saga-orchestrator/kafka/message/note/custody"
 
 	"github.com/google/uuid"
+
+	sharedsaga "github.com/Chronicle20/atlas/libs/atlas-saga"
 )
 
 func TestCreateNoteCommandProvider(t *testing.T) {
@@ -35,3 +38,35 @@ func TestCreateNoteCommandProvider(t *testing.T) {
 		t.Errorf("body mismatch: %+v", c.Body)
 	}
 }
+
+func TestAcceptToNoteProviderKeysByAttachment(t *testing.T) {
+	txn := uuid.New()
+	attachmentId := uuid.New()
+	msgs, err := AcceptToNoteProvider(txn, AcceptToNoteParams{
+		AttachmentId: attachmentId,
+		SenderId:     100,
+		RecipientId:  200,
+		Snapshot:     sharedsaga.AssetSnapshot{TemplateId: 1302000, Quantity: 1},
+	})()
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	var c noteCustody.Command[noteCustody.AcceptToNoteCommandBody]
+	if err := json.Unmarshal(msgs[0].Value, &c); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if c.Type != noteCustody.CommandAcceptToNote || c.TransactionId != txn {
+		t.Errorf("envelope mismatch: %+v", c)
+	}
+	if c.Body.AttachmentId != attachmentId || c.Body.Snapshot.TemplateId != 1302000 {
+		t.Errorf("body mismatch: %+v", c.Body)
+	}
+
+	rel, err := ReleaseFromNoteProvider(uuid.New(), attachmentId)()
+	if err != nil {
+		t.Fatalf("provider: %v", err)
+	}
+	if string(rel[0].Key) != string(msgs[0].Key) {
+		t.Errorf("accept and release for one attachment must share a partition key")
+	}
+}
@@ -0,0 +1,55 @@
+package saga
+
+import (
+	alliancemock "atlas-saga-orchestrator/alliance/mock"
+	"testing"
+
+	"github.com/google/uuid"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+// The alliance NPC's steps must reach atlas-guilds with the saga's transaction
+// id, otherwise the CREATED / CAPACITY_UPDATED / ERROR reply never advances the
+// step.
+func TestAllianceActionsIssueGuildsCommands(t *testing.T) {
+	logger, _ := logtest.NewNullLogger()
+	ctx := worldTransferTestCtx(t)
+
+	var created, increased uuid.UUID
+	var name string
+	ap := &alliancemock.ProcessorMock{
+		CreateFunc: func(transactionId uuid.UUID, worldId world.Id, characterId uint32, n string) error {
+			created = transactionId
+			name = n
+			return nil
+		},
+		RequestCapacityIncreaseFunc: func(transactionId uuid.UUID, worldId world.Id, characterId uint32) error {
+			increased = transactionId
+			return nil
+		},
+	}
+	h := NewHandler(logger, ctx).WithAllianceProcessor(ap)
+
+	tx := uuid.New()
+	s, err := NewBuilder().
+		SetTransactionId(tx).
+		SetSagaType(QuestReward).
+		SetInitiatedBy("alliance-handler-test").
+		AddStep("create", Pending, CreateAlliance, CreateAlliancePayload{CharacterId: 1, WorldId: world.Id(0), Name: "Alliance"}).
+		AddStep("capacity", Pending, RequestAllianceCapacityIncrease, RequestAllianceCapacityIncreasePayload{CharacterId: 1, WorldId: world.Id(0)}).
+		Build()
+	require.NoError(t, err)
+
+	for _, st := range s.Steps() {
+		handler, ok := h.GetHandler(st.Action())
+		require.True(t, ok, "action [%s] has no handler", st.Action())
+		require.NoError(t, handler(s, st))
+	}
+	assert.Equal(t, tx, created)
+	assert.Equal(t, "Alliance", name)
+	assert.Equal(t, tx, increased)
+}
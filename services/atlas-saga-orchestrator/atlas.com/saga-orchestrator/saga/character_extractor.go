@@ -100,6 +100,15 @@ func ExtractCharacterId(step Step[any]) uint32 {
 		// is never routed through ForCharacter. Same posture as
 		// ReleaseFromMtsHoldingPayload.
 		return p.OwnerId
+	case TransferToNotePayload:
+		return p.CharacterId
+	case AcceptToNotePayload:
+		// The sender: an accept_to_note only ever follows the sender's own
+		// release_from_character. ReleaseFromNotePayload has no case for the
+		// same reason ReleaseFromTradePayload has none.
+		return p.SenderId
+	case WithdrawFromNotePayload:
+		return p.CharacterId
 	case SelectGachaponRewardPayload:
 		return p.CharacterId
 	case EmitGachaponWinPayload:
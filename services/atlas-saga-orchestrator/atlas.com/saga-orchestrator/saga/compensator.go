@@ -11,6 +11,7 @@ import (
 	character2 "atlas-saga-orchestrator/kafka/message/character"
 	sagaMsg "atlas-saga-orchestrator/kafka/message/saga"
 	"atlas-saga-orchestrator/mts"
+	"atlas-saga-orchestrator/note"
 	"atlas-saga-orchestrator/pending_change"
 	"atlas-saga-orchestrator/pet"
 	"atlas-saga-orchestrator/skill"
@@ -45,6 +46,7 @@ type Compensator interface {
 	WithBuddyListProcessor(buddylist.Processor) Compensator
 	WithPendingChangeProcessor(pending_change.Processor) Compensator
 	WithPetProcessor(pet.Processor) Compensator
+	WithNoteProcessor(note.Processor) Compensator
 
 	CompensateFailedStep(s Saga) error
 	compensateEquipAsset(s Saga, failedStep Step[any]) error
@@ -94,6 +96,13 @@ type Compensator interface {
 	// Kafka path.
 	DispatchTradeStagingRollbacks(s Saga)
 
+	// DispatchNoteAttachmentRollbacks reverse-walks the completed steps of a
+	// note_attachment saga (a send with attachments, a claim or an expiry
+	// return). Like the trade-staging walk it re-grants released items from the
+	// paired accept's snapshot, and additionally restores a released escrow row
+	// so a failed delivery leaves the attachment claimable. Pure dispatch half.
+	DispatchNoteAttachmentRollbacks(s Saga)
+
 	// DispatchCharacterCreationRollbacks is the dispatch half of the reverse-walk
 	// compensator. It fires the inverse commands (DestroyItem / DeleteSkill /
 	// DeleteCharacter-last) for each completed step of a CharacterCreation saga.
@@ -198,6 +207,7 @@ type CompensatorImpl struct {
 	buddyP    buddylist.Processor
 	pcP       pending_change.Processor
 	petP      pet.Processor
+	noteP     note.Processor
 }
 
 func NewCompensator(l logrus.FieldLogger, ctx context.Context) Compensator {
@@ -217,6 +227,7 @@ func NewCompensator(l logrus.FieldLogger, ctx context.Context) Compensator {
 		buddyP:    buddylist.NewProcessor(l, ctx),
 		pcP:       pending_change.NewProcessor(l, ctx),
 		petP:      pet.NewProcessor(l, ctx),
+		noteP:     note.NewProcessor(l, ctx),
 	}
 }
 
@@ -299,6 +310,12 @@ func (c *CompensatorImpl) WithPetProcessor(petP pet.Processor) Compensator {
 	return n
 }
 
+func (c *CompensatorImpl) WithNoteProcessor(noteP note.Processor) Compensator {
+	n := c.copy()
+	n.noteP = noteP
+	return n
+}
+
 // CompensateFailedStep handles compensation for failed steps
 func (c *CompensatorImpl) CompensateFailedStep(s Saga) error {
 	// Find the failed step
@@ -398,6 +415,14 @@ func (c *CompensatorImpl) CompensateFailedStep(s Saga) error {
 		return c.compensateTradeStaging(s, failedStep)
 	}
 
+	// Note-attachment reverse-walk. Sends, claims and expiry returns all move
+	// goods between a character and atlas-notes' escrow; a failure partway
+	// through must put every moved item back where it came from. Taken ahead of
+	// the per-action switch for the same reason as the trade-staging arm.
+	if s.SagaType() == NoteAttachment {
+		return c.compensateNoteAttachment(s, failedStep)
+	}
+
 	// Note-send reverse-walk: a failed create_note must refund the
 	// already-destroyed Note item; a failed consume_note_item has nothing to
 	// refund. Either way the saga terminates with one Failed emission so the
@@ -2350,6 +2375,10 @@ func (c *CompensatorImpl) compensateMtsOperation(s Saga, failedStep Step[any]) e
 //     snapshot from the saga's AcceptToMtsListing step so stats survive.
 //   - ReleaseFromMtsHolding (WithdrawFromMts: holding soft-deleted) →
 //     RestoreMtsHolding (un-soft-delete the same holding row).
+//   - MtsBidEscrow (buy-order fill: escrow released ahead of the settlement) →
+//     MtsBidEscrow with -Amount on the prepaid wallet, re-holding the escrow so
+//     the still-open order stays fully funded. Single-step bid escrow sagas never
+//     reach here with the step Completed.
 //
 // Steps that committed no compensable mutation have no inverse:
 //   - AcceptToMtsListing failing leaves no listing row (its own atomic tx rolled
@@ -2412,6 +2441,18 @@ func (c *CompensatorImpl) DispatchMtsOperationRollbacks(s Saga) {
 					}).Error("Reverse-walk: ReleaseFromCharacter → AcceptToCharacter re-grant dispatch failed; continuing chain.")
 				}
 			}
+		case MtsBidEscrow:
+			if payload, ok := step.Payload().(MtsBidEscrowPayload); ok {
+				const currencyTypePrepaid = uint32(3)
+				if err := c.cashshopP.AwardCurrencyAndEmit(s.TransactionId(), payload.BidderAccountId, currencyTypePrepaid, -payload.Amount); err != nil {
+					c.l.WithError(err).WithFields(logrus.Fields{
+						"transaction_id": s.TransactionId().String(),
+						"step_id":        step.StepId(),
+						"account_id":     payload.BidderAccountId,
+						"amount":         payload.Amount,
+					}).Error("Reverse-walk: MtsBidEscrow reversal dispatch failed; continuing chain.")
+				}
+			}
 		case ReleaseFromMtsHolding:
 			if payload, ok := step.Payload().(ReleaseFromMtsHoldingPayload); ok {
 				if err := c.mtsP.RestoreMtsHoldingAndEmit(s.TransactionId(), payload.HoldingId); err != nil {
@@ -3141,3 +3182,210 @@ func (c *CompensatorImpl) DispatchTradeStagingRollbacks(s Saga) {
 		}
 	}
 }
+
+// compensateNoteAttachment reverse-walks a failed note_attachment saga.
+//
+// The saga shapes are:
+//   - send: per item release_from_character + accept_to_note, an optional
+//     award_mesos debit + accept_to_note for mesos, then create_note.
+//   - claim / return: per attachment release_from_note then accept_to_character,
+//     award_asset (minted reward items), award_currency (minted NX) or
+//     award_mesos.
+//
+// Inverses:
+//   - AwardMesos → the negated award, refunding a send debit or clawing back a
+//     delivered meso attachment.
+//   - AwardCurrency → the negated award against the same wallet.
+//   - AcceptToCharacter / AwardAsset → RequestDestroyItem.
+//   - ReleaseFromCharacter → RequestAcceptAsset using the snapshot carried on
+//     the accept_to_note for the same asset, so the sender gets back the item
+//     they attached rather than a bare template.
+//   - AcceptToNote → RemoveNoteAttachment, hard-deleting the escrow row so the
+//     re-granted item is not also claimable.
+//   - ReleaseFromNote → RestoreNoteAttachment, undoing the soft-delete so the
+//     attachment can be claimed (or returned) again.
+//
+// Each inverse is claimed once through claimTradeRollback.
+func (c *CompensatorImpl) compensateNoteAttachment(s Saga, failedStep Step[any]) error {
+	c.l.WithFields(logrus.Fields{
+		"transaction_id": s.TransactionId().String(),
+		"failed_step":    failedStep.StepId(),
+		"failed_action":  failedStep.Action(),
+		"tenant_id":      c.t.Id().String(),
+	}).Info("Note attachment saga failing — dispatching reverse-walk compensation.")
+
+	c.DispatchNoteAttachmentRollbacks(s)
+
+	if !GetCache().TryTransition(c.ctx, s.TransactionId(), SagaLifecycleCompensating, SagaLifecycleFailed) {
+		c.l.WithFields(logrus.Fields{
+			"transaction_id": s.TransactionId().String(),
+			"tenant_id":      c.t.Id().String(),
+		}).Info("saga already in terminal Failed state; reverse-walk emission skipped.")
+		SagaTimers().Cancel(s.TransactionId())
+		GetCache().Remove(c.ctx, s.TransactionId())
+		return nil
+	}
+
+	SagaTimers().Cancel(s.TransactionId())
+	GetCache().Remove(c.ctx, s.TransactionId())
+
+	reason := fmt.Sprintf("Note attachment failed at step [%s] action [%s]", failedStep.StepId(), failedStep.Action())
+	if err := EmitSagaFailed(c.l, c.ctx, s, sagaMsg.ErrorCodeUnknown, reason, failedStep.StepId()); err != nil {
+		c.l.WithError(err).WithFields(logrus.Fields{
+			"transaction_id": s.TransactionId().String(),
+			"tenant_id":      c.t.Id().String(),
+		}).Error("Failed to emit saga failed event after note attachment compensation.")
+		return err
+	}
+	return nil
+}
+
+// DispatchNoteAttachmentRollbacks issues the inverse of every completed step of
+// a failing note_attachment saga, newest first. See compensateNoteAttachment for
+// the per-action contract.
+func (c *CompensatorImpl) DispatchNoteAttachmentRollbacks(s Saga) {
+	// A send carries one accept_to_note per attached item, so the snapshot for a
+	// release is found by the asset it moved rather than by action alone. The
+	// accept's payload is present whether or not the step ran.
+	snapshots := make(map[uint32]AcceptToNotePayload)
+	for _, step := range s.Steps() {
+		if step.Action() != AcceptToNote {
+			continue
+		}
+		if p, ok := step.Payload().(AcceptToNotePayload); ok && p.Mesos == 0 {
+			snapshots[p.AssetId] = p
+		}
+	}
+
+	steps := s.Steps()
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		if step.Status() != Completed {
+			continue
+		}
+		switch step.Action() {
+		case AwardMesos:
+			payload, ok := step.Payload().(AwardMesosPayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			ch := channel.NewModel(payload.WorldId, payload.ChannelId)
+			if err := c.charP.AwardMesosAndEmit(s.TransactionId(), ch, payload.CharacterId, payload.CharacterId, "SYSTEM", -payload.Amount, false); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"character_id":   payload.CharacterId,
+					"amount":         payload.Amount,
+				}).Error("Reverse-walk: note AwardMesos reversal dispatch failed; continuing chain.")
+			}
+		case AwardCurrency:
+			payload, ok := step.Payload().(AwardCurrencyPayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			if err := c.cashshopP.AwardCurrencyAndEmit(s.TransactionId(), payload.AccountId, payload.CurrencyType, -payload.Amount); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"account_id":     payload.AccountId,
+					"amount":         payload.Amount,
+				}).Error("Reverse-walk: note AwardCurrency reversal dispatch failed; continuing chain.")
+			}
+		case AcceptToCharacter:
+			payload, ok := step.Payload().(AcceptToCharacterPayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			if err := c.compP.RequestDestroyItem(s.TransactionId(), payload.CharacterId, payload.TemplateId, payload.AssetData.Quantity, false); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"character_id":   payload.CharacterId,
+					"template_id":    payload.TemplateId,
+				}).Error("Reverse-walk: note AcceptToCharacter → DestroyItem dispatch failed; continuing chain.")
+			}
+		case AwardAsset:
+			payload, ok := step.Payload().(AwardItemActionPayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			if err := c.compP.RequestDestroyItem(s.TransactionId(), payload.CharacterId, payload.Item.TemplateId, payload.Item.Quantity, false); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"character_id":   payload.CharacterId,
+					"template_id":    payload.Item.TemplateId,
+				}).Error("Reverse-walk: note AwardAsset → DestroyItem dispatch failed; continuing chain.")
+			}
+		case ReleaseFromCharacter:
+			payload, ok := step.Payload().(ReleaseFromCharacterPayload)
+			if !ok {
+				continue
+			}
+			accept, found := snapshots[payload.AssetId]
+			if !found {
+				c.l.WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"character_id":   payload.CharacterId,
+					"asset_id":       payload.AssetId,
+				}).Error("Reverse-walk: note ReleaseFromCharacter has no AcceptToNote snapshot to re-grant; skipping.")
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			assetData := assetDataFromSnapshot(accept.Snapshot)
+			if err := c.compP.RequestAcceptAsset(s.TransactionId(), payload.CharacterId, payload.InventoryType, accept.Snapshot.TemplateId, assetData); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"character_id":   payload.CharacterId,
+					"template_id":    accept.Snapshot.TemplateId,
+				}).Error("Reverse-walk: note ReleaseFromCharacter → AcceptToCharacter re-grant dispatch failed; continuing chain.")
+			}
+		case AcceptToNote:
+			payload, ok := step.Payload().(AcceptToNotePayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			if err := c.noteP.RemoveNoteAttachmentAndEmit(s.TransactionId(), payload.AttachmentId); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"attachment_id":  payload.AttachmentId.String(),
+				}).Error("Reverse-walk: AcceptToNote → RemoveNoteAttachment dispatch failed; continuing chain.")
+			}
+		case ReleaseFromNote:
+			payload, ok := step.Payload().(ReleaseFromNotePayload)
+			if !ok {
+				continue
+			}
+			if !c.claimTradeRollback(s, step) {
+				continue
+			}
+			if err := c.noteP.RestoreNoteAttachmentAndEmit(s.TransactionId(), payload.AttachmentId); err != nil {
+				c.l.WithError(err).WithFields(logrus.Fields{
+					"transaction_id": s.TransactionId().String(),
+					"step_id":        step.StepId(),
+					"attachment_id":  payload.AttachmentId.String(),
+				}).Error("Reverse-walk: ReleaseFromNote → RestoreNoteAttachment dispatch failed; continuing chain.")
+			}
+		}
+	}
+}
@@ -88,6 +88,12 @@ const (
 	EventKindTradeCustodyReleased EventKind = "trade.custody_released"
 	EventKindTradeCustodyError    EventKind = "trade.custody_error"
 
+	// Note attachment custody (atlas-notes custody acks on
+	// EVENT_TOPIC_NOTE_CUSTODY_STATUS).
+	EventKindNoteCustodyAccepted EventKind = "note.custody_accepted"
+	EventKindNoteCustodyReleased EventKind = "note.custody_released"
+	EventKindNoteCustodyError    EventKind = "note.custody_error"
+
 	// Compartment (character inventory).
 	EventKindCompartmentCreated        EventKind = "compartment.created"
 	EventKindCompartmentCreationFailed EventKind = "compartment.creation_failed"
@@ -114,6 +120,11 @@ const (
 	EventKindGuildEmblemUpdated    EventKind = "guild.emblem_updated"
 	EventKindGuildCapacityUpdated  EventKind = "guild.capacity_updated"
 
+	// Alliance.
+	EventKindAllianceCreated         EventKind = "alliance.created"
+	EventKindAllianceCapacityUpdated EventKind = "alliance.capacity_updated"
+	EventKindAllianceError           EventKind = "alliance.error"
+
 	// Invite.
 	EventKindInviteCreated  EventKind = "invite.created"
 	EventKindInviteAccepted EventKind = "invite.accepted"
@@ -196,6 +207,12 @@ var acceptanceTable = map[sharedsaga.Action][]EventKind{
 	// Note.
 	sharedsaga.CreateNote: {EventKindNoteCreated, EventKindNoteCreateFailed},
 
+	// Note attachments.
+	sharedsaga.TransferToNote:   {}, // composite: expanded into release_from_character + accept_to_note
+	sharedsaga.WithdrawFromNote: {}, // composite: expanded into release_from_note + accept_to_character | award_mesos
+	sharedsaga.AcceptToNote:     {EventKindNoteCustodyAccepted, EventKindNoteCustodyError},
+	sharedsaga.ReleaseFromNote:  {EventKindNoteCustodyReleased, EventKindNoteCustodyError},
+
 	// Quest.
 	sharedsaga.CompleteQuest:    {EventKindQuestCompleted},
 	sharedsaga.StartQuest:       {EventKindQuestStarted},
@@ -253,6 +270,10 @@ var acceptanceTable = map[sharedsaga.Action][]EventKind{
 	sharedsaga.RequestGuildDisband:          {EventKindGuildDisbanded},
 	sharedsaga.RequestGuildCapacityIncrease: {EventKindGuildCapacityUpdated},
 
+	// Alliance.
+	sharedsaga.CreateAlliance:                  {EventKindAllianceCreated, EventKindAllianceError},
+	sharedsaga.RequestAllianceCapacityIncrease: {EventKindAllianceCapacityUpdated, EventKindAllianceError},
+
 	// Invite.
 	sharedsaga.CreateInvite: {EventKindInviteCreated, EventKindInviteAccepted, EventKindInviteRejected},
 
@@ -438,6 +459,11 @@ var outcomeTable = map[EventKind]EventOutcome{
 	EventKindTradeCustodyReleased: OutcomeSuccess,
 	EventKindTradeCustodyError:    OutcomeFailure,
 
+	// Note attachment custody.
+	EventKindNoteCustodyAccepted: OutcomeSuccess,
+	EventKindNoteCustodyReleased: OutcomeSuccess,
+	EventKindNoteCustodyError:    OutcomeFailure,
+
 	// Compartment (character inventory).
 	EventKindCompartmentCreated:        OutcomeSuccess,
 	EventKindCompartmentCreationFailed: OutcomeFailure,
@@ -464,6 +490,11 @@ var outcomeTable = map[EventKind]EventOutcome{
 	EventKindGuildEmblemUpdated:    OutcomeSuccess,
 	EventKindGuildCapacityUpdated:  OutcomeSuccess,
 
+	// Alliance.
+	EventKindAllianceCreated:         OutcomeSuccess,
+	EventKindAllianceCapacityUpdated: OutcomeSuccess,
+	EventKindAllianceError:           OutcomeFailure,
+
 	// Invite.
 	EventKindInviteCreated:  OutcomeSuccess,
 	EventKindInviteAccepted: OutcomeSuccess,
@@ -40,6 +40,7 @@ var allActions = []sharedsaga.Action{
 	sharedsaga.MtsBidEscrow,
 	sharedsaga.RequestGuildName, sharedsaga.RequestGuildEmblem, sharedsaga.RequestGuildDisband,
 	sharedsaga.RequestGuildCapacityIncrease, sharedsaga.CreateInvite,
+	sharedsaga.CreateAlliance, sharedsaga.RequestAllianceCapacityIncrease,
 	sharedsaga.CreateCharacter, sharedsaga.AwaitCharacterCreated, sharedsaga.AwaitInventoryCreated,
 	sharedsaga.StartInstanceTransport,
 	sharedsaga.SelectGachaponReward, sharedsaga.EmitGachaponWin,
@@ -114,6 +115,8 @@ func TestStepAcceptsEvent_KnownSuccessKinds(t *testing.T) {
 		{sharedsaga.RequestGuildEmblem, EventKindGuildEmblemUpdated},
 		{sharedsaga.RequestGuildDisband, EventKindGuildDisbanded},
 		{sharedsaga.RequestGuildCapacityIncrease, EventKindGuildCapacityUpdated},
+		{sharedsaga.CreateAlliance, EventKindAllianceCreated},
+		{sharedsaga.RequestAllianceCapacityIncrease, EventKindAllianceCapacityUpdated},
 		{sharedsaga.CreateInvite, EventKindInviteCreated},
 		// A warp step advances only once the character's map change is confirmed
 		// (character.map_changed), so a step chained after the warp — e.g. the
@@ -1,6 +1,7 @@
 package saga
 
 import (
+	"atlas-saga-orchestrator/alliance"
 	"atlas-saga-orchestrator/buddylist"
 	"atlas-saga-orchestrator/buff"
 	"atlas-saga-orchestrator/cashshop"
@@ -93,6 +94,7 @@ type Handler interface {
 	// green.
 	WithPartyProcessor(party.Processor) Handler
 	WithPendingChangeProcessor(pending_change.Processor) Handler
+	WithAllianceProcessor(alliance.Processor) Handler
 
 	GetHandler(action Action) (ActionHandler, bool)
 
@@ -119,6 +121,8 @@ type Handler interface {
 	handleRequestGuildEmblem(s Saga, st Step[any]) error
 	handleRequestGuildDisband(s Saga, st Step[any]) error
 	handleRequestGuildCapacityIncrease(s Saga, st Step[any]) error
+	handleCreateAlliance(s Saga, st Step[any]) error
+	handleRequestAllianceCapacityIncrease(s Saga, st Step[any]) error
 	handleCreateInvite(s Saga, st Step[any]) error
 	handleCreateCharacter(s Saga, st Step[any]) error
 	handleCreateAndEquipAsset(s Saga, st Step[any]) error
@@ -187,6 +191,8 @@ type Handler interface {
 	handleEmitMegaphone(s Saga, st Step[any]) error
 	handleEnqueueWorldBroadcast(s Saga, st Step[any]) error
 	handleCreateNote(s Saga, st Step[any]) error
+	handleAcceptToNote(s Saga, st Step[any]) error
+	handleReleaseFromNote(s Saga, st Step[any]) error
 	handleOpenNpcShop(s Saga, st Step[any]) error
 	handleExtendAssetExpiration(s Saga, st Step[any]) error
 	handleValidateWorldTransfer(s Saga, st Step[any]) error
@@ -233,6 +239,7 @@ type HandlerImpl struct {
 	noteP           note.Processor
 	partyP          party.Processor
 	pendingChangeP  pending_change.Processor
+	allianceP       alliance.Processor
 }
 
 func NewHandler(l logrus.FieldLogger, ctx context.Context) Handler {
@@ -270,6 +277,7 @@ func NewHandler(l logrus.FieldLogger, ctx context.Context) Handler {
 		noteP:           note.NewProcessor(l, ctx),
 		partyP:          party.NewProcessor(l, ctx),
 		pendingChangeP:  pending_change.NewProcessor(l, ctx),
+		allianceP:       alliance.NewProcessor(l, ctx),
 	}
 }
 
@@ -790,6 +798,12 @@ func (h *HandlerImpl) WithPendingChangeProcessor(pendingChangeP pending_change.P
 	return &c
 }
 
+func (h *HandlerImpl) WithAllianceProcessor(allianceP alliance.Processor) Handler {
+	c := *h
+	c.allianceP = allianceP
+	return &c
+}
+
 func (h *HandlerImpl) WithNoteProcessor(noteP note.Processor) Handler {
 	return &HandlerImpl{
 		l:     h.l,
@@ -850,6 +864,10 @@ func (h *HandlerImpl) GetHandler(action Action) (ActionHandler, bool) {
 		return h.handleRequestGuildDisband, true
 	case RequestGuildCapacityIncrease:
 		return h.handleRequestGuildCapacityIncrease, true
+	case CreateAlliance:
+		return h.handleCreateAlliance, true
+	case RequestAllianceCapacityIncrease:
+		return h.handleRequestAllianceCapacityIncrease, true
 	case CreateInvite:
 		return h.handleCreateInvite, true
 	case CreateCharacter:
@@ -1020,6 +1038,10 @@ func (h *HandlerImpl) GetHandler(action Action) (ActionHandler, bool) {
 		return h.handleEnqueueWorldBroadcast, true
 	case CreateNote:
 		return h.handleCreateNote, true
+	case AcceptToNote:
+		return h.handleAcceptToNote, true
+	case ReleaseFromNote:
+		return h.handleReleaseFromNote, true
 	}
 	return nil, false
 }
@@ -1899,6 +1921,38 @@ func (h *HandlerImpl) handleRequestGuildCapacityIncrease(s Saga, st Step[any]) e
 	return nil
 }
 
+// handleCreateAlliance handles the CreateAlliance action
+func (h *HandlerImpl) handleCreateAlliance(s Saga, st Step[any]) error {
+	payload, ok := st.Payload().(CreateAlliancePayload)
+	if !ok {
+		return errors.New("invalid payload")
+	}
+
+	err := h.allianceP.Create(s.TransactionId(), payload.WorldId, payload.CharacterId, payload.Name)
+	if err != nil {
+		h.logActionError(s, st, err, "Unable to request alliance creation.")
+		return err
+	}
+
+	return nil
+}
+
+// handleRequestAllianceCapacityIncrease handles the RequestAllianceCapacityIncrease action
+func (h *HandlerImpl) handleRequestAllianceCapacityIncrease(s Saga, st Step[any]) error {
+	payload, ok := st.Payload().(RequestAllianceCapacityIncreasePayload)
+	if !ok {
+		return errors.New("invalid payload")
+	}
+
+	err := h.allianceP.RequestCapacityIncrease(s.TransactionId(), payload.WorldId, payload.CharacterId)
+	if err != nil {
+		h.logActionError(s, st, err, "Unable to request alliance capacity increase.")
+		return err
+	}
+
+	return nil
+}
+
 // handleCreateInvite handles the CreateInvite action
 func (h *HandlerImpl) handleCreateInvite(s Saga, st Step[any]) error {
 	// Extract the payload
@@ -2249,7 +2303,7 @@ func (h *HandlerImpl) handleShowStorage(s Saga, st Step[any]) error {
 	}
 
 	ch := channel.NewModel(payload.WorldId, payload.ChannelId)
-	err := h.storageP.ShowStorageAndEmit(s.TransactionId(), ch, payload.CharacterId, payload.NpcId, payload.AccountId)
+	err := h.storageP.ShowStorageAndEmit(s.TransactionId(), ch, payload.CharacterId, payload.NpcId, payload.AccountId, payload.Tab)
 	if err != nil {
 		h.logActionError(s, st, err, "Unable to show storage.")
 		return err
@@ -3628,6 +3682,55 @@ func (h *HandlerImpl) handleCreateNote(s Saga, st Step[any]) error {
 	return nil
 }
 
+// handleAcceptToNote dispatches ACCEPT_TO_NOTE, the custody step that creates
+// atlas-notes' attachment row. For an item it runs after the
+// release_from_character that removed the asset from the sender; for mesos,
+// after the award_mesos debit. Completion arrives on the custody ack.
+func (h *HandlerImpl) handleAcceptToNote(s Saga, st Step[any]) error {
+	payload, ok := st.Payload().(AcceptToNotePayload)
+	if !ok {
+		return errors.New("invalid payload")
+	}
+
+	h.l.Debugf("Accepting attachment [%s] (template [%d], mesos [%d]) from [%d] to note custody for [%d]",
+		payload.AttachmentId, payload.Snapshot.TemplateId, payload.Mesos, payload.SenderId, payload.RecipientId)
+
+	err := h.noteP.AcceptToNoteAndEmit(payload.TransactionId, note.AcceptToNoteParams{
+		AttachmentId:        payload.AttachmentId,
+		SenderId:            payload.SenderId,
+		RecipientId:         payload.RecipientId,
+		WorldId:             payload.WorldId,
+		SourceInventoryType: payload.SourceInventoryType,
+		AssetId:             payload.AssetId,
+		Mesos:               payload.Mesos,
+		Snapshot:            payload.Snapshot,
+	})
+	if err != nil {
+		h.logActionError(s, st, err, "Unable to accept attachment to note custody.")
+		return err
+	}
+	return nil
+}
+
+// handleReleaseFromNote dispatches RELEASE_FROM_NOTE. atlas-notes releases the
+// row only if it is still escrowed, so a second claim (or a claim racing an
+// expiry return) fails here before anything is delivered twice.
+func (h *HandlerImpl) handleReleaseFromNote(s Saga, st Step[any]) error {
+	payload, ok := st.Payload().(ReleaseFromNotePayload)
+	if !ok {
+		return errors.New("invalid payload")
+	}
+
+	h.l.Debugf("Releasing note attachment [%s]", payload.AttachmentId)
+
+	err := h.noteP.ReleaseFromNoteAndEmit(payload.TransactionId, payload.AttachmentId)
+	if err != nil {
+		h.logActionError(s, st, err, "Unable to release note attachment.")
+		return err
+	}
+	return nil
+}
+
 // handleEmitMegaphone handles the EmitMegaphone action.
 // Produces a BroadcastEvent to EVENT_TOPIC_MEGAPHONE for the stateless
 // megaphone tiers (MEGAPHONE/SUPER/ITEM/TRIPLE). Fire-and-forget — no Kafka
@@ -49,6 +49,7 @@ const (
 	MesoSackUse           = sharedsaga.MesoSackUse
 	MtsOperation          = sharedsaga.MtsOperation
 	NoteSend              = sharedsaga.NoteSend
+	NoteAttachment        = sharedsaga.NoteAttachment
 	SkillBookUse          = sharedsaga.SkillBookUse
 	PetNameTagUse         = sharedsaga.PetNameTagUse
 	RemoteMerchant        = sharedsaga.RemoteMerchant
@@ -202,6 +203,10 @@ const (
 	RequestGuildCapacityIncrease = sharedsaga.RequestGuildCapacityIncrease
 	CreateInvite                 = sharedsaga.CreateInvite
 
+	// Alliance actions
+	CreateAlliance                  = sharedsaga.CreateAlliance
+	RequestAllianceCapacityIncrease = sharedsaga.RequestAllianceCapacityIncrease
+
 	// Character creation actions
 	CreateCharacter       = sharedsaga.CreateCharacter
 	AwaitCharacterCreated = sharedsaga.AwaitCharacterCreated
@@ -235,6 +240,14 @@ const (
 	// Note actions
 	CreateNote = sharedsaga.CreateNote
 
+	// Note attachment custody. transfer_to_note and withdraw_from_note are
+	// COMPOSITES expanded by expandTransferToNote / expandWithdrawFromNote;
+	// accept_to_note and release_from_note are dispatched to atlas-notes.
+	TransferToNote   = sharedsaga.TransferToNote
+	WithdrawFromNote = sharedsaga.WithdrawFromNote
+	AcceptToNote     = sharedsaga.AcceptToNote
+	ReleaseFromNote  = sharedsaga.ReleaseFromNote
+
 	// Megaphone / world broadcast actions
 	EmitMegaphone         = sharedsaga.EmitMegaphone
 	EnqueueWorldBroadcast = sharedsaga.EnqueueWorldBroadcast
@@ -371,12 +384,20 @@ type (
 	ApplyAssetKarmaPayload              = sharedsaga.ApplyAssetKarmaPayload
 	IncubatorResultPayload              = sharedsaga.IncubatorResultPayload
 	CreateNotePayload                   = sharedsaga.CreateNotePayload
+	TransferToNotePayload               = sharedsaga.TransferToNotePayload
+	WithdrawFromNotePayload             = sharedsaga.WithdrawFromNotePayload
+	AcceptToNotePayload                 = sharedsaga.AcceptToNotePayload
+	ReleaseFromNotePayload              = sharedsaga.ReleaseFromNotePayload
 	ExtendAssetExpirationPayload        = sharedsaga.ExtendAssetExpirationPayload
 	// Megaphone / world broadcast payload types
 	EmitMegaphonePayload         = sharedsaga.EmitMegaphonePayload
 	EnqueueWorldBroadcastPayload = sharedsaga.EnqueueWorldBroadcastPayload
 	AssetSnapshot                = sharedsaga.AssetSnapshot
 	AvatarSnapshot               = sharedsaga.AvatarSnapshot
+
+	// Alliance payload types
+	CreateAlliancePayload                  = sharedsaga.CreateAlliancePayload
+	RequestAllianceCapacityIncreasePayload = sharedsaga.RequestAllianceCapacityIncreasePayload
 )
 
 // ============================================================
@@ -1664,6 +1685,30 @@ func (s *Step[T]) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
 		}
 		s.payload = any(payload).(T)
+	case TransferToNote:
+		var payload TransferToNotePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
+	case WithdrawFromNote:
+		var payload WithdrawFromNotePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
+	case AcceptToNote:
+		var payload AcceptToNotePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
+	case ReleaseFromNote:
+		var payload ReleaseFromNotePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
 	case DeductExperience:
 		var payload DeductExperiencePayload
 		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
@@ -1706,6 +1751,18 @@ func (s *Step[T]) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
 		}
 		s.payload = any(payload).(T)
+	case CreateAlliance:
+		var payload CreateAlliancePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
+	case RequestAllianceCapacityIncrease:
+		var payload RequestAllianceCapacityIncreasePayload
+		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to unmarshal payload for action %s: %w", s.action, err)
+		}
+		s.payload = any(payload).(T)
 	case StartRPSGame:
 		var payload StartRPSGamePayload
 		if err := json.Unmarshal(actionOnly.Payload, &payload); err != nil {
@@ -293,3 +293,92 @@ func TestMtsSettlePurchaseCompensation(t *testing.T) {
 	_, lifecycleOk := GetCache().GetLifecycle(tctx, transactionId)
 	assert.False(t, lifecycleOk, "saga should be evicted from cache after compensation")
 }
+
+// TestMtsBuyOrderFillCompensation covers the buy-order fill shape: the order's
+// escrow is released ahead of the expanded settlement, so a failed move must
+// reverse both awards AND re-hold the escrow, leaving the buyer exactly where the
+// still-open order left them (escrow held, nothing else moved).
+//
+//	step 0: mts_buy_order_escrow_release (prepaid, +escrow)   ← Completed
+//	step 1: award_currency_buyer         (prepaid, -markedUp) ← Completed
+//	step 2: award_currency_seller        (points,  +listVal)  ← Completed
+//	step 3: mts_move_listing_to_holding                       ← Failed
+func TestMtsBuyOrderFillCompensation(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	ctx := context.Background()
+	te, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	tctx := tenant.WithContext(ctx, te)
+
+	const (
+		buyerId         = uint32(7001)
+		buyerAccountId  = uint32(8001)
+		sellerId        = uint32(7002)
+		sellerAccountId = uint32(8002)
+		escrow          = int32(1570)
+		markedUpPrice   = int32(1463)
+		listValue       = int32(900)
+		currencyPoints  = uint32(2)
+		currencyPrepaid = uint32(3)
+	)
+
+	transactionId := uuid.New()
+	listingId := uuid.New()
+	cashshopMock := &mtsTestCashshopMock{}
+	mtsMockP := &mtsTestMtsMock{}
+
+	s, err := NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(MtsOperation).
+		SetInitiatedBy("mts-buy-order-fill-compensation-test").
+		AddStep("mts_buy_order_escrow_release", Completed, MtsBidEscrow, MtsBidEscrowPayload{
+			TransactionId:   transactionId,
+			ListingId:       listingId,
+			BidderId:        buyerId,
+			BidderAccountId: buyerAccountId,
+			Amount:          escrow,
+		}).
+		AddStep("award_currency_buyer", Completed, AwardCurrency, AwardCurrencyPayload{
+			CharacterId:  buyerId,
+			AccountId:    buyerAccountId,
+			CurrencyType: currencyPrepaid,
+			Amount:       -markedUpPrice,
+		}).
+		AddStep("award_currency_seller", Completed, AwardCurrency, AwardCurrencyPayload{
+			CharacterId:  sellerId,
+			AccountId:    sellerAccountId,
+			CurrencyType: currencyPoints,
+			Amount:       listValue,
+		}).
+		AddStep("mts_move_listing_to_holding", Failed, MtsMoveListingToHolding, MtsMoveListingToHoldingPayload{
+			TransactionId: transactionId,
+			ListingId:     listingId,
+			BuyerId:       buyerId,
+		}).
+		Build()
+	assert.NoError(t, err, "saga build should not fail")
+	assert.NoError(t, GetCache().Put(tctx, s))
+	defer GetCache().Remove(tctx, transactionId)
+
+	NewCompensator(logger, tctx).
+		WithCashshopProcessor(cashshopMock).
+		WithMtsProcessor(mtsMockP).
+		DispatchMtsOperationRollbacks(s)
+
+	assert.Equal(t, 3, len(cashshopMock.awardCalls), "expected seller debit, buyer re-credit and escrow re-hold")
+	if len(cashshopMock.awardCalls) == 3 {
+		// Reverse-walk order: seller, buyer, then the escrow release.
+		assert.Equal(t, mtsAwardCurrencyCall{AccountId: sellerAccountId, CurrencyType: currencyPoints, Amount: -listValue}, cashshopMock.awardCalls[0])
+		assert.Equal(t, mtsAwardCurrencyCall{AccountId: buyerAccountId, CurrencyType: currencyPrepaid, Amount: markedUpPrice}, cashshopMock.awardCalls[1])
+		assert.Equal(t, mtsAwardCurrencyCall{AccountId: buyerAccountId, CurrencyType: currencyPrepaid, Amount: -escrow}, cashshopMock.awardCalls[2])
+	}
+
+	var buyerNet int32 = escrow - markedUpPrice
+	for _, c := range cashshopMock.awardCalls {
+		if c.AccountId == buyerAccountId {
+			buyerNet += c.Amount
+		}
+	}
+	assert.Equal(t, int32(0), buyerNet, "buyer nets to zero relative to the held escrow")
+	assert.Equal(t, 0, mtsMockP.restoreCalls+mtsMockP.moveCalls, "no custody inverse for a failed move")
+}
@@ -0,0 +1,279 @@
+package saga
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	charactermock "atlas-saga-orchestrator/character/mock"
+	compartmentmock "atlas-saga-orchestrator/compartment/mock"
+	asset2 "atlas-saga-orchestrator/kafka/message/asset"
+	notemock "atlas-saga-orchestrator/note/mock"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+const (
+	noteSenderId    = uint32(100)
+	noteRecipientId = uint32(200)
+	noteAssetId     = uint32(55)
+	noteTemplate    = uint32(1302000)
+	noteWeaponAtk   = uint16(17)
+)
+
+type noteAttachmentHarness struct {
+	compensator Compensator
+	tctx        context.Context
+	regrants    *[]tradeRegrantCall
+	destroys    *[]uint32
+	mesos       *[]int32
+	removed     *[]uuid.UUID
+	restored    *[]uuid.UUID
+}
+
+func newNoteAttachmentHarness(t *testing.T) noteAttachmentHarness {
+	t.Helper()
+	logger, _ := logtest.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+
+	te, err := tenant.Create(uuid.New(), "GMS", 83, 1)
+	require.NoError(t, err)
+	tctx := tenant.WithContext(context.Background(), te)
+
+	h := noteAttachmentHarness{
+		tctx:     tctx,
+		regrants: &[]tradeRegrantCall{},
+		destroys: &[]uint32{},
+		mesos:    &[]int32{},
+		removed:  &[]uuid.UUID{},
+		restored: &[]uuid.UUID{},
+	}
+	compMock := &compartmentmock.ProcessorMock{
+		RequestAcceptAssetFunc: func(_ uuid.UUID, characterId uint32, inventoryType byte, templateId uint32, assetData asset2.AssetData) error {
+			*h.regrants = append(*h.regrants, tradeRegrantCall{CharacterId: characterId, InventoryType: inventoryType, TemplateId: templateId, AssetData: assetData})
+			return nil
+		},
+		RequestDestroyItemFunc: func(_ uuid.UUID, _ uint32, templateId uint32, _ uint32, _ bool) error {
+			*h.destroys = append(*h.destroys, templateId)
+			return nil
+		},
+	}
+	charMock := &charactermock.ProcessorMock{
+		AwardMesosAndEmitFunc: func(_ uuid.UUID, _ channel.Model, _ uint32, _ uint32, _ string, amount int32, _ bool) error {
+			*h.mesos = append(*h.mesos, amount)
+			return nil
+		},
+	}
+	noteMock := &notemock.ProcessorMock{
+		RemoveNoteAttachmentAndEmitFunc: func(_ uuid.UUID, attachmentId uuid.UUID) error {
+			*h.removed = append(*h.removed, attachmentId)
+			return nil
+		},
+		RestoreNoteAttachmentAndEmitFunc: func(_ uuid.UUID, attachmentId uuid.UUID) error {
+			*h.restored = append(*h.restored, attachmentId)
+			return nil
+		},
+	}
+	h.compensator = NewCompensator(logger, tctx).
+		WithCompartmentProcessor(compMock).
+		WithCharacterProcessor(charMock).
+		WithNoteProcessor(noteMock)
+	return h
+}
+
+// noteSendSaga reproduces an expanded send: one item attachment, one meso
+// attachment, then create_note.
+func noteSendSaga(t *testing.T, transactionId, itemId, mesoId uuid.UUID, statuses [5]Status) Saga {
+	t.Helper()
+	s, err := NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(NoteAttachment).
+		SetInitiatedBy("note-attachment-test").
+		AddStep("release_from_character_"+itemId.String(), statuses[0], ReleaseFromCharacter, ReleaseFromCharacterPayload{
+			TransactionId: transactionId, CharacterId: noteSenderId, InventoryType: 1, AssetId: noteAssetId, Quantity: 1,
+		}).
+		AddStep("accept_to_note_"+itemId.String(), statuses[1], AcceptToNote, AcceptToNotePayload{
+			TransactionId: transactionId, AttachmentId: itemId, SenderId: noteSenderId, RecipientId: noteRecipientId,
+			SourceInventoryType: 1, AssetId: noteAssetId,
+			Snapshot: AssetSnapshot{TemplateId: noteTemplate, Quantity: 1, WeaponAttack: noteWeaponAtk},
+		}).
+		AddStep("award_mesos_"+mesoId.String(), statuses[2], AwardMesos, AwardMesosPayload{
+			CharacterId: noteSenderId, WorldId: 0, ChannelId: 1, ActorType: "SYSTEM", Amount: -5000,
+		}).
+		AddStep("accept_to_note_"+mesoId.String(), statuses[3], AcceptToNote, AcceptToNotePayload{
+			TransactionId: transactionId, AttachmentId: mesoId, SenderId: noteSenderId, RecipientId: noteRecipientId, Mesos: 5000,
+		}).
+		AddStep("create_note", statuses[4], CreateNote, CreateNotePayload{
+			ReceiverId: noteRecipientId, SenderId: noteSenderId, Message: "hello",
+		}).
+		Build()
+	require.NoError(t, err)
+	return s
+}
+
+// TestNoteSendRollbackReturnsEverythingWhenCreateNoteFails pins the send's
+// failure contract: with both attachments escrowed and create_note failing, the
+// sender gets the item back with its stats, the debit is refunded, and both
+// escrow rows are removed so the recipient cannot also claim them.
+func TestNoteSendRollbackReturnsEverythingWhenCreateNoteFails(t *testing.T) {
+	h := newNoteAttachmentHarness(t)
+	transactionId, itemId, mesoId := uuid.New(), uuid.New(), uuid.New()
+
+	s := noteSendSaga(t, transactionId, itemId, mesoId, [5]Status{Completed, Completed, Completed, Completed, Failed})
+	require.NoError(t, GetCache().Put(h.tctx, s))
+	require.True(t, GetCache().TryTransition(h.tctx, transactionId, SagaLifecyclePending, SagaLifecycleCompensating))
+
+	h.compensator.DispatchNoteAttachmentRollbacks(s)
+
+	require.Len(t, *h.regrants, 1)
+	assert.Equal(t, noteSenderId, (*h.regrants)[0].CharacterId)
+	assert.Equal(t, noteTemplate, (*h.regrants)[0].TemplateId)
+	assert.Equal(t, noteWeaponAtk, (*h.regrants)[0].AssetData.WeaponAttack,
+		"the re-grant must carry the accept_to_note snapshot, not a bare template")
+	assert.Equal(t, []int32{5000}, *h.mesos, "the meso debit must be refunded")
+	assert.ElementsMatch(t, []uuid.UUID{itemId, mesoId}, *h.removed)
+	assert.Empty(t, *h.restored)
+
+	GetCache().Remove(h.tctx, transactionId)
+}
+
+// TestNoteSendRollbackRegrantsWhenTheEscrowAcceptFails is the item-destruction
+// case: the release completed and the accept failed, so no escrow row exists and
+// only the re-grant puts the item back.
+func TestNoteSendRollbackRegrantsWhenTheEscrowAcceptFails(t *testing.T) {
+	h := newNoteAttachmentHarness(t)
+	transactionId, itemId, mesoId := uuid.New(), uuid.New(), uuid.New()
+
+	s := noteSendSaga(t, transactionId, itemId, mesoId, [5]Status{Completed, Failed, Pending, Pending, Pending})
+	require.NoError(t, GetCache().Put(h.tctx, s))
+	require.True(t, GetCache().TryTransition(h.tctx, transactionId, SagaLifecyclePending, SagaLifecycleCompensating))
+
+	h.compensator.DispatchNoteAttachmentRollbacks(s)
+	h.compensator.DispatchNoteAttachmentRollbacks(s)
+
+	assert.Len(t, *h.regrants, 1, "the released item is re-granted exactly once")
+	assert.Empty(t, *h.removed, "the accept FAILED, so no escrow row exists to remove")
+	assert.Empty(t, *h.mesos)
+
+	GetCache().Remove(h.tctx, transactionId)
+}
+
+// TestNoteClaimRollbackRestoresTheAttachment pins the claim direction: a
+// released escrow row whose delivery failed must be restored, or the attachment
+// is neither in the recipient's inventory nor claimable.
+func TestNoteClaimRollbackRestoresTheAttachment(t *testing.T) {
+	h := newNoteAttachmentHarness(t)
+	transactionId, attachmentId := uuid.New(), uuid.New()
+
+	s, err := NewBuilder().
+		SetTransactionId(transactionId).
+		SetSagaType(NoteAttachment).
+		SetInitiatedBy("note-attachment-test").
+		AddStep("release_from_note_"+attachmentId.String(), Completed, ReleaseFromNote, ReleaseFromNotePayload{
+			TransactionId: transactionId, AttachmentId: attachmentId,
+		}).
+		AddStep("accept_to_character_"+attachmentId.String(), Failed, AcceptToCharacter, AcceptToCharacterPayload{
+			TransactionId: transactionId, CharacterId: noteRecipientId, InventoryType: 1, TemplateId: noteTemplate,
+			AssetData: asset2.AssetData{Quantity: 1},
+		}).
+		Build()
+	require.NoError(t, err)
+	require.NoError(t, GetCache().Put(h.tctx, s))
+	require.True(t, GetCache().TryTransition(h.tctx, transactionId, SagaLifecyclePending, SagaLifecycleCompensating))
+
+	h.compensator.DispatchNoteAttachmentRollbacks(s)
+
+	assert.Equal(t, []uuid.UUID{attachmentId}, *h.restored)
+	assert.Empty(t, *h.destroys, "the delivery failed, so there is nothing to destroy")
+
+	GetCache().Remove(h.tctx, transactionId)
+}
+
+// TestExpandWithdrawFromNoteReleasesBeforeDelivering pins the ordering: the
+// release is the compare-and-set that decides which claim owns the row, so it
+// must precede the delivery.
+func TestExpandWithdrawFromNoteReleasesBeforeDelivering(t *testing.T) {
+	p := newTestExpansionProcessor(t)
+	attachmentId := uuid.New()
+
+	steps, err := p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: attachmentId, CharacterId: noteRecipientId,
+		Snapshot: AssetSnapshot{TemplateId: noteTemplate, Quantity: 1, WeaponAttack: noteWeaponAtk},
+	}))
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, ReleaseFromNote, steps[0].Action())
+	assert.Equal(t, AcceptToCharacter, steps[1].Action())
+
+	pl, ok := steps[1].Payload().(AcceptToCharacterPayload)
+	require.True(t, ok)
+	assert.Equal(t, noteRecipientId, pl.CharacterId)
+	assert.Equal(t, byte(1), pl.InventoryType, "the inventory type is derived from the template")
+	assert.Equal(t, noteWeaponAtk, pl.AssetData.WeaponAttack)
+}
+
+// TestExpandWithdrawFromNoteDeliversMesos pins the meso arm and the guard
+// against an attachment that carries nothing.
+func TestExpandWithdrawFromNoteDeliversMesos(t *testing.T) {
+	p := newTestExpansionProcessor(t)
+
+	steps, err := p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: uuid.New(), CharacterId: noteRecipientId, Mesos: 5000,
+	}))
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	pl, ok := steps[1].Payload().(AwardMesosPayload)
+	require.True(t, ok)
+	assert.Equal(t, int32(5000), pl.Amount)
+
+	_, err = p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: uuid.New(), CharacterId: noteRecipientId,
+	}))
+	require.Error(t, err)
+}
+
+// TestExpandWithdrawFromNoteMintsRewardItems pins that a reward attachment —
+// one no character ever held — is generated through award_asset rather than
+// accepted from a template-only snapshot, which would deliver a statless equip.
+func TestExpandWithdrawFromNoteMintsRewardItems(t *testing.T) {
+	p := newTestExpansionProcessor(t)
+
+	steps, err := p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: uuid.New(), CharacterId: noteRecipientId,
+		Snapshot: AssetSnapshot{TemplateId: noteTemplate, Quantity: 1}, Minted: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	pl, ok := steps[1].Payload().(AwardItemActionPayload)
+	require.True(t, ok)
+	assert.Equal(t, noteRecipientId, pl.CharacterId)
+	assert.Equal(t, noteTemplate, pl.Item.TemplateId)
+}
+
+func TestExpandWithdrawFromNoteCreditsRewardCurrency(t *testing.T) {
+	p := newTestExpansionProcessor(t)
+
+	steps, err := p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: uuid.New(), CharacterId: noteRecipientId,
+		Minted: true, AccountId: 77, Currency: 500, CurrencyType: 1,
+	}))
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	pl, ok := steps[1].Payload().(AwardCurrencyPayload)
+	require.True(t, ok)
+	assert.Equal(t, uint32(77), pl.AccountId)
+	assert.Equal(t, uint32(1), pl.CurrencyType)
+	assert.Equal(t, int32(500), pl.Amount)
+
+	_, err = p.expandWithdrawFromNote(NewStep[any]("withdraw", Pending, WithdrawFromNote, WithdrawFromNotePayload{
+		TransactionId: uuid.New(), AttachmentId: uuid.New(), CharacterId: noteRecipientId,
+		Minted: true, Currency: 500, CurrencyType: 1,
+	}))
+	assert.Error(t, err)
+}
@@ -1180,7 +1180,8 @@ func isExpandableAction(a Action) bool {
 	case TransferToStorage, WithdrawFromStorage,
 		TransferToCashShop, WithdrawFromCashShop,
 		TransferToMts, WithdrawFromMts, MtsSettlePurchase,
-		TradeSettlement, TransferToTrade, TradeUnwind:
+		TradeSettlement, TransferToTrade, TradeUnwind,
+		TransferToNote, WithdrawFromNote:
 		return true
 	default:
 		return false
@@ -1226,6 +1227,10 @@ func (p *ProcessorImpl) expandAndProcessStep(s Saga, st Step[any]) error {
 		newSteps, err = p.expandTransferToTrade(st)
 	case TradeUnwind:
 		newSteps, err = p.expandTradeUnwind(st)
+	case TransferToNote:
+		newSteps, err = p.expandTransferToNote(st)
+	case WithdrawFromNote:
+		newSteps, err = p.expandWithdrawFromNote(st)
 	default:
 		return fmt.Errorf("unknown high-level action for expansion: %s", st.Action())
 	}
@@ -1517,6 +1522,193 @@ func (p *ProcessorImpl) expandTransferToTrade(st Step[any]) ([]Step[any], error)
 	return steps, nil
 }
 
+// expandTransferToNote expands transfer_to_note into release_from_character +
+// accept_to_note — one attached item leaving its sender's compartment for
+// atlas-notes' attachment custody. It is expandTransferToTrade with a different
+// custodian: the snapshot is read here, at the last point the asset exists, and
+// carried on the accept so the escrow row can hand back exactly what was sent.
+//
+// An equipped item (negative slot) or an untradeable one without a karma mark is
+// refused before anything moves. Mail is a trade with a delay, so it honours the
+// same restrictions; failing the expansion fails the send with nothing to undo.
+//
+// Step ids are suffixed with the attachment id because a send carries one
+// composite per attached item and the expanded steps must stay distinct.
+func (p *ProcessorImpl) expandTransferToNote(st Step[any]) ([]Step[any], error) {
+	payload, ok := st.Payload().(TransferToNotePayload)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload type for TransferToNote")
+	}
+
+	comp, err := compartment.RequestCompartment(p.l, p.ctx)(payload.CharacterId, payload.SourceInventoryType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lookup character [%d] inventory compartment: %w", payload.CharacterId, err)
+	}
+
+	var foundAsset *compartment.AssetRestModel
+	for i := range comp.Assets {
+		assetId, perr := strconv.ParseUint(comp.Assets[i].Id, 10, 32)
+		if perr != nil {
+			p.l.WithError(perr).Warnf("Asset id [%s] in character [%d]'s compartment is not numeric. Skipping it.", comp.Assets[i].Id, payload.CharacterId)
+			continue
+		}
+		if uint32(assetId) == payload.AssetId {
+			foundAsset = &comp.Assets[i]
+			break
+		}
+	}
+	if foundAsset == nil {
+		return nil, fmt.Errorf("no asset found with id [%d] in character [%d] inventory [%d]",
+			payload.AssetId, payload.CharacterId, payload.SourceInventoryType)
+	}
+	if foundAsset.Slot < 0 {
+		return nil, fmt.Errorf("asset [%d] of character [%d] is equipped and cannot be attached to a note", payload.AssetId, payload.CharacterId)
+	}
+	if af.HasFlag(foundAsset.Flag, af.FlagUntradeable) {
+		if kf, ok := af.KarmaFlagFor(foundAsset.TemplateId); !ok || !af.HasFlag(foundAsset.Flag, kf) {
+			return nil, fmt.Errorf("asset [%d] of character [%d] is untradeable and cannot be attached to a note", payload.AssetId, payload.CharacterId)
+		}
+	}
+
+	quantity := payload.Quantity
+	if quantity == 0 || quantity > foundAsset.Quantity {
+		quantity = foundAsset.Quantity
+	}
+
+	suffix := payload.AttachmentId.String()
+	return []Step[any]{
+		NewStep[any](
+			"release_from_character_"+suffix,
+			Pending,
+			ReleaseFromCharacter,
+			ReleaseFromCharacterPayload{
+				TransactionId: payload.TransactionId,
+				CharacterId:   payload.CharacterId,
+				InventoryType: payload.SourceInventoryType,
+				AssetId:       payload.AssetId,
+				Quantity:      quantity,
+			},
+		),
+		NewStep[any](
+			"accept_to_note_"+suffix,
+			Pending,
+			AcceptToNote,
+			AcceptToNotePayload{
+				TransactionId:       payload.TransactionId,
+				AttachmentId:        payload.AttachmentId,
+				SenderId:            payload.CharacterId,
+				RecipientId:         payload.RecipientId,
+				WorldId:             payload.WorldId,
+				SourceInventoryType: payload.SourceInventoryType,
+				AssetId:             payload.AssetId,
+				Snapshot:            assetSnapshotFromCompartmentAsset(foundAsset, quantity),
+			},
+		),
+	}, nil
+}
+
+// expandWithdrawFromNote expands withdraw_from_note into release_from_note
+// followed by the delivery: accept_to_character for an item attachment,
+// award_asset for a minted reward item, or award_mesos for a meso attachment. The release comes first because it is the
+// compare-and-set that decides which claim or return owns the row; delivering
+// first would mint the item before knowing whether this saga won it.
+//
+// No lookup is needed — the snapshot travels on the composite because the
+// escrow row is the only place the item still exists.
+func (p *ProcessorImpl) expandWithdrawFromNote(st Step[any]) ([]Step[any], error) {
+	payload, ok := st.Payload().(WithdrawFromNotePayload)
+	if !ok {
+		return nil, fmt.Errorf("invalid payload type for WithdrawFromNote")
+	}
+	if payload.Snapshot.TemplateId == 0 && payload.Mesos == 0 && payload.Currency == 0 {
+		return nil, fmt.Errorf("note attachment [%s] carries neither an item, mesos nor currency", payload.AttachmentId)
+	}
+	if payload.Mesos > math.MaxInt32 {
+		return nil, fmt.Errorf("note attachment [%s] mesos exceed int32 range (%d)", payload.AttachmentId, payload.Mesos)
+	}
+	if payload.Currency > math.MaxInt32 {
+		return nil, fmt.Errorf("note attachment [%s] currency exceeds int32 range (%d)", payload.AttachmentId, payload.Currency)
+	}
+	if payload.Currency > 0 && payload.AccountId == 0 {
+		return nil, fmt.Errorf("note attachment [%s] carries currency but no account", payload.AttachmentId)
+	}
+
+	suffix := payload.AttachmentId.String()
+	steps := []Step[any]{
+		NewStep[any](
+			"release_from_note_"+suffix,
+			Pending,
+			ReleaseFromNote,
+			ReleaseFromNotePayload{
+				TransactionId: payload.TransactionId,
+				AttachmentId:  payload.AttachmentId,
+			},
+		),
+	}
+
+	if payload.Mesos > 0 {
+		return append(steps, NewStep[any](
+			"award_mesos_"+suffix,
+			Pending,
+			AwardMesos,
+			AwardMesosPayload{
+				CharacterId: payload.CharacterId,
+				WorldId:     payload.WorldId,
+				ChannelId:   payload.ChannelId,
+				ActorId:     0,
+				ActorType:   "SYSTEM",
+				Amount:      int32(payload.Mesos),
+			},
+		)), nil
+	}
+
+	if payload.Currency > 0 {
+		return append(steps, NewStep[any](
+			"award_currency_"+suffix,
+			Pending,
+			AwardCurrency,
+			AwardCurrencyPayload{
+				CharacterId:  payload.CharacterId,
+				AccountId:    payload.AccountId,
+				CurrencyType: payload.CurrencyType,
+				Amount:       int32(payload.Currency),
+			},
+		)), nil
+	}
+
+	if payload.Minted {
+		return append(steps, NewStep[any](
+			"award_asset_"+suffix,
+			Pending,
+			AwardAsset,
+			AwardItemActionPayload{
+				CharacterId: payload.CharacterId,
+				Item: ItemPayload{
+					TemplateId: payload.Snapshot.TemplateId,
+					Quantity:   payload.Snapshot.Quantity,
+				},
+			},
+		)), nil
+	}
+
+	inventoryType, ok := inventory.TypeFromItemId(item.Id(payload.Snapshot.TemplateId))
+	if !ok {
+		return nil, fmt.Errorf("unable to derive inventory type for attachment template [%d]", payload.Snapshot.TemplateId)
+	}
+	return append(steps, NewStep[any](
+		"accept_to_character_"+suffix,
+		Pending,
+		AcceptToCharacter,
+		AcceptToCharacterPayload{
+			TransactionId: payload.TransactionId,
+			CharacterId:   payload.CharacterId,
+			InventoryType: byte(inventoryType),
+			TemplateId:    payload.Snapshot.TemplateId,
+			AssetData:     assetDataFromSnapshot(payload.Snapshot),
+		},
+	)), nil
+}
+
 // expandTradeSettlement expands the task-205 trade_settlement composite into
 // the concrete two-party swap (design §5A.7).
 //
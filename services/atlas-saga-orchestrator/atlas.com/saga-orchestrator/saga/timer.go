@@ -223,6 +223,7 @@ var reverseWalkSagaTypes = []Type{
 	MtsOperation,
 	TradeTransaction,
 	TradeStaging,
+	NoteAttachment,
 	PetEvolution,
 	ItemTagUse,
 	SealingLockUse,
@@ -262,7 +263,8 @@ var allSagaTypes = []Type{
 	CharacterCreation, StorageOperation, CharacterRespawn, GachaponTransaction,
 	PetEvolution, ItemTagUse, SealingLockUse, IncubatorUse, ExpirationExtenderUse,
 	KarmaScissorsUse, PointReset,
-	MtsOperation, NoteSend, SkillBookUse, MesoSackUse, WorldTransfer, PetNameTagUse,
+	MtsOperation, NoteSend, NoteAttachment, SkillBookUse, MesoSackUse, WorldTransfer,
+	PetNameTagUse,
 }
 
 // dispatchTimeoutRollbacks fires the reverse walk for a timed-out saga and
@@ -291,6 +293,10 @@ func dispatchTimeoutRollbacks(l logrus.FieldLogger, ctx context.Context, s Saga)
 		// release from the compartment completed, the accept into escrow did
 		// not, and nothing puts it back.
 		c.DispatchTradeStagingRollbacks(s)
+	case NoteAttachment:
+		// Same hazard as staging, in both directions: a stalled accept_to_note
+		// strands an attached item, a stalled delivery strands a claimed one.
+		c.DispatchNoteAttachmentRollbacks(s)
 	case PetEvolution:
 		c.DispatchPetEvolutionRollbacks(s)
 	case ItemTagUse, SealingLockUse, IncubatorUse, ExpirationExtenderUse, KarmaScissorsUse:
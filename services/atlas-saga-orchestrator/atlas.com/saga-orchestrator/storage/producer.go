@@ -80,7 +80,7 @@ func DepositRollbackCommandProvider(transactionId uuid.UUID, worldId world.Id, a
 	return producer.SingleMessageProvider(key, value)
 }
 
-func ShowStorageCommandProvider(transactionId uuid.UUID, ch channel.Model, characterId uint32, npcId uint32, accountId uint32) model.Provider[[]kafka.Message] {
+func ShowStorageCommandProvider(transactionId uuid.UUID, ch channel.Model, characterId uint32, npcId uint32, accountId uint32, tab byte) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(characterId))
 	value := &storage2.ShowStorageCommand{
 		TransactionId: transactionId,
@@ -89,6 +89,7 @@ func ShowStorageCommandProvider(transactionId uuid.UUID, ch channel.Model, chara
 		CharacterId:   characterId,
 		NpcId:         npcId,
 		AccountId:     accountId,
+		Tab:           tab,
 		Type:          storage2.CommandTypeShowStorage,
 	}
 	return producer.SingleMessageProvider(key, value)
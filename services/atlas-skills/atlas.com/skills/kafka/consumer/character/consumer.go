@@ -30,9 +30,8 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 		return func(rf func(topic string, handler handler.Handler) (string, error)) error {
 			var t string
 			t, _ = topic.EnvProvider(l)(character.EnvEventTopicStatus)()
-			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventLogout(db)))); err != nil {
-				return err
-			}
+			// LOGOUT is deliberately not handled: cooldowns persist across
+			// logout and channel change until they lapse.
 			if _, err := rf(t, message.AdaptHandler(message.PersistentConfig(handleStatusEventDeleted(db)))); err != nil {
 				return err
 			}
@@ -41,18 +40,6 @@ func InitHandlers(l logrus.FieldLogger) func(db *gorm.DB) func(rf func(topic str
 	}
 }
 
-func handleStatusEventLogout(db *gorm.DB) message.Handler[character.StatusEvent[character.LogoutStatusEventBody]] {
-	return func(l logrus.FieldLogger, ctx context.Context, e character.StatusEvent[character.LogoutStatusEventBody]) {
-		if e.Type != character.EventStatusTypeLogout {
-			return
-		}
-		err := skill.NewProcessor(l, ctx, db).ClearAll(e.CharacterId)
-		if err != nil {
-			l.WithError(err).Errorf("Unable to process logout for character [%d].", e.CharacterId)
-		}
-	}
-}
-
 func handleStatusEventDeleted(db *gorm.DB) message.Handler[character.StatusEvent[character.DeletedStatusEventBody]] {
 	return func(l logrus.FieldLogger, ctx context.Context, e character.StatusEvent[character.DeletedStatusEventBody]) {
 		if e.Type != character.StatusEventTypeDeleted {
@@ -26,27 +26,6 @@ func setupCooldownRegistry(t *testing.T) {
 	skill.InitRegistry(client)
 }
 
-func TestHandleStatusEventLogout(t *testing.T) {
-	setupCooldownRegistry(t)
-	db := test.SetupTestDB(t)
-	defer test.CleanupTestDB(db)
-
-	ctx := test.CreateTestContext()
-	logger, _ := logtest.NewNullLogger()
-
-	characterId := uint32(12345)
-
-	skillProcessor := skill.NewProcessor(logger, ctx, db)
-
-	// The logout handler calls ClearAll which clears cooldowns
-	// Since we can't easily set cooldowns in tests (requires registry),
-	// we just verify the call doesn't error
-	err := skillProcessor.ClearAll(characterId)
-	if err != nil {
-		t.Fatalf("ClearAll() unexpected error: %v", err)
-	}
-}
-
 func TestHandleStatusEventDeleted(t *testing.T) {
 	setupCooldownRegistry(t)
 	db := test.SetupTestDB(t)
@@ -37,7 +37,7 @@ func TestHandleCommandResetCooldowns_TypeGuard(t *testing.T) {
 	logger, _ := logtest.NewNullLogger()
 
 	characterId := uint32(100)
-	if err := skill.GetRegistry().Apply(ctx, characterId, 1311006, 300); err != nil {
+	if err := skill.GetRegistry().Apply(ctx, characterId, 1311006, 300000); err != nil {
 		t.Fatalf("Apply() unexpected error: %v", err)
 	}
 
@@ -67,7 +67,7 @@ func TestHandleCommandResetCooldowns_ClearsRegistry(t *testing.T) {
 
 	characterId := uint32(100)
 	for _, id := range []uint32{5121010, 1311006, 5221006} {
-		if err := skill.GetRegistry().Apply(ctx, characterId, id, 300); err != nil {
+		if err := skill.GetRegistry().Apply(ctx, characterId, id, 300000); err != nil {
 			t.Fatalf("Apply() unexpected error: %v", err)
 		}
 	}
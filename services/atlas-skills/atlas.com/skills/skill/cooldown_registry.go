@@ -37,9 +37,13 @@ func compositeKey(characterId, skillId uint32) string {
 	return fmt.Sprintf("%d:%d", characterId, skillId)
 }
 
+// Apply starts a cooldown of the given length in milliseconds, the unit
+// atlas-data normalizes WZ `cooltime` to. The entry outlives the session:
+// logout and channel change leave it in place, and the expiration task
+// clears it once it lapses.
 func (r *Registry) Apply(ctx context.Context, characterId uint32, skillId uint32, cooldown uint32) error {
 	t := tenant.MustFromContext(ctx)
-	expiresAt := time.Now().Add(time.Duration(cooldown) * time.Second)
+	expiresAt := time.Now().Add(time.Duration(cooldown) * time.Millisecond)
 	err := r.reg.Put(ctx, t, compositeKey(characterId, skillId), expiresAt)
 	if err != nil {
 		return err
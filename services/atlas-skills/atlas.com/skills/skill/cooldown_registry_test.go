@@ -36,7 +36,7 @@ func TestCooldownRegistry_Apply_And_Get(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	err := GetRegistry().Apply(ctx, 1000, 2001001, 30)
+	err := GetRegistry().Apply(ctx, 1000, 2001001, 30000)
 	assert.NoError(t, err)
 
 	expiresAt, err := GetRegistry().Get(ctx, 1000, 2001001)
@@ -59,7 +59,7 @@ func TestCooldownRegistry_Clear(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30)
+	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30000)
 
 	err := GetRegistry().Clear(ctx, 1000, 2001001)
 	assert.NoError(t, err)
@@ -73,9 +73,9 @@ func TestCooldownRegistry_ClearAll(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30)
-	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60)
-	_ = GetRegistry().Apply(ctx, 1000, 2001003, 90)
+	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30000)
+	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60000)
+	_ = GetRegistry().Apply(ctx, 1000, 2001003, 90000)
 
 	err := GetRegistry().ClearAll(ctx, 1000)
 	assert.NoError(t, err)
@@ -93,8 +93,8 @@ func TestCooldownRegistry_ClearAll_DoesNotAffectOtherCharacters(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30)
-	_ = GetRegistry().Apply(ctx, 2000, 2001001, 30)
+	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30000)
+	_ = GetRegistry().Apply(ctx, 2000, 2001001, 30000)
 
 	_ = GetRegistry().ClearAll(ctx, 1000)
 
@@ -121,12 +121,12 @@ func TestCooldownRegistry_ClearAll_NumericPrefixIsolation(t *testing.T) {
 	ctx := cooldownTestCtx(ten)
 
 	// char 100 — target of ClearAll
-	_ = GetRegistry().Apply(ctx, 100, 2001001, 30)
-	_ = GetRegistry().Apply(ctx, 100, 2001002, 60)
+	_ = GetRegistry().Apply(ctx, 100, 2001001, 30000)
+	_ = GetRegistry().Apply(ctx, 100, 2001002, 60000)
 	// char 1000 — numeric prefix of 100 in string form
-	_ = GetRegistry().Apply(ctx, 1000, 3001001, 30)
+	_ = GetRegistry().Apply(ctx, 1000, 3001001, 30000)
 	// char 1001 — another numeric prefix match
-	_ = GetRegistry().Apply(ctx, 1001, 3001002, 30)
+	_ = GetRegistry().Apply(ctx, 1001, 3001002, 30000)
 
 	err := GetRegistry().ClearAll(ctx, 100)
 	assert.NoError(t, err)
@@ -149,9 +149,9 @@ func TestCooldownRegistry_GetAll(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30)
-	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60)
-	_ = GetRegistry().Apply(ctx, 2000, 3001001, 90)
+	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30000)
+	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60000)
+	_ = GetRegistry().Apply(ctx, 2000, 3001001, 90000)
 
 	all := GetRegistry().GetAll(context.Background())
 	assert.Len(t, all, 3)
@@ -213,8 +213,8 @@ func TestCooldownRegistry_MultipleSkillsSameCharacter(t *testing.T) {
 	ten := setupCooldownTestTenant(t)
 	ctx := cooldownTestCtx(ten)
 
-	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30)
-	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60)
+	_ = GetRegistry().Apply(ctx, 1000, 2001001, 30000)
+	_ = GetRegistry().Apply(ctx, 1000, 2001002, 60000)
 
 	exp1, err := GetRegistry().Get(ctx, 1000, 2001001)
 	assert.NoError(t, err)
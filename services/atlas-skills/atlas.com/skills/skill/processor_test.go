@@ -496,13 +496,13 @@ func TestResetCooldowns_ClearsAllButExcepted(t *testing.T) {
 	defer cleanup()
 
 	characterId := uint32(100)
-	if err := skill.GetRegistry().Apply(ctx, characterId, 5121010, 2940); err != nil {
+	if err := skill.GetRegistry().Apply(ctx, characterId, 5121010, 2940000); err != nil {
 		t.Fatalf("Apply() unexpected error: %v", err)
 	}
-	if err := skill.GetRegistry().Apply(ctx, characterId, 1311006, 300); err != nil {
+	if err := skill.GetRegistry().Apply(ctx, characterId, 1311006, 300000); err != nil {
 		t.Fatalf("Apply() unexpected error: %v", err)
 	}
-	if err := skill.GetRegistry().Apply(ctx, characterId, 5221006, 60); err != nil {
+	if err := skill.GetRegistry().Apply(ctx, characterId, 5221006, 60000); err != nil {
 		t.Fatalf("Apply() unexpected error: %v", err)
 	}
 
@@ -584,7 +584,7 @@ func TestResetCooldowns_AllExcepted_NoOp(t *testing.T) {
 	defer cleanup()
 
 	characterId := uint32(100)
-	if err := skill.GetRegistry().Apply(ctx, characterId, 5121010, 2940); err != nil {
+	if err := skill.GetRegistry().Apply(ctx, characterId, 5121010, 2940000); err != nil {
 		t.Fatalf("Apply() unexpected error: %v", err)
 	}
 
@@ -610,7 +610,7 @@ func TestResetCooldowns_MultipleExceptions(t *testing.T) {
 
 	characterId := uint32(100)
 	for _, id := range []uint32{5121010, 1311006, 5221006} {
-		if err := skill.GetRegistry().Apply(ctx, characterId, id, 60); err != nil {
+		if err := skill.GetRegistry().Apply(ctx, characterId, id, 60000); err != nil {
 			t.Fatalf("Apply() unexpected error: %v", err)
 		}
 	}
@@ -50,10 +50,11 @@ func (p *ProcessorImpl) GetAssetsByStorageId(storageId uuid.UUID) ([]Model, erro
 
 // StorageEntity is a minimal storage entity for cross-package queries
 type StorageEntity struct {
-	TenantId  uuid.UUID `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
+	TenantId  uuid.UUID `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
 	Id        uuid.UUID `gorm:"primaryKey;type:uuid"`
-	WorldId   byte      `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
-	AccountId uint32    `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
+	WorldId   byte      `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
+	AccountId uint32    `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
+	Tab       byte      `gorm:"not null;default:0;uniqueIndex:idx_tenant_world_account_tab"`
 	Capacity  uint32    `gorm:"not null;default:4"`
 	Mesos     uint32    `gorm:"not null;default:0"`
 }
@@ -68,7 +69,8 @@ func (p *ProcessorImpl) GetOrCreateStorageId(worldId world.Id, accountId uint32)
 	var id uuid.UUID
 	err := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
 		var storageEntity StorageEntity
-		err := tx.Where("world_id = ? AND account_id = ?", byte(worldId), accountId).
+		// Direct asset creation always targets the main tab (tab 0).
+		err := tx.Where("world_id = ? AND account_id = ? AND tab = ?", byte(worldId), accountId, 0).
 			First(&storageEntity).Error
 		if err == nil {
 			id = storageEntity.Id
@@ -0,0 +1,72 @@
+package configuration
+
+// MesoMode selects where an account's storage mesos live.
+type MesoMode string
+
+const (
+	// MesoModeWorld keeps a separate meso balance on each world's main storage.
+	MesoModeWorld MesoMode = "WORLD"
+	// MesoModeAccount pools mesos on the account-wide shared storage row so
+	// every world sees the same balance.
+	MesoModeAccount MesoMode = "ACCOUNT"
+)
+
+// Model is the immutable per-tenant storage tier configuration. Fields are
+// private with getters; construct via Extract or DefaultConfig.
+type Model struct {
+	extraTabs         byte     // additional per-world tabs beyond the main storage (tabs 1..extraTabs)
+	tabCapacity       uint32   // slot capacity of a newly created extra tab
+	sharedTab         bool     // whether the account-wide shared tab is offered
+	sharedTabCapacity uint32   // slot capacity of a newly created shared tab
+	mesoMode          MesoMode // per-world or account-wide mesos
+}
+
+// NewModel builds a Model directly. It exists for tests and callers that
+// assemble a configuration without going through atlas-tenants.
+func NewModel(extraTabs byte, tabCapacity uint32, sharedTab bool, sharedTabCapacity uint32, mesoMode MesoMode) Model {
+	return Model{
+		extraTabs:         extraTabs,
+		tabCapacity:       tabCapacity,
+		sharedTab:         sharedTab,
+		sharedTabCapacity: sharedTabCapacity,
+		mesoMode:          mesoMode,
+	}
+}
+
+func (m Model) ExtraTabs() byte {
+	return m.extraTabs
+}
+
+func (m Model) TabCapacity() uint32 {
+	return m.tabCapacity
+}
+
+func (m Model) SharedTab() bool {
+	return m.sharedTab
+}
+
+func (m Model) SharedTabCapacity() uint32 {
+	return m.sharedTabCapacity
+}
+
+func (m Model) MesoMode() MesoMode {
+	return m.mesoMode
+}
+
+// AccountMesos reports whether mesos are pooled across the account.
+func (m Model) AccountMesos() bool {
+	return m.mesoMode == MesoModeAccount
+}
+
+// DefaultConfig returns the classic single-storage layout: one tab per world,
+// no shared tab, and per-world mesos. The registry falls back to it whenever
+// the tenant has not configured storage tiers.
+func DefaultConfig() Model {
+	return Model{
+		extraTabs:         0,
+		tabCapacity:       24,
+		sharedTab:         false,
+		sharedTabCapacity: 24,
+		mesoMode:          MesoModeWorld,
+	}
+}
@@ -0,0 +1,61 @@
+package configuration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func testLogger() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(testWriter{})
+	return l
+}
+
+type testWriter struct{}
+
+func (testWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+// On a fetch miss/error, GetTenantConfig must return the classic single-tab
+// defaults rather than propagating the error.
+func TestGetTenantConfigDefaultsOnFetchError(t *testing.T) {
+	calls := 0
+	r := newRegistryWithFetcher(func(_ logrus.FieldLogger, _ context.Context, _ uuid.UUID) (Model, error) {
+		calls++
+		return Model{}, errors.New("fetch failed")
+	})
+
+	tenantId := uuid.New()
+	got := r.GetTenantConfig(testLogger(), context.Background(), tenantId)
+	if got != DefaultConfig() {
+		t.Fatalf("expected defaults on fetch error, got %+v", got)
+	}
+	_ = r.GetTenantConfig(testLogger(), context.Background(), tenantId)
+	if calls != 1 {
+		t.Fatalf("expected fetcher to be invoked once, got %d", calls)
+	}
+}
+
+func TestExtractDefaultsAndClamps(t *testing.T) {
+	m := Extract(RestModel{ExtraTabs: 300, SharedTab: true, MesoMode: "GUILD"})
+	if m.ExtraTabs() != MaxExtraTabs {
+		t.Fatalf("ExtraTabs = %d, want %d", m.ExtraTabs(), MaxExtraTabs)
+	}
+	if m.TabCapacity() != DefaultConfig().TabCapacity() || m.SharedTabCapacity() != DefaultConfig().SharedTabCapacity() {
+		t.Fatalf("zero capacities were not defaulted: %+v", m)
+	}
+	if m.MesoMode() != MesoModeWorld {
+		t.Fatalf("unknown meso mode should fall back to WORLD, got %s", m.MesoMode())
+	}
+	if !m.SharedTab() {
+		t.Fatal("SharedTab should be preserved")
+	}
+
+	m = Extract(RestModel{ExtraTabs: 2, TabCapacity: 48, MesoMode: "ACCOUNT"})
+	if m.ExtraTabs() != 2 || m.TabCapacity() != 48 || !m.AccountMesos() {
+		t.Fatalf("Extract = %+v", m)
+	}
+}
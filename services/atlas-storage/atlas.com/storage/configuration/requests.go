@@ -0,0 +1,30 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Chronicle20/atlas/libs/atlas-rest/requests"
+)
+
+const (
+	configurationsResource = "configurations"
+	storageConfigResource  = "storage-configs"
+)
+
+func getBaseRequest(ctx context.Context) (string, error) {
+	return requests.RootUrlFor(ctx, "TENANTS")
+}
+
+// requestForTenant builds the atlas-tenants fetch for a tenant's storage
+// configuration: GET /tenants/{tenantId}/configurations/storage-configs.
+func requestForTenant(ctx context.Context, tenantId uuid.UUID) requests.Request[RestModel] {
+	root, err := getBaseRequest(ctx)
+	if err != nil {
+		return requests.ErrorRequest[RestModel](err)
+	}
+	url := fmt.Sprintf("%stenants/%s/%s/%s", root, tenantId.String(), configurationsResource, storageConfigResource)
+	return requests.GetRequest[RestModel](url)
+}
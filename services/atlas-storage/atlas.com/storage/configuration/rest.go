@@ -0,0 +1,53 @@
+package configuration
+
+// RestModel is the JSON:API representation of the storage configuration
+// fetched from atlas-tenants.
+type RestModel struct {
+	Id                string `json:"-"`
+	ExtraTabs         int    `json:"extraTabs"`
+	TabCapacity       int    `json:"tabCapacity"`
+	SharedTab         bool   `json:"sharedTab"`
+	SharedTabCapacity int    `json:"sharedTabCapacity"`
+	MesoMode          string `json:"mesoMode"`
+}
+
+func (r RestModel) GetName() string {
+	return "storage-configs"
+}
+
+func (r RestModel) GetID() string {
+	return r.Id
+}
+
+func (r *RestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+// MaxExtraTabs bounds the number of extra tabs so tab numbers never collide
+// with the shared tab.
+const MaxExtraTabs = 254
+
+// Extract converts the fetched RestModel into the immutable domain Model,
+// substituting the default for any capacity left at zero and any unknown meso
+// mode.
+func Extract(r RestModel) Model {
+	d := DefaultConfig()
+	m := Model{
+		tabCapacity:       uint32(max(r.TabCapacity, 0)),
+		sharedTab:         r.SharedTab,
+		sharedTabCapacity: uint32(max(r.SharedTabCapacity, 0)),
+		mesoMode:          MesoMode(r.MesoMode),
+	}
+	m.extraTabs = byte(min(max(r.ExtraTabs, 0), MaxExtraTabs))
+	if m.tabCapacity == 0 {
+		m.tabCapacity = d.tabCapacity
+	}
+	if m.sharedTabCapacity == 0 {
+		m.sharedTabCapacity = d.sharedTabCapacity
+	}
+	if m.mesoMode != MesoModeWorld && m.mesoMode != MesoModeAccount {
+		m.mesoMode = d.mesoMode
+	}
+	return m
+}
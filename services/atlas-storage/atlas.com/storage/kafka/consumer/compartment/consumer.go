@@ -50,10 +50,17 @@ func handleAcceptCommand(db *gorm.DB) kafkaMessage.Handler[compartment.Command[c
 			return
 		}
 
+		// Items land in whichever tab the character has open; with no open
+		// projection they go to the main tab.
+		tab := storage.TabMain
+		if proj, ok := projection.GetManager().Get(ctx, c.CharacterId); ok {
+			tab = proj.Tab()
+		}
+
 		// Guarded: ACCEPT creates a durable storage asset and Kafka delivery is
 		// at-least-once (task-208). The claim lives in the processor so it can
 		// cover the DB write without enclosing the direct Kafka emit.
-		err := storage.NewProcessor(l, ctx, db).AcceptOnceAndEmit(c.WorldId, c.AccountId, c.CharacterId, c.Body)
+		err := storage.NewProcessor(l, ctx, db).AcceptOnceAndEmit(c.WorldId, c.AccountId, c.CharacterId, tab, c.Body)
 		if err != nil {
 			l.WithError(err).Errorf("Unable to accept item for account [%d] world [%d] transaction [%s].", c.AccountId, c.WorldId, c.Body.TransactionId)
 			return
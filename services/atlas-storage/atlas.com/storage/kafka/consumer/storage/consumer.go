@@ -119,7 +119,7 @@ func handleArrangeCommand(db *gorm.DB) kafkaMessage.Handler[message.Command[mess
 			return
 		}
 
-		err := storage.NewProcessor(l, ctx, db).ArrangeAndEmit(c.TransactionId, c.WorldId, c.AccountId)
+		err := storage.NewProcessor(l, ctx, db).ArrangeAndEmit(c.TransactionId, c.WorldId, c.AccountId, c.Body.Tab)
 		if err != nil {
 			l.WithError(err).Errorf("Unable to arrange storage for account [%d] world [%d].", c.AccountId, c.WorldId)
 		}
@@ -132,14 +132,14 @@ func handleShowStorageCommand(db *gorm.DB) kafkaMessage.Handler[message.ShowStor
 			return
 		}
 
-		l.Debugf("Received ShowStorage command for character [%d], NPC [%d], account [%d], world [%d]",
-			c.CharacterId, c.NpcId, c.AccountId, c.WorldId)
+		l.Debugf("Received ShowStorage command for character [%d], NPC [%d], account [%d], world [%d], tab [%d]",
+			c.CharacterId, c.NpcId, c.AccountId, c.WorldId, c.Tab)
 
-		// Build the projection from storage data
-		proj, err := projection.BuildProjection(l, db.WithContext(ctx))(c.CharacterId, c.AccountId, c.WorldId, c.NpcId)
+		// Build the projection from the requested storage tab
+		proj, err := projection.BuildProjection(l, ctx, db)(c.CharacterId, c.AccountId, c.WorldId, c.NpcId, c.Tab)
 		if err != nil {
-			l.WithError(err).Errorf("Failed to build projection for character [%d], account [%d], world [%d]",
-				c.CharacterId, c.AccountId, c.WorldId)
+			l.WithError(err).Errorf("Failed to build projection for character [%d], account [%d], world [%d], tab [%d]",
+				c.CharacterId, c.AccountId, c.WorldId, c.Tab)
 			return
 		}
 
@@ -151,7 +151,7 @@ func handleShowStorageCommand(db *gorm.DB) kafkaMessage.Handler[message.ShowStor
 
 		// Emit PROJECTION_CREATED event
 		ch := channel.NewModel(c.WorldId, c.ChannelId)
-		err = storage.NewProcessor(l, ctx, db).EmitProjectionCreatedEvent(c.CharacterId, c.AccountId, ch, c.NpcId)
+		err = storage.NewProcessor(l, ctx, db).EmitProjectionCreatedEvent(c.CharacterId, c.AccountId, ch, c.NpcId, c.Tab)
 		if err != nil {
 			l.WithError(err).Errorf("Failed to emit PROJECTION_CREATED event for character [%d]", c.CharacterId)
 		}
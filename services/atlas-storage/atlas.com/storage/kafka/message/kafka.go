@@ -144,11 +144,16 @@ type MesosUpdatedEventBody struct {
 	NewMesos uint32 `json:"newMesos"`
 }
 
-// ArrangeBody contains the data needed to arrange storage (empty for now)
-type ArrangeBody struct{}
+// ArrangeBody contains the data needed to arrange storage. Tab selects the
+// storage tab to arrange; omitted means the main tab.
+type ArrangeBody struct {
+	Tab byte `json:"tab,omitempty"`
+}
 
 // ArrangedEventBody contains the data for an arranged event
-type ArrangedEventBody struct{}
+type ArrangedEventBody struct {
+	Tab byte `json:"tab,omitempty"`
+}
 
 // ErrorEventBody contains the data for an error event
 type ErrorEventBody struct {
@@ -164,6 +169,7 @@ type ShowStorageCommand struct {
 	CharacterId   uint32     `json:"characterId"`
 	NpcId         uint32     `json:"npcId"`
 	AccountId     uint32     `json:"accountId"`
+	Tab           byte       `json:"tab,omitempty"`
 	Type          string     `json:"type"`
 }
 
@@ -180,6 +186,7 @@ type ProjectionCreatedEventBody struct {
 	WorldId     world.Id   `json:"worldId"`
 	ChannelId   channel.Id `json:"channelId"`
 	NpcId       uint32     `json:"npcId"`
+	Tab         byte       `json:"tab,omitempty"`
 }
 
 // ProjectionDestroyedEventBody contains the data for a projection destroyed event
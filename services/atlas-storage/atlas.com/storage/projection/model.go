@@ -18,6 +18,7 @@ type Model struct {
 	accountId    uint32
 	worldId      world.Id
 	storageId    uuid.UUID
+	tab          byte
 	capacity     uint32
 	mesos        uint32
 	npcId        uint32
@@ -40,6 +41,11 @@ func (m Model) StorageId() uuid.UUID {
 	return m.storageId
 }
 
+// Tab is the storage tab the character opened.
+func (m Model) Tab() byte {
+	return m.tab
+}
+
 func (m Model) Capacity() uint32 {
 	return m.capacity
 }
@@ -79,6 +85,7 @@ type Builder struct {
 	accountId    uint32
 	worldId      world.Id
 	storageId    uuid.UUID
+	tab          byte
 	capacity     uint32
 	mesos        uint32
 	npcId        uint32
@@ -111,6 +118,11 @@ func (b *Builder) SetStorageId(storageId uuid.UUID) *Builder {
 	return b
 }
 
+func (b *Builder) SetTab(tab byte) *Builder {
+	b.tab = tab
+	return b
+}
+
 func (b *Builder) SetCapacity(capacity uint32) *Builder {
 	b.capacity = capacity
 	return b
@@ -153,6 +165,7 @@ func (b *Builder) Build() (Model, error) {
 		accountId:    b.accountId,
 		worldId:      b.worldId,
 		storageId:    b.storageId,
+		tab:          b.tab,
 		capacity:     b.capacity,
 		mesos:        b.mesos,
 		npcId:        b.npcId,
@@ -179,6 +192,7 @@ func (m Model) MarshalJSON() ([]byte, error) {
 		AccountId    uint32                   `json:"accountId"`
 		WorldId      world.Id                 `json:"worldId"`
 		StorageId    uuid.UUID                `json:"storageId"`
+		Tab          byte                     `json:"tab"`
 		Capacity     uint32                   `json:"capacity"`
 		Mesos        uint32                   `json:"mesos"`
 		NpcId        uint32                   `json:"npcId"`
@@ -188,6 +202,7 @@ func (m Model) MarshalJSON() ([]byte, error) {
 		AccountId:    m.accountId,
 		WorldId:      m.worldId,
 		StorageId:    m.storageId,
+		Tab:          m.tab,
 		Capacity:     m.capacity,
 		Mesos:        m.mesos,
 		NpcId:        m.npcId,
@@ -201,6 +216,7 @@ func (m *Model) UnmarshalJSON(data []byte) error {
 		AccountId    uint32                   `json:"accountId"`
 		WorldId      world.Id                 `json:"worldId"`
 		StorageId    uuid.UUID                `json:"storageId"`
+		Tab          byte                     `json:"tab"`
 		Capacity     uint32                   `json:"capacity"`
 		Mesos        uint32                   `json:"mesos"`
 		NpcId        uint32                   `json:"npcId"`
@@ -213,6 +229,7 @@ func (m *Model) UnmarshalJSON(data []byte) error {
 	m.accountId = aux.AccountId
 	m.worldId = aux.WorldId
 	m.storageId = aux.StorageId
+	m.tab = aux.Tab
 	m.capacity = aux.Capacity
 	m.mesos = aux.Mesos
 	m.npcId = aux.NpcId
@@ -242,6 +259,7 @@ func Clone(m Model) *Builder {
 		accountId:    m.accountId,
 		worldId:      m.worldId,
 		storageId:    m.storageId,
+		tab:          m.tab,
 		capacity:     m.capacity,
 		mesos:        m.mesos,
 		npcId:        m.npcId,
@@ -3,6 +3,7 @@ package projection
 import (
 	"atlas-storage/asset"
 	"atlas-storage/storage"
+	"context"
 
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -11,15 +12,23 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
-// BuildProjection creates a new projection from storage data.
-// Assets are grouped by their inventory type into compartments.
-func BuildProjection(l logrus.FieldLogger, db *gorm.DB) func(characterId uint32, accountId uint32, worldId world.Id, npcId uint32) (Model, error) {
-	return func(characterId uint32, accountId uint32, worldId world.Id, npcId uint32) (Model, error) {
-		s, err := storage.GetByWorldAndAccountId(l, db)(worldId, accountId)
+// BuildProjection creates a new projection from one storage tab.
+// Assets are grouped by their inventory type into compartments. Mesos come
+// from whichever row holds the account's storage mesos under the tenant's
+// meso mode, so every tab shows the same balance.
+func BuildProjection(l logrus.FieldLogger, ctx context.Context, db *gorm.DB) func(characterId uint32, accountId uint32, worldId world.Id, npcId uint32, tab byte) (Model, error) {
+	return func(characterId uint32, accountId uint32, worldId world.Id, npcId uint32, tab byte) (Model, error) {
+		p := storage.NewProcessor(l, ctx, db)
+		s, err := p.GetOrCreateTab(worldId, accountId, tab)
 		if err != nil {
 			return Model{}, err
 		}
 
+		var mesos uint32
+		if ms, err := p.GetMesoStorage(worldId, accountId); err == nil {
+			mesos = ms.Mesos()
+		}
+
 		// Group assets by inventory type
 		compartments := make(map[inventory.Type][]asset.Model)
 		for _, a := range s.Assets() {
@@ -32,8 +41,9 @@ func BuildProjection(l logrus.FieldLogger, db *gorm.DB) func(characterId uint32,
 			SetAccountId(accountId).
 			SetWorldId(worldId).
 			SetStorageId(s.Id()).
+			SetTab(tab).
 			SetCapacity(s.Capacity()).
-			SetMesos(s.Mesos()).
+			SetMesos(mesos).
 			SetNpcId(npcId).
 			SetCompartments(compartments).
 			MustBuild(), nil
@@ -15,6 +15,7 @@ type RestModel struct {
 	AccountId    uint32                       `json:"accountId"`
 	WorldId      world.Id                     `json:"worldId"`
 	StorageId    string                       `json:"storageId"`
+	Tab          byte                         `json:"tab"`
 	Capacity     uint32                       `json:"capacity"`
 	Mesos        uint32                       `json:"mesos"`
 	NpcId        uint32                       `json:"npcId"`
@@ -52,6 +53,7 @@ func Transform(m Model) (RestModel, error) {
 		AccountId:    m.AccountId(),
 		WorldId:      m.WorldId(),
 		StorageId:    m.StorageId().String(),
+		Tab:          m.Tab(),
 		Capacity:     m.Capacity(),
 		Mesos:        m.Mesos(),
 		NpcId:        m.NpcId(),
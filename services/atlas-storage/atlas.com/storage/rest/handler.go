@@ -54,6 +54,24 @@ func ParseWorldId(l logrus.FieldLogger, next func(world.Id) http.HandlerFunc) ht
 	}
 }
 
+// ParseTab reads the optional tab query parameter, defaulting to the main tab (0).
+func ParseTab(l logrus.FieldLogger, next func(byte) http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tabStr := r.URL.Query().Get("tab")
+		if tabStr == "" {
+			next(0)(w, r)
+			return
+		}
+		tab, err := strconv.ParseUint(tabStr, 10, 8)
+		if err != nil {
+			l.WithError(err).Errorf("Error parsing tab")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		next(byte(tab))(w, r)
+	}
+}
+
 func ParseAssetId(l logrus.FieldLogger, next func(uint32) http.HandlerFunc) http.HandlerFunc {
 	return server.ParseIntId[uint32](l, "assetId", next)
 }
@@ -8,14 +8,23 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
-// Create creates a new storage for an account in a world
+// Create creates the main storage tab for an account in a world
 func Create(l logrus.FieldLogger, db *gorm.DB, tenantId uuid.UUID) func(worldId world.Id, accountId uint32) (Model, error) {
 	return func(worldId world.Id, accountId uint32) (Model, error) {
+		return CreateTab(l, db, tenantId)(worldId, accountId, TabMain, mainTabCapacity)
+	}
+}
+
+// CreateTab creates a storage tab for an account. The shared tab is created
+// under SharedWorldId regardless of worldId.
+func CreateTab(l logrus.FieldLogger, db *gorm.DB, tenantId uuid.UUID) func(worldId world.Id, accountId uint32, tab byte, capacity uint32) (Model, error) {
+	return func(worldId world.Id, accountId uint32, tab byte, capacity uint32) (Model, error) {
 		e := Entity{
 			TenantId:  tenantId,
-			WorldId:   byte(worldId),
+			WorldId:   byte(rowWorldId(worldId, tab)),
 			AccountId: accountId,
-			Capacity:  4,
+			Tab:       tab,
+			Capacity:  capacity,
 			Mesos:     0,
 		}
 		err := db.Create(&e).Error
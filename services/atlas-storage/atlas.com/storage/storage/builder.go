@@ -14,6 +14,7 @@ type ModelBuilder struct {
 	id        uuid.UUID
 	worldId   world.Id
 	accountId uint32
+	tab       byte
 	capacity  uint32
 	mesos     uint32
 	assets    []asset.Model
@@ -41,6 +42,11 @@ func (b *ModelBuilder) SetAccountId(accountId uint32) *ModelBuilder {
 	return b
 }
 
+func (b *ModelBuilder) SetTab(tab byte) *ModelBuilder {
+	b.tab = tab
+	return b
+}
+
 func (b *ModelBuilder) SetCapacity(capacity uint32) *ModelBuilder {
 	b.capacity = capacity
 	return b
@@ -77,6 +83,7 @@ func (b *ModelBuilder) Build() (Model, error) {
 		id:        b.id,
 		worldId:   b.worldId,
 		accountId: b.accountId,
+		tab:       b.tab,
 		capacity:  b.capacity,
 		mesos:     b.mesos,
 		assets:    b.assets,
@@ -99,6 +106,7 @@ func Clone(m Model) *ModelBuilder {
 		id:        m.id,
 		worldId:   m.worldId,
 		accountId: m.accountId,
+		tab:       m.tab,
 		capacity:  m.capacity,
 		mesos:     m.mesos,
 		assets:    m.assets,
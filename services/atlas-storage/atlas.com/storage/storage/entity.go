@@ -8,10 +8,11 @@ import (
 )
 
 type Entity struct {
-	TenantId  uuid.UUID `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
+	TenantId  uuid.UUID `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
 	Id        uuid.UUID `gorm:"primaryKey;type:uuid"`
-	WorldId   byte      `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
-	AccountId uint32    `gorm:"not null;uniqueIndex:idx_tenant_world_account"`
+	WorldId   byte      `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
+	AccountId uint32    `gorm:"not null;uniqueIndex:idx_tenant_world_account_tab"`
+	Tab       byte      `gorm:"not null;default:0;uniqueIndex:idx_tenant_world_account_tab"`
 	Capacity  uint32    `gorm:"not null;default:4"`
 	Mesos     uint32    `gorm:"not null;default:0"`
 }
@@ -28,6 +29,13 @@ func (e *Entity) BeforeCreate(_ *gorm.DB) error {
 }
 
 func Migration(db *gorm.DB) error {
+	// The pre-tab unique index (tenant, world, account) would reject a second
+	// tab for the same account; drop it before AutoMigrate adds the tab-aware one.
+	if db.Migrator().HasTable(&Entity{}) && db.Migrator().HasIndex(&Entity{}, "idx_tenant_world_account") {
+		if err := db.Migrator().DropIndex(&Entity{}, "idx_tenant_world_account"); err != nil {
+			return err
+		}
+	}
 	return db.AutoMigrate(&Entity{})
 }
 
@@ -38,6 +46,7 @@ func Make(e Entity) Model {
 		SetId(e.Id).
 		SetWorldId(world.Id(e.WorldId)).
 		SetAccountId(e.AccountId).
+		SetTab(e.Tab).
 		SetCapacity(e.Capacity).
 		SetMesos(e.Mesos).
 		MustBuild()
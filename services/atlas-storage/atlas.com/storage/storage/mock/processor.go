@@ -13,6 +13,9 @@ import (
 
 type ProcessorMock struct {
 	GetOrCreateStorageFunc            func(worldId world.Id, accountId uint32) (storage.Model, error)
+	GetOrCreateTabFunc                func(worldId world.Id, accountId uint32, tab byte) (storage.Model, error)
+	GetTabsFunc                       func(worldId world.Id, accountId uint32) ([]storage.Model, error)
+	GetMesoStorageFunc                func(worldId world.Id, accountId uint32) (storage.Model, error)
 	GetStorageByWorldAndAccountIdFunc func(worldId world.Id, accountId uint32) (storage.Model, error)
 	CreateStorageFunc                 func(worldId world.Id, accountId uint32) (storage.Model, error)
 	DepositFunc                       func(worldId world.Id, accountId uint32, body message.DepositBody) (uint32, error)
@@ -22,15 +25,15 @@ type ProcessorMock struct {
 	UpdateMesosFunc                   func(worldId world.Id, accountId uint32, body message.UpdateMesosBody) error
 	UpdateMesosAndEmitFunc            func(transactionId uuid.UUID, worldId world.Id, accountId uint32, body message.UpdateMesosBody) error
 	DepositRollbackFunc               func(body message.DepositRollbackBody) error
-	AcceptFunc                        func(worldId world.Id, accountId uint32, body compartment.AcceptCommandBody) (uint32, int16, error)
-	AcceptAndEmitFunc                 func(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error
-	AcceptOnceAndEmitFunc             func(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error
+	AcceptFunc                        func(worldId world.Id, accountId uint32, tab byte, body compartment.AcceptCommandBody) (uint32, int16, error)
+	AcceptAndEmitFunc                 func(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error
+	AcceptOnceAndEmitFunc             func(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error
 	ReleaseFunc                       func(body compartment.ReleaseCommandBody) error
 	ReleaseAndEmitFunc                func(worldId world.Id, accountId uint32, characterId uint32, body compartment.ReleaseCommandBody) error
 	ReleaseOnceAndEmitFunc            func(worldId world.Id, accountId uint32, characterId uint32, body compartment.ReleaseCommandBody) error
-	MergeAndSortFunc                  func(worldId world.Id, accountId uint32) error
-	ArrangeAndEmitFunc                func(transactionId uuid.UUID, worldId world.Id, accountId uint32) error
-	EmitProjectionCreatedEventFunc    func(characterId uint32, accountId uint32, ch channel.Model, npcId uint32) error
+	MergeAndSortFunc                  func(worldId world.Id, accountId uint32, tab byte) error
+	ArrangeAndEmitFunc                func(transactionId uuid.UUID, worldId world.Id, accountId uint32, tab byte) error
+	EmitProjectionCreatedEventFunc    func(characterId uint32, accountId uint32, ch channel.Model, npcId uint32, tab byte) error
 	ExpireAndEmitFunc                 func(transactionId uuid.UUID, worldId world.Id, accountId uint32, assetId uint32, isCash bool, replaceItemId uint32, replaceMessage string) error
 	DeleteByAccountIdFunc             func(accountId uint32) error
 	EmitProjectionDestroyedEventFunc  func(characterId uint32, accountId uint32, worldId world.Id) error
@@ -45,6 +48,27 @@ func (m *ProcessorMock) GetOrCreateStorage(worldId world.Id, accountId uint32) (
 	return storage.Model{}, nil
 }
 
+func (m *ProcessorMock) GetOrCreateTab(worldId world.Id, accountId uint32, tab byte) (storage.Model, error) {
+	if m.GetOrCreateTabFunc != nil {
+		return m.GetOrCreateTabFunc(worldId, accountId, tab)
+	}
+	return storage.Model{}, nil
+}
+
+func (m *ProcessorMock) GetTabs(worldId world.Id, accountId uint32) ([]storage.Model, error) {
+	if m.GetTabsFunc != nil {
+		return m.GetTabsFunc(worldId, accountId)
+	}
+	return nil, nil
+}
+
+func (m *ProcessorMock) GetMesoStorage(worldId world.Id, accountId uint32) (storage.Model, error) {
+	if m.GetMesoStorageFunc != nil {
+		return m.GetMesoStorageFunc(worldId, accountId)
+	}
+	return storage.Model{}, nil
+}
+
 func (m *ProcessorMock) GetStorageByWorldAndAccountId(worldId world.Id, accountId uint32) (storage.Model, error) {
 	if m.GetStorageByWorldAndAccountIdFunc != nil {
 		return m.GetStorageByWorldAndAccountIdFunc(worldId, accountId)
@@ -108,23 +132,23 @@ func (m *ProcessorMock) DepositRollback(body message.DepositRollbackBody) error
 	return nil
 }
 
-func (m *ProcessorMock) Accept(worldId world.Id, accountId uint32, body compartment.AcceptCommandBody) (uint32, int16, error) {
+func (m *ProcessorMock) Accept(worldId world.Id, accountId uint32, tab byte, body compartment.AcceptCommandBody) (uint32, int16, error) {
 	if m.AcceptFunc != nil {
-		return m.AcceptFunc(worldId, accountId, body)
+		return m.AcceptFunc(worldId, accountId, tab, body)
 	}
 	return 0, 0, nil
 }
 
-func (m *ProcessorMock) AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error {
+func (m *ProcessorMock) AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error {
 	if m.AcceptAndEmitFunc != nil {
-		return m.AcceptAndEmitFunc(worldId, accountId, characterId, body)
+		return m.AcceptAndEmitFunc(worldId, accountId, characterId, tab, body)
 	}
 	return nil
 }
 
-func (m *ProcessorMock) AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error {
+func (m *ProcessorMock) AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error {
 	if m.AcceptOnceAndEmitFunc != nil {
-		return m.AcceptOnceAndEmitFunc(worldId, accountId, characterId, body)
+		return m.AcceptOnceAndEmitFunc(worldId, accountId, characterId, tab, body)
 	}
 	return nil
 }
@@ -150,23 +174,23 @@ func (m *ProcessorMock) ReleaseAndEmit(worldId world.Id, accountId uint32, chara
 	return nil
 }
 
-func (m *ProcessorMock) MergeAndSort(worldId world.Id, accountId uint32) error {
+func (m *ProcessorMock) MergeAndSort(worldId world.Id, accountId uint32, tab byte) error {
 	if m.MergeAndSortFunc != nil {
-		return m.MergeAndSortFunc(worldId, accountId)
+		return m.MergeAndSortFunc(worldId, accountId, tab)
 	}
 	return nil
 }
 
-func (m *ProcessorMock) ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32) error {
+func (m *ProcessorMock) ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, tab byte) error {
 	if m.ArrangeAndEmitFunc != nil {
-		return m.ArrangeAndEmitFunc(transactionId, worldId, accountId)
+		return m.ArrangeAndEmitFunc(transactionId, worldId, accountId, tab)
 	}
 	return nil
 }
 
-func (m *ProcessorMock) EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32) error {
+func (m *ProcessorMock) EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32, tab byte) error {
 	if m.EmitProjectionCreatedEventFunc != nil {
-		return m.EmitProjectionCreatedEventFunc(characterId, accountId, ch, npcId)
+		return m.EmitProjectionCreatedEventFunc(characterId, accountId, ch, npcId, tab)
 	}
 	return nil
 }
@@ -13,6 +13,7 @@ type Model struct {
 	id        uuid.UUID
 	worldId   world.Id
 	accountId uint32
+	tab       byte
 	capacity  uint32
 	mesos     uint32
 	assets    []asset.Model
@@ -30,6 +31,15 @@ func (m Model) AccountId() uint32 {
 	return m.accountId
 }
 
+func (m Model) Tab() byte {
+	return m.tab
+}
+
+// IsShared reports whether this is the account-wide shared tab.
+func (m Model) IsShared() bool {
+	return m.tab == TabShared
+}
+
 func (m Model) Capacity() uint32 {
 	return m.capacity
 }
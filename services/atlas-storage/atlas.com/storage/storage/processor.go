@@ -2,6 +2,7 @@ package storage
 
 import (
 	"atlas-storage/asset"
+	"atlas-storage/configuration"
 	"atlas-storage/data/consumable"
 	"atlas-storage/data/etc"
 	"atlas-storage/data/setup"
@@ -29,6 +30,9 @@ import (
 
 type Processor interface {
 	GetOrCreateStorage(worldId world.Id, accountId uint32) (Model, error)
+	GetOrCreateTab(worldId world.Id, accountId uint32, tab byte) (Model, error)
+	GetTabs(worldId world.Id, accountId uint32) ([]Model, error)
+	GetMesoStorage(worldId world.Id, accountId uint32) (Model, error)
 	GetStorageByWorldAndAccountId(worldId world.Id, accountId uint32) (Model, error)
 	CreateStorage(worldId world.Id, accountId uint32) (Model, error)
 	Deposit(worldId world.Id, accountId uint32, body message.DepositBody) (uint32, error)
@@ -38,15 +42,15 @@ type Processor interface {
 	UpdateMesos(worldId world.Id, accountId uint32, body message.UpdateMesosBody) error
 	UpdateMesosAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, body message.UpdateMesosBody) error
 	DepositRollback(body message.DepositRollbackBody) error
-	Accept(worldId world.Id, accountId uint32, body compartment.AcceptCommandBody) (uint32, int16, error)
-	AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error
-	AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error
+	Accept(worldId world.Id, accountId uint32, tab byte, body compartment.AcceptCommandBody) (uint32, int16, error)
+	AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error
+	AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error
 	Release(body compartment.ReleaseCommandBody) error
 	ReleaseAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.ReleaseCommandBody) error
 	ReleaseOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.ReleaseCommandBody) error
-	MergeAndSort(worldId world.Id, accountId uint32) error
-	ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32) error
-	EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32) error
+	MergeAndSort(worldId world.Id, accountId uint32, tab byte) error
+	ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, tab byte) error
+	EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32, tab byte) error
 	ExpireAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, assetId uint32, isCash bool, replaceItemId uint32, replaceMessage string) error
 	DeleteByAccountId(accountId uint32) error
 	EmitProjectionDestroyedEvent(characterId uint32, accountId uint32, worldId world.Id) error
@@ -74,14 +78,128 @@ func (p *ProcessorImpl) WithTransaction(tx *gorm.DB) *ProcessorImpl {
 }
 
 func (p *ProcessorImpl) GetOrCreateStorage(worldId world.Id, accountId uint32) (Model, error) {
+	return p.getOrCreateTab(worldId, accountId, TabMain, mainTabCapacity)
+}
+
+// GetOrCreateTab returns one of the account's storage tabs, creating it on first
+// use with the configured capacity. Tabs the tenant's storage configuration
+// does not offer yield ErrTabUnavailable.
+func (p *ProcessorImpl) GetOrCreateTab(worldId world.Id, accountId uint32, tab byte) (Model, error) {
+	cfg := p.config()
+	if !tabAvailable(cfg, tab) {
+		return Model{}, ErrTabUnavailable
+	}
+	return p.getOrCreateTab(worldId, accountId, tab, tabCapacity(cfg, tab))
+}
+
+// GetTabs returns every tab the tenant's storage configuration offers the
+// account from a world, in tab order, creating any that do not exist yet.
+func (p *ProcessorImpl) GetTabs(worldId world.Id, accountId uint32) ([]Model, error) {
+	cfg := p.config()
+	tabs := availableTabs(cfg)
+	results := make([]Model, 0, len(tabs))
+	for _, tab := range tabs {
+		s, err := p.getOrCreateTab(worldId, accountId, tab, tabCapacity(cfg, tab))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, s)
+	}
+	return results, nil
+}
+
+// GetMesoStorage returns the row holding the account's storage mesos for a
+// world. In world meso mode that is the world's main tab; in account meso mode
+// it is the account-wide row, which absorbs any balance still sitting on the
+// world's main tab the first time it is resolved.
+func (p *ProcessorImpl) GetMesoStorage(worldId world.Id, accountId uint32) (Model, error) {
+	if !p.config().AccountMesos() {
+		return GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+	}
+
+	var result Model
+	err := database.ExecuteTransaction(p.db.WithContext(p.ctx), func(tx *gorm.DB) error {
+		shared, err := p.WithTransaction(tx).getOrCreateTab(worldId, accountId, TabShared, p.config().SharedTabCapacity())
+		if err != nil {
+			return err
+		}
+		main, err := GetByWorldAndAccountId(p.l, tx)(worldId, accountId)
+		if err != nil || main.Mesos() == 0 {
+			result = shared
+			return nil
+		}
+		if err = UpdateMesos(p.l, tx)(shared.Id(), shared.Mesos()+main.Mesos()); err != nil {
+			return err
+		}
+		if err = UpdateMesos(p.l, tx)(main.Id(), 0); err != nil {
+			return err
+		}
+		p.l.Debugf("Folded [%d] world [%d] storage mesos into the account-wide balance for account [%d].", main.Mesos(), worldId, accountId)
+		result = Clone(shared).SetMesos(shared.Mesos() + main.Mesos()).MustBuild()
+		return nil
+	})
+	return result, err
+}
+
+func (p *ProcessorImpl) getOrCreateTab(worldId world.Id, accountId uint32, tab byte, capacity uint32) (Model, error) {
 	t := tenant.MustFromContext(p.ctx)
 
-	s, err := GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+	s, err := GetByWorldAccountAndTab(p.l, p.db.WithContext(p.ctx))(worldId, accountId, tab)
 	if err == nil {
 		return s, nil
 	}
 
-	return Create(p.l, p.db.WithContext(p.ctx), t.Id())(worldId, accountId)
+	return CreateTab(p.l, p.db.WithContext(p.ctx), t.Id())(worldId, accountId, tab, capacity)
+}
+
+func (p *ProcessorImpl) config() configuration.Model {
+	t := tenant.MustFromContext(p.ctx)
+	return configuration.GetRegistry().GetTenantConfig(p.l, p.ctx, t.Id())
+}
+
+// tabAvailable reports whether the configuration offers a tab.
+func tabAvailable(cfg configuration.Model, tab byte) bool {
+	if tab == TabMain {
+		return true
+	}
+	if tab == TabShared {
+		return cfg.SharedTab()
+	}
+	return tab <= cfg.ExtraTabs()
+}
+
+// tabCapacity is the capacity a tab is created with.
+func tabCapacity(cfg configuration.Model, tab byte) uint32 {
+	switch tab {
+	case TabMain:
+		return mainTabCapacity
+	case TabShared:
+		return cfg.SharedTabCapacity()
+	default:
+		return cfg.TabCapacity()
+	}
+}
+
+// availableTabs lists the tabs a configuration offers, in tab order.
+func availableTabs(cfg configuration.Model) []byte {
+	tabs := []byte{TabMain}
+	for tab := byte(1); tab <= cfg.ExtraTabs(); tab++ {
+		tabs = append(tabs, tab)
+	}
+	if cfg.SharedTab() {
+		tabs = append(tabs, TabShared)
+	}
+	return tabs
+}
+
+// shareable reports whether an item may sit in the account-wide tab: a cash
+// item or cash equip a character could trade, or anything explicitly flagged
+// for account sharing. Ordinary items stay in their world's tabs.
+func shareable(m asset.Model) bool {
+	if assetConstants.HasFlag(m.Flag(), assetConstants.FlagAccountSharing) {
+		return true
+	}
+	return (m.IsCash() || m.IsCashEquipment()) && m.CanBeTraded()
 }
 
 func (p *ProcessorImpl) GetStorageByWorldAndAccountId(worldId world.Id, accountId uint32) (Model, error) {
@@ -195,7 +313,7 @@ func (p *ProcessorImpl) WithdrawAndEmit(transactionId uuid.UUID, worldId world.I
 }
 
 func (p *ProcessorImpl) UpdateMesos(worldId world.Id, accountId uint32, body message.UpdateMesosBody) error {
-	s, err := GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+	s, err := p.GetMesoStorage(worldId, accountId)
 	if err != nil {
 		return err
 	}
@@ -220,7 +338,7 @@ func (p *ProcessorImpl) UpdateMesos(worldId world.Id, accountId uint32, body mes
 }
 
 func (p *ProcessorImpl) UpdateMesosAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, body message.UpdateMesosBody) error {
-	s, err := GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+	s, err := p.GetMesoStorage(worldId, accountId)
 	if err != nil {
 		return err
 	}
@@ -238,7 +356,7 @@ func (p *ProcessorImpl) UpdateMesosAndEmit(transactionId uuid.UUID, worldId worl
 		return err
 	}
 
-	s, _ = GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+	s, _ = p.GetMesoStorage(worldId, accountId)
 
 	_ = p.emitMesosUpdatedEvent(transactionId, worldId, accountId, oldMesos, s.Mesos())
 
@@ -249,11 +367,11 @@ func (p *ProcessorImpl) DepositRollback(body message.DepositRollbackBody) error
 	return asset.Delete(p.l, p.db.WithContext(p.ctx))(uint32(body.AssetId))
 }
 
-// Accept accepts an item into storage as part of a transfer saga
-func (p *ProcessorImpl) Accept(worldId world.Id, accountId uint32, body compartment.AcceptCommandBody) (uint32, int16, error) {
+// Accept accepts an item into a storage tab as part of a transfer saga
+func (p *ProcessorImpl) Accept(worldId world.Id, accountId uint32, tab byte, body compartment.AcceptCommandBody) (uint32, int16, error) {
 	t := tenant.MustFromContext(p.ctx)
 
-	s, err := p.GetOrCreateStorage(worldId, accountId)
+	s, err := p.GetOrCreateTab(worldId, accountId, tab)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -292,6 +410,10 @@ func (p *ProcessorImpl) Accept(worldId world.Id, accountId uint32, body compartm
 		SetPetId(body.PetId).
 		Build()
 
+	if s.IsShared() && !shareable(m) {
+		return 0, 0, ErrNotShareable
+	}
+
 	invType := inventoryTypeFromTemplateId(body.TemplateId)
 
 	// Determine slot
@@ -344,8 +466,8 @@ func (p *ProcessorImpl) Accept(worldId world.Id, accountId uint32, body compartm
 	return a.Id(), slot, nil
 }
 
-func (p *ProcessorImpl) AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error {
-	assetId, slot, err := p.Accept(worldId, accountId, body)
+func (p *ProcessorImpl) AcceptAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error {
+	assetId, slot, err := p.Accept(worldId, accountId, tab, body)
 	if err != nil {
 		_ = p.emitCompartmentErrorEvent(worldId, accountId, characterId, body.TransactionId, "ACCEPT_FAILED", err.Error())
 		return err
@@ -367,13 +489,17 @@ func (p *ProcessorImpl) AcceptAndEmit(worldId world.Id, accountId uint32, charac
 // pooled postgres connection open across that call would turn a broker hiccup
 // into connection-pool exhaustion, so emission stays outside the transaction,
 // exactly where it was before the claim existed.
-func (p *ProcessorImpl) AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, body compartment.AcceptCommandBody) error {
+//
+// The destination tab is deliberately not part of the claim: it comes from the
+// character's open storage projection, which may be gone by the time a
+// redelivery arrives.
+func (p *ProcessorImpl) AcceptOnceAndEmit(worldId world.Id, accountId uint32, characterId uint32, tab byte, body compartment.AcceptCommandBody) error {
 	var assetId uint32
 	var slot int16
 
 	err := database.Once(p.ctx, p.db, idempotencyKey(p.l, body.TransactionId, compartment.CommandAccept, acceptClaim{WorldId: worldId, AccountId: accountId, CharacterId: characterId, Body: body}), compartment.CommandAccept, func(tx *gorm.DB) error {
 		var ierr error
-		assetId, slot, ierr = p.WithTransaction(tx).Accept(worldId, accountId, body)
+		assetId, slot, ierr = p.WithTransaction(tx).Accept(worldId, accountId, tab, body)
 		return ierr
 	})
 	if errors.Is(err, database.ErrDuplicate) {
@@ -609,8 +735,8 @@ type mergeKey struct {
 	flag       uint16
 }
 
-func (p *ProcessorImpl) MergeAndSort(worldId world.Id, accountId uint32) error {
-	s, err := GetByWorldAndAccountId(p.l, p.db.WithContext(p.ctx))(worldId, accountId)
+func (p *ProcessorImpl) MergeAndSort(worldId world.Id, accountId uint32, tab byte) error {
+	s, err := GetByWorldAccountAndTab(p.l, p.db.WithContext(p.ctx))(worldId, accountId, tab)
 	if err != nil {
 		return err
 	}
@@ -795,23 +921,23 @@ func (p *ProcessorImpl) getSlotMaxByTemplateId(templateId uint32) (uint32, error
 	}
 }
 
-func (p *ProcessorImpl) ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32) error {
-	err := p.MergeAndSort(worldId, accountId)
+func (p *ProcessorImpl) ArrangeAndEmit(transactionId uuid.UUID, worldId world.Id, accountId uint32, tab byte) error {
+	err := p.MergeAndSort(worldId, accountId, tab)
 	if err != nil {
 		_ = p.emitErrorEvent(transactionId, worldId, accountId, message.ErrorCodeGeneric, err.Error())
 		return err
 	}
 
-	return p.emitArrangedEvent(transactionId, worldId, accountId)
+	return p.emitArrangedEvent(transactionId, worldId, accountId, tab)
 }
 
-func (p *ProcessorImpl) emitArrangedEvent(transactionId uuid.UUID, worldId world.Id, accountId uint32) error {
+func (p *ProcessorImpl) emitArrangedEvent(transactionId uuid.UUID, worldId world.Id, accountId uint32, tab byte) error {
 	event := &message.StatusEvent[message.ArrangedEventBody]{
 		TransactionId: transactionId,
 		WorldId:       worldId,
 		AccountId:     accountId,
 		Type:          message.StatusEventTypeArranged,
-		Body:          message.ArrangedEventBody{},
+		Body:          message.ArrangedEventBody{Tab: tab},
 	}
 
 	return producer.ProviderImpl(p.l)(p.ctx)(message.EnvEventTopic)(createMessageProvider(accountId, event))
@@ -839,7 +965,7 @@ func min(a, b uint32) uint32 {
 	return b
 }
 
-func (p *ProcessorImpl) EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32) error {
+func (p *ProcessorImpl) EmitProjectionCreatedEvent(characterId uint32, accountId uint32, ch channel.Model, npcId uint32, tab byte) error {
 	event := &message.StatusEvent[message.ProjectionCreatedEventBody]{
 		WorldId:   ch.WorldId(),
 		AccountId: accountId,
@@ -850,6 +976,7 @@ func (p *ProcessorImpl) EmitProjectionCreatedEvent(characterId uint32, accountId
 			WorldId:     ch.WorldId(),
 			ChannelId:   ch.Id(),
 			NpcId:       npcId,
+			Tab:         tab,
 		},
 	}
 
@@ -874,20 +1001,15 @@ func (p *ProcessorImpl) ExpireAndEmit(transactionId uuid.UUID, worldId world.Id,
 		if replaceItemId > 0 {
 			p.l.Debugf("Creating replacement item [%d] for expired storage item [%d].", replaceItemId, a.TemplateId())
 
-			s, err := p.WithTransaction(tx).GetOrCreateStorage(worldId, accountId)
-			if err != nil {
-				p.l.WithError(err).Errorf("Failed to get storage for replacement item creation.")
-				return err
-			}
-
-			assets, err := asset.GetByStorageId(tx)(s.Id())
+			// The replacement lands in the same tab the expired item occupied.
+			assets, err := asset.GetByStorageId(tx)(a.StorageId())
 			if err != nil {
 				p.l.WithError(err).Errorf("Failed to get assets for slot calculation.")
 				return err
 			}
 			nextSlot := int16(len(assets))
 
-			replacement := asset.NewBuilder(s.Id(), replaceItemId).
+			replacement := asset.NewBuilder(a.StorageId(), replaceItemId).
 				SetSlot(nextSlot).
 				Build()
 
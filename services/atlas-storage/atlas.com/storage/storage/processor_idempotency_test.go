@@ -35,13 +35,13 @@ func TestAcceptOnceAndEmit_RedeliveryDoesNotDuplicate(t *testing.T) {
 	}
 
 	p := NewProcessor(l, ctx, db)
-	require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), accountId, 12, body))
+	require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), accountId, 12, TabMain, body))
 
 	var assets int64
 	require.NoError(t, db.Table("storage_assets").Count(&assets).Error)
 	require.EqualValues(t, 1, assets, "first delivery must deposit the asset")
 
-	require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), accountId, 12, body),
+	require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), accountId, 12, TabMain, body),
 		"a duplicate must be swallowed, not surfaced as an error")
 
 	require.NoError(t, db.Table("storage_assets").Count(&assets).Error)
@@ -59,7 +59,7 @@ func TestAcceptOnceAndEmit_DistinctCommandsBothApply(t *testing.T) {
 
 	p := NewProcessor(l, ctx, db)
 	for i := 0; i < 2; i++ {
-		require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), 5001, 12, compartment.AcceptCommandBody{
+		require.NoError(t, p.AcceptOnceAndEmit(world.Id(0), 5001, 12, TabMain, compartment.AcceptCommandBody{
 			TransactionId: uuid.New(),
 			TemplateId:    1812000,
 			AssetData:     message.AssetData{Quantity: 1},
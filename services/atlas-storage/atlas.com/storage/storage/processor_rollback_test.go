@@ -59,7 +59,7 @@ func TestMergeAndSort_RollsBackQuantityUpdatesWhenDeleteFails(t *testing.T) {
 	databasetest.FailWritesOn(t, db, "storage_assets", databasetest.WriteDelete)
 
 	p := NewProcessor(l, ctx, db)
-	require.Error(t, p.MergeAndSort(world.Id(0), 5002))
+	require.Error(t, p.MergeAndSort(world.Id(0), 5002, TabMain))
 
 	var quantities []uint32
 	require.NoError(t, db.Table("storage_assets").Order("slot").Pluck("quantity", &quantities).Error)
@@ -0,0 +1,145 @@
+package storage_test
+
+import (
+	"atlas-storage/configuration"
+	"atlas-storage/kafka/message"
+	"atlas-storage/kafka/message/compartment"
+	"atlas-storage/storage"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	assetConstants "github.com/Chronicle20/atlas/libs/atlas-constants/asset"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// tieredContext returns a tenant context whose storage configuration is
+// pinned in the registry, bypassing the atlas-tenants fetch.
+func tieredContext(cfg configuration.Model) context.Context {
+	t, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	configuration.GetRegistry().Set(t.Id(), cfg)
+	return tenant.WithContext(context.Background(), t)
+}
+
+func tieredProcessor(t *testing.T, cfg configuration.Model) (storage.Processor, *gorm.DB) {
+	db := testDatabase(t)
+	return storage.NewProcessor(testLogger(), tieredContext(cfg), db), db
+}
+
+func TestProcessor_GetOrCreateTab_DefaultConfigOffersMainTabOnly(t *testing.T) {
+	p, _ := tieredProcessor(t, configuration.DefaultConfig())
+
+	s, err := p.GetOrCreateTab(world.Id(0), 7001, storage.TabMain)
+	require.NoError(t, err)
+	require.Equal(t, storage.TabMain, s.Tab())
+
+	_, err = p.GetOrCreateTab(world.Id(0), 7001, 1)
+	require.ErrorIs(t, err, storage.ErrTabUnavailable)
+	_, err = p.GetOrCreateTab(world.Id(0), 7001, storage.TabShared)
+	require.ErrorIs(t, err, storage.ErrTabUnavailable)
+}
+
+func TestProcessor_GetTabs_ConfiguredTiers(t *testing.T) {
+	p, _ := tieredProcessor(t, configuration.NewModel(2, 48, true, 24, configuration.MesoModeWorld))
+
+	tabs, err := p.GetTabs(world.Id(0), 7002)
+	require.NoError(t, err)
+	require.Len(t, tabs, 4)
+	require.Equal(t, []byte{0, 1, 2, storage.TabShared}, []byte{tabs[0].Tab(), tabs[1].Tab(), tabs[2].Tab(), tabs[3].Tab()})
+	require.Equal(t, uint32(4), tabs[0].Capacity())
+	require.Equal(t, uint32(48), tabs[1].Capacity())
+	require.Equal(t, uint32(24), tabs[3].Capacity())
+
+	_, err = p.GetOrCreateTab(world.Id(0), 7002, 3)
+	require.ErrorIs(t, err, storage.ErrTabUnavailable)
+
+	// The shared tab is one row across worlds; extra tabs are per world.
+	other, err := p.GetOrCreateTab(world.Id(1), 7002, storage.TabShared)
+	require.NoError(t, err)
+	require.Equal(t, tabs[3].Id(), other.Id())
+	extra, err := p.GetOrCreateTab(world.Id(1), 7002, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, tabs[1].Id(), extra.Id())
+}
+
+func TestProcessor_Accept_TargetsTabAndGuardsSharedTab(t *testing.T) {
+	p, _ := tieredProcessor(t, configuration.NewModel(1, 24, true, 24, configuration.MesoModeWorld))
+	accountId := uint32(7003)
+
+	acceptItem := func(tab byte, templateId uint32, cashId int64, flag uint16) error {
+		body := compartment.AcceptCommandBody{TransactionId: uuid.New(), TemplateId: templateId}
+		body.CashId = cashId
+		body.Flag = flag
+		_, _, err := p.Accept(world.Id(0), accountId, tab, body)
+		return err
+	}
+	accept := func(tab byte, flag uint16) error {
+		return acceptItem(tab, 1302000, 0, flag)
+	}
+
+	require.NoError(t, accept(1, 0))
+	extra, err := p.GetOrCreateTab(world.Id(0), accountId, 1)
+	require.NoError(t, err)
+	require.Len(t, extra.Assets(), 1)
+	main, err := p.GetOrCreateStorage(world.Id(0), accountId)
+	require.NoError(t, err)
+	require.Empty(t, main.Assets())
+
+	// Only cash-tradeable items, or items flagged for account sharing, cross
+	// worlds; an ordinary tradeable sword does not.
+	require.ErrorIs(t, accept(storage.TabShared, 0), storage.ErrNotShareable)
+	require.ErrorIs(t, acceptItem(storage.TabShared, 5150000, 0, uint16(assetConstants.FlagUntradeable)), storage.ErrNotShareable)
+	require.NoError(t, accept(storage.TabShared, uint16(assetConstants.FlagUntradeable|assetConstants.FlagAccountSharing)))
+	require.NoError(t, acceptItem(storage.TabShared, 5150000, 0, 0))
+	require.NoError(t, acceptItem(storage.TabShared, 1302000, 12345, 0))
+
+	shared, err := p.GetOrCreateTab(world.Id(0), accountId, storage.TabShared)
+	require.NoError(t, err)
+	require.Len(t, shared.Assets(), 3)
+
+	require.ErrorIs(t, accept(2, 0), storage.ErrTabUnavailable)
+}
+
+func TestProcessor_AccountMesoMode_PoolsAcrossWorlds(t *testing.T) {
+	p, db := tieredProcessor(t, configuration.NewModel(0, 24, false, 24, configuration.MesoModeAccount))
+	accountId := uint32(7004)
+
+	main, err := p.GetOrCreateStorage(world.Id(0), accountId)
+	require.NoError(t, err)
+	require.NoError(t, storage.UpdateMesos(testLogger(), db)(main.Id(), 500))
+
+	// The first resolution folds the world balance into the account row.
+	ms, err := p.GetMesoStorage(world.Id(0), accountId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(500), ms.Mesos())
+	require.True(t, ms.IsShared())
+	main, err = p.GetStorageByWorldAndAccountId(world.Id(0), accountId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), main.Mesos())
+
+	require.NoError(t, p.UpdateMesos(world.Id(1), accountId, message.UpdateMesosBody{Operation: "ADD", Mesos: 100}))
+
+	ms, err = p.GetMesoStorage(world.Id(0), accountId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(600), ms.Mesos())
+}
+
+func TestProcessor_WorldMesoMode_KeepsWorldsSeparate(t *testing.T) {
+	p, _ := tieredProcessor(t, configuration.DefaultConfig())
+	accountId := uint32(7005)
+
+	for _, w := range []world.Id{0, 1} {
+		_, err := p.GetOrCreateStorage(w, accountId)
+		require.NoError(t, err)
+	}
+	require.NoError(t, p.UpdateMesos(world.Id(0), accountId, message.UpdateMesosBody{Operation: "ADD", Mesos: 100}))
+
+	ms, err := p.GetMesoStorage(world.Id(1), accountId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), ms.Mesos())
+	require.False(t, ms.IsShared())
+}
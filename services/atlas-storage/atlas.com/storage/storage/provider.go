@@ -9,28 +9,43 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 )
 
+// GetByWorldAndAccountId returns the account's main storage tab in a world.
 func GetByWorldAndAccountId(l logrus.FieldLogger, db *gorm.DB) func(worldId world.Id, accountId uint32) (Model, error) {
 	return func(worldId world.Id, accountId uint32) (Model, error) {
+		return GetByWorldAccountAndTab(l, db)(worldId, accountId, TabMain)
+	}
+}
+
+// GetByWorldAccountAndTab returns a single storage tab. The shared tab ignores
+// worldId and resolves to the account-wide row.
+func GetByWorldAccountAndTab(l logrus.FieldLogger, db *gorm.DB) func(worldId world.Id, accountId uint32, tab byte) (Model, error) {
+	return func(worldId world.Id, accountId uint32, tab byte) (Model, error) {
 		var e Entity
-		err := db.Where("world_id = ? AND account_id = ?", byte(worldId), accountId).First(&e).Error
+		err := db.Where("world_id = ? AND account_id = ? AND tab = ?", byte(rowWorldId(worldId, tab)), accountId, tab).First(&e).Error
 		if err != nil {
 			return Model{}, err
 		}
+		return makeWithAssets(l, db)(e), nil
+	}
+}
 
-		assets, err := asset.GetByStorageId(db)(e.Id)
+// GetTabsByWorldAndAccountId returns every existing tab the account can see
+// from a world: its per-world tabs plus the account-wide row, ordered by tab.
+func GetTabsByWorldAndAccountId(l logrus.FieldLogger, db *gorm.DB) func(worldId world.Id, accountId uint32) ([]Model, error) {
+	return func(worldId world.Id, accountId uint32) ([]Model, error) {
+		var entities []Entity
+		err := db.Where("account_id = ? AND (world_id = ? OR (world_id = ? AND tab = ?))", accountId, byte(worldId), byte(SharedWorldId), TabShared).
+			Order("tab").
+			Find(&entities).Error
 		if err != nil {
-			l.WithError(err).Warnf("Failed to load assets for storage %s, returning empty assets", e.Id)
-			assets = []asset.Model{}
+			return nil, err
 		}
 
-		return NewModelBuilder().
-			SetId(e.Id).
-			SetWorldId(world.Id(e.WorldId)).
-			SetAccountId(e.AccountId).
-			SetCapacity(e.Capacity).
-			SetMesos(e.Mesos).
-			SetAssets(assets).
-			MustBuild(), nil
+		models := make([]Model, 0, len(entities))
+		for _, e := range entities {
+			models = append(models, makeWithAssets(l, db)(e))
+		}
+		return models, nil
 	}
 }
 
@@ -44,23 +59,23 @@ func GetByAccountId(l logrus.FieldLogger, db *gorm.DB) func(accountId uint32) ([
 
 		var models []Model
 		for _, e := range entities {
-			assets, err := asset.GetByStorageId(db)(e.Id)
-			if err != nil {
-				l.WithError(err).Warnf("Failed to load assets for storage %s, returning empty assets", e.Id)
-				assets = []asset.Model{}
-			}
-
-			m := NewModelBuilder().
-				SetId(e.Id).
-				SetWorldId(world.Id(e.WorldId)).
-				SetAccountId(e.AccountId).
-				SetCapacity(e.Capacity).
-				SetMesos(e.Mesos).
-				SetAssets(assets).
-				MustBuild()
-			models = append(models, m)
+			models = append(models, makeWithAssets(l, db)(e))
 		}
 
 		return models, nil
 	}
 }
+
+func makeWithAssets(l logrus.FieldLogger, db *gorm.DB) func(e Entity) Model {
+	return func(e Entity) Model {
+		assets, err := asset.GetByStorageId(db)(e.Id)
+		if err != nil {
+			l.WithError(err).Warnf("Failed to load assets for storage %s, returning empty assets", e.Id)
+			assets = []asset.Model{}
+		}
+
+		return Clone(Make(e)).
+			SetAssets(assets).
+			MustBuild()
+	}
+}
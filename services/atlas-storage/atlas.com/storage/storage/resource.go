@@ -2,6 +2,7 @@ package storage
 
 import (
 	"atlas-storage/rest"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -22,41 +23,92 @@ func InitResource(si jsonapi.ServerInformation) func(db *gorm.DB) server.RouteIn
 
 			r := router.PathPrefix("/storage/accounts").Subrouter()
 			r.HandleFunc("/{accountId}", registerGet("get_storage", handleGetStorageRequest(db))).Methods(http.MethodGet)
+			r.HandleFunc("/{accountId}/tabs", registerGet("get_storage_tabs", handleGetStorageTabsRequest(db))).Methods(http.MethodGet)
 			r.HandleFunc("/{accountId}", registerPost("create_storage", handleCreateStorageRequest(db))).Methods(http.MethodPost)
 		}
 	}
 }
 
 func handleGetStorageRequest(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseAccountId(d.Logger(), func(accountId uint32) http.HandlerFunc {
+			return rest.ParseWorldId(d.Logger(), func(worldId world.Id) http.HandlerFunc {
+				return rest.ParseTab(d.Logger(), func(tab byte) http.HandlerFunc {
+					return func(w http.ResponseWriter, r *http.Request) {
+						// Use processor to get or create the tab lazily
+						p := NewProcessor(d.Logger(), d.Context(), db)
+						s, err := p.GetOrCreateTab(worldId, accountId, tab)
+						if errors.Is(err, ErrTabUnavailable) {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						if err != nil {
+							d.Logger().WithError(err).Errorf("Unable to get or create storage tab %d for world %d account %d.", tab, worldId, accountId)
+							server.WriteErrorResponse(d.Logger())(w)(err)
+							return
+						}
+						s = withMesos(p, s, worldId, accountId)
+
+						// Transform storage to REST model with decorated assets
+						restModel, err := Transform(s)
+						if err != nil {
+							d.Logger().WithError(err).Errorf("Unable to transform storage for world %d account %d.", worldId, accountId)
+							server.WriteErrorResponse(d.Logger())(w)(err)
+							return
+						}
+
+						query := r.URL.Query()
+						queryParams := jsonapi.ParseQueryFields(&query)
+						server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(restModel)
+					}
+				})
+			})
+		})
+	}
+}
+
+func handleGetStorageTabsRequest(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 		return rest.ParseAccountId(d.Logger(), func(accountId uint32) http.HandlerFunc {
 			return rest.ParseWorldId(d.Logger(), func(worldId world.Id) http.HandlerFunc {
 				return func(w http.ResponseWriter, r *http.Request) {
-					// Use processor to get or create storage lazily
-					s, err := NewProcessor(d.Logger(), d.Context(), db).GetOrCreateStorage(worldId, accountId)
+					p := NewProcessor(d.Logger(), d.Context(), db)
+					tabs, err := p.GetTabs(worldId, accountId)
 					if err != nil {
-						d.Logger().WithError(err).Errorf("Unable to get or create storage for world %d account %d.", worldId, accountId)
+						d.Logger().WithError(err).Errorf("Unable to get storage tabs for world %d account %d.", worldId, accountId)
 						server.WriteErrorResponse(d.Logger())(w)(err)
 						return
 					}
+					for i := range tabs {
+						tabs[i] = withMesos(p, tabs[i], worldId, accountId)
+					}
 
-					// Transform storage to REST model with decorated assets
-					restModel, err := Transform(s)
+					restModels, err := TransformAll(tabs)
 					if err != nil {
-						d.Logger().WithError(err).Errorf("Unable to transform storage for world %d account %d.", worldId, accountId)
+						d.Logger().WithError(err).Errorf("Unable to transform storage tabs for world %d account %d.", worldId, accountId)
 						server.WriteErrorResponse(d.Logger())(w)(err)
 						return
 					}
 
 					query := r.URL.Query()
 					queryParams := jsonapi.ParseQueryFields(&query)
-					server.MarshalResponse[RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(restModel)
+					server.MarshalResponse[[]RestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(restModels)
 				}
 			})
 		})
 	}
 }
 
+// withMesos reports the account's storage meso balance on a tab, which may
+// live on a different row than the tab itself under account meso mode.
+func withMesos(p Processor, s Model, worldId world.Id, accountId uint32) Model {
+	ms, err := p.GetMesoStorage(worldId, accountId)
+	if err != nil {
+		return s
+	}
+	return Clone(s).SetMesos(ms.Mesos()).MustBuild()
+}
+
 func handleCreateStorageRequest(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 		return rest.ParseAccountId(d.Logger(), func(accountId uint32) http.HandlerFunc {
@@ -13,6 +13,7 @@ type RestModel struct {
 	Id        string            `json:"-"`
 	WorldId   world.Id          `json:"world_id"`
 	AccountId uint32            `json:"account_id"`
+	Tab       byte              `json:"tab"`
 	Capacity  uint32            `json:"capacity"`
 	Mesos     uint32            `json:"mesos"`
 	Assets    []asset.RestModel `json:"-"`
@@ -95,6 +96,18 @@ func (r *RestModel) SetReferencedStructs(references map[string]map[string]jsonap
 	return nil
 }
 
+func TransformAll(models []Model) ([]RestModel, error) {
+	results := make([]RestModel, 0, len(models))
+	for _, m := range models {
+		rm, err := Transform(m)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, rm)
+	}
+	return results, nil
+}
+
 func Transform(m Model) (RestModel, error) {
 	restAssets, err := asset.TransformAll(m.Assets())
 	if err != nil {
@@ -105,6 +118,7 @@ func Transform(m Model) (RestModel, error) {
 		Id:        m.Id().String(),
 		WorldId:   m.WorldId(),
 		AccountId: m.AccountId(),
+		Tab:       m.Tab(),
 		Capacity:  m.Capacity(),
 		Mesos:     m.Mesos(),
 		Assets:    restAssets,
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"errors"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+)
+
+const (
+	// TabMain is the classic per-world storage every account has.
+	TabMain byte = 0
+	// TabShared is the account-wide tab visible from every world. Its row is
+	// stored under SharedWorldId so one row serves all worlds.
+	TabShared byte = 0xFF
+)
+
+// SharedWorldId is the sentinel world the account-wide row lives under. It
+// holds the shared tab's items and, in account meso mode, the pooled mesos.
+const SharedWorldId world.Id = 0xFF
+
+// mainTabCapacity is the slot count a freshly created main tab starts with.
+const mainTabCapacity uint32 = 4
+
+var (
+	// ErrTabUnavailable is returned when a tab is requested that the tenant's
+	// storage configuration does not offer.
+	ErrTabUnavailable = errors.New("storage tab is not available")
+	// ErrNotShareable is returned when an item that cannot cross characters is
+	// placed in the shared tab.
+	ErrNotShareable = errors.New("item cannot be placed in the shared storage tab")
+)
+
+// rowWorldId maps a tab to the world its row is keyed under.
+func rowWorldId(worldId world.Id, tab byte) world.Id {
+	if tab == TabShared {
+		return SharedWorldId
+	}
+	return worldId
+}
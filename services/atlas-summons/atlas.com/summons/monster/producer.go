@@ -60,12 +60,15 @@ type command[E any] struct {
 	Body      E          `json:"body"`
 }
 
-// damageCommandBody mirrors monsters/kafka/consumer/monster/kafka.go:43-47.
-// Setting CharacterId to the summon owner is what credits the owner.
+// damageCommandBody mirrors the atlas-monsters damageCommandBody. Setting
+// CharacterId to the summon owner is what credits the owner. Element is the
+// summon's attack element ("" for physical) and drives the monster's
+// elemental weakness/resistance adjustment.
 type damageCommandBody struct {
 	CharacterId uint32   `json:"characterId"`
 	Damages     []uint32 `json:"damages"`
 	AttackType  byte     `json:"attackType"`
+	Element     string   `json:"element,omitempty"`
 }
 
 // applyStatusCommandBody mirrors monsters/kafka/consumer/monster/kafka.go:49-57.
@@ -150,7 +153,8 @@ func RemovePuppetProvider(f field.Model, ownerCharacterId uint32) model.Provider
 
 // monsterDamageProvider credits ownerCharacterId with the supplied damage values
 // against a monster (FR-4.2). CharacterId = owner ⇒ XP/drops/kill credit.
-func monsterDamageProvider(f field.Model, monsterId uint32, ownerCharacterId uint32, damages []uint32) model.Provider[[]kafka.Message] {
+// element is the summon's attack element, "" for physical.
+func monsterDamageProvider(f field.Model, monsterId uint32, ownerCharacterId uint32, damages []uint32, element string) model.Provider[[]kafka.Message] {
 	key := producer.CreateKey(int(monsterId))
 	value := &command[damageCommandBody]{
 		WorldId:   f.WorldId(),
@@ -163,14 +167,15 @@ func monsterDamageProvider(f field.Model, monsterId uint32, ownerCharacterId uin
 			CharacterId: ownerCharacterId,
 			Damages:     damages,
 			AttackType:  0,
+			Element:     element,
 		},
 	}
 	return producer.SingleMessageProvider(key, value)
 }
 
 // MonsterDamageProvider is the exported entry point for the summon processor.
-func MonsterDamageProvider(f field.Model, monsterId uint32, ownerCharacterId uint32, damages []uint32) model.Provider[[]kafka.Message] {
-	return monsterDamageProvider(f, monsterId, ownerCharacterId, damages)
+func MonsterDamageProvider(f field.Model, monsterId uint32, ownerCharacterId uint32, damages []uint32, element string) model.Provider[[]kafka.Message] {
+	return monsterDamageProvider(f, monsterId, ownerCharacterId, damages, element)
 }
 
 // monsterApplyStatusProvider applies the supplied statuses to a monster, sourced
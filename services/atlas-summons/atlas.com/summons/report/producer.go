@@ -0,0 +1,74 @@
+// Package report declares atlas-summons' local view of the atlas-ban report
+// command contract (COMMAND_TOPIC_REPORT) and the provider used to file a
+// detection report against a summon owner whose reported summon damage keeps
+// failing validation.
+//
+// Services in this monorepo never import one another, so the envelope and body
+// shapes here are re-declared to match the atlas-ban consumer at
+// services/atlas-ban/atlas.com/ban/kafka/message/report. The JSON tags MUST
+// stay byte-identical to that consumer or the report is silently dropped.
+package report
+
+import (
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
+	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
+	"github.com/Chronicle20/atlas/libs/atlas-kafka/producer"
+	"github.com/Chronicle20/atlas/libs/atlas-model/model"
+)
+
+const (
+	// EnvCommandTopic names the env var holding the atlas-ban report command topic.
+	EnvCommandTopic = "COMMAND_TOPIC_REPORT"
+
+	// CommandTypeCreate files a report. Mirrors atlas-ban CommandTypeCreate.
+	CommandTypeCreate = "CREATE"
+
+	// KindDetection is a system-filed report from a cheat detector. atlas-ban
+	// emits no status event for it.
+	KindDetection = "detection"
+)
+
+// Command is the atlas-ban report command envelope.
+type Command[E any] struct {
+	Type string `json:"type"`
+	Body E      `json:"body"`
+}
+
+// CreateCommandBody mirrors the atlas-ban CREATE body. A detection report
+// carries only the accused; there is no reporter.
+type CreateCommandBody struct {
+	Kind        string     `json:"kind"`
+	WorldId     world.Id   `json:"worldId"`
+	ChannelId   channel.Id `json:"channelId"`
+	ReporterId  uint32     `json:"reporterId"`
+	AccusedId   uint32     `json:"accusedId"`
+	AccusedName string     `json:"accusedName"`
+	ReasonType  byte       `json:"reasonType"`
+	Description string     `json:"description"`
+	ChatClaim   bool       `json:"chatClaim"`
+	ChatLog     string     `json:"chatLog"`
+}
+
+// detectionProvider files a detection report against accusedId. Keyed by the
+// accused: there is no reporter.
+func detectionProvider(worldId world.Id, channelId channel.Id, accusedId uint32, description string) model.Provider[[]kafka.Message] {
+	key := producer.CreateKey(int(accusedId))
+	value := &Command[CreateCommandBody]{
+		Type: CommandTypeCreate,
+		Body: CreateCommandBody{
+			Kind:        KindDetection,
+			WorldId:     worldId,
+			ChannelId:   channelId,
+			AccusedId:   accusedId,
+			Description: description,
+		},
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+// DetectionProvider is the exported entry point for the summon processor.
+func DetectionProvider(worldId world.Id, channelId channel.Id, accusedId uint32, description string) model.Provider[[]kafka.Message] {
+	return detectionProvider(worldId, channelId, accusedId, description)
+}
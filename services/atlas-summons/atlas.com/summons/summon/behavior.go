@@ -0,0 +1,73 @@
+package summon
+
+import (
+	"atlas-summons/data/skill/effect"
+	"time"
+
+	summonconst "github.com/Chronicle20/atlas/libs/atlas-constants/summon"
+)
+
+// Attack elements carried on the monster DAMAGE command. The strings are the
+// element keys atlas-monsters resolves against a monster's WZ elemAttr
+// ("LIGHTING" is atlas-data's spelling); an empty element is physical.
+const (
+	elementFire      = "FIRE"
+	elementIce       = "ICE"
+	elementLightning = "LIGHTING"
+	elementHoly      = "HOLY"
+)
+
+// behavior is what a summon archetype does server-side. The roster
+// (libs/atlas-constants/summon) classifies a skill — type, movement, on-hit
+// stun/freeze, one-shot; a behavior says how the processor treats it. Hooks
+// are optional: a nil hook means the archetype does nothing at that point.
+type behavior struct {
+	// attacks is true for summons whose client reports attacks. An attack
+	// reported for any other summon is dropped.
+	attacks bool
+	// stationary summons hold the position they were cast at; a move packet
+	// for one is dropped.
+	stationary bool
+	// absorbsDamage summons take monster hits and fall at 0 HP.
+	absorbsDamage bool
+	// element is the attack element credited on DAMAGE ("" for physical).
+	element string
+	// hp is the summon's spawn HP from its skill effect. nil means 0.
+	hp func(eff effect.Model) int32
+	// prepare decorates the spawn builder before the summon is persisted.
+	prepare func(p *ProcessorImpl, b *ModelBuilder, auraLevel byte, hexLevel byte, now time.Time)
+	// spawned runs once the summon is persisted and CREATED emitted.
+	spawned func(p *ProcessorImpl, m Model)
+	// despawned runs once the summon is removed and DESTROYED emitted.
+	despawned func(p *ProcessorImpl, m Model)
+}
+
+// behaviors maps a summon skill id to its behavior. Entries are registered
+// from init() in the per-archetype behavior_*.go files.
+var behaviors = make(map[uint32]behavior)
+
+func registerBehavior(b behavior, skillIds ...uint32) {
+	for _, id := range skillIds {
+		behaviors[id] = b
+	}
+}
+
+// behaviorFor returns the behavior of a summon skill. A roster skill with no
+// registered behavior falls back to its type's default, so adding a summon is
+// still one roster row; a non-summon skill has no behavior.
+func behaviorFor(skillId uint32) (behavior, bool) {
+	if b, ok := behaviors[skillId]; ok {
+		return b, true
+	}
+	e, ok := summonconst.Lookup(skillId)
+	if !ok {
+		return behavior{}, false
+	}
+	switch e.Type {
+	case summonconst.TypePuppet:
+		return puppetBehavior(), true
+	case summonconst.TypeBuffAura:
+		return beholderBehavior(), true
+	}
+	return attackerBehavior(e.Movement == summonconst.MovementStationary, ""), true
+}
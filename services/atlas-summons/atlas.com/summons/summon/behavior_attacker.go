@@ -0,0 +1,56 @@
+package summon
+
+import (
+	skillconst "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+)
+
+// attackerBehavior is a summon that fights for its owner. Its damage is
+// validated against the owner's ceiling and credited to the owner; stun and
+// freeze come from the roster. A stationary attacker is a turret.
+func attackerBehavior(stationary bool, element string) behavior {
+	return behavior{
+		attacks:    true,
+		stationary: stationary,
+		element:    element,
+	}
+}
+
+func init() {
+	// Turrets: cast in place and fire from there.
+	registerBehavior(attackerBehavior(true, ""),
+		uint32(skillconst.OutlawOctopusId),
+		uint32(skillconst.CorsairWrathOfTheOctopiId),
+	)
+
+	// Physical attackers. Silver Hawk and Golden Eagle stun, Gaviota
+	// self-cancels after one attack (roster flags).
+	registerBehavior(attackerBehavior(false, ""),
+		uint32(skillconst.RangerSilverHawkId),
+		uint32(skillconst.SniperGoldenEagleId),
+		uint32(skillconst.PriestSummonDragonId),
+		uint32(skillconst.OutlawGaviotaId),
+		uint32(skillconst.DawnWarriorStage1SoulId),
+		uint32(skillconst.WindArcherStage1StormId),
+		uint32(skillconst.NightWalkerStage1DarknessId),
+	)
+
+	// Elemental attackers: the element decides the target's weakness or
+	// immunity in atlas-monsters, and gates element-bound statuses such as
+	// Elquines' and Frostprey's freeze.
+	registerBehavior(attackerBehavior(false, elementFire),
+		uint32(skillconst.IceLightningArchMagicianIfritId),
+		uint32(skillconst.BlazeWizardStage3IfritId),
+		uint32(skillconst.BlazeWizardStage1FlameId),
+		uint32(skillconst.BowmasterPhoenixId),
+	)
+	registerBehavior(attackerBehavior(false, elementIce),
+		uint32(skillconst.FirePoisonArchMagicianElquinesId),
+		uint32(skillconst.MarksmanFrostpreyId),
+	)
+	registerBehavior(attackerBehavior(false, elementLightning),
+		uint32(skillconst.ThunderBreakerStage1LightningSpriteId),
+	)
+	registerBehavior(attackerBehavior(false, elementHoly),
+		uint32(skillconst.BishopBahamutId),
+	)
+}
@@ -0,0 +1,63 @@
+package summon
+
+import (
+	"atlas-summons/data/skill/effect"
+	"time"
+
+	skillconst "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+)
+
+// beholderBehavior is the Dark Knight's Beholder: it does not attack, and
+// heals and buffs its owner on the aura sweep (beholder_task.go) from a
+// snapshot taken at spawn.
+func beholderBehavior() behavior {
+	return behavior{
+		hp:      func(eff effect.Model) int32 { return int32(eff.X()) + 1 }, // Beholder hp = effect x + 1
+		prepare: prepareBeholder,
+	}
+}
+
+// prepareBeholder snapshots the Beholder's aura heal + hex buff at spawn so
+// the periodic sweep heals/buffs the owner without re-resolving skill data
+// each tick (FR-5.x). The resolved values are a faithful read of the caster's
+// trained AURA_OF_THE_BEHOLDER (1320008, heal hp + interval x) and
+// HEX_OF_THE_BEHOLDER (1320009, buff statups + interval x + duration time).
+func prepareBeholder(p *ProcessorImpl, b *ModelBuilder, auraLevel byte, hexLevel byte, now time.Time) {
+	if auraLevel > 0 {
+		aura, err := p.effects.GetEffect(uint32(skillconst.DarkKnightAuraOfTheBeholderId), auraLevel)
+		if err != nil {
+			p.l.WithError(err).Warnf("No AURA_OF_THE_BEHOLDER effect for level [%d]; Beholder [%d] will not heal.", auraLevel, b.id)
+		} else {
+			healInterval := time.Duration(aura.X()) * time.Second
+			b.SetHealAmount(aura.Hp()).SetHealInterval(healInterval).SetNextHealAt(now.Add(healInterval))
+		}
+	}
+	if hexLevel > 0 {
+		hex, err := p.effects.GetEffect(uint32(skillconst.DarkKnightHexOfTheBeholderId), hexLevel)
+		if err != nil {
+			p.l.WithError(err).Warnf("No HEX_OF_THE_BEHOLDER effect for level [%d]; Beholder [%d] will not buff.", hexLevel, b.id)
+			return
+		}
+		buffInterval := time.Duration(hex.X()) * time.Second
+		changes := make([]StatChange, 0, len(hex.Statups()))
+		for _, su := range hex.Statups() {
+			changes = append(changes, StatChange{Type: su.Type, Amount: su.Amount})
+		}
+		// The buff sourceId MUST be the positive, real skill id. It is
+		// written into the client give-buff packet as the per-stat rSkillID,
+		// and the v83 client looks up GetSkillTemplate(rSkillID) to render the
+		// buff icon/tooltip — a negative id resolves to null and crashes the
+		// client. (design.md Q3 negated it for server-side collision
+		// avoidance, but HEX_OF_THE_BEHOLDER is only ever applied by the
+		// Beholder, so there is no real collision and the negation was
+		// client-fatal.)
+		b.SetBuffInterval(buffInterval).SetNextBuffAt(now.Add(buffInterval)).
+			SetBuffDuration(hex.Duration()).SetBuffLevel(hexLevel).
+			SetBuffSourceId(int32(skillconst.DarkKnightHexOfTheBeholderId)).
+			SetBuffChanges(changes)
+	}
+}
+
+func init() {
+	registerBehavior(beholderBehavior(), uint32(skillconst.DarkKnightBeholderId))
+}
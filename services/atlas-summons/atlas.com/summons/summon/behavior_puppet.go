@@ -0,0 +1,38 @@
+package summon
+
+import (
+	"atlas-summons/data/skill/effect"
+	monstermsg "atlas-summons/monster"
+
+	skillconst "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
+)
+
+// puppetBehavior is a decoy: it stands where it was cast, soaks monster hits
+// with effect x HP, and draws aggro. atlas-monsters is told of the puppet on
+// spawn, hands the monsters it covers to the owner with aggro and biases
+// later controller elections toward the owner; it is told again on despawn.
+func puppetBehavior() behavior {
+	return behavior{
+		stationary:    true,
+		absorbsDamage: true,
+		hp:            func(eff effect.Model) int32 { return int32(eff.X()) },
+		spawned: func(p *ProcessorImpl, m Model) {
+			if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.AddPuppetProvider(m.Field(), m.OwnerCharacterId(), m.X(), m.Y())); err != nil {
+				p.l.WithError(err).Errorf("Unable to emit ADD_PUPPET for summon [%d].", m.Id())
+			}
+		},
+		despawned: func(p *ProcessorImpl, m Model) {
+			if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.RemovePuppetProvider(m.Field(), m.OwnerCharacterId())); err != nil {
+				p.l.WithError(err).Errorf("Unable to emit REMOVE_PUPPET for summon [%d].", m.Id())
+			}
+		},
+	}
+}
+
+func init() {
+	registerBehavior(puppetBehavior(),
+		uint32(skillconst.RangerPuppetId),
+		uint32(skillconst.SniperPuppetId),
+		uint32(skillconst.WindArcherStage3PuppetId),
+	)
+}
@@ -0,0 +1,51 @@
+package summon
+
+import (
+	"testing"
+
+	summonconst "github.com/Chronicle20/atlas/libs/atlas-constants/summon"
+)
+
+// TestEveryRosterSummonHasBehavior pins the registrations to the roster: all
+// 21 summon skills are registered, and only summon skills are.
+func TestEveryRosterSummonHasBehavior(t *testing.T) {
+	if len(behaviors) != 21 {
+		t.Fatalf("expected 21 registered behaviors, got %d", len(behaviors))
+	}
+	for id := range behaviors {
+		if !summonconst.IsSummonSkill(id) {
+			t.Fatalf("behavior registered for non-summon skill [%d]", id)
+		}
+	}
+	if _, ok := behaviorFor(2001002); ok {
+		t.Fatalf("expected no behavior for a non-summon skill")
+	}
+}
+
+func TestBehaviorArchetypes(t *testing.T) {
+	cases := []struct {
+		name          string
+		skillId       uint32
+		attacks       bool
+		stationary    bool
+		absorbsDamage bool
+		element       string
+	}{
+		{"puppet", 3111002, false, true, true, ""},
+		{"octopus turret", 5211001, true, true, false, ""},
+		{"silver hawk", 3111005, true, false, false, ""},
+		{"bahamut", 2321003, true, false, false, elementHoly},
+		{"ifrit", 2221005, true, false, false, elementFire},
+		{"elquines", 2121005, true, false, false, elementIce},
+		{"beholder", 1321007, false, false, false, ""},
+	}
+	for _, tc := range cases {
+		b, ok := behaviorFor(tc.skillId)
+		if !ok {
+			t.Fatalf("%s: no behavior", tc.name)
+		}
+		if b.attacks != tc.attacks || b.stationary != tc.stationary || b.absorbsDamage != tc.absorbsDamage || b.element != tc.element {
+			t.Fatalf("%s: got attacks=%t stationary=%t absorbsDamage=%t element=%q", tc.name, b.attacks, b.stationary, b.absorbsDamage, b.element)
+		}
+	}
+}
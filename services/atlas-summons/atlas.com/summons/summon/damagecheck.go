@@ -0,0 +1,140 @@
+package summon
+
+import (
+	reportmsg "atlas-summons/report"
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+// Summon damage lines are checked against the owner's per-hit ceiling: a
+// line above it is clamped; a line no unmodified client could send is
+// rejected and credits nothing; every violation feeds a per-owner suspicion
+// score that files a detection report with atlas-ban once it crosses the
+// threshold.
+const (
+	// damageRejectFactor scales the ceiling into the rejection ceiling.
+	damageRejectFactor = 2.0
+	// damageLineCap is the client's own per-line damage cap.
+	damageLineCap = 199999
+
+	// A clamp can come from a buff the ceiling does not model, so it weighs
+	// little; a rejection cannot come from an unmodified client.
+	suspicionClampWeight  = 1.0
+	suspicionRejectWeight = 5.0
+	// suspicionHalfLife lets the odd clamp from a summon fighting alongside
+	// an unmodelled buff fade, while a modified summon that trips the check
+	// on every hit climbs past suspicionReportThreshold within minutes.
+	suspicionHalfLife        = 10 * time.Minute
+	suspicionReportThreshold = 25.0
+	// suspicionReportCooldown bounds detection reports to one per owner per
+	// window. It is also the score's Redis TTL: after a window of silence
+	// the score has decayed to nothing and the cooldown has lapsed.
+	suspicionReportCooldown = time.Hour
+)
+
+type damageVerdict byte
+
+const (
+	damageOk damageVerdict = iota
+	damageClamped
+	damageRejected
+)
+
+// checkSummonDamage validates one reported summon damage line against the
+// owner's per-hit ceiling and returns the damage to credit. A ceiling of 0
+// means none could be computed (owner stats unavailable): the line passes
+// unless it exceeds the client's own cap.
+func checkSummonDamage(reported uint32, ceiling int64) (uint32, damageVerdict) {
+	if reported > damageLineCap {
+		return 0, damageRejected
+	}
+	if ceiling <= 0 {
+		return reported, damageOk
+	}
+	if float64(reported) > float64(ceiling)*damageRejectFactor {
+		return 0, damageRejected
+	}
+	if int64(reported) > ceiling {
+		return clampDamage(reported, ceiling), damageClamped
+	}
+	return reported, damageOk
+}
+
+// storedSuspicion is an owner's summon-damage suspicion score. Times
+// serialize as unix-milli (0 == zero time).
+type storedSuspicion struct {
+	Score      float64 `json:"score"`
+	UpdatedAt  int64   `json:"updatedAt"`
+	ReportedAt int64   `json:"reportedAt"`
+}
+
+func decayedSuspicion(s storedSuspicion, now time.Time) float64 {
+	elapsed := now.Sub(msToTime(s.UpdatedAt))
+	if s.UpdatedAt == 0 || elapsed <= 0 {
+		return s.Score
+	}
+	return s.Score * math.Pow(0.5, float64(elapsed)/float64(suspicionHalfLife))
+}
+
+// RecordSuspicion adds weight to the owner's summon-damage suspicion score and
+// returns the decayed total. report is true exactly when the total has
+// reached the threshold and no report was filed within the cooldown. The
+// score lives in Redis rather than in-process because summon attacks are
+// partitioned by map, so an owner who changes maps may land on another pod;
+// it is read, scored and stamped in one optimistic transaction, so two pods
+// recording at once cannot both win the report.
+func (r *Registry) RecordSuspicion(ctx context.Context, t tenant.Model, characterId uint32, weight float64, now time.Time) (score float64, report bool, err error) {
+	s, err := r.suspicion.UpsertWithTTL(ctx, t, characterId, suspicionReportCooldown, func(s storedSuspicion) storedSuspicion {
+		report = false
+		s.Score = decayedSuspicion(s, now) + weight
+		s.UpdatedAt = timeToMs(now)
+		if s.Score >= suspicionReportThreshold && (s.ReportedAt == 0 || now.Sub(msToTime(s.ReportedAt)) >= suspicionReportCooldown) {
+			s.ReportedAt = timeToMs(now)
+			report = true
+		}
+		return s
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return s.Score, report, nil
+}
+
+// recordDamageViolation logs a clamped or rejected summon damage line, adds
+// it to the owner's suspicion score, and files a detection report when the
+// score crosses the threshold.
+func (p *ProcessorImpl) recordDamageViolation(m Model, tgt AttackTarget, ceiling int64, verdict damageVerdict) {
+	weight := suspicionClampWeight
+	event := "summon_damage_clamped"
+	if verdict == damageRejected {
+		weight = suspicionRejectWeight
+		event = "summon_damage_rejected"
+	}
+	score, report, err := GetRegistry().RecordSuspicion(p.ctx, p.t, m.OwnerCharacterId(), weight, time.Now())
+	if err != nil {
+		p.l.WithError(err).Errorf("Unable to record summon damage suspicion for character [%d].", m.OwnerCharacterId())
+	}
+	p.l.WithFields(logrus.Fields{
+		"character_id": m.OwnerCharacterId(),
+		"summon_id":    m.Id(),
+		"skill_id":     m.SkillId(),
+		"monster_id":   tgt.MonsterId,
+		"reported":     tgt.Damage,
+		"ceiling":      ceiling,
+		"suspicion":    score,
+	}).Warn(event)
+
+	if !report {
+		return
+	}
+	desc := fmt.Sprintf("Summon damage violation: summon skill [%d] reported line [%d] against a ceiling of [%d]; suspicion score [%.1f].", m.SkillId(), tgt.Damage, ceiling, score)
+	if err := p.emit(reportmsg.EnvCommandTopic, reportmsg.DetectionProvider(m.Field().WorldId(), m.Field().ChannelId(), m.OwnerCharacterId(), desc)); err != nil {
+		p.l.WithError(err).Errorf("Unable to file summon damage detection report for character [%d].", m.OwnerCharacterId())
+	}
+}
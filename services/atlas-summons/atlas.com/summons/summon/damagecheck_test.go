@@ -0,0 +1,111 @@
+package summon
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+
+	tenant "github.com/Chronicle20/atlas/libs/atlas-tenant"
+)
+
+func TestCheckSummonDamage(t *testing.T) {
+	cases := []struct {
+		name     string
+		reported uint32
+		ceiling  int64
+		want     uint32
+		verdict  damageVerdict
+	}{
+		{"within ceiling", 900, 1000, 900, damageOk},
+		{"above ceiling is clamped", 1500, 1000, 1000, damageClamped},
+		{"beyond reject factor is rejected", 2001, 1000, 0, damageRejected},
+		{"no ceiling passes", 150000, 0, 150000, damageOk},
+		{"above the client line cap is rejected without a ceiling", 200000, 0, 0, damageRejected},
+	}
+	for _, tc := range cases {
+		got, verdict := checkSummonDamage(tc.reported, tc.ceiling)
+		if got != tc.want || verdict != tc.verdict {
+			t.Fatalf("%s: got (%d, %d), want (%d, %d)", tc.name, got, verdict, tc.want, tc.verdict)
+		}
+	}
+}
+
+func TestRecordSuspicionDecaysAndReportsOnce(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+	r := newRegistry(goredis.NewClient(&goredis.Options{Addr: mr.Addr()}))
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	score, report, err := r.RecordSuspicion(ctx, ten, 42, 20, now)
+	if err != nil || report || score != 20 {
+		t.Fatalf("first record: score=%v report=%t err=%v", score, report, err)
+	}
+
+	// One half-life later the 20 has decayed to 10.
+	score, report, _ = r.RecordSuspicion(ctx, ten, 42, suspicionRejectWeight, now.Add(suspicionHalfLife))
+	if report || score != 15 {
+		t.Fatalf("after decay: score=%v report=%t", score, report)
+	}
+
+	score, report, _ = r.RecordSuspicion(ctx, ten, 42, 10, now.Add(suspicionHalfLife))
+	if !report || score != 25 {
+		t.Fatalf("threshold: score=%v report=%t", score, report)
+	}
+	if _, report, _ = r.RecordSuspicion(ctx, ten, 42, 10, now.Add(suspicionHalfLife+time.Minute)); report {
+		t.Fatalf("expected the cooldown to suppress a second report")
+	}
+
+	// Another character's score is separate.
+	if score, _, _ = r.RecordSuspicion(ctx, ten, 7, 1, now); score != 1 {
+		t.Fatalf("expected a fresh score for another character, got %v", score)
+	}
+}
+
+// Owners' summon attacks can be handled on several pods at once; only one of
+// the records that cross the threshold together may file the report.
+func TestRecordSuspicionConcurrentRecordsReportOnce(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+	r := newRegistry(goredis.NewClient(&goredis.Options{Addr: mr.Addr()}))
+	ten, _ := tenant.Create(uuid.New(), "GMS", 83, 1)
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	var reports atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, report, err := r.RecordSuspicion(ctx, ten, 42, suspicionReportThreshold, now)
+			if err != nil {
+				t.Errorf("RecordSuspicion: %v", err)
+			}
+			if report {
+				reports.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := reports.Load(); got != 1 {
+		t.Fatalf("expected exactly one report, got %d", got)
+	}
+	if score, _, _ := r.RecordSuspicion(ctx, ten, 42, 0, now); score != 20*suspicionReportThreshold {
+		t.Fatalf("expected every record to count, got score %v", score)
+	}
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/item"
-	skillconst "github.com/Chronicle20/atlas/libs/atlas-constants/skill"
 	summonconst "github.com/Chronicle20/atlas/libs/atlas-constants/summon"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
@@ -108,11 +107,12 @@ func (p *ProcessorImpl) GetInField(f field.Model) ([]Model, error) {
 // Spawn classifies the cast skill against the summon roster, removes any
 // same-skill or mobility-conflicting existing summon for the owner, fetches the
 // skill effect for HP/duration, persists the new summon, and emits CREATED.
-// A non-summon skill is a graceful no-op (FR-1.3). For a Beholder
-// (TypeBuffAura) the caster's trained AURA_OF_THE_BEHOLDER / HEX_OF_THE_BEHOLDER
-// levels (auraLevel/hexLevel, resolved channel-side from the caster's skill
-// book and threaded through the SPAWN command) drive the heal/buff snapshot;
-// other summons ignore them.
+// A non-summon skill is a graceful no-op (FR-1.3). The summon's behavior
+// (behavior.go) supplies its HP and any spawn-time setup: for a Beholder the
+// caster's trained AURA_OF_THE_BEHOLDER / HEX_OF_THE_BEHOLDER levels
+// (auraLevel/hexLevel, resolved channel-side from the caster's skill book and
+// threaded through the SPAWN command) drive the heal/buff snapshot; other
+// summons ignore them. A puppet is registered with atlas-monsters.
 func (p *ProcessorImpl) Spawn(f field.Model, ownerCharacterId uint32, skillId uint32, skillLevel byte, x int16, y int16, auraLevel byte, hexLevel byte) (Model, error) {
 	entry, ok := summonconst.Lookup(skillId)
 	if !ok {
@@ -138,11 +138,10 @@ func (p *ProcessorImpl) Spawn(f field.Model, ownerCharacterId uint32, skillId ui
 	now := time.Now()
 	expires := now.Add(time.Duration(eff.Duration()) * time.Millisecond)
 
+	bh, _ := behaviorFor(skillId)
 	hp := int32(0)
-	if entry.Type == summonconst.TypePuppet {
-		hp = int32(eff.X())
-	} else if entry.Type == summonconst.TypeBuffAura {
-		hp = int32(eff.X()) + 1 // Beholder hp = effect x + 1
+	if bh.hp != nil {
+		hp = bh.hp(eff)
 	}
 
 	b := NewBuilder().
@@ -150,46 +149,8 @@ func (p *ProcessorImpl) Spawn(f field.Model, ownerCharacterId uint32, skillId ui
 		SetSummonType(SummonType(entry.Type)).SetMovementType(MovementType(entry.Movement)).
 		SetField(f).SetX(x).SetY(y).SetHp(hp).SetMaxHp(hp).
 		SetSpawnTime(now).SetExpiresAt(expires).SetAnimated(true)
-
-	// Phase 5 (FR-5.x): snapshot the Beholder's aura heal + hex buff at spawn so
-	// the periodic sweep (Task 5.2) heals/buffs the owner without re-resolving
-	// skill data each tick. The resolved values are a faithful read of the
-	// caster's trained AURA_OF_THE_BEHOLDER (1320008, heal hp + interval x) and
-	// HEX_OF_THE_BEHOLDER (1320009, buff statups + interval x + duration time).
-	if entry.Type == summonconst.TypeBuffAura {
-		if auraLevel > 0 {
-			aura, aerr := p.effects.GetEffect(uint32(skillconst.DarkKnightAuraOfTheBeholderId), auraLevel)
-			if aerr != nil {
-				p.l.WithError(aerr).Warnf("No AURA_OF_THE_BEHOLDER effect for level [%d]; Beholder [%d] will not heal.", auraLevel, id)
-			} else {
-				healInterval := time.Duration(aura.X()) * time.Second
-				b.SetHealAmount(aura.Hp()).SetHealInterval(healInterval).SetNextHealAt(now.Add(healInterval))
-			}
-		}
-		if hexLevel > 0 {
-			hex, herr := p.effects.GetEffect(uint32(skillconst.DarkKnightHexOfTheBeholderId), hexLevel)
-			if herr != nil {
-				p.l.WithError(herr).Warnf("No HEX_OF_THE_BEHOLDER effect for level [%d]; Beholder [%d] will not buff.", hexLevel, id)
-			} else {
-				buffInterval := time.Duration(hex.X()) * time.Second
-				changes := make([]StatChange, 0, len(hex.Statups()))
-				for _, su := range hex.Statups() {
-					changes = append(changes, StatChange{Type: su.Type, Amount: su.Amount})
-				}
-				// The buff sourceId MUST be the positive, real skill id. It is
-				// written into the client give-buff packet as the per-stat rSkillID,
-				// and the v83 client looks up GetSkillTemplate(rSkillID) to render the
-				// buff icon/tooltip — a negative id resolves to null and crashes the
-				// client. (design.md Q3 negated it for server-side collision
-				// avoidance, but HEX_OF_THE_BEHOLDER is only ever applied by the
-				// Beholder, so there is no real collision and the negation was
-				// client-fatal.)
-				b.SetBuffInterval(buffInterval).SetNextBuffAt(now.Add(buffInterval)).
-					SetBuffDuration(hex.Duration()).SetBuffLevel(hexLevel).
-					SetBuffSourceId(int32(skillconst.DarkKnightHexOfTheBeholderId)).
-					SetBuffChanges(changes)
-			}
-		}
+	if bh.prepare != nil {
+		bh.prepare(p, b, auraLevel, hexLevel, now)
 	}
 
 	m := b.Build()
@@ -201,14 +162,9 @@ func (p *ProcessorImpl) Spawn(f field.Model, ownerCharacterId uint32, skillId ui
 	if err := p.emit(EnvEventTopicSummonStatus, createdEventProvider(m)); err != nil {
 		p.l.WithError(err).Errorf("Unable to emit CREATED for summon [%d].", id)
 	}
-	// FR-4.x: register puppets with atlas-monsters so the monster controller
-	// picker biases toward the puppet's owner.
-	if m.IsPuppet() {
-		if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.AddPuppetProvider(m.Field(), m.OwnerCharacterId(), m.X(), m.Y())); err != nil {
-			p.l.WithError(err).Errorf("Unable to emit ADD_PUPPET for summon [%d].", id)
-		}
+	if bh.spawned != nil {
+		bh.spawned(p, m)
 	}
-	// Phase 5: Beholder timer init.
 	return m, nil
 }
 
@@ -244,13 +200,18 @@ func (p *ProcessorImpl) resolveOwned(id uint32, senderCharacterId uint32, prefer
 // Move relays an owner's summon-move packet: it verifies ownership (a character
 // may only move a summon it owns — §11), updates the persisted position, and
 // emits MOVED carrying the raw movement blob for byte-faithful rebroadcast. A
-// missing summon or a non-owner sender is a graceful no-op (returns nil).
+// missing summon, a non-owner sender, or a stationary summon (puppets, turrets)
+// is a graceful no-op (returns nil).
 func (p *ProcessorImpl) Move(id uint32, senderCharacterId uint32, x int16, y int16, stance byte, rawMovement []byte) error {
 	m, ok := p.resolveOwned(id, senderCharacterId, false)
 	if !ok {
 		return nil
 	}
 	id = m.Id()
+	if bh, _ := behaviorFor(m.SkillId()); bh.stationary {
+		p.l.Debugf("Summon [%d] of owner [%d] is stationary; dropping move.", id, m.OwnerCharacterId())
+		return nil
+	}
 	updated, err := GetRegistry().Update(p.ctx, p.t, id, func(cur Model) Model {
 		return cur.Move(x, y, stance)
 	})
@@ -261,18 +222,25 @@ func (p *ProcessorImpl) Move(id uint32, senderCharacterId uint32, x int16, y int
 }
 
 // Attack relays an owner's summon-attack packet. It verifies ownership, then for
-// each reported target it credits the OWNER with the (server-clamped) damage via a
-// monster DAMAGE command (FR-4.2 — so XP/drops/kill credit accrue to the player,
-// not the summon), applies stun/freeze where the roster + proc allow (FR-4.4),
-// and emits an ATTACKED event carrying the clamped targets for rebroadcast.
-// Gaviota self-cancels after a single attack (FR-4.5). A missing summon or a
-// non-owner sender is a graceful no-op (returns nil).
+// each reported target it validates the damage against the owner's per-hit
+// ceiling (damagecheck.go), credits the OWNER with the validated damage and the
+// summon's element via a monster DAMAGE command (FR-4.2 — so XP/drops/kill
+// credit accrue to the player, not the summon), applies stun/freeze where the
+// roster + proc allow (FR-4.4), and emits an ATTACKED event carrying the
+// validated targets for rebroadcast. Gaviota self-cancels after a single attack
+// (FR-4.5). A missing summon, a non-owner sender, or a summon that does not
+// attack is a graceful no-op (returns nil).
 func (p *ProcessorImpl) Attack(id uint32, senderCharacterId uint32, direction byte, targets []AttackTarget) error {
 	m, ok := p.resolveOwned(id, senderCharacterId, false)
 	if !ok {
 		return nil // already gone / no owned summon
 	}
 	id = m.Id()
+	bh, _ := behaviorFor(m.SkillId())
+	if !bh.attacks {
+		p.l.Debugf("Summon [%d] of owner [%d] does not attack; dropping attack.", id, m.OwnerCharacterId())
+		return nil
+	}
 
 	eff, err := p.effects.GetEffect(m.SkillId(), m.SkillLevel())
 	if err != nil {
@@ -281,7 +249,7 @@ func (p *ProcessorImpl) Attack(id uint32, senderCharacterId uint32, direction by
 
 	// Owner combat stats drive the weapon-type-aware per-hit ceiling (FR-4.3;
 	// see FaithfulMaxPerHit). If stats are unavailable, set
-	// max=0 so clampDamage treats it as "no ceiling" — never zero legit damage.
+	// max=0 so checkSummonDamage treats it as "no ceiling" — never zero legit damage.
 	var max int64
 	stats, serr := p.stats.GetByCharacter(m.Field().WorldId(), m.Field().ChannelId(), m.OwnerCharacterId())
 	if serr != nil {
@@ -308,17 +276,17 @@ func (p *ProcessorImpl) Attack(id uint32, senderCharacterId uint32, direction by
 
 	clampedTargets := make([]AttackTarget, 0, len(targets))
 	for _, tgt := range targets {
-		dmg := clampDamage(tgt.Damage, max)
-		if max > 0 && int64(tgt.Damage) > max {
-			// FR-4.3 alert: warn-only (clamp-and-continue). Intentionally does NOT
-			// emit to COMMAND_TOPIC_BAN (context.md §9 — false-positive risk).
-			p.l.Infof("Summon [%d] owner [%d] reported damage [%d] > ceiling [%d] on mob [%d]; clamped. (FR-4.3 alert)",
-				id, m.OwnerCharacterId(), tgt.Damage, max, tgt.MonsterId)
+		dmg, verdict := checkSummonDamage(tgt.Damage, max)
+		if verdict != damageOk {
+			p.recordDamageViolation(m, tgt, max, verdict)
 		}
 		clampedTargets = append(clampedTargets, AttackTarget{MonsterId: tgt.MonsterId, Damage: dmg})
+		if verdict == damageRejected {
+			continue
+		}
 
 		// FR-4.2: credit the owner via monster DAMAGE.
-		if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.MonsterDamageProvider(m.Field(), tgt.MonsterId, m.OwnerCharacterId(), []uint32{dmg})); err != nil {
+		if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.MonsterDamageProvider(m.Field(), tgt.MonsterId, m.OwnerCharacterId(), []uint32{dmg}, bh.element)); err != nil {
 			p.l.WithError(err).Errorf("Unable to emit monster DAMAGE for summon [%d] target [%d].", id, tgt.MonsterId)
 		}
 
@@ -392,7 +360,7 @@ func (p *ProcessorImpl) Damage(id uint32, senderCharacterId uint32, amount int32
 	// negative and despawn them on the FIRST monster hit. The v83 client still sends
 	// a DamageSummon when a monster touches a non-puppet summon (observed on Phoenix
 	// 3121006), so guard here: non-puppets are immune.
-	if !m.IsPuppet() {
+	if bh, _ := behaviorFor(m.SkillId()); !bh.absorbsDamage {
 		return nil
 	}
 	id = m.Id()
@@ -425,13 +393,11 @@ func (p *ProcessorImpl) Despawn(id uint32, animated bool) error {
 	if err := p.emit(EnvEventTopicSummonStatus, destroyedEventProvider(m, animated)); err != nil {
 		p.l.WithError(err).Errorf("Unable to emit DESTROYED for summon [%d].", id)
 	}
-	// FR-4.x: clear the puppet registration in atlas-monsters.
-	if m.IsPuppet() {
-		if err := p.emit(monstermsg.EnvCommandTopic, monstermsg.RemovePuppetProvider(m.Field(), m.OwnerCharacterId())); err != nil {
-			p.l.WithError(err).Errorf("Unable to emit REMOVE_PUPPET for summon [%d].", id)
-		}
+	// FR-4.x: a puppet clears its registration in atlas-monsters. Beholder
+	// timer cleanup is implicit (registry removal).
+	if bh, _ := behaviorFor(m.SkillId()); bh.despawned != nil {
+		bh.despawned(p, m)
 	}
-	// Phase 5: Beholder timer cleanup is implicit (registry removal).
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	monstermsg "atlas-summons/monster"
+	reportmsg "atlas-summons/report"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
@@ -137,7 +138,9 @@ func TestAttackCreditsOwnerAndClamps(t *testing.T) {
 	p, captured := newAttackProcessor(t, effectAttacker(50, 1.0), 200, nil)
 	m := spawnAttacker(t, p, 3111005, 42)
 
-	const reported = uint32(4000000) // absurd, must be clamped
+	// Ceiling for these stats is 4860; 6000 is above it but within the
+	// rejection factor, so it is clamped rather than rejected.
+	const reported = uint32(6000)
 	err := p.Attack(m.Id(), 42, 0, []AttackTarget{{MonsterId: 9999, Damage: reported}})
 	if err != nil {
 		t.Fatalf("Attack returned error: %v", err)
@@ -226,3 +229,113 @@ func TestAttackByNonOwnerDropped(t *testing.T) {
 		}
 	}
 }
+
+// messagesOf returns the captured messages on topic with the given type.
+func messagesOf(captured []capturedMessage, topic string, typ string) []capturedMessage {
+	var out []capturedMessage
+	for _, c := range captured {
+		if c.topic == topic && c.payload["type"] == typ {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func TestAttackRejectsImpossibleDamage(t *testing.T) {
+	p, captured := newAttackProcessor(t, effectAttacker(50, 1.0), 200, nil)
+	m := spawnAttacker(t, p, 3111005, 42)
+
+	// Far beyond twice the 4860 ceiling: no unmodified client sends this.
+	if err := p.Attack(m.Id(), 42, 0, []AttackTarget{{MonsterId: 9999, Damage: 4000000}}); err != nil {
+		t.Fatalf("Attack returned error: %v", err)
+	}
+	if got := messagesOf(*captured, monstermsg.EnvCommandTopic, monstermsg.CommandTypeDamage); len(got) != 0 {
+		t.Fatalf("expected no DAMAGE for a rejected line; got %+v", got)
+	}
+	if got := messagesOf(*captured, monstermsg.EnvCommandTopic, monstermsg.CommandTypeApplyStatus); len(got) != 0 {
+		t.Fatalf("expected no APPLY_STATUS for a rejected line; got %+v", got)
+	}
+	attacked := messagesOf(*captured, EnvEventTopicSummonStatus, EventSummonStatusAttacked)
+	if len(attacked) != 1 {
+		t.Fatalf("expected one ATTACKED event; got %+v", *captured)
+	}
+	tgts, _ := attacked[0].payload["body"].(map[string]any)["targets"].([]any)
+	if d := tgts[0].(map[string]any)["damage"].(float64); d != 0 {
+		t.Fatalf("expected ATTACKED to rebroadcast the rejected line as 0, got %v", d)
+	}
+}
+
+func TestAttackFilesDetectionReportPastThreshold(t *testing.T) {
+	p, captured := newAttackProcessor(t, effectAttacker(50, 1.0), 200, nil)
+	m := spawnAttacker(t, p, 3111005, 42)
+
+	// Each rejected line weighs 5 and the score decays between lines, so the
+	// sixth is the first certain to cross the threshold of 25.
+	targets := make([]AttackTarget, 6)
+	for i := range targets {
+		targets[i] = AttackTarget{MonsterId: uint32(9000 + i), Damage: 4000000}
+	}
+	if err := p.Attack(m.Id(), 42, 0, targets); err != nil {
+		t.Fatalf("Attack returned error: %v", err)
+	}
+	reports := messagesOf(*captured, reportmsg.EnvCommandTopic, reportmsg.CommandTypeCreate)
+	if len(reports) != 1 {
+		t.Fatalf("expected exactly one detection report; got %+v", reports)
+	}
+	body, _ := reports[0].payload["body"].(map[string]any)
+	if body["kind"] != reportmsg.KindDetection || uint32(body["accusedId"].(float64)) != 42 {
+		t.Fatalf("expected a detection report against owner 42, got %+v", body)
+	}
+
+	// The cooldown holds back a second report.
+	if err := p.Attack(m.Id(), 42, 0, targets); err != nil {
+		t.Fatalf("Attack returned error: %v", err)
+	}
+	if got := messagesOf(*captured, reportmsg.EnvCommandTopic, reportmsg.CommandTypeCreate); len(got) != 1 {
+		t.Fatalf("expected the report cooldown to hold, got %d reports", len(got))
+	}
+}
+
+func TestAttackCarriesSummonElement(t *testing.T) {
+	cases := []struct {
+		skillId uint32
+		element string
+	}{
+		{2321003, "HOLY"},      // Bahamut
+		{2221005, "FIRE"},      // Ifrit
+		{2121005, "ICE"},       // Elquines
+		{15001004, "LIGHTING"}, // Thunder Breaker Lightning
+		{3111005, ""},          // Silver Hawk, physical
+	}
+	for _, tc := range cases {
+		p, captured := newAttackProcessor(t, effectAttacker(50, 1.0), 200, nil)
+		m := spawnAttacker(t, p, tc.skillId, 42)
+		if err := p.Attack(m.Id(), 42, 0, []AttackTarget{{MonsterId: 9999, Damage: 1000}}); err != nil {
+			t.Fatalf("Attack returned error: %v", err)
+		}
+		dmg := messagesOf(*captured, monstermsg.EnvCommandTopic, monstermsg.CommandTypeDamage)
+		if len(dmg) != 1 {
+			t.Fatalf("skill [%d]: expected one DAMAGE, got %+v", tc.skillId, dmg)
+		}
+		body, _ := dmg[0].payload["body"].(map[string]any)
+		got, _ := body["element"].(string)
+		if got != tc.element {
+			t.Fatalf("skill [%d]: expected element %q, got %q", tc.skillId, tc.element, got)
+		}
+	}
+}
+
+func TestAttackByNonAttackerDropped(t *testing.T) {
+	p, captured := newAttackProcessor(t, effectWithX(800, 60000), 200, nil)
+	m := spawnAttacker(t, p, 3111002, 42) // Ranger Puppet
+
+	if err := p.Attack(m.Id(), 42, 0, []AttackTarget{{MonsterId: 9999, Damage: 1000}}); err != nil {
+		t.Fatalf("Attack returned error: %v", err)
+	}
+	if got := messagesOf(*captured, monstermsg.EnvCommandTopic, monstermsg.CommandTypeDamage); len(got) != 0 {
+		t.Fatalf("expected no DAMAGE from a puppet; got %+v", got)
+	}
+	if got := messagesOf(*captured, EnvEventTopicSummonStatus, EventSummonStatusAttacked); len(got) != 0 {
+		t.Fatalf("expected no ATTACKED from a puppet; got %+v", got)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Chronicle20/atlas/libs/atlas-constants/channel"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/field"
 	_map "github.com/Chronicle20/atlas/libs/atlas-constants/map"
+	summonconst "github.com/Chronicle20/atlas/libs/atlas-constants/summon"
 	"github.com/Chronicle20/atlas/libs/atlas-constants/world"
 	"github.com/Chronicle20/atlas/libs/atlas-model/model"
 	objectid "github.com/Chronicle20/atlas/libs/atlas-object-id"
@@ -53,16 +54,20 @@ func newMoveProcessor(t *testing.T) (*ProcessorImpl, tenant.Model, context.Conte
 	return p, ten, ctx, emitted
 }
 
-// putSummon persists a summon directly via the registry so Move tests don't
-// depend on Spawn/effect data.
-func putSummon(t *testing.T, ctx context.Context, ten tenant.Model, ownerCharacterId uint32, x, y int16) Model {
+// putSummon persists a summon of the given roster skill directly via the
+// registry so Move tests don't depend on Spawn/effect data.
+func putSummon(t *testing.T, ctx context.Context, ten tenant.Model, ownerCharacterId uint32, skillId uint32, x, y int16) Model {
 	t.Helper()
+	entry, ok := summonconst.Lookup(skillId)
+	if !ok {
+		t.Fatalf("skill [%d] is not a summon", skillId)
+	}
 	f := field.NewBuilder(world.Id(0), channel.Id(0), _map.Id(100000000)).SetInstance(uuid.Nil).Build()
 	id := GetIdAllocator().Allocate(ctx, ten)
 	m := NewBuilder().
-		SetId(id).SetOwnerCharacterId(ownerCharacterId).SetSkillId(3111002).SetSkillLevel(20).
-		SetSummonType(SummonTypePuppet).SetMovementType(MovementStationary).
-		SetField(f).SetX(x).SetY(y).SetHp(800).SetMaxHp(800).SetAnimated(true).Build()
+		SetId(id).SetOwnerCharacterId(ownerCharacterId).SetSkillId(skillId).SetSkillLevel(20).
+		SetSummonType(SummonType(entry.Type)).SetMovementType(MovementType(entry.Movement)).
+		SetField(f).SetX(x).SetY(y).SetAnimated(true).Build()
 	if err := GetRegistry().Put(ctx, ten, m); err != nil {
 		t.Fatalf("Put: %v", err)
 	}
@@ -71,7 +76,7 @@ func putSummon(t *testing.T, ctx context.Context, ten tenant.Model, ownerCharact
 
 func TestMoveByOwnerUpdatesPosition(t *testing.T) {
 	p, ten, ctx, emitted := newMoveProcessor(t)
-	m := putSummon(t, ctx, ten, 42, 100, -50)
+	m := putSummon(t, ctx, ten, 42, 3121006, 100, -50) // Phoenix, circle-follow
 
 	raw := []byte{0x01, 0x02, 0x03}
 	if err := p.Move(m.Id(), 42, 250, -120, 3, raw); err != nil {
@@ -95,7 +100,7 @@ func TestMoveByOwnerUpdatesPosition(t *testing.T) {
 
 func TestMoveByNonOwnerRejected(t *testing.T) {
 	p, ten, ctx, emitted := newMoveProcessor(t)
-	m := putSummon(t, ctx, ten, 42, 100, -50)
+	m := putSummon(t, ctx, ten, 42, 3121006, 100, -50)
 
 	if err := p.Move(m.Id(), 99, 250, -120, 3, nil); err != nil {
 		t.Fatalf("Move by non-owner should be a nil no-op, got %v", err)
@@ -112,3 +117,25 @@ func TestMoveByNonOwnerRejected(t *testing.T) {
 		t.Fatalf("expected no emit for non-owner move, got %v", *emitted)
 	}
 }
+
+func TestMoveOfStationarySummonDropped(t *testing.T) {
+	for _, skillId := range []uint32{5211001, 3111002} { // Octopus turret, Ranger Puppet
+		p, ten, ctx, emitted := newMoveProcessor(t)
+		m := putSummon(t, ctx, ten, 42, skillId, 100, -50)
+
+		if err := p.Move(m.Id(), 42, 250, -120, 3, nil); err != nil {
+			t.Fatalf("Move of stationary summon should be a nil no-op, got %v", err)
+		}
+
+		got, err := GetRegistry().Get(ctx, ten, m.Id())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.X() != 100 || got.Y() != -50 {
+			t.Fatalf("stationary summon [%d] moved: got (%d,%d) want (100,-50)", skillId, got.X(), got.Y())
+		}
+		if len(*emitted) != 0 {
+			t.Fatalf("expected no emit for stationary summon [%d] move, got %v", skillId, *emitted)
+		}
+	}
+}
@@ -164,6 +164,9 @@ type Registry struct {
 	// tenant-scoped the same way.
 	fieldIdx *atlasredis.TenantKeyedSet[string]
 	ownerIdx *atlasredis.TenantKeyedSet[string]
+	// suspicion backs the per-owner summon-damage suspicion score
+	// (damagecheck.go): atlas:summon-suspicion:<tenant>:<characterId>.
+	suspicion *atlasredis.TenantRegistry[uint32, storedSuspicion]
 }
 
 var (
@@ -173,9 +176,10 @@ var (
 
 func newRegistry(rc *goredis.Client) *Registry {
 	return &Registry{
-		reg:      atlasredis.NewTenantRegistry[uint32, storedSummon](rc, "summon", func(id uint32) string { return strconv.FormatUint(uint64(id), 10) }),
-		fieldIdx: atlasredis.NewTenantKeyedSet[string](rc, "summon-map", func(s string) string { return s }),
-		ownerIdx: atlasredis.NewTenantKeyedSet[string](rc, "summon-owner", func(s string) string { return s }),
+		reg:       atlasredis.NewTenantRegistry[uint32, storedSummon](rc, "summon", func(id uint32) string { return strconv.FormatUint(uint64(id), 10) }),
+		fieldIdx:  atlasredis.NewTenantKeyedSet[string](rc, "summon-map", func(s string) string { return s }),
+		ownerIdx:  atlasredis.NewTenantKeyedSet[string](rc, "summon-owner", func(s string) string { return s }),
+		suspicion: atlasredis.NewTenantRegistry[uint32, storedSuspicion](rc, "summon-suspicion", func(id uint32) string { return strconv.FormatUint(uint64(id), 10) }),
 	}
 }
 
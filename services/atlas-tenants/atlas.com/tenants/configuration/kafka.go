@@ -37,6 +37,12 @@ const (
 	EventTypeImprintConfigCreated = "IMPRINT_CONFIG_CREATED"
 	EventTypeImprintConfigUpdated = "IMPRINT_CONFIG_UPDATED"
 	EventTypeImprintConfigDeleted = "IMPRINT_CONFIG_DELETED"
+	EventTypeStorageConfigCreated = "STORAGE_CONFIG_CREATED"
+	EventTypeStorageConfigUpdated = "STORAGE_CONFIG_UPDATED"
+	EventTypeStorageConfigDeleted = "STORAGE_CONFIG_DELETED"
+	EventTypeFameConfigCreated    = "FAME_CONFIG_CREATED"
+	EventTypeFameConfigUpdated    = "FAME_CONFIG_UPDATED"
+	EventTypeFameConfigDeleted    = "FAME_CONFIG_DELETED"
 )
 
 // ConfigurationStatusEvent is a generic event for configuration status changes
@@ -143,6 +149,30 @@ func CreateKiteConfigStatusEventProvider(tenantId uuid.UUID, eventType string, k
 	return producer.SingleMessageProvider(key, value)
 }
 
+// CreateStorageConfigStatusEventProvider creates a provider for storage-config status events
+func CreateStorageConfigStatusEventProvider(tenantId uuid.UUID, eventType string, storageConfigId string) model.Provider[[]kafka.Message] {
+	key := []byte(tenantId.String())
+	value := ConfigurationStatusEvent{
+		TenantId:     tenantId,
+		Type:         eventType,
+		ResourceType: "storage-config",
+		ResourceId:   storageConfigId,
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
+// CreateFameConfigStatusEventProvider creates a provider for fame-config status events
+func CreateFameConfigStatusEventProvider(tenantId uuid.UUID, eventType string, fameConfigId string) model.Provider[[]kafka.Message] {
+	key := []byte(tenantId.String())
+	value := ConfigurationStatusEvent{
+		TenantId:     tenantId,
+		Type:         eventType,
+		ResourceType: "fame-config",
+		ResourceId:   fameConfigId,
+	}
+	return producer.SingleMessageProvider(key, value)
+}
+
 // CreateRankingsStatusEventProvider creates a provider for rankings configuration status events
 func CreateRankingsStatusEventProvider(tenantId uuid.UUID, eventType string, rankingsId string) model.Provider[[]kafka.Message] {
 	key := []byte(tenantId.String())
@@ -82,6 +82,26 @@ type ProcessorMock struct {
 	GetKiteConfigFunc           func(tenantID uuid.UUID) (map[string]interface{}, error)
 	KiteConfigProviderFunc      func(tenantID uuid.UUID) model.Provider[map[string]interface{}]
 
+	// Kite config operations
+	CreateStorageConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error)
+	CreateStorageConfigAndEmitFunc func(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error)
+	UpdateStorageConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error)
+	UpdateStorageConfigAndEmitFunc func(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error)
+	DeleteStorageConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) error
+	DeleteStorageConfigAndEmitFunc func(tenantID uuid.UUID) error
+	GetStorageConfigFunc           func(tenantID uuid.UUID) (map[string]interface{}, error)
+	StorageConfigProviderFunc      func(tenantID uuid.UUID) model.Provider[map[string]interface{}]
+
+	// Fame config operations
+	CreateFameConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error)
+	CreateFameConfigAndEmitFunc func(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error)
+	UpdateFameConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error)
+	UpdateFameConfigAndEmitFunc func(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error)
+	DeleteFameConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) error
+	DeleteFameConfigAndEmitFunc func(tenantID uuid.UUID) error
+	GetFameConfigFunc           func(tenantID uuid.UUID) (map[string]interface{}, error)
+	FameConfigProviderFunc      func(tenantID uuid.UUID) model.Provider[map[string]interface{}]
+
 	// Imprint config operations
 	CreateImprintConfigFunc        func(mb *message.Buffer) func(tenantID uuid.UUID) func(config map[string]interface{}) (configuration.Model, error)
 	CreateImprintConfigAndEmitFunc func(tenantID uuid.UUID, config map[string]interface{}) (configuration.Model, error)
@@ -994,3 +1014,155 @@ func (m *ProcessorMock) KiteConfigProvider(tenantID uuid.UUID) model.Provider[ma
 		return map[string]interface{}{}, nil
 	}
 }
+
+// CreateStorageConfig is a mock implementation
+func (m *ProcessorMock) CreateStorageConfig(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+	if m.CreateStorageConfigFunc != nil {
+		return m.CreateStorageConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+		return func(cfg map[string]interface{}) (configuration.Model, error) {
+			return configuration.Model{}, nil
+		}
+	}
+}
+
+// CreateStorageConfigAndEmit is a mock implementation
+func (m *ProcessorMock) CreateStorageConfigAndEmit(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error) {
+	if m.CreateStorageConfigAndEmitFunc != nil {
+		return m.CreateStorageConfigAndEmitFunc(tenantID, cfg)
+	}
+	return configuration.Model{}, nil
+}
+
+// UpdateStorageConfig is a mock implementation
+func (m *ProcessorMock) UpdateStorageConfig(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+	if m.UpdateStorageConfigFunc != nil {
+		return m.UpdateStorageConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+		return func(cfg map[string]interface{}) (configuration.Model, error) {
+			return configuration.Model{}, nil
+		}
+	}
+}
+
+// UpdateStorageConfigAndEmit is a mock implementation
+func (m *ProcessorMock) UpdateStorageConfigAndEmit(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error) {
+	if m.UpdateStorageConfigAndEmitFunc != nil {
+		return m.UpdateStorageConfigAndEmitFunc(tenantID, cfg)
+	}
+	return configuration.Model{}, nil
+}
+
+// DeleteStorageConfig is a mock implementation
+func (m *ProcessorMock) DeleteStorageConfig(mb *message.Buffer) func(tenantID uuid.UUID) error {
+	if m.DeleteStorageConfigFunc != nil {
+		return m.DeleteStorageConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) error {
+		return nil
+	}
+}
+
+// DeleteStorageConfigAndEmit is a mock implementation
+func (m *ProcessorMock) DeleteStorageConfigAndEmit(tenantID uuid.UUID) error {
+	if m.DeleteStorageConfigAndEmitFunc != nil {
+		return m.DeleteStorageConfigAndEmitFunc(tenantID)
+	}
+	return nil
+}
+
+// GetStorageConfig is a mock implementation
+func (m *ProcessorMock) GetStorageConfig(tenantID uuid.UUID) (map[string]interface{}, error) {
+	if m.GetStorageConfigFunc != nil {
+		return m.GetStorageConfigFunc(tenantID)
+	}
+	return map[string]interface{}{}, nil
+}
+
+// StorageConfigProvider is a mock implementation
+func (m *ProcessorMock) StorageConfigProvider(tenantID uuid.UUID) model.Provider[map[string]interface{}] {
+	if m.StorageConfigProviderFunc != nil {
+		return m.StorageConfigProviderFunc(tenantID)
+	}
+	return func() (map[string]interface{}, error) {
+		return map[string]interface{}{}, nil
+	}
+}
+
+// CreateFameConfig is a mock implementation
+func (m *ProcessorMock) CreateFameConfig(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+	if m.CreateFameConfigFunc != nil {
+		return m.CreateFameConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+		return func(cfg map[string]interface{}) (configuration.Model, error) {
+			return configuration.Model{}, nil
+		}
+	}
+}
+
+// CreateFameConfigAndEmit is a mock implementation
+func (m *ProcessorMock) CreateFameConfigAndEmit(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error) {
+	if m.CreateFameConfigAndEmitFunc != nil {
+		return m.CreateFameConfigAndEmitFunc(tenantID, cfg)
+	}
+	return configuration.Model{}, nil
+}
+
+// UpdateFameConfig is a mock implementation
+func (m *ProcessorMock) UpdateFameConfig(mb *message.Buffer) func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+	if m.UpdateFameConfigFunc != nil {
+		return m.UpdateFameConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) func(cfg map[string]interface{}) (configuration.Model, error) {
+		return func(cfg map[string]interface{}) (configuration.Model, error) {
+			return configuration.Model{}, nil
+		}
+	}
+}
+
+// UpdateFameConfigAndEmit is a mock implementation
+func (m *ProcessorMock) UpdateFameConfigAndEmit(tenantID uuid.UUID, cfg map[string]interface{}) (configuration.Model, error) {
+	if m.UpdateFameConfigAndEmitFunc != nil {
+		return m.UpdateFameConfigAndEmitFunc(tenantID, cfg)
+	}
+	return configuration.Model{}, nil
+}
+
+// DeleteFameConfig is a mock implementation
+func (m *ProcessorMock) DeleteFameConfig(mb *message.Buffer) func(tenantID uuid.UUID) error {
+	if m.DeleteFameConfigFunc != nil {
+		return m.DeleteFameConfigFunc(mb)
+	}
+	return func(tenantID uuid.UUID) error {
+		return nil
+	}
+}
+
+// DeleteFameConfigAndEmit is a mock implementation
+func (m *ProcessorMock) DeleteFameConfigAndEmit(tenantID uuid.UUID) error {
+	if m.DeleteFameConfigAndEmitFunc != nil {
+		return m.DeleteFameConfigAndEmitFunc(tenantID)
+	}
+	return nil
+}
+
+// GetFameConfig is a mock implementation
+func (m *ProcessorMock) GetFameConfig(tenantID uuid.UUID) (map[string]interface{}, error) {
+	if m.GetFameConfigFunc != nil {
+		return m.GetFameConfigFunc(tenantID)
+	}
+	return map[string]interface{}{}, nil
+}
+
+// FameConfigProvider is a mock implementation
+func (m *ProcessorMock) FameConfigProvider(tenantID uuid.UUID) model.Provider[map[string]interface{}] {
+	if m.FameConfigProviderFunc != nil {
+		return m.FameConfigProviderFunc(tenantID)
+	}
+	return func() (map[string]interface{}, error) {
+		return map[string]interface{}{}, nil
+	}
+}
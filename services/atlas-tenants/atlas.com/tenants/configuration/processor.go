@@ -188,6 +188,42 @@ type Processor interface {
 	// KiteConfigProvider returns a provider for the kite-configs configuration
 	KiteConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}]
 
+	// Storage config operations
+	// CreateStorageConfig creates (or replaces) the tenant's storage-configs configuration
+	CreateStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error)
+	// CreateStorageConfigAndEmit creates the storage-configs configuration and emits events
+	CreateStorageConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error)
+	// UpdateStorageConfig updates the existing storage-configs configuration
+	UpdateStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error)
+	// UpdateStorageConfigAndEmit updates the storage-configs configuration and emits events
+	UpdateStorageConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error)
+	// DeleteStorageConfig deletes the storage-configs configuration
+	DeleteStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) error
+	// DeleteStorageConfigAndEmit deletes the storage-configs configuration and emits events
+	DeleteStorageConfigAndEmit(tenantId uuid.UUID) error
+	// GetStorageConfig gets the storage-configs configuration for a tenant
+	GetStorageConfig(tenantId uuid.UUID) (map[string]interface{}, error)
+	// StorageConfigProvider returns a provider for the storage-configs configuration
+	StorageConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}]
+
+	// Fame config operations
+	// CreateFameConfig creates (or replaces) the tenant's fame-configs configuration
+	CreateFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error)
+	// CreateFameConfigAndEmit creates the fame-configs configuration and emits events
+	CreateFameConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error)
+	// UpdateFameConfig updates the existing fame-configs configuration
+	UpdateFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error)
+	// UpdateFameConfigAndEmit updates the fame-configs configuration and emits events
+	UpdateFameConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error)
+	// DeleteFameConfig deletes the fame-configs configuration
+	DeleteFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) error
+	// DeleteFameConfigAndEmit deletes the fame-configs configuration and emits events
+	DeleteFameConfigAndEmit(tenantId uuid.UUID) error
+	// GetFameConfig gets the fame-configs configuration for a tenant
+	GetFameConfig(tenantId uuid.UUID) (map[string]interface{}, error)
+	// FameConfigProvider returns a provider for the fame-configs configuration
+	FameConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}]
+
 	// Imprint config operations (FR-2.6 pending-change expiry; see imprint_handler.go)
 	// CreateImprintConfig creates a new imprint config configuration
 	CreateImprintConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(config map[string]interface{}) (Model, error)
@@ -2248,3 +2284,321 @@ func (p *ProcessorImpl) GetKiteConfig(tenantId uuid.UUID) (map[string]interface{
 func (p *ProcessorImpl) KiteConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}] {
 	return GetKiteConfigProvider(tenantId)(p.db)
 }
+
+// CreateStorageConfig creates (or replaces) the tenant's storage-configs configuration
+func (p *ProcessorImpl) CreateStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+	return func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+		return func(cfg map[string]interface{}) (Model, error) {
+			storageConfigId := ""
+			if id, ok := cfg["id"].(string); ok {
+				storageConfigId = id
+			}
+
+			resourceData, err := CreateSingleStorageConfigJsonData(cfg)
+			if err != nil {
+				return Model{}, err
+			}
+
+			existingProvider := GetByTenantIdAndResourceNameProvider(tenantId, "storage-configs")(p.db)
+			existing, err := existingProvider()
+			if err == nil {
+				existing.ResourceData = resourceData
+				if err := UpdateConfiguration(p.db, existing); err != nil {
+					return Model{}, err
+				}
+				m, err := Make(existing)
+				if err != nil {
+					return Model{}, err
+				}
+				if err := mb.Put(EventTopicConfigurationStatus, CreateStorageConfigStatusEventProvider(tenantId, EventTypeStorageConfigUpdated, storageConfigId)); err != nil {
+					return Model{}, err
+				}
+				return m, nil
+			} else if errors.Is(err, gorm.ErrRecordNotFound) {
+				entity := Entity{
+					ID:           uuid.New(),
+					TenantId:     tenantId,
+					ResourceName: "storage-configs",
+					ResourceData: resourceData,
+				}
+				if err := CreateConfiguration(p.db, entity); err != nil {
+					return Model{}, err
+				}
+				m, err := Make(entity)
+				if err != nil {
+					return Model{}, err
+				}
+				if err := mb.Put(EventTopicConfigurationStatus, CreateStorageConfigStatusEventProvider(tenantId, EventTypeStorageConfigCreated, storageConfigId)); err != nil {
+					return Model{}, err
+				}
+				return m, nil
+			}
+			return Model{}, err
+		}
+	}
+}
+
+// CreateStorageConfigAndEmit creates the storage-configs configuration and emits events
+func (p *ProcessorImpl) CreateStorageConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error) {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return Model{}, err
+	}
+	var result Model
+	txErr := database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		var err error
+		result, err = message.EmitWithResult[Model, uuid.UUID](outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) func(uuid.UUID) (Model, error) {
+			return func(tenantId uuid.UUID) (Model, error) {
+				return NewProcessor(p.l, ctx, tx).CreateStorageConfig(mb)(tenantId)(cfg)
+			}
+		})(tenantId)
+		return err
+	})
+	return result, txErr
+}
+
+// UpdateStorageConfig updates the existing storage-configs configuration
+func (p *ProcessorImpl) UpdateStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+	return func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+		return func(cfg map[string]interface{}) (Model, error) {
+			existingProvider := GetByTenantIdAndResourceNameProvider(tenantId, "storage-configs")(p.db)
+			existing, err := existingProvider()
+			if err != nil {
+				return Model{}, err
+			}
+
+			storageConfigId := ""
+			if id, ok := cfg["id"].(string); ok {
+				storageConfigId = id
+			}
+
+			resourceData, err := CreateSingleStorageConfigJsonData(cfg)
+			if err != nil {
+				return Model{}, err
+			}
+			existing.ResourceData = resourceData
+			if err := UpdateConfiguration(p.db, existing); err != nil {
+				return Model{}, err
+			}
+			m, err := Make(existing)
+			if err != nil {
+				return Model{}, err
+			}
+			if err := mb.Put(EventTopicConfigurationStatus, CreateStorageConfigStatusEventProvider(tenantId, EventTypeStorageConfigUpdated, storageConfigId)); err != nil {
+				return Model{}, err
+			}
+			return m, nil
+		}
+	}
+}
+
+// UpdateStorageConfigAndEmit updates the storage-configs configuration and emits events
+func (p *ProcessorImpl) UpdateStorageConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error) {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return Model{}, err
+	}
+	var result Model
+	txErr := database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		var err error
+		result, err = message.EmitWithResult[Model, uuid.UUID](outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) func(uuid.UUID) (Model, error) {
+			return func(tenantId uuid.UUID) (Model, error) {
+				return NewProcessor(p.l, ctx, tx).UpdateStorageConfig(mb)(tenantId)(cfg)
+			}
+		})(tenantId)
+		return err
+	})
+	return result, txErr
+}
+
+// DeleteStorageConfig deletes the storage-configs configuration
+func (p *ProcessorImpl) DeleteStorageConfig(mb *message.Buffer) func(tenantId uuid.UUID) error {
+	return func(tenantId uuid.UUID) error {
+		if _, err := DeleteConfigurationByResourceName(p.db, tenantId, "storage-configs"); err != nil {
+			return err
+		}
+		return mb.Put(EventTopicConfigurationStatus, CreateStorageConfigStatusEventProvider(tenantId, EventTypeStorageConfigDeleted, ""))
+	}
+}
+
+// DeleteStorageConfigAndEmit deletes the storage-configs configuration and emits events
+func (p *ProcessorImpl) DeleteStorageConfigAndEmit(tenantId uuid.UUID) error {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return err
+	}
+	return database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) error {
+			return NewProcessor(p.l, ctx, tx).DeleteStorageConfig(mb)(tenantId)
+		})
+	})
+}
+
+// GetStorageConfig gets the storage-configs configuration for a tenant
+func (p *ProcessorImpl) GetStorageConfig(tenantId uuid.UUID) (map[string]interface{}, error) {
+	return p.StorageConfigProvider(tenantId)()
+}
+
+// StorageConfigProvider returns a provider for the storage-configs configuration
+func (p *ProcessorImpl) StorageConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}] {
+	return GetStorageConfigProvider(tenantId)(p.db)
+}
+
+// CreateFameConfig creates (or replaces) the tenant's fame-configs configuration
+func (p *ProcessorImpl) CreateFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+	return func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+		return func(cfg map[string]interface{}) (Model, error) {
+			fameConfigId := ""
+			if id, ok := cfg["id"].(string); ok {
+				fameConfigId = id
+			}
+
+			resourceData, err := CreateSingleFameConfigJsonData(cfg)
+			if err != nil {
+				return Model{}, err
+			}
+
+			existingProvider := GetByTenantIdAndResourceNameProvider(tenantId, "fame-configs")(p.db)
+			existing, err := existingProvider()
+			if err == nil {
+				existing.ResourceData = resourceData
+				if err := UpdateConfiguration(p.db, existing); err != nil {
+					return Model{}, err
+				}
+				m, err := Make(existing)
+				if err != nil {
+					return Model{}, err
+				}
+				if err := mb.Put(EventTopicConfigurationStatus, CreateFameConfigStatusEventProvider(tenantId, EventTypeFameConfigUpdated, fameConfigId)); err != nil {
+					return Model{}, err
+				}
+				return m, nil
+			} else if errors.Is(err, gorm.ErrRecordNotFound) {
+				entity := Entity{
+					ID:           uuid.New(),
+					TenantId:     tenantId,
+					ResourceName: "fame-configs",
+					ResourceData: resourceData,
+				}
+				if err := CreateConfiguration(p.db, entity); err != nil {
+					return Model{}, err
+				}
+				m, err := Make(entity)
+				if err != nil {
+					return Model{}, err
+				}
+				if err := mb.Put(EventTopicConfigurationStatus, CreateFameConfigStatusEventProvider(tenantId, EventTypeFameConfigCreated, fameConfigId)); err != nil {
+					return Model{}, err
+				}
+				return m, nil
+			}
+			return Model{}, err
+		}
+	}
+}
+
+// CreateFameConfigAndEmit creates the fame-configs configuration and emits events
+func (p *ProcessorImpl) CreateFameConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error) {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return Model{}, err
+	}
+	var result Model
+	txErr := database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		var err error
+		result, err = message.EmitWithResult[Model, uuid.UUID](outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) func(uuid.UUID) (Model, error) {
+			return func(tenantId uuid.UUID) (Model, error) {
+				return NewProcessor(p.l, ctx, tx).CreateFameConfig(mb)(tenantId)(cfg)
+			}
+		})(tenantId)
+		return err
+	})
+	return result, txErr
+}
+
+// UpdateFameConfig updates the existing fame-configs configuration
+func (p *ProcessorImpl) UpdateFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+	return func(tenantId uuid.UUID) func(cfg map[string]interface{}) (Model, error) {
+		return func(cfg map[string]interface{}) (Model, error) {
+			existingProvider := GetByTenantIdAndResourceNameProvider(tenantId, "fame-configs")(p.db)
+			existing, err := existingProvider()
+			if err != nil {
+				return Model{}, err
+			}
+
+			fameConfigId := ""
+			if id, ok := cfg["id"].(string); ok {
+				fameConfigId = id
+			}
+
+			resourceData, err := CreateSingleFameConfigJsonData(cfg)
+			if err != nil {
+				return Model{}, err
+			}
+			existing.ResourceData = resourceData
+			if err := UpdateConfiguration(p.db, existing); err != nil {
+				return Model{}, err
+			}
+			m, err := Make(existing)
+			if err != nil {
+				return Model{}, err
+			}
+			if err := mb.Put(EventTopicConfigurationStatus, CreateFameConfigStatusEventProvider(tenantId, EventTypeFameConfigUpdated, fameConfigId)); err != nil {
+				return Model{}, err
+			}
+			return m, nil
+		}
+	}
+}
+
+// UpdateFameConfigAndEmit updates the fame-configs configuration and emits events
+func (p *ProcessorImpl) UpdateFameConfigAndEmit(tenantId uuid.UUID, cfg map[string]interface{}) (Model, error) {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return Model{}, err
+	}
+	var result Model
+	txErr := database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		var err error
+		result, err = message.EmitWithResult[Model, uuid.UUID](outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) func(uuid.UUID) (Model, error) {
+			return func(tenantId uuid.UUID) (Model, error) {
+				return NewProcessor(p.l, ctx, tx).UpdateFameConfig(mb)(tenantId)(cfg)
+			}
+		})(tenantId)
+		return err
+	})
+	return result, txErr
+}
+
+// DeleteFameConfig deletes the fame-configs configuration
+func (p *ProcessorImpl) DeleteFameConfig(mb *message.Buffer) func(tenantId uuid.UUID) error {
+	return func(tenantId uuid.UUID) error {
+		if _, err := DeleteConfigurationByResourceName(p.db, tenantId, "fame-configs"); err != nil {
+			return err
+		}
+		return mb.Put(EventTopicConfigurationStatus, CreateFameConfigStatusEventProvider(tenantId, EventTypeFameConfigDeleted, ""))
+	}
+}
+
+// DeleteFameConfigAndEmit deletes the fame-configs configuration and emits events
+func (p *ProcessorImpl) DeleteFameConfigAndEmit(tenantId uuid.UUID) error {
+	ctx, err := p.tenantCtx(tenantId)
+	if err != nil {
+		return err
+	}
+	return database.ExecuteTransaction(p.db.WithContext(ctx), func(tx *gorm.DB) error {
+		return message.Emit(outbox.EmitProvider(p.l, ctx, tx))(func(mb *message.Buffer) error {
+			return NewProcessor(p.l, ctx, tx).DeleteFameConfig(mb)(tenantId)
+		})
+	})
+}
+
+// GetFameConfig gets the fame-configs configuration for a tenant
+func (p *ProcessorImpl) GetFameConfig(tenantId uuid.UUID) (map[string]interface{}, error) {
+	return p.FameConfigProvider(tenantId)()
+}
+
+// FameConfigProvider returns a provider for the fame-configs configuration
+func (p *ProcessorImpl) FameConfigProvider(tenantId uuid.UUID) model.Provider[map[string]interface{}] {
+	return GetFameConfigProvider(tenantId)(p.db)
+}
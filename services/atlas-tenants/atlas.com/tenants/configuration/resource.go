@@ -1505,6 +1505,276 @@ func DeleteKiteConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *res
 	}
 }
 
+// GetStorageConfigHandler handles GET /tenants/{tenantId}/configurations/storage-configs
+func GetStorageConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+
+				cfg, err := processor.GetStorageConfig(tenantId)
+				if err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					d.Logger().WithError(err).Error("Failed to get storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				rm, err := TransformStorageConfig(cfg)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[StorageConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// CreateStorageConfigHandler handles POST /tenants/{tenantId}/configurations/storage-configs
+func CreateStorageConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext, model StorageConfigRestModel) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext, model StorageConfigRestModel) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				cfg, err := ExtractStorageConfig(model)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to extract storage-configs data")
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if _, err = processor.CreateStorageConfigAndEmit(tenantId, cfg); err != nil {
+					d.Logger().WithError(err).Error("Failed to create storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				created, err := processor.GetStorageConfig(tenantId)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to get created storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				rm, err := TransformStorageConfig(created)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				w.WriteHeader(http.StatusCreated)
+				server.MarshalResponse[StorageConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// UpdateStorageConfigHandler handles PATCH /tenants/{tenantId}/configurations/storage-configs
+func UpdateStorageConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext, model StorageConfigRestModel) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext, model StorageConfigRestModel) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				cfg, err := ExtractStorageConfig(model)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to extract storage-configs data")
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if _, err = processor.UpdateStorageConfigAndEmit(tenantId, cfg); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					d.Logger().WithError(err).Error("Failed to update storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				updated, err := processor.GetStorageConfig(tenantId)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to get updated storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				rm, err := TransformStorageConfig(updated)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[StorageConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// DeleteStorageConfigHandler handles DELETE /tenants/{tenantId}/configurations/storage-configs
+func DeleteStorageConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if err := processor.DeleteStorageConfigAndEmit(tenantId); err != nil {
+					d.Logger().WithError(err).Error("Failed to delete storage-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+	}
+}
+
+// GetFameConfigHandler handles GET /tenants/{tenantId}/configurations/fame-configs
+func GetFameConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+
+				cfg, err := processor.GetFameConfig(tenantId)
+				if err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					d.Logger().WithError(err).Error("Failed to get fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				rm, err := TransformFameConfig(cfg)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[FameConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// CreateFameConfigHandler handles POST /tenants/{tenantId}/configurations/fame-configs
+func CreateFameConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext, model FameConfigRestModel) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext, model FameConfigRestModel) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				cfg, err := ExtractFameConfig(model)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to extract fame-configs data")
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if _, err = processor.CreateFameConfigAndEmit(tenantId, cfg); err != nil {
+					d.Logger().WithError(err).Error("Failed to create fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				created, err := processor.GetFameConfig(tenantId)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to get created fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				rm, err := TransformFameConfig(created)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				w.WriteHeader(http.StatusCreated)
+				server.MarshalResponse[FameConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// UpdateFameConfigHandler handles PATCH /tenants/{tenantId}/configurations/fame-configs
+func UpdateFameConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext, model FameConfigRestModel) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext, model FameConfigRestModel) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				cfg, err := ExtractFameConfig(model)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to extract fame-configs data")
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if _, err = processor.UpdateFameConfigAndEmit(tenantId, cfg); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					d.Logger().WithError(err).Error("Failed to update fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				updated, err := processor.GetFameConfig(tenantId)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to get updated fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				rm, err := TransformFameConfig(updated)
+				if err != nil {
+					d.Logger().WithError(err).Error("Failed to transform fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+
+				query := r.URL.Query()
+				queryParams := jsonapi.ParseQueryFields(&query)
+				server.MarshalResponse[FameConfigRestModel](d.Logger())(w)(c.ServerInformation())(queryParams)(rm)
+			}
+		})
+	}
+}
+
+// DeleteFameConfigHandler handles DELETE /tenants/{tenantId}/configurations/fame-configs
+func DeleteFameConfigHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
+		return rest.ParseTenantId(d.Logger(), func(tenantId uuid.UUID) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				processor := NewProcessor(d.Logger(), d.Context(), db)
+				if err := processor.DeleteFameConfigAndEmit(tenantId); err != nil {
+					d.Logger().WithError(err).Error("Failed to delete fame-configs configuration")
+					server.WriteErrorResponse(d.Logger())(w)(err)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+	}
+}
+
 // SeedRpsRewardsHandler handles POST /tenants/{tenantId}/configurations/rps-rewards/seed
 func SeedRpsRewardsHandler(db *gorm.DB) func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
 	return func(d *rest.HandlerDependency, c *rest.HandlerContext) http.HandlerFunc {
@@ -1539,6 +1809,8 @@ func RegisterRoutes(db *gorm.DB) func(si jsonapi.ServerInformation) server.Route
 			registerTradeConfigInputHandler := rest.RegisterInputHandler[TradeConfigRestModel](l)(si)
 			registerRankingsInputHandler := rest.RegisterInputHandler[RankingsRestModel](l)(si)
 			registerKiteConfigInputHandler := rest.RegisterInputHandler[KiteConfigRestModel](l)(si)
+			registerStorageConfigInputHandler := rest.RegisterInputHandler[StorageConfigRestModel](l)(si)
+			registerFameConfigInputHandler := rest.RegisterInputHandler[FameConfigRestModel](l)(si)
 
 			// Route endpoints
 			//
@@ -1619,6 +1891,18 @@ func RegisterRoutes(db *gorm.DB) func(si jsonapi.ServerInformation) server.Route
 			r.HandleFunc("/tenants/{tenantId}/configurations/kite-configs", registerKiteConfigInputHandler("update_kite_config", UpdateKiteConfigHandler(db))).Methods(http.MethodPatch)
 			r.HandleFunc("/tenants/{tenantId}/configurations/kite-configs", registerHandler("delete_kite_config", DeleteKiteConfigHandler(db))).Methods(http.MethodDelete)
 
+			// Storage config endpoints — one config per tenant (kite-configs shape).
+			r.HandleFunc("/tenants/{tenantId}/configurations/storage-configs", registerHandler("get_storage_config", GetStorageConfigHandler(db))).Methods(http.MethodGet)
+			r.HandleFunc("/tenants/{tenantId}/configurations/storage-configs", registerStorageConfigInputHandler("create_storage_config", CreateStorageConfigHandler(db))).Methods(http.MethodPost)
+			r.HandleFunc("/tenants/{tenantId}/configurations/storage-configs", registerStorageConfigInputHandler("update_storage_config", UpdateStorageConfigHandler(db))).Methods(http.MethodPatch)
+			r.HandleFunc("/tenants/{tenantId}/configurations/storage-configs", registerHandler("delete_storage_config", DeleteStorageConfigHandler(db))).Methods(http.MethodDelete)
+
+			// Fame config endpoints — one config per tenant (kite-configs shape).
+			r.HandleFunc("/tenants/{tenantId}/configurations/fame-configs", registerHandler("get_fame_config", GetFameConfigHandler(db))).Methods(http.MethodGet)
+			r.HandleFunc("/tenants/{tenantId}/configurations/fame-configs", registerFameConfigInputHandler("create_fame_config", CreateFameConfigHandler(db))).Methods(http.MethodPost)
+			r.HandleFunc("/tenants/{tenantId}/configurations/fame-configs", registerFameConfigInputHandler("update_fame_config", UpdateFameConfigHandler(db))).Methods(http.MethodPatch)
+			r.HandleFunc("/tenants/{tenantId}/configurations/fame-configs", registerHandler("delete_fame_config", DeleteFameConfigHandler(db))).Methods(http.MethodDelete)
+
 			// Imprint config endpoints (FR-2.6 pending-change expiry) — see
 			// imprint_handler.go.
 			RegisterImprintConfigRoutes(db, si, l, r)
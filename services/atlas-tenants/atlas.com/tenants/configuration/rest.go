@@ -2,6 +2,8 @@ package configuration
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 
@@ -966,6 +968,166 @@ func CreateSingleKiteConfigJsonData(cfg map[string]interface{}) (json.RawMessage
 	return json.Marshal(map[string]interface{}{"data": cfg})
 }
 
+// StorageConfigRestModel is the JSON:API resource for the per-tenant storage
+// tier policy consumed by atlas-storage: how many extra per-world tabs an
+// account gets, whether an account-wide shared tab exists, and whether mesos
+// are kept per world or pooled across the account. One row per tenant, like
+// kite-configs.
+type StorageConfigRestModel struct {
+	Id                string `json:"-"`
+	ExtraTabs         int    `json:"extraTabs"`
+	TabCapacity       int    `json:"tabCapacity"`
+	SharedTab         bool   `json:"sharedTab"`
+	SharedTabCapacity int    `json:"sharedTabCapacity"`
+	MesoMode          string `json:"mesoMode"`
+}
+
+func (r StorageConfigRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *StorageConfigRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func (r StorageConfigRestModel) GetName() string {
+	return "storage-configs"
+}
+
+// TransformStorageConfig converts the stored JSONB map into a StorageConfigRestModel.
+func TransformStorageConfig(data map[string]interface{}) (StorageConfigRestModel, error) {
+	id, _ := data["id"].(string)
+
+	readInt := func(key string) int {
+		if v, ok := data[key].(float64); ok {
+			return int(v)
+		}
+		if v, ok := data[key].(int); ok {
+			return v
+		}
+		return 0
+	}
+
+	sharedTab, _ := data["sharedTab"].(bool)
+	mesoMode, _ := data["mesoMode"].(string)
+
+	return StorageConfigRestModel{
+		Id:                id,
+		ExtraTabs:         readInt("extraTabs"),
+		TabCapacity:       readInt("tabCapacity"),
+		SharedTab:         sharedTab,
+		SharedTabCapacity: readInt("sharedTabCapacity"),
+		MesoMode:          mesoMode,
+	}, nil
+}
+
+// ExtractStorageConfig converts a StorageConfigRestModel back into the stored
+// JSONB map. An unknown meso mode is rejected so a typo cannot silently pool
+// or split a tenant's mesos.
+func ExtractStorageConfig(r StorageConfigRestModel) (map[string]interface{}, error) {
+	switch r.MesoMode {
+	case "", "WORLD", "ACCOUNT":
+	default:
+		return nil, fmt.Errorf("invalid mesoMode %q", r.MesoMode)
+	}
+	if r.ExtraTabs < 0 || r.TabCapacity < 0 || r.SharedTabCapacity < 0 {
+		return nil, errors.New("storage tab counts and capacities must not be negative")
+	}
+	id := r.Id
+	if id == "" {
+		id = uuid.New().String()
+	}
+	return map[string]interface{}{
+		"id":                id,
+		"extraTabs":         r.ExtraTabs,
+		"tabCapacity":       r.TabCapacity,
+		"sharedTab":         r.SharedTab,
+		"sharedTabCapacity": r.SharedTabCapacity,
+		"mesoMode":          r.MesoMode,
+	}, nil
+}
+
+// CreateSingleStorageConfigJsonData wraps one storage config in a JSON:API
+// document using the same flat layout as CreateSingleKiteConfigJsonData.
+func CreateSingleStorageConfigJsonData(cfg map[string]interface{}) (json.RawMessage, error) {
+	return json.Marshal(map[string]interface{}{"data": cfg})
+}
+
+// FameConfigRestModel is the JSON:API resource for the per-tenant fame
+// limits enforced by atlas-fame: how many times a character may give fame per
+// day, how long before the same target may be famed again, and the level a
+// character needs to give fame at all. Zero fields fall back to atlas-fame's
+// defaults. One row per tenant, like kite-configs.
+type FameConfigRestModel struct {
+	Id                 string `json:"-"`
+	DailyLimit         int    `json:"dailyLimit"`
+	TargetCooldownDays int    `json:"targetCooldownDays"`
+	MinimumLevel       int    `json:"minimumLevel"`
+}
+
+func (r FameConfigRestModel) GetID() string {
+	return r.Id
+}
+
+func (r *FameConfigRestModel) SetID(id string) error {
+	r.Id = id
+	return nil
+}
+
+func (r FameConfigRestModel) GetName() string {
+	return "fame-configs"
+}
+
+// TransformFameConfig converts the stored JSONB map into a FameConfigRestModel.
+func TransformFameConfig(data map[string]interface{}) (FameConfigRestModel, error) {
+	id, _ := data["id"].(string)
+
+	readInt := func(key string) int {
+		if v, ok := data[key].(float64); ok {
+			return int(v)
+		}
+		if v, ok := data[key].(int); ok {
+			return v
+		}
+		return 0
+	}
+
+	return FameConfigRestModel{
+		Id:                 id,
+		DailyLimit:         readInt("dailyLimit"),
+		TargetCooldownDays: readInt("targetCooldownDays"),
+		MinimumLevel:       readInt("minimumLevel"),
+	}, nil
+}
+
+// ExtractFameConfig converts a FameConfigRestModel back into the stored JSONB
+// map. Negative limits are rejected rather than read as "unlimited".
+func ExtractFameConfig(r FameConfigRestModel) (map[string]interface{}, error) {
+	if r.DailyLimit < 0 || r.TargetCooldownDays < 0 || r.MinimumLevel < 0 {
+		return nil, errors.New("fame limits must not be negative")
+	}
+	if r.MinimumLevel > 255 {
+		return nil, errors.New("minimumLevel must not exceed 255")
+	}
+	id := r.Id
+	if id == "" {
+		id = uuid.New().String()
+	}
+	return map[string]interface{}{
+		"id":                 id,
+		"dailyLimit":         r.DailyLimit,
+		"targetCooldownDays": r.TargetCooldownDays,
+		"minimumLevel":       r.MinimumLevel,
+	}, nil
+}
+
+// CreateSingleFameConfigJsonData wraps one fame config in a JSON:API document
+// using the same flat layout as CreateSingleKiteConfigJsonData.
+func CreateSingleFameConfigJsonData(cfg map[string]interface{}) (json.RawMessage, error) {
+	return json.Marshal(map[string]interface{}{"data": cfg})
+}
+
 // RpsRewardRungRestModel is the nested JSON attribute shape of a single rung
 // embedded in the rps-rewards `ladder` array.
 type RpsRewardRungRestModel struct {
@@ -281,3 +281,71 @@ func TestKiteConfigTransformExtractRoundTrip(t *testing.T) {
 		t.Errorf("round-trip maxPerMap = %v, want 10", out["maxPerMap"])
 	}
 }
+
+func TestStorageConfigTransformExtractRoundTrip(t *testing.T) {
+	data := map[string]interface{}{
+		"id":                "storage-configs",
+		"extraTabs":         float64(2),
+		"tabCapacity":       float64(48),
+		"sharedTab":         true,
+		"sharedTabCapacity": float64(24),
+		"mesoMode":          "ACCOUNT",
+	}
+	rm, err := TransformStorageConfig(data)
+	if err != nil {
+		t.Fatalf("TransformStorageConfig: %v", err)
+	}
+	if rm.ExtraTabs != 2 || rm.TabCapacity != 48 || !rm.SharedTab || rm.SharedTabCapacity != 24 || rm.MesoMode != "ACCOUNT" {
+		t.Errorf("TransformStorageConfig = %+v", rm)
+	}
+	if rm.GetName() != "storage-configs" {
+		t.Errorf("GetName() = %s, want storage-configs", rm.GetName())
+	}
+
+	out, err := ExtractStorageConfig(rm)
+	if err != nil {
+		t.Fatalf("ExtractStorageConfig: %v", err)
+	}
+	if out["extraTabs"] != 2 || out["sharedTab"] != true || out["mesoMode"] != "ACCOUNT" {
+		t.Errorf("round-trip = %v", out)
+	}
+}
+
+func TestStorageConfigExtractRejectsUnknownMesoMode(t *testing.T) {
+	if _, err := ExtractStorageConfig(StorageConfigRestModel{MesoMode: "GUILD"}); err == nil {
+		t.Fatal("expected an error for an unknown mesoMode")
+	}
+}
+
+func TestFameConfigTransformExtractRoundTrip(t *testing.T) {
+	data := map[string]interface{}{
+		"id":                 "fame-configs",
+		"dailyLimit":         float64(3),
+		"targetCooldownDays": float64(7),
+		"minimumLevel":       float64(20),
+	}
+	rm, err := TransformFameConfig(data)
+	if err != nil {
+		t.Fatalf("TransformFameConfig: %v", err)
+	}
+	if rm.DailyLimit != 3 || rm.TargetCooldownDays != 7 || rm.MinimumLevel != 20 {
+		t.Errorf("TransformFameConfig = %+v", rm)
+	}
+	if rm.GetName() != "fame-configs" {
+		t.Errorf("GetName() = %s, want fame-configs", rm.GetName())
+	}
+
+	out, err := ExtractFameConfig(rm)
+	if err != nil {
+		t.Fatalf("ExtractFameConfig: %v", err)
+	}
+	if out["dailyLimit"] != 3 || out["targetCooldownDays"] != 7 || out["minimumLevel"] != 20 {
+		t.Errorf("round-trip = %v", out)
+	}
+}
+
+func TestFameConfigExtractRejectsNegativeLimits(t *testing.T) {
+	if _, err := ExtractFameConfig(FameConfigRestModel{DailyLimit: -1}); err == nil {
+		t.Fatal("expected an error for a negative dailyLimit")
+	}
+}
@@ -0,0 +1,55 @@
+#!/usr/bin/env bash
+# note-custody-contract-mirror-guard.sh — enforces that the
+# COMMAND_TOPIC_NOTE_CUSTODY / EVENT_TOPIC_NOTE_CUSTODY_STATUS contract is
+# identical in its two copies.
+#
+# atlas-notes owns the contract; atlas-saga-orchestrator carries a mirror
+# because the two services live in separate Go modules and nothing in the
+# compiler links them. A field name or json tag changed in one copy and not the
+# other does not fail any build — it decodes into a zero-valued body at
+# runtime, silently: an attachment escrowed with no snapshot is an item that
+# comes back out of the note as nothing. Modelled on
+# tools/trade-contract-mirror-guard.sh.
+#
+# The two files are compared from their `package` clause onward: the only
+# permitted difference is the leading doc comment, which names the mirror
+# direction and therefore differs by design.
+#
+# Run from the repo root; drift → non-zero exit.
+set -euo pipefail
+
+ROOT="$(cd "$(dirname "$0")/.." && pwd)"
+OWNER="$ROOT/services/atlas-notes/atlas.com/notes/kafka/message/note/custody/kafka.go"
+MIRROR="$ROOT/services/atlas-saga-orchestrator/atlas.com/saga-orchestrator/kafka/message/note/custody/kafka.go"
+
+rc=0
+for f in "$OWNER" "$MIRROR"; do
+    if [ ! -f "$f" ]; then
+        echo "note-custody-contract-mirror-guard: FAIL — missing contract file: ${f#"$ROOT"/}"
+        rc=1
+    fi
+done
+[ "$rc" -ne 0 ] && exit "$rc"
+
+body() { awk '/^package /{p=1} p' "$1"; }
+
+if diff -u --label "owner: ${OWNER#"$ROOT"/}" --label "mirror: ${MIRROR#"$ROOT"/}" \
+        <(body "$OWNER") <(body "$MIRROR"); then
+    echo "OK: the note custody contract mirror matches its owner."
+    exit 0
+fi
+
+echo ""
+echo "note-custody-contract-mirror-guard: FAIL — the note custody Kafka contract has drifted."
+echo "  owner : ${OWNER#"$ROOT"/}"
+echo "  mirror: ${MIRROR#"$ROOT"/}"
+echo ""
+echo "  These two files are one cross-service wire contract. Struct names, field"
+echo "  names and json tags must match exactly; only the leading doc comment,"
+echo "  which names the mirror direction, may differ."
+echo ""
+echo "  FIX: apply the change to the owner, then re-copy it to the mirror and"
+echo "  restore the mirror's doc-comment header:"
+echo "    cp ${OWNER#"$ROOT"/} ${MIRROR#"$ROOT"/}"
+echo "  (then edit the copied header back to \"Mirrors <owner path>\")"
+exit 1
@@ -461,6 +461,12 @@ else
     skip "mist contract mirror guard (contract unchanged)"
 fi
 
+if touched 'kafka/message/note/custody/kafka\.go'; then
+    step "note custody contract mirror guard" ./tools/note-custody-contract-mirror-guard.sh
+else
+    skip "note custody contract mirror guard (contract unchanged)"
+fi
+
 if touched 'kafka/message/shops/kafka\.go'; then
     step "npc-shop contract mirror guard" ./tools/npc-shop-contract-mirror-guard.sh
 else